@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"strconv"
+
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
@@ -16,22 +18,35 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// AttributeKeyTxPriority is the event attribute holding the priority assigned
+// to a transaction during CheckTx.
+const AttributeKeyTxPriority = "priority"
+
 // HandlerOptions defines the list of module keepers required to run the Cosmos EVM
 // AnteHandler decorators.
 type HandlerOptions struct {
-	Cdc                    codec.BinaryCodec
-	AccountKeeper          anteinterfaces.AccountKeeper
-	BankKeeper             anteinterfaces.BankKeeper
-	IBCKeeper              *ibckeeper.Keeper
-	FeeMarketKeeper        anteinterfaces.FeeMarketKeeper
-	EvmKeeper              anteinterfaces.EVMKeeper
+	Cdc             codec.BinaryCodec
+	AccountKeeper   anteinterfaces.AccountKeeper
+	BankKeeper      anteinterfaces.BankKeeper
+	IBCKeeper       *ibckeeper.Keeper
+	FeeMarketKeeper anteinterfaces.FeeMarketKeeper
+	EvmKeeper       anteinterfaces.EVMKeeper
+	// Erc20Keeper is optional. When set, Ethereum transactions can pay their fees
+	// in the alternative denominations defined in the fee market parameters.
+	Erc20Keeper            anteinterfaces.Erc20Keeper
 	FeegrantKeeper         ante.FeegrantKeeper
 	ExtensionOptionChecker ante.ExtensionOptionChecker
 	SignModeHandler        *txsigning.HandlerMap
 	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params authtypes.Params) error
 	MaxTxGasWanted         uint64
-	TxFeeChecker           ante.TxFeeChecker
-	PendingTxListener      PendingTxListener
+	// RejectOversizedTxs rejects in check tx mode the Ethereum transactions whose
+	// gas limit exceeds MaxTxGasWanted instead of capping their gas wanted to it.
+	RejectOversizedTxs bool
+	TxFeeChecker       ante.TxFeeChecker
+	PendingTxListener  PendingTxListener
+	// EVMDecoratorOptions are optional and allow to inject custom decorators in
+	// the chain run for EVM transactions, e.g. using InsertBefore and InsertAfter.
+	EVMDecoratorOptions []EVMDecoratorOption
 }
 
 // Validate checks if the keepers are defined
@@ -68,6 +83,10 @@ func (options HandlerOptions) Validate() error {
 		return errorsmod.Wrap(errortypes.ErrLogic, "pending tx listener is required for AnteHandler")
 	}
 
+	if _, err := options.evmDecorators(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrLogic, "invalid EVM ante decorators: %s", err)
+	}
+
 	return nil
 }
 
@@ -99,7 +118,7 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 					)
 				}
 
-				return anteHandler(ctx, tx, sim)
+				return withTxPriorityEvent(anteHandler)(ctx, tx, sim)
 			}
 		}
 
@@ -111,6 +130,27 @@ func NewAnteHandler(options HandlerOptions) sdk.AnteHandler {
 			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid transaction type: %T", tx)
 		}
 
-		return anteHandler(ctx, tx, sim)
+		return withTxPriorityEvent(anteHandler)(ctx, tx, sim)
+	}
+}
+
+// withTxPriorityEvent wraps the ante handler to emit the priority assigned to
+// the transaction during CheckTx, i.e. the effective tip for EVM transactions
+// and the fee priority for Cosmos transactions, since it is not part of the
+// CheckTx response.
+func withTxPriorityEvent(anteHandler sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, sim bool) (sdk.Context, error) {
+		newCtx, err := anteHandler(ctx, tx, sim)
+		if err != nil || !newCtx.IsCheckTx() || sim {
+			return newCtx, err
+		}
+
+		newCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeTx,
+				sdk.NewAttribute(AttributeKeyTxPriority, strconv.FormatInt(newCtx.Priority(), 10)),
+			),
+		)
+		return newCtx, nil
 	}
 }
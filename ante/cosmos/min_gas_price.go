@@ -37,16 +37,27 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType, "invalid transaction type %T, expected sdk.FeeTx", tx)
 	}
 
-	minGasPrice := mpd.feemarketKeeper.GetParams(ctx).MinGasPrice
+	params := mpd.feemarketKeeper.GetParams(ctx)
+	minGasPrice := params.MinGasPrice
 
 	feeCoins := feeTx.GetFee()
 	evmDenom := evmtypes.GetEVMCoinDenom()
+	minGasPriceDenom := evmDenom
+
+	// the minimum gas price set for the fee denomination, if any, overrides the
+	// global one and allows paying the fees in that denomination
+	if len(feeCoins) == 1 {
+		if override, found := params.GetMinGasPriceOverride(feeCoins.GetDenomByIndex(0)); found {
+			minGasPrice = override
+			minGasPriceDenom = feeCoins.GetDenomByIndex(0)
+		}
+	}
 
 	// only allow user to pass in aatom and stake native token as transaction fees
 	// allow use stake native tokens for fees is just for unit tests to pass
 	//
 	// TODO: is the handling of stake necessary here? Why not adjust the tests to contain the correct denom?
-	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom, minGasPriceDenom}, feeCoins.GetDenomByIndex(0)))
 	if !validFees && !simulate {
 		return ctx, fmt.Errorf("expected only native token %s for fee, but got %s", evmDenom, feeCoins.String())
 	}
@@ -58,7 +69,7 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 	minGasPrices := sdk.DecCoins{
 		{
-			Denom:  evmDenom,
+			Denom:  minGasPriceDenom,
 			Amount: minGasPrice,
 		},
 	}
@@ -1,21 +1,145 @@
 package ante
 
 import (
+	"fmt"
+
 	evmante "github.com/cosmos/evm/ante/evm"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// EVMMonoDecoratorName is the name of the decorator running all the default
+	// checks for EVM transactions.
+	EVMMonoDecoratorName = "evm_mono"
+	// TxListenerDecoratorName is the name of the decorator notifying the pending
+	// EVM transactions. It must be the last decorator of the chain.
+	TxListenerDecoratorName = "tx_listener"
+)
+
+// NamedDecorator is an ante decorator identified by a unique name, used to
+// position custom decorators within the EVM ante handler chain.
+type NamedDecorator struct {
+	Name      string
+	Decorator sdk.AnteDecorator
+}
+
+// EVMDecoratorOption customizes the chain of decorators run for EVM
+// transactions.
+type EVMDecoratorOption func(decorators []NamedDecorator) ([]NamedDecorator, error)
+
+// InsertBefore returns an EVMDecoratorOption that inserts the given decorators
+// right before the decorator with the given name.
+func InsertBefore(name string, decorators ...NamedDecorator) EVMDecoratorOption {
+	return func(chain []NamedDecorator) ([]NamedDecorator, error) {
+		return insertDecorators(chain, name, 0, decorators)
+	}
+}
+
+// InsertAfter returns an EVMDecoratorOption that inserts the given decorators
+// right after the decorator with the given name.
+func InsertAfter(name string, decorators ...NamedDecorator) EVMDecoratorOption {
+	return func(chain []NamedDecorator) ([]NamedDecorator, error) {
+		return insertDecorators(chain, name, 1, decorators)
+	}
+}
+
+// insertDecorators inserts the decorators at the position of the decorator
+// with the given name, shifted by the given offset.
+func insertDecorators(chain []NamedDecorator, name string, offset int, decorators []NamedDecorator) ([]NamedDecorator, error) {
+	for i, d := range chain {
+		if d.Name != name {
+			continue
+		}
+
+		pos := i + offset
+		res := make([]NamedDecorator, 0, len(chain)+len(decorators))
+		res = append(res, chain[:pos]...)
+		res = append(res, decorators...)
+		res = append(res, chain[pos:]...)
+		return res, nil
+	}
+
+	return nil, fmt.Errorf("decorator %s not found in the EVM ante handler chain", name)
+}
+
+// ValidateEVMDecorators checks that the chain of EVM decorators is safe to
+// run, i.e. that every decorator is set and uniquely named, that the mono
+// decorator is included and that the tx listener decorator is the last one.
+func ValidateEVMDecorators(decorators []NamedDecorator) error {
+	seen := make(map[string]struct{}, len(decorators))
+	for _, d := range decorators {
+		if d.Name == "" {
+			return fmt.Errorf("EVM ante decorator name cannot be empty")
+		}
+		if d.Decorator == nil {
+			return fmt.Errorf("EVM ante decorator %s cannot be nil", d.Name)
+		}
+		if _, found := seen[d.Name]; found {
+			return fmt.Errorf("duplicate EVM ante decorator %s", d.Name)
+		}
+		seen[d.Name] = struct{}{}
+	}
+
+	if _, found := seen[EVMMonoDecoratorName]; !found {
+		return fmt.Errorf("EVM ante decorator %s is required", EVMMonoDecoratorName)
+	}
+
+	if len(decorators) == 0 || decorators[len(decorators)-1].Name != TxListenerDecoratorName {
+		return fmt.Errorf("EVM ante decorator %s must be the last one", TxListenerDecoratorName)
+	}
+
+	return nil
+}
+
+// evmDecorators returns the chain of decorators run for EVM transactions,
+// after applying the custom options defined in the handler options.
+func (options HandlerOptions) evmDecorators() ([]NamedDecorator, error) {
+	decorators := []NamedDecorator{
+		{
+			Name: EVMMonoDecoratorName,
+			Decorator: evmante.NewEVMMonoDecorator(
+				options.AccountKeeper,
+				options.FeeMarketKeeper,
+				options.EvmKeeper,
+				options.MaxTxGasWanted,
+			).
+				WithErc20Keeper(options.Erc20Keeper).
+				WithFeegrantKeeper(options.FeegrantKeeper).
+				WithRejectOversizedTxs(options.RejectOversizedTxs),
+		},
+		{
+			Name:      TxListenerDecoratorName,
+			Decorator: NewTxListenerDecorator(options.PendingTxListener),
+		},
+	}
+
+	var err error
+	for _, opt := range options.EVMDecoratorOptions {
+		decorators, err = opt(decorators)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ValidateEVMDecorators(decorators); err != nil {
+		return nil, err
+	}
+
+	return decorators, nil
+}
+
 // newMonoEVMAnteHandler creates the sdk.AnteHandler implementation for the EVM transactions.
 func newMonoEVMAnteHandler(options HandlerOptions) sdk.AnteHandler {
-	decorators := []sdk.AnteDecorator{
-		evmante.NewEVMMonoDecorator(
-			options.AccountKeeper,
-			options.FeeMarketKeeper,
-			options.EvmKeeper,
-			options.MaxTxGasWanted,
-		),
-		NewTxListenerDecorator(options.PendingTxListener),
+	namedDecorators, err := options.evmDecorators()
+	if err != nil {
+		// safety check: shouldn't happen since the options are validated
+		panic(err)
+	}
+
+	decorators := make([]sdk.AnteDecorator, len(namedDecorators))
+	for i, d := range namedDecorators {
+		decorators[i] = d.Decorator
 	}
 
 	return sdk.ChainAnteDecorators(decorators...)
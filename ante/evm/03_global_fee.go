@@ -1,6 +1,10 @@
 package evm
 
 import (
+	"math/big"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -34,3 +38,25 @@ func CheckGlobalFee(fee, globalMinGasPrice, gasLimit math.LegacyDec) error {
 
 	return nil
 }
+
+// CheckConvertedGlobalFee validates the provided fee value, converted into the
+// alternative fee denomination, against the required fee computed from the
+// minimum gas price set for that denomination.
+func CheckConvertedGlobalFee(fee *big.Int, feeConversion *evmtypes.FeeConversion, minGasPrice, gasLimit math.LegacyDec) error {
+	if minGasPrice.IsZero() {
+		return nil
+	}
+
+	convertedFee := math.LegacyNewDecFromInt(feeConversion.ConvertFee(fee))
+	requiredFee := minGasPrice.Mul(gasLimit)
+
+	if convertedFee.LT(requiredFee) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"provided fee < minimum global fee (%s%s < %s%s). Please increase the priority tip (for EIP-1559 txs) or the gas prices (for access list or legacy txs)", //nolint:lll
+			convertedFee.TruncateInt().String(), feeConversion.Denom, requiredFee.Ceil().TruncateInt().String(), feeConversion.Denom,
+		)
+	}
+
+	return nil
+}
@@ -28,13 +28,58 @@ func VerifyAccountBalance(
 	from common.Address,
 	ethTx *ethtypes.Transaction,
 ) error {
+	account, err := verifyAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// VerifyAccountValueBalance checks that the account balance is greater than the
+// value transferred by the transaction. It is used when the transaction fees are
+// paid in an alternative denomination, whose balance is checked on deduction.
+// The account will be set to store if it doesn't exist, i.e. cannot be found on store.
+func VerifyAccountValueBalance(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+) error {
+	account, err := verifyAccount(ctx, evmKeeper, accountKeeper, account, from)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.CheckSenderValueBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+		return errorsmod.Wrap(err, "failed to check sender balance")
+	}
+
+	return nil
+}
+
+// verifyAccount checks that the sender is an EOA and creates its account if it
+// doesn't exist yet.
+func verifyAccount(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+) (*statedb.Account, error) {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.IsContract() {
 		// check eip-7702
 		code := evmKeeper.GetCode(ctx, common.BytesToHash(account.CodeHash))
 		_, delegated := ethtypes.ParseDelegation(code)
 		if len(code) > 0 && !delegated {
-			return errorsmod.Wrapf(
+			return nil, errorsmod.Wrapf(
 				errortypes.ErrInvalidType,
 				"the sender is not EOA: address %s", from,
 			)
@@ -46,9 +91,5 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
-		return errorsmod.Wrap(err, "failed to check sender balance")
-	}
-
-	return nil
+	return account, nil
 }
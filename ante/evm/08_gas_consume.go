@@ -36,6 +36,50 @@ func UpdateCumulativeGasWanted(
 	return cumulativeGasWanted
 }
 
+// CheckOversizedTx returns an error if the gas limit of an eth tx exceeds the
+// max tx gas wanted in check tx mode. Otherwise, the gas wanted of the tx is
+// capped to the max tx gas wanted and it can be included in blocks that don't
+// have enough gas left to execute it.
+func CheckOversizedTx(ctx sdktypes.Context, msgGasWanted uint64, maxTxGasWanted uint64) error {
+	if !ctx.IsCheckTx() || maxTxGasWanted == 0 || msgGasWanted <= maxTxGasWanted {
+		return nil
+	}
+
+	return errorsmod.Wrapf(
+		errortypes.ErrOutOfGas,
+		"tx gas (%d) exceeds max tx gas wanted (%d)",
+		msgGasWanted,
+		maxTxGasWanted,
+	)
+}
+
+// CheckRemainingBlockGas returns an error if the gas limit of the txs executed
+// in a block exceeds the gas left in the block.
+//
+// NOTE: it must be run before the fees are deducted and the nonces incremented.
+// The tx is then rejected without any state change: it has no receipt, the
+// sender is charged no fees and keeps its nonce, so that the tx is left in the
+// mempool to be included in a later block. Otherwise, the tx would run out of
+// block gas after its execution, with its fees charged and its nonce consumed
+// but its execution reverted and no consistent receipt.
+func CheckRemainingBlockGas(ctx sdktypes.Context, gasWanted uint64) error {
+	if ctx.ExecMode() != sdktypes.ExecModeFinalize || ctx.BlockGasMeter() == nil {
+		return nil
+	}
+
+	remaining := ctx.BlockGasMeter().GasRemaining()
+	if gasWanted > remaining {
+		return errorsmod.Wrapf(
+			errortypes.ErrOutOfGas,
+			"tx gas (%d) exceeds remaining block gas (%d)",
+			gasWanted,
+			remaining,
+		)
+	}
+
+	return nil
+}
+
 // ConsumeFeesAndEmitEvent deduces fees from sender and emits the event
 func ConsumeFeesAndEmitEvent(
 	ctx sdktypes.Context,
@@ -54,11 +54,14 @@ func (eeed EthEmitEventDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 func EmitTxHashEvent(ctx sdk.Context, msg *evmtypes.MsgEthereumTx, blockTxIndex, msgIndex uint64) {
 	// emit ethereum tx hash as an event so that it can be indexed by CometBFT for query purposes
 	// it's emitted in ante handler, so we can query failed transaction (out of block gas limit).
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			evmtypes.EventTypeEthereumTx,
-			sdk.NewAttribute(evmtypes.AttributeKeyEthereumTxHash, msg.Hash().String()),
-			sdk.NewAttribute(evmtypes.AttributeKeyTxIndex, strconv.FormatUint(blockTxIndex+msgIndex, 10)), // #nosec G115
-		),
-	)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(evmtypes.AttributeKeyEthereumTxHash, msg.Hash().String()),
+		sdk.NewAttribute(evmtypes.AttributeKeyTxIndex, strconv.FormatUint(blockTxIndex+msgIndex, 10)), // #nosec G115
+	}
+	// the CometBFT tx hash is indexed along with the ethereum one, so that they
+	// can be translated to each other
+	if hash := evmtypes.CometTxHash(ctx); hash != "" {
+		attrs = append(attrs, sdk.NewAttribute(evmtypes.AttributeKeyTxHash, hash))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(evmtypes.EventTypeEthereumTx, attrs...))
 }
@@ -0,0 +1,181 @@
+package evm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// GetFeeConversion returns the fee conversion requested by an Ethereum
+// transaction. A transaction requests to pay its fees in an alternative
+// denomination when the fee amount of the Cosmos tx wrapping it consists of a
+// single coin that is not the EVM denomination. As the Cosmos tx fee is not
+// covered by the Ethereum signatures, each sender must also authorize the
+// denomination with a fee payment signature, see VerifyFeePayment.
+//
+// The alternative denomination must belong to a registered token pair and have
+// a conversion rate defined in the fee market module. A nil fee conversion is
+// returned if the fees are paid in the EVM denomination or if fee abstraction is
+// disabled, i.e. the erc20 keeper is not set.
+func GetFeeConversion(
+	ctx sdk.Context,
+	feeMarketKeeper anteinterfaces.FeeMarketKeeper,
+	erc20Keeper anteinterfaces.Erc20Keeper,
+	tx sdk.Tx,
+) (*evmtypes.FeeConversion, error) {
+	if erc20Keeper == nil {
+		return nil, nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, nil
+	}
+
+	fees := feeTx.GetFee()
+	if len(fees) != 1 {
+		return nil, nil
+	}
+
+	denom := fees[0].Denom
+	if denom == evmtypes.GetEVMCoinDenom() || denom == evmtypes.GetEVMCoinExtendedDenom() {
+		return nil, nil
+	}
+
+	if !erc20Keeper.IsDenomRegistered(ctx, denom) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"fee denom %s is not a registered token pair", denom,
+		)
+	}
+
+	rate, found := feeMarketKeeper.GetFeeDenomRate(ctx, denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"fee denom %s is not accepted to pay fees", denom,
+		)
+	}
+
+	return evmtypes.NewFeeConversion(denom, rate), nil
+}
+
+// VerifyFeePayment verifies that the sender of the Ethereum message at the given
+// index authorized its fees to be paid in the alternative denomination and/or by
+// the fee granter, with the fee payment signature at the same index in the
+// Ethereum extension option. The fee denomination and granter are set on the
+// Cosmos tx wrapping the Ethereum messages, which is not covered by their
+// signatures, so they could otherwise be changed by anyone relaying the
+// transaction.
+func VerifyFeePayment(
+	tx sdk.Tx,
+	msgIndex int,
+	ethTx *ethtypes.Transaction,
+	from common.Address,
+	feeConversion *evmtypes.FeeConversion,
+	feeGranter sdk.AccAddress,
+) error {
+	sigs := getFeePaymentSignatures(tx)
+	if msgIndex >= len(sigs) {
+		return errorsmod.Wrapf(errortypes.ErrNoSignatures, "missing the fee payment signature of message %d", msgIndex)
+	}
+
+	denom := evmtypes.GetEVMCoinDenom()
+	if feeConversion != nil {
+		denom = feeConversion.Denom
+	}
+
+	message := evmtypes.FeePaymentMessage(ethTx.Hash(), denom, feeGranter)
+	return evmtypes.VerifyFeePaymentSignature(sigs[msgIndex], message, from)
+}
+
+// getFeePaymentSignatures returns the fee payment signatures of the Ethereum
+// extension option of the transaction, if any.
+func getFeePaymentSignatures(tx sdk.Tx) [][]byte {
+	txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	for _, opt := range txWithExtensions.GetExtensionOptions() {
+		if extOpt, ok := opt.GetCachedValue().(*evmtypes.ExtensionOptionsEthereumTx); ok {
+			return extOpt.FeePaymentSignatures
+		}
+	}
+	return nil
+}
+
+// ConsumeConvertedFeesAndEmitEvent converts the fees from the EVM denomination
+// into the alternative fee denomination, deducts them from the sender and emits
+// the corresponding events.
+func ConsumeConvertedFeesAndEmitEvent(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	fees sdk.Coins,
+	feeConversion *evmtypes.FeeConversion,
+	from sdk.AccAddress,
+) error {
+	convertedFees := feeConversion.ConvertCoins(fees)
+	if err := deductFees(
+		ctx,
+		evmKeeper,
+		convertedFees,
+		from,
+	); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, convertedFees.String()),
+		),
+		sdk.NewEvent(
+			evmtypes.EventTypeFeeConversion,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+			sdk.NewAttribute(evmtypes.AttributeKeyFeeDenom, feeConversion.Denom),
+			sdk.NewAttribute(evmtypes.AttributeKeyFeeRate, feeConversion.Rate.String()),
+			sdk.NewAttribute(evmtypes.AttributeKeyConvertedFee, convertedFees.String()),
+		),
+	})
+	return nil
+}
+
+// CheckConvertedTxFee checks that the Amount field of the txFeeInfo input covers
+// the txFee converted into the alternative fee denomination, and that the
+// GasLimit field is equal to the txGasLimit value.
+func CheckConvertedTxFee(
+	txFeeInfo *tx.Fee,
+	feeConversion *evmtypes.FeeConversion,
+	txFee *big.Int,
+	txGasLimit uint64,
+) error {
+	if txFeeInfo == nil {
+		return nil
+	}
+
+	requiredFee := feeConversion.ConvertFee(txFee)
+	if txFeeInfo.Amount.AmountOf(feeConversion.Denom).LT(requiredFee) {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFee,
+			"invalid AuthInfo Fee Amount (%s < %s%s)", txFeeInfo.Amount, requiredFee, feeConversion.Denom,
+		)
+	}
+
+	if txFeeInfo.GasLimit != txGasLimit {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid AuthInfo Fee GasLimit (%d != %d)", txFeeInfo.GasLimit, txGasLimit)
+	}
+
+	return nil
+}
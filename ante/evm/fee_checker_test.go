@@ -47,6 +47,10 @@ func (m MockFeemarketKeeper) GetParams(_ sdk.Context) (params feemarkettypes.Par
 	return feemarkettypes.DefaultParams()
 }
 
+func (m MockFeemarketKeeper) GetFeeDenomRate(_ sdk.Context, _ string) (math.LegacyDec, bool) {
+	return math.LegacyDec{}, false
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
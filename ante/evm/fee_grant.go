@@ -0,0 +1,57 @@
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// GetFeeGranter returns the fee granter set on the Cosmos tx wrapping an
+// Ethereum transaction, if any. An error is returned if a fee granter is set
+// but fee grants are disabled, i.e. the feegrant keeper is not set.
+//
+// As the Cosmos tx fee is not covered by the Ethereum signatures, each sender
+// must also authorize the granter with a fee payment signature, see
+// VerifyFeePayment.
+func GetFeeGranter(feegrantKeeper authante.FeegrantKeeper, tx sdk.Tx) (sdk.AccAddress, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, nil
+	}
+
+	granter := sdk.AccAddress(feeTx.FeeGranter())
+	if granter.Empty() {
+		return nil, nil
+	}
+
+	if feegrantKeeper == nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	return granter, nil
+}
+
+// UseFeeGrant consumes the fees from the allowance given by the granter to the
+// sender of the Ethereum transaction and emits an event recording the
+// sponsorship. The fees are then expected to be deducted from the granter.
+func UseFeeGrant(
+	ctx sdk.Context,
+	feegrantKeeper authante.FeegrantKeeper,
+	granter, grantee sdk.AccAddress,
+	fees sdk.Coins,
+	msgs []sdk.Msg,
+) error {
+	if err := feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fees, msgs); err != nil {
+		return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", granter, grantee)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, granter.String()),
+		),
+	)
+	return nil
+}
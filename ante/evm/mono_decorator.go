@@ -18,6 +18,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 )
 
 const AcceptedTxType = 0 |
@@ -32,7 +33,10 @@ type MonoDecorator struct {
 	accountKeeper   anteinterfaces.AccountKeeper
 	feeMarketKeeper anteinterfaces.FeeMarketKeeper
 	evmKeeper       anteinterfaces.EVMKeeper
+	erc20Keeper     anteinterfaces.Erc20Keeper
+	feegrantKeeper  authante.FeegrantKeeper
 	maxGasWanted    uint64
+	rejectOversized bool
 }
 
 // NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the ante handle logic
@@ -55,6 +59,29 @@ func NewEVMMonoDecorator(
 	}
 }
 
+// WithErc20Keeper sets the erc20 keeper used to enable fee abstraction, i.e.
+// paying the fees of Ethereum transactions in the alternative denominations of
+// registered token pairs defined in the fee market parameters.
+func (md MonoDecorator) WithErc20Keeper(erc20Keeper anteinterfaces.Erc20Keeper) MonoDecorator {
+	md.erc20Keeper = erc20Keeper
+	return md
+}
+
+// WithFeegrantKeeper sets the feegrant keeper used to allow the fees of Ethereum
+// transactions to be paid by a granter through a fee allowance.
+func (md MonoDecorator) WithFeegrantKeeper(feegrantKeeper authante.FeegrantKeeper) MonoDecorator {
+	md.feegrantKeeper = feegrantKeeper
+	return md
+}
+
+// WithRejectOversizedTxs rejects in check tx mode the Ethereum transactions
+// whose gas limit exceeds the max gas wanted, instead of capping their gas
+// wanted to it.
+func (md MonoDecorator) WithRejectOversizedTxs(reject bool) MonoDecorator {
+	md.rejectOversized = reject
+	return md
+}
+
 // AnteHandle handles the entire decorator chain using a mono decorator.
 func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// 0. Basic validation of the transaction
@@ -71,6 +98,22 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	evmDenom := evmtypes.GetEVMCoinDenom()
 
+	// fee abstraction: resolve the denomination used to pay the fees
+	feeConversion, err := GetFeeConversion(ctx, md.feeMarketKeeper, md.erc20Keeper, tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// fee abstraction: the minimum gas price set for the alternative fee
+	// denomination, if any, overrides the global one
+	var (
+		minGasPriceOverride    sdkmath.LegacyDec
+		hasMinGasPriceOverride bool
+	)
+	if feeConversion != nil {
+		minGasPriceOverride, hasMinGasPriceOverride = md.feeMarketKeeper.GetParams(ctx).GetMinGasPriceOverride(feeConversion.Denom)
+	}
+
 	// 1. setup ctx
 	ctx, err = SetupContextAndResetTransientGas(ctx, tx, md.evmKeeper)
 	if err != nil {
@@ -83,191 +126,270 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
-	// this loop will complete after the first message.
-	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "expected 1 message, got %d", len(msgs))
-	}
-	msgIndex := 0
-
-	ethMsg, ethTx, err := evmtypes.UnpackEthMsg(msgs[msgIndex])
+	// fee grant: resolve the account sponsoring the fees, if any
+	feeGranter, err := GetFeeGranter(md.feegrantKeeper, tx)
 	if err != nil {
 		return ctx, err
 	}
 
-	// call go-ethereum transaction validation
-	header := ethtypes.Header{
-		GasLimit:   ethTx.Gas(),
-		BaseFee:    decUtils.BaseFee,
-		Number:     big.NewInt(ctx.BlockHeight()),
-		Time:       uint64(ctx.BlockTime().Unix()), //nolint:gosec
-		Difficulty: big.NewInt(0),
-	}
-	if err := txpool.ValidateTransaction(ethTx, &header, decUtils.Signer, &txpool.ValidationOptions{
-		Config:  evmtypes.GetEthChainConfig(),
-		Accept:  AcceptedTxType,
-		MaxSize: math.MaxUint64, // tx size is checked in cometbft
-		MinTip:  new(big.Int),
-	}); err != nil {
-		return ctx, err
+	// NOTE: multiple EVM messages can be batched in a single transaction. They
+	// are processed in order, so the nonces of the messages signed by the same
+	// sender must be consecutive, and their gas and fees are accumulated.
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "expected at least 1 message")
 	}
 
-	feeAmt := ethMsg.GetFee()
-	gas := ethTx.Gas()
-	fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
-	gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
-
-	// TODO: computation for mempool and global fee can be made using only
-	// the price instead of the fee. This would save some computation.
-	//
-	// 2. mempool inclusion fee
-	if ctx.IsCheckTx() && !simulate {
-		// FIX: Mempool dec should be converted
-		if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+	ethMsgs := make([]*evmtypes.MsgEthereumTx, 0, len(msgs))
+	for msgIndex, msg := range msgs {
+		ethMsg, ethTx, err := evmtypes.UnpackEthMsg(msg)
+		if err != nil {
+			return ctx, err
+		}
+		ethMsgs = append(ethMsgs, ethMsg)
+
+		// call go-ethereum transaction validation
+		header := ethtypes.Header{
+			GasLimit:   ethTx.Gas(),
+			BaseFee:    decUtils.BaseFee,
+			Number:     big.NewInt(ctx.BlockHeight()),
+			Time:       uint64(ctx.BlockTime().Unix()), //nolint:gosec
+			Difficulty: big.NewInt(0),
+		}
+		if err := txpool.ValidateTransaction(ethTx, &header, decUtils.Signer, &txpool.ValidationOptions{
+			Config:  evmtypes.GetEthChainConfig(),
+			Accept:  AcceptedTxType,
+			MaxSize: math.MaxUint64, // tx size is checked in cometbft
+			MinTip:  new(big.Int),
+		}); err != nil {
 			return ctx, err
 		}
-	}
 
-	if ethTx.Type() >= ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
-		// If the base fee is not empty, we compute the effective gas price
-		// according to current base fee price. The gas limit is specified
-		// by the user, while the price is given by the minimum between the
-		// max price paid for the entire tx, and the sum between the price
-		// for the tip and the base fee.
-		feeAmt = ethMsg.GetEffectiveFee(decUtils.BaseFee)
-		fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
-	}
+		feeAmt := ethMsg.GetFee()
+		gas := ethTx.Gas()
 
-	// 3. min gas price (global min fee)
-	if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
-		return ctx, err
-	}
+		// the tx must fit in the gas left in the block or in the
+		// max gas wanted if oversized txs are rejected
+		if err := CheckRemainingBlockGas(ctx, decUtils.TxGasLimit+gas); err != nil {
+			return ctx, err
+		}
+		if md.rejectOversized {
+			if err := CheckOversizedTx(ctx, gas, md.maxGasWanted); err != nil {
+				return ctx, err
+			}
+		}
+		fee := sdkmath.LegacyNewDecFromBigInt(feeAmt)
+		gasLimit := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(gas))
+
+		// TODO: computation for mempool and global fee can be made using only
+		// the price instead of the fee. This would save some computation.
+		//
+		// 2. mempool inclusion fee
+		if ctx.IsCheckTx() && !simulate {
+			// FIX: Mempool dec should be converted
+			if err := CheckMempoolFee(fee, decUtils.MempoolMinGasPrice, gasLimit, decUtils.Rules.IsLondon); err != nil {
+				return ctx, err
+			}
+		}
 
-	// 4. validate msg contents
-	if err := ValidateMsg(
-		decUtils.EvmParams,
-		ethTx,
-	); err != nil {
-		return ctx, err
-	}
+		if ethTx.Type() >= ethtypes.DynamicFeeTxType && decUtils.BaseFee != nil {
+			// If the base fee is not empty, we compute the effective gas price
+			// according to current base fee price. The gas limit is specified
+			// by the user, while the price is given by the minimum between the
+			// max price paid for the entire tx, and the sum between the price
+			// for the tip and the base fee.
+			feeAmt = ethMsg.GetEffectiveFee(decUtils.BaseFee)
+			fee = sdkmath.LegacyNewDecFromBigInt(feeAmt)
+		}
 
-	// 5. signature verification
-	if err := SignatureVerification(
-		ethMsg,
-		ethTx,
-		decUtils.Signer,
-	); err != nil {
-		return ctx, err
-	}
+		// 3. min gas price (global min fee)
+		if hasMinGasPriceOverride {
+			if err := CheckConvertedGlobalFee(feeAmt, feeConversion, minGasPriceOverride, gasLimit); err != nil {
+				return ctx, err
+			}
+		} else if err := CheckGlobalFee(fee, decUtils.GlobalMinGasPrice, gasLimit); err != nil {
+			return ctx, err
+		}
 
-	from := ethMsg.GetFrom()
-	fromAddr := common.BytesToAddress(from)
-
-	// 6. account balance verification
-	// We get the account with the balance from the EVM keeper because it is
-	// using a wrapper of the bank keeper as a dependency to scale all
-	// balances to 18 decimals.
-	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
-		ctx,
-		md.evmKeeper,
-		md.accountKeeper,
-		account,
-		fromAddr,
-		ethTx,
-	); err != nil {
-		return ctx, err
-	}
+		// 4. validate msg contents
+		if err := ValidateMsg(
+			decUtils.EvmParams,
+			ethTx,
+		); err != nil {
+			return ctx, err
+		}
 
-	// 7. can transfer
-	coreMsg := ethMsg.AsMessage(decUtils.BaseFee)
-	if err := CanTransfer(
-		ctx,
-		md.evmKeeper,
-		*coreMsg,
-		decUtils.BaseFee,
-		decUtils.EvmParams,
-		decUtils.Rules.IsLondon,
-	); err != nil {
-		return ctx, err
-	}
+		// 5. signature verification
+		if err := SignatureVerification(
+			ethMsg,
+			ethTx,
+			decUtils.Signer,
+		); err != nil {
+			return ctx, err
+		}
 
-	// 8. gas consumption
-	msgFees, err := evmkeeper.VerifyFee(
-		ethTx,
-		evmDenom,
-		decUtils.BaseFee,
-		decUtils.Rules.IsHomestead,
-		decUtils.Rules.IsIstanbul,
-		decUtils.Rules.IsShanghai,
-		ctx.IsCheckTx(),
-	)
-	if err != nil {
-		return ctx, err
-	}
+		from := ethMsg.GetFrom()
+		fromAddr := common.BytesToAddress(from)
 
-	err = ConsumeFeesAndEmitEvent(
-		ctx,
-		md.evmKeeper,
-		msgFees,
-		from,
-	)
-	if err != nil {
-		return ctx, err
-	}
+		// fee abstraction and fee grant: the sender must have authorized the fee
+		// denomination and the fee granter
+		if feeConversion != nil || feeGranter != nil {
+			if err := VerifyFeePayment(tx, msgIndex, ethTx, fromAddr, feeConversion, feeGranter); err != nil {
+				return ctx, err
+			}
+		}
 
-	gasWanted := UpdateCumulativeGasWanted(
-		ctx,
-		gas,
-		md.maxGasWanted,
-		decUtils.GasWanted,
-	)
-	decUtils.GasWanted = gasWanted
+		// 6. account balance verification
+		// We get the account with the balance from the EVM keeper because it is
+		// using a wrapper of the bank keeper as a dependency to scale all
+		// balances to 18 decimals.
+		account := md.evmKeeper.GetAccount(ctx, fromAddr)
+		verifyBalance := VerifyAccountBalance
+		if feeConversion != nil || feeGranter != nil {
+			// fees are paid in the alternative denomination or by the granter,
+			// whose balance is checked when deducting them.
+			verifyBalance = VerifyAccountValueBalance
+		}
+		if err := verifyBalance(
+			ctx,
+			md.evmKeeper,
+			md.accountKeeper,
+			account,
+			fromAddr,
+			ethTx,
+		); err != nil {
+			return ctx, err
+		}
 
-	minPriority := GetMsgPriority(
-		ethTx,
-		decUtils.MinPriority,
-		decUtils.BaseFee,
-	)
-	decUtils.MinPriority = minPriority
-
-	// Update the fee to be paid for the tx adding the fee specified for the
-	// current message.
-	decUtils.TxFee.Add(decUtils.TxFee, ethMsg.GetFee())
-
-	// Update the transaction gas limit adding the gas specified in the
-	// current message.
-	decUtils.TxGasLimit += gas
-
-	// 9. increment sequence
-	acc := md.accountKeeper.GetAccount(ctx, from)
-	if acc == nil {
-		// safety check: shouldn't happen
-		return ctx, errorsmod.Wrapf(
-			errortypes.ErrUnknownAddress,
-			"account %s does not exist",
-			from,
+		// 7. can transfer
+		coreMsg := ethMsg.AsMessage(decUtils.BaseFee)
+		if err := CanTransfer(
+			ctx,
+			md.evmKeeper,
+			*coreMsg,
+			decUtils.BaseFee,
+			decUtils.EvmParams,
+			decUtils.Rules.IsLondon,
+		); err != nil {
+			return ctx, err
+		}
+
+		// 8. gas consumption
+		msgFees, err := evmkeeper.VerifyFee(
+			ethTx,
+			evmDenom,
+			decUtils.BaseFee,
+			decUtils.EvmParams.GasOverrides,
+			decUtils.Rules.IsHomestead,
+			decUtils.Rules.IsIstanbul,
+			decUtils.Rules.IsShanghai,
+			ctx.IsCheckTx(),
 		)
-	}
+		if err != nil {
+			return ctx, err
+		}
 
-	if err := IncrementNonce(ctx, md.accountKeeper, acc, ethTx.Nonce()); err != nil {
-		return ctx, err
+		feePayer := from
+		if feeGranter != nil {
+			grantedFees := msgFees
+			if feeConversion != nil {
+				grantedFees = feeConversion.ConvertCoins(msgFees)
+			}
+			if err := UseFeeGrant(ctx, md.feegrantKeeper, feeGranter, from, grantedFees, msgs); err != nil {
+				return ctx, err
+			}
+			feePayer = feeGranter
+		}
+
+		if feeConversion != nil {
+			err = ConsumeConvertedFeesAndEmitEvent(
+				ctx,
+				md.evmKeeper,
+				msgFees,
+				feeConversion,
+				feePayer,
+			)
+		} else {
+			err = ConsumeFeesAndEmitEvent(
+				ctx,
+				md.evmKeeper,
+				msgFees,
+				feePayer,
+			)
+		}
+		if err != nil {
+			return ctx, err
+		}
+
+		gasWanted := UpdateCumulativeGasWanted(
+			ctx,
+			gas,
+			md.maxGasWanted,
+			decUtils.GasWanted,
+		)
+		decUtils.GasWanted = gasWanted
+
+		minPriority := GetMsgPriority(
+			ethTx,
+			decUtils.MinPriority,
+			decUtils.BaseFee,
+		)
+		decUtils.MinPriority = minPriority
+
+		// Update the fee to be paid for the tx adding the fee specified for the
+		// current message.
+		decUtils.TxFee.Add(decUtils.TxFee, ethMsg.GetFee())
+
+		// Update the transaction gas limit adding the gas specified in the
+		// current message.
+		decUtils.TxGasLimit += gas
+
+		// 9. increment sequence
+		acc := md.accountKeeper.GetAccount(ctx, from)
+		if acc == nil {
+			// safety check: shouldn't happen
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnknownAddress,
+				"account %s does not exist",
+				from,
+			)
+		}
+
+		if err := IncrementNonce(ctx, md.accountKeeper, acc, ethTx.Nonce()); err != nil {
+			return ctx, err
+		}
+
+		// 10. emit events
+		txIdx := uint64(msgIndex) //nolint:gosec // G115
+		EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
 	}
 
-	// 10. gas wanted
+	// 11. gas wanted
 	if err := CheckGasWanted(ctx, md.feeMarketKeeper, tx, decUtils.Rules.IsLondon); err != nil {
 		return ctx, err
 	}
 
-	// 11. emit events
-	txIdx := uint64(msgIndex) //nolint:gosec // G115
-	EmitTxHashEvent(ctx, ethMsg, decUtils.BlockTxIndex, txIdx)
+	if feeConversion != nil {
+		if err := CheckConvertedTxFee(txFeeInfo, feeConversion, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+			return ctx, err
+		}
 
-	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+		// refunds of the unused gas are paid in the same denomination
+		ctx = evmtypes.WithFeeConversion(ctx, feeConversion)
+	} else if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
 		return ctx, err
 	}
 
+	if feeGranter != nil {
+		// refunds of the unused gas are paid back to the granter
+		ctx = evmtypes.WithFeeGranter(ctx, feeGranter)
+	}
+
+	if len(msgs) > 1 {
+		// batched messages are executed atomically
+		ctx = evmtypes.WithAtomicBatch(ctx, ethMsgs)
+	}
+
 	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
 	if err != nil {
 		return ctx, err
@@ -3,14 +3,17 @@ package evm_test
 import (
 	"context"
 	"math/big"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
@@ -27,11 +30,17 @@ import (
 	vmtypes "github.com/cosmos/evm/x/vm/types/mocks"
 
 	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/feegrant"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -82,6 +91,56 @@ func (m MockFeeMarketKeeper) AddTransientGasWanted(_ sdk.Context, _ uint64) (uin
 }
 func (m MockFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool    { return true }
 func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return math.LegacyZeroDec() }
+func (m MockFeeMarketKeeper) GetFeeDenomRate(_ sdk.Context, _ string) (math.LegacyDec, bool) {
+	return math.LegacyDec{}, false
+}
+
+// defines the conversion rates of the alternative fee denoms
+type MockFeeDenomFeeMarketKeeper struct {
+	MockFeeMarketKeeper
+	rates map[string]math.LegacyDec
+}
+
+func (m MockFeeDenomFeeMarketKeeper) GetFeeDenomRate(_ sdk.Context, denom string) (math.LegacyDec, bool) {
+	rate, found := m.rates[denom]
+	return rate, found
+}
+
+// only methods called by EVMMonoDecorator
+type MockErc20Keeper struct {
+	denoms []string
+}
+
+func (m MockErc20Keeper) IsDenomRegistered(_ sdk.Context, denom string) bool {
+	return slices.Contains(m.denoms, denom)
+}
+
+// records the fees deducted by EVMMonoDecorator
+type FeeRecordingEVMKeeper struct {
+	*ExtendedEVMKeeper
+	deductedFees sdk.Coins
+	feePayer     common.Address
+}
+
+func (k *FeeRecordingEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, fees sdk.Coins, from common.Address) error {
+	k.deductedFees = k.deductedFees.Add(fees...)
+	k.feePayer = from
+	return nil
+}
+
+// uses the fee allowances with the logic of the basic allowance
+type MockFeegrantKeeper struct {
+	allowances map[string]*feegrant.BasicAllowance
+}
+
+func (m MockFeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	allowance, found := m.allowances[granter.String()+grantee.String()]
+	if !found {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "fee-grant not found")
+	}
+	_, err := allowance.Accept(ctx, fee, msgs)
+	return err
+}
 
 // matches the actual signatures
 type MockAccountKeeper struct {
@@ -114,6 +173,23 @@ func (m MockAccountKeeper) TryAddUnorderedNonce(_ sdk.Context, _ []byte, _ time.
 func (m MockAccountKeeper) UnorderedTransactionsEnabled() bool { return false }
 func (m MockAccountKeeper) AddressCodec() address.Codec        { return nil }
 
+// keeps the accounts updated by EVMMonoDecorator to track their sequences
+type SequenceAccountKeeper struct {
+	MockAccountKeeper
+	accounts map[string]sdk.AccountI
+}
+
+func (m SequenceAccountKeeper) GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI {
+	if account, found := m.accounts[addr.String()]; found {
+		return account
+	}
+	return m.MockAccountKeeper.GetAccount(ctx, addr)
+}
+
+func (m SequenceAccountKeeper) SetAccount(_ context.Context, account sdk.AccountI) {
+	m.accounts[account.GetAddress().String()] = account
+}
+
 func signMsgEthereumTx(t *testing.T, privKey *ethsecp256k1.PrivKey, args *evmsdktypes.EvmTxArgs) *evmsdktypes.MsgEthereumTx {
 	t.Helper()
 	msg := evmsdktypes.NewTx(args)
@@ -135,6 +211,42 @@ func setupFundedKeeper(t *testing.T, privKey *ethsecp256k1.PrivKey) (*ExtendedEV
 	return keeper, cosmosAddr
 }
 
+func signFeePayment(t *testing.T, privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx, denom string, granter sdk.AccAddress) []byte {
+	t.Helper()
+	key, err := privKey.ToECDSA()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(accounts.TextHash(evmsdktypes.FeePaymentMessage(msg.Hash(), denom, granter)), key)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27 // as returned by personal_sign
+	return sig
+}
+
+// prepareFeePaymentTx builds the Cosmos tx wrapping the given messages, paying
+// the given fees, possibly with the allowance of the granter, with the given
+// fee payment signatures.
+func prepareFeePaymentTx(t *testing.T, cfg client.TxConfig, fees sdk.Coins, granter sdk.AccAddress, feePaymentSigs [][]byte, msgs ...*evmsdktypes.MsgEthereumTx) sdk.Tx {
+	t.Helper()
+	txBuilder := cfg.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(toMsgSlice(msgs)...))
+
+	option, err := codectypes.NewAnyWithValue(&evmsdktypes.ExtensionOptionsEthereumTx{
+		FeePaymentSignatures: feePaymentSigs,
+	})
+	require.NoError(t, err)
+	builder, ok := txBuilder.(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	builder.SetExtensionOptions(option)
+
+	var gasLimit uint64
+	for _, msg := range msgs {
+		gasLimit += msg.GetGas()
+	}
+	txBuilder.SetGasLimit(gasLimit)
+	txBuilder.SetFeeAmount(fees)
+	txBuilder.SetFeeGranter(granter)
+	return txBuilder.GetTx()
+}
+
 func toMsgSlice(msgs []*evmsdktypes.MsgEthereumTx) []sdk.Msg {
 	out := make([]sdk.Msg, len(msgs))
 	for i, m := range msgs {
@@ -169,7 +281,7 @@ func TestMonoDecorator(t *testing.T) {
 			"",
 		},
 		{
-			"failure with two evm txs",
+			"failure with two evm txs with non-sequential nonces",
 			true,
 			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
 				args1 := &evmsdktypes.EvmTxArgs{
@@ -179,7 +291,7 @@ func TestMonoDecorator(t *testing.T) {
 					Input:    []byte("test"),
 				}
 				args2 := &evmsdktypes.EvmTxArgs{
-					Nonce:    1,
+					Nonce:    2,
 					GasLimit: 100000,
 					GasPrice: big.NewInt(1),
 					Input:    []byte("test2"),
@@ -189,7 +301,7 @@ func TestMonoDecorator(t *testing.T) {
 					signMsgEthereumTx(t, privKey, args2),
 				}
 			},
-			"expected 1 message, got 2",
+			"tx nonce is higher than account nonce",
 		},
 	}
 
@@ -217,3 +329,381 @@ func TestMonoDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestMonoDecoratorBlockGas(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cfg := encoding.MakeConfig(chainID)
+
+	testCases := []struct {
+		name            string
+		checkTx         bool
+		execMode        sdk.ExecMode
+		blockGasUsed    uint64
+		maxGasWanted    uint64
+		rejectOversized bool
+		expErr          string
+	}{
+		{
+			name:     "success when the tx fits in the remaining block gas",
+			execMode: sdk.ExecModeFinalize,
+		},
+		{
+			name:         "failure when the tx exceeds the remaining block gas",
+			execMode:     sdk.ExecModeFinalize,
+			blockGasUsed: 100000,
+			expErr:       "exceeds remaining block gas",
+		},
+		{
+			name:         "success when the tx exceeds the remaining block gas outside of block execution",
+			execMode:     sdk.ExecModeSimulate,
+			blockGasUsed: 100000,
+		},
+		{
+			name:         "success with an oversized tx in check tx mode",
+			checkTx:      true,
+			execMode:     sdk.ExecModeCheck,
+			maxGasWanted: 50000,
+		},
+		{
+			name:            "failure with an oversized tx in check tx mode when rejected",
+			checkTx:         true,
+			execMode:        sdk.ExecModeCheck,
+			maxGasWanted:    50000,
+			rejectOversized: true,
+			expErr:          "exceeds max tx gas wanted",
+		},
+		{
+			name:            "success with an oversized tx in block execution when rejected",
+			execMode:        sdk.ExecModeFinalize,
+			maxGasWanted:    50000,
+			rejectOversized: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, _ := ethsecp256k1.GenerateKey()
+			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
+			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, tc.maxGasWanted).
+				WithRejectOversizedTxs(tc.rejectOversized)
+			blockGasMeter := storetypes.NewGasMeter(150000)
+			blockGasMeter.ConsumeGas(tc.blockGasUsed, "block gas used")
+			ctx := sdk.NewContext(nil, tmproto.Header{}, tc.checkTx, log.NewNopLogger()).
+				WithExecMode(tc.execMode).
+				WithBlockGasMeter(blockGasMeter)
+
+			args := &evmsdktypes.EvmTxArgs{
+				Nonce:    0,
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+				Input:    []byte("test"),
+			}
+			tx, err := utiltx.PrepareEthTx(cfg.TxConfig, nil, signMsgEthereumTx(t, privKey, args))
+			require.NoError(t, err)
+
+			_, err = monoDec.AnteHandle(ctx, tx, true, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+				require.ErrorIs(t, err, errortypes.ErrOutOfGas)
+			}
+		})
+	}
+}
+
+func TestMonoDecoratorBatch(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cfg := encoding.MakeConfig(chainID)
+
+	testCases := []struct {
+		name         string
+		nonces       []uint64
+		blockGasUsed uint64
+		expErr       string
+	}{
+		{
+			name:   "success with sequential nonces",
+			nonces: []uint64{0, 1, 2},
+		},
+		{
+			name:   "failure with a repeated nonce",
+			nonces: []uint64{0, 1, 1},
+			expErr: "invalid nonce; got 1, expected 2",
+		},
+		{
+			name:   "failure with a nonce gap",
+			nonces: []uint64{0, 2},
+			expErr: "tx nonce: 2, account accountNonce: 1",
+		},
+		{
+			name:         "failure when the cumulative gas exceeds the remaining block gas",
+			nonces:       []uint64{0, 1, 2},
+			blockGasUsed: 100000,
+			expErr:       "tx gas (300000) exceeds remaining block gas (250000)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, _ := ethsecp256k1.GenerateKey()
+			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
+			feeKeeper := &FeeRecordingEVMKeeper{ExtendedEVMKeeper: keeper}
+			accountKeeper := SequenceAccountKeeper{
+				MockAccountKeeper: MockAccountKeeper{FundedAddr: cosmosAddr},
+				accounts:          map[string]sdk.AccountI{},
+			}
+
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, feeKeeper, 0)
+			blockGasMeter := storetypes.NewGasMeter(350000)
+			blockGasMeter.ConsumeGas(tc.blockGasUsed, "block gas used")
+			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger()).
+				WithExecMode(sdk.ExecModeFinalize).
+				WithBlockGasMeter(blockGasMeter)
+
+			msgs := make([]*evmsdktypes.MsgEthereumTx, len(tc.nonces))
+			for i, nonce := range tc.nonces {
+				msgs[i] = signMsgEthereumTx(t, privKey, &evmsdktypes.EvmTxArgs{
+					Nonce:    nonce,
+					GasLimit: 100000,
+					GasPrice: big.NewInt(2),
+					Input:    []byte("test"),
+				})
+			}
+			tx, err := utiltx.PrepareEthTx(cfg.TxConfig, nil, toMsgSlice(msgs)...)
+			require.NoError(t, err)
+
+			newCtx, err := monoDec.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the gas and fees of the batched txs are accumulated
+			batchGas := uint64(100000 * len(msgs))
+			require.Equal(t, batchGas, newCtx.GasMeter().Limit())
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinDenom(), int64(2*batchGas))), feeKeeper.deductedFees)
+
+			// the sender nonce is incremented once per batched tx
+			require.Equal(t, uint64(len(msgs)), accountKeeper.GetAccount(ctx, cosmosAddr).GetSequence())
+
+			// the batch is flagged to be executed atomically
+			require.True(t, evmsdktypes.IsAtomicBatch(newCtx))
+		})
+	}
+}
+
+func TestMonoDecoratorFeeAbstraction(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cfg := encoding.MakeConfig(chainID)
+
+	const (
+		feeDenom          = "ufoo"
+		unregisteredDenom = "ubar"
+	)
+	// 100000 gas at a gas price of 1, paid at a rate of 4 per unit of ufoo
+	fees := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 25000))
+
+	testCases := []struct {
+		name           string
+		fees           sdk.Coins
+		feePaymentSigs func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte
+		expErr         string
+	}{
+		{
+			name: "success paying the fees in a registered alternative denom",
+			fees: fees,
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, feeDenom, nil)}
+			},
+		},
+		{
+			name: "failure paying the fees in an unregistered denom",
+			fees: sdk.NewCoins(sdk.NewInt64Coin(unregisteredDenom, 25000)),
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, unregisteredDenom, nil)}
+			},
+			expErr: "fee denom ubar is not a registered token pair",
+		},
+		{
+			name: "failure paying fees lower than the converted ones",
+			fees: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 24999)),
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, feeDenom, nil)}
+			},
+			expErr: "invalid AuthInfo Fee Amount",
+		},
+		{
+			name: "failure without the fee payment signature",
+			fees: fees,
+			feePaymentSigs: func(*ethsecp256k1.PrivKey, *evmsdktypes.MsgEthereumTx) [][]byte {
+				return nil
+			},
+			expErr: "missing the fee payment signature of message 0",
+		},
+		{
+			name: "failure with a fee payment signed for another denom",
+			fees: fees,
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, evmsdktypes.GetEVMCoinDenom(), nil)}
+			},
+			expErr: "instead of the sender",
+		},
+		{
+			name: "failure with a fee payment signed by another account",
+			fees: fees,
+			feePaymentSigs: func(_ *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				otherKey, _ := ethsecp256k1.GenerateKey()
+				return [][]byte{signFeePayment(t, otherKey, msg, feeDenom, nil)}
+			},
+			expErr: "instead of the sender",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, _ := ethsecp256k1.GenerateKey()
+			extendedKeeper, cosmosAddr := setupFundedKeeper(t, privKey)
+			keeper := &FeeRecordingEVMKeeper{ExtendedEVMKeeper: extendedKeeper}
+			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+			feeMarketKeeper := MockFeeDenomFeeMarketKeeper{
+				rates: map[string]math.LegacyDec{feeDenom: math.LegacyNewDec(4)},
+			}
+
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, 0).
+				WithErc20Keeper(MockErc20Keeper{denoms: []string{feeDenom}})
+			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
+
+			msg := signMsgEthereumTx(t, privKey, &evmsdktypes.EvmTxArgs{
+				Nonce:    0,
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+				Input:    []byte("test"),
+			})
+			tx := prepareFeePaymentTx(t, cfg.TxConfig, tc.fees, nil, tc.feePaymentSigs(privKey, msg), msg)
+
+			newCtx, err := monoDec.AnteHandle(ctx, tx, true, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, fees, keeper.deductedFees)
+			require.Equal(t, common.BytesToAddress(cosmosAddr), keeper.feePayer)
+
+			feeConversion, ok := evmsdktypes.GetFeeConversion(newCtx)
+			require.True(t, ok)
+			require.Equal(t, feeDenom, feeConversion.Denom)
+		})
+	}
+}
+
+func TestMonoDecoratorFeeGrant(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	require.NoError(t, config.EvmAppOptions(chainID))
+	cfg := encoding.MakeConfig(chainID)
+
+	granter := sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	// 100000 gas at a gas price of 1
+	fees := sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinExtendedDenom(), 100000))
+
+	testCases := []struct {
+		name           string
+		spendLimit     sdk.Coins
+		noGrant        bool
+		feePaymentSigs func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte
+		expErr         string
+	}{
+		{
+			name:       "success paying the fees with the fee allowance",
+			spendLimit: sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinDenom(), 150000)),
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, evmsdktypes.GetEVMCoinDenom(), granter)}
+			},
+		},
+		{
+			name:       "failure exceeding the fee allowance",
+			spendLimit: sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinDenom(), 50000)),
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, evmsdktypes.GetEVMCoinDenom(), granter)}
+			},
+			expErr: "fee limit exceeded",
+		},
+		{
+			name:    "failure without a fee grant",
+			noGrant: true,
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, evmsdktypes.GetEVMCoinDenom(), granter)}
+			},
+			expErr: "fee-grant not found",
+		},
+		{
+			name:       "failure without the fee payment signature",
+			spendLimit: sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinDenom(), 150000)),
+			feePaymentSigs: func(*ethsecp256k1.PrivKey, *evmsdktypes.MsgEthereumTx) [][]byte {
+				return nil
+			},
+			expErr: "missing the fee payment signature of message 0",
+		},
+		{
+			name:       "failure with a fee payment not authorizing the granter",
+			spendLimit: sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinDenom(), 150000)),
+			feePaymentSigs: func(privKey *ethsecp256k1.PrivKey, msg *evmsdktypes.MsgEthereumTx) [][]byte {
+				return [][]byte{signFeePayment(t, privKey, msg, evmsdktypes.GetEVMCoinDenom(), nil)}
+			},
+			expErr: "instead of the sender",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privKey, _ := ethsecp256k1.GenerateKey()
+			extendedKeeper, cosmosAddr := setupFundedKeeper(t, privKey)
+			keeper := &FeeRecordingEVMKeeper{ExtendedEVMKeeper: extendedKeeper}
+			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+
+			allowance := &feegrant.BasicAllowance{SpendLimit: tc.spendLimit}
+			feegrantKeeper := MockFeegrantKeeper{allowances: map[string]*feegrant.BasicAllowance{}}
+			if !tc.noGrant {
+				feegrantKeeper.allowances[granter.String()+cosmosAddr.String()] = allowance
+			}
+
+			monoDec := evm.NewEVMMonoDecorator(accountKeeper, MockFeeMarketKeeper{}, keeper, 0).
+				WithFeegrantKeeper(feegrantKeeper)
+			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+			ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1e19))
+
+			msg := signMsgEthereumTx(t, privKey, &evmsdktypes.EvmTxArgs{
+				Nonce:    0,
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+				Input:    []byte("test"),
+			})
+			tx := prepareFeePaymentTx(t, cfg.TxConfig, fees, granter, tc.feePaymentSigs(privKey, msg), msg)
+
+			newCtx, err := monoDec.AnteHandle(ctx, tx, true, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Empty(t, keeper.deductedFees)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, fees, keeper.deductedFees)
+			require.Equal(t, common.BytesToAddress(granter), keeper.feePayer)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(evmsdktypes.GetEVMCoinDenom(), 50000)), allowance.SpendLimit)
+
+			feeGranter, ok := evmsdktypes.GetFeeGranter(newCtx)
+			require.True(t, ok)
+			require.Equal(t, granter, feeGranter)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type noopDecorator struct{}
+
+func (noopDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func namedDecorator(name string) ante.NamedDecorator {
+	return ante.NamedDecorator{Name: name, Decorator: noopDecorator{}}
+}
+
+func decoratorNames(decorators []ante.NamedDecorator) []string {
+	names := make([]string, len(decorators))
+	for i, d := range decorators {
+		names[i] = d.Name
+	}
+	return names
+}
+
+func TestEVMDecoratorOptions(t *testing.T) {
+	defaultChain := func() []ante.NamedDecorator {
+		return []ante.NamedDecorator{
+			namedDecorator(ante.EVMMonoDecoratorName),
+			namedDecorator(ante.TxListenerDecoratorName),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		opts     []ante.EVMDecoratorOption
+		expNames []string
+		expError string
+	}{
+		{
+			name:     "pass - no options",
+			expNames: []string{ante.EVMMonoDecoratorName, ante.TxListenerDecoratorName},
+		},
+		{
+			name: "pass - insert before and after the mono decorator",
+			opts: []ante.EVMDecoratorOption{
+				ante.InsertBefore(ante.EVMMonoDecoratorName, namedDecorator("allowlist")),
+				ante.InsertAfter(ante.EVMMonoDecoratorName, namedDecorator("extra_fee"), namedDecorator("tx_type_ban")),
+			},
+			expNames: []string{"allowlist", ante.EVMMonoDecoratorName, "extra_fee", "tx_type_ban", ante.TxListenerDecoratorName},
+		},
+		{
+			name: "pass - insert relative to a custom decorator",
+			opts: []ante.EVMDecoratorOption{
+				ante.InsertBefore(ante.EVMMonoDecoratorName, namedDecorator("allowlist")),
+				ante.InsertBefore("allowlist", namedDecorator("kyc")),
+			},
+			expNames: []string{"kyc", "allowlist", ante.EVMMonoDecoratorName, ante.TxListenerDecoratorName},
+		},
+		{
+			name:     "fail - unknown decorator",
+			opts:     []ante.EVMDecoratorOption{ante.InsertAfter("unknown", namedDecorator("allowlist"))},
+			expError: "not found",
+		},
+		{
+			name:     "fail - insert after the tx listener",
+			opts:     []ante.EVMDecoratorOption{ante.InsertAfter(ante.TxListenerDecoratorName, namedDecorator("allowlist"))},
+			expError: "must be the last one",
+		},
+		{
+			name:     "fail - duplicate name",
+			opts:     []ante.EVMDecoratorOption{ante.InsertBefore(ante.TxListenerDecoratorName, namedDecorator(ante.EVMMonoDecoratorName))},
+			expError: "duplicate",
+		},
+		{
+			name:     "fail - nil decorator",
+			opts:     []ante.EVMDecoratorOption{ante.InsertBefore(ante.TxListenerDecoratorName, ante.NamedDecorator{Name: "nil"})},
+			expError: "cannot be nil",
+		},
+		{
+			name: "fail - mono decorator removed",
+			opts: []ante.EVMDecoratorOption{
+				func(decorators []ante.NamedDecorator) ([]ante.NamedDecorator, error) {
+					return decorators[1:], nil
+				},
+			},
+			expError: "is required",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			decorators := defaultChain()
+			for _, opt := range tc.opts {
+				decorators, err = opt(decorators)
+				if err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = ante.ValidateEVMDecorators(decorators)
+			}
+
+			if tc.expError != "" {
+				require.ErrorContains(t, err, tc.expError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expNames, decoratorNames(decorators))
+		})
+	}
+}
@@ -44,6 +44,12 @@ type FeeMarketKeeper interface {
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
+	GetFeeDenomRate(ctx sdk.Context, denom string) (math.LegacyDec, bool)
+}
+
+// Erc20Keeper exposes the required erc20 keeper interface required for ante handlers
+type Erc20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
 }
 
 type ProtoTxProvider interface {
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_TokenPairs_FullMethodName = "/cosmos.evm.erc20.v1.Query/TokenPairs"
-	Query_TokenPair_FullMethodName  = "/cosmos.evm.erc20.v1.Query/TokenPair"
-	Query_Params_FullMethodName     = "/cosmos.evm.erc20.v1.Query/Params"
+	Query_TokenPairs_FullMethodName        = "/cosmos.evm.erc20.v1.Query/TokenPairs"
+	Query_TokenPair_FullMethodName         = "/cosmos.evm.erc20.v1.Query/TokenPair"
+	Query_Params_FullMethodName            = "/cosmos.evm.erc20.v1.Query/Params"
+	Query_ParamsAuthorities_FullMethodName = "/cosmos.evm.erc20.v1.Query/ParamsAuthorities"
+	Query_ParamsAtHeight_FullMethodName    = "/cosmos.evm.erc20.v1.Query/ParamsAtHeight"
+	Query_Account_FullMethodName           = "/cosmos.evm.erc20.v1.Query/Account"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,16 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/erc20 module parameters.
+	ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/erc20 module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
+	// Account queries a unified view of an account: its bech32 and hex
+	// addresses, Cosmos sequence and EVM nonce, bank and ERC20 token pair
+	// balances, code hash and account type.
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +84,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error) {
+	out := new(QueryParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAuthorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, Query_Account_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +121,16 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/erc20 module parameters.
+	ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/erc20 module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
+	// Account queries a unified view of an account: its bech32 and hex
+	// addresses, Cosmos sequence and EVM nonce, bank and ERC20 token pair
+	// balances, code hash and account type.
+	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +147,15 @@ func (UnimplementedQueryServer) TokenPair(context.Context, *QueryTokenPairReques
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAuthorities not implemented")
+}
+func (UnimplementedQueryServer) ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
+func (UnimplementedQueryServer) Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +223,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAuthorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAuthorities(ctx, req.(*QueryParamsAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Account_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +296,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ParamsAuthorities",
+			Handler:    _Query_ParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/query.proto",
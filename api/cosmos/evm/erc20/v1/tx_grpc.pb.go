@@ -19,11 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_ConvertERC20_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
-	Msg_ConvertCoin_FullMethodName      = "/cosmos.evm.erc20.v1.Msg/ConvertCoin"
-	Msg_UpdateParams_FullMethodName     = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
-	Msg_RegisterERC20_FullMethodName    = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
-	Msg_ToggleConversion_FullMethodName = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_ConvertERC20_FullMethodName            = "/cosmos.evm.erc20.v1.Msg/ConvertERC20"
+	Msg_ConvertCoin_FullMethodName             = "/cosmos.evm.erc20.v1.Msg/ConvertCoin"
+	Msg_UpdateParams_FullMethodName            = "/cosmos.evm.erc20.v1.Msg/UpdateParams"
+	Msg_RegisterERC20_FullMethodName           = "/cosmos.evm.erc20.v1.Msg/RegisterERC20"
+	Msg_ToggleConversion_FullMethodName        = "/cosmos.evm.erc20.v1.Msg/ToggleConversion"
+	Msg_UpdateParamsAuthorities_FullMethodName = "/cosmos.evm.erc20.v1.Msg/UpdateParamsAuthorities"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +49,10 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/erc20 module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +108,15 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	out := new(MsgUpdateParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParamsAuthorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -125,6 +139,10 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/erc20 module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -147,6 +165,9 @@ func (UnimplementedMsgServer) RegisterERC20(context.Context, *MsgRegisterERC20)
 func (UnimplementedMsgServer) ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (UnimplementedMsgServer) UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsAuthorities not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -250,6 +271,24 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParamsAuthorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, req.(*MsgUpdateParamsAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +316,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "UpdateParamsAuthorities",
+			Handler:    _Msg_UpdateParamsAuthorities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
@@ -14,6 +14,108 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]*FeeDenomRate
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDenomRate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDenomRate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	v := new(FeeDenomRate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := new(FeeDenomRate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_15_list)(nil)
+
+type _Params_15_list struct {
+	list *[]*DenomMinGasPrice
+}
+
+func (x *_Params_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomMinGasPrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomMinGasPrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_15_list) AppendMutable() protoreflect.Value {
+	v := new(DenomMinGasPrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_15_list) NewElement() protoreflect.Value {
+	v := new(DenomMinGasPrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                             protoreflect.MessageDescriptor
 	fd_Params_no_base_fee                 protoreflect.FieldDescriptor
@@ -23,6 +125,14 @@ var (
 	fd_Params_base_fee                    protoreflect.FieldDescriptor
 	fd_Params_min_gas_price               protoreflect.FieldDescriptor
 	fd_Params_min_gas_multiplier          protoreflect.FieldDescriptor
+	fd_Params_fee_denom_rates             protoreflect.FieldDescriptor
+	fd_Params_base_fee_policy             protoreflect.FieldDescriptor
+	fd_Params_block_gas_target            protoreflect.FieldDescriptor
+	fd_Params_min_base_fee                protoreflect.FieldDescriptor
+	fd_Params_max_base_fee                protoreflect.FieldDescriptor
+	fd_Params_max_base_fee_change_rate    protoreflect.FieldDescriptor
+	fd_Params_min_gas_price_overrides     protoreflect.FieldDescriptor
+	fd_Params_min_gas_price_adjustment    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -35,6 +145,14 @@ func init() {
 	fd_Params_base_fee = md_Params.Fields().ByName("base_fee")
 	fd_Params_min_gas_price = md_Params.Fields().ByName("min_gas_price")
 	fd_Params_min_gas_multiplier = md_Params.Fields().ByName("min_gas_multiplier")
+	fd_Params_fee_denom_rates = md_Params.Fields().ByName("fee_denom_rates")
+	fd_Params_base_fee_policy = md_Params.Fields().ByName("base_fee_policy")
+	fd_Params_block_gas_target = md_Params.Fields().ByName("block_gas_target")
+	fd_Params_min_base_fee = md_Params.Fields().ByName("min_base_fee")
+	fd_Params_max_base_fee = md_Params.Fields().ByName("max_base_fee")
+	fd_Params_max_base_fee_change_rate = md_Params.Fields().ByName("max_base_fee_change_rate")
+	fd_Params_min_gas_price_overrides = md_Params.Fields().ByName("min_gas_price_overrides")
+	fd_Params_min_gas_price_adjustment = md_Params.Fields().ByName("min_gas_price_adjustment")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -144,6 +262,54 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.FeeDenomRates) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.FeeDenomRates})
+		if !f(fd_Params_fee_denom_rates, value) {
+			return
+		}
+	}
+	if x.BaseFeePolicy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BaseFeePolicy))
+		if !f(fd_Params_base_fee_policy, value) {
+			return
+		}
+	}
+	if x.BlockGasTarget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockGasTarget)
+		if !f(fd_Params_block_gas_target, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_Params_min_base_fee, value) {
+			return
+		}
+	}
+	if x.MaxBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFee)
+		if !f(fd_Params_max_base_fee, value) {
+			return
+		}
+	}
+	if x.MaxBaseFeeChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseFeeChangeRate)
+		if !f(fd_Params_max_base_fee_change_rate, value) {
+			return
+		}
+	}
+	if len(x.MinGasPriceOverrides) != 0 {
+		value := protoreflect.ValueOfList(&_Params_15_list{list: &x.MinGasPriceOverrides})
+		if !f(fd_Params_min_gas_price_overrides, value) {
+			return
+		}
+	}
+	if x.MinGasPriceAdjustment != nil {
+		value := protoreflect.ValueOfMessage(x.MinGasPriceAdjustment.ProtoReflect())
+		if !f(fd_Params_min_gas_price_adjustment, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -173,6 +339,22 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinGasPrice != ""
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		return x.MinGasMultiplier != ""
+	case "cosmos.evm.feemarket.v1.Params.fee_denom_rates":
+		return len(x.FeeDenomRates) != 0
+	case "cosmos.evm.feemarket.v1.Params.base_fee_policy":
+		return x.BaseFeePolicy != 0
+	case "cosmos.evm.feemarket.v1.Params.block_gas_target":
+		return x.BlockGasTarget != uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.min_base_fee":
+		return x.MinBaseFee != ""
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		return x.MaxBaseFee != ""
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change_rate":
+		return x.MaxBaseFeeChangeRate != ""
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_overrides":
+		return len(x.MinGasPriceOverrides) != 0
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment":
+		return x.MinGasPriceAdjustment != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -203,6 +385,22 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinGasPrice = ""
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = ""
+	case "cosmos.evm.feemarket.v1.Params.fee_denom_rates":
+		x.FeeDenomRates = nil
+	case "cosmos.evm.feemarket.v1.Params.base_fee_policy":
+		x.BaseFeePolicy = 0
+	case "cosmos.evm.feemarket.v1.Params.block_gas_target":
+		x.BlockGasTarget = uint64(0)
+	case "cosmos.evm.feemarket.v1.Params.min_base_fee":
+		x.MinBaseFee = ""
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = ""
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change_rate":
+		x.MaxBaseFeeChangeRate = ""
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_overrides":
+		x.MinGasPriceOverrides = nil
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment":
+		x.MinGasPriceAdjustment = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -240,6 +438,36 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		value := x.MinGasMultiplier
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.fee_denom_rates":
+		if len(x.FeeDenomRates) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.FeeDenomRates}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.feemarket.v1.Params.base_fee_policy":
+		value := x.BaseFeePolicy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.evm.feemarket.v1.Params.block_gas_target":
+		value := x.BlockGasTarget
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.feemarket.v1.Params.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		value := x.MaxBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change_rate":
+		value := x.MaxBaseFeeChangeRate
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_overrides":
+		if len(x.MinGasPriceOverrides) == 0 {
+			return protoreflect.ValueOfList(&_Params_15_list{})
+		}
+		listValue := &_Params_15_list{list: &x.MinGasPriceOverrides}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment":
+		value := x.MinGasPriceAdjustment
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -274,6 +502,26 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinGasPrice = value.Interface().(string)
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		x.MinGasMultiplier = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.fee_denom_rates":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.FeeDenomRates = *clv.list
+	case "cosmos.evm.feemarket.v1.Params.base_fee_policy":
+		x.BaseFeePolicy = (BaseFeePolicy)(value.Enum())
+	case "cosmos.evm.feemarket.v1.Params.block_gas_target":
+		x.BlockGasTarget = value.Uint()
+	case "cosmos.evm.feemarket.v1.Params.min_base_fee":
+		x.MinBaseFee = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		x.MaxBaseFee = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change_rate":
+		x.MaxBaseFeeChangeRate = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_overrides":
+		lv := value.List()
+		clv := lv.(*_Params_15_list)
+		x.MinGasPriceOverrides = *clv.list
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment":
+		x.MinGasPriceAdjustment = value.Message().Interface().(*MinGasPriceAdjustment)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -294,6 +542,23 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.Params.fee_denom_rates":
+		if x.FeeDenomRates == nil {
+			x.FeeDenomRates = []*FeeDenomRate{}
+		}
+		value := &_Params_9_list{list: &x.FeeDenomRates}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_overrides":
+		if x.MinGasPriceOverrides == nil {
+			x.MinGasPriceOverrides = []*DenomMinGasPrice{}
+		}
+		value := &_Params_15_list{list: &x.MinGasPriceOverrides}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment":
+		if x.MinGasPriceAdjustment == nil {
+			x.MinGasPriceAdjustment = new(MinGasPriceAdjustment)
+		}
+		return protoreflect.ValueOfMessage(x.MinGasPriceAdjustment.ProtoReflect())
 	case "cosmos.evm.feemarket.v1.Params.no_base_fee":
 		panic(fmt.Errorf("field no_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	case "cosmos.evm.feemarket.v1.Params.base_fee_change_denominator":
@@ -308,6 +573,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		panic(fmt.Errorf("field min_gas_multiplier of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.base_fee_policy":
+		panic(fmt.Errorf("field base_fee_policy of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.block_gas_target":
+		panic(fmt.Errorf("field block_gas_target of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		panic(fmt.Errorf("field max_base_fee of message cosmos.evm.feemarket.v1.Params is not mutable"))
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change_rate":
+		panic(fmt.Errorf("field max_base_fee_change_rate of message cosmos.evm.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -335,6 +610,25 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.evm.feemarket.v1.Params.min_gas_multiplier":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.fee_denom_rates":
+		list := []*FeeDenomRate{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "cosmos.evm.feemarket.v1.Params.base_fee_policy":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.evm.feemarket.v1.Params.block_gas_target":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.feemarket.v1.Params.min_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.max_base_fee_change_rate":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_overrides":
+		list := []*DenomMinGasPrice{}
+		return protoreflect.ValueOfList(&_Params_15_list{list: &list})
+	case "cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment":
+		m := new(MinGasPriceAdjustment)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.Params"))
@@ -428,6 +722,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.FeeDenomRates) > 0 {
+			for _, e := range x.FeeDenomRates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BaseFeePolicy != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeePolicy))
+		}
+		if x.BlockGasTarget != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockGasTarget))
+		}
+		l = len(x.MinBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseFeeChangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MinGasPriceOverrides) > 0 {
+			for _, e := range x.MinGasPriceOverrides {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinGasPriceAdjustment != nil {
+			l = options.Size(x.MinGasPriceAdjustment)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -457,6 +785,85 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MinGasPriceAdjustment != nil {
+			encoded, err := options.Marshal(x.MinGasPriceAdjustment)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.MinGasPriceOverrides) > 0 {
+			for iNdEx := len(x.MinGasPriceOverrides) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinGasPriceOverrides[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.MaxBaseFeeChangeRate) > 0 {
+			i -= len(x.MaxBaseFeeChangeRate)
+			copy(dAtA[i:], x.MaxBaseFeeChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFeeChangeRate)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.MaxBaseFee) > 0 {
+			i -= len(x.MaxBaseFee)
+			copy(dAtA[i:], x.MaxBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseFee)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.BlockGasTarget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockGasTarget))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.BaseFeePolicy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeePolicy))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.FeeDenomRates) > 0 {
+			for iNdEx := len(x.FeeDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeDenomRates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if len(x.MinGasMultiplier) > 0 {
 			i -= len(x.MinGasMultiplier)
 			copy(dAtA[i:], x.MinGasMultiplier)
@@ -725,79 +1132,2030 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.MinGasMultiplier = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeDenomRates = append(x.FeeDenomRates, &FeeDenomRate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDenomRates[len(x.FeeDenomRates)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeePolicy", wireType)
+				}
+				x.BaseFeePolicy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseFeePolicy |= BaseFeePolicy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockGasTarget", wireType)
+				}
+				x.BlockGasTarget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockGasTarget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/evm/feemarket/v1/feemarket.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the EVM module parameters
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
-	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
-	// base_fee_change_denominator bounds the amount the base fee can change
-	// between blocks.
-	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
-	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
-	// have.
-	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
-	// enable_height defines at which block height the base fee calculation is
-	// enabled.
-	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
-	// base_fee for EIP-1559 blocks.
-	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
-	// min_gas_price defines the minimum gas price value for cosmos and eth
-	// transactions
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseFeeChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceOverrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPriceOverrides = append(x.MinGasPriceOverrides, &DenomMinGasPrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinGasPriceOverrides[len(x.MinGasPriceOverrides)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceAdjustment", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinGasPriceAdjustment == nil {
+					x.MinGasPriceAdjustment = &MinGasPriceAdjustment{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinGasPriceAdjustment); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDenomRate       protoreflect.MessageDescriptor
+	fd_FeeDenomRate_denom protoreflect.FieldDescriptor
+	fd_FeeDenomRate_rate  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_feemarket_proto_init()
+	md_FeeDenomRate = File_cosmos_evm_feemarket_v1_feemarket_proto.Messages().ByName("FeeDenomRate")
+	fd_FeeDenomRate_denom = md_FeeDenomRate.Fields().ByName("denom")
+	fd_FeeDenomRate_rate = md_FeeDenomRate.Fields().ByName("rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDenomRate)(nil)
+
+type fastReflection_FeeDenomRate FeeDenomRate
+
+func (x *FeeDenomRate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDenomRate)(x)
+}
+
+func (x *FeeDenomRate) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDenomRate_messageType fastReflection_FeeDenomRate_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDenomRate_messageType{}
+
+type fastReflection_FeeDenomRate_messageType struct{}
+
+func (x fastReflection_FeeDenomRate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDenomRate)(nil)
+}
+func (x fastReflection_FeeDenomRate_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomRate)
+}
+func (x fastReflection_FeeDenomRate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomRate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDenomRate) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDenomRate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDenomRate) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDenomRate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDenomRate) New() protoreflect.Message {
+	return new(fastReflection_FeeDenomRate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDenomRate) Interface() protoreflect.ProtoMessage {
+	return (*FeeDenomRate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDenomRate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeDenomRate_denom, value) {
+			return
+		}
+	}
+	if x.Rate != "" {
+		value := protoreflect.ValueOfString(x.Rate)
+		if !f(fd_FeeDenomRate_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDenomRate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.denom":
+		return x.Denom != ""
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.rate":
+		return x.Rate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeDenomRate"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeDenomRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomRate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.denom":
+		x.Denom = ""
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.rate":
+		x.Rate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeDenomRate"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeDenomRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDenomRate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.rate":
+		value := x.Rate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeDenomRate"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeDenomRate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomRate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.rate":
+		x.Rate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeDenomRate"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeDenomRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomRate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.feemarket.v1.FeeDenomRate is not mutable"))
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.rate":
+		panic(fmt.Errorf("field rate of message cosmos.evm.feemarket.v1.FeeDenomRate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeDenomRate"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeDenomRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDenomRate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.FeeDenomRate.rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.FeeDenomRate"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.FeeDenomRate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDenomRate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.FeeDenomRate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDenomRate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDenomRate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDenomRate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDenomRate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDenomRate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomRate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDenomRate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomRate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DenomMinGasPrice               protoreflect.MessageDescriptor
+	fd_DenomMinGasPrice_denom         protoreflect.FieldDescriptor
+	fd_DenomMinGasPrice_min_gas_price protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_feemarket_proto_init()
+	md_DenomMinGasPrice = File_cosmos_evm_feemarket_v1_feemarket_proto.Messages().ByName("DenomMinGasPrice")
+	fd_DenomMinGasPrice_denom = md_DenomMinGasPrice.Fields().ByName("denom")
+	fd_DenomMinGasPrice_min_gas_price = md_DenomMinGasPrice.Fields().ByName("min_gas_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_DenomMinGasPrice)(nil)
+
+type fastReflection_DenomMinGasPrice DenomMinGasPrice
+
+func (x *DenomMinGasPrice) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DenomMinGasPrice)(x)
+}
+
+func (x *DenomMinGasPrice) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DenomMinGasPrice_messageType fastReflection_DenomMinGasPrice_messageType
+var _ protoreflect.MessageType = fastReflection_DenomMinGasPrice_messageType{}
+
+type fastReflection_DenomMinGasPrice_messageType struct{}
+
+func (x fastReflection_DenomMinGasPrice_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DenomMinGasPrice)(nil)
+}
+func (x fastReflection_DenomMinGasPrice_messageType) New() protoreflect.Message {
+	return new(fastReflection_DenomMinGasPrice)
+}
+func (x fastReflection_DenomMinGasPrice_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomMinGasPrice
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DenomMinGasPrice) Descriptor() protoreflect.MessageDescriptor {
+	return md_DenomMinGasPrice
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DenomMinGasPrice) Type() protoreflect.MessageType {
+	return _fastReflection_DenomMinGasPrice_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DenomMinGasPrice) New() protoreflect.Message {
+	return new(fastReflection_DenomMinGasPrice)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DenomMinGasPrice) Interface() protoreflect.ProtoMessage {
+	return (*DenomMinGasPrice)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DenomMinGasPrice) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_DenomMinGasPrice_denom, value) {
+			return
+		}
+	}
+	if x.MinGasPrice != "" {
+		value := protoreflect.ValueOfString(x.MinGasPrice)
+		if !f(fd_DenomMinGasPrice_min_gas_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DenomMinGasPrice) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.denom":
+		return x.Denom != ""
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.min_gas_price":
+		return x.MinGasPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.DenomMinGasPrice"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.DenomMinGasPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomMinGasPrice) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.denom":
+		x.Denom = ""
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.min_gas_price":
+		x.MinGasPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.DenomMinGasPrice"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.DenomMinGasPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DenomMinGasPrice) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.min_gas_price":
+		value := x.MinGasPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.DenomMinGasPrice"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.DenomMinGasPrice does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomMinGasPrice) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.min_gas_price":
+		x.MinGasPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.DenomMinGasPrice"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.DenomMinGasPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomMinGasPrice) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.feemarket.v1.DenomMinGasPrice is not mutable"))
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.min_gas_price":
+		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.feemarket.v1.DenomMinGasPrice is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.DenomMinGasPrice"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.DenomMinGasPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DenomMinGasPrice) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.DenomMinGasPrice.min_gas_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.DenomMinGasPrice"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.DenomMinGasPrice does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DenomMinGasPrice) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.DenomMinGasPrice", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DenomMinGasPrice) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DenomMinGasPrice) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DenomMinGasPrice) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DenomMinGasPrice) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DenomMinGasPrice)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DenomMinGasPrice)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinGasPrice) > 0 {
+			i -= len(x.MinGasPrice)
+			copy(dAtA[i:], x.MinGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPrice)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DenomMinGasPrice)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomMinGasPrice: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DenomMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MinGasPriceAdjustment                       protoreflect.MessageDescriptor
+	fd_MinGasPriceAdjustment_window                protoreflect.FieldDescriptor
+	fd_MinGasPriceAdjustment_target_utilization    protoreflect.FieldDescriptor
+	fd_MinGasPriceAdjustment_max_change_rate       protoreflect.FieldDescriptor
+	fd_MinGasPriceAdjustment_min_gas_price_floor   protoreflect.FieldDescriptor
+	fd_MinGasPriceAdjustment_min_gas_price_ceiling protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_feemarket_v1_feemarket_proto_init()
+	md_MinGasPriceAdjustment = File_cosmos_evm_feemarket_v1_feemarket_proto.Messages().ByName("MinGasPriceAdjustment")
+	fd_MinGasPriceAdjustment_window = md_MinGasPriceAdjustment.Fields().ByName("window")
+	fd_MinGasPriceAdjustment_target_utilization = md_MinGasPriceAdjustment.Fields().ByName("target_utilization")
+	fd_MinGasPriceAdjustment_max_change_rate = md_MinGasPriceAdjustment.Fields().ByName("max_change_rate")
+	fd_MinGasPriceAdjustment_min_gas_price_floor = md_MinGasPriceAdjustment.Fields().ByName("min_gas_price_floor")
+	fd_MinGasPriceAdjustment_min_gas_price_ceiling = md_MinGasPriceAdjustment.Fields().ByName("min_gas_price_ceiling")
+}
+
+var _ protoreflect.Message = (*fastReflection_MinGasPriceAdjustment)(nil)
+
+type fastReflection_MinGasPriceAdjustment MinGasPriceAdjustment
+
+func (x *MinGasPriceAdjustment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MinGasPriceAdjustment)(x)
+}
+
+func (x *MinGasPriceAdjustment) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MinGasPriceAdjustment_messageType fastReflection_MinGasPriceAdjustment_messageType
+var _ protoreflect.MessageType = fastReflection_MinGasPriceAdjustment_messageType{}
+
+type fastReflection_MinGasPriceAdjustment_messageType struct{}
+
+func (x fastReflection_MinGasPriceAdjustment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MinGasPriceAdjustment)(nil)
+}
+func (x fastReflection_MinGasPriceAdjustment_messageType) New() protoreflect.Message {
+	return new(fastReflection_MinGasPriceAdjustment)
+}
+func (x fastReflection_MinGasPriceAdjustment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MinGasPriceAdjustment
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MinGasPriceAdjustment) Descriptor() protoreflect.MessageDescriptor {
+	return md_MinGasPriceAdjustment
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MinGasPriceAdjustment) Type() protoreflect.MessageType {
+	return _fastReflection_MinGasPriceAdjustment_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MinGasPriceAdjustment) New() protoreflect.Message {
+	return new(fastReflection_MinGasPriceAdjustment)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MinGasPriceAdjustment) Interface() protoreflect.ProtoMessage {
+	return (*MinGasPriceAdjustment)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MinGasPriceAdjustment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_MinGasPriceAdjustment_window, value) {
+			return
+		}
+	}
+	if x.TargetUtilization != "" {
+		value := protoreflect.ValueOfString(x.TargetUtilization)
+		if !f(fd_MinGasPriceAdjustment_target_utilization, value) {
+			return
+		}
+	}
+	if x.MaxChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxChangeRate)
+		if !f(fd_MinGasPriceAdjustment_max_change_rate, value) {
+			return
+		}
+	}
+	if x.MinGasPriceFloor != "" {
+		value := protoreflect.ValueOfString(x.MinGasPriceFloor)
+		if !f(fd_MinGasPriceAdjustment_min_gas_price_floor, value) {
+			return
+		}
+	}
+	if x.MinGasPriceCeiling != "" {
+		value := protoreflect.ValueOfString(x.MinGasPriceCeiling)
+		if !f(fd_MinGasPriceAdjustment_min_gas_price_ceiling, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MinGasPriceAdjustment) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.window":
+		return x.Window != uint64(0)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.target_utilization":
+		return x.TargetUtilization != ""
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.max_change_rate":
+		return x.MaxChangeRate != ""
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_floor":
+		return x.MinGasPriceFloor != ""
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_ceiling":
+		return x.MinGasPriceCeiling != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MinGasPriceAdjustment"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MinGasPriceAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MinGasPriceAdjustment) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.window":
+		x.Window = uint64(0)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.target_utilization":
+		x.TargetUtilization = ""
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.max_change_rate":
+		x.MaxChangeRate = ""
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_floor":
+		x.MinGasPriceFloor = ""
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_ceiling":
+		x.MinGasPriceCeiling = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MinGasPriceAdjustment"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MinGasPriceAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MinGasPriceAdjustment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.target_utilization":
+		value := x.TargetUtilization
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.max_change_rate":
+		value := x.MaxChangeRate
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_floor":
+		value := x.MinGasPriceFloor
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_ceiling":
+		value := x.MinGasPriceCeiling
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MinGasPriceAdjustment"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MinGasPriceAdjustment does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MinGasPriceAdjustment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.window":
+		x.Window = value.Uint()
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.target_utilization":
+		x.TargetUtilization = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.max_change_rate":
+		x.MaxChangeRate = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_floor":
+		x.MinGasPriceFloor = value.Interface().(string)
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_ceiling":
+		x.MinGasPriceCeiling = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MinGasPriceAdjustment"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MinGasPriceAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MinGasPriceAdjustment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.window":
+		panic(fmt.Errorf("field window of message cosmos.evm.feemarket.v1.MinGasPriceAdjustment is not mutable"))
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.target_utilization":
+		panic(fmt.Errorf("field target_utilization of message cosmos.evm.feemarket.v1.MinGasPriceAdjustment is not mutable"))
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.max_change_rate":
+		panic(fmt.Errorf("field max_change_rate of message cosmos.evm.feemarket.v1.MinGasPriceAdjustment is not mutable"))
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_floor":
+		panic(fmt.Errorf("field min_gas_price_floor of message cosmos.evm.feemarket.v1.MinGasPriceAdjustment is not mutable"))
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_ceiling":
+		panic(fmt.Errorf("field min_gas_price_ceiling of message cosmos.evm.feemarket.v1.MinGasPriceAdjustment is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MinGasPriceAdjustment"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MinGasPriceAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MinGasPriceAdjustment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.target_utilization":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.max_change_rate":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_floor":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.feemarket.v1.MinGasPriceAdjustment.min_gas_price_ceiling":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.feemarket.v1.MinGasPriceAdjustment"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.feemarket.v1.MinGasPriceAdjustment does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MinGasPriceAdjustment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.feemarket.v1.MinGasPriceAdjustment", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MinGasPriceAdjustment) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MinGasPriceAdjustment) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MinGasPriceAdjustment) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MinGasPriceAdjustment) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MinGasPriceAdjustment)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		l = len(x.TargetUtilization)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxChangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinGasPriceFloor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinGasPriceCeiling)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MinGasPriceAdjustment)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinGasPriceCeiling) > 0 {
+			i -= len(x.MinGasPriceCeiling)
+			copy(dAtA[i:], x.MinGasPriceCeiling)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPriceCeiling)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MinGasPriceFloor) > 0 {
+			i -= len(x.MinGasPriceFloor)
+			copy(dAtA[i:], x.MinGasPriceFloor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPriceFloor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MaxChangeRate) > 0 {
+			i -= len(x.MaxChangeRate)
+			copy(dAtA[i:], x.MaxChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChangeRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TargetUtilization) > 0 {
+			i -= len(x.TargetUtilization)
+			copy(dAtA[i:], x.TargetUtilization)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetUtilization)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MinGasPriceAdjustment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MinGasPriceAdjustment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MinGasPriceAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetUtilization = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceFloor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPriceFloor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceCeiling", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPriceCeiling = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/evm/feemarket/v1/feemarket.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BaseFeePolicy defines what happens to the base fee portion of the fees paid
+// by Ethereum transactions, i.e. the gas used times the base fee. The priority
+// tips are always distributed to the validators.
+type BaseFeePolicy int32
+
+const (
+	// BASE_FEE_POLICY_DISTRIBUTE distributes the base fee to the validators and
+	// delegators together with the rest of the transaction fees.
+	BaseFeePolicy_BASE_FEE_POLICY_DISTRIBUTE BaseFeePolicy = 0
+	// BASE_FEE_POLICY_BURN burns the base fee, as specified by EIP-1559.
+	BaseFeePolicy_BASE_FEE_POLICY_BURN BaseFeePolicy = 1
+	// BASE_FEE_POLICY_COMMUNITY_POOL sends the base fee to the community pool.
+	BaseFeePolicy_BASE_FEE_POLICY_COMMUNITY_POOL BaseFeePolicy = 2
+)
+
+// Enum value maps for BaseFeePolicy.
+var (
+	BaseFeePolicy_name = map[int32]string{
+		0: "BASE_FEE_POLICY_DISTRIBUTE",
+		1: "BASE_FEE_POLICY_BURN",
+		2: "BASE_FEE_POLICY_COMMUNITY_POOL",
+	}
+	BaseFeePolicy_value = map[string]int32{
+		"BASE_FEE_POLICY_DISTRIBUTE":     0,
+		"BASE_FEE_POLICY_BURN":           1,
+		"BASE_FEE_POLICY_COMMUNITY_POOL": 2,
+	}
+)
+
+func (x BaseFeePolicy) Enum() *BaseFeePolicy {
+	p := new(BaseFeePolicy)
+	*p = x
+	return p
+}
+
+func (x BaseFeePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BaseFeePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes[0].Descriptor()
+}
+
+func (BaseFeePolicy) Type() protoreflect.EnumType {
+	return &file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes[0]
+}
+
+func (x BaseFeePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BaseFeePolicy.Descriptor instead.
+func (BaseFeePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{0}
+}
+
+// Params defines the EVM module parameters
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
+	NoBaseFee bool `protobuf:"varint,1,opt,name=no_base_fee,json=noBaseFee,proto3" json:"no_base_fee,omitempty"`
+	// base_fee_change_denominator bounds the amount the base fee can change
+	// between blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
+	// have. It's ignored if block_gas_target is set.
+	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// enable_height defines at which block height the base fee calculation is
+	// enabled.
+	EnableHeight int64 `protobuf:"varint,5,opt,name=enable_height,json=enableHeight,proto3" json:"enable_height,omitempty"`
+	// base_fee for EIP-1559 blocks.
+	BaseFee string `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	// min_gas_price defines the minimum gas price value for cosmos and eth
+	// transactions
 	MinGasPrice string `protobuf:"bytes,7,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier string `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3" json:"min_gas_multiplier,omitempty"`
+	// fee_denom_rates defines the alternative denominations accepted to pay
+	// the fees of Ethereum transactions, together with their conversion rate
+	// into the EVM denomination.
+	FeeDenomRates []*FeeDenomRate `protobuf:"bytes,9,rep,name=fee_denom_rates,json=feeDenomRates,proto3" json:"fee_denom_rates,omitempty"`
+	// base_fee_policy defines what happens to the base fee portion of the fees
+	// paid by Ethereum transactions.
+	BaseFeePolicy BaseFeePolicy `protobuf:"varint,10,opt,name=base_fee_policy,json=baseFeePolicy,proto3,enum=cosmos.evm.feemarket.v1.BaseFeePolicy" json:"base_fee_policy,omitempty"`
+	// block_gas_target defines the gas used per block targeted by the base fee
+	// adjustments, independently of the consensus block gas limit. If zero, the
+	// target is the block gas limit divided by the elasticity multiplier.
+	BlockGasTarget uint64 `protobuf:"varint,11,opt,name=block_gas_target,json=blockGasTarget,proto3" json:"block_gas_target,omitempty"`
+	// min_base_fee defines the lowest base fee the EIP-1559 adjustments can set.
+	// Unlike min_gas_price, it doesn't affect the fees accepted by the nodes. If
+	// zero, the base fee has no floor besides min_gas_price.
+	MinBaseFee string `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// max_base_fee defines the highest base fee the EIP-1559 adjustments can set.
+	// If zero, the base fee has no ceiling.
+	MaxBaseFee string `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3" json:"max_base_fee,omitempty"`
+	// max_base_fee_change_rate bounds the relative change of the base fee between
+	// two blocks, e.g. 0.125 for 12.5%. If zero, the change is only bounded by
+	// the base_fee_change_denominator.
+	MaxBaseFeeChangeRate string `protobuf:"bytes,14,opt,name=max_base_fee_change_rate,json=maxBaseFeeChangeRate,proto3" json:"max_base_fee_change_rate,omitempty"`
+	// min_gas_price_overrides defines the minimum gas prices of the alternative
+	// fee denominations, which replace min_gas_price for the transactions paying
+	// their fees in these denominations.
+	MinGasPriceOverrides []*DenomMinGasPrice `protobuf:"bytes,15,rep,name=min_gas_price_overrides,json=minGasPriceOverrides,proto3" json:"min_gas_price_overrides,omitempty"`
+	// min_gas_price_adjustment defines the automatic adjustments of min_gas_price
+	// based on the block utilization. It's disabled if its window is zero.
+	MinGasPriceAdjustment *MinGasPriceAdjustment `protobuf:"bytes,16,opt,name=min_gas_price_adjustment,json=minGasPriceAdjustment,proto3" json:"min_gas_price_adjustment,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -869,6 +3227,241 @@ func (x *Params) GetMinGasMultiplier() string {
 	return ""
 }
 
+func (x *Params) GetFeeDenomRates() []*FeeDenomRate {
+	if x != nil {
+		return x.FeeDenomRates
+	}
+	return nil
+}
+
+func (x *Params) GetBaseFeePolicy() BaseFeePolicy {
+	if x != nil {
+		return x.BaseFeePolicy
+	}
+	return BaseFeePolicy_BASE_FEE_POLICY_DISTRIBUTE
+}
+
+func (x *Params) GetBlockGasTarget() uint64 {
+	if x != nil {
+		return x.BlockGasTarget
+	}
+	return 0
+}
+
+func (x *Params) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseFee() string {
+	if x != nil {
+		return x.MaxBaseFee
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseFeeChangeRate() string {
+	if x != nil {
+		return x.MaxBaseFeeChangeRate
+	}
+	return ""
+}
+
+func (x *Params) GetMinGasPriceOverrides() []*DenomMinGasPrice {
+	if x != nil {
+		return x.MinGasPriceOverrides
+	}
+	return nil
+}
+
+func (x *Params) GetMinGasPriceAdjustment() *MinGasPriceAdjustment {
+	if x != nil {
+		return x.MinGasPriceAdjustment
+	}
+	return nil
+}
+
+// FeeDenomRate defines the rate used to convert the fees of an Ethereum
+// transaction into an alternative fee denomination.
+type FeeDenomRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the bank denomination of the alternative fee token. It must
+	// belong to a registered ERC20 token pair.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denomination, in its 18 decimals
+	// representation, that one unit of the alternative denomination is worth.
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *FeeDenomRate) Reset() {
+	*x = FeeDenomRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDenomRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDenomRate) ProtoMessage() {}
+
+// Deprecated: Use FeeDenomRate.ProtoReflect.Descriptor instead.
+func (*FeeDenomRate) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeDenomRate) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FeeDenomRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+// DenomMinGasPrice defines the minimum gas price of a fee denomination.
+type DenomMinGasPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the bank denomination the fees are paid in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_gas_price is the minimum amount of the denomination, in its bank
+	// representation, to pay per unit of gas.
+	MinGasPrice string `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+}
+
+func (x *DenomMinGasPrice) Reset() {
+	*x = DenomMinGasPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomMinGasPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomMinGasPrice) ProtoMessage() {}
+
+// Deprecated: Use DenomMinGasPrice.ProtoReflect.Descriptor instead.
+func (*DenomMinGasPrice) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DenomMinGasPrice) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomMinGasPrice) GetMinGasPrice() string {
+	if x != nil {
+		return x.MinGasPrice
+	}
+	return ""
+}
+
+// MinGasPriceAdjustment defines how the min_gas_price parameter is adjusted at
+// the end of each window of blocks, depending on the average utilization of the
+// block gas limit over that window.
+type MinGasPriceAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the number of consecutive blocks the utilization is averaged
+	// over before adjusting the min gas price. The windows are fixed and don't
+	// overlap: the min gas price is adjusted once per window, at its last block,
+	// and the next window starts empty. If zero, the adjustments are disabled.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// target_utilization is the average ratio of the block gas limit used over
+	// the window above which the min gas price increases, and below which it
+	// decreases, e.g. 0.5 for 50%.
+	TargetUtilization string `protobuf:"bytes,2,opt,name=target_utilization,json=targetUtilization,proto3" json:"target_utilization,omitempty"`
+	// max_change_rate bounds the relative change of the min gas price at the end
+	// of a window, e.g. 0.1 for 10%. The change is proportional to the deviation
+	// of the utilization from its target.
+	MaxChangeRate string `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3" json:"max_change_rate,omitempty"`
+	// min_gas_price_floor is the lowest min gas price the adjustments can set.
+	MinGasPriceFloor string `protobuf:"bytes,4,opt,name=min_gas_price_floor,json=minGasPriceFloor,proto3" json:"min_gas_price_floor,omitempty"`
+	// min_gas_price_ceiling is the highest min gas price the adjustments can
+	// set.
+	MinGasPriceCeiling string `protobuf:"bytes,5,opt,name=min_gas_price_ceiling,json=minGasPriceCeiling,proto3" json:"min_gas_price_ceiling,omitempty"`
+}
+
+func (x *MinGasPriceAdjustment) Reset() {
+	*x = MinGasPriceAdjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinGasPriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinGasPriceAdjustment) ProtoMessage() {}
+
+// Deprecated: Use MinGasPriceAdjustment.ProtoReflect.Descriptor instead.
+func (*MinGasPriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MinGasPriceAdjustment) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *MinGasPriceAdjustment) GetTargetUtilization() string {
+	if x != nil {
+		return x.TargetUtilization
+	}
+	return ""
+}
+
+func (x *MinGasPriceAdjustment) GetMaxChangeRate() string {
+	if x != nil {
+		return x.MaxChangeRate
+	}
+	return ""
+}
+
+func (x *MinGasPriceAdjustment) GetMinGasPriceFloor() string {
+	if x != nil {
+		return x.MinGasPriceFloor
+	}
+	return ""
+}
+
+func (x *MinGasPriceAdjustment) GetMinGasPriceCeiling() string {
+	if x != nil {
+		return x.MinGasPriceCeiling
+	}
+	return ""
+}
+
 var File_cosmos_evm_feemarket_v1_feemarket_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
@@ -878,7 +3471,7 @@ var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
 	0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x03, 0x0a, 0x06,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x09, 0x0a, 0x06,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x73,
 	0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x42,
 	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66,
@@ -905,26 +3498,119 @@ var file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc = []byte{
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
 	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0f, 0x66, 0x65,
+	0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65,
+	0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0d, 0x66, 0x65, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x4e, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x47, 0x61, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x69, 0x6e,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x60, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x17, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x18, 0x6d,
+	0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x15, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x22, 0x8a, 0xe7, 0xb0, 0x2a,
 	0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x66, 0x65,
 	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04,
 	0x08, 0x04, 0x10, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0xe2, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x43, 0x45, 0x46, 0xaa, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76,
-	0x6d, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x62, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3c, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x76, 0x0a, 0x10, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x4c, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0x90, 0x03, 0x0a, 0x15, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x57, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x57, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x5b, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x65,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x2a, 0xc7, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x1a, 0x42, 0x41, 0x53, 0x45, 0x5f,
+	0x46, 0x45, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x54, 0x52,
+	0x49, 0x42, 0x55, 0x54, 0x45, 0x10, 0x00, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45, 0x45,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x01, 0x1a, 0x15,
+	0x8a, 0x9d, 0x20, 0x11, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x42, 0x0a, 0x1e, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x55, 0x4e, 0x49,
+	0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x02, 0x1a, 0x1e, 0x8a, 0x9d, 0x20, 0x1a, 0x42,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42,
+	0xe2, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42,
+	0x0e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x46, 0xaa, 0x02, 0x17,
+	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x23, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x46,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1a, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -939,16 +3625,25 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_feemarket_v1_feemarket_proto_rawDescData
 }
 
-var file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cosmos_evm_feemarket_v1_feemarket_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: cosmos.evm.feemarket.v1.Params
+	(BaseFeePolicy)(0),            // 0: cosmos.evm.feemarket.v1.BaseFeePolicy
+	(*Params)(nil),                // 1: cosmos.evm.feemarket.v1.Params
+	(*FeeDenomRate)(nil),          // 2: cosmos.evm.feemarket.v1.FeeDenomRate
+	(*DenomMinGasPrice)(nil),      // 3: cosmos.evm.feemarket.v1.DenomMinGasPrice
+	(*MinGasPriceAdjustment)(nil), // 4: cosmos.evm.feemarket.v1.MinGasPriceAdjustment
 }
 var file_cosmos_evm_feemarket_v1_feemarket_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: cosmos.evm.feemarket.v1.Params.fee_denom_rates:type_name -> cosmos.evm.feemarket.v1.FeeDenomRate
+	0, // 1: cosmos.evm.feemarket.v1.Params.base_fee_policy:type_name -> cosmos.evm.feemarket.v1.BaseFeePolicy
+	3, // 2: cosmos.evm.feemarket.v1.Params.min_gas_price_overrides:type_name -> cosmos.evm.feemarket.v1.DenomMinGasPrice
+	4, // 3: cosmos.evm.feemarket.v1.Params.min_gas_price_adjustment:type_name -> cosmos.evm.feemarket.v1.MinGasPriceAdjustment
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_feemarket_v1_feemarket_proto_init() }
@@ -969,19 +3664,56 @@ func file_cosmos_evm_feemarket_v1_feemarket_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDenomRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenomMinGasPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinGasPriceAdjustment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_feemarket_v1_feemarket_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_evm_feemarket_v1_feemarket_proto_goTypes,
 		DependencyIndexes: file_cosmos_evm_feemarket_v1_feemarket_proto_depIdxs,
+		EnumInfos:         file_cosmos_evm_feemarket_v1_feemarket_proto_enumTypes,
 		MessageInfos:      file_cosmos_evm_feemarket_v1_feemarket_proto_msgTypes,
 	}.Build()
 	File_cosmos_evm_feemarket_v1_feemarket_proto = out.File
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/cosmos.evm.feemarket.v1.Query/Params"
-	Query_BaseFee_FullMethodName  = "/cosmos.evm.feemarket.v1.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_Params_FullMethodName            = "/cosmos.evm.feemarket.v1.Query/Params"
+	Query_BaseFee_FullMethodName           = "/cosmos.evm.feemarket.v1.Query/BaseFee"
+	Query_BlockGas_FullMethodName          = "/cosmos.evm.feemarket.v1.Query/BlockGas"
+	Query_ParamsAuthorities_FullMethodName = "/cosmos.evm.feemarket.v1.Query/ParamsAuthorities"
+	Query_ParamsAtHeight_FullMethodName    = "/cosmos.evm.feemarket.v1.Query/ParamsAtHeight"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/feemarket module parameters.
+	ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/feemarket module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +79,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error) {
+	out := new(QueryParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAuthorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +107,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/feemarket module parameters.
+	ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/feemarket module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +129,12 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAuthorities not implemented")
+}
+func (UnimplementedQueryServer) ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +202,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAuthorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAuthorities(ctx, req.(*QueryParamsAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +257,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "ParamsAuthorities",
+			Handler:    _Query_ParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.evm.feemarket.v1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName            = "/cosmos.evm.feemarket.v1.Msg/UpdateParams"
+	Msg_UpdateParamsAuthorities_FullMethodName = "/cosmos.evm.feemarket.v1.Msg/UpdateParamsAuthorities"
 )
 
 // MsgClient is the client API for Msg service.
@@ -30,6 +31,10 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/feemarket module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error)
 }
 
 type msgClient struct {
@@ -49,6 +54,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	out := new(MsgUpdateParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParamsAuthorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -57,6 +71,10 @@ type MsgServer interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/feemarket module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -67,6 +85,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsAuthorities not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +119,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParamsAuthorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, req.(*MsgUpdateParamsAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +148,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateParamsAuthorities",
+			Handler:    _Msg_UpdateParamsAuthorities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/tx.proto",
@@ -152,6 +152,52 @@ func (x *_Params_9_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]string
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedMsgTypeUrls as it is not of Message kind"))
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                           protoreflect.MessageDescriptor
 	fd_Params_evm_denom                 protoreflect.FieldDescriptor
@@ -160,6 +206,9 @@ var (
 	fd_Params_access_control            protoreflect.FieldDescriptor
 	fd_Params_active_static_precompiles protoreflect.FieldDescriptor
 	fd_Params_history_serve_window      protoreflect.FieldDescriptor
+	fd_Params_allowed_msg_type_urls     protoreflect.FieldDescriptor
+	fd_Params_circuit_breaker           protoreflect.FieldDescriptor
+	fd_Params_gas_overrides             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -171,6 +220,9 @@ func init() {
 	fd_Params_access_control = md_Params.Fields().ByName("access_control")
 	fd_Params_active_static_precompiles = md_Params.Fields().ByName("active_static_precompiles")
 	fd_Params_history_serve_window = md_Params.Fields().ByName("history_serve_window")
+	fd_Params_allowed_msg_type_urls = md_Params.Fields().ByName("allowed_msg_type_urls")
+	fd_Params_circuit_breaker = md_Params.Fields().ByName("circuit_breaker")
+	fd_Params_gas_overrides = md_Params.Fields().ByName("gas_overrides")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -274,6 +326,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.AllowedMsgTypeUrls) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.AllowedMsgTypeUrls})
+		if !f(fd_Params_allowed_msg_type_urls, value) {
+			return
+		}
+	}
+	if x.CircuitBreaker != nil {
+		value := protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
+		if !f(fd_Params_circuit_breaker, value) {
+			return
+		}
+	}
+	if x.GasOverrides != nil {
+		value := protoreflect.ValueOfMessage(x.GasOverrides.ProtoReflect())
+		if !f(fd_Params_gas_overrides, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -301,6 +371,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.ActiveStaticPrecompiles) != 0
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
 		return x.HistoryServeWindow != uint64(0)
+	case "cosmos.evm.vm.v1.Params.allowed_msg_type_urls":
+		return len(x.AllowedMsgTypeUrls) != 0
+	case "cosmos.evm.vm.v1.Params.circuit_breaker":
+		return x.CircuitBreaker != nil
+	case "cosmos.evm.vm.v1.Params.gas_overrides":
+		return x.GasOverrides != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -329,6 +405,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.ActiveStaticPrecompiles = nil
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
 		x.HistoryServeWindow = uint64(0)
+	case "cosmos.evm.vm.v1.Params.allowed_msg_type_urls":
+		x.AllowedMsgTypeUrls = nil
+	case "cosmos.evm.vm.v1.Params.circuit_breaker":
+		x.CircuitBreaker = nil
+	case "cosmos.evm.vm.v1.Params.gas_overrides":
+		x.GasOverrides = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -372,6 +454,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
 		value := x.HistoryServeWindow
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Params.allowed_msg_type_urls":
+		if len(x.AllowedMsgTypeUrls) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.AllowedMsgTypeUrls}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Params.circuit_breaker":
+		value := x.CircuitBreaker
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.Params.gas_overrides":
+		value := x.GasOverrides
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -410,6 +504,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.ActiveStaticPrecompiles = *clv.list
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
 		x.HistoryServeWindow = value.Uint()
+	case "cosmos.evm.vm.v1.Params.allowed_msg_type_urls":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.AllowedMsgTypeUrls = *clv.list
+	case "cosmos.evm.vm.v1.Params.circuit_breaker":
+		x.CircuitBreaker = value.Message().Interface().(*CircuitBreaker)
+	case "cosmos.evm.vm.v1.Params.gas_overrides":
+		x.GasOverrides = value.Message().Interface().(*GasOverrides)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -453,6 +555,22 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_9_list{list: &x.ActiveStaticPrecompiles}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.Params.allowed_msg_type_urls":
+		if x.AllowedMsgTypeUrls == nil {
+			x.AllowedMsgTypeUrls = []string{}
+		}
+		value := &_Params_11_list{list: &x.AllowedMsgTypeUrls}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.Params.circuit_breaker":
+		if x.CircuitBreaker == nil {
+			x.CircuitBreaker = new(CircuitBreaker)
+		}
+		return protoreflect.ValueOfMessage(x.CircuitBreaker.ProtoReflect())
+	case "cosmos.evm.vm.v1.Params.gas_overrides":
+		if x.GasOverrides == nil {
+			x.GasOverrides = new(GasOverrides)
+		}
+		return protoreflect.ValueOfMessage(x.GasOverrides.ProtoReflect())
 	case "cosmos.evm.vm.v1.Params.evm_denom":
 		panic(fmt.Errorf("field evm_denom of message cosmos.evm.vm.v1.Params is not mutable"))
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
@@ -486,6 +604,15 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
 	case "cosmos.evm.vm.v1.Params.history_serve_window":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Params.allowed_msg_type_urls":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
+	case "cosmos.evm.vm.v1.Params.circuit_breaker":
+		m := new(CircuitBreaker)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.Params.gas_overrides":
+		m := new(GasOverrides)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Params"))
@@ -585,6 +712,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.HistoryServeWindow != 0 {
 			n += 1 + runtime.Sov(uint64(x.HistoryServeWindow))
 		}
+		if len(x.AllowedMsgTypeUrls) > 0 {
+			for _, s := range x.AllowedMsgTypeUrls {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CircuitBreaker != nil {
+			l = options.Size(x.CircuitBreaker)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasOverrides != nil {
+			l = options.Size(x.GasOverrides)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -614,6 +755,43 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.GasOverrides != nil {
+			encoded, err := options.Marshal(x.GasOverrides)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.CircuitBreaker != nil {
+			encoded, err := options.Marshal(x.CircuitBreaker)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.AllowedMsgTypeUrls) > 0 {
+			for iNdEx := len(x.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedMsgTypeUrls[iNdEx])
+				copy(dAtA[i:], x.AllowedMsgTypeUrls[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedMsgTypeUrls[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
 		if x.HistoryServeWindow != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoryServeWindow))
 			i--
@@ -955,6 +1133,110 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedMsgTypeUrls = append(x.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CircuitBreaker == nil {
+					x.CircuitBreaker = &CircuitBreaker{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreaker); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasOverrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasOverrides == nil {
+					x.GasOverrides = &GasOverrides{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasOverrides); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -990,28 +1272,78 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_CircuitBreaker_3_list)(nil)
+
+type _CircuitBreaker_3_list struct {
+	list *[]string
+}
+
+func (x *_CircuitBreaker_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CircuitBreaker_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_CircuitBreaker_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CircuitBreaker_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CircuitBreaker_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CircuitBreaker at list field PausedPrecompiles as it is not of Message kind"))
+}
+
+func (x *_CircuitBreaker_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CircuitBreaker_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_CircuitBreaker_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_AccessControl        protoreflect.MessageDescriptor
-	fd_AccessControl_create protoreflect.FieldDescriptor
-	fd_AccessControl_call   protoreflect.FieldDescriptor
+	md_CircuitBreaker                    protoreflect.MessageDescriptor
+	fd_CircuitBreaker_pause_create       protoreflect.FieldDescriptor
+	fd_CircuitBreaker_pause_call         protoreflect.FieldDescriptor
+	fd_CircuitBreaker_paused_precompiles protoreflect.FieldDescriptor
+	fd_CircuitBreaker_expiry_height      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControl")
-	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
-	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
+	md_CircuitBreaker = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("CircuitBreaker")
+	fd_CircuitBreaker_pause_create = md_CircuitBreaker.Fields().ByName("pause_create")
+	fd_CircuitBreaker_pause_call = md_CircuitBreaker.Fields().ByName("pause_call")
+	fd_CircuitBreaker_paused_precompiles = md_CircuitBreaker.Fields().ByName("paused_precompiles")
+	fd_CircuitBreaker_expiry_height = md_CircuitBreaker.Fields().ByName("expiry_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
+var _ protoreflect.Message = (*fastReflection_CircuitBreaker)(nil)
 
-type fastReflection_AccessControl AccessControl
+type fastReflection_CircuitBreaker CircuitBreaker
 
-func (x *AccessControl) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControl)(x)
+func (x *CircuitBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(x)
 }
 
-func (x *AccessControl) slowProtoReflect() protoreflect.Message {
+func (x *CircuitBreaker) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1023,43 +1355,43 @@ func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
+var _fastReflection_CircuitBreaker_messageType fastReflection_CircuitBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreaker_messageType{}
 
-type fastReflection_AccessControl_messageType struct{}
+type fastReflection_CircuitBreaker_messageType struct{}
 
-func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControl)(nil)
+func (x fastReflection_CircuitBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreaker)(nil)
 }
-func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x fastReflection_CircuitBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
 }
-func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x fastReflection_CircuitBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControl
+func (x *fastReflection_CircuitBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreaker
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControl_messageType
+func (x *fastReflection_CircuitBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreaker_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControl) New() protoreflect.Message {
-	return new(fastReflection_AccessControl)
+func (x *fastReflection_CircuitBreaker) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreaker)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
-	return (*AccessControl)(x)
+func (x *fastReflection_CircuitBreaker) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreaker)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1067,20 +1399,32 @@ func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Create != nil {
-		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-		if !f(fd_AccessControl_create, value) {
+func (x *fastReflection_CircuitBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PauseCreate != false {
+		value := protoreflect.ValueOfBool(x.PauseCreate)
+		if !f(fd_CircuitBreaker_pause_create, value) {
 			return
 		}
 	}
-	if x.Call != nil {
-		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
-		if !f(fd_AccessControl_call, value) {
+	if x.PauseCall != false {
+		value := protoreflect.ValueOfBool(x.PauseCall)
+		if !f(fd_CircuitBreaker_pause_call, value) {
 			return
 		}
 	}
-}
+	if len(x.PausedPrecompiles) != 0 {
+		value := protoreflect.ValueOfList(&_CircuitBreaker_3_list{list: &x.PausedPrecompiles})
+		if !f(fd_CircuitBreaker_paused_precompiles, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_CircuitBreaker_expiry_height, value) {
+			return
+		}
+	}
+}
 
 // Has reports whether a field is populated.
 //
@@ -1093,17 +1437,21 @@ func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CircuitBreaker) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		return x.Create != nil
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		return x.Call != nil
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_create":
+		return x.PauseCreate != false
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_call":
+		return x.PauseCall != false
+	case "cosmos.evm.vm.v1.CircuitBreaker.paused_precompiles":
+		return len(x.PausedPrecompiles) != 0
+	case "cosmos.evm.vm.v1.CircuitBreaker.expiry_height":
+		return x.ExpiryHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1113,17 +1461,21 @@ func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CircuitBreaker) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		x.Create = nil
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		x.Call = nil
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_create":
+		x.PauseCreate = false
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_call":
+		x.PauseCall = false
+	case "cosmos.evm.vm.v1.CircuitBreaker.paused_precompiles":
+		x.PausedPrecompiles = nil
+	case "cosmos.evm.vm.v1.CircuitBreaker.expiry_height":
+		x.ExpiryHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1133,19 +1485,28 @@ func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CircuitBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		value := x.Create
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		value := x.Call
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_create":
+		value := x.PauseCreate
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_call":
+		value := x.PauseCall
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.CircuitBreaker.paused_precompiles":
+		if len(x.PausedPrecompiles) == 0 {
+			return protoreflect.ValueOfList(&_CircuitBreaker_3_list{})
+		}
+		listValue := &_CircuitBreaker_3_list{list: &x.PausedPrecompiles}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.CircuitBreaker.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CircuitBreaker does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1159,17 +1520,23 @@ func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CircuitBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		x.Create = value.Message().Interface().(*AccessControlType)
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		x.Call = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_create":
+		x.PauseCreate = value.Bool()
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_call":
+		x.PauseCall = value.Bool()
+	case "cosmos.evm.vm.v1.CircuitBreaker.paused_precompiles":
+		lv := value.List()
+		clv := lv.(*_CircuitBreaker_3_list)
+		x.PausedPrecompiles = *clv.list
+	case "cosmos.evm.vm.v1.CircuitBreaker.expiry_height":
+		x.ExpiryHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1183,52 +1550,57 @@ func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CircuitBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		if x.Create == nil {
-			x.Create = new(AccessControlType)
-		}
-		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		if x.Call == nil {
-			x.Call = new(AccessControlType)
+	case "cosmos.evm.vm.v1.CircuitBreaker.paused_precompiles":
+		if x.PausedPrecompiles == nil {
+			x.PausedPrecompiles = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		value := &_CircuitBreaker_3_list{list: &x.PausedPrecompiles}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_create":
+		panic(fmt.Errorf("field pause_create of message cosmos.evm.vm.v1.CircuitBreaker is not mutable"))
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_call":
+		panic(fmt.Errorf("field pause_call of message cosmos.evm.vm.v1.CircuitBreaker is not mutable"))
+	case "cosmos.evm.vm.v1.CircuitBreaker.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message cosmos.evm.vm.v1.CircuitBreaker is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CircuitBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControl.create":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.AccessControl.call":
-		m := new(AccessControlType)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_create":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.CircuitBreaker.pause_call":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.CircuitBreaker.paused_precompiles":
+		list := []string{}
+		return protoreflect.ValueOfList(&_CircuitBreaker_3_list{list: &list})
+	case "cosmos.evm.vm.v1.CircuitBreaker.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CircuitBreaker"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CircuitBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CircuitBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControl", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.CircuitBreaker", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1236,7 +1608,7 @@ func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CircuitBreaker) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1247,7 +1619,7 @@ func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CircuitBreaker) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1259,7 +1631,7 @@ func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControl) IsValid() bool {
+func (x *fastReflection_CircuitBreaker) IsValid() bool {
 	return x != nil
 }
 
@@ -1269,9 +1641,9 @@ func (x *fastReflection_AccessControl) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CircuitBreaker) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*CircuitBreaker)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1283,13 +1655,20 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Create != nil {
-			l = options.Size(x.Create)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PauseCreate {
+			n += 2
 		}
-		if x.Call != nil {
-			l = options.Size(x.Call)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.PauseCall {
+			n += 2
+		}
+		if len(x.PausedPrecompiles) > 0 {
+			for _, s := range x.PausedPrecompiles {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1301,7 +1680,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*CircuitBreaker)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1320,33 +1699,39 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Call != nil {
-			encoded, err := options.Marshal(x.Call)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PausedPrecompiles) > 0 {
+			for iNdEx := len(x.PausedPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PausedPrecompiles[iNdEx])
+				copy(dAtA[i:], x.PausedPrecompiles[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PausedPrecompiles[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.PauseCall {
 			i--
-			dAtA[i] = 0x12
+			if x.PauseCall {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
 		}
-		if x.Create != nil {
-			encoded, err := options.Marshal(x.Create)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if x.PauseCreate {
+			i--
+			if x.PauseCreate {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1359,7 +1744,7 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControl)
+		x := input.Message.Interface().(*CircuitBreaker)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1391,17 +1776,17 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseCreate", wireType)
 				}
-				var msglen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1411,33 +1796,37 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Create == nil {
-					x.Create = &AccessControlType{}
+				x.PauseCreate = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseCall", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
-			case 2:
+				x.PauseCall = bool(v != 0)
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedPrecompiles", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1447,28 +1836,43 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Call == nil {
-					x.Call = &AccessControlType{}
+				x.PausedPrecompiles = append(x.PausedPrecompiles, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1504,74 +1908,30 @@ func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
-
-type _AccessControlType_2_list struct {
-	list *[]string
-}
-
-func (x *_AccessControlType_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
-}
-
-func (x *_AccessControlType_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_AccessControlType_2_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_AccessControlType                     protoreflect.MessageDescriptor
-	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
-	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+	md_GasOverrides                      protoreflect.MessageDescriptor
+	fd_GasOverrides_tx_data_zero_gas     protoreflect.FieldDescriptor
+	fd_GasOverrides_tx_data_non_zero_gas protoreflect.FieldDescriptor
+	fd_GasOverrides_sstore_set_gas       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessControlType = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlType")
-	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
-	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+	md_GasOverrides = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("GasOverrides")
+	fd_GasOverrides_tx_data_zero_gas = md_GasOverrides.Fields().ByName("tx_data_zero_gas")
+	fd_GasOverrides_tx_data_non_zero_gas = md_GasOverrides.Fields().ByName("tx_data_non_zero_gas")
+	fd_GasOverrides_sstore_set_gas = md_GasOverrides.Fields().ByName("sstore_set_gas")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+var _ protoreflect.Message = (*fastReflection_GasOverrides)(nil)
 
-type fastReflection_AccessControlType AccessControlType
+type fastReflection_GasOverrides GasOverrides
 
-func (x *AccessControlType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(x)
+func (x *GasOverrides) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasOverrides)(x)
 }
 
-func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+func (x *GasOverrides) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1583,43 +1943,43 @@ func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
-var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+var _fastReflection_GasOverrides_messageType fastReflection_GasOverrides_messageType
+var _ protoreflect.MessageType = fastReflection_GasOverrides_messageType{}
 
-type fastReflection_AccessControlType_messageType struct{}
+type fastReflection_GasOverrides_messageType struct{}
 
-func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessControlType)(nil)
+func (x fastReflection_GasOverrides_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasOverrides)(nil)
 }
-func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x fastReflection_GasOverrides_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasOverrides)
 }
-func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x fastReflection_GasOverrides_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasOverrides
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessControlType
+func (x *fastReflection_GasOverrides) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasOverrides
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
-	return _fastReflection_AccessControlType_messageType
+func (x *fastReflection_GasOverrides) Type() protoreflect.MessageType {
+	return _fastReflection_GasOverrides_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessControlType) New() protoreflect.Message {
-	return new(fastReflection_AccessControlType)
+func (x *fastReflection_GasOverrides) New() protoreflect.Message {
+	return new(fastReflection_GasOverrides)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
-	return (*AccessControlType)(x)
+func (x *fastReflection_GasOverrides) Interface() protoreflect.ProtoMessage {
+	return (*GasOverrides)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1627,16 +1987,22 @@ func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AccessType != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
-		if !f(fd_AccessControlType_access_type, value) {
+func (x *fastReflection_GasOverrides) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TxDataZeroGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxDataZeroGas)
+		if !f(fd_GasOverrides_tx_data_zero_gas, value) {
 			return
 		}
 	}
-	if len(x.AccessControlList) != 0 {
-		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
-		if !f(fd_AccessControlType_access_control_list, value) {
+	if x.TxDataNonZeroGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxDataNonZeroGas)
+		if !f(fd_GasOverrides_tx_data_non_zero_gas, value) {
+			return
+		}
+	}
+	if x.SstoreSetGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SstoreSetGas)
+		if !f(fd_GasOverrides_sstore_set_gas, value) {
 			return
 		}
 	}
@@ -1653,17 +2019,19 @@ func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GasOverrides) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		return x.AccessType != 0
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		return len(x.AccessControlList) != 0
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_zero_gas":
+		return x.TxDataZeroGas != uint64(0)
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_non_zero_gas":
+		return x.TxDataNonZeroGas != uint64(0)
+	case "cosmos.evm.vm.v1.GasOverrides.sstore_set_gas":
+		return x.SstoreSetGas != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GasOverrides"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.GasOverrides does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1673,17 +2041,19 @@ func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GasOverrides) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		x.AccessType = 0
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		x.AccessControlList = nil
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_zero_gas":
+		x.TxDataZeroGas = uint64(0)
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_non_zero_gas":
+		x.TxDataNonZeroGas = uint64(0)
+	case "cosmos.evm.vm.v1.GasOverrides.sstore_set_gas":
+		x.SstoreSetGas = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GasOverrides"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.GasOverrides does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1693,22 +2063,22 @@ func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasOverrides) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		value := x.AccessType
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		if len(x.AccessControlList) == 0 {
-			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
-		}
-		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
-		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_zero_gas":
+		value := x.TxDataZeroGas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_non_zero_gas":
+		value := x.TxDataNonZeroGas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.GasOverrides.sstore_set_gas":
+		value := x.SstoreSetGas
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GasOverrides"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.GasOverrides does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1722,19 +2092,19 @@ func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GasOverrides) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		x.AccessType = (AccessType)(value.Enum())
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		lv := value.List()
-		clv := lv.(*_AccessControlType_2_list)
-		x.AccessControlList = *clv.list
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_zero_gas":
+		x.TxDataZeroGas = value.Uint()
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_non_zero_gas":
+		x.TxDataNonZeroGas = value.Uint()
+	case "cosmos.evm.vm.v1.GasOverrides.sstore_set_gas":
+		x.SstoreSetGas = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GasOverrides"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.GasOverrides does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1748,49 +2118,48 @@ func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasOverrides) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		if x.AccessControlList == nil {
-			x.AccessControlList = []string{}
-		}
-		value := &_AccessControlType_2_list{list: &x.AccessControlList}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlType is not mutable"))
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_zero_gas":
+		panic(fmt.Errorf("field tx_data_zero_gas of message cosmos.evm.vm.v1.GasOverrides is not mutable"))
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_non_zero_gas":
+		panic(fmt.Errorf("field tx_data_non_zero_gas of message cosmos.evm.vm.v1.GasOverrides is not mutable"))
+	case "cosmos.evm.vm.v1.GasOverrides.sstore_set_gas":
+		panic(fmt.Errorf("field sstore_set_gas of message cosmos.evm.vm.v1.GasOverrides is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GasOverrides"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.GasOverrides does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasOverrides) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessControlType.access_type":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
-		list := []string{}
-		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_zero_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.GasOverrides.tx_data_non_zero_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.GasOverrides.sstore_set_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.GasOverrides"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.GasOverrides does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GasOverrides) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.GasOverrides", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1798,7 +2167,7 @@ func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GasOverrides) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1809,7 +2178,7 @@ func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GasOverrides) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1821,7 +2190,7 @@ func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessControlType) IsValid() bool {
+func (x *fastReflection_GasOverrides) IsValid() bool {
 	return x != nil
 }
 
@@ -1831,9 +2200,9 @@ func (x *fastReflection_AccessControlType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GasOverrides) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*GasOverrides)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1845,14 +2214,14 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.AccessType != 0 {
-			n += 1 + runtime.Sov(uint64(x.AccessType))
+		if x.TxDataZeroGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxDataZeroGas))
 		}
-		if len(x.AccessControlList) > 0 {
-			for _, s := range x.AccessControlList {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.TxDataNonZeroGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxDataNonZeroGas))
+		}
+		if x.SstoreSetGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.SstoreSetGas))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1864,7 +2233,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*GasOverrides)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1883,17 +2252,18 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AccessControlList) > 0 {
-			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.AccessControlList[iNdEx])
-				copy(dAtA[i:], x.AccessControlList[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if x.SstoreSetGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SstoreSetGas))
+			i--
+			dAtA[i] = 0x18
 		}
-		if x.AccessType != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+		if x.TxDataNonZeroGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxDataNonZeroGas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TxDataZeroGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxDataZeroGas))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -1908,7 +2278,7 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessControlType)
+		x := input.Message.Interface().(*GasOverrides)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1940,17 +2310,17 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasOverrides: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxDataZeroGas", wireType)
 				}
-				x.AccessType = 0
+				x.TxDataZeroGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1960,16 +2330,16 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.AccessType |= AccessType(b&0x7F) << shift
+					x.TxDataZeroGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxDataNonZeroGas", wireType)
 				}
-				var stringLen uint64
+				x.TxDataNonZeroGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1979,24 +2349,30 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.TxDataNonZeroGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SstoreSetGas", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.SstoreSetGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SstoreSetGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2033,71 +2409,27 @@ func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_ChainConfig                      protoreflect.MessageDescriptor
-	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
-	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
-	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
-	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
-	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
-	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
-	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
-	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
-	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
-	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
-	fd_ChainConfig_denom                protoreflect.FieldDescriptor
-	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
-	fd_ChainConfig_shanghai_time        protoreflect.FieldDescriptor
-	fd_ChainConfig_cancun_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_prague_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_verkle_time          protoreflect.FieldDescriptor
-	fd_ChainConfig_osaka_time           protoreflect.FieldDescriptor
+	md_AccessControl        protoreflect.MessageDescriptor
+	fd_AccessControl_create protoreflect.FieldDescriptor
+	fd_AccessControl_call   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
-	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
-	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
-	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
-	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
-	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
-	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
-	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
-	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
-	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
-	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
-	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
-	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
-	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
-	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
-	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
-	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
-	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
-	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
-	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
-	fd_ChainConfig_shanghai_time = md_ChainConfig.Fields().ByName("shanghai_time")
-	fd_ChainConfig_cancun_time = md_ChainConfig.Fields().ByName("cancun_time")
-	fd_ChainConfig_prague_time = md_ChainConfig.Fields().ByName("prague_time")
-	fd_ChainConfig_verkle_time = md_ChainConfig.Fields().ByName("verkle_time")
-	fd_ChainConfig_osaka_time = md_ChainConfig.Fields().ByName("osaka_time")
+	md_AccessControl = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControl")
+	fd_AccessControl_create = md_AccessControl.Fields().ByName("create")
+	fd_AccessControl_call = md_AccessControl.Fields().ByName("call")
 }
 
-var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessControl)(nil)
 
-type fastReflection_ChainConfig ChainConfig
+type fastReflection_AccessControl AccessControl
 
-func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(x)
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControl)(x)
 }
 
-func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+func (x *AccessControl) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2109,43 +2441,43 @@ func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
-var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+var _fastReflection_AccessControl_messageType fastReflection_AccessControl_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControl_messageType{}
 
-type fastReflection_ChainConfig_messageType struct{}
+type fastReflection_AccessControl_messageType struct{}
 
-func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ChainConfig)(nil)
+func (x fastReflection_AccessControl_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControl)(nil)
 }
-func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x fastReflection_AccessControl_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
 }
-func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x fastReflection_AccessControl_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_ChainConfig
+func (x *fastReflection_AccessControl) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControl
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
-	return _fastReflection_ChainConfig_messageType
+func (x *fastReflection_AccessControl) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControl_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ChainConfig) New() protoreflect.Message {
-	return new(fastReflection_ChainConfig)
+func (x *fastReflection_AccessControl) New() protoreflect.Message {
+	return new(fastReflection_AccessControl)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
-	return (*ChainConfig)(x)
+func (x *fastReflection_AccessControl) Interface() protoreflect.ProtoMessage {
+	return (*AccessControl)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2153,148 +2485,16 @@ func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.HomesteadBlock != "" {
-		value := protoreflect.ValueOfString(x.HomesteadBlock)
-		if !f(fd_ChainConfig_homestead_block, value) {
-			return
-		}
-	}
-	if x.DaoForkBlock != "" {
-		value := protoreflect.ValueOfString(x.DaoForkBlock)
-		if !f(fd_ChainConfig_dao_fork_block, value) {
-			return
-		}
-	}
-	if x.DaoForkSupport != false {
-		value := protoreflect.ValueOfBool(x.DaoForkSupport)
-		if !f(fd_ChainConfig_dao_fork_support, value) {
-			return
-		}
-	}
-	if x.Eip150Block != "" {
-		value := protoreflect.ValueOfString(x.Eip150Block)
-		if !f(fd_ChainConfig_eip150_block, value) {
-			return
-		}
-	}
-	if x.Eip155Block != "" {
-		value := protoreflect.ValueOfString(x.Eip155Block)
-		if !f(fd_ChainConfig_eip155_block, value) {
-			return
-		}
-	}
-	if x.Eip158Block != "" {
-		value := protoreflect.ValueOfString(x.Eip158Block)
-		if !f(fd_ChainConfig_eip158_block, value) {
-			return
-		}
-	}
-	if x.ByzantiumBlock != "" {
-		value := protoreflect.ValueOfString(x.ByzantiumBlock)
-		if !f(fd_ChainConfig_byzantium_block, value) {
-			return
-		}
-	}
-	if x.ConstantinopleBlock != "" {
-		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
-		if !f(fd_ChainConfig_constantinople_block, value) {
-			return
-		}
-	}
-	if x.PetersburgBlock != "" {
-		value := protoreflect.ValueOfString(x.PetersburgBlock)
-		if !f(fd_ChainConfig_petersburg_block, value) {
-			return
-		}
-	}
-	if x.IstanbulBlock != "" {
-		value := protoreflect.ValueOfString(x.IstanbulBlock)
-		if !f(fd_ChainConfig_istanbul_block, value) {
-			return
-		}
-	}
-	if x.MuirGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
-		if !f(fd_ChainConfig_muir_glacier_block, value) {
-			return
-		}
-	}
-	if x.BerlinBlock != "" {
-		value := protoreflect.ValueOfString(x.BerlinBlock)
-		if !f(fd_ChainConfig_berlin_block, value) {
-			return
-		}
-	}
-	if x.LondonBlock != "" {
-		value := protoreflect.ValueOfString(x.LondonBlock)
-		if !f(fd_ChainConfig_london_block, value) {
-			return
-		}
-	}
-	if x.ArrowGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
-		if !f(fd_ChainConfig_arrow_glacier_block, value) {
-			return
-		}
-	}
-	if x.GrayGlacierBlock != "" {
-		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
-		if !f(fd_ChainConfig_gray_glacier_block, value) {
-			return
-		}
-	}
-	if x.MergeNetsplitBlock != "" {
-		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
-		if !f(fd_ChainConfig_merge_netsplit_block, value) {
-			return
-		}
-	}
-	if x.ChainId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ChainId)
-		if !f(fd_ChainConfig_chain_id, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_ChainConfig_denom, value) {
-			return
-		}
-	}
-	if x.Decimals != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Decimals)
-		if !f(fd_ChainConfig_decimals, value) {
-			return
-		}
-	}
-	if x.ShanghaiTime != "" {
-		value := protoreflect.ValueOfString(x.ShanghaiTime)
-		if !f(fd_ChainConfig_shanghai_time, value) {
-			return
-		}
-	}
-	if x.CancunTime != "" {
-		value := protoreflect.ValueOfString(x.CancunTime)
-		if !f(fd_ChainConfig_cancun_time, value) {
-			return
-		}
-	}
-	if x.PragueTime != "" {
-		value := protoreflect.ValueOfString(x.PragueTime)
-		if !f(fd_ChainConfig_prague_time, value) {
-			return
-		}
-	}
-	if x.VerkleTime != "" {
-		value := protoreflect.ValueOfString(x.VerkleTime)
-		if !f(fd_ChainConfig_verkle_time, value) {
+func (x *fastReflection_AccessControl) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Create != nil {
+		value := protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+		if !f(fd_AccessControl_create, value) {
 			return
 		}
 	}
-	if x.OsakaTime != "" {
-		value := protoreflect.ValueOfString(x.OsakaTime)
-		if !f(fd_ChainConfig_osaka_time, value) {
+	if x.Call != nil {
+		value := protoreflect.ValueOfMessage(x.Call.ProtoReflect())
+		if !f(fd_AccessControl_call, value) {
 			return
 		}
 	}
@@ -2311,61 +2511,17 @@ func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessControl) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return x.HomesteadBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return x.DaoForkBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return x.DaoForkSupport != false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return x.Eip150Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return x.Eip155Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return x.Eip158Block != ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return x.ByzantiumBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return x.ConstantinopleBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return x.PetersburgBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return x.IstanbulBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return x.MuirGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return x.BerlinBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return x.LondonBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return x.ArrowGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return x.GrayGlacierBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return x.MergeNetsplitBlock != ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return x.ChainId != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return x.Denom != ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return x.Decimals != uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		return x.ShanghaiTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		return x.CancunTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		return x.PragueTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		return x.VerkleTime != ""
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		return x.OsakaTime != ""
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		return x.Create != nil
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		return x.Call != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2375,61 +2531,17 @@ func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessControl) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = false
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = ""
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = ""
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = ""
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = uint64(0)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		x.ShanghaiTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		x.CancunTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		x.PragueTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		x.VerkleTime = ""
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		x.OsakaTime = ""
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		x.Create = nil
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		x.Call = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2439,85 +2551,19 @@ func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		value := x.HomesteadBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		value := x.DaoForkBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		value := x.DaoForkSupport
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		value := x.Eip150Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		value := x.Eip155Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		value := x.Eip158Block
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		value := x.ByzantiumBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		value := x.ConstantinopleBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		value := x.PetersburgBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		value := x.IstanbulBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		value := x.MuirGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		value := x.BerlinBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		value := x.LondonBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		value := x.ArrowGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		value := x.GrayGlacierBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		value := x.MergeNetsplitBlock
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		value := x.Decimals
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		value := x.ShanghaiTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		value := x.CancunTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		value := x.PragueTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		value := x.VerkleTime
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		value := x.OsakaTime
-		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		value := x.Create
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		value := x.Call
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2531,61 +2577,17 @@ func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessControl) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		x.HomesteadBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		x.DaoForkBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		x.DaoForkSupport = value.Bool()
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		x.Eip150Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		x.Eip155Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		x.Eip158Block = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		x.ByzantiumBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		x.ConstantinopleBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		x.PetersburgBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		x.IstanbulBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		x.MuirGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		x.BerlinBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		x.LondonBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		x.ArrowGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		x.GrayGlacierBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		x.MergeNetsplitBlock = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		x.ChainId = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		x.Denom = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		x.Decimals = value.Uint()
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		x.ShanghaiTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		x.CancunTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		x.PragueTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		x.VerkleTime = value.Interface().(string)
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		x.OsakaTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		x.Create = value.Message().Interface().(*AccessControlType)
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		x.Call = value.Message().Interface().(*AccessControlType)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2599,132 +2601,52 @@ func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		panic(fmt.Errorf("field shanghai_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		panic(fmt.Errorf("field cancun_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		panic(fmt.Errorf("field prague_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		panic(fmt.Errorf("field verkle_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		panic(fmt.Errorf("field osaka_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		if x.Create == nil {
+			x.Create = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Create.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		if x.Call == nil {
+			x.Call = new(AccessControlType)
+		}
+		return protoreflect.ValueOfMessage(x.Call.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessControl) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.london_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.decimals":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.AccessControl.create":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.AccessControl.call":
+		m := new(AccessControlType)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControl"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControl does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessControl) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControl", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2732,7 +2654,7 @@ func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessControl) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2743,7 +2665,7 @@ func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessControl) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2755,7 +2677,7 @@ func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ChainConfig) IsValid() bool {
+func (x *fastReflection_AccessControl) IsValid() bool {
 	return x != nil
 }
 
@@ -2765,9 +2687,9 @@ func (x *fastReflection_ChainConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessControl) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2779,99 +2701,14 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.HomesteadBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DaoForkBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DaoForkSupport {
-			n += 2
-		}
-		l = len(x.Eip150Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip155Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Eip158Block)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ByzantiumBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ConstantinopleBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PetersburgBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.IstanbulBlock)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MuirGlacierBlock)
-		if l > 0 {
+		if x.Create != nil {
+			l = options.Size(x.Create)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BerlinBlock)
-		if l > 0 {
+		if x.Call != nil {
+			l = options.Size(x.Call)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.LondonBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ArrowGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GrayGlacierBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MergeNetsplitBlock)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 2 + runtime.Sov(uint64(x.ChainId))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		if x.Decimals != 0 {
-			n += 2 + runtime.Sov(uint64(x.Decimals))
-		}
-		l = len(x.ShanghaiTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CancunTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PragueTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VerkleTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.OsakaTime)
-		if l > 0 {
-			n += 2 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2882,7 +2719,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
+		x := input.Message.Interface().(*AccessControl)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2901,214 +2738,51 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.OsakaTime) > 0 {
-			i -= len(x.OsakaTime)
-			copy(dAtA[i:], x.OsakaTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OsakaTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xfa
-		}
-		if len(x.VerkleTime) > 0 {
-			i -= len(x.VerkleTime)
-			copy(dAtA[i:], x.VerkleTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerkleTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xf2
-		}
-		if len(x.PragueTime) > 0 {
-			i -= len(x.PragueTime)
-			copy(dAtA[i:], x.PragueTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PragueTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xea
-		}
-		if len(x.CancunTime) > 0 {
-			i -= len(x.CancunTime)
-			copy(dAtA[i:], x.CancunTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xe2
-		}
-		if len(x.ShanghaiTime) > 0 {
-			i -= len(x.ShanghaiTime)
-			copy(dAtA[i:], x.ShanghaiTime)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiTime)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xda
-		}
-		if x.Decimals != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xd0
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xca
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x1
+		if x.Call != nil {
+			encoded, err := options.Marshal(x.Call)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xc0
+			dAtA[i] = 0x12
 		}
-		if len(x.MergeNetsplitBlock) > 0 {
-			i -= len(x.MergeNetsplitBlock)
-			copy(dAtA[i:], x.MergeNetsplitBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
-			i--
-			dAtA[i] = 0x1
+		if x.Create != nil {
+			encoded, err := options.Marshal(x.Create)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xaa
+			dAtA[i] = 0xa
 		}
-		if len(x.GrayGlacierBlock) > 0 {
-			i -= len(x.GrayGlacierBlock)
-			copy(dAtA[i:], x.GrayGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		if len(x.ArrowGlacierBlock) > 0 {
-			i -= len(x.ArrowGlacierBlock)
-			copy(dAtA[i:], x.ArrowGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-		if len(x.LondonBlock) > 0 {
-			i -= len(x.LondonBlock)
-			copy(dAtA[i:], x.LondonBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
-		if len(x.BerlinBlock) > 0 {
-			i -= len(x.BerlinBlock)
-			copy(dAtA[i:], x.BerlinBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.MuirGlacierBlock) > 0 {
-			i -= len(x.MuirGlacierBlock)
-			copy(dAtA[i:], x.MuirGlacierBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
-			i--
-			dAtA[i] = 0x62
-		}
-		if len(x.IstanbulBlock) > 0 {
-			i -= len(x.IstanbulBlock)
-			copy(dAtA[i:], x.IstanbulBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
-			i--
-			dAtA[i] = 0x5a
-		}
-		if len(x.PetersburgBlock) > 0 {
-			i -= len(x.PetersburgBlock)
-			copy(dAtA[i:], x.PetersburgBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if len(x.ConstantinopleBlock) > 0 {
-			i -= len(x.ConstantinopleBlock)
-			copy(dAtA[i:], x.ConstantinopleBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
-			i--
-			dAtA[i] = 0x4a
-		}
-		if len(x.ByzantiumBlock) > 0 {
-			i -= len(x.ByzantiumBlock)
-			copy(dAtA[i:], x.ByzantiumBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.Eip158Block) > 0 {
-			i -= len(x.Eip158Block)
-			copy(dAtA[i:], x.Eip158Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Eip155Block) > 0 {
-			i -= len(x.Eip155Block)
-			copy(dAtA[i:], x.Eip155Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Eip150Block) > 0 {
-			i -= len(x.Eip150Block)
-			copy(dAtA[i:], x.Eip150Block)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.DaoForkSupport {
-			i--
-			if x.DaoForkSupport {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.DaoForkBlock) > 0 {
-			i -= len(x.DaoForkBlock)
-			copy(dAtA[i:], x.DaoForkBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.HomesteadBlock) > 0 {
-			i -= len(x.HomesteadBlock)
-			copy(dAtA[i:], x.HomesteadBlock)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ChainConfig)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControl)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
@@ -3135,17 +2809,17 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3155,81 +2829,33 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Create == nil {
+					x.Create = &AccessControlType{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Create); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DaoForkSupport = bool(v != 0)
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3239,81 +2865,1907 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip150Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
+				if x.Call == nil {
+					x.Call = &AccessControlType{}
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Call); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Eip155Block = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_AccessControlType_2_list)(nil)
+
+type _AccessControlType_2_list struct {
+	list *[]string
+}
+
+func (x *_AccessControlType_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessControlType_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessControlType_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessControlType_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessControlType_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessControlType at list field AccessControlList as it is not of Message kind"))
+}
+
+func (x *_AccessControlType_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessControlType_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessControlType_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_AccessControlType                     protoreflect.MessageDescriptor
+	fd_AccessControlType_access_type         protoreflect.FieldDescriptor
+	fd_AccessControlType_access_control_list protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_AccessControlType = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessControlType")
+	fd_AccessControlType_access_type = md_AccessControlType.Fields().ByName("access_type")
+	fd_AccessControlType_access_control_list = md_AccessControlType.Fields().ByName("access_control_list")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccessControlType)(nil)
+
+type fastReflection_AccessControlType AccessControlType
+
+func (x *AccessControlType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(x)
+}
+
+func (x *AccessControlType) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccessControlType_messageType fastReflection_AccessControlType_messageType
+var _ protoreflect.MessageType = fastReflection_AccessControlType_messageType{}
+
+type fastReflection_AccessControlType_messageType struct{}
+
+func (x fastReflection_AccessControlType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessControlType)(nil)
+}
+func (x fastReflection_AccessControlType_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
+}
+func (x fastReflection_AccessControlType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccessControlType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessControlType
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccessControlType) Type() protoreflect.MessageType {
+	return _fastReflection_AccessControlType_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccessControlType) New() protoreflect.Message {
+	return new(fastReflection_AccessControlType)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccessControlType) Interface() protoreflect.ProtoMessage {
+	return (*AccessControlType)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccessControlType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AccessType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AccessType))
+		if !f(fd_AccessControlType_access_type, value) {
+			return
+		}
+	}
+	if len(x.AccessControlList) != 0 {
+		value := protoreflect.ValueOfList(&_AccessControlType_2_list{list: &x.AccessControlList})
+		if !f(fd_AccessControlType_access_control_list, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccessControlType) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		return x.AccessType != 0
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		return len(x.AccessControlList) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		x.AccessType = 0
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		x.AccessControlList = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccessControlType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		value := x.AccessType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		if len(x.AccessControlList) == 0 {
+			return protoreflect.ValueOfList(&_AccessControlType_2_list{})
+		}
+		listValue := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		x.AccessType = (AccessType)(value.Enum())
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		lv := value.List()
+		clv := lv.(*_AccessControlType_2_list)
+		x.AccessControlList = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		if x.AccessControlList == nil {
+			x.AccessControlList = []string{}
+		}
+		value := &_AccessControlType_2_list{list: &x.AccessControlList}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		panic(fmt.Errorf("field access_type of message cosmos.evm.vm.v1.AccessControlType is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccessControlType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.AccessControlType.access_type":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.evm.vm.v1.AccessControlType.access_control_list":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessControlType_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessControlType"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessControlType does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccessControlType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessControlType", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccessControlType) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccessControlType) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccessControlType) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccessControlType) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AccessType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccessType))
+		}
+		if len(x.AccessControlList) > 0 {
+			for _, s := range x.AccessControlList {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AccessControlList) > 0 {
+			for iNdEx := len(x.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AccessControlList[iNdEx])
+				copy(dAtA[i:], x.AccessControlList[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessControlList[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.AccessType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccessType))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccessControlType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+				}
+				x.AccessType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccessType |= AccessType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccessControlList = append(x.AccessControlList, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ChainConfig                      protoreflect.MessageDescriptor
+	fd_ChainConfig_homestead_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_dao_fork_support     protoreflect.FieldDescriptor
+	fd_ChainConfig_eip150_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip155_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_eip158_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_byzantium_block      protoreflect.FieldDescriptor
+	fd_ChainConfig_constantinople_block protoreflect.FieldDescriptor
+	fd_ChainConfig_petersburg_block     protoreflect.FieldDescriptor
+	fd_ChainConfig_istanbul_block       protoreflect.FieldDescriptor
+	fd_ChainConfig_muir_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_berlin_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_london_block         protoreflect.FieldDescriptor
+	fd_ChainConfig_arrow_glacier_block  protoreflect.FieldDescriptor
+	fd_ChainConfig_gray_glacier_block   protoreflect.FieldDescriptor
+	fd_ChainConfig_merge_netsplit_block protoreflect.FieldDescriptor
+	fd_ChainConfig_chain_id             protoreflect.FieldDescriptor
+	fd_ChainConfig_denom                protoreflect.FieldDescriptor
+	fd_ChainConfig_decimals             protoreflect.FieldDescriptor
+	fd_ChainConfig_shanghai_time        protoreflect.FieldDescriptor
+	fd_ChainConfig_cancun_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_prague_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_verkle_time          protoreflect.FieldDescriptor
+	fd_ChainConfig_osaka_time           protoreflect.FieldDescriptor
+	fd_ChainConfig_p256_block           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_ChainConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("ChainConfig")
+	fd_ChainConfig_homestead_block = md_ChainConfig.Fields().ByName("homestead_block")
+	fd_ChainConfig_dao_fork_block = md_ChainConfig.Fields().ByName("dao_fork_block")
+	fd_ChainConfig_dao_fork_support = md_ChainConfig.Fields().ByName("dao_fork_support")
+	fd_ChainConfig_eip150_block = md_ChainConfig.Fields().ByName("eip150_block")
+	fd_ChainConfig_eip155_block = md_ChainConfig.Fields().ByName("eip155_block")
+	fd_ChainConfig_eip158_block = md_ChainConfig.Fields().ByName("eip158_block")
+	fd_ChainConfig_byzantium_block = md_ChainConfig.Fields().ByName("byzantium_block")
+	fd_ChainConfig_constantinople_block = md_ChainConfig.Fields().ByName("constantinople_block")
+	fd_ChainConfig_petersburg_block = md_ChainConfig.Fields().ByName("petersburg_block")
+	fd_ChainConfig_istanbul_block = md_ChainConfig.Fields().ByName("istanbul_block")
+	fd_ChainConfig_muir_glacier_block = md_ChainConfig.Fields().ByName("muir_glacier_block")
+	fd_ChainConfig_berlin_block = md_ChainConfig.Fields().ByName("berlin_block")
+	fd_ChainConfig_london_block = md_ChainConfig.Fields().ByName("london_block")
+	fd_ChainConfig_arrow_glacier_block = md_ChainConfig.Fields().ByName("arrow_glacier_block")
+	fd_ChainConfig_gray_glacier_block = md_ChainConfig.Fields().ByName("gray_glacier_block")
+	fd_ChainConfig_merge_netsplit_block = md_ChainConfig.Fields().ByName("merge_netsplit_block")
+	fd_ChainConfig_chain_id = md_ChainConfig.Fields().ByName("chain_id")
+	fd_ChainConfig_denom = md_ChainConfig.Fields().ByName("denom")
+	fd_ChainConfig_decimals = md_ChainConfig.Fields().ByName("decimals")
+	fd_ChainConfig_shanghai_time = md_ChainConfig.Fields().ByName("shanghai_time")
+	fd_ChainConfig_cancun_time = md_ChainConfig.Fields().ByName("cancun_time")
+	fd_ChainConfig_prague_time = md_ChainConfig.Fields().ByName("prague_time")
+	fd_ChainConfig_verkle_time = md_ChainConfig.Fields().ByName("verkle_time")
+	fd_ChainConfig_osaka_time = md_ChainConfig.Fields().ByName("osaka_time")
+	fd_ChainConfig_p256_block = md_ChainConfig.Fields().ByName("p256_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_ChainConfig)(nil)
+
+type fastReflection_ChainConfig ChainConfig
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(x)
+}
+
+func (x *ChainConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ChainConfig_messageType fastReflection_ChainConfig_messageType
+var _ protoreflect.MessageType = fastReflection_ChainConfig_messageType{}
+
+type fastReflection_ChainConfig_messageType struct{}
+
+func (x fastReflection_ChainConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ChainConfig)(nil)
+}
+func (x fastReflection_ChainConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+func (x fastReflection_ChainConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ChainConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_ChainConfig
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ChainConfig) Type() protoreflect.MessageType {
+	return _fastReflection_ChainConfig_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ChainConfig) New() protoreflect.Message {
+	return new(fastReflection_ChainConfig)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ChainConfig) Interface() protoreflect.ProtoMessage {
+	return (*ChainConfig)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ChainConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.HomesteadBlock != "" {
+		value := protoreflect.ValueOfString(x.HomesteadBlock)
+		if !f(fd_ChainConfig_homestead_block, value) {
+			return
+		}
+	}
+	if x.DaoForkBlock != "" {
+		value := protoreflect.ValueOfString(x.DaoForkBlock)
+		if !f(fd_ChainConfig_dao_fork_block, value) {
+			return
+		}
+	}
+	if x.DaoForkSupport != false {
+		value := protoreflect.ValueOfBool(x.DaoForkSupport)
+		if !f(fd_ChainConfig_dao_fork_support, value) {
+			return
+		}
+	}
+	if x.Eip150Block != "" {
+		value := protoreflect.ValueOfString(x.Eip150Block)
+		if !f(fd_ChainConfig_eip150_block, value) {
+			return
+		}
+	}
+	if x.Eip155Block != "" {
+		value := protoreflect.ValueOfString(x.Eip155Block)
+		if !f(fd_ChainConfig_eip155_block, value) {
+			return
+		}
+	}
+	if x.Eip158Block != "" {
+		value := protoreflect.ValueOfString(x.Eip158Block)
+		if !f(fd_ChainConfig_eip158_block, value) {
+			return
+		}
+	}
+	if x.ByzantiumBlock != "" {
+		value := protoreflect.ValueOfString(x.ByzantiumBlock)
+		if !f(fd_ChainConfig_byzantium_block, value) {
+			return
+		}
+	}
+	if x.ConstantinopleBlock != "" {
+		value := protoreflect.ValueOfString(x.ConstantinopleBlock)
+		if !f(fd_ChainConfig_constantinople_block, value) {
+			return
+		}
+	}
+	if x.PetersburgBlock != "" {
+		value := protoreflect.ValueOfString(x.PetersburgBlock)
+		if !f(fd_ChainConfig_petersburg_block, value) {
+			return
+		}
+	}
+	if x.IstanbulBlock != "" {
+		value := protoreflect.ValueOfString(x.IstanbulBlock)
+		if !f(fd_ChainConfig_istanbul_block, value) {
+			return
+		}
+	}
+	if x.MuirGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.MuirGlacierBlock)
+		if !f(fd_ChainConfig_muir_glacier_block, value) {
+			return
+		}
+	}
+	if x.BerlinBlock != "" {
+		value := protoreflect.ValueOfString(x.BerlinBlock)
+		if !f(fd_ChainConfig_berlin_block, value) {
+			return
+		}
+	}
+	if x.LondonBlock != "" {
+		value := protoreflect.ValueOfString(x.LondonBlock)
+		if !f(fd_ChainConfig_london_block, value) {
+			return
+		}
+	}
+	if x.ArrowGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.ArrowGlacierBlock)
+		if !f(fd_ChainConfig_arrow_glacier_block, value) {
+			return
+		}
+	}
+	if x.GrayGlacierBlock != "" {
+		value := protoreflect.ValueOfString(x.GrayGlacierBlock)
+		if !f(fd_ChainConfig_gray_glacier_block, value) {
+			return
+		}
+	}
+	if x.MergeNetsplitBlock != "" {
+		value := protoreflect.ValueOfString(x.MergeNetsplitBlock)
+		if !f(fd_ChainConfig_merge_netsplit_block, value) {
+			return
+		}
+	}
+	if x.ChainId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChainId)
+		if !f(fd_ChainConfig_chain_id, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ChainConfig_denom, value) {
+			return
+		}
+	}
+	if x.Decimals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Decimals)
+		if !f(fd_ChainConfig_decimals, value) {
+			return
+		}
+	}
+	if x.ShanghaiTime != "" {
+		value := protoreflect.ValueOfString(x.ShanghaiTime)
+		if !f(fd_ChainConfig_shanghai_time, value) {
+			return
+		}
+	}
+	if x.CancunTime != "" {
+		value := protoreflect.ValueOfString(x.CancunTime)
+		if !f(fd_ChainConfig_cancun_time, value) {
+			return
+		}
+	}
+	if x.PragueTime != "" {
+		value := protoreflect.ValueOfString(x.PragueTime)
+		if !f(fd_ChainConfig_prague_time, value) {
+			return
+		}
+	}
+	if x.VerkleTime != "" {
+		value := protoreflect.ValueOfString(x.VerkleTime)
+		if !f(fd_ChainConfig_verkle_time, value) {
+			return
+		}
+	}
+	if x.OsakaTime != "" {
+		value := protoreflect.ValueOfString(x.OsakaTime)
+		if !f(fd_ChainConfig_osaka_time, value) {
+			return
+		}
+	}
+	if x.P256Block != "" {
+		value := protoreflect.ValueOfString(x.P256Block)
+		if !f(fd_ChainConfig_p256_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ChainConfig) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return x.HomesteadBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return x.DaoForkBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return x.DaoForkSupport != false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return x.Eip150Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return x.Eip155Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return x.Eip158Block != ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return x.ByzantiumBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return x.ConstantinopleBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return x.PetersburgBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return x.IstanbulBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return x.MuirGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return x.BerlinBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return x.LondonBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return x.ArrowGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return x.GrayGlacierBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return x.MergeNetsplitBlock != ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return x.ChainId != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return x.Denom != ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return x.Decimals != uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		return x.ShanghaiTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		return x.CancunTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		return x.PragueTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		return x.VerkleTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		return x.OsakaTime != ""
+	case "cosmos.evm.vm.v1.ChainConfig.p256_block":
+		return x.P256Block != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = false
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = ""
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = ""
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = ""
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = uint64(0)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		x.ShanghaiTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		x.CancunTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		x.PragueTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		x.VerkleTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		x.OsakaTime = ""
+	case "cosmos.evm.vm.v1.ChainConfig.p256_block":
+		x.P256Block = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ChainConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		value := x.HomesteadBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		value := x.DaoForkBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		value := x.DaoForkSupport
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		value := x.Eip150Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		value := x.Eip155Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		value := x.Eip158Block
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		value := x.ByzantiumBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		value := x.ConstantinopleBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		value := x.PetersburgBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		value := x.IstanbulBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		value := x.MuirGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		value := x.BerlinBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		value := x.LondonBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		value := x.ArrowGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		value := x.GrayGlacierBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		value := x.MergeNetsplitBlock
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		value := x.Decimals
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		value := x.ShanghaiTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		value := x.CancunTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		value := x.PragueTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		value := x.VerkleTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		value := x.OsakaTime
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.ChainConfig.p256_block":
+		value := x.P256Block
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		x.HomesteadBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		x.DaoForkBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		x.DaoForkSupport = value.Bool()
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		x.Eip150Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		x.Eip155Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		x.Eip158Block = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		x.ByzantiumBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		x.ConstantinopleBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		x.PetersburgBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		x.IstanbulBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		x.MuirGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		x.BerlinBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		x.LondonBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		x.ArrowGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		x.GrayGlacierBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		x.MergeNetsplitBlock = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		x.ChainId = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		x.Decimals = value.Uint()
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		x.ShanghaiTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		x.CancunTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		x.PragueTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		x.VerkleTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		x.OsakaTime = value.Interface().(string)
+	case "cosmos.evm.vm.v1.ChainConfig.p256_block":
+		x.P256Block = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		panic(fmt.Errorf("field homestead_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		panic(fmt.Errorf("field dao_fork_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		panic(fmt.Errorf("field dao_fork_support of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		panic(fmt.Errorf("field eip150_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		panic(fmt.Errorf("field eip155_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		panic(fmt.Errorf("field eip158_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		panic(fmt.Errorf("field byzantium_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		panic(fmt.Errorf("field constantinople_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		panic(fmt.Errorf("field petersburg_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		panic(fmt.Errorf("field istanbul_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		panic(fmt.Errorf("field muir_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		panic(fmt.Errorf("field berlin_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		panic(fmt.Errorf("field london_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		panic(fmt.Errorf("field arrow_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		panic(fmt.Errorf("field gray_glacier_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		panic(fmt.Errorf("field merge_netsplit_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		panic(fmt.Errorf("field denom of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		panic(fmt.Errorf("field decimals of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		panic(fmt.Errorf("field shanghai_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		panic(fmt.Errorf("field cancun_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		panic(fmt.Errorf("field prague_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		panic(fmt.Errorf("field verkle_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		panic(fmt.Errorf("field osaka_time of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	case "cosmos.evm.vm.v1.ChainConfig.p256_block":
+		panic(fmt.Errorf("field p256_block of message cosmos.evm.vm.v1.ChainConfig is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ChainConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.ChainConfig.homestead_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.dao_fork_support":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.ChainConfig.eip150_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip155_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.eip158_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.byzantium_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.constantinople_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.petersburg_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.istanbul_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.muir_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.berlin_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.london_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.arrow_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.gray_glacier_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.merge_netsplit_block":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.chain_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.decimals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.ChainConfig.shanghai_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.cancun_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.prague_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.verkle_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.osaka_time":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.ChainConfig.p256_block":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.ChainConfig"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.ChainConfig does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ChainConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.ChainConfig", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ChainConfig) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ChainConfig) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ChainConfig) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.HomesteadBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DaoForkBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DaoForkSupport {
+			n += 2
+		}
+		l = len(x.Eip150Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip155Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Eip158Block)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ByzantiumBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ConstantinopleBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PetersburgBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IstanbulBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MuirGlacierBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BerlinBlock)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LondonBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ArrowGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GrayGlacierBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MergeNetsplitBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 2 + runtime.Sov(uint64(x.ChainId))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Decimals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Decimals))
+		}
+		l = len(x.ShanghaiTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CancunTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PragueTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VerkleTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OsakaTime)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.P256Block)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.P256Block) > 0 {
+			i -= len(x.P256Block)
+			copy(dAtA[i:], x.P256Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.P256Block)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.OsakaTime) > 0 {
+			i -= len(x.OsakaTime)
+			copy(dAtA[i:], x.OsakaTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OsakaTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.VerkleTime) > 0 {
+			i -= len(x.VerkleTime)
+			copy(dAtA[i:], x.VerkleTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VerkleTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if len(x.PragueTime) > 0 {
+			i -= len(x.PragueTime)
+			copy(dAtA[i:], x.PragueTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PragueTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+		if len(x.CancunTime) > 0 {
+			i -= len(x.CancunTime)
+			copy(dAtA[i:], x.CancunTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CancunTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.ShanghaiTime) > 0 {
+			i -= len(x.ShanghaiTime)
+			copy(dAtA[i:], x.ShanghaiTime)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShanghaiTime)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.Decimals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Decimals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if len(x.MergeNetsplitBlock) > 0 {
+			i -= len(x.MergeNetsplitBlock)
+			copy(dAtA[i:], x.MergeNetsplitBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MergeNetsplitBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.GrayGlacierBlock) > 0 {
+			i -= len(x.GrayGlacierBlock)
+			copy(dAtA[i:], x.GrayGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GrayGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.ArrowGlacierBlock) > 0 {
+			i -= len(x.ArrowGlacierBlock)
+			copy(dAtA[i:], x.ArrowGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArrowGlacierBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.LondonBlock) > 0 {
+			i -= len(x.LondonBlock)
+			copy(dAtA[i:], x.LondonBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LondonBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.BerlinBlock) > 0 {
+			i -= len(x.BerlinBlock)
+			copy(dAtA[i:], x.BerlinBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BerlinBlock)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MuirGlacierBlock) > 0 {
+			i -= len(x.MuirGlacierBlock)
+			copy(dAtA[i:], x.MuirGlacierBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MuirGlacierBlock)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.IstanbulBlock) > 0 {
+			i -= len(x.IstanbulBlock)
+			copy(dAtA[i:], x.IstanbulBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IstanbulBlock)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.PetersburgBlock) > 0 {
+			i -= len(x.PetersburgBlock)
+			copy(dAtA[i:], x.PetersburgBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PetersburgBlock)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.ConstantinopleBlock) > 0 {
+			i -= len(x.ConstantinopleBlock)
+			copy(dAtA[i:], x.ConstantinopleBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConstantinopleBlock)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ByzantiumBlock) > 0 {
+			i -= len(x.ByzantiumBlock)
+			copy(dAtA[i:], x.ByzantiumBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ByzantiumBlock)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Eip158Block) > 0 {
+			i -= len(x.Eip158Block)
+			copy(dAtA[i:], x.Eip158Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip158Block)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Eip155Block) > 0 {
+			i -= len(x.Eip155Block)
+			copy(dAtA[i:], x.Eip155Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip155Block)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Eip150Block) > 0 {
+			i -= len(x.Eip150Block)
+			copy(dAtA[i:], x.Eip150Block)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Eip150Block)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.DaoForkSupport {
+			i--
+			if x.DaoForkSupport {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DaoForkBlock) > 0 {
+			i -= len(x.DaoForkBlock)
+			copy(dAtA[i:], x.DaoForkBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DaoForkBlock)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.HomesteadBlock) > 0 {
+			i -= len(x.HomesteadBlock)
+			copy(dAtA[i:], x.HomesteadBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HomesteadBlock)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ChainConfig)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HomesteadBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HomesteadBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DaoForkBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DaoForkSupport", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DaoForkSupport = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip150Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip150Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip155Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Eip155Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Eip158Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
@@ -3770,46 +5222,562 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CancunTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 29:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PragueTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VerkleTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsakaTime", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OsakaTime = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 32:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field P256Block", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.P256Block = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_State       protoreflect.MessageDescriptor
+	fd_State_key   protoreflect.FieldDescriptor
+	fd_State_value protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_evm_proto_init()
+	md_State = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("State")
+	fd_State_key = md_State.Fields().ByName("key")
+	fd_State_value = md_State.Fields().ByName("value")
+}
+
+var _ protoreflect.Message = (*fastReflection_State)(nil)
+
+type fastReflection_State State
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_State)(x)
+}
+
+func (x *State) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_State_messageType fastReflection_State_messageType
+var _ protoreflect.MessageType = fastReflection_State_messageType{}
+
+type fastReflection_State_messageType struct{}
+
+func (x fastReflection_State_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_State)(nil)
+}
+func (x fastReflection_State_messageType) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
+	return md_State
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_State) Type() protoreflect.MessageType {
+	return _fastReflection_State_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_State) New() protoreflect.Message {
+	return new(fastReflection_State)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
+	return (*State)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Key != "" {
+		value := protoreflect.ValueOfString(x.Key)
+		if !f(fd_State_key, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_State_value, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		return x.Key != ""
+	case "cosmos.evm.vm.v1.State.value":
+		return x.Value != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		x.Key = ""
+	case "cosmos.evm.vm.v1.State.value":
+		x.Value = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		value := x.Key
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.State.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		x.Key = value.Interface().(string)
+	case "cosmos.evm.vm.v1.State.value":
+		x.Value = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.State is not mutable"))
+	case "cosmos.evm.vm.v1.State.value":
+		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.State is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.State.key":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.State.value":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.State", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_State) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*State)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CancunTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 29:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PragueTime", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.PragueTime = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 30:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VerkleTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3837,11 +5805,11 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.VerkleTime = string(dAtA[iNdEx:postIndex])
+				x.Key = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 31:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OsakaTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3869,7 +5837,7 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.OsakaTime = string(dAtA[iNdEx:postIndex])
+				x.Value = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3906,29 +5874,80 @@ func (x *fastReflection_ChainConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_TransactionLogs_2_list)(nil)
+
+type _TransactionLogs_2_list struct {
+	list *[]*Log
+}
+
+func (x *_TransactionLogs_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_TransactionLogs_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_TransactionLogs_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_TransactionLogs_2_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_TransactionLogs_2_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_TransactionLogs_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_State       protoreflect.MessageDescriptor
-	fd_State_key   protoreflect.FieldDescriptor
-	fd_State_value protoreflect.FieldDescriptor
+	md_TransactionLogs      protoreflect.MessageDescriptor
+	fd_TransactionLogs_hash protoreflect.FieldDescriptor
+	fd_TransactionLogs_logs protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_State = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("State")
-	fd_State_key = md_State.Fields().ByName("key")
-	fd_State_value = md_State.Fields().ByName("value")
+	md_TransactionLogs = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TransactionLogs")
+	fd_TransactionLogs_hash = md_TransactionLogs.Fields().ByName("hash")
+	fd_TransactionLogs_logs = md_TransactionLogs.Fields().ByName("logs")
 }
 
-var _ protoreflect.Message = (*fastReflection_State)(nil)
+var _ protoreflect.Message = (*fastReflection_TransactionLogs)(nil)
 
-type fastReflection_State State
+type fastReflection_TransactionLogs TransactionLogs
 
-func (x *State) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_State)(x)
+func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TransactionLogs)(x)
 }
 
-func (x *State) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3939,43 +5958,43 @@ func (x *State) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_State_messageType fastReflection_State_messageType
-var _ protoreflect.MessageType = fastReflection_State_messageType{}
+var _fastReflection_TransactionLogs_messageType fastReflection_TransactionLogs_messageType
+var _ protoreflect.MessageType = fastReflection_TransactionLogs_messageType{}
 
-type fastReflection_State_messageType struct{}
+type fastReflection_TransactionLogs_messageType struct{}
 
-func (x fastReflection_State_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_State)(nil)
+func (x fastReflection_TransactionLogs_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TransactionLogs)(nil)
 }
-func (x fastReflection_State_messageType) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x fastReflection_TransactionLogs_messageType) New() protoreflect.Message {
+	return new(fastReflection_TransactionLogs)
 }
-func (x fastReflection_State_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x fastReflection_TransactionLogs_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransactionLogs
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_State) Descriptor() protoreflect.MessageDescriptor {
-	return md_State
+func (x *fastReflection_TransactionLogs) Descriptor() protoreflect.MessageDescriptor {
+	return md_TransactionLogs
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_State) Type() protoreflect.MessageType {
-	return _fastReflection_State_messageType
+func (x *fastReflection_TransactionLogs) Type() protoreflect.MessageType {
+	return _fastReflection_TransactionLogs_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_State) New() protoreflect.Message {
-	return new(fastReflection_State)
+func (x *fastReflection_TransactionLogs) New() protoreflect.Message {
+	return new(fastReflection_TransactionLogs)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
-	return (*State)(x)
+func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
+	return (*TransactionLogs)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3983,16 +6002,16 @@ func (x *fastReflection_State) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Key != "" {
-		value := protoreflect.ValueOfString(x.Key)
-		if !f(fd_State_key, value) {
+func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_TransactionLogs_hash, value) {
 			return
 		}
 	}
-	if x.Value != "" {
-		value := protoreflect.ValueOfString(x.Value)
-		if !f(fd_State_value, value) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &x.Logs})
+		if !f(fd_TransactionLogs_logs, value) {
 			return
 		}
 	}
@@ -4009,17 +6028,17 @@ func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		return x.Key != ""
-	case "cosmos.evm.vm.v1.State.value":
-		return x.Value != ""
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		return x.Hash != ""
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		return len(x.Logs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4029,17 +6048,17 @@ func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		x.Key = ""
-	case "cosmos.evm.vm.v1.State.value":
-		x.Value = ""
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		x.Hash = ""
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		x.Logs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4049,19 +6068,22 @@ func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		value := x.Key
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.State.value":
-		value := x.Value
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		value := x.Hash
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_TransactionLogs_2_list{})
+		}
+		listValue := &_TransactionLogs_2_list{list: &x.Logs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4075,17 +6097,19 @@ func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) prot
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		x.Key = value.Interface().(string)
-	case "cosmos.evm.vm.v1.State.value":
-		x.Value = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		x.Hash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		lv := value.List()
+		clv := lv.(*_TransactionLogs_2_list)
+		x.Logs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4099,44 +6123,49 @@ func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		panic(fmt.Errorf("field key of message cosmos.evm.vm.v1.State is not mutable"))
-	case "cosmos.evm.vm.v1.State.value":
-		panic(fmt.Errorf("field value of message cosmos.evm.vm.v1.State is not mutable"))
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
+		}
+		value := &_TransactionLogs_2_list{list: &x.Logs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.TransactionLogs is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TransactionLogs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.State.key":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.State.value":
+	case "cosmos.evm.vm.v1.TransactionLogs.hash":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.State"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.State does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.State", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TransactionLogs", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4144,7 +6173,7 @@ func (x *fastReflection_State) WhichOneof(d protoreflect.OneofDescriptor) protor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4155,7 +6184,7 @@ func (x *fastReflection_State) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4167,7 +6196,7 @@ func (x *fastReflection_State) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_State) IsValid() bool {
+func (x *fastReflection_TransactionLogs) IsValid() bool {
 	return x != nil
 }
 
@@ -4177,9 +6206,9 @@ func (x *fastReflection_State) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4191,13 +6220,15 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Key)
+		l = len(x.Hash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Value)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4209,7 +6240,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4228,17 +6259,26 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Value) > 0 {
-			i -= len(x.Value)
-			copy(dAtA[i:], x.Value)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
-		if len(x.Key) > 0 {
-			i -= len(x.Key)
-			copy(dAtA[i:], x.Key)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4253,7 +6293,7 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*State)
+		x := input.Message.Interface().(*TransactionLogs)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4285,15 +6325,15 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4321,13 +6361,13 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Key = string(dAtA[iNdEx:postIndex])
+				x.Hash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4337,23 +6377,25 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Value = string(dAtA[iNdEx:postIndex])
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4390,80 +6432,91 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_TransactionLogs_2_list)(nil)
+var _ protoreflect.List = (*_Log_2_list)(nil)
 
-type _TransactionLogs_2_list struct {
-	list *[]*Log
+type _Log_2_list struct {
+	list *[]string
 }
 
-func (x *_TransactionLogs_2_list) Len() int {
+func (x *_Log_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_TransactionLogs_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_Log_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_TransactionLogs_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+func (x *_Log_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_TransactionLogs_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
+func (x *_Log_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_TransactionLogs_2_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Log_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Log at list field Topics as it is not of Message kind"))
 }
 
-func (x *_TransactionLogs_2_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_Log_2_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_TransactionLogs_2_list) NewElement() protoreflect.Value {
-	v := new(Log)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_Log_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *_TransactionLogs_2_list) IsValid() bool {
+func (x *_Log_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_TransactionLogs      protoreflect.MessageDescriptor
-	fd_TransactionLogs_hash protoreflect.FieldDescriptor
-	fd_TransactionLogs_logs protoreflect.FieldDescriptor
+	md_Log                 protoreflect.MessageDescriptor
+	fd_Log_address         protoreflect.FieldDescriptor
+	fd_Log_topics          protoreflect.FieldDescriptor
+	fd_Log_data            protoreflect.FieldDescriptor
+	fd_Log_block_number    protoreflect.FieldDescriptor
+	fd_Log_tx_hash         protoreflect.FieldDescriptor
+	fd_Log_tx_index        protoreflect.FieldDescriptor
+	fd_Log_block_hash      protoreflect.FieldDescriptor
+	fd_Log_index           protoreflect.FieldDescriptor
+	fd_Log_removed         protoreflect.FieldDescriptor
+	fd_Log_block_timestamp protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TransactionLogs = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TransactionLogs")
-	fd_TransactionLogs_hash = md_TransactionLogs.Fields().ByName("hash")
-	fd_TransactionLogs_logs = md_TransactionLogs.Fields().ByName("logs")
+	md_Log = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Log")
+	fd_Log_address = md_Log.Fields().ByName("address")
+	fd_Log_topics = md_Log.Fields().ByName("topics")
+	fd_Log_data = md_Log.Fields().ByName("data")
+	fd_Log_block_number = md_Log.Fields().ByName("block_number")
+	fd_Log_tx_hash = md_Log.Fields().ByName("tx_hash")
+	fd_Log_tx_index = md_Log.Fields().ByName("tx_index")
+	fd_Log_block_hash = md_Log.Fields().ByName("block_hash")
+	fd_Log_index = md_Log.Fields().ByName("index")
+	fd_Log_removed = md_Log.Fields().ByName("removed")
+	fd_Log_block_timestamp = md_Log.Fields().ByName("block_timestamp")
 }
 
-var _ protoreflect.Message = (*fastReflection_TransactionLogs)(nil)
+var _ protoreflect.Message = (*fastReflection_Log)(nil)
 
-type fastReflection_TransactionLogs TransactionLogs
+type fastReflection_Log Log
 
-func (x *TransactionLogs) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TransactionLogs)(x)
+func (x *Log) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Log)(x)
 }
 
-func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+func (x *Log) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4474,43 +6527,43 @@ func (x *TransactionLogs) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TransactionLogs_messageType fastReflection_TransactionLogs_messageType
-var _ protoreflect.MessageType = fastReflection_TransactionLogs_messageType{}
+var _fastReflection_Log_messageType fastReflection_Log_messageType
+var _ protoreflect.MessageType = fastReflection_Log_messageType{}
 
-type fastReflection_TransactionLogs_messageType struct{}
+type fastReflection_Log_messageType struct{}
 
-func (x fastReflection_TransactionLogs_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TransactionLogs)(nil)
+func (x fastReflection_Log_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Log)(nil)
 }
-func (x fastReflection_TransactionLogs_messageType) New() protoreflect.Message {
-	return new(fastReflection_TransactionLogs)
+func (x fastReflection_Log_messageType) New() protoreflect.Message {
+	return new(fastReflection_Log)
 }
-func (x fastReflection_TransactionLogs_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TransactionLogs
+func (x fastReflection_Log_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Log
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TransactionLogs) Descriptor() protoreflect.MessageDescriptor {
-	return md_TransactionLogs
+func (x *fastReflection_Log) Descriptor() protoreflect.MessageDescriptor {
+	return md_Log
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TransactionLogs) Type() protoreflect.MessageType {
-	return _fastReflection_TransactionLogs_messageType
+func (x *fastReflection_Log) Type() protoreflect.MessageType {
+	return _fastReflection_Log_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TransactionLogs) New() protoreflect.Message {
-	return new(fastReflection_TransactionLogs)
+func (x *fastReflection_Log) New() protoreflect.Message {
+	return new(fastReflection_Log)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
-	return (*TransactionLogs)(x)
+func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
+	return (*Log)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4518,16 +6571,64 @@ func (x *fastReflection_TransactionLogs) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_TransactionLogs_hash, value) {
+func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Log_address, value) {
 			return
 		}
 	}
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &x.Logs})
-		if !f(fd_TransactionLogs_logs, value) {
+	if len(x.Topics) != 0 {
+		value := protoreflect.ValueOfList(&_Log_2_list{list: &x.Topics})
+		if !f(fd_Log_topics, value) {
+			return
+		}
+	}
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_Log_data, value) {
+			return
+		}
+	}
+	if x.BlockNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockNumber)
+		if !f(fd_Log_block_number, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_Log_tx_hash, value) {
+			return
+		}
+	}
+	if x.TxIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TxIndex)
+		if !f(fd_Log_tx_index, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_Log_block_hash, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_Log_index, value) {
+			return
+		}
+	}
+	if x.Removed != false {
+		value := protoreflect.ValueOfBool(x.Removed)
+		if !f(fd_Log_removed, value) {
+			return
+		}
+	}
+	if x.BlockTimestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockTimestamp)
+		if !f(fd_Log_block_timestamp, value) {
 			return
 		}
 	}
@@ -4544,17 +6645,33 @@ func (x *fastReflection_TransactionLogs) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		return x.Hash != ""
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		return len(x.Logs) != 0
+	case "cosmos.evm.vm.v1.Log.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.Log.topics":
+		return len(x.Topics) != 0
+	case "cosmos.evm.vm.v1.Log.data":
+		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.Log.block_number":
+		return x.BlockNumber != uint64(0)
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		return x.TxHash != ""
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		return x.TxIndex != uint64(0)
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.Log.index":
+		return x.Index != uint64(0)
+	case "cosmos.evm.vm.v1.Log.removed":
+		return x.Removed != false
+	case "cosmos.evm.vm.v1.Log.block_timestamp":
+		return x.BlockTimestamp != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4564,17 +6681,33 @@ func (x *fastReflection_TransactionLogs) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		x.Hash = ""
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		x.Logs = nil
+	case "cosmos.evm.vm.v1.Log.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.Log.topics":
+		x.Topics = nil
+	case "cosmos.evm.vm.v1.Log.data":
+		x.Data = nil
+	case "cosmos.evm.vm.v1.Log.block_number":
+		x.BlockNumber = uint64(0)
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		x.TxHash = ""
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		x.TxIndex = uint64(0)
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.Log.index":
+		x.Index = uint64(0)
+	case "cosmos.evm.vm.v1.Log.removed":
+		x.Removed = false
+	case "cosmos.evm.vm.v1.Log.block_timestamp":
+		x.BlockTimestamp = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4584,22 +6717,46 @@ func (x *fastReflection_TransactionLogs) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		value := x.Hash
+	case "cosmos.evm.vm.v1.Log.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_TransactionLogs_2_list{})
+	case "cosmos.evm.vm.v1.Log.topics":
+		if len(x.Topics) == 0 {
+			return protoreflect.ValueOfList(&_Log_2_list{})
 		}
-		listValue := &_TransactionLogs_2_list{list: &x.Logs}
+		listValue := &_Log_2_list{list: &x.Topics}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.Log.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.Log.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		value := x.TxIndex
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.Log.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.Log.removed":
+		value := x.Removed
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.Log.block_timestamp":
+		value := x.BlockTimestamp
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4613,19 +6770,35 @@ func (x *fastReflection_TransactionLogs) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		x.Hash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
+	case "cosmos.evm.vm.v1.Log.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Log.topics":
 		lv := value.List()
-		clv := lv.(*_TransactionLogs_2_list)
-		x.Logs = *clv.list
+		clv := lv.(*_Log_2_list)
+		x.Topics = *clv.list
+	case "cosmos.evm.vm.v1.Log.data":
+		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.Log.block_number":
+		x.BlockNumber = value.Uint()
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		x.TxIndex = value.Uint()
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Log.index":
+		x.Index = value.Uint()
+	case "cosmos.evm.vm.v1.Log.removed":
+		x.Removed = value.Bool()
+	case "cosmos.evm.vm.v1.Log.block_timestamp":
+		x.BlockTimestamp = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4639,49 +6812,81 @@ func (x *fastReflection_TransactionLogs) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
+func (x *fastReflection_Log) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.Log.topics":
+		if x.Topics == nil {
+			x.Topics = []string{}
 		}
-		value := &_TransactionLogs_2_list{list: &x.Logs}
+		value := &_Log_2_list{list: &x.Topics}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
-		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.TransactionLogs is not mutable"))
+	case "cosmos.evm.vm.v1.Log.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		panic(fmt.Errorf("field tx_index of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.index":
+		panic(fmt.Errorf("field index of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.removed":
+		panic(fmt.Errorf("field removed of message cosmos.evm.vm.v1.Log is not mutable"))
+	case "cosmos.evm.vm.v1.Log.block_timestamp":
+		panic(fmt.Errorf("field block_timestamp of message cosmos.evm.vm.v1.Log is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TransactionLogs) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Log) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TransactionLogs.hash":
+	case "cosmos.evm.vm.v1.Log.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TransactionLogs.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_TransactionLogs_2_list{list: &list})
+	case "cosmos.evm.vm.v1.Log.topics":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Log_2_list{list: &list})
+	case "cosmos.evm.vm.v1.Log.data":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.Log.block_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Log.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.Log.tx_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Log.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.Log.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.Log.removed":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.Log.block_timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TransactionLogs"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TransactionLogs does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TransactionLogs", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Log", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4689,7 +6894,7 @@ func (x *fastReflection_TransactionLogs) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4700,7 +6905,7 @@ func (x *fastReflection_TransactionLogs) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4712,7 +6917,7 @@ func (x *fastReflection_TransactionLogs) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TransactionLogs) IsValid() bool {
+func (x *fastReflection_Log) IsValid() bool {
 	return x != nil
 }
 
@@ -4722,9 +6927,9 @@ func (x *fastReflection_TransactionLogs) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4736,16 +6941,43 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
-				l = options.Size(e)
+		if len(x.Topics) > 0 {
+			for _, s := range x.Topics {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TxIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxIndex))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Removed {
+			n += 2
+		}
+		if x.BlockTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTimestamp))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4756,7 +6988,7 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4775,26 +7007,70 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.BlockTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestamp))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.Removed {
+			i--
+			if x.Removed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.TxIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Topics) > 0 {
+			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Topics[iNdEx])
+				copy(dAtA[i:], x.Topics[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
 				i--
 				dAtA[i] = 0x12
 			}
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4809,7 +7085,7 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TransactionLogs)
+		x := input.Message.Interface().(*Log)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4828,28 +7104,145 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TransactionLogs: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4877,13 +7270,32 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.TxHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				}
+				x.TxIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4893,146 +7305,148 @@ func (x *fastReflection_TransactionLogs) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_Log_2_list)(nil)
-
-type _Log_2_list struct {
-	list *[]string
-}
-
-func (x *_Log_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Log_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_Log_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Log_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Log_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Log at list field Topics as it is not of Message kind"))
-}
-
-func (x *_Log_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Log_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
+				x.Removed = bool(v != 0)
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				}
+				x.BlockTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTimestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x *_Log_2_list) IsValid() bool {
-	return x.list != nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_Log                 protoreflect.MessageDescriptor
-	fd_Log_address         protoreflect.FieldDescriptor
-	fd_Log_topics          protoreflect.FieldDescriptor
-	fd_Log_data            protoreflect.FieldDescriptor
-	fd_Log_block_number    protoreflect.FieldDescriptor
-	fd_Log_tx_hash         protoreflect.FieldDescriptor
-	fd_Log_tx_index        protoreflect.FieldDescriptor
-	fd_Log_block_hash      protoreflect.FieldDescriptor
-	fd_Log_index           protoreflect.FieldDescriptor
-	fd_Log_removed         protoreflect.FieldDescriptor
-	fd_Log_block_timestamp protoreflect.FieldDescriptor
+	md_TxResult                  protoreflect.MessageDescriptor
+	fd_TxResult_contract_address protoreflect.FieldDescriptor
+	fd_TxResult_bloom            protoreflect.FieldDescriptor
+	fd_TxResult_tx_logs          protoreflect.FieldDescriptor
+	fd_TxResult_ret              protoreflect.FieldDescriptor
+	fd_TxResult_reverted         protoreflect.FieldDescriptor
+	fd_TxResult_gas_used         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_Log = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Log")
-	fd_Log_address = md_Log.Fields().ByName("address")
-	fd_Log_topics = md_Log.Fields().ByName("topics")
-	fd_Log_data = md_Log.Fields().ByName("data")
-	fd_Log_block_number = md_Log.Fields().ByName("block_number")
-	fd_Log_tx_hash = md_Log.Fields().ByName("tx_hash")
-	fd_Log_tx_index = md_Log.Fields().ByName("tx_index")
-	fd_Log_block_hash = md_Log.Fields().ByName("block_hash")
-	fd_Log_index = md_Log.Fields().ByName("index")
-	fd_Log_removed = md_Log.Fields().ByName("removed")
-	fd_Log_block_timestamp = md_Log.Fields().ByName("block_timestamp")
+	md_TxResult = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TxResult")
+	fd_TxResult_contract_address = md_TxResult.Fields().ByName("contract_address")
+	fd_TxResult_bloom = md_TxResult.Fields().ByName("bloom")
+	fd_TxResult_tx_logs = md_TxResult.Fields().ByName("tx_logs")
+	fd_TxResult_ret = md_TxResult.Fields().ByName("ret")
+	fd_TxResult_reverted = md_TxResult.Fields().ByName("reverted")
+	fd_TxResult_gas_used = md_TxResult.Fields().ByName("gas_used")
 }
 
-var _ protoreflect.Message = (*fastReflection_Log)(nil)
+var _ protoreflect.Message = (*fastReflection_TxResult)(nil)
 
-type fastReflection_Log Log
+type fastReflection_TxResult TxResult
 
-func (x *Log) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Log)(x)
+func (x *TxResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxResult)(x)
 }
 
-func (x *Log) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+func (x *TxResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5043,43 +7457,43 @@ func (x *Log) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Log_messageType fastReflection_Log_messageType
-var _ protoreflect.MessageType = fastReflection_Log_messageType{}
+var _fastReflection_TxResult_messageType fastReflection_TxResult_messageType
+var _ protoreflect.MessageType = fastReflection_TxResult_messageType{}
 
-type fastReflection_Log_messageType struct{}
+type fastReflection_TxResult_messageType struct{}
 
-func (x fastReflection_Log_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Log)(nil)
+func (x fastReflection_TxResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxResult)(nil)
 }
-func (x fastReflection_Log_messageType) New() protoreflect.Message {
-	return new(fastReflection_Log)
+func (x fastReflection_TxResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxResult)
 }
-func (x fastReflection_Log_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Log
+func (x fastReflection_TxResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxResult
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Log) Descriptor() protoreflect.MessageDescriptor {
-	return md_Log
+func (x *fastReflection_TxResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxResult
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Log) Type() protoreflect.MessageType {
-	return _fastReflection_Log_messageType
+func (x *fastReflection_TxResult) Type() protoreflect.MessageType {
+	return _fastReflection_TxResult_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Log) New() protoreflect.Message {
-	return new(fastReflection_Log)
+func (x *fastReflection_TxResult) New() protoreflect.Message {
+	return new(fastReflection_TxResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
-	return (*Log)(x)
+func (x *fastReflection_TxResult) Interface() protoreflect.ProtoMessage {
+	return (*TxResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5087,64 +7501,40 @@ func (x *fastReflection_Log) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Log_address, value) {
-			return
-		}
-	}
-	if len(x.Topics) != 0 {
-		value := protoreflect.ValueOfList(&_Log_2_list{list: &x.Topics})
-		if !f(fd_Log_topics, value) {
-			return
-		}
-	}
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_Log_data, value) {
-			return
-		}
-	}
-	if x.BlockNumber != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockNumber)
-		if !f(fd_Log_block_number, value) {
-			return
-		}
-	}
-	if x.TxHash != "" {
-		value := protoreflect.ValueOfString(x.TxHash)
-		if !f(fd_Log_tx_hash, value) {
+func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_TxResult_contract_address, value) {
 			return
 		}
 	}
-	if x.TxIndex != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.TxIndex)
-		if !f(fd_Log_tx_index, value) {
+	if len(x.Bloom) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bloom)
+		if !f(fd_TxResult_bloom, value) {
 			return
 		}
 	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_Log_block_hash, value) {
+	if x.TxLogs != nil {
+		value := protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
+		if !f(fd_TxResult_tx_logs, value) {
 			return
 		}
 	}
-	if x.Index != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Index)
-		if !f(fd_Log_index, value) {
+	if len(x.Ret) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ret)
+		if !f(fd_TxResult_ret, value) {
 			return
 		}
 	}
-	if x.Removed != false {
-		value := protoreflect.ValueOfBool(x.Removed)
-		if !f(fd_Log_removed, value) {
+	if x.Reverted != false {
+		value := protoreflect.ValueOfBool(x.Reverted)
+		if !f(fd_TxResult_reverted, value) {
 			return
 		}
 	}
-	if x.BlockTimestamp != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlockTimestamp)
-		if !f(fd_Log_block_timestamp, value) {
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_TxResult_gas_used, value) {
 			return
 		}
 	}
@@ -5161,33 +7551,25 @@ func (x *fastReflection_Log) Range(f func(protoreflect.FieldDescriptor, protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		return x.Address != ""
-	case "cosmos.evm.vm.v1.Log.topics":
-		return len(x.Topics) != 0
-	case "cosmos.evm.vm.v1.Log.data":
-		return len(x.Data) != 0
-	case "cosmos.evm.vm.v1.Log.block_number":
-		return x.BlockNumber != uint64(0)
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		return x.TxHash != ""
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		return x.TxIndex != uint64(0)
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.Log.index":
-		return x.Index != uint64(0)
-	case "cosmos.evm.vm.v1.Log.removed":
-		return x.Removed != false
-	case "cosmos.evm.vm.v1.Log.block_timestamp":
-		return x.BlockTimestamp != uint64(0)
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		return x.ContractAddress != ""
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		return len(x.Bloom) != 0
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		return x.TxLogs != nil
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		return len(x.Ret) != 0
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		return x.Reverted != false
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		return x.GasUsed != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5197,33 +7579,25 @@ func (x *fastReflection_Log) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		x.Address = ""
-	case "cosmos.evm.vm.v1.Log.topics":
-		x.Topics = nil
-	case "cosmos.evm.vm.v1.Log.data":
-		x.Data = nil
-	case "cosmos.evm.vm.v1.Log.block_number":
-		x.BlockNumber = uint64(0)
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		x.TxHash = ""
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		x.TxIndex = uint64(0)
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.Log.index":
-		x.Index = uint64(0)
-	case "cosmos.evm.vm.v1.Log.removed":
-		x.Removed = false
-	case "cosmos.evm.vm.v1.Log.block_timestamp":
-		x.BlockTimestamp = uint64(0)
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		x.ContractAddress = ""
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		x.Bloom = nil
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		x.TxLogs = nil
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		x.Ret = nil
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		x.Reverted = false
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		x.GasUsed = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5233,46 +7607,31 @@ func (x *fastReflection_Log) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		value := x.Address
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Log.topics":
-		if len(x.Topics) == 0 {
-			return protoreflect.ValueOfList(&_Log_2_list{})
-		}
-		listValue := &_Log_2_list{list: &x.Topics}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.Log.data":
-		value := x.Data
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		value := x.Bloom
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.Log.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		value := x.TxHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		value := x.TxIndex
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Log.index":
-		value := x.Index
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.Log.removed":
-		value := x.Removed
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		value := x.TxLogs
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		value := x.Ret
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		value := x.Reverted
 		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.Log.block_timestamp":
-		value := x.BlockTimestamp
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		value := x.GasUsed
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5286,35 +7645,25 @@ func (x *fastReflection_Log) Get(descriptor protoreflect.FieldDescriptor) protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Log.topics":
-		lv := value.List()
-		clv := lv.(*_Log_2_list)
-		x.Topics = *clv.list
-	case "cosmos.evm.vm.v1.Log.data":
-		x.Data = value.Bytes()
-	case "cosmos.evm.vm.v1.Log.block_number":
-		x.BlockNumber = value.Uint()
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		x.TxHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		x.TxIndex = value.Uint()
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Log.index":
-		x.Index = value.Uint()
-	case "cosmos.evm.vm.v1.Log.removed":
-		x.Removed = value.Bool()
-	case "cosmos.evm.vm.v1.Log.block_timestamp":
-		x.BlockTimestamp = value.Uint()
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		x.Bloom = value.Bytes()
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		x.TxLogs = value.Message().Interface().(*TransactionLogs)
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		x.Ret = value.Bytes()
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		x.Reverted = value.Bool()
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		x.GasUsed = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5328,81 +7677,64 @@ func (x *fastReflection_Log) Set(fd protoreflect.FieldDescriptor, value protoref
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.topics":
-		if x.Topics == nil {
-			x.Topics = []string{}
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		if x.TxLogs == nil {
+			x.TxLogs = new(TransactionLogs)
 		}
-		value := &_Log_2_list{list: &x.Topics}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.Log.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		panic(fmt.Errorf("field tx_hash of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		panic(fmt.Errorf("field tx_index of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.index":
-		panic(fmt.Errorf("field index of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.removed":
-		panic(fmt.Errorf("field removed of message cosmos.evm.vm.v1.Log is not mutable"))
-	case "cosmos.evm.vm.v1.Log.block_timestamp":
-		panic(fmt.Errorf("field block_timestamp of message cosmos.evm.vm.v1.Log is not mutable"))
+		return protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
+		panic(fmt.Errorf("field contract_address of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.bloom":
+		panic(fmt.Errorf("field bloom of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.reverted":
+		panic(fmt.Errorf("field reverted of message cosmos.evm.vm.v1.TxResult is not mutable"))
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
+		panic(fmt.Errorf("field gas_used of message cosmos.evm.vm.v1.TxResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Log) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Log.address":
+	case "cosmos.evm.vm.v1.TxResult.contract_address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Log.topics":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Log_2_list{list: &list})
-	case "cosmos.evm.vm.v1.Log.data":
+	case "cosmos.evm.vm.v1.TxResult.bloom":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.Log.block_number":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.Log.tx_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Log.tx_index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.Log.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Log.index":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.Log.removed":
+	case "cosmos.evm.vm.v1.TxResult.tx_logs":
+		m := new(TransactionLogs)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.TxResult.ret":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.TxResult.reverted":
 		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.Log.block_timestamp":
+	case "cosmos.evm.vm.v1.TxResult.gas_used":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Log"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Log does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Log", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TxResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5410,7 +7742,7 @@ func (x *fastReflection_Log) WhichOneof(d protoreflect.OneofDescriptor) protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5421,7 +7753,7 @@ func (x *fastReflection_Log) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5433,7 +7765,7 @@ func (x *fastReflection_Log) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Log) IsValid() bool {
+func (x *fastReflection_TxResult) IsValid() bool {
 	return x != nil
 }
 
@@ -5443,9 +7775,9 @@ func (x *fastReflection_Log) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*TxResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5457,42 +7789,27 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.ContractAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Topics) > 0 {
-			for _, s := range x.Topics {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Data)
+		l = len(x.Bloom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.TxHash)
-		if l > 0 {
+		if x.TxLogs != nil {
+			l = options.Size(x.TxLogs)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TxIndex != 0 {
-			n += 1 + runtime.Sov(uint64(x.TxIndex))
-		}
-		l = len(x.BlockHash)
+		l = len(x.Ret)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Index != 0 {
-			n += 1 + runtime.Sov(uint64(x.Index))
-		}
-		if x.Removed {
+		if x.Reverted {
 			n += 2
 		}
-		if x.BlockTimestamp != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockTimestamp))
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5504,7 +7821,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*TxResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5523,70 +7840,53 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockTimestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimestamp))
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
 			i--
-			dAtA[i] = 0x50
+			dAtA[i] = 0x30
 		}
-		if x.Removed {
+		if x.Reverted {
 			i--
-			if x.Removed {
+			if x.Reverted {
 				dAtA[i] = 1
 			} else {
 				dAtA[i] = 0
 			}
 			i--
-			dAtA[i] = 0x48
-		}
-		if x.Index != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
-			i--
-			dAtA[i] = 0x40
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.TxIndex != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxIndex))
-			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.TxHash) > 0 {
-			i -= len(x.TxHash)
-			copy(dAtA[i:], x.TxHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
-			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x28
 		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if len(x.Ret) > 0 {
+			i -= len(x.Ret)
+			copy(dAtA[i:], x.Ret)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x22
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.TxLogs != nil {
+			encoded, err := options.Marshal(x.TxLogs)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Topics) > 0 {
-			for iNdEx := len(x.Topics) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Topics[iNdEx])
-				copy(dAtA[i:], x.Topics[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Topics[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Bloom) > 0 {
+			i -= len(x.Bloom)
+			copy(dAtA[i:], x.Bloom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bloom)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5601,7 +7901,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Log)
+		x := input.Message.Interface().(*TxResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5633,15 +7933,15 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Log: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5669,43 +7969,11 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Topics = append(x.Topics, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -5732,35 +8000,16 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Bloom = append(x.Bloom[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bloom == nil {
+					x.Bloom = []byte{}
 				}
 				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5770,48 +8019,33 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				if x.TxLogs == nil {
+					x.TxLogs = &TransactionLogs{}
 				}
-				x.TxIndex = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TxIndex |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxLogs); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			case 7:
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5821,46 +8055,29 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-				}
-				x.Index = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Index |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ret == nil {
+					x.Ret = []byte{}
 				}
-			case 9:
+				iNdEx = postIndex
+			case 5:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
 				}
 				var v int
 				for shift := uint(0); ; shift += 7 {
@@ -5877,12 +8094,12 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-				x.Removed = bool(v != 0)
-			case 10:
+				x.Reverted = bool(v != 0)
+			case 6:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 				}
-				x.BlockTimestamp = 0
+				x.GasUsed = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5892,7 +8109,7 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlockTimestamp |= uint64(b&0x7F) << shift
+					x.GasUsed |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -5932,37 +8149,75 @@ func (x *fastReflection_Log) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_AccessTuple_2_list)(nil)
+
+type _AccessTuple_2_list struct {
+	list *[]string
+}
+
+func (x *_AccessTuple_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_AccessTuple_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_AccessTuple_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_AccessTuple_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_AccessTuple_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message AccessTuple at list field StorageKeys as it is not of Message kind"))
+}
+
+func (x *_AccessTuple_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_AccessTuple_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_AccessTuple_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TxResult                  protoreflect.MessageDescriptor
-	fd_TxResult_contract_address protoreflect.FieldDescriptor
-	fd_TxResult_bloom            protoreflect.FieldDescriptor
-	fd_TxResult_tx_logs          protoreflect.FieldDescriptor
-	fd_TxResult_ret              protoreflect.FieldDescriptor
-	fd_TxResult_reverted         protoreflect.FieldDescriptor
-	fd_TxResult_gas_used         protoreflect.FieldDescriptor
+	md_AccessTuple              protoreflect.MessageDescriptor
+	fd_AccessTuple_address      protoreflect.FieldDescriptor
+	fd_AccessTuple_storage_keys protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TxResult = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TxResult")
-	fd_TxResult_contract_address = md_TxResult.Fields().ByName("contract_address")
-	fd_TxResult_bloom = md_TxResult.Fields().ByName("bloom")
-	fd_TxResult_tx_logs = md_TxResult.Fields().ByName("tx_logs")
-	fd_TxResult_ret = md_TxResult.Fields().ByName("ret")
-	fd_TxResult_reverted = md_TxResult.Fields().ByName("reverted")
-	fd_TxResult_gas_used = md_TxResult.Fields().ByName("gas_used")
+	md_AccessTuple = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessTuple")
+	fd_AccessTuple_address = md_AccessTuple.Fields().ByName("address")
+	fd_AccessTuple_storage_keys = md_AccessTuple.Fields().ByName("storage_keys")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxResult)(nil)
+var _ protoreflect.Message = (*fastReflection_AccessTuple)(nil)
 
-type fastReflection_TxResult TxResult
+type fastReflection_AccessTuple AccessTuple
 
-func (x *TxResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxResult)(x)
+func (x *AccessTuple) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccessTuple)(x)
 }
 
-func (x *TxResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5973,43 +8228,43 @@ func (x *TxResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxResult_messageType fastReflection_TxResult_messageType
-var _ protoreflect.MessageType = fastReflection_TxResult_messageType{}
+var _fastReflection_AccessTuple_messageType fastReflection_AccessTuple_messageType
+var _ protoreflect.MessageType = fastReflection_AccessTuple_messageType{}
 
-type fastReflection_TxResult_messageType struct{}
+type fastReflection_AccessTuple_messageType struct{}
 
-func (x fastReflection_TxResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxResult)(nil)
+func (x fastReflection_AccessTuple_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccessTuple)(nil)
 }
-func (x fastReflection_TxResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxResult)
+func (x fastReflection_AccessTuple_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccessTuple)
 }
-func (x fastReflection_TxResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxResult
+func (x fastReflection_AccessTuple_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessTuple
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxResult
+func (x *fastReflection_AccessTuple) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccessTuple
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxResult) Type() protoreflect.MessageType {
-	return _fastReflection_TxResult_messageType
+func (x *fastReflection_AccessTuple) Type() protoreflect.MessageType {
+	return _fastReflection_AccessTuple_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxResult) New() protoreflect.Message {
-	return new(fastReflection_TxResult)
+func (x *fastReflection_AccessTuple) New() protoreflect.Message {
+	return new(fastReflection_AccessTuple)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxResult) Interface() protoreflect.ProtoMessage {
-	return (*TxResult)(x)
+func (x *fastReflection_AccessTuple) Interface() protoreflect.ProtoMessage {
+	return (*AccessTuple)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6017,40 +8272,16 @@ func (x *fastReflection_TxResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ContractAddress != "" {
-		value := protoreflect.ValueOfString(x.ContractAddress)
-		if !f(fd_TxResult_contract_address, value) {
-			return
-		}
-	}
-	if len(x.Bloom) != 0 {
-		value := protoreflect.ValueOfBytes(x.Bloom)
-		if !f(fd_TxResult_bloom, value) {
-			return
-		}
-	}
-	if x.TxLogs != nil {
-		value := protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
-		if !f(fd_TxResult_tx_logs, value) {
-			return
-		}
-	}
-	if len(x.Ret) != 0 {
-		value := protoreflect.ValueOfBytes(x.Ret)
-		if !f(fd_TxResult_ret, value) {
-			return
-		}
-	}
-	if x.Reverted != false {
-		value := protoreflect.ValueOfBool(x.Reverted)
-		if !f(fd_TxResult_reverted, value) {
+func (x *fastReflection_AccessTuple) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_AccessTuple_address, value) {
 			return
 		}
 	}
-	if x.GasUsed != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasUsed)
-		if !f(fd_TxResult_gas_used, value) {
+	if len(x.StorageKeys) != 0 {
+		value := protoreflect.ValueOfList(&_AccessTuple_2_list{list: &x.StorageKeys})
+		if !f(fd_AccessTuple_storage_keys, value) {
 			return
 		}
 	}
@@ -6067,25 +8298,17 @@ func (x *fastReflection_TxResult) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AccessTuple) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		return x.ContractAddress != ""
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		return len(x.Bloom) != 0
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		return x.TxLogs != nil
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		return len(x.Ret) != 0
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		return x.Reverted != false
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		return x.GasUsed != uint64(0)
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		return len(x.StorageKeys) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6095,25 +8318,17 @@ func (x *fastReflection_TxResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AccessTuple) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		x.ContractAddress = ""
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		x.Bloom = nil
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		x.TxLogs = nil
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		x.Ret = nil
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		x.Reverted = false
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		x.GasUsed = uint64(0)
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		x.StorageKeys = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6123,31 +8338,22 @@ func (x *fastReflection_TxResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessTuple) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		value := x.ContractAddress
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		value := x.Bloom
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		value := x.TxLogs
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		value := x.Ret
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		value := x.Reverted
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		value := x.GasUsed
-		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		if len(x.StorageKeys) == 0 {
+			return protoreflect.ValueOfList(&_AccessTuple_2_list{})
+		}
+		listValue := &_AccessTuple_2_list{list: &x.StorageKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6161,25 +8367,19 @@ func (x *fastReflection_TxResult) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AccessTuple) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		x.ContractAddress = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		x.Bloom = value.Bytes()
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		x.TxLogs = value.Message().Interface().(*TransactionLogs)
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		x.Ret = value.Bytes()
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		x.Reverted = value.Bool()
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		x.GasUsed = value.Uint()
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		lv := value.List()
+		clv := lv.(*_AccessTuple_2_list)
+		x.StorageKeys = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6193,64 +8393,49 @@ func (x *fastReflection_TxResult) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessTuple) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		if x.TxLogs == nil {
-			x.TxLogs = new(TransactionLogs)
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		if x.StorageKeys == nil {
+			x.StorageKeys = []string{}
 		}
-		return protoreflect.ValueOfMessage(x.TxLogs.ProtoReflect())
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
-		panic(fmt.Errorf("field contract_address of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		panic(fmt.Errorf("field bloom of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		panic(fmt.Errorf("field reverted of message cosmos.evm.vm.v1.TxResult is not mutable"))
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		panic(fmt.Errorf("field gas_used of message cosmos.evm.vm.v1.TxResult is not mutable"))
+		value := &_AccessTuple_2_list{list: &x.StorageKeys}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.AccessTuple.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.AccessTuple is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AccessTuple) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TxResult.contract_address":
+	case "cosmos.evm.vm.v1.AccessTuple.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TxResult.bloom":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.TxResult.tx_logs":
-		m := new(TransactionLogs)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.TxResult.ret":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.TxResult.reverted":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TxResult.gas_used":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_AccessTuple_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TxResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TxResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AccessTuple) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TxResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessTuple", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6258,7 +8443,7 @@ func (x *fastReflection_TxResult) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AccessTuple) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6269,7 +8454,7 @@ func (x *fastReflection_TxResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AccessTuple) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6281,7 +8466,7 @@ func (x *fastReflection_TxResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxResult) IsValid() bool {
+func (x *fastReflection_AccessTuple) IsValid() bool {
 	return x != nil
 }
 
@@ -6291,9 +8476,9 @@ func (x *fastReflection_TxResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxResult)
+		x := input.Message.Interface().(*AccessTuple)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6305,27 +8490,15 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.ContractAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Bloom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TxLogs != nil {
-			l = options.Size(x.TxLogs)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Ret)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Reverted {
-			n += 2
-		}
-		if x.GasUsed != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		if len(x.StorageKeys) > 0 {
+			for _, s := range x.StorageKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6337,7 +8510,7 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxResult)
+		x := input.Message.Interface().(*AccessTuple)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6356,53 +8529,19 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.GasUsed != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.Reverted {
-			i--
-			if x.Reverted {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Ret) > 0 {
-			i -= len(x.Ret)
-			copy(dAtA[i:], x.Ret)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.TxLogs != nil {
-			encoded, err := options.Marshal(x.TxLogs)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.StorageKeys) > 0 {
+			for iNdEx := len(x.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.StorageKeys[iNdEx])
+				copy(dAtA[i:], x.StorageKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Bloom) > 0 {
-			i -= len(x.Bloom)
-			copy(dAtA[i:], x.Bloom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bloom)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.ContractAddress) > 0 {
-			i -= len(x.ContractAddress)
-			copy(dAtA[i:], x.ContractAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6417,7 +8556,7 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxResult)
+		x := input.Message.Interface().(*AccessTuple)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6449,15 +8588,15 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6485,83 +8624,13 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bloom", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Bloom = append(x.Bloom[:0], dAtA[iNdEx:postIndex]...)
-				if x.Bloom == nil {
-					x.Bloom = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxLogs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TxLogs == nil {
-					x.TxLogs = &TransactionLogs{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxLogs); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6571,65 +8640,24 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
-				if x.Ret == nil {
-					x.Ret = []byte{}
-				}
+				x.StorageKeys = append(x.StorageKeys, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Reverted = bool(v != 0)
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-				}
-				x.GasUsed = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasUsed |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6657,83 +8685,55 @@ func (x *fastReflection_TxResult) ProtoMethods() *protoiface.Methods {
 	return &protoiface.Methods{
 		NoUnkeyedLiterals: struct{}{},
 		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_AccessTuple_2_list)(nil)
-
-type _AccessTuple_2_list struct {
-	list *[]string
-}
-
-func (x *_AccessTuple_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_AccessTuple_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_AccessTuple_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_AccessTuple_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_AccessTuple_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message AccessTuple at list field StorageKeys as it is not of Message kind"))
-}
-
-func (x *_AccessTuple_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_AccessTuple_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_AccessTuple_2_list) IsValid() bool {
-	return x.list != nil
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
 
 var (
-	md_AccessTuple              protoreflect.MessageDescriptor
-	fd_AccessTuple_address      protoreflect.FieldDescriptor
-	fd_AccessTuple_storage_keys protoreflect.FieldDescriptor
+	md_TraceConfig                    protoreflect.MessageDescriptor
+	fd_TraceConfig_tracer             protoreflect.FieldDescriptor
+	fd_TraceConfig_timeout            protoreflect.FieldDescriptor
+	fd_TraceConfig_reexec             protoreflect.FieldDescriptor
+	fd_TraceConfig_disable_stack      protoreflect.FieldDescriptor
+	fd_TraceConfig_disable_storage    protoreflect.FieldDescriptor
+	fd_TraceConfig_debug              protoreflect.FieldDescriptor
+	fd_TraceConfig_limit              protoreflect.FieldDescriptor
+	fd_TraceConfig_overrides          protoreflect.FieldDescriptor
+	fd_TraceConfig_enable_memory      protoreflect.FieldDescriptor
+	fd_TraceConfig_enable_return_data protoreflect.FieldDescriptor
+	fd_TraceConfig_tracer_json_config protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_AccessTuple = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("AccessTuple")
-	fd_AccessTuple_address = md_AccessTuple.Fields().ByName("address")
-	fd_AccessTuple_storage_keys = md_AccessTuple.Fields().ByName("storage_keys")
+	md_TraceConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TraceConfig")
+	fd_TraceConfig_tracer = md_TraceConfig.Fields().ByName("tracer")
+	fd_TraceConfig_timeout = md_TraceConfig.Fields().ByName("timeout")
+	fd_TraceConfig_reexec = md_TraceConfig.Fields().ByName("reexec")
+	fd_TraceConfig_disable_stack = md_TraceConfig.Fields().ByName("disable_stack")
+	fd_TraceConfig_disable_storage = md_TraceConfig.Fields().ByName("disable_storage")
+	fd_TraceConfig_debug = md_TraceConfig.Fields().ByName("debug")
+	fd_TraceConfig_limit = md_TraceConfig.Fields().ByName("limit")
+	fd_TraceConfig_overrides = md_TraceConfig.Fields().ByName("overrides")
+	fd_TraceConfig_enable_memory = md_TraceConfig.Fields().ByName("enable_memory")
+	fd_TraceConfig_enable_return_data = md_TraceConfig.Fields().ByName("enable_return_data")
+	fd_TraceConfig_tracer_json_config = md_TraceConfig.Fields().ByName("tracer_json_config")
 }
 
-var _ protoreflect.Message = (*fastReflection_AccessTuple)(nil)
+var _ protoreflect.Message = (*fastReflection_TraceConfig)(nil)
 
-type fastReflection_AccessTuple AccessTuple
+type fastReflection_TraceConfig TraceConfig
 
-func (x *AccessTuple) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AccessTuple)(x)
+func (x *TraceConfig) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TraceConfig)(x)
 }
 
-func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6744,43 +8744,43 @@ func (x *AccessTuple) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AccessTuple_messageType fastReflection_AccessTuple_messageType
-var _ protoreflect.MessageType = fastReflection_AccessTuple_messageType{}
+var _fastReflection_TraceConfig_messageType fastReflection_TraceConfig_messageType
+var _ protoreflect.MessageType = fastReflection_TraceConfig_messageType{}
 
-type fastReflection_AccessTuple_messageType struct{}
+type fastReflection_TraceConfig_messageType struct{}
 
-func (x fastReflection_AccessTuple_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AccessTuple)(nil)
+func (x fastReflection_TraceConfig_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TraceConfig)(nil)
 }
-func (x fastReflection_AccessTuple_messageType) New() protoreflect.Message {
-	return new(fastReflection_AccessTuple)
+func (x fastReflection_TraceConfig_messageType) New() protoreflect.Message {
+	return new(fastReflection_TraceConfig)
 }
-func (x fastReflection_AccessTuple_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessTuple
+func (x fastReflection_TraceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TraceConfig
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AccessTuple) Descriptor() protoreflect.MessageDescriptor {
-	return md_AccessTuple
+func (x *fastReflection_TraceConfig) Descriptor() protoreflect.MessageDescriptor {
+	return md_TraceConfig
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AccessTuple) Type() protoreflect.MessageType {
-	return _fastReflection_AccessTuple_messageType
+func (x *fastReflection_TraceConfig) Type() protoreflect.MessageType {
+	return _fastReflection_TraceConfig_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AccessTuple) New() protoreflect.Message {
-	return new(fastReflection_AccessTuple)
+func (x *fastReflection_TraceConfig) New() protoreflect.Message {
+	return new(fastReflection_TraceConfig)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AccessTuple) Interface() protoreflect.ProtoMessage {
-	return (*AccessTuple)(x)
+func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
+	return (*TraceConfig)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6788,16 +8788,70 @@ func (x *fastReflection_AccessTuple) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AccessTuple) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_AccessTuple_address, value) {
+func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tracer != "" {
+		value := protoreflect.ValueOfString(x.Tracer)
+		if !f(fd_TraceConfig_tracer, value) {
 			return
 		}
 	}
-	if len(x.StorageKeys) != 0 {
-		value := protoreflect.ValueOfList(&_AccessTuple_2_list{list: &x.StorageKeys})
-		if !f(fd_AccessTuple_storage_keys, value) {
+	if x.Timeout != "" {
+		value := protoreflect.ValueOfString(x.Timeout)
+		if !f(fd_TraceConfig_timeout, value) {
+			return
+		}
+	}
+	if x.Reexec != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Reexec)
+		if !f(fd_TraceConfig_reexec, value) {
+			return
+		}
+	}
+	if x.DisableStack != false {
+		value := protoreflect.ValueOfBool(x.DisableStack)
+		if !f(fd_TraceConfig_disable_stack, value) {
+			return
+		}
+	}
+	if x.DisableStorage != false {
+		value := protoreflect.ValueOfBool(x.DisableStorage)
+		if !f(fd_TraceConfig_disable_storage, value) {
+			return
+		}
+	}
+	if x.Debug != false {
+		value := protoreflect.ValueOfBool(x.Debug)
+		if !f(fd_TraceConfig_debug, value) {
+			return
+		}
+	}
+	if x.Limit != int32(0) {
+		value := protoreflect.ValueOfInt32(x.Limit)
+		if !f(fd_TraceConfig_limit, value) {
+			return
+		}
+	}
+	if x.Overrides != nil {
+		value := protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
+		if !f(fd_TraceConfig_overrides, value) {
+			return
+		}
+	}
+	if x.EnableMemory != false {
+		value := protoreflect.ValueOfBool(x.EnableMemory)
+		if !f(fd_TraceConfig_enable_memory, value) {
+			return
+		}
+	}
+	if x.EnableReturnData != false {
+		value := protoreflect.ValueOfBool(x.EnableReturnData)
+		if !f(fd_TraceConfig_enable_return_data, value) {
+			return
+		}
+	}
+	if x.TracerJsonConfig != "" {
+		value := protoreflect.ValueOfString(x.TracerJsonConfig)
+		if !f(fd_TraceConfig_tracer_json_config, value) {
 			return
 		}
 	}
@@ -6814,17 +8868,35 @@ func (x *fastReflection_AccessTuple) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AccessTuple) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		return x.Address != ""
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		return len(x.StorageKeys) != 0
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		return x.Tracer != ""
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		return x.Timeout != ""
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		return x.Reexec != uint64(0)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		return x.DisableStack != false
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		return x.DisableStorage != false
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		return x.Debug != false
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		return x.Limit != int32(0)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		return x.Overrides != nil
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		return x.EnableMemory != false
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		return x.EnableReturnData != false
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		return x.TracerJsonConfig != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6834,17 +8906,35 @@ func (x *fastReflection_AccessTuple) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		x.Address = ""
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		x.StorageKeys = nil
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		x.Tracer = ""
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		x.Timeout = ""
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		x.Reexec = uint64(0)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		x.DisableStack = false
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		x.DisableStorage = false
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		x.Debug = false
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		x.Limit = int32(0)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		x.Overrides = nil
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		x.EnableMemory = false
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		x.EnableReturnData = false
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		x.TracerJsonConfig = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6854,22 +8944,46 @@ func (x *fastReflection_AccessTuple) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AccessTuple) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		value := x.Address
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		value := x.Tracer
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		value := x.Timeout
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		value := x.Reexec
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		value := x.DisableStack
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		value := x.DisableStorage
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		value := x.Debug
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		value := x.Limit
+		return protoreflect.ValueOfInt32(value)
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		value := x.EnableMemory
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		value := x.EnableReturnData
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		value := x.TracerJsonConfig
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		if len(x.StorageKeys) == 0 {
-			return protoreflect.ValueOfList(&_AccessTuple_2_list{})
-		}
-		listValue := &_AccessTuple_2_list{list: &x.StorageKeys}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6883,19 +8997,35 @@ func (x *fastReflection_AccessTuple) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		lv := value.List()
-		clv := lv.(*_AccessTuple_2_list)
-		x.StorageKeys = *clv.list
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		x.Tracer = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		x.Timeout = value.Interface().(string)
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		x.Reexec = value.Uint()
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		x.DisableStack = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		x.DisableStorage = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		x.Debug = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		x.Limit = int32(value.Int())
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		x.Overrides = value.Message().Interface().(*ChainConfig)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		x.EnableMemory = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		x.EnableReturnData = value.Bool()
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		x.TracerJsonConfig = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6909,49 +9039,84 @@ func (x *fastReflection_AccessTuple) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TraceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		if x.StorageKeys == nil {
-			x.StorageKeys = []string{}
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		if x.Overrides == nil {
+			x.Overrides = new(ChainConfig)
 		}
-		value := &_AccessTuple_2_list{list: &x.StorageKeys}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.AccessTuple.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.AccessTuple is not mutable"))
+		return protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		panic(fmt.Errorf("field tracer of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		panic(fmt.Errorf("field timeout of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		panic(fmt.Errorf("field reexec of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		panic(fmt.Errorf("field disable_stack of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		panic(fmt.Errorf("field disable_storage of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		panic(fmt.Errorf("field debug of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		panic(fmt.Errorf("field limit of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		panic(fmt.Errorf("field enable_memory of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		panic(fmt.Errorf("field enable_return_data of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+		panic(fmt.Errorf("field tracer_json_config of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AccessTuple) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TraceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.AccessTuple.address":
+	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.TraceConfig.reexec":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.debug":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.limit":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "cosmos.evm.vm.v1.TraceConfig.overrides":
+		m := new(ChainConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.AccessTuple.storage_keys":
-		list := []string{}
-		return protoreflect.ValueOfList(&_AccessTuple_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.AccessTuple"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.AccessTuple does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AccessTuple) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.AccessTuple", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TraceConfig", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6959,7 +9124,7 @@ func (x *fastReflection_AccessTuple) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AccessTuple) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6970,7 +9135,7 @@ func (x *fastReflection_AccessTuple) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AccessTuple) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6982,7 +9147,7 @@ func (x *fastReflection_AccessTuple) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AccessTuple) IsValid() bool {
+func (x *fastReflection_TraceConfig) IsValid() bool {
 	return x != nil
 }
 
@@ -6992,9 +9157,9 @@ func (x *fastReflection_AccessTuple) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AccessTuple)
+		x := input.Message.Interface().(*TraceConfig)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7006,15 +9171,42 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.Tracer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.StorageKeys) > 0 {
-			for _, s := range x.StorageKeys {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Timeout)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reexec != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reexec))
+		}
+		if x.DisableStack {
+			n += 2
+		}
+		if x.DisableStorage {
+			n += 2
+		}
+		if x.Debug {
+			n += 2
+		}
+		if x.Limit != 0 {
+			n += 1 + runtime.Sov(uint64(x.Limit))
+		}
+		if x.Overrides != nil {
+			l = options.Size(x.Overrides)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EnableMemory {
+			n += 2
+		}
+		if x.EnableReturnData {
+			n += 2
+		}
+		l = len(x.TracerJsonConfig)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7026,7 +9218,7 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AccessTuple)
+		x := input.Message.Interface().(*TraceConfig)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7045,19 +9237,98 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.StorageKeys) > 0 {
-			for iNdEx := len(x.StorageKeys) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.StorageKeys[iNdEx])
-				copy(dAtA[i:], x.StorageKeys[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StorageKeys[iNdEx])))
-				i--
-				dAtA[i] = 0x12
+		if len(x.TracerJsonConfig) > 0 {
+			i -= len(x.TracerJsonConfig)
+			copy(dAtA[i:], x.TracerJsonConfig)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TracerJsonConfig)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.EnableReturnData {
+			i--
+			if x.EnableReturnData {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
+			i--
+			dAtA[i] = 0x60
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.EnableMemory {
+			i--
+			if x.EnableMemory {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Overrides != nil {
+			encoded, err := options.Marshal(x.Overrides)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Limit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Debug {
+			i--
+			if x.Debug {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DisableStorage {
+			i--
+			if x.DisableStorage {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.DisableStack {
+			i--
+			if x.DisableStack {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Reexec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reexec))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Timeout) > 0 {
+			i -= len(x.Timeout)
+			copy(dAtA[i:], x.Timeout)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Timeout)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tracer) > 0 {
+			i -= len(x.Tracer)
+			copy(dAtA[i:], x.Tracer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tracer)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7072,7 +9343,7 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AccessTuple)
+		x := input.Message.Interface().(*TraceConfig)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7104,15 +9375,15 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccessTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7140,11 +9411,11 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Tracer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageKeys", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7172,7 +9443,213 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StorageKeys = append(x.StorageKeys, string(dAtA[iNdEx:postIndex]))
+				x.Timeout = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
+				}
+				x.Reexec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reexec |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableStack = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableStorage = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Debug = bool(v != 0)
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				}
+				x.Limit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Limit |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Overrides == nil {
+					x.Overrides = &ChainConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Overrides); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableMemory = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EnableReturnData = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7210,46 +9687,30 @@ func (x *fastReflection_AccessTuple) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TraceConfig                    protoreflect.MessageDescriptor
-	fd_TraceConfig_tracer             protoreflect.FieldDescriptor
-	fd_TraceConfig_timeout            protoreflect.FieldDescriptor
-	fd_TraceConfig_reexec             protoreflect.FieldDescriptor
-	fd_TraceConfig_disable_stack      protoreflect.FieldDescriptor
-	fd_TraceConfig_disable_storage    protoreflect.FieldDescriptor
-	fd_TraceConfig_debug              protoreflect.FieldDescriptor
-	fd_TraceConfig_limit              protoreflect.FieldDescriptor
-	fd_TraceConfig_overrides          protoreflect.FieldDescriptor
-	fd_TraceConfig_enable_memory      protoreflect.FieldDescriptor
-	fd_TraceConfig_enable_return_data protoreflect.FieldDescriptor
-	fd_TraceConfig_tracer_json_config protoreflect.FieldDescriptor
+	md_Preinstall         protoreflect.MessageDescriptor
+	fd_Preinstall_name    protoreflect.FieldDescriptor
+	fd_Preinstall_address protoreflect.FieldDescriptor
+	fd_Preinstall_code    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_TraceConfig = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("TraceConfig")
-	fd_TraceConfig_tracer = md_TraceConfig.Fields().ByName("tracer")
-	fd_TraceConfig_timeout = md_TraceConfig.Fields().ByName("timeout")
-	fd_TraceConfig_reexec = md_TraceConfig.Fields().ByName("reexec")
-	fd_TraceConfig_disable_stack = md_TraceConfig.Fields().ByName("disable_stack")
-	fd_TraceConfig_disable_storage = md_TraceConfig.Fields().ByName("disable_storage")
-	fd_TraceConfig_debug = md_TraceConfig.Fields().ByName("debug")
-	fd_TraceConfig_limit = md_TraceConfig.Fields().ByName("limit")
-	fd_TraceConfig_overrides = md_TraceConfig.Fields().ByName("overrides")
-	fd_TraceConfig_enable_memory = md_TraceConfig.Fields().ByName("enable_memory")
-	fd_TraceConfig_enable_return_data = md_TraceConfig.Fields().ByName("enable_return_data")
-	fd_TraceConfig_tracer_json_config = md_TraceConfig.Fields().ByName("tracer_json_config")
+	md_Preinstall = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Preinstall")
+	fd_Preinstall_name = md_Preinstall.Fields().ByName("name")
+	fd_Preinstall_address = md_Preinstall.Fields().ByName("address")
+	fd_Preinstall_code = md_Preinstall.Fields().ByName("code")
 }
 
-var _ protoreflect.Message = (*fastReflection_TraceConfig)(nil)
+var _ protoreflect.Message = (*fastReflection_Preinstall)(nil)
 
-type fastReflection_TraceConfig TraceConfig
+type fastReflection_Preinstall Preinstall
 
-func (x *TraceConfig) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TraceConfig)(x)
+func (x *Preinstall) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Preinstall)(x)
 }
 
-func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+func (x *Preinstall) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7260,43 +9721,43 @@ func (x *TraceConfig) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TraceConfig_messageType fastReflection_TraceConfig_messageType
-var _ protoreflect.MessageType = fastReflection_TraceConfig_messageType{}
+var _fastReflection_Preinstall_messageType fastReflection_Preinstall_messageType
+var _ protoreflect.MessageType = fastReflection_Preinstall_messageType{}
 
-type fastReflection_TraceConfig_messageType struct{}
+type fastReflection_Preinstall_messageType struct{}
 
-func (x fastReflection_TraceConfig_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TraceConfig)(nil)
+func (x fastReflection_Preinstall_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Preinstall)(nil)
 }
-func (x fastReflection_TraceConfig_messageType) New() protoreflect.Message {
-	return new(fastReflection_TraceConfig)
+func (x fastReflection_Preinstall_messageType) New() protoreflect.Message {
+	return new(fastReflection_Preinstall)
 }
-func (x fastReflection_TraceConfig_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TraceConfig
+func (x fastReflection_Preinstall_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Preinstall
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TraceConfig) Descriptor() protoreflect.MessageDescriptor {
-	return md_TraceConfig
+func (x *fastReflection_Preinstall) Descriptor() protoreflect.MessageDescriptor {
+	return md_Preinstall
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TraceConfig) Type() protoreflect.MessageType {
-	return _fastReflection_TraceConfig_messageType
+func (x *fastReflection_Preinstall) Type() protoreflect.MessageType {
+	return _fastReflection_Preinstall_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TraceConfig) New() protoreflect.Message {
-	return new(fastReflection_TraceConfig)
+func (x *fastReflection_Preinstall) New() protoreflect.Message {
+	return new(fastReflection_Preinstall)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
-	return (*TraceConfig)(x)
+func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
+	return (*Preinstall)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7304,70 +9765,22 @@ func (x *fastReflection_TraceConfig) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tracer != "" {
-		value := protoreflect.ValueOfString(x.Tracer)
-		if !f(fd_TraceConfig_tracer, value) {
-			return
-		}
-	}
-	if x.Timeout != "" {
-		value := protoreflect.ValueOfString(x.Timeout)
-		if !f(fd_TraceConfig_timeout, value) {
-			return
-		}
-	}
-	if x.Reexec != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Reexec)
-		if !f(fd_TraceConfig_reexec, value) {
-			return
-		}
-	}
-	if x.DisableStack != false {
-		value := protoreflect.ValueOfBool(x.DisableStack)
-		if !f(fd_TraceConfig_disable_stack, value) {
-			return
-		}
-	}
-	if x.DisableStorage != false {
-		value := protoreflect.ValueOfBool(x.DisableStorage)
-		if !f(fd_TraceConfig_disable_storage, value) {
-			return
-		}
-	}
-	if x.Debug != false {
-		value := protoreflect.ValueOfBool(x.Debug)
-		if !f(fd_TraceConfig_debug, value) {
-			return
-		}
-	}
-	if x.Limit != int32(0) {
-		value := protoreflect.ValueOfInt32(x.Limit)
-		if !f(fd_TraceConfig_limit, value) {
-			return
-		}
-	}
-	if x.Overrides != nil {
-		value := protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
-		if !f(fd_TraceConfig_overrides, value) {
-			return
-		}
-	}
-	if x.EnableMemory != false {
-		value := protoreflect.ValueOfBool(x.EnableMemory)
-		if !f(fd_TraceConfig_enable_memory, value) {
+func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_Preinstall_name, value) {
 			return
 		}
 	}
-	if x.EnableReturnData != false {
-		value := protoreflect.ValueOfBool(x.EnableReturnData)
-		if !f(fd_TraceConfig_enable_return_data, value) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Preinstall_address, value) {
 			return
 		}
 	}
-	if x.TracerJsonConfig != "" {
-		value := protoreflect.ValueOfString(x.TracerJsonConfig)
-		if !f(fd_TraceConfig_tracer_json_config, value) {
+	if x.Code != "" {
+		value := protoreflect.ValueOfString(x.Code)
+		if !f(fd_Preinstall_code, value) {
 			return
 		}
 	}
@@ -7384,35 +9797,19 @@ func (x *fastReflection_TraceConfig) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		return x.Tracer != ""
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		return x.Timeout != ""
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		return x.Reexec != uint64(0)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		return x.DisableStack != false
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		return x.DisableStorage != false
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		return x.Debug != false
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		return x.Limit != int32(0)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		return x.Overrides != nil
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		return x.EnableMemory != false
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		return x.EnableReturnData != false
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		return x.TracerJsonConfig != ""
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		return x.Name != ""
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		return x.Code != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7422,35 +9819,19 @@ func (x *fastReflection_TraceConfig) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		x.Tracer = ""
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		x.Timeout = ""
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		x.Reexec = uint64(0)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		x.DisableStack = false
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		x.DisableStorage = false
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		x.Debug = false
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		x.Limit = int32(0)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		x.Overrides = nil
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		x.EnableMemory = false
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		x.EnableReturnData = false
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		x.TracerJsonConfig = ""
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		x.Name = ""
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		x.Code = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7460,46 +9841,22 @@ func (x *fastReflection_TraceConfig) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		value := x.Tracer
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		value := x.Timeout
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		value := x.Reexec
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		value := x.DisableStack
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		value := x.DisableStorage
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		value := x.Debug
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		value := x.Limit
-		return protoreflect.ValueOfInt32(value)
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		value := x.Overrides
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		value := x.EnableMemory
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		value := x.EnableReturnData
-		return protoreflect.ValueOfBool(value)
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		value := x.TracerJsonConfig
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		value := x.Code
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7513,35 +9870,19 @@ func (x *fastReflection_TraceConfig) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		x.Tracer = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		x.Timeout = value.Interface().(string)
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		x.Reexec = value.Uint()
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		x.DisableStack = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		x.DisableStorage = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		x.Debug = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		x.Limit = int32(value.Int())
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		x.Overrides = value.Message().Interface().(*ChainConfig)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		x.EnableMemory = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		x.EnableReturnData = value.Bool()
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		x.TracerJsonConfig = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		x.Name = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		x.Code = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7555,84 +9896,48 @@ func (x *fastReflection_TraceConfig) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Preinstall) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		if x.Overrides == nil {
-			x.Overrides = new(ChainConfig)
-		}
-		return protoreflect.ValueOfMessage(x.Overrides.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
-		panic(fmt.Errorf("field tracer of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
-		panic(fmt.Errorf("field timeout of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		panic(fmt.Errorf("field reexec of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		panic(fmt.Errorf("field disable_stack of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		panic(fmt.Errorf("field disable_storage of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		panic(fmt.Errorf("field debug of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		panic(fmt.Errorf("field limit of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		panic(fmt.Errorf("field enable_memory of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		panic(fmt.Errorf("field enable_return_data of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
-		panic(fmt.Errorf("field tracer_json_config of message cosmos.evm.vm.v1.TraceConfig is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.name":
+		panic(fmt.Errorf("field name of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+	case "cosmos.evm.vm.v1.Preinstall.code":
+		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.Preinstall is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TraceConfig) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Preinstall) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.TraceConfig.tracer":
+	case "cosmos.evm.vm.v1.Preinstall.name":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TraceConfig.timeout":
+	case "cosmos.evm.vm.v1.Preinstall.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.TraceConfig.reexec":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.TraceConfig.disable_stack":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.disable_storage":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.debug":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.limit":
-		return protoreflect.ValueOfInt32(int32(0))
-	case "cosmos.evm.vm.v1.TraceConfig.overrides":
-		m := new(ChainConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.TraceConfig.enable_memory":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.enable_return_data":
-		return protoreflect.ValueOfBool(false)
-	case "cosmos.evm.vm.v1.TraceConfig.tracer_json_config":
+	case "cosmos.evm.vm.v1.Preinstall.code":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.TraceConfig"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.TraceConfig does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.TraceConfig", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Preinstall", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7640,7 +9945,7 @@ func (x *fastReflection_TraceConfig) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7651,7 +9956,7 @@ func (x *fastReflection_TraceConfig) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7663,7 +9968,7 @@ func (x *fastReflection_TraceConfig) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TraceConfig) IsValid() bool {
+func (x *fastReflection_Preinstall) IsValid() bool {
 	return x != nil
 }
 
@@ -7673,9 +9978,9 @@ func (x *fastReflection_TraceConfig) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*Preinstall)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7687,40 +9992,15 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Tracer)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Timeout)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Reexec != 0 {
-			n += 1 + runtime.Sov(uint64(x.Reexec))
-		}
-		if x.DisableStack {
-			n += 2
-		}
-		if x.DisableStorage {
-			n += 2
-		}
-		if x.Debug {
-			n += 2
-		}
-		if x.Limit != 0 {
-			n += 1 + runtime.Sov(uint64(x.Limit))
-		}
-		if x.Overrides != nil {
-			l = options.Size(x.Overrides)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.EnableMemory {
-			n += 2
-		}
-		if x.EnableReturnData {
-			n += 2
-		}
-		l = len(x.TracerJsonConfig)
+		l = len(x.Code)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7734,7 +10014,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*Preinstall)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7753,98 +10033,24 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TracerJsonConfig) > 0 {
-			i -= len(x.TracerJsonConfig)
-			copy(dAtA[i:], x.TracerJsonConfig)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TracerJsonConfig)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if x.EnableReturnData {
-			i--
-			if x.EnableReturnData {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x60
-		}
-		if x.EnableMemory {
-			i--
-			if x.EnableMemory {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.Overrides != nil {
-			encoded, err := options.Marshal(x.Overrides)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x52
-		}
-		if x.Limit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Limit))
-			i--
-			dAtA[i] = 0x48
-		}
-		if x.Debug {
-			i--
-			if x.Debug {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.DisableStorage {
-			i--
-			if x.DisableStorage {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x30
-		}
-		if x.DisableStack {
-			i--
-			if x.DisableStack {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.Reexec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reexec))
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Timeout) > 0 {
-			i -= len(x.Timeout)
-			copy(dAtA[i:], x.Timeout)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Timeout)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Tracer) > 0 {
-			i -= len(x.Tracer)
-			copy(dAtA[i:], x.Tracer)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tracer)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7859,7 +10065,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TraceConfig)
+		x := input.Message.Interface().(*Preinstall)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7891,47 +10097,15 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tracer", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Tracer = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7959,111 +10133,13 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Timeout = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reexec", wireType)
-				}
-				x.Reexec = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Reexec |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DisableStack = bool(v != 0)
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.DisableStorage = bool(v != 0)
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Debug = bool(v != 0)
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-				}
-				x.Limit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Limit |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8073,71 +10149,27 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Overrides == nil {
-					x.Overrides = &ChainConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Overrides); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 11:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableMemory", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.EnableMemory = bool(v != 0)
-			case 12:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnableReturnData", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.EnableReturnData = bool(v != 0)
-			case 13:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8165,7 +10197,7 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+				x.Code = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8203,30 +10235,30 @@ func (x *fastReflection_TraceConfig) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Preinstall         protoreflect.MessageDescriptor
-	fd_Preinstall_name    protoreflect.FieldDescriptor
-	fd_Preinstall_address protoreflect.FieldDescriptor
-	fd_Preinstall_code    protoreflect.FieldDescriptor
+	md_CodeMetadata              protoreflect.MessageDescriptor
+	fd_CodeMetadata_code_hash    protoreflect.FieldDescriptor
+	fd_CodeMetadata_publisher    protoreflect.FieldDescriptor
+	fd_CodeMetadata_metadata_uri protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_evm_proto_init()
-	md_Preinstall = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("Preinstall")
-	fd_Preinstall_name = md_Preinstall.Fields().ByName("name")
-	fd_Preinstall_address = md_Preinstall.Fields().ByName("address")
-	fd_Preinstall_code = md_Preinstall.Fields().ByName("code")
+	md_CodeMetadata = File_cosmos_evm_vm_v1_evm_proto.Messages().ByName("CodeMetadata")
+	fd_CodeMetadata_code_hash = md_CodeMetadata.Fields().ByName("code_hash")
+	fd_CodeMetadata_publisher = md_CodeMetadata.Fields().ByName("publisher")
+	fd_CodeMetadata_metadata_uri = md_CodeMetadata.Fields().ByName("metadata_uri")
 }
 
-var _ protoreflect.Message = (*fastReflection_Preinstall)(nil)
+var _ protoreflect.Message = (*fastReflection_CodeMetadata)(nil)
 
-type fastReflection_Preinstall Preinstall
+type fastReflection_CodeMetadata CodeMetadata
 
-func (x *Preinstall) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Preinstall)(x)
+func (x *CodeMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CodeMetadata)(x)
 }
 
-func (x *Preinstall) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+func (x *CodeMetadata) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8237,43 +10269,43 @@ func (x *Preinstall) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Preinstall_messageType fastReflection_Preinstall_messageType
-var _ protoreflect.MessageType = fastReflection_Preinstall_messageType{}
+var _fastReflection_CodeMetadata_messageType fastReflection_CodeMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_CodeMetadata_messageType{}
 
-type fastReflection_Preinstall_messageType struct{}
+type fastReflection_CodeMetadata_messageType struct{}
 
-func (x fastReflection_Preinstall_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Preinstall)(nil)
+func (x fastReflection_CodeMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CodeMetadata)(nil)
 }
-func (x fastReflection_Preinstall_messageType) New() protoreflect.Message {
-	return new(fastReflection_Preinstall)
+func (x fastReflection_CodeMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_CodeMetadata)
 }
-func (x fastReflection_Preinstall_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Preinstall
+func (x fastReflection_CodeMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CodeMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Preinstall) Descriptor() protoreflect.MessageDescriptor {
-	return md_Preinstall
+func (x *fastReflection_CodeMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_CodeMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Preinstall) Type() protoreflect.MessageType {
-	return _fastReflection_Preinstall_messageType
+func (x *fastReflection_CodeMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_CodeMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Preinstall) New() protoreflect.Message {
-	return new(fastReflection_Preinstall)
+func (x *fastReflection_CodeMetadata) New() protoreflect.Message {
+	return new(fastReflection_CodeMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
-	return (*Preinstall)(x)
+func (x *fastReflection_CodeMetadata) Interface() protoreflect.ProtoMessage {
+	return (*CodeMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8281,22 +10313,22 @@ func (x *fastReflection_Preinstall) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_Preinstall_name, value) {
+func (x *fastReflection_CodeMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CodeHash != "" {
+		value := protoreflect.ValueOfString(x.CodeHash)
+		if !f(fd_CodeMetadata_code_hash, value) {
 			return
 		}
 	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_Preinstall_address, value) {
+	if x.Publisher != "" {
+		value := protoreflect.ValueOfString(x.Publisher)
+		if !f(fd_CodeMetadata_publisher, value) {
 			return
 		}
 	}
-	if x.Code != "" {
-		value := protoreflect.ValueOfString(x.Code)
-		if !f(fd_Preinstall_code, value) {
+	if x.MetadataUri != "" {
+		value := protoreflect.ValueOfString(x.MetadataUri)
+		if !f(fd_CodeMetadata_metadata_uri, value) {
 			return
 		}
 	}
@@ -8313,19 +10345,19 @@ func (x *fastReflection_Preinstall) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CodeMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		return x.Name != ""
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		return x.Address != ""
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		return x.Code != ""
+	case "cosmos.evm.vm.v1.CodeMetadata.code_hash":
+		return x.CodeHash != ""
+	case "cosmos.evm.vm.v1.CodeMetadata.publisher":
+		return x.Publisher != ""
+	case "cosmos.evm.vm.v1.CodeMetadata.metadata_uri":
+		return x.MetadataUri != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CodeMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CodeMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8335,19 +10367,19 @@ func (x *fastReflection_Preinstall) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CodeMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		x.Name = ""
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		x.Address = ""
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		x.Code = ""
+	case "cosmos.evm.vm.v1.CodeMetadata.code_hash":
+		x.CodeHash = ""
+	case "cosmos.evm.vm.v1.CodeMetadata.publisher":
+		x.Publisher = ""
+	case "cosmos.evm.vm.v1.CodeMetadata.metadata_uri":
+		x.MetadataUri = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CodeMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CodeMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8357,22 +10389,22 @@ func (x *fastReflection_Preinstall) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CodeMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		value := x.Name
+	case "cosmos.evm.vm.v1.CodeMetadata.code_hash":
+		value := x.CodeHash
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		value := x.Address
+	case "cosmos.evm.vm.v1.CodeMetadata.publisher":
+		value := x.Publisher
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		value := x.Code
+	case "cosmos.evm.vm.v1.CodeMetadata.metadata_uri":
+		value := x.MetadataUri
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CodeMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CodeMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8386,19 +10418,19 @@ func (x *fastReflection_Preinstall) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CodeMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		x.Name = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		x.Code = value.Interface().(string)
+	case "cosmos.evm.vm.v1.CodeMetadata.code_hash":
+		x.CodeHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.CodeMetadata.publisher":
+		x.Publisher = value.Interface().(string)
+	case "cosmos.evm.vm.v1.CodeMetadata.metadata_uri":
+		x.MetadataUri = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CodeMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CodeMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8411,49 +10443,49 @@ func (x *fastReflection_Preinstall) Set(fd protoreflect.FieldDescriptor, value p
 // if not already stored.
 // It panics if the field does not contain a composite type.
 //
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
-		panic(fmt.Errorf("field name of message cosmos.evm.vm.v1.Preinstall is not mutable"))
-	case "cosmos.evm.vm.v1.Preinstall.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.Preinstall is not mutable"))
-	case "cosmos.evm.vm.v1.Preinstall.code":
-		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.Preinstall is not mutable"))
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CodeMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.CodeMetadata.code_hash":
+		panic(fmt.Errorf("field code_hash of message cosmos.evm.vm.v1.CodeMetadata is not mutable"))
+	case "cosmos.evm.vm.v1.CodeMetadata.publisher":
+		panic(fmt.Errorf("field publisher of message cosmos.evm.vm.v1.CodeMetadata is not mutable"))
+	case "cosmos.evm.vm.v1.CodeMetadata.metadata_uri":
+		panic(fmt.Errorf("field metadata_uri of message cosmos.evm.vm.v1.CodeMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CodeMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CodeMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Preinstall) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CodeMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.Preinstall.name":
+	case "cosmos.evm.vm.v1.CodeMetadata.code_hash":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Preinstall.address":
+	case "cosmos.evm.vm.v1.CodeMetadata.publisher":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.Preinstall.code":
+	case "cosmos.evm.vm.v1.CodeMetadata.metadata_uri":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.Preinstall"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.CodeMetadata"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.Preinstall does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.CodeMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CodeMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.Preinstall", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.CodeMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8461,7 +10493,7 @@ func (x *fastReflection_Preinstall) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CodeMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8472,7 +10504,7 @@ func (x *fastReflection_Preinstall) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CodeMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8484,7 +10516,7 @@ func (x *fastReflection_Preinstall) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Preinstall) IsValid() bool {
+func (x *fastReflection_CodeMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -8494,9 +10526,9 @@ func (x *fastReflection_Preinstall) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CodeMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Preinstall)
+		x := input.Message.Interface().(*CodeMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8508,15 +10540,15 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		l = len(x.CodeHash)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Address)
+		l = len(x.Publisher)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Code)
+		l = len(x.MetadataUri)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8530,7 +10562,7 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Preinstall)
+		x := input.Message.Interface().(*CodeMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8549,24 +10581,24 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+		if len(x.MetadataUri) > 0 {
+			i -= len(x.MetadataUri)
+			copy(dAtA[i:], x.MetadataUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MetadataUri)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.Publisher) > 0 {
+			i -= len(x.Publisher)
+			copy(dAtA[i:], x.Publisher)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Publisher)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if len(x.CodeHash) > 0 {
+			i -= len(x.CodeHash)
+			copy(dAtA[i:], x.CodeHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CodeHash)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8581,7 +10613,7 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Preinstall)
+		x := input.Message.Interface().(*CodeMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8613,15 +10645,15 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Preinstall: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8649,11 +10681,11 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.CodeHash = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8681,11 +10713,11 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Publisher = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8713,7 +10745,7 @@ func (x *fastReflection_Preinstall) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = string(dAtA[iNdEx:postIndex])
+				x.MetadataUri = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8835,6 +10867,15 @@ type Params struct {
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64   `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
+	// allowed_msg_type_urls defines the type URLs of the Cosmos SDK messages that
+	// can be executed through the message router precompile
+	AllowedMsgTypeUrls []string `protobuf:"bytes,11,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// circuit_breaker defines the EVM operations paused chain-wide for incident
+	// response
+	CircuitBreaker *CircuitBreaker `protobuf:"bytes,12,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// gas_overrides defines the chain specific overrides of the go-ethereum gas
+	// costs
+	GasOverrides *GasOverrides `protobuf:"bytes,13,opt,name=gas_overrides,json=gasOverrides,proto3" json:"gas_overrides,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -8899,6 +10940,152 @@ func (x *Params) GetHistoryServeWindow() uint64 {
 	return 0
 }
 
+func (x *Params) GetAllowedMsgTypeUrls() []string {
+	if x != nil {
+		return x.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (x *Params) GetCircuitBreaker() *CircuitBreaker {
+	if x != nil {
+		return x.CircuitBreaker
+	}
+	return nil
+}
+
+func (x *Params) GetGasOverrides() *GasOverrides {
+	if x != nil {
+		return x.GasOverrides
+	}
+	return nil
+}
+
+// CircuitBreaker defines the EVM operations paused chain-wide. The value
+// transfers to accounts without code are never paused.
+type CircuitBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pause_create pauses the contract creations
+	PauseCreate bool `protobuf:"varint,1,opt,name=pause_create,json=pauseCreate,proto3" json:"pause_create,omitempty"`
+	// pause_call pauses the calls to contracts and precompiles
+	PauseCall bool `protobuf:"varint,2,opt,name=pause_call,json=pauseCall,proto3" json:"pause_call,omitempty"`
+	// paused_precompiles defines the hex addresses of the precompiled contracts
+	// that are paused
+	PausedPrecompiles []string `protobuf:"bytes,3,rep,name=paused_precompiles,json=pausedPrecompiles,proto3" json:"paused_precompiles,omitempty"`
+	// expiry_height defines the block height from which the circuit breaker is
+	// lifted. The circuit breaker has no expiry if it's zero.
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (x *CircuitBreaker) Reset() {
+	*x = CircuitBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreaker) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreaker.ProtoReflect.Descriptor instead.
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CircuitBreaker) GetPauseCreate() bool {
+	if x != nil {
+		return x.PauseCreate
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetPauseCall() bool {
+	if x != nil {
+		return x.PauseCall
+	}
+	return false
+}
+
+func (x *CircuitBreaker) GetPausedPrecompiles() []string {
+	if x != nil {
+		return x.PausedPrecompiles
+	}
+	return nil
+}
+
+func (x *CircuitBreaker) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+// GasOverrides defines overrides of the go-ethereum gas costs. A zero value
+// keeps the go-ethereum default cost.
+type GasOverrides struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_data_zero_gas is the intrinsic gas cost of a zero transaction data byte
+	TxDataZeroGas uint64 `protobuf:"varint,1,opt,name=tx_data_zero_gas,json=txDataZeroGas,proto3" json:"tx_data_zero_gas,omitempty"`
+	// tx_data_non_zero_gas is the intrinsic gas cost of a non-zero transaction
+	// data byte
+	TxDataNonZeroGas uint64 `protobuf:"varint,2,opt,name=tx_data_non_zero_gas,json=txDataNonZeroGas,proto3" json:"tx_data_non_zero_gas,omitempty"`
+	// sstore_set_gas is the gas cost of an SSTORE setting a storage slot from
+	// zero to a non-zero value
+	SstoreSetGas uint64 `protobuf:"varint,3,opt,name=sstore_set_gas,json=sstoreSetGas,proto3" json:"sstore_set_gas,omitempty"`
+}
+
+func (x *GasOverrides) Reset() {
+	*x = GasOverrides{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasOverrides) ProtoMessage() {}
+
+// Deprecated: Use GasOverrides.ProtoReflect.Descriptor instead.
+func (*GasOverrides) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GasOverrides) GetTxDataZeroGas() uint64 {
+	if x != nil {
+		return x.TxDataZeroGas
+	}
+	return 0
+}
+
+func (x *GasOverrides) GetTxDataNonZeroGas() uint64 {
+	if x != nil {
+		return x.TxDataNonZeroGas
+	}
+	return 0
+}
+
+func (x *GasOverrides) GetSstoreSetGas() uint64 {
+	if x != nil {
+		return x.SstoreSetGas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -8915,7 +11102,7 @@ type AccessControl struct {
 func (x *AccessControl) Reset() {
 	*x = AccessControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[1]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8929,7 +11116,7 @@ func (*AccessControl) ProtoMessage() {}
 
 // Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AccessControl) GetCreate() *AccessControlType {
@@ -8967,7 +11154,7 @@ type AccessControlType struct {
 func (x *AccessControlType) Reset() {
 	*x = AccessControlType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[2]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8981,7 +11168,7 @@ func (*AccessControlType) ProtoMessage() {}
 
 // Deprecated: Use AccessControlType.ProtoReflect.Descriptor instead.
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AccessControlType) GetAccessType() AccessType {
@@ -9063,12 +11250,15 @@ type ChainConfig struct {
 	VerkleTime string `protobuf:"bytes,30,opt,name=verkle_time,json=verkleTime,proto3" json:"verkle_time,omitempty"`
 	// osaka_time: Osaka switch time (nil = no fork, 0 = already on osaka)
 	OsakaTime string `protobuf:"bytes,31,opt,name=osaka_time,json=osakaTime,proto3" json:"osaka_time,omitempty"`
+	// p256_block: RIP-7212 secp256r1 verification precompile activation block
+	// (nil = not active, 0 = already active)
+	P256Block string `protobuf:"bytes,32,opt,name=p256_block,json=p256Block,proto3" json:"p256_block,omitempty"`
 }
 
 func (x *ChainConfig) Reset() {
 	*x = ChainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[3]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9082,7 +11272,7 @@ func (*ChainConfig) ProtoMessage() {}
 
 // Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{3}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ChainConfig) GetHomesteadBlock() string {
@@ -9253,6 +11443,13 @@ func (x *ChainConfig) GetOsakaTime() string {
 	return ""
 }
 
+func (x *ChainConfig) GetP256Block() string {
+	if x != nil {
+		return x.P256Block
+	}
+	return ""
+}
+
 // State represents a single Storage key value pair item.
 type State struct {
 	state         protoimpl.MessageState
@@ -9268,7 +11465,7 @@ type State struct {
 func (x *State) Reset() {
 	*x = State{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[4]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9282,7 +11479,7 @@ func (*State) ProtoMessage() {}
 
 // Deprecated: Use State.ProtoReflect.Descriptor instead.
 func (*State) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *State) GetKey() string {
@@ -9316,7 +11513,7 @@ type TransactionLogs struct {
 func (x *TransactionLogs) Reset() {
 	*x = TransactionLogs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[5]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9330,7 +11527,7 @@ func (*TransactionLogs) ProtoMessage() {}
 
 // Deprecated: Use TransactionLogs.ProtoReflect.Descriptor instead.
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TransactionLogs) GetHash() string {
@@ -9385,7 +11582,7 @@ type Log struct {
 func (x *Log) Reset() {
 	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[6]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9399,7 +11596,7 @@ func (*Log) ProtoMessage() {}
 
 // Deprecated: Use Log.ProtoReflect.Descriptor instead.
 func (*Log) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Log) GetAddress() string {
@@ -9498,7 +11695,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[7]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9512,7 +11709,7 @@ func (*TxResult) ProtoMessage() {}
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TxResult) GetContractAddress() string {
@@ -9572,7 +11769,7 @@ type AccessTuple struct {
 func (x *AccessTuple) Reset() {
 	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[8]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9586,7 +11783,7 @@ func (*AccessTuple) ProtoMessage() {}
 
 // Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AccessTuple) GetAddress() string {
@@ -9637,7 +11834,7 @@ type TraceConfig struct {
 func (x *TraceConfig) Reset() {
 	*x = TraceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[9]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9651,7 +11848,7 @@ func (*TraceConfig) ProtoMessage() {}
 
 // Deprecated: Use TraceConfig.ProtoReflect.Descriptor instead.
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TraceConfig) GetTracer() string {
@@ -9749,7 +11946,7 @@ type Preinstall struct {
 func (x *Preinstall) Reset() {
 	*x = Preinstall{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[10]
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9763,7 +11960,7 @@ func (*Preinstall) ProtoMessage() {}
 
 // Deprecated: Use Preinstall.ProtoReflect.Descriptor instead.
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Preinstall) GetName() string {
@@ -9787,6 +11984,63 @@ func (x *Preinstall) GetCode() string {
 	return ""
 }
 
+// CodeMetadata defines the metadata published by an account for a contract code
+// hash, used as an anchor to verify the source of the deployed contracts.
+type CodeMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code_hash is the hex encoded hash of the contract code
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// publisher is the bech32 address of the account that published the metadata
+	Publisher string `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// metadata_uri is the location of the compiler metadata of the contract, e.g.
+	// ipfs://<cid>
+	MetadataUri string `protobuf:"bytes,3,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+}
+
+func (x *CodeMetadata) Reset() {
+	*x = CodeMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_evm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeMetadata) ProtoMessage() {}
+
+// Deprecated: Use CodeMetadata.ProtoReflect.Descriptor instead.
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CodeMetadata) GetCodeHash() string {
+	if x != nil {
+		return x.CodeHash
+	}
+	return ""
+}
+
+func (x *CodeMetadata) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *CodeMetadata) GetMetadataUri() string {
+	if x != nil {
+		return x.MetadataUri
+	}
+	return ""
+}
+
 var File_cosmos_evm_vm_v1_evm_proto protoreflect.FileDescriptor
 
 var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
@@ -9795,7 +12049,7 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x65, 0x76, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x08, 0x65, 0x76, 0x6d,
@@ -9819,10 +12073,44 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69, 0x73, 0x74, 0x6f,
 	0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
 	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x78, 0x2f, 0x76, 0x6d, 0x2f,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03,
-	0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x91,
+	0x72, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x49, 0x0a, 0x15, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xde, 0x1f, 0x12, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x55, 0x72, 0x6c, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0d, 0x67, 0x61, 0x73, 0x5f, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x67, 0x61, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x3a, 0x1b, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x78, 0x2f, 0x76, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a,
+	0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69,
+	0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x8d,
+	0x01, 0x0a, 0x0c, 0x47, 0x61, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12,
+	0x27, 0x0a, 0x10, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f,
+	0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x78, 0x44, 0x61, 0x74,
+	0x61, 0x5a, 0x65, 0x72, 0x6f, 0x47, 0x61, 0x73, 0x12, 0x2e, 0x0a, 0x14, 0x74, 0x78, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x6e, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f,
+	0x6e, 0x5a, 0x65, 0x72, 0x6f, 0x47, 0x61, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x73, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x65, 0x74, 0x47, 0x61, 0x73, 0x22, 0x91,
 	0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
 	0x12, 0x41, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
@@ -9846,7 +12134,7 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x74, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x65, 0x73,
 	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x52,
 	0x11, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0xa8, 0x10, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x73, 0x74, 0x22, 0x84, 0x11, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0f, 0x68, 0x6f, 0x6d, 0x65, 0x73, 0x74, 0x65, 0x61, 0x64, 0x5f,
 	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xda, 0xde, 0x1f,
 	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
@@ -9975,127 +12263,140 @@ var file_cosmos_evm_vm_v1_evm_proto_rawDesc = []byte{
 	0x42, 0x2e, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x11, 0x79,
 	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0x52, 0x09, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x4a, 0x04, 0x08, 0x05, 0x10,
-	0x06, 0x4a, 0x04, 0x08, 0x16, 0x10, 0x17, 0x4a, 0x04, 0x08, 0x17, 0x10, 0x18, 0x22, 0x2f, 0x0a,
-	0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50,
-	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
-	0x22, 0x87, 0x03, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x52, 0x09, 0x6f, 0x73, 0x61, 0x6b, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x70,
+	0x32, 0x35, 0x36, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3b, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xe2, 0xde, 0x1f, 0x09, 0x50, 0x32,
+	0x35, 0x36, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x70, 0x32, 0x35, 0x36, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x09, 0x70, 0x32,
+	0x35, 0x36, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08,
+	0x16, 0x10, 0x17, 0x4a, 0x04, 0x08, 0x17, 0x10, 0x18, 0x22, 0x2f, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x0f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x87, 0x03, 0x0a,
+	0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x08,
+	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x14,
+	0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08,
+	0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54, 0x78, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xf2,
+	0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x6f,
+	0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0e, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a, 0x0b, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32,
-	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x13, 0xea, 0xde, 0x1f, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x2f, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xea, 0xde, 0x1f, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x22, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0c,
-	0xea, 0xde, 0x1f, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a,
-	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x54,
-	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0f,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
-	0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
-	0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x74, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x61, 0x0a,
-	0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde,
-	0x1f, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00,
-	0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
-	0x61, 0x63, 0x6b, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63,
-	0x6b, 0x12, 0x3b, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10,
-	0xea, 0xde, 0x1f, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42,
-	0x0a, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10,
-	0xea, 0xde, 0x1f, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x10, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x2a, 0xc0, 0x01, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53,
-	0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d, 0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
-	0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73,
-	0x12, 0x34, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d,
-	0x20, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
-	0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64,
-	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42,
-	0x08, 0x45, 0x76, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xea, 0xde, 0x1f, 0x0b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xa0, 0x04, 0x0a,
+	0x0b, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x72, 0x65, 0x65, 0x78, 0x65, 0x63, 0x12, 0x35, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea,
+	0xde, 0x1f, 0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x52,
+	0x0c, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x3b, 0x0a,
+	0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0c, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x12, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e,
+	0x0a, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x4e, 0x0a, 0x0a, 0x50, 0x72, 0x65, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x6c, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x2a, 0xc0, 0x01,
+	0x0a, 0x0a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x10, 0x00, 0x1a, 0x1c, 0x8a, 0x9d,
+	0x20, 0x18, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x41, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x38, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x1a,
+	0x8a, 0x9d, 0x20, 0x16, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00,
+	0x42, 0xab, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x45, 0x76, 0x6d, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d,
+	0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10111,34 +12412,39 @@ func file_cosmos_evm_vm_v1_evm_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_evm_vm_v1_evm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cosmos_evm_vm_v1_evm_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_cosmos_evm_vm_v1_evm_proto_goTypes = []interface{}{
 	(AccessType)(0),           // 0: cosmos.evm.vm.v1.AccessType
 	(*Params)(nil),            // 1: cosmos.evm.vm.v1.Params
-	(*AccessControl)(nil),     // 2: cosmos.evm.vm.v1.AccessControl
-	(*AccessControlType)(nil), // 3: cosmos.evm.vm.v1.AccessControlType
-	(*ChainConfig)(nil),       // 4: cosmos.evm.vm.v1.ChainConfig
-	(*State)(nil),             // 5: cosmos.evm.vm.v1.State
-	(*TransactionLogs)(nil),   // 6: cosmos.evm.vm.v1.TransactionLogs
-	(*Log)(nil),               // 7: cosmos.evm.vm.v1.Log
-	(*TxResult)(nil),          // 8: cosmos.evm.vm.v1.TxResult
-	(*AccessTuple)(nil),       // 9: cosmos.evm.vm.v1.AccessTuple
-	(*TraceConfig)(nil),       // 10: cosmos.evm.vm.v1.TraceConfig
-	(*Preinstall)(nil),        // 11: cosmos.evm.vm.v1.Preinstall
+	(*CircuitBreaker)(nil),    // 2: cosmos.evm.vm.v1.CircuitBreaker
+	(*GasOverrides)(nil),      // 3: cosmos.evm.vm.v1.GasOverrides
+	(*AccessControl)(nil),     // 4: cosmos.evm.vm.v1.AccessControl
+	(*AccessControlType)(nil), // 5: cosmos.evm.vm.v1.AccessControlType
+	(*ChainConfig)(nil),       // 6: cosmos.evm.vm.v1.ChainConfig
+	(*State)(nil),             // 7: cosmos.evm.vm.v1.State
+	(*TransactionLogs)(nil),   // 8: cosmos.evm.vm.v1.TransactionLogs
+	(*Log)(nil),               // 9: cosmos.evm.vm.v1.Log
+	(*TxResult)(nil),          // 10: cosmos.evm.vm.v1.TxResult
+	(*AccessTuple)(nil),       // 11: cosmos.evm.vm.v1.AccessTuple
+	(*TraceConfig)(nil),       // 12: cosmos.evm.vm.v1.TraceConfig
+	(*Preinstall)(nil),        // 13: cosmos.evm.vm.v1.Preinstall
+	(*CodeMetadata)(nil),      // 14: cosmos.evm.vm.v1.CodeMetadata
 }
 var file_cosmos_evm_vm_v1_evm_proto_depIdxs = []int32{
-	2, // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
-	3, // 1: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
-	3, // 2: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
-	0, // 3: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
-	7, // 4: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
-	6, // 5: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
-	4, // 6: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	4, // 0: cosmos.evm.vm.v1.Params.access_control:type_name -> cosmos.evm.vm.v1.AccessControl
+	2, // 1: cosmos.evm.vm.v1.Params.circuit_breaker:type_name -> cosmos.evm.vm.v1.CircuitBreaker
+	3, // 2: cosmos.evm.vm.v1.Params.gas_overrides:type_name -> cosmos.evm.vm.v1.GasOverrides
+	5, // 3: cosmos.evm.vm.v1.AccessControl.create:type_name -> cosmos.evm.vm.v1.AccessControlType
+	5, // 4: cosmos.evm.vm.v1.AccessControl.call:type_name -> cosmos.evm.vm.v1.AccessControlType
+	0, // 5: cosmos.evm.vm.v1.AccessControlType.access_type:type_name -> cosmos.evm.vm.v1.AccessType
+	9, // 6: cosmos.evm.vm.v1.TransactionLogs.logs:type_name -> cosmos.evm.vm.v1.Log
+	8, // 7: cosmos.evm.vm.v1.TxResult.tx_logs:type_name -> cosmos.evm.vm.v1.TransactionLogs
+	6, // 8: cosmos.evm.vm.v1.TraceConfig.overrides:type_name -> cosmos.evm.vm.v1.ChainConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_evm_proto_init() }
@@ -10160,7 +12466,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControl); i {
+			switch v := v.(*CircuitBreaker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10172,7 +12478,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessControlType); i {
+			switch v := v.(*GasOverrides); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10184,7 +12490,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainConfig); i {
+			switch v := v.(*AccessControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10196,7 +12502,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+			switch v := v.(*AccessControlType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10208,7 +12514,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionLogs); i {
+			switch v := v.(*ChainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10220,7 +12526,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+			switch v := v.(*State); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10232,7 +12538,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxResult); i {
+			switch v := v.(*TransactionLogs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10244,7 +12550,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10256,7 +12562,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceConfig); i {
+			switch v := v.(*TxResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10268,6 +12574,30 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_evm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Preinstall); i {
 			case 0:
 				return &v.state
@@ -10279,6 +12609,18 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_vm_v1_evm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10286,7 +12628,7 @@ func file_cosmos_evm_vm_v1_evm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_evm_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -6,6 +6,7 @@ import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	v1 "github.com/cosmos/evm/api/cosmos/evm/types/v1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -5377,25 +5378,27 @@ func (x *fastReflection_QueryStorageResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryCodeRequest         protoreflect.MessageDescriptor
-	fd_QueryCodeRequest_address protoreflect.FieldDescriptor
+	md_QueryContractStorageRequest            protoreflect.MessageDescriptor
+	fd_QueryContractStorageRequest_address    protoreflect.FieldDescriptor
+	fd_QueryContractStorageRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryCodeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeRequest")
-	fd_QueryCodeRequest_address = md_QueryCodeRequest.Fields().ByName("address")
+	md_QueryContractStorageRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryContractStorageRequest")
+	fd_QueryContractStorageRequest_address = md_QueryContractStorageRequest.Fields().ByName("address")
+	fd_QueryContractStorageRequest_pagination = md_QueryContractStorageRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractStorageRequest)(nil)
 
-type fastReflection_QueryCodeRequest QueryCodeRequest
+type fastReflection_QueryContractStorageRequest QueryContractStorageRequest
 
-func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCodeRequest)(x)
+func (x *QueryContractStorageRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractStorageRequest)(x)
 }
 
-func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractStorageRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5407,43 +5410,43 @@ func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCodeRequest_messageType fastReflection_QueryCodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCodeRequest_messageType{}
+var _fastReflection_QueryContractStorageRequest_messageType fastReflection_QueryContractStorageRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractStorageRequest_messageType{}
 
-type fastReflection_QueryCodeRequest_messageType struct{}
+type fastReflection_QueryContractStorageRequest_messageType struct{}
 
-func (x fastReflection_QueryCodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCodeRequest)(nil)
+func (x fastReflection_QueryContractStorageRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractStorageRequest)(nil)
 }
-func (x fastReflection_QueryCodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeRequest)
+func (x fastReflection_QueryContractStorageRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractStorageRequest)
 }
-func (x fastReflection_QueryCodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeRequest
+func (x fastReflection_QueryContractStorageRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractStorageRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeRequest
+func (x *fastReflection_QueryContractStorageRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractStorageRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCodeRequest_messageType
+func (x *fastReflection_QueryContractStorageRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractStorageRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCodeRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeRequest)
+func (x *fastReflection_QueryContractStorageRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryContractStorageRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryCodeRequest)(x)
+func (x *fastReflection_QueryContractStorageRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractStorageRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5451,10 +5454,16 @@ func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryContractStorageRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryCodeRequest_address, value) {
+		if !f(fd_QueryContractStorageRequest_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractStorageRequest_pagination, value) {
 			return
 		}
 	}
@@ -5471,15 +5480,17 @@ func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractStorageRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.address":
 		return x.Address != ""
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5489,15 +5500,17 @@ func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractStorageRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.address":
 		x.Address = ""
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5507,16 +5520,19 @@ func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractStorageRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5530,15 +5546,17 @@ func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractStorageRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.address":
 		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5552,40 +5570,48 @@ func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractStorageRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
-		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryCodeRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryContractStorageRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractStorageRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryContractStorageRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractStorageRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryContractStorageRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5593,7 +5619,7 @@ func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractStorageRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5604,7 +5630,7 @@ func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractStorageRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5616,7 +5642,7 @@ func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCodeRequest) IsValid() bool {
+func (x *fastReflection_QueryContractStorageRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5626,9 +5652,9 @@ func (x *fastReflection_QueryCodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractStorageRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryContractStorageRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5644,6 +5670,10 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5654,7 +5684,7 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryContractStorageRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5673,6 +5703,20 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Address) > 0 {
 			i -= len(x.Address)
 			copy(dAtA[i:], x.Address)
@@ -5691,7 +5735,7 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeRequest)
+		x := input.Message.Interface().(*QueryContractStorageRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5723,10 +5767,10 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractStorageRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5761,6 +5805,42 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 				}
 				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5796,26 +5876,79 @@ func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryContractStorageResponse_1_list)(nil)
+
+type _QueryContractStorageResponse_1_list struct {
+	list *[]*State
+}
+
+func (x *_QueryContractStorageResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryContractStorageResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryContractStorageResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*State)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryContractStorageResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*State)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryContractStorageResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(State)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractStorageResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryContractStorageResponse_1_list) NewElement() protoreflect.Value {
+	v := new(State)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryContractStorageResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryCodeResponse      protoreflect.MessageDescriptor
-	fd_QueryCodeResponse_code protoreflect.FieldDescriptor
+	md_QueryContractStorageResponse            protoreflect.MessageDescriptor
+	fd_QueryContractStorageResponse_storage    protoreflect.FieldDescriptor
+	fd_QueryContractStorageResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryCodeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeResponse")
-	fd_QueryCodeResponse_code = md_QueryCodeResponse.Fields().ByName("code")
+	md_QueryContractStorageResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryContractStorageResponse")
+	fd_QueryContractStorageResponse_storage = md_QueryContractStorageResponse.Fields().ByName("storage")
+	fd_QueryContractStorageResponse_pagination = md_QueryContractStorageResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryCodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryContractStorageResponse)(nil)
 
-type fastReflection_QueryCodeResponse QueryCodeResponse
+type fastReflection_QueryContractStorageResponse QueryContractStorageResponse
 
-func (x *QueryCodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryCodeResponse)(x)
+func (x *QueryContractStorageResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryContractStorageResponse)(x)
 }
 
-func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryContractStorageResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5827,43 +5960,43 @@ func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryCodeResponse_messageType fastReflection_QueryCodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryCodeResponse_messageType{}
+var _fastReflection_QueryContractStorageResponse_messageType fastReflection_QueryContractStorageResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryContractStorageResponse_messageType{}
 
-type fastReflection_QueryCodeResponse_messageType struct{}
+type fastReflection_QueryContractStorageResponse_messageType struct{}
 
-func (x fastReflection_QueryCodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryCodeResponse)(nil)
+func (x fastReflection_QueryContractStorageResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryContractStorageResponse)(nil)
 }
-func (x fastReflection_QueryCodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeResponse)
+func (x fastReflection_QueryContractStorageResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryContractStorageResponse)
 }
-func (x fastReflection_QueryCodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeResponse
+func (x fastReflection_QueryContractStorageResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractStorageResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryCodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryCodeResponse
+func (x *fastReflection_QueryContractStorageResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryContractStorageResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryCodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryCodeResponse_messageType
+func (x *fastReflection_QueryContractStorageResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryContractStorageResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryCodeResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryCodeResponse)
+func (x *fastReflection_QueryContractStorageResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryContractStorageResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryCodeResponse)(x)
+func (x *fastReflection_QueryContractStorageResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryContractStorageResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5871,10 +6004,16 @@ func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Code) != 0 {
-		value := protoreflect.ValueOfBytes(x.Code)
-		if !f(fd_QueryCodeResponse_code, value) {
+func (x *fastReflection_QueryContractStorageResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Storage) != 0 {
+		value := protoreflect.ValueOfList(&_QueryContractStorageResponse_1_list{list: &x.Storage})
+		if !f(fd_QueryContractStorageResponse_storage, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryContractStorageResponse_pagination, value) {
 			return
 		}
 	}
@@ -5891,15 +6030,17 @@ func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryContractStorageResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		return len(x.Code) != 0
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.storage":
+		return len(x.Storage) != 0
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5909,15 +6050,17 @@ func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryContractStorageResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		x.Code = nil
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.storage":
+		x.Storage = nil
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5927,16 +6070,22 @@ func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractStorageResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		value := x.Code
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.storage":
+		if len(x.Storage) == 0 {
+			return protoreflect.ValueOfList(&_QueryContractStorageResponse_1_list{})
+		}
+		listValue := &_QueryContractStorageResponse_1_list{list: &x.Storage}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5950,15 +6099,19 @@ func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryContractStorageResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		x.Code = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.storage":
+		lv := value.List()
+		clv := lv.(*_QueryContractStorageResponse_1_list)
+		x.Storage = *clv.list
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5972,40 +6125,53 @@ func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractStorageResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.QueryCodeResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.storage":
+		if x.Storage == nil {
+			x.Storage = []*State{}
+		}
+		value := &_QueryContractStorageResponse_1_list{list: &x.Storage}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryCodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryContractStorageResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.storage":
+		list := []*State{}
+		return protoreflect.ValueOfList(&_QueryContractStorageResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryContractStorageResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryContractStorageResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryContractStorageResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryContractStorageResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryContractStorageResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6013,7 +6179,7 @@ func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryContractStorageResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6024,7 +6190,7 @@ func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryContractStorageResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6036,7 +6202,7 @@ func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryCodeResponse) IsValid() bool {
+func (x *fastReflection_QueryContractStorageResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6046,9 +6212,9 @@ func (x *fastReflection_QueryCodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryContractStorageResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*QueryContractStorageResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6060,8 +6226,14 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Code)
-		if l > 0 {
+		if len(x.Storage) > 0 {
+			for _, e := range x.Storage {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6074,7 +6246,7 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*QueryContractStorageResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6093,12 +6265,35 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Code) > 0 {
-			i -= len(x.Code)
-			copy(dAtA[i:], x.Code)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Storage) > 0 {
+			for iNdEx := len(x.Storage) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Storage[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -6111,7 +6306,7 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryCodeResponse)
+		x := input.Message.Interface().(*QueryContractStorageResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6143,17 +6338,17 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractStorageResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryContractStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6163,24 +6358,60 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
-				if x.Code == nil {
-					x.Code = []byte{}
+				x.Storage = append(x.Storage, &State{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Storage[len(x.Storage)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -6219,27 +6450,25 @@ func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
-	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
-	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
+	md_QueryCodeRequest         protoreflect.MessageDescriptor
+	fd_QueryCodeRequest_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTxLogsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
-	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
-	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
+	md_QueryCodeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeRequest")
+	fd_QueryCodeRequest_address = md_QueryCodeRequest.Fields().ByName("address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeRequest)(nil)
 
-type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
+type fastReflection_QueryCodeRequest QueryCodeRequest
 
-func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(x)
+func (x *QueryCodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeRequest)(x)
 }
 
-func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryCodeRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6251,43 +6480,43 @@ func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
+var _fastReflection_QueryCodeRequest_messageType fastReflection_QueryCodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeRequest_messageType{}
 
-type fastReflection_QueryTxLogsRequest_messageType struct{}
+type fastReflection_QueryCodeRequest_messageType struct{}
 
-func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsRequest)(nil)
+func (x fastReflection_QueryCodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeRequest)(nil)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x fastReflection_QueryCodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeRequest)
 }
-func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x fastReflection_QueryCodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsRequest
+func (x *fastReflection_QueryCodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsRequest_messageType
+func (x *fastReflection_QueryCodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsRequest)
+func (x *fastReflection_QueryCodeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsRequest)(x)
+func (x *fastReflection_QueryCodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6295,16 +6524,10 @@ func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_QueryTxLogsRequest_hash, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsRequest_pagination, value) {
+func (x *fastReflection_QueryCodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryCodeRequest_address, value) {
 			return
 		}
 	}
@@ -6321,17 +6544,15 @@ func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		return x.Hash != ""
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6341,17 +6562,15 @@ func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		x.Hash = ""
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6361,19 +6580,16 @@ func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		value := x.Hash
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6387,17 +6603,15 @@ func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		x.Hash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6411,48 +6625,40 @@ func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
-		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxLogsRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryCodeRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+	case "cosmos.evm.vm.v1.QueryCodeRequest.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6460,7 +6666,7 @@ func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6471,7 +6677,7 @@ func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6483,7 +6689,7 @@ func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
+func (x *fastReflection_QueryCodeRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6493,9 +6699,9 @@ func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6507,14 +6713,10 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6525,7 +6727,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6544,24 +6746,10 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6576,7 +6764,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsRequest)
+		x := input.Message.Interface().(*QueryCodeRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6608,15 +6796,15 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6644,43 +6832,7 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6717,79 +6869,26 @@ func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
-
-type _QueryTxLogsResponse_1_list struct {
-	list *[]*Log
-}
-
-func (x *_QueryTxLogsResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Log)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Log)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Log)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
-	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
-	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
+	md_QueryCodeResponse      protoreflect.MessageDescriptor
+	fd_QueryCodeResponse_code protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTxLogsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
-	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
-	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
+	md_QueryCodeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeResponse")
+	fd_QueryCodeResponse_code = md_QueryCodeResponse.Fields().ByName("code")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeResponse)(nil)
 
-type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
+type fastReflection_QueryCodeResponse QueryCodeResponse
 
-func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(x)
+func (x *QueryCodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeResponse)(x)
 }
 
-func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryCodeResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6801,43 +6900,43 @@ func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
+var _fastReflection_QueryCodeResponse_messageType fastReflection_QueryCodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeResponse_messageType{}
 
-type fastReflection_QueryTxLogsResponse_messageType struct{}
+type fastReflection_QueryCodeResponse_messageType struct{}
 
-func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTxLogsResponse)(nil)
+func (x fastReflection_QueryCodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeResponse)(nil)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x fastReflection_QueryCodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeResponse)
 }
-func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x fastReflection_QueryCodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTxLogsResponse
+func (x *fastReflection_QueryCodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTxLogsResponse_messageType
+func (x *fastReflection_QueryCodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTxLogsResponse)
+func (x *fastReflection_QueryCodeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTxLogsResponse)(x)
+func (x *fastReflection_QueryCodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6845,16 +6944,10 @@ func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Logs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
-		if !f(fd_QueryTxLogsResponse_logs, value) {
-			return
-		}
-	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTxLogsResponse_pagination, value) {
+func (x *fastReflection_QueryCodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Code) != 0 {
+		value := protoreflect.ValueOfBytes(x.Code)
+		if !f(fd_QueryCodeResponse_code, value) {
 			return
 		}
 	}
@@ -6871,17 +6964,15 @@ func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		return len(x.Logs) != 0
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		return len(x.Code) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6891,17 +6982,15 @@ func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		x.Logs = nil
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		x.Code = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6911,22 +7000,16 @@ func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		if len(x.Logs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
-		}
-		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		value := x.Code
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6940,19 +7023,15 @@ func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		lv := value.List()
-		clv := lv.(*_QueryTxLogsResponse_1_list)
-		x.Logs = *clv.list
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		x.Code = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6966,53 +7045,40 @@ func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		if x.Logs == nil {
-			x.Logs = []*Log{}
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		panic(fmt.Errorf("field code of message cosmos.evm.vm.v1.QueryCodeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
-		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
-		list := []*Log{}
-		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryCodeResponse.code":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7020,7 +7086,7 @@ func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7031,7 +7097,7 @@ func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7043,7 +7109,7 @@ func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
+func (x *fastReflection_QueryCodeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7053,9 +7119,9 @@ func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7067,14 +7133,8 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Logs) > 0 {
-			for _, e := range x.Logs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Code)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7087,7 +7147,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7106,35 +7166,12 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Code) > 0 {
+			i -= len(x.Code)
+			copy(dAtA[i:], x.Code)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Code)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Logs) > 0 {
-			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Logs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7147,7 +7184,7 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTxLogsResponse)
+		x := input.Message.Interface().(*QueryCodeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7179,51 +7216,17 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Logs = append(x.Logs, &Log{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7233,26 +7236,24 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Code = append(x.Code[:0], dAtA[iNdEx:postIndex]...)
+				if x.Code == nil {
+					x.Code = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -7291,23 +7292,27 @@ func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryTxLogsRequest            protoreflect.MessageDescriptor
+	fd_QueryTxLogsRequest_hash       protoreflect.FieldDescriptor
+	fd_QueryTxLogsRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryParamsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryTxLogsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsRequest")
+	fd_QueryTxLogsRequest_hash = md_QueryTxLogsRequest.Fields().ByName("hash")
+	fd_QueryTxLogsRequest_pagination = md_QueryTxLogsRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsRequest)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryTxLogsRequest QueryTxLogsRequest
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryTxLogsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7319,43 +7324,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryTxLogsRequest_messageType fastReflection_QueryTxLogsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsRequest_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryTxLogsRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryTxLogsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsRequest)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryTxLogsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryTxLogsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryTxLogsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryTxLogsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryTxLogsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryTxLogsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7363,7 +7368,19 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryTxLogsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_QueryTxLogsRequest_hash, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsRequest_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7377,13 +7394,17 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		return x.Hash != ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7393,13 +7414,17 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		x.Hash = ""
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7409,13 +7434,19 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7429,13 +7460,17 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		x.Hash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7449,36 +7484,48 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		panic(fmt.Errorf("field hash of message cosmos.evm.vm.v1.QueryTxLogsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTxLogsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7486,7 +7533,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7497,7 +7544,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7509,7 +7556,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryTxLogsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7519,9 +7566,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7533,6 +7580,14 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7543,7 +7598,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7562,6 +7617,27 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7573,7 +7649,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryTxLogsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7605,12 +7681,80 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7646,26 +7790,79 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryTxLogsResponse_1_list)(nil)
+
+type _QueryTxLogsResponse_1_list struct {
+	list *[]*Log
+}
+
+func (x *_QueryTxLogsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTxLogsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTxLogsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Log)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTxLogsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Log)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTxLogsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Log)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTxLogsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryTxLogsResponse            protoreflect.MessageDescriptor
+	fd_QueryTxLogsResponse_logs       protoreflect.FieldDescriptor
+	fd_QueryTxLogsResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryParamsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_QueryTxLogsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTxLogsResponse")
+	fd_QueryTxLogsResponse_logs = md_QueryTxLogsResponse.Fields().ByName("logs")
+	fd_QueryTxLogsResponse_pagination = md_QueryTxLogsResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTxLogsResponse)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_QueryTxLogsResponse QueryTxLogsResponse
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *QueryTxLogsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryTxLogsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7677,43 +7874,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryTxLogsResponse_messageType fastReflection_QueryTxLogsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTxLogsResponse_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryTxLogsResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryTxLogsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTxLogsResponse)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryTxLogsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryTxLogsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryTxLogsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTxLogsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryTxLogsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTxLogsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryTxLogsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTxLogsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryTxLogsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTxLogsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7721,10 +7918,16 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
+func (x *fastReflection_QueryTxLogsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Logs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &x.Logs})
+		if !f(fd_QueryTxLogsResponse_logs, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTxLogsResponse_pagination, value) {
 			return
 		}
 	}
@@ -7741,15 +7944,17 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTxLogsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		return x.Params != nil
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		return len(x.Logs) != 0
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7759,15 +7964,17 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTxLogsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		x.Params = nil
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		x.Logs = nil
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7777,16 +7984,22 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		value := x.Params
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		if len(x.Logs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{})
+		}
+		listValue := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7800,15 +8013,19 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTxLogsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		lv := value.List()
+		clv := lv.(*_QueryTxLogsResponse_1_list)
+		x.Logs = *clv.list
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7822,44 +8039,53 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		if x.Logs == nil {
+			x.Logs = []*Log{}
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		value := &_QueryTxLogsResponse_1_list{list: &x.Logs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTxLogsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
-		m := new(Params)
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.logs":
+		list := []*Log{}
+		return protoreflect.ValueOfList(&_QueryTxLogsResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTxLogsResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTxLogsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTxLogsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTxLogsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTxLogsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7867,7 +8093,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTxLogsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7878,7 +8104,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTxLogsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7890,7 +8116,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryTxLogsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7900,9 +8126,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTxLogsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7914,8 +8140,14 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		if len(x.Logs) > 0 {
+			for _, e := range x.Logs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -7928,7 +8160,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7947,8 +8179,8 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7959,7 +8191,23 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Logs) > 0 {
+			for iNdEx := len(x.Logs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Logs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7972,7 +8220,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryTxLogsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8004,15 +8252,15 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -8039,10 +8287,44 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.Logs = append(x.Logs, &Log{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Logs[len(x.Logs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -8082,31 +8364,23 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EthCallRequest                  protoreflect.MessageDescriptor
-	fd_EthCallRequest_args             protoreflect.FieldDescriptor
-	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
-	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
-	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_EthCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallRequest")
-	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
-	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
-	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
-	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
+	md_QueryParamsRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_EthCallRequest EthCallRequest
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8118,43 +8392,43 @@ func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
-var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_EthCallRequest_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EthCallRequest)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_EthCallRequest
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
-	return _fastReflection_EthCallRequest_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
-	return new(fastReflection_EthCallRequest)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
-	return (*EthCallRequest)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8162,31 +8436,7 @@ func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Args) != 0 {
-		value := protoreflect.ValueOfBytes(x.Args)
-		if !f(fd_EthCallRequest_args, value) {
-			return
-		}
-	}
-	if x.GasCap != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GasCap)
-		if !f(fd_EthCallRequest_gas_cap, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_EthCallRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_EthCallRequest_chain_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -8200,21 +8450,13 @@ func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		return len(x.Args) != 0
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		return x.GasCap != uint64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		return x.ChainId != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8224,21 +8466,13 @@ func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		x.Args = nil
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		x.GasCap = uint64(0)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		x.ChainId = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8248,25 +8482,13 @@ func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		value := x.Args
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		value := x.GasCap
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8280,21 +8502,13 @@ func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		x.Args = value.Bytes()
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		x.GasCap = value.Uint()
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		x.ChainId = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8308,52 +8522,36 @@ func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EthCallRequest.args":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8361,7 +8559,7 @@ func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8372,7 +8570,7 @@ func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8384,7 +8582,7 @@ func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EthCallRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8394,9 +8592,9 @@ func (x *fastReflection_EthCallRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8408,20 +8606,6 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Args)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GasCap != 0 {
-			n += 1 + runtime.Sov(uint64(x.GasCap))
-		}
-		l = len(x.ProposerAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8432,7 +8616,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8451,30 +8635,6 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.GasCap != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Args) > 0 {
-			i -= len(x.Args)
-			copy(dAtA[i:], x.Args)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8486,7 +8646,7 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EthCallRequest)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8518,132 +8678,26 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
-				if x.Args == nil {
-					x.Args = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
-				}
-				x.GasCap = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GasCap |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -8666,29 +8720,25 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EstimateGasResponse          protoreflect.MessageDescriptor
-	fd_EstimateGasResponse_gas      protoreflect.FieldDescriptor
-	fd_EstimateGasResponse_ret      protoreflect.FieldDescriptor
-	fd_EstimateGasResponse_vm_error protoreflect.FieldDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_EstimateGasResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EstimateGasResponse")
-	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
-	fd_EstimateGasResponse_ret = md_EstimateGasResponse.Fields().ByName("ret")
-	fd_EstimateGasResponse_vm_error = md_EstimateGasResponse.Fields().ByName("vm_error")
+	md_QueryParamsResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_EstimateGasResponse EstimateGasResponse
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8700,43 +8750,43 @@ func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
-var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_EstimateGasResponse_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EstimateGasResponse)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_EstimateGasResponse
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
-	return _fastReflection_EstimateGasResponse_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
-	return new(fastReflection_EstimateGasResponse)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
-	return (*EstimateGasResponse)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8744,22 +8794,10 @@ func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Gas != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Gas)
-		if !f(fd_EstimateGasResponse_gas, value) {
-			return
-		}
-	}
-	if len(x.Ret) != 0 {
-		value := protoreflect.ValueOfBytes(x.Ret)
-		if !f(fd_EstimateGasResponse_ret, value) {
-			return
-		}
-	}
-	if x.VmError != "" {
-		value := protoreflect.ValueOfString(x.VmError)
-		if !f(fd_EstimateGasResponse_vm_error, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -8776,19 +8814,15 @@ func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		return x.Gas != uint64(0)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		return len(x.Ret) != 0
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		return x.VmError != ""
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8798,19 +8832,15 @@ func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		x.Gas = uint64(0)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		x.Ret = nil
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		x.VmError = ""
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8820,22 +8850,16 @@ func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		value := x.Gas
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		value := x.Ret
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		value := x.VmError
-		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8849,19 +8873,15 @@ func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		x.Gas = value.Uint()
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		x.Ret = value.Bytes()
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		x.VmError = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8875,48 +8895,44 @@ func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		panic(fmt.Errorf("field gas of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		panic(fmt.Errorf("field vm_error of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryParamsResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EstimateGasResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8924,7 +8940,7 @@ func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8935,7 +8951,7 @@ func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8947,7 +8963,7 @@ func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EstimateGasResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8957,9 +8973,9 @@ func (x *fastReflection_EstimateGasResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8971,15 +8987,8 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Gas != 0 {
-			n += 1 + runtime.Sov(uint64(x.Gas))
-		}
-		l = len(x.Ret)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.VmError)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -8992,7 +9001,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9011,24 +9020,19 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.VmError) > 0 {
-			i -= len(x.VmError)
-			copy(dAtA[i:], x.VmError)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Ret) > 0 {
-			i -= len(x.Ret)
-			copy(dAtA[i:], x.Ret)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Gas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9041,7 +9045,7 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EstimateGasResponse)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9073,36 +9077,17 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-				}
-				x.Gas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Gas |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9112,57 +9097,27 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
-				if x.Ret == nil {
-					x.Ret = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.VmError = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9199,93 +9154,82 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+var _ protoreflect.List = (*_EthCallRequest_6_list)(nil)
 
-type _QueryTraceTxRequest_4_list struct {
-	list *[]*MsgEthereumTx
+type _EthCallRequest_6_list struct {
+	list *[][]byte
 }
 
-func (x *_QueryTraceTxRequest_4_list) Len() int {
+func (x *_EthCallRequest_6_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+func (x *_EthCallRequest_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
 }
 
-func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+func (x *_EthCallRequest_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+func (x *_EthCallRequest_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_EthCallRequest_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EthCallRequest at list field PendingTxs as it is not of Message kind"))
 }
 
-func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
+func (x *_EthCallRequest_6_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+func (x *_EthCallRequest_6_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
 }
 
-func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
+func (x *_EthCallRequest_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+	md_EthCallRequest                  protoreflect.MessageDescriptor
+	fd_EthCallRequest_args             protoreflect.FieldDescriptor
+	fd_EthCallRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_EthCallRequest_proposer_address protoreflect.FieldDescriptor
+	fd_EthCallRequest_chain_id         protoreflect.FieldDescriptor
+	fd_EthCallRequest_overrides        protoreflect.FieldDescriptor
+	fd_EthCallRequest_pending_txs      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceTxRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
-	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
-	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
-	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
-	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
-	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
-	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
-	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
-	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+	md_EthCallRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallRequest")
+	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
+	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
+	fd_EthCallRequest_proposer_address = md_EthCallRequest.Fields().ByName("proposer_address")
+	fd_EthCallRequest_chain_id = md_EthCallRequest.Fields().ByName("chain_id")
+	fd_EthCallRequest_overrides = md_EthCallRequest.Fields().ByName("overrides")
+	fd_EthCallRequest_pending_txs = md_EthCallRequest.Fields().ByName("pending_txs")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
 
-type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+type fastReflection_EthCallRequest EthCallRequest
 
-func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(x)
+func (x *EthCallRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(x)
 }
 
-func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+func (x *EthCallRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9297,43 +9241,43 @@ func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+var _fastReflection_EthCallRequest_messageType fastReflection_EthCallRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallRequest_messageType{}
 
-type fastReflection_QueryTraceTxRequest_messageType struct{}
+type fastReflection_EthCallRequest_messageType struct{}
 
-func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxRequest)(nil)
+func (x fastReflection_EthCallRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallRequest)(nil)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x fastReflection_EthCallRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
-func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x fastReflection_EthCallRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxRequest
+func (x *fastReflection_EthCallRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxRequest_messageType
+func (x *fastReflection_EthCallRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxRequest)
+func (x *fastReflection_EthCallRequest) New() protoreflect.Message {
+	return new(fastReflection_EthCallRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxRequest)(x)
+func (x *fastReflection_EthCallRequest) Interface() protoreflect.ProtoMessage {
+	return (*EthCallRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9341,58 +9285,40 @@ func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Msg != nil {
-		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_msg, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_trace_config, value) {
-			return
-		}
-	}
-	if len(x.Predecessors) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
-		if !f(fd_QueryTraceTxRequest_predecessors, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceTxRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceTxRequest_block_hash, value) {
+func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfBytes(x.Args)
+		if !f(fd_EthCallRequest_args, value) {
 			return
 		}
 	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceTxRequest_block_time, value) {
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_EthCallRequest_gas_cap, value) {
 			return
 		}
 	}
 	if len(x.ProposerAddress) != 0 {
 		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
+		if !f(fd_EthCallRequest_proposer_address, value) {
 			return
 		}
 	}
 	if x.ChainId != int64(0) {
 		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceTxRequest_chain_id, value) {
+		if !f(fd_EthCallRequest_chain_id, value) {
 			return
 		}
 	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+	if len(x.Overrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.Overrides)
+		if !f(fd_EthCallRequest_overrides, value) {
+			return
+		}
+	}
+	if len(x.PendingTxs) != 0 {
+		value := protoreflect.ValueOfList(&_EthCallRequest_6_list{list: &x.PendingTxs})
+		if !f(fd_EthCallRequest_pending_txs, value) {
 			return
 		}
 	}
@@ -9409,31 +9335,25 @@ func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		return x.Msg != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		return len(x.Predecessors) != 0
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		return len(x.Args) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
 		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
 		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		return len(x.Overrides) != 0
+	case "cosmos.evm.vm.v1.EthCallRequest.pending_txs":
+		return len(x.PendingTxs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9443,31 +9363,25 @@ func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		x.Msg = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		x.Predecessors = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		x.Args = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
 		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
 		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		x.Overrides = nil
+	case "cosmos.evm.vm.v1.EthCallRequest.pending_txs":
+		x.PendingTxs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9477,43 +9391,34 @@ func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		value := x.Msg
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		value := x.TraceConfig
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		if len(x.Predecessors) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
-		}
-		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		value := x.BlockHash
-		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		value := x.BlockTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		value := x.Args
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
 		value := x.ProposerAddress
 		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
 		value := x.ChainId
 		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallRequest.pending_txs":
+		if len(x.PendingTxs) == 0 {
+			return protoreflect.ValueOfList(&_EthCallRequest_6_list{})
+		}
+		listValue := &_EthCallRequest_6_list{list: &x.PendingTxs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9527,33 +9432,27 @@ func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		x.Msg = value.Message().Interface().(*MsgEthereumTx)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		lv := value.List()
-		clv := lv.(*_QueryTraceTxRequest_4_list)
-		x.Predecessors = *clv.list
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		x.Args = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
 		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
 		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		x.Overrides = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallRequest.pending_txs":
+		lv := value.List()
+		clv := lv.(*_EthCallRequest_6_list)
+		x.PendingTxs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9567,89 +9466,65 @@ func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		if x.Msg == nil {
-			x.Msg = new(MsgEthereumTx)
-		}
-		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		if x.Predecessors == nil {
-			x.Predecessors = []*MsgEthereumTx{}
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
+		panic(fmt.Errorf("field args of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		panic(fmt.Errorf("field overrides of message cosmos.evm.vm.v1.EthCallRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallRequest.pending_txs":
+		if x.PendingTxs == nil {
+			x.PendingTxs = [][]byte{}
 		}
-		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		value := &_EthCallRequest_6_list{list: &x.PendingTxs}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
-		m := new(MsgEthereumTx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
-		m := new(TraceConfig)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
-		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+	case "cosmos.evm.vm.v1.EthCallRequest.args":
 		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+	case "cosmos.evm.vm.v1.EthCallRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.EthCallRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.chain_id":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.EthCallRequest.overrides":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallRequest.pending_txs":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_EthCallRequest_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EthCallRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9657,7 +9532,7 @@ func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EthCallRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9668,7 +9543,7 @@ func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EthCallRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9680,7 +9555,7 @@ func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+func (x *fastReflection_EthCallRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -9690,9 +9565,9 @@ func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9704,30 +9579,12 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Msg != nil {
-			l = options.Size(x.Msg)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Predecessors) > 0 {
-			for _, e := range x.Predecessors {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
+		l = len(x.Args)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
 		}
 		l = len(x.ProposerAddress)
 		if l > 0 {
@@ -9736,8 +9593,15 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		if x.ChainId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ChainId))
 		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		l = len(x.Overrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PendingTxs) > 0 {
+			for _, b := range x.PendingTxs {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9749,7 +9613,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9768,92 +9632,45 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+		if len(x.PendingTxs) > 0 {
+			for iNdEx := len(x.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PendingTxs[iNdEx])
+				copy(dAtA[i:], x.PendingTxs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingTxs[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Overrides) > 0 {
+			i -= len(x.Overrides)
+			copy(dAtA[i:], x.Overrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Overrides)))
 			i--
-			dAtA[i] = 0x50
+			dAtA[i] = 0x2a
 		}
 		if x.ChainId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
 			i--
-			dAtA[i] = 0x48
+			dAtA[i] = 0x20
 		}
 		if len(x.ProposerAddress) > 0 {
 			i -= len(x.ProposerAddress)
 			copy(dAtA[i:], x.ProposerAddress)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
 			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1a
 		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x10
 		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
 			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Predecessors) > 0 {
-			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Predecessors[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.Msg != nil {
-			encoded, err := options.Marshal(x.Msg)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9866,7 +9683,7 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxRequest)
+		x := input.Message.Interface().(*EthCallRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9898,17 +9715,17 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9918,33 +9735,31 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Msg == nil {
-					x.Msg = &MsgEthereumTx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Args = append(x.Args[:0], dAtA[iNdEx:postIndex]...)
+				if x.Args == nil {
+					x.Args = []byte{}
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 				}
-				var msglen int
+				x.GasCap = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9954,33 +9769,16 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.GasCap |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9990,50 +9788,31 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
 				}
 				iNdEx = postIndex
-			case 5:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 				}
-				var stringLen uint64
+				x.ChainId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10043,29 +9822,16 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ChainId |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10075,31 +9841,29 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Overrides = append(x.Overrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.Overrides == nil {
+					x.Overrides = []byte{}
 				}
 				iNdEx = postIndex
-			case 8:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -10126,49 +9890,9 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
+				x.PendingTxs = append(x.PendingTxs, make([]byte, postIndex-iNdEx))
+				copy(x.PendingTxs[len(x.PendingTxs)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-				}
-				x.BlockMaxGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10204,26 +9928,78 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_EthCallBatchRequest_1_list)(nil)
+
+type _EthCallBatchRequest_1_list struct {
+	list *[][]byte
+}
+
+func (x *_EthCallBatchRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EthCallBatchRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_EthCallBatchRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EthCallBatchRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EthCallBatchRequest_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EthCallBatchRequest at list field Args as it is not of Message kind"))
+}
+
+func (x *_EthCallBatchRequest_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EthCallBatchRequest_1_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_EthCallBatchRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+	md_EthCallBatchRequest                  protoreflect.MessageDescriptor
+	fd_EthCallBatchRequest_args             protoreflect.FieldDescriptor
+	fd_EthCallBatchRequest_gas_cap          protoreflect.FieldDescriptor
+	fd_EthCallBatchRequest_proposer_address protoreflect.FieldDescriptor
+	fd_EthCallBatchRequest_chain_id         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceTxResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
-	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+	md_EthCallBatchRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallBatchRequest")
+	fd_EthCallBatchRequest_args = md_EthCallBatchRequest.Fields().ByName("args")
+	fd_EthCallBatchRequest_gas_cap = md_EthCallBatchRequest.Fields().ByName("gas_cap")
+	fd_EthCallBatchRequest_proposer_address = md_EthCallBatchRequest.Fields().ByName("proposer_address")
+	fd_EthCallBatchRequest_chain_id = md_EthCallBatchRequest.Fields().ByName("chain_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_EthCallBatchRequest)(nil)
 
-type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+type fastReflection_EthCallBatchRequest EthCallBatchRequest
 
-func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(x)
+func (x *EthCallBatchRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallBatchRequest)(x)
 }
 
-func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *EthCallBatchRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10235,43 +10011,43 @@ func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+var _fastReflection_EthCallBatchRequest_messageType fastReflection_EthCallBatchRequest_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallBatchRequest_messageType{}
 
-type fastReflection_QueryTraceTxResponse_messageType struct{}
+type fastReflection_EthCallBatchRequest_messageType struct{}
 
-func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceTxResponse)(nil)
+func (x fastReflection_EthCallBatchRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallBatchRequest)(nil)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x fastReflection_EthCallBatchRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallBatchRequest)
 }
-func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x fastReflection_EthCallBatchRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallBatchRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceTxResponse
+func (x *fastReflection_EthCallBatchRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallBatchRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceTxResponse_messageType
+func (x *fastReflection_EthCallBatchRequest) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallBatchRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceTxResponse)
+func (x *fastReflection_EthCallBatchRequest) New() protoreflect.Message {
+	return new(fastReflection_EthCallBatchRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceTxResponse)(x)
+func (x *fastReflection_EthCallBatchRequest) Interface() protoreflect.ProtoMessage {
+	return (*EthCallBatchRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10279,10 +10055,28 @@ func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceTxResponse_data, value) {
+func (x *fastReflection_EthCallBatchRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfList(&_EthCallBatchRequest_1_list{list: &x.Args})
+		if !f(fd_EthCallBatchRequest_args, value) {
+			return
+		}
+	}
+	if x.GasCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasCap)
+		if !f(fd_EthCallBatchRequest_gas_cap, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_EthCallBatchRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_EthCallBatchRequest_chain_id, value) {
 			return
 		}
 	}
@@ -10299,15 +10093,21 @@ func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EthCallBatchRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.args":
+		return len(x.Args) != 0
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.gas_cap":
+		return x.GasCap != uint64(0)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.chain_id":
+		return x.ChainId != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10317,15 +10117,21 @@ func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EthCallBatchRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		x.Data = nil
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.args":
+		x.Args = nil
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.gas_cap":
+		x.GasCap = uint64(0)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.chain_id":
+		x.ChainId = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10335,16 +10141,28 @@ func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallBatchRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		value := x.Data
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.args":
+		if len(x.Args) == 0 {
+			return protoreflect.ValueOfList(&_EthCallBatchRequest_1_list{})
+		}
+		listValue := &_EthCallBatchRequest_1_list{list: &x.Args}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.gas_cap":
+		value := x.GasCap
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.proposer_address":
+		value := x.ProposerAddress
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10358,15 +10176,23 @@ func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EthCallBatchRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.args":
+		lv := value.List()
+		clv := lv.(*_EthCallBatchRequest_1_list)
+		x.Args = *clv.list
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.gas_cap":
+		x.GasCap = value.Uint()
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.chain_id":
+		x.ChainId = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10380,40 +10206,57 @@ func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallBatchRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceTxResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.args":
+		if x.Args == nil {
+			x.Args = [][]byte{}
+		}
+		value := &_EthCallBatchRequest_1_list{list: &x.Args}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.gas_cap":
+		panic(fmt.Errorf("field gas_cap of message cosmos.evm.vm.v1.EthCallBatchRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.EthCallBatchRequest is not mutable"))
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.EthCallBatchRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallBatchRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.args":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_EthCallBatchRequest_1_list{list: &list})
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.gas_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.proposer_address":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EthCallBatchRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EthCallBatchRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallBatchRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10421,7 +10264,7 @@ func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EthCallBatchRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10432,7 +10275,7 @@ func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EthCallBatchRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10444,7 +10287,7 @@ func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+func (x *fastReflection_EthCallBatchRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -10454,9 +10297,9 @@ func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EthCallBatchRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallBatchRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10468,10 +10311,22 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
+		if len(x.Args) > 0 {
+			for _, b := range x.Args {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GasCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasCap))
+		}
+		l = len(x.ProposerAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10482,7 +10337,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallBatchRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10501,12 +10356,31 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x20
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Args) > 0 {
+			for iNdEx := len(x.Args) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Args[iNdEx])
+				copy(dAtA[i:], x.Args[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10519,7 +10393,7 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceTxResponse)
+		x := input.Message.Interface().(*EthCallBatchRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10551,15 +10425,15 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallBatchRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -10586,11 +10460,81 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.Args = append(x.Args, make([]byte, postIndex-iNdEx))
+				copy(x.Args[len(x.Args)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+				}
+				x.GasCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10626,139 +10570,76 @@ func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
+var (
+	md_EthCallBatchResult          protoreflect.MessageDescriptor
+	fd_EthCallBatchResult_response protoreflect.FieldDescriptor
+	fd_EthCallBatchResult_error    protoreflect.FieldDescriptor
+)
 
-type _QueryTraceBlockRequest_1_list struct {
-	list *[]*MsgEthereumTx
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_EthCallBatchResult = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallBatchResult")
+	fd_EthCallBatchResult_response = md_EthCallBatchResult.Fields().ByName("response")
+	fd_EthCallBatchResult_error = md_EthCallBatchResult.Fields().ByName("error")
 }
 
-func (x *_QueryTraceBlockRequest_1_list) Len() int {
-	if x.list == nil {
-		return 0
+var _ protoreflect.Message = (*fastReflection_EthCallBatchResult)(nil)
+
+type fastReflection_EthCallBatchResult EthCallBatchResult
+
+func (x *EthCallBatchResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallBatchResult)(x)
+}
+
+func (x *EthCallBatchResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return len(*x.list)
+	return mi.MessageOf(x)
 }
 
-func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+var _fastReflection_EthCallBatchResult_messageType fastReflection_EthCallBatchResult_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallBatchResult_messageType{}
+
+type fastReflection_EthCallBatchResult_messageType struct{}
+
+func (x fastReflection_EthCallBatchResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallBatchResult)(nil)
+}
+func (x fastReflection_EthCallBatchResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallBatchResult)
+}
+func (x fastReflection_EthCallBatchResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallBatchResult
 }
 
-func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	(*x.list)[i] = concreteValue
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EthCallBatchResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallBatchResult
 }
 
-func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
-	*x.list = append(*x.list, concreteValue)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EthCallBatchResult) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallBatchResult_messageType
 }
 
-func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
-	v := new(MsgEthereumTx)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
-	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
-	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceBlockRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
-	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
-	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
-	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
-	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
-	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
-	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
-	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
-	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
-}
-
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
-
-type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
-
-func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(x)
-}
-
-func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
-
-type fastReflection_QueryTraceBlockRequest_messageType struct{}
-
-func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockRequest)(nil)
-}
-func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
-}
-func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockRequest
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockRequest_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockRequest)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EthCallBatchResult) New() protoreflect.Message {
+	return new(fastReflection_EthCallBatchResult)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockRequest)(x)
+func (x *fastReflection_EthCallBatchResult) Interface() protoreflect.ProtoMessage {
+	return (*EthCallBatchResult)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10766,52 +10647,16 @@ func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
-		if !f(fd_QueryTraceBlockRequest_txs, value) {
-			return
-		}
-	}
-	if x.TraceConfig != nil {
-		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
-			return
-		}
-	}
-	if x.BlockNumber != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockNumber)
-		if !f(fd_QueryTraceBlockRequest_block_number, value) {
-			return
-		}
-	}
-	if x.BlockHash != "" {
-		value := protoreflect.ValueOfString(x.BlockHash)
-		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
-			return
-		}
-	}
-	if x.BlockTime != nil {
-		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-		if !f(fd_QueryTraceBlockRequest_block_time, value) {
-			return
-		}
-	}
-	if len(x.ProposerAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.ProposerAddress)
-		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
-			return
-		}
-	}
-	if x.ChainId != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ChainId)
-		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
+func (x *fastReflection_EthCallBatchResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Response != nil {
+		value := protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+		if !f(fd_EthCallBatchResult_response, value) {
 			return
 		}
 	}
-	if x.BlockMaxGas != int64(0) {
-		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
-		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EthCallBatchResult_error, value) {
 			return
 		}
 	}
@@ -10828,29 +10673,17 @@ func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EthCallBatchResult) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		return len(x.Txs) != 0
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		return x.TraceConfig != nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		return x.BlockNumber != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		return x.BlockHash != ""
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		return x.BlockTime != nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		return len(x.ProposerAddress) != 0
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		return x.ChainId != int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		return x.BlockMaxGas != int64(0)
+	case "cosmos.evm.vm.v1.EthCallBatchResult.response":
+		return x.Response != nil
+	case "cosmos.evm.vm.v1.EthCallBatchResult.error":
+		return x.Error != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10860,29 +10693,17 @@ func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EthCallBatchResult) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		x.Txs = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = ""
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = nil
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = int64(0)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = int64(0)
+	case "cosmos.evm.vm.v1.EthCallBatchResult.response":
+		x.Response = nil
+	case "cosmos.evm.vm.v1.EthCallBatchResult.error":
+		x.Error = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10892,40 +10713,19 @@ func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallBatchResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
-		}
-		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		value := x.TraceConfig
+	case "cosmos.evm.vm.v1.EthCallBatchResult.response":
+		value := x.Response
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		value := x.BlockNumber
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		value := x.BlockHash
+	case "cosmos.evm.vm.v1.EthCallBatchResult.error":
+		value := x.Error
 		return protoreflect.ValueOfString(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		value := x.BlockTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		value := x.ProposerAddress
-		return protoreflect.ValueOfBytes(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		value := x.ChainId
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		value := x.BlockMaxGas
-		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResult does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10939,31 +10739,17 @@ func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EthCallBatchResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		lv := value.List()
-		clv := lv.(*_QueryTraceBlockRequest_1_list)
-		x.Txs = *clv.list
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		x.TraceConfig = value.Message().Interface().(*TraceConfig)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		x.BlockNumber = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		x.BlockHash = value.Interface().(string)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		x.ProposerAddress = value.Bytes()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		x.ChainId = value.Int()
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		x.BlockMaxGas = value.Int()
+	case "cosmos.evm.vm.v1.EthCallBatchResult.response":
+		x.Response = value.Message().Interface().(*MsgEthereumTxResponse)
+	case "cosmos.evm.vm.v1.EthCallBatchResult.error":
+		x.Error = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10977,81 +10763,48 @@ func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallBatchResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		if x.Txs == nil {
-			x.Txs = []*MsgEthereumTx{}
-		}
-		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		if x.TraceConfig == nil {
-			x.TraceConfig = new(TraceConfig)
-		}
-		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		if x.BlockTime == nil {
-			x.BlockTime = new(timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.EthCallBatchResult.response":
+		if x.Response == nil {
+			x.Response = new(MsgEthereumTxResponse)
 		}
-		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
-		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+		return protoreflect.ValueOfMessage(x.Response.ProtoReflect())
+	case "cosmos.evm.vm.v1.EthCallBatchResult.error":
+		panic(fmt.Errorf("field error of message cosmos.evm.vm.v1.EthCallBatchResult is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EthCallBatchResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
-		list := []*MsgEthereumTx{}
-		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
-		m := new(TraceConfig)
+	case "cosmos.evm.vm.v1.EthCallBatchResult.response":
+		m := new(MsgEthereumTxResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+	case "cosmos.evm.vm.v1.EthCallBatchResult.error":
 		return protoreflect.ValueOfString("")
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
-		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResult"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResult does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EthCallBatchResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallBatchResult", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11059,7 +10812,7 @@ func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EthCallBatchResult) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11070,7 +10823,7 @@ func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EthCallBatchResult) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11082,7 +10835,7 @@ func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+func (x *fastReflection_EthCallBatchResult) IsValid() bool {
 	return x != nil
 }
 
@@ -11092,9 +10845,9 @@ func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EthCallBatchResult) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EthCallBatchResult)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11106,37 +10859,14 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.TraceConfig != nil {
-			l = options.Size(x.TraceConfig)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockNumber != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockNumber))
-		}
-		l = len(x.BlockHash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlockTime != nil {
-			l = options.Size(x.BlockTime)
+		if x.Response != nil {
+			l = options.Size(x.Response)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ProposerAddress)
+		l = len(x.Error)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ChainId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ChainId))
-		}
-		if x.BlockMaxGas != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11147,7 +10877,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EthCallBatchResult)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11166,51 +10896,15 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.BlockMaxGas != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
-			i--
-			dAtA[i] = 0x50
-		}
-		if x.ChainId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
-			i--
-			dAtA[i] = 0x48
-		}
-		if len(x.ProposerAddress) > 0 {
-			i -= len(x.ProposerAddress)
-			copy(dAtA[i:], x.ProposerAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if x.BlockTime != nil {
-			encoded, err := options.Marshal(x.BlockTime)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.BlockHash) > 0 {
-			i -= len(x.BlockHash)
-			copy(dAtA[i:], x.BlockHash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.BlockNumber != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
 			i--
-			dAtA[i] = 0x28
+			dAtA[i] = 0x12
 		}
-		if x.TraceConfig != nil {
-			encoded, err := options.Marshal(x.TraceConfig)
+		if x.Response != nil {
+			encoded, err := options.Marshal(x.Response)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11221,23 +10915,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11250,7 +10928,7 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		x := input.Message.Interface().(*EthCallBatchResult)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11282,49 +10960,15 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallBatchResult: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Txs = append(x.Txs, &MsgEthereumTx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -11351,35 +10995,16 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TraceConfig == nil {
-					x.TraceConfig = &TraceConfig{}
+				if x.Response == nil {
+					x.Response = &MsgEthereumTxResponse{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Response); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-				}
-				x.BlockNumber = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockNumber |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -11407,116 +11032,6721 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				x.Error = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.BlockTime == nil {
-					x.BlockTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.ProposerAddress == nil {
-					x.ProposerAddress = []byte{}
-				}
-				iNdEx = postIndex
-			case 9:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
-				x.ChainId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EthCallBatchResponse_1_list)(nil)
+
+type _EthCallBatchResponse_1_list struct {
+	list *[]*EthCallBatchResult
+}
+
+func (x *_EthCallBatchResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EthCallBatchResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EthCallBatchResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EthCallBatchResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EthCallBatchResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EthCallBatchResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EthCallBatchResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(EthCallBatchResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EthCallBatchResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EthCallBatchResponse_1_list) NewElement() protoreflect.Value {
+	v := new(EthCallBatchResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EthCallBatchResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EthCallBatchResponse         protoreflect.MessageDescriptor
+	fd_EthCallBatchResponse_results protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_EthCallBatchResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EthCallBatchResponse")
+	fd_EthCallBatchResponse_results = md_EthCallBatchResponse.Fields().ByName("results")
+}
+
+var _ protoreflect.Message = (*fastReflection_EthCallBatchResponse)(nil)
+
+type fastReflection_EthCallBatchResponse EthCallBatchResponse
+
+func (x *EthCallBatchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EthCallBatchResponse)(x)
+}
+
+func (x *EthCallBatchResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EthCallBatchResponse_messageType fastReflection_EthCallBatchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EthCallBatchResponse_messageType{}
+
+type fastReflection_EthCallBatchResponse_messageType struct{}
+
+func (x fastReflection_EthCallBatchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EthCallBatchResponse)(nil)
+}
+func (x fastReflection_EthCallBatchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EthCallBatchResponse)
+}
+func (x fastReflection_EthCallBatchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallBatchResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EthCallBatchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EthCallBatchResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EthCallBatchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EthCallBatchResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EthCallBatchResponse) New() protoreflect.Message {
+	return new(fastReflection_EthCallBatchResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EthCallBatchResponse) Interface() protoreflect.ProtoMessage {
+	return (*EthCallBatchResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EthCallBatchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Results) != 0 {
+		value := protoreflect.ValueOfList(&_EthCallBatchResponse_1_list{list: &x.Results})
+		if !f(fd_EthCallBatchResponse_results, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EthCallBatchResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EthCallBatchResponse.results":
+		return len(x.Results) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EthCallBatchResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EthCallBatchResponse.results":
+		x.Results = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EthCallBatchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.EthCallBatchResponse.results":
+		if len(x.Results) == 0 {
+			return protoreflect.ValueOfList(&_EthCallBatchResponse_1_list{})
+		}
+		listValue := &_EthCallBatchResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EthCallBatchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EthCallBatchResponse.results":
+		lv := value.List()
+		clv := lv.(*_EthCallBatchResponse_1_list)
+		x.Results = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EthCallBatchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EthCallBatchResponse.results":
+		if x.Results == nil {
+			x.Results = []*EthCallBatchResult{}
+		}
+		value := &_EthCallBatchResponse_1_list{list: &x.Results}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EthCallBatchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EthCallBatchResponse.results":
+		list := []*EthCallBatchResult{}
+		return protoreflect.ValueOfList(&_EthCallBatchResponse_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EthCallBatchResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EthCallBatchResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EthCallBatchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EthCallBatchResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EthCallBatchResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EthCallBatchResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EthCallBatchResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EthCallBatchResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EthCallBatchResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Results) > 0 {
+			for _, e := range x.Results {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EthCallBatchResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Results) > 0 {
+			for iNdEx := len(x.Results) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Results[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EthCallBatchResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallBatchResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EthCallBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Results = append(x.Results, &EthCallBatchResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Results[len(x.Results)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EstimateGasResponse          protoreflect.MessageDescriptor
+	fd_EstimateGasResponse_gas      protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_ret      protoreflect.FieldDescriptor
+	fd_EstimateGasResponse_vm_error protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_EstimateGasResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("EstimateGasResponse")
+	fd_EstimateGasResponse_gas = md_EstimateGasResponse.Fields().ByName("gas")
+	fd_EstimateGasResponse_ret = md_EstimateGasResponse.Fields().ByName("ret")
+	fd_EstimateGasResponse_vm_error = md_EstimateGasResponse.Fields().ByName("vm_error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EstimateGasResponse)(nil)
+
+type fastReflection_EstimateGasResponse EstimateGasResponse
+
+func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(x)
+}
+
+func (x *EstimateGasResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EstimateGasResponse_messageType fastReflection_EstimateGasResponse_messageType
+var _ protoreflect.MessageType = fastReflection_EstimateGasResponse_messageType{}
+
+type fastReflection_EstimateGasResponse_messageType struct{}
+
+func (x fastReflection_EstimateGasResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EstimateGasResponse)(nil)
+}
+func (x fastReflection_EstimateGasResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
+}
+func (x fastReflection_EstimateGasResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EstimateGasResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EstimateGasResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EstimateGasResponse) Type() protoreflect.MessageType {
+	return _fastReflection_EstimateGasResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EstimateGasResponse) New() protoreflect.Message {
+	return new(fastReflection_EstimateGasResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EstimateGasResponse) Interface() protoreflect.ProtoMessage {
+	return (*EstimateGasResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EstimateGasResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Gas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Gas)
+		if !f(fd_EstimateGasResponse_gas, value) {
+			return
+		}
+	}
+	if len(x.Ret) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ret)
+		if !f(fd_EstimateGasResponse_ret, value) {
+			return
+		}
+	}
+	if x.VmError != "" {
+		value := protoreflect.ValueOfString(x.VmError)
+		if !f(fd_EstimateGasResponse_vm_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EstimateGasResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		return x.Gas != uint64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		return len(x.Ret) != 0
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		return x.VmError != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		x.Gas = uint64(0)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		x.Ret = nil
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		x.VmError = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EstimateGasResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		value := x.Gas
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		value := x.Ret
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		value := x.VmError
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		x.Gas = value.Uint()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		x.Ret = value.Bytes()
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		x.VmError = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		panic(fmt.Errorf("field gas of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		panic(fmt.Errorf("field ret of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		panic(fmt.Errorf("field vm_error of message cosmos.evm.vm.v1.EstimateGasResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EstimateGasResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.EstimateGasResponse.gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.EstimateGasResponse.ret":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.EstimateGasResponse.vm_error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.EstimateGasResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.EstimateGasResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EstimateGasResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.EstimateGasResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EstimateGasResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EstimateGasResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EstimateGasResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EstimateGasResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Gas != 0 {
+			n += 1 + runtime.Sov(uint64(x.Gas))
+		}
+		l = len(x.Ret)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VmError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EstimateGasResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VmError) > 0 {
+			i -= len(x.VmError)
+			copy(dAtA[i:], x.VmError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Ret) > 0 {
+			i -= len(x.Ret)
+			copy(dAtA[i:], x.Ret)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Gas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Gas))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EstimateGasResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+				}
+				x.Gas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Gas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ret == nil {
+					x.Ret = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VmError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceTxRequest_4_list)(nil)
+
+type _QueryTraceTxRequest_4_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceTxRequest_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceTxRequest_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceTxRequest_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceTxRequest_4_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceTxRequest_4_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceTxRequest_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceTxRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceTxRequest_msg              protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_predecessors     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceTxRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxRequest")
+	fd_QueryTraceTxRequest_msg = md_QueryTraceTxRequest.Fields().ByName("msg")
+	fd_QueryTraceTxRequest_trace_config = md_QueryTraceTxRequest.Fields().ByName("trace_config")
+	fd_QueryTraceTxRequest_predecessors = md_QueryTraceTxRequest.Fields().ByName("predecessors")
+	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
+	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
+	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
+	fd_QueryTraceTxRequest_proposer_address = md_QueryTraceTxRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceTxRequest_chain_id = md_QueryTraceTxRequest.Fields().ByName("chain_id")
+	fd_QueryTraceTxRequest_block_max_gas = md_QueryTraceTxRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
+
+type fastReflection_QueryTraceTxRequest QueryTraceTxRequest
+
+func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(x)
+}
+
+func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxRequest_messageType fastReflection_QueryTraceTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxRequest_messageType{}
+
+type fastReflection_QueryTraceTxRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxRequest)(nil)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+func (x fastReflection_QueryTraceTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Msg != nil {
+		value := protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_msg, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_trace_config, value) {
+			return
+		}
+	}
+	if len(x.Predecessors) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &x.Predecessors})
+		if !f(fd_QueryTraceTxRequest_predecessors, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceTxRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceTxRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceTxRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceTxRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceTxRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceTxRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		return x.Msg != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		return len(x.Predecessors) != 0
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		x.Msg = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		x.Predecessors = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		value := x.Msg
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		if len(x.Predecessors) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{})
+		}
+		listValue := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		x.Msg = value.Message().Interface().(*MsgEthereumTx)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		lv := value.List()
+		clv := lv.(*_QueryTraceTxRequest_4_list)
+		x.Predecessors = *clv.list
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		if x.Msg == nil {
+			x.Msg = new(MsgEthereumTx)
+		}
+		return protoreflect.ValueOfMessage(x.Msg.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		if x.Predecessors == nil {
+			x.Predecessors = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceTxRequest_4_list{list: &x.Predecessors}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceTxRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.msg":
+		m := new(MsgEthereumTx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceTxRequest_4_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceTxRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Msg != nil {
+			l = options.Size(x.Msg)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Predecessors) > 0 {
+			for _, e := range x.Predecessors {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Predecessors) > 0 {
+			for iNdEx := len(x.Predecessors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Predecessors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Msg != nil {
+			encoded, err := options.Marshal(x.Msg)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Msg == nil {
+					x.Msg = &MsgEthereumTx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msg); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Predecessors = append(x.Predecessors, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Predecessors[len(x.Predecessors)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceTxResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceTxResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceTxResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceTxResponse")
+	fd_QueryTraceTxResponse_data = md_QueryTraceTxResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceTxResponse)(nil)
+
+type fastReflection_QueryTraceTxResponse QueryTraceTxResponse
+
+func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(x)
+}
+
+func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceTxResponse_messageType fastReflection_QueryTraceTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceTxResponse_messageType{}
+
+type fastReflection_QueryTraceTxResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceTxResponse)(nil)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+func (x fastReflection_QueryTraceTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceTxResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceTxResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceTxResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceTxResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTraceBlockRequest_1_list)(nil)
+
+type _QueryTraceBlockRequest_1_list struct {
+	list *[]*MsgEthereumTx
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgEthereumTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTraceBlockRequest_1_list) AppendMutable() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTraceBlockRequest_1_list) NewElement() protoreflect.Value {
+	v := new(MsgEthereumTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTraceBlockRequest_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTraceBlockRequest                  protoreflect.MessageDescriptor
+	fd_QueryTraceBlockRequest_txs              protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_trace_config     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_number     protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_hash       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_time       protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_proposer_address protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_chain_id         protoreflect.FieldDescriptor
+	fd_QueryTraceBlockRequest_block_max_gas    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceBlockRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockRequest")
+	fd_QueryTraceBlockRequest_txs = md_QueryTraceBlockRequest.Fields().ByName("txs")
+	fd_QueryTraceBlockRequest_trace_config = md_QueryTraceBlockRequest.Fields().ByName("trace_config")
+	fd_QueryTraceBlockRequest_block_number = md_QueryTraceBlockRequest.Fields().ByName("block_number")
+	fd_QueryTraceBlockRequest_block_hash = md_QueryTraceBlockRequest.Fields().ByName("block_hash")
+	fd_QueryTraceBlockRequest_block_time = md_QueryTraceBlockRequest.Fields().ByName("block_time")
+	fd_QueryTraceBlockRequest_proposer_address = md_QueryTraceBlockRequest.Fields().ByName("proposer_address")
+	fd_QueryTraceBlockRequest_chain_id = md_QueryTraceBlockRequest.Fields().ByName("chain_id")
+	fd_QueryTraceBlockRequest_block_max_gas = md_QueryTraceBlockRequest.Fields().ByName("block_max_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockRequest)(nil)
+
+type fastReflection_QueryTraceBlockRequest QueryTraceBlockRequest
+
+func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(x)
+}
+
+func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockRequest_messageType fastReflection_QueryTraceBlockRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockRequest_messageType{}
+
+type fastReflection_QueryTraceBlockRequest_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockRequest)(nil)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+func (x fastReflection_QueryTraceBlockRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &x.Txs})
+		if !f(fd_QueryTraceBlockRequest_txs, value) {
+			return
+		}
+	}
+	if x.TraceConfig != nil {
+		value := protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_trace_config, value) {
+			return
+		}
+	}
+	if x.BlockNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockNumber)
+		if !f(fd_QueryTraceBlockRequest_block_number, value) {
+			return
+		}
+	}
+	if x.BlockHash != "" {
+		value := protoreflect.ValueOfString(x.BlockHash)
+		if !f(fd_QueryTraceBlockRequest_block_hash, value) {
+			return
+		}
+	}
+	if x.BlockTime != nil {
+		value := protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+		if !f(fd_QueryTraceBlockRequest_block_time, value) {
+			return
+		}
+	}
+	if len(x.ProposerAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.ProposerAddress)
+		if !f(fd_QueryTraceBlockRequest_proposer_address, value) {
+			return
+		}
+	}
+	if x.ChainId != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChainId)
+		if !f(fd_QueryTraceBlockRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.BlockMaxGas != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockMaxGas)
+		if !f(fd_QueryTraceBlockRequest_block_max_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		return len(x.Txs) != 0
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		return x.TraceConfig != nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		return x.BlockNumber != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		return x.BlockHash != ""
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		return x.BlockTime != nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		return len(x.ProposerAddress) != 0
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		return x.ChainId != int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		return x.BlockMaxGas != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		x.Txs = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = ""
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = nil
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = int64(0)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{})
+		}
+		listValue := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		value := x.TraceConfig
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		value := x.BlockNumber
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		value := x.BlockHash
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		value := x.BlockTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		value := x.ProposerAddress
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		value := x.BlockMaxGas
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		lv := value.List()
+		clv := lv.(*_QueryTraceBlockRequest_1_list)
+		x.Txs = *clv.list
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		x.TraceConfig = value.Message().Interface().(*TraceConfig)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		x.BlockNumber = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		x.BlockHash = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		x.ProposerAddress = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		x.ChainId = value.Int()
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		x.BlockMaxGas = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		if x.Txs == nil {
+			x.Txs = []*MsgEthereumTx{}
+		}
+		value := &_QueryTraceBlockRequest_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		if x.TraceConfig == nil {
+			x.TraceConfig = new(TraceConfig)
+		}
+		return protoreflect.ValueOfMessage(x.TraceConfig.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		if x.BlockTime == nil {
+			x.BlockTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.BlockTime.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		panic(fmt.Errorf("field block_number of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		panic(fmt.Errorf("field block_hash of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		panic(fmt.Errorf("field proposer_address of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		panic(fmt.Errorf("field block_max_gas of message cosmos.evm.vm.v1.QueryTraceBlockRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.txs":
+		list := []*MsgEthereumTx{}
+		return protoreflect.ValueOfList(&_QueryTraceBlockRequest_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config":
+		m := new(TraceConfig)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_number":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_hash":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.proposer_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.chain_id":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.evm.vm.v1.QueryTraceBlockRequest.block_max_gas":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TraceConfig != nil {
+			l = options.Size(x.TraceConfig)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockNumber))
+		}
+		l = len(x.BlockHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockTime != nil {
+			l = options.Size(x.BlockTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposerAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ChainId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChainId))
+		}
+		if x.BlockMaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockMaxGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockMaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockMaxGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.ChainId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChainId))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.ProposerAddress) > 0 {
+			i -= len(x.ProposerAddress)
+			copy(dAtA[i:], x.ProposerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposerAddress)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.BlockTime != nil {
+			encoded, err := options.Marshal(x.BlockTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.BlockHash) > 0 {
+			i -= len(x.BlockHash)
+			copy(dAtA[i:], x.BlockHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BlockNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockNumber))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TraceConfig != nil {
+			encoded, err := options.Marshal(x.TraceConfig)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &MsgEthereumTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TraceConfig == nil {
+					x.TraceConfig = &TraceConfig{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TraceConfig); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				}
+				x.BlockNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockTime == nil {
+					x.BlockTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposerAddress = append(x.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.ProposerAddress == nil {
+					x.ProposerAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				x.ChainId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChainId |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				}
+				x.BlockMaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockMaxGas |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
+	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryTraceBlockResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
+	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+
+type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+
+func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(x)
+}
+
+func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+
+type fastReflection_QueryTraceBlockResponse_messageType struct{}
+
+func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTraceBlockResponse)(nil)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTraceBlockResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTraceBlockResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTraceBlockResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTraceBlockResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Data) != 0 {
+		value := protoreflect.ValueOfBytes(x.Data)
+		if !f(fd_QueryTraceBlockResponse_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		return len(x.Data) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		x.Data = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		value := x.Data
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		x.Data = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceBlockResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Data)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Data) > 0 {
+			i -= len(x.Data)
+			copy(dAtA[i:], x.Data)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
+				if x.Data == nil {
+					x.Data = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBaseFeeRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryBaseFeeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBaseFeeRequest)(nil)
+
+type fastReflection_QueryBaseFeeRequest QueryBaseFeeRequest
+
+func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeRequest)(x)
+}
+
+func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBaseFeeRequest_messageType fastReflection_QueryBaseFeeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBaseFeeRequest_messageType{}
+
+type fastReflection_QueryBaseFeeRequest_messageType struct{}
+
+func (x fastReflection_QueryBaseFeeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeRequest)(nil)
+}
+func (x fastReflection_QueryBaseFeeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeRequest)
+}
+func (x fastReflection_QueryBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBaseFeeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBaseFeeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBaseFeeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryBaseFeeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryBaseFeeResponse          protoreflect.MessageDescriptor
+	fd_QueryBaseFeeResponse_base_fee protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryBaseFeeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeResponse")
+	fd_QueryBaseFeeResponse_base_fee = md_QueryBaseFeeResponse.Fields().ByName("base_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryBaseFeeResponse)(nil)
+
+type fastReflection_QueryBaseFeeResponse QueryBaseFeeResponse
+
+func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeResponse)(x)
+}
+
+func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryBaseFeeResponse_messageType fastReflection_QueryBaseFeeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryBaseFeeResponse_messageType{}
+
+type fastReflection_QueryBaseFeeResponse_messageType struct{}
+
+func (x fastReflection_QueryBaseFeeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryBaseFeeResponse)(nil)
+}
+func (x fastReflection_QueryBaseFeeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeResponse)
+}
+func (x fastReflection_QueryBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryBaseFeeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryBaseFeeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryBaseFeeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryBaseFeeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryBaseFeeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryBaseFeeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BaseFee != "" {
+		value := protoreflect.ValueOfString(x.BaseFee)
+		if !f(fd_QueryBaseFeeResponse_base_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		return x.BaseFee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		x.BaseFee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		value := x.BaseFee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		x.BaseFee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		panic(fmt.Errorf("field base_fee of message cosmos.evm.vm.v1.QueryBaseFeeResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BaseFee) > 0 {
+			i -= len(x.BaseFee)
+			copy(dAtA[i:], x.BaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGlobalMinGasPriceRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryGlobalMinGasPriceRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
+
+type fastReflection_QueryGlobalMinGasPriceRequest QueryGlobalMinGasPriceRequest
+
+func (x *QueryGlobalMinGasPriceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceRequest)(x)
+}
+
+func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGlobalMinGasPriceRequest_messageType fastReflection_QueryGlobalMinGasPriceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceRequest_messageType{}
+
+type fastReflection_QueryGlobalMinGasPriceRequest_messageType struct{}
+
+func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
+}
+func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceRequest)
+}
+func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGlobalMinGasPriceRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryGlobalMinGasPriceRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryGlobalMinGasPriceResponse               protoreflect.MessageDescriptor
+	fd_QueryGlobalMinGasPriceResponse_min_gas_price protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryGlobalMinGasPriceResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceResponse")
+	fd_QueryGlobalMinGasPriceResponse_min_gas_price = md_QueryGlobalMinGasPriceResponse.Fields().ByName("min_gas_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
+
+type fastReflection_QueryGlobalMinGasPriceResponse QueryGlobalMinGasPriceResponse
+
+func (x *QueryGlobalMinGasPriceResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceResponse)(x)
+}
+
+func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryGlobalMinGasPriceResponse_messageType fastReflection_QueryGlobalMinGasPriceResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceResponse_messageType{}
+
+type fastReflection_QueryGlobalMinGasPriceResponse_messageType struct{}
+
+func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
+}
+func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceResponse)
+}
+func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryGlobalMinGasPriceResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryGlobalMinGasPriceResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryGlobalMinGasPriceResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryGlobalMinGasPriceResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinGasPrice != "" {
+		value := protoreflect.ValueOfString(x.MinGasPrice)
+		if !f(fd_QueryGlobalMinGasPriceResponse_min_gas_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		return x.MinGasPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		x.MinGasPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		value := x.MinGasPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		x.MinGasPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MinGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinGasPrice) > 0 {
+			i -= len(x.MinGasPrice)
+			copy(dAtA[i:], x.MinGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPrice)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEIP712TypedDataRequest                protoreflect.MessageDescriptor
+	fd_QueryEIP712TypedDataRequest_tx_bytes       protoreflect.FieldDescriptor
+	fd_QueryEIP712TypedDataRequest_chain_id       protoreflect.FieldDescriptor
+	fd_QueryEIP712TypedDataRequest_account_number protoreflect.FieldDescriptor
+	fd_QueryEIP712TypedDataRequest_sequence       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryEIP712TypedDataRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryEIP712TypedDataRequest")
+	fd_QueryEIP712TypedDataRequest_tx_bytes = md_QueryEIP712TypedDataRequest.Fields().ByName("tx_bytes")
+	fd_QueryEIP712TypedDataRequest_chain_id = md_QueryEIP712TypedDataRequest.Fields().ByName("chain_id")
+	fd_QueryEIP712TypedDataRequest_account_number = md_QueryEIP712TypedDataRequest.Fields().ByName("account_number")
+	fd_QueryEIP712TypedDataRequest_sequence = md_QueryEIP712TypedDataRequest.Fields().ByName("sequence")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEIP712TypedDataRequest)(nil)
+
+type fastReflection_QueryEIP712TypedDataRequest QueryEIP712TypedDataRequest
+
+func (x *QueryEIP712TypedDataRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEIP712TypedDataRequest)(x)
+}
+
+func (x *QueryEIP712TypedDataRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEIP712TypedDataRequest_messageType fastReflection_QueryEIP712TypedDataRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEIP712TypedDataRequest_messageType{}
+
+type fastReflection_QueryEIP712TypedDataRequest_messageType struct{}
+
+func (x fastReflection_QueryEIP712TypedDataRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEIP712TypedDataRequest)(nil)
+}
+func (x fastReflection_QueryEIP712TypedDataRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEIP712TypedDataRequest)
+}
+func (x fastReflection_QueryEIP712TypedDataRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEIP712TypedDataRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEIP712TypedDataRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEIP712TypedDataRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEIP712TypedDataRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEIP712TypedDataRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEIP712TypedDataRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_QueryEIP712TypedDataRequest_tx_bytes, value) {
+			return
+		}
+	}
+	if x.ChainId != "" {
+		value := protoreflect.ValueOfString(x.ChainId)
+		if !f(fd_QueryEIP712TypedDataRequest_chain_id, value) {
+			return
+		}
+	}
+	if x.AccountNumber != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccountNumber)
+		if !f(fd_QueryEIP712TypedDataRequest_account_number, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_QueryEIP712TypedDataRequest_sequence, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.tx_bytes":
+		return len(x.TxBytes) != 0
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.chain_id":
+		return x.ChainId != ""
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.account_number":
+		return x.AccountNumber != uint64(0)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.sequence":
+		return x.Sequence != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.tx_bytes":
+		x.TxBytes = nil
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.chain_id":
+		x.ChainId = ""
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.account_number":
+		x.AccountNumber = uint64(0)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.sequence":
+		x.Sequence = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.chain_id":
+		value := x.ChainId
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.account_number":
+		value := x.AccountNumber
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.tx_bytes":
+		x.TxBytes = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.chain_id":
+		x.ChainId = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.account_number":
+		x.AccountNumber = value.Uint()
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.sequence":
+		x.Sequence = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.chain_id":
+		panic(fmt.Errorf("field chain_id of message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.account_number":
+		panic(fmt.Errorf("field account_number of message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest is not mutable"))
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.sequence":
+		panic(fmt.Errorf("field sequence of message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEIP712TypedDataRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.chain_id":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.account_number":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEIP712TypedDataRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryEIP712TypedDataRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEIP712TypedDataRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEIP712TypedDataRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEIP712TypedDataRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEIP712TypedDataRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChainId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AccountNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountNumber))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEIP712TypedDataRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.AccountNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountNumber))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ChainId) > 0 {
+			i -= len(x.ChainId)
+			copy(dAtA[i:], x.ChainId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChainId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEIP712TypedDataRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEIP712TypedDataRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEIP712TypedDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChainId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+				}
+				x.AccountNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountNumber |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryEIP712TypedDataResponse            protoreflect.MessageDescriptor
+	fd_QueryEIP712TypedDataResponse_typed_data protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryEIP712TypedDataResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryEIP712TypedDataResponse")
+	fd_QueryEIP712TypedDataResponse_typed_data = md_QueryEIP712TypedDataResponse.Fields().ByName("typed_data")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEIP712TypedDataResponse)(nil)
+
+type fastReflection_QueryEIP712TypedDataResponse QueryEIP712TypedDataResponse
+
+func (x *QueryEIP712TypedDataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEIP712TypedDataResponse)(x)
+}
+
+func (x *QueryEIP712TypedDataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryEIP712TypedDataResponse_messageType fastReflection_QueryEIP712TypedDataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEIP712TypedDataResponse_messageType{}
+
+type fastReflection_QueryEIP712TypedDataResponse_messageType struct{}
+
+func (x fastReflection_QueryEIP712TypedDataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEIP712TypedDataResponse)(nil)
+}
+func (x fastReflection_QueryEIP712TypedDataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEIP712TypedDataResponse)
+}
+func (x fastReflection_QueryEIP712TypedDataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEIP712TypedDataResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEIP712TypedDataResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEIP712TypedDataResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryEIP712TypedDataResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEIP712TypedDataResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEIP712TypedDataResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TypedData != "" {
+		value := protoreflect.ValueOfString(x.TypedData)
+		if !f(fd_QueryEIP712TypedDataResponse_typed_data, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse.typed_data":
+		return x.TypedData != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse.typed_data":
+		x.TypedData = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse.typed_data":
+		value := x.TypedData
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse.typed_data":
+		x.TypedData = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse.typed_data":
+		panic(fmt.Errorf("field typed_data of message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryEIP712TypedDataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse.typed_data":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryEIP712TypedDataResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryEIP712TypedDataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryEIP712TypedDataResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryEIP712TypedDataResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryEIP712TypedDataResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryEIP712TypedDataResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryEIP712TypedDataResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryEIP712TypedDataResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TypedData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEIP712TypedDataResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TypedData) > 0 {
+			i -= len(x.TypedData)
+			copy(dAtA[i:], x.TypedData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypedData)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryEIP712TypedDataResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEIP712TypedDataResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEIP712TypedDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypedData", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ChainId |= int64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 10:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypedData = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryParamsAuthoritiesRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_evm_vm_v1_query_proto_init()
+	md_QueryParamsAuthoritiesRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsAuthoritiesRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryParamsAuthoritiesRequest)(nil)
+
+type fastReflection_QueryParamsAuthoritiesRequest QueryParamsAuthoritiesRequest
+
+func (x *QueryParamsAuthoritiesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsAuthoritiesRequest)(x)
+}
+
+func (x *QueryParamsAuthoritiesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryParamsAuthoritiesRequest_messageType fastReflection_QueryParamsAuthoritiesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsAuthoritiesRequest_messageType{}
+
+type fastReflection_QueryParamsAuthoritiesRequest_messageType struct{}
+
+func (x fastReflection_QueryParamsAuthoritiesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsAuthoritiesRequest)(nil)
+}
+func (x fastReflection_QueryParamsAuthoritiesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAuthoritiesRequest)
+}
+func (x fastReflection_QueryParamsAuthoritiesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAuthoritiesRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAuthoritiesRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsAuthoritiesRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAuthoritiesRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsAuthoritiesRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryParamsAuthoritiesRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryParamsAuthoritiesRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsAuthoritiesRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryParamsAuthoritiesRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BlockMaxGas = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockMaxGas |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAuthoritiesRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11552,27 +17782,78 @@ func (x *fastReflection_QueryTraceBlockRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
+var _ protoreflect.List = (*_QueryParamsAuthoritiesResponse_1_list)(nil)
+
+type _QueryParamsAuthoritiesResponse_1_list struct {
+	list *[]*v1.ParamsAuthority
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.ParamsAuthority)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.ParamsAuthority)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1.ParamsAuthority)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1.ParamsAuthority)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryParamsAuthoritiesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTraceBlockResponse      protoreflect.MessageDescriptor
-	fd_QueryTraceBlockResponse_data protoreflect.FieldDescriptor
+	md_QueryParamsAuthoritiesResponse                    protoreflect.MessageDescriptor
+	fd_QueryParamsAuthoritiesResponse_params_authorities protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryTraceBlockResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryTraceBlockResponse")
-	fd_QueryTraceBlockResponse_data = md_QueryTraceBlockResponse.Fields().ByName("data")
+	md_QueryParamsAuthoritiesResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsAuthoritiesResponse")
+	fd_QueryParamsAuthoritiesResponse_params_authorities = md_QueryParamsAuthoritiesResponse.Fields().ByName("params_authorities")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTraceBlockResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsAuthoritiesResponse)(nil)
 
-type fastReflection_QueryTraceBlockResponse QueryTraceBlockResponse
+type fastReflection_QueryParamsAuthoritiesResponse QueryParamsAuthoritiesResponse
 
-func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(x)
+func (x *QueryParamsAuthoritiesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsAuthoritiesResponse)(x)
 }
 
-func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+func (x *QueryParamsAuthoritiesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11583,43 +17864,43 @@ func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTraceBlockResponse_messageType fastReflection_QueryTraceBlockResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTraceBlockResponse_messageType{}
+var _fastReflection_QueryParamsAuthoritiesResponse_messageType fastReflection_QueryParamsAuthoritiesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsAuthoritiesResponse_messageType{}
 
-type fastReflection_QueryTraceBlockResponse_messageType struct{}
+type fastReflection_QueryParamsAuthoritiesResponse_messageType struct{}
 
-func (x fastReflection_QueryTraceBlockResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTraceBlockResponse)(nil)
+func (x fastReflection_QueryParamsAuthoritiesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsAuthoritiesResponse)(nil)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x fastReflection_QueryParamsAuthoritiesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAuthoritiesResponse)
 }
-func (x fastReflection_QueryTraceBlockResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x fastReflection_QueryParamsAuthoritiesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAuthoritiesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTraceBlockResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTraceBlockResponse
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAuthoritiesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTraceBlockResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTraceBlockResponse_messageType
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsAuthoritiesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTraceBlockResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTraceBlockResponse)
+func (x *fastReflection_QueryParamsAuthoritiesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAuthoritiesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTraceBlockResponse)(x)
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsAuthoritiesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11627,10 +17908,10 @@ func (x *fastReflection_QueryTraceBlockResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Data) != 0 {
-		value := protoreflect.ValueOfBytes(x.Data)
-		if !f(fd_QueryTraceBlockResponse_data, value) {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.ParamsAuthorities) != 0 {
+		value := protoreflect.ValueOfList(&_QueryParamsAuthoritiesResponse_1_list{list: &x.ParamsAuthorities})
+		if !f(fd_QueryParamsAuthoritiesResponse_params_authorities, value) {
 			return
 		}
 	}
@@ -11647,15 +17928,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		return len(x.Data) != 0
+	case "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities":
+		return len(x.ParamsAuthorities) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11665,15 +17946,15 @@ func (x *fastReflection_QueryTraceBlockResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		x.Data = nil
+	case "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities":
+		x.ParamsAuthorities = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11683,16 +17964,19 @@ func (x *fastReflection_QueryTraceBlockResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		value := x.Data
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities":
+		if len(x.ParamsAuthorities) == 0 {
+			return protoreflect.ValueOfList(&_QueryParamsAuthoritiesResponse_1_list{})
+		}
+		listValue := &_QueryParamsAuthoritiesResponse_1_list{list: &x.ParamsAuthorities}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11706,15 +17990,17 @@ func (x *fastReflection_QueryTraceBlockResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		x.Data = value.Bytes()
+	case "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities":
+		lv := value.List()
+		clv := lv.(*_QueryParamsAuthoritiesResponse_1_list)
+		x.ParamsAuthorities = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11728,40 +18014,45 @@ func (x *fastReflection_QueryTraceBlockResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		panic(fmt.Errorf("field data of message cosmos.evm.vm.v1.QueryTraceBlockResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities":
+		if x.ParamsAuthorities == nil {
+			x.ParamsAuthorities = []*v1.ParamsAuthority{}
+		}
+		value := &_QueryParamsAuthoritiesResponse_1_list{list: &x.ParamsAuthorities}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTraceBlockResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryTraceBlockResponse.data":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities":
+		list := []*v1.ParamsAuthority{}
+		return protoreflect.ValueOfList(&_QueryParamsAuthoritiesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryTraceBlockResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryTraceBlockResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryTraceBlockResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11769,7 +18060,7 @@ func (x *fastReflection_QueryTraceBlockResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11780,7 +18071,7 @@ func (x *fastReflection_QueryTraceBlockResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11792,7 +18083,7 @@ func (x *fastReflection_QueryTraceBlockResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11802,9 +18093,9 @@ func (x *fastReflection_QueryTraceBlockResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsAuthoritiesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryParamsAuthoritiesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11816,9 +18107,11 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Data)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ParamsAuthorities) > 0 {
+			for _, e := range x.ParamsAuthorities {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -11830,7 +18123,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryParamsAuthoritiesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11849,12 +18142,21 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Data) > 0 {
-			i -= len(x.Data)
-			copy(dAtA[i:], x.Data)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Data)))
-			i--
-			dAtA[i] = 0xa
+		if len(x.ParamsAuthorities) > 0 {
+			for iNdEx := len(x.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParamsAuthorities[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -11867,7 +18169,7 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTraceBlockResponse)
+		x := input.Message.Interface().(*QueryParamsAuthoritiesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11899,17 +18201,17 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAuthoritiesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11919,24 +18221,24 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Data = append(x.Data[:0], dAtA[iNdEx:postIndex]...)
-				if x.Data == nil {
-					x.Data = []byte{}
+				x.ParamsAuthorities = append(x.ParamsAuthorities, &v1.ParamsAuthority{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParamsAuthorities[len(x.ParamsAuthorities)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -11975,24 +18277,26 @@ func (x *fastReflection_QueryTraceBlockResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_QueryBaseFeeRequest protoreflect.MessageDescriptor
+	md_QueryParamsAtHeightRequest        protoreflect.MessageDescriptor
+	fd_QueryParamsAtHeightRequest_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryBaseFeeRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeRequest")
+	md_QueryParamsAtHeightRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsAtHeightRequest")
+	fd_QueryParamsAtHeightRequest_height = md_QueryParamsAtHeightRequest.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBaseFeeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsAtHeightRequest)(nil)
 
-type fastReflection_QueryBaseFeeRequest QueryBaseFeeRequest
+type fastReflection_QueryParamsAtHeightRequest QueryParamsAtHeightRequest
 
-func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeRequest)(x)
+func (x *QueryParamsAtHeightRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsAtHeightRequest)(x)
 }
 
-func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+func (x *QueryParamsAtHeightRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12003,43 +18307,43 @@ func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBaseFeeRequest_messageType fastReflection_QueryBaseFeeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBaseFeeRequest_messageType{}
+var _fastReflection_QueryParamsAtHeightRequest_messageType fastReflection_QueryParamsAtHeightRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsAtHeightRequest_messageType{}
 
-type fastReflection_QueryBaseFeeRequest_messageType struct{}
+type fastReflection_QueryParamsAtHeightRequest_messageType struct{}
 
-func (x fastReflection_QueryBaseFeeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeRequest)(nil)
+func (x fastReflection_QueryParamsAtHeightRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsAtHeightRequest)(nil)
 }
-func (x fastReflection_QueryBaseFeeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeRequest)
+func (x fastReflection_QueryParamsAtHeightRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAtHeightRequest)
 }
-func (x fastReflection_QueryBaseFeeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeRequest
+func (x fastReflection_QueryParamsAtHeightRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAtHeightRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBaseFeeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeRequest
+func (x *fastReflection_QueryParamsAtHeightRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAtHeightRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBaseFeeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBaseFeeRequest_messageType
+func (x *fastReflection_QueryParamsAtHeightRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsAtHeightRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBaseFeeRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeRequest)
+func (x *fastReflection_QueryParamsAtHeightRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAtHeightRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryBaseFeeRequest)(x)
+func (x *fastReflection_QueryParamsAtHeightRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsAtHeightRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12047,7 +18351,13 @@ func (x *fastReflection_QueryBaseFeeRequest) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryParamsAtHeightRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_QueryParamsAtHeightRequest_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -12061,13 +18371,15 @@ func (x *fastReflection_QueryBaseFeeRequest) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsAtHeightRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightRequest.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12077,13 +18389,15 @@ func (x *fastReflection_QueryBaseFeeRequest) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsAtHeightRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightRequest.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12093,13 +18407,16 @@ func (x *fastReflection_QueryBaseFeeRequest) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAtHeightRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12113,13 +18430,15 @@ func (x *fastReflection_QueryBaseFeeRequest) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsAtHeightRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightRequest.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12133,36 +18452,40 @@ func (x *fastReflection_QueryBaseFeeRequest) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAtHeightRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightRequest.height":
+		panic(fmt.Errorf("field height of message cosmos.evm.vm.v1.QueryParamsAtHeightRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBaseFeeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAtHeightRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsAtHeightRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsAtHeightRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12170,7 +18493,7 @@ func (x *fastReflection_QueryBaseFeeRequest) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsAtHeightRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12181,7 +18504,7 @@ func (x *fastReflection_QueryBaseFeeRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsAtHeightRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12193,7 +18516,7 @@ func (x *fastReflection_QueryBaseFeeRequest) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsAtHeightRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -12203,9 +18526,9 @@ func (x *fastReflection_QueryBaseFeeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsAtHeightRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryParamsAtHeightRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12217,6 +18540,9 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -12227,7 +18553,7 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryParamsAtHeightRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12246,6 +18572,11 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -12257,7 +18588,7 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeRequest)
+		x := input.Message.Interface().(*QueryParamsAtHeightRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12289,12 +18620,31 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAtHeightRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12331,26 +18681,28 @@ func (x *fastReflection_QueryBaseFeeRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryBaseFeeResponse          protoreflect.MessageDescriptor
-	fd_QueryBaseFeeResponse_base_fee protoreflect.FieldDescriptor
+	md_QueryParamsAtHeightResponse               protoreflect.MessageDescriptor
+	fd_QueryParamsAtHeightResponse_params        protoreflect.FieldDescriptor
+	fd_QueryParamsAtHeightResponse_change_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryBaseFeeResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryBaseFeeResponse")
-	fd_QueryBaseFeeResponse_base_fee = md_QueryBaseFeeResponse.Fields().ByName("base_fee")
+	md_QueryParamsAtHeightResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryParamsAtHeightResponse")
+	fd_QueryParamsAtHeightResponse_params = md_QueryParamsAtHeightResponse.Fields().ByName("params")
+	fd_QueryParamsAtHeightResponse_change_height = md_QueryParamsAtHeightResponse.Fields().ByName("change_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryBaseFeeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsAtHeightResponse)(nil)
 
-type fastReflection_QueryBaseFeeResponse QueryBaseFeeResponse
+type fastReflection_QueryParamsAtHeightResponse QueryParamsAtHeightResponse
 
-func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeResponse)(x)
+func (x *QueryParamsAtHeightResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsAtHeightResponse)(x)
 }
 
-func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+func (x *QueryParamsAtHeightResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12361,43 +18713,43 @@ func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryBaseFeeResponse_messageType fastReflection_QueryBaseFeeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryBaseFeeResponse_messageType{}
+var _fastReflection_QueryParamsAtHeightResponse_messageType fastReflection_QueryParamsAtHeightResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsAtHeightResponse_messageType{}
 
-type fastReflection_QueryBaseFeeResponse_messageType struct{}
+type fastReflection_QueryParamsAtHeightResponse_messageType struct{}
 
-func (x fastReflection_QueryBaseFeeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryBaseFeeResponse)(nil)
+func (x fastReflection_QueryParamsAtHeightResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsAtHeightResponse)(nil)
 }
-func (x fastReflection_QueryBaseFeeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeResponse)
+func (x fastReflection_QueryParamsAtHeightResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAtHeightResponse)
 }
-func (x fastReflection_QueryBaseFeeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeResponse
+func (x fastReflection_QueryParamsAtHeightResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAtHeightResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryBaseFeeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryBaseFeeResponse
+func (x *fastReflection_QueryParamsAtHeightResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsAtHeightResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryBaseFeeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryBaseFeeResponse_messageType
+func (x *fastReflection_QueryParamsAtHeightResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsAtHeightResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryBaseFeeResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryBaseFeeResponse)
+func (x *fastReflection_QueryParamsAtHeightResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsAtHeightResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryBaseFeeResponse)(x)
+func (x *fastReflection_QueryParamsAtHeightResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsAtHeightResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12405,10 +18757,16 @@ func (x *fastReflection_QueryBaseFeeResponse) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BaseFee != "" {
-		value := protoreflect.ValueOfString(x.BaseFee)
-		if !f(fd_QueryBaseFeeResponse_base_fee, value) {
+func (x *fastReflection_QueryParamsAtHeightResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsAtHeightResponse_params, value) {
+			return
+		}
+	}
+	if x.ChangeHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ChangeHeight)
+		if !f(fd_QueryParamsAtHeightResponse_change_height, value) {
 			return
 		}
 	}
@@ -12425,15 +18783,17 @@ func (x *fastReflection_QueryBaseFeeResponse) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsAtHeightResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		return x.BaseFee != ""
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params":
+		return x.Params != nil
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.change_height":
+		return x.ChangeHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12443,15 +18803,17 @@ func (x *fastReflection_QueryBaseFeeResponse) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsAtHeightResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		x.BaseFee = ""
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params":
+		x.Params = nil
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.change_height":
+		x.ChangeHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12461,16 +18823,19 @@ func (x *fastReflection_QueryBaseFeeResponse) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAtHeightResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		value := x.BaseFee
-		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.change_height":
+		value := x.ChangeHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12484,15 +18849,17 @@ func (x *fastReflection_QueryBaseFeeResponse) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsAtHeightResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		x.BaseFee = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.change_height":
+		x.ChangeHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12506,40 +18873,48 @@ func (x *fastReflection_QueryBaseFeeResponse) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAtHeightResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		panic(fmt.Errorf("field base_fee of message cosmos.evm.vm.v1.QueryBaseFeeResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.change_height":
+		panic(fmt.Errorf("field change_height of message cosmos.evm.vm.v1.QueryParamsAtHeightResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryBaseFeeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsAtHeightResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryBaseFeeResponse.base_fee":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryParamsAtHeightResponse.change_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryBaseFeeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryParamsAtHeightResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryBaseFeeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryParamsAtHeightResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsAtHeightResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryBaseFeeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryParamsAtHeightResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12547,7 +18922,7 @@ func (x *fastReflection_QueryBaseFeeResponse) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsAtHeightResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12558,7 +18933,7 @@ func (x *fastReflection_QueryBaseFeeResponse) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsAtHeightResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12570,7 +18945,7 @@ func (x *fastReflection_QueryBaseFeeResponse) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsAtHeightResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -12580,9 +18955,9 @@ func (x *fastReflection_QueryBaseFeeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsAtHeightResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryParamsAtHeightResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12594,10 +18969,13 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.BaseFee)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ChangeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChangeHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -12608,7 +18986,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryParamsAtHeightResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12627,10 +19005,22 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BaseFee) > 0 {
-			i -= len(x.BaseFee)
-			copy(dAtA[i:], x.BaseFee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseFee)))
+		if x.ChangeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChangeHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -12645,7 +19035,7 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryBaseFeeResponse)
+		x := input.Message.Interface().(*QueryParamsAtHeightResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12677,17 +19067,17 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAtHeightResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -12697,24 +19087,47 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseFee = string(dAtA[iNdEx:postIndex])
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangeHeight", wireType)
+				}
+				x.ChangeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChangeHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12751,24 +19164,28 @@ func (x *fastReflection_QueryBaseFeeResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryGlobalMinGasPriceRequest protoreflect.MessageDescriptor
+	md_QueryCodeMetadataRequest            protoreflect.MessageDescriptor
+	fd_QueryCodeMetadataRequest_address    protoreflect.FieldDescriptor
+	fd_QueryCodeMetadataRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryGlobalMinGasPriceRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceRequest")
+	md_QueryCodeMetadataRequest = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeMetadataRequest")
+	fd_QueryCodeMetadataRequest_address = md_QueryCodeMetadataRequest.Fields().ByName("address")
+	fd_QueryCodeMetadataRequest_pagination = md_QueryCodeMetadataRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeMetadataRequest)(nil)
 
-type fastReflection_QueryGlobalMinGasPriceRequest QueryGlobalMinGasPriceRequest
+type fastReflection_QueryCodeMetadataRequest QueryCodeMetadataRequest
 
-func (x *QueryGlobalMinGasPriceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceRequest)(x)
+func (x *QueryCodeMetadataRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeMetadataRequest)(x)
 }
 
-func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+func (x *QueryCodeMetadataRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12779,43 +19196,43 @@ func (x *QueryGlobalMinGasPriceRequest) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGlobalMinGasPriceRequest_messageType fastReflection_QueryGlobalMinGasPriceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceRequest_messageType{}
+var _fastReflection_QueryCodeMetadataRequest_messageType fastReflection_QueryCodeMetadataRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeMetadataRequest_messageType{}
 
-type fastReflection_QueryGlobalMinGasPriceRequest_messageType struct{}
+type fastReflection_QueryCodeMetadataRequest_messageType struct{}
 
-func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceRequest)(nil)
+func (x fastReflection_QueryCodeMetadataRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeMetadataRequest)(nil)
 }
-func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceRequest)
+func (x fastReflection_QueryCodeMetadataRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeMetadataRequest)
 }
-func (x fastReflection_QueryGlobalMinGasPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceRequest
+func (x fastReflection_QueryCodeMetadataRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeMetadataRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceRequest
+func (x *fastReflection_QueryCodeMetadataRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeMetadataRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGlobalMinGasPriceRequest_messageType
+func (x *fastReflection_QueryCodeMetadataRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeMetadataRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceRequest)
+func (x *fastReflection_QueryCodeMetadataRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeMetadataRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryGlobalMinGasPriceRequest)(x)
+func (x *fastReflection_QueryCodeMetadataRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeMetadataRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12823,7 +19240,19 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryCodeMetadataRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryCodeMetadataRequest_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCodeMetadataRequest_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -12837,13 +19266,17 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeMetadataRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.address":
+		return x.Address != ""
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12853,13 +19286,17 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeMetadataRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.address":
+		x.Address = ""
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12869,13 +19306,19 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeMetadataRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12889,13 +19332,17 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeMetadataRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12909,36 +19356,48 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeMetadataRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.evm.vm.v1.QueryCodeMetadataRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeMetadataRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeMetadataRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeMetadataRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12946,7 +19405,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeMetadataRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12957,7 +19416,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeMetadataRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12969,7 +19428,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) IsValid() bool {
+func (x *fastReflection_QueryCodeMetadataRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -12979,9 +19438,9 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeMetadataRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		x := input.Message.Interface().(*QueryCodeMetadataRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12993,6 +19452,14 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 		var n int
 		var l int
 		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -13003,7 +19470,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		x := input.Message.Interface().(*QueryCodeMetadataRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13022,6 +19489,27 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -13033,7 +19521,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceRequest)
+		x := input.Message.Interface().(*QueryCodeMetadataRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13052,25 +19540,93 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeMetadataRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -13106,27 +19662,80 @@ func (x *fastReflection_QueryGlobalMinGasPriceRequest) ProtoMethods() *protoifac
 	}
 }
 
+var _ protoreflect.List = (*_QueryCodeMetadataResponse_1_list)(nil)
+
+type _QueryCodeMetadataResponse_1_list struct {
+	list *[]*CodeMetadata
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CodeMetadata)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CodeMetadata)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(CodeMetadata)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) NewElement() protoreflect.Value {
+	v := new(CodeMetadata)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryCodeMetadataResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryGlobalMinGasPriceResponse               protoreflect.MessageDescriptor
-	fd_QueryGlobalMinGasPriceResponse_min_gas_price protoreflect.FieldDescriptor
+	md_QueryCodeMetadataResponse            protoreflect.MessageDescriptor
+	fd_QueryCodeMetadataResponse_metadata   protoreflect.FieldDescriptor
+	fd_QueryCodeMetadataResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_evm_vm_v1_query_proto_init()
-	md_QueryGlobalMinGasPriceResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryGlobalMinGasPriceResponse")
-	fd_QueryGlobalMinGasPriceResponse_min_gas_price = md_QueryGlobalMinGasPriceResponse.Fields().ByName("min_gas_price")
+	md_QueryCodeMetadataResponse = File_cosmos_evm_vm_v1_query_proto.Messages().ByName("QueryCodeMetadataResponse")
+	fd_QueryCodeMetadataResponse_metadata = md_QueryCodeMetadataResponse.Fields().ByName("metadata")
+	fd_QueryCodeMetadataResponse_pagination = md_QueryCodeMetadataResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryCodeMetadataResponse)(nil)
 
-type fastReflection_QueryGlobalMinGasPriceResponse QueryGlobalMinGasPriceResponse
+type fastReflection_QueryCodeMetadataResponse QueryCodeMetadataResponse
 
-func (x *QueryGlobalMinGasPriceResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceResponse)(x)
+func (x *QueryCodeMetadataResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryCodeMetadataResponse)(x)
 }
 
-func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+func (x *QueryCodeMetadataResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13137,43 +19746,43 @@ func (x *QueryGlobalMinGasPriceResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryGlobalMinGasPriceResponse_messageType fastReflection_QueryGlobalMinGasPriceResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryGlobalMinGasPriceResponse_messageType{}
+var _fastReflection_QueryCodeMetadataResponse_messageType fastReflection_QueryCodeMetadataResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryCodeMetadataResponse_messageType{}
 
-type fastReflection_QueryGlobalMinGasPriceResponse_messageType struct{}
+type fastReflection_QueryCodeMetadataResponse_messageType struct{}
 
-func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryGlobalMinGasPriceResponse)(nil)
+func (x fastReflection_QueryCodeMetadataResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryCodeMetadataResponse)(nil)
 }
-func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceResponse)
+func (x fastReflection_QueryCodeMetadataResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeMetadataResponse)
 }
-func (x fastReflection_QueryGlobalMinGasPriceResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceResponse
+func (x fastReflection_QueryCodeMetadataResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeMetadataResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryGlobalMinGasPriceResponse
+func (x *fastReflection_QueryCodeMetadataResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryCodeMetadataResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryGlobalMinGasPriceResponse_messageType
+func (x *fastReflection_QueryCodeMetadataResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryCodeMetadataResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryGlobalMinGasPriceResponse)
+func (x *fastReflection_QueryCodeMetadataResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryCodeMetadataResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryGlobalMinGasPriceResponse)(x)
+func (x *fastReflection_QueryCodeMetadataResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryCodeMetadataResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -13181,10 +19790,16 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MinGasPrice != "" {
-		value := protoreflect.ValueOfString(x.MinGasPrice)
-		if !f(fd_QueryGlobalMinGasPriceResponse_min_gas_price, value) {
+func (x *fastReflection_QueryCodeMetadataResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Metadata) != 0 {
+		value := protoreflect.ValueOfList(&_QueryCodeMetadataResponse_1_list{list: &x.Metadata})
+		if !f(fd_QueryCodeMetadataResponse_metadata, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryCodeMetadataResponse_pagination, value) {
 			return
 		}
 	}
@@ -13201,15 +19816,17 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryCodeMetadataResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		return x.MinGasPrice != ""
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata":
+		return len(x.Metadata) != 0
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13219,15 +19836,17 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryCodeMetadataResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		x.MinGasPrice = ""
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata":
+		x.Metadata = nil
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13237,16 +19856,22 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeMetadataResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		value := x.MinGasPrice
-		return protoreflect.ValueOfString(value)
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata":
+		if len(x.Metadata) == 0 {
+			return protoreflect.ValueOfList(&_QueryCodeMetadataResponse_1_list{})
+		}
+		listValue := &_QueryCodeMetadataResponse_1_list{list: &x.Metadata}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13260,15 +19885,19 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryCodeMetadataResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		x.MinGasPrice = value.Interface().(string)
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata":
+		lv := value.List()
+		clv := lv.(*_QueryCodeMetadataResponse_1_list)
+		x.Metadata = *clv.list
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13282,40 +19911,53 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeMetadataResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		panic(fmt.Errorf("field min_gas_price of message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse is not mutable"))
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata":
+		if x.Metadata == nil {
+			x.Metadata = []*CodeMetadata{}
+		}
+		value := &_QueryCodeMetadataResponse_1_list{list: &x.Metadata}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryCodeMetadataResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse.min_gas_price":
-		return protoreflect.ValueOfString("")
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata":
+		list := []*CodeMetadata{}
+		return protoreflect.ValueOfList(&_QueryCodeMetadataResponse_1_list{list: &list})
+	case "cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.evm.vm.v1.QueryCodeMetadataResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.evm.vm.v1.QueryCodeMetadataResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryCodeMetadataResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.evm.vm.v1.QueryCodeMetadataResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13323,7 +19965,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryCodeMetadataResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13334,7 +19976,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryCodeMetadataResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13346,7 +19988,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) IsValid() bool {
+func (x *fastReflection_QueryCodeMetadataResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -13356,9 +19998,9 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryCodeMetadataResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		x := input.Message.Interface().(*QueryCodeMetadataResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13370,8 +20012,14 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
-		l = len(x.MinGasPrice)
-		if l > 0 {
+		if len(x.Metadata) > 0 {
+			for _, e := range x.Metadata {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -13384,7 +20032,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		x := input.Message.Interface().(*QueryCodeMetadataResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13403,12 +20051,35 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinGasPrice) > 0 {
-			i -= len(x.MinGasPrice)
-			copy(dAtA[i:], x.MinGasPrice)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinGasPrice)))
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Metadata) > 0 {
+			for iNdEx := len(x.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Metadata[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -13421,7 +20092,7 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryGlobalMinGasPriceResponse)
+		x := input.Message.Interface().(*QueryCodeMetadataResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13440,30 +20111,64 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeMetadataResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryCodeMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Metadata = append(x.Metadata, &CodeMetadata{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Metadata[len(x.Metadata)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13473,23 +20178,27 @@ func (x *fastReflection_QueryGlobalMinGasPriceResponse) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinGasPrice = string(dAtA[iNdEx:postIndex])
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -14041,6 +20750,101 @@ func (x *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryContractStorageRequest is the request type for the
+// Query/ContractStorage RPC method.
+type QueryContractStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address of the contract to query the storage
+	// for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryContractStorageRequest) Reset() {
+	*x = QueryContractStorageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractStorageRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryContractStorageRequest.ProtoReflect.Descriptor instead.
+func (*QueryContractStorageRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryContractStorageRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryContractStorageRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryContractStorageResponse is the response type for the
+// Query/ContractStorage RPC method.
+type QueryContractStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// storage are the set storage slots of the contract, ordered by key.
+	Storage []*State `protobuf:"bytes,1,rep,name=storage,proto3" json:"storage,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryContractStorageResponse) Reset() {
+	*x = QueryContractStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractStorageResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryContractStorageResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractStorageResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryContractStorageResponse) GetStorage() []*State {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *QueryContractStorageResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	state         protoimpl.MessageState
@@ -14054,7 +20858,7 @@ type QueryCodeRequest struct {
 func (x *QueryCodeRequest) Reset() {
 	*x = QueryCodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[12]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14068,7 +20872,7 @@ func (*QueryCodeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryCodeRequest.ProtoReflect.Descriptor instead.
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryCodeRequest) GetAddress() string {
@@ -14092,7 +20896,7 @@ type QueryCodeResponse struct {
 func (x *QueryCodeResponse) Reset() {
 	*x = QueryCodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[13]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14106,7 +20910,7 @@ func (*QueryCodeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryCodeResponse.ProtoReflect.Descriptor instead.
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryCodeResponse) GetCode() []byte {
@@ -14131,7 +20935,7 @@ type QueryTxLogsRequest struct {
 func (x *QueryTxLogsRequest) Reset() {
 	*x = QueryTxLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[14]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14145,7 +20949,7 @@ func (*QueryTxLogsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryTxLogsRequest) GetHash() string {
@@ -14177,7 +20981,7 @@ type QueryTxLogsResponse struct {
 func (x *QueryTxLogsResponse) Reset() {
 	*x = QueryTxLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[15]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14191,7 +20995,7 @@ func (*QueryTxLogsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTxLogsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryTxLogsResponse) GetLogs() []*Log {
@@ -14218,7 +21022,7 @@ type QueryParamsRequest struct {
 func (x *QueryParamsRequest) Reset() {
 	*x = QueryParamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[16]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14232,7 +21036,7 @@ func (*QueryParamsRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 // QueryParamsResponse defines the response type for querying x/vm parameters.
@@ -14248,7 +21052,7 @@ type QueryParamsResponse struct {
 func (x *QueryParamsResponse) Reset() {
 	*x = QueryParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[17]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14262,7 +21066,7 @@ func (*QueryParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryParamsResponse) GetParams() *Params {
@@ -14286,12 +21090,18 @@ type EthCallRequest struct {
 	ProposerAddress []byte `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the set of account overrides applied to the state before
+	// executing the call, using the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// pending_txs are the binary encoded ethereum transactions of the pending
+	// block, executed in order before the call.
+	PendingTxs [][]byte `protobuf:"bytes,6,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
 }
 
 func (x *EthCallRequest) Reset() {
 	*x = EthCallRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[18]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14305,7 +21115,7 @@ func (*EthCallRequest) ProtoMessage() {}
 
 // Deprecated: Use EthCallRequest.ProtoReflect.Descriptor instead.
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EthCallRequest) GetArgs() []byte {
@@ -14319,21 +21129,184 @@ func (x *EthCallRequest) GetGasCap() uint64 {
 	if x != nil {
 		return x.GasCap
 	}
-	return 0
+	return 0
+}
+
+func (x *EthCallRequest) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *EthCallRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *EthCallRequest) GetOverrides() []byte {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *EthCallRequest) GetPendingTxs() [][]byte {
+	if x != nil {
+		return x.PendingTxs
+	}
+	return nil
+}
+
+// EthCallBatchRequest defines EthCallBatch request
+type EthCallBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// args are the arguments of the calls, executed in order, using the same
+	// json format as the json rpc api.
+	Args [][]byte `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used by each call
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress []byte `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *EthCallBatchRequest) Reset() {
+	*x = EthCallBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthCallBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthCallBatchRequest) ProtoMessage() {}
+
+// Deprecated: Use EthCallBatchRequest.ProtoReflect.Descriptor instead.
+func (*EthCallBatchRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EthCallBatchRequest) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *EthCallBatchRequest) GetGasCap() uint64 {
+	if x != nil {
+		return x.GasCap
+	}
+	return 0
+}
+
+func (x *EthCallBatchRequest) GetProposerAddress() []byte {
+	if x != nil {
+		return x.ProposerAddress
+	}
+	return nil
+}
+
+func (x *EthCallBatchRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+// EthCallBatchResult defines the result of a call of a batch
+type EthCallBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// response is the result of the call, empty if the call failed to execute
+	Response *MsgEthereumTxResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// error is the reason the call failed to execute, e.g. its invalid args.
+	// Reverted calls are executed and reported by the vm_error of the response.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EthCallBatchResult) Reset() {
+	*x = EthCallBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EthCallBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthCallBatchResult) ProtoMessage() {}
+
+// Deprecated: Use EthCallBatchResult.ProtoReflect.Descriptor instead.
+func (*EthCallBatchResult) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EthCallBatchResult) GetResponse() *MsgEthereumTxResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *EthCallBatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EthCallBatchResponse defines EthCallBatch response
+type EthCallBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results are the results of the calls, in the order of the request args
+	Results []*EthCallBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *EthCallBatchResponse) Reset() {
+	*x = EthCallBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *EthCallRequest) GetProposerAddress() []byte {
-	if x != nil {
-		return x.ProposerAddress
-	}
-	return nil
+func (x *EthCallBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EthCallRequest) GetChainId() int64 {
+func (*EthCallBatchResponse) ProtoMessage() {}
+
+// Deprecated: Use EthCallBatchResponse.ProtoReflect.Descriptor instead.
+func (*EthCallBatchResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EthCallBatchResponse) GetResults() []*EthCallBatchResult {
 	if x != nil {
-		return x.ChainId
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
 // EstimateGasResponse defines EstimateGas response
@@ -14354,7 +21327,7 @@ type EstimateGasResponse struct {
 func (x *EstimateGasResponse) Reset() {
 	*x = EstimateGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[19]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14368,7 +21341,7 @@ func (*EstimateGasResponse) ProtoMessage() {}
 
 // Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EstimateGasResponse) GetGas() uint64 {
@@ -14422,7 +21395,7 @@ type QueryTraceTxRequest struct {
 func (x *QueryTraceTxRequest) Reset() {
 	*x = QueryTraceTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[20]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14436,7 +21409,7 @@ func (*QueryTraceTxRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
@@ -14515,7 +21488,7 @@ type QueryTraceTxResponse struct {
 func (x *QueryTraceTxResponse) Reset() {
 	*x = QueryTraceTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[21]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14529,7 +21502,7 @@ func (*QueryTraceTxResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryTraceTxResponse) GetData() []byte {
@@ -14566,7 +21539,7 @@ type QueryTraceBlockRequest struct {
 func (x *QueryTraceBlockRequest) Reset() {
 	*x = QueryTraceBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[22]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14580,7 +21553,7 @@ func (*QueryTraceBlockRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
@@ -14652,7 +21625,7 @@ type QueryTraceBlockResponse struct {
 func (x *QueryTraceBlockResponse) Reset() {
 	*x = QueryTraceBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[23]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14666,7 +21639,7 @@ func (*QueryTraceBlockResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryTraceBlockResponse) GetData() []byte {
@@ -14687,7 +21660,7 @@ type QueryBaseFeeRequest struct {
 func (x *QueryBaseFeeRequest) Reset() {
 	*x = QueryBaseFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[24]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14701,7 +21674,7 @@ func (*QueryBaseFeeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeRequest.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 // QueryBaseFeeResponse returns the EIP1559 base fee.
@@ -14717,93 +21690,444 @@ type QueryBaseFeeResponse struct {
 func (x *QueryBaseFeeResponse) Reset() {
 	*x = QueryBaseFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[25]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBaseFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBaseFeeResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryBaseFeeResponse.ProtoReflect.Descriptor instead.
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QueryBaseFeeResponse) GetBaseFee() string {
+	if x != nil {
+		return x.BaseFee
+	}
+	return ""
+}
+
+// QueryGlobalMinGasPriceRequest defines the request type for querying the
+// GlobalMinGasPrice
+type QueryGlobalMinGasPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryGlobalMinGasPriceRequest) Reset() {
+	*x = QueryGlobalMinGasPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGlobalMinGasPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGlobalMinGasPriceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryGlobalMinGasPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{31}
+}
+
+// QueryGlobalMinGasPriceResponse returns the GlobalMinGasPrice
+type QueryGlobalMinGasPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min_gas_price is the feemarket's min_gas_price
+	MinGasPrice string `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+}
+
+func (x *QueryGlobalMinGasPriceResponse) Reset() {
+	*x = QueryGlobalMinGasPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGlobalMinGasPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGlobalMinGasPriceResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryGlobalMinGasPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryGlobalMinGasPriceResponse) GetMinGasPrice() string {
+	if x != nil {
+		return x.MinGasPrice
+	}
+	return ""
+}
+
+// QueryEIP712TypedDataRequest defines the request type for querying the
+// EIP-712 typed data of an unsigned Cosmos transaction.
+type QueryEIP712TypedDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tx_bytes is the protobuf encoded unsigned transaction
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// chain_id is the Cosmos chain id the transaction is signed for
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the signer
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the sequence of the signer, used when the transaction does
+	// not contain any signer info
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *QueryEIP712TypedDataRequest) Reset() {
+	*x = QueryEIP712TypedDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEIP712TypedDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEIP712TypedDataRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryEIP712TypedDataRequest.ProtoReflect.Descriptor instead.
+func (*QueryEIP712TypedDataRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryEIP712TypedDataRequest) GetTxBytes() []byte {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *QueryEIP712TypedDataRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *QueryEIP712TypedDataRequest) GetAccountNumber() uint64 {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return 0
+}
+
+func (x *QueryEIP712TypedDataRequest) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// QueryEIP712TypedDataResponse returns the EIP-712 typed data.
+type QueryEIP712TypedDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// typed_data is the JSON encoded EIP-712 typed data payload
+	TypedData string `protobuf:"bytes,1,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+}
+
+func (x *QueryEIP712TypedDataResponse) Reset() {
+	*x = QueryEIP712TypedDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEIP712TypedDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEIP712TypedDataResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryEIP712TypedDataResponse.ProtoReflect.Descriptor instead.
+func (*QueryEIP712TypedDataResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryEIP712TypedDataResponse) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+// QueryParamsAuthoritiesRequest defines the request type for querying the
+// accounts allowed to update a subset of the x/vm parameters.
+type QueryParamsAuthoritiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsAuthoritiesRequest) Reset() {
+	*x = QueryParamsAuthoritiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsAuthoritiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsAuthoritiesRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsAuthoritiesRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{35}
+}
+
+// QueryParamsAuthoritiesResponse defines the response type for querying the
+// accounts allowed to update a subset of the x/vm parameters.
+type QueryParamsAuthoritiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params_authorities are the accounts and the parameters they can update.
+	ParamsAuthorities []*v1.ParamsAuthority `protobuf:"bytes,1,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities,omitempty"`
+}
+
+func (x *QueryParamsAuthoritiesResponse) Reset() {
+	*x = QueryParamsAuthoritiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsAuthoritiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsAuthoritiesResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsAuthoritiesResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueryParamsAuthoritiesResponse) GetParamsAuthorities() []*v1.ParamsAuthority {
+	if x != nil {
+		return x.ParamsAuthorities
+	}
+	return nil
+}
+
+// QueryParamsAtHeightRequest defines the request type for querying the x/vm
+// parameters in effect at a past height.
+type QueryParamsAtHeightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the block height to query the parameters at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryParamsAtHeightRequest) Reset() {
+	*x = QueryParamsAtHeightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsAtHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsAtHeightRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsAtHeightRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsAtHeightRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *QueryParamsAtHeightRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// QueryParamsAtHeightResponse defines the response type for querying the
+// x/vm parameters in effect at a past height.
+type QueryParamsAtHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params are the parameters in effect at the end of the queried block.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// change_height is the height of the parameter change in effect at the
+	// queried block.
+	ChangeHeight int64 `protobuf:"varint,2,opt,name=change_height,json=changeHeight,proto3" json:"change_height,omitempty"`
+}
+
+func (x *QueryParamsAtHeightResponse) Reset() {
+	*x = QueryParamsAtHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryBaseFeeResponse) String() string {
+func (x *QueryParamsAtHeightResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryBaseFeeResponse) ProtoMessage() {}
+func (*QueryParamsAtHeightResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryBaseFeeResponse.ProtoReflect.Descriptor instead.
-func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use QueryParamsAtHeightResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsAtHeightResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *QueryBaseFeeResponse) GetBaseFee() string {
+func (x *QueryParamsAtHeightResponse) GetParams() *Params {
 	if x != nil {
-		return x.BaseFee
+		return x.Params
 	}
-	return ""
+	return nil
 }
 
-// QueryGlobalMinGasPriceRequest defines the request type for querying the
-// GlobalMinGasPrice
-type QueryGlobalMinGasPriceRequest struct {
+func (x *QueryParamsAtHeightResponse) GetChangeHeight() int64 {
+	if x != nil {
+		return x.ChangeHeight
+	}
+	return 0
+}
+
+// QueryCodeMetadataRequest is the request type for the Query/CodeMetadata
+// RPC method.
+type QueryCodeMetadataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// address is the ethereum hex address of the contract to query the code
+	// metadata for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGlobalMinGasPriceRequest) Reset() {
-	*x = QueryGlobalMinGasPriceRequest{}
+func (x *QueryCodeMetadataRequest) Reset() {
+	*x = QueryCodeMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[26]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGlobalMinGasPriceRequest) String() string {
+func (x *QueryCodeMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGlobalMinGasPriceRequest) ProtoMessage() {}
+func (*QueryCodeMetadataRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryGlobalMinGasPriceRequest.ProtoReflect.Descriptor instead.
-func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use QueryCodeMetadataRequest.ProtoReflect.Descriptor instead.
+func (*QueryCodeMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{39}
 }
 
-// QueryGlobalMinGasPriceResponse returns the GlobalMinGasPrice
-type QueryGlobalMinGasPriceResponse struct {
+func (x *QueryCodeMetadataRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryCodeMetadataRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryCodeMetadataResponse is the response type for the Query/CodeMetadata
+// RPC method.
+type QueryCodeMetadataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// min_gas_price is the feemarket's min_gas_price
-	MinGasPrice string `protobuf:"bytes,1,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+	// metadata are the metadata published for the code hash of the contract,
+	// ordered by publisher.
+	Metadata []*CodeMetadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryGlobalMinGasPriceResponse) Reset() {
-	*x = QueryGlobalMinGasPriceResponse{}
+func (x *QueryCodeMetadataResponse) Reset() {
+	*x = QueryCodeMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[27]
+		mi := &file_cosmos_evm_vm_v1_query_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryGlobalMinGasPriceResponse) String() string {
+func (x *QueryCodeMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryGlobalMinGasPriceResponse) ProtoMessage() {}
+func (*QueryCodeMetadataResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryGlobalMinGasPriceResponse.ProtoReflect.Descriptor instead.
-func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use QueryCodeMetadataResponse.ProtoReflect.Descriptor instead.
+func (*QueryCodeMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_evm_vm_v1_query_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *QueryGlobalMinGasPriceResponse) GetMinGasPrice() string {
+func (x *QueryCodeMetadataResponse) GetMetadata() []*CodeMetadata {
 	if x != nil {
-		return x.MinGasPrice
+		return x.Metadata
 	}
-	return ""
+	return nil
+}
+
+func (x *QueryCodeMetadataResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
 var File_cosmos_evm_vm_v1_query_proto protoreflect.FileDescriptor
@@ -14816,72 +22140,94 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
 	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
 	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76,
-	0x31, 0x2f, 0x65, 0x76, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x4c, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x39, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x63, 0x0a, 0x14, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
-	0x3f, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
-	0x22, 0x86, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x1c, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0,
-	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x8b, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22,
-	0x30, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x22, 0x4b, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x39, 0x0a, 0x13, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x63, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x64,
+	0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x19, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x86, 0x01, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4b, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x22, 0x8b, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x39, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x30, 0x0a, 0x14, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x4b, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x2c, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0x2c,
-	0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a, 0x10,
+	0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xa5, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x10,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
@@ -14911,7 +22257,7 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
 	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
 	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0xb7, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0xf6, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
 	0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61,
 	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12,
@@ -14922,141 +22268,235 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70,
 	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19,
 	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x13, 0x45, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67,
-	0x61, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x89, 0x04, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72,
-	0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72,
-	0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0c,
-	0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
-	0x6d, 0x54, 0x78, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x45, 0x74, 0x68,
+	0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x61, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61, 0x70, 0x12, 0x5d, 0x0a,
 	0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f,
 	0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10,
-	0x03, 0x52, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x03, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x12, 0x45, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x14, 0x45, 0x74, 0x68, 0x43,
+	0x61, 0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x54, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x89, 0x04, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x54, 0x78, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x0c, 0x70, 0x72, 0x65,
+	0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78,
-	0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90,
-	0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa,
-	0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61,
-	0x73, 0x22, 0x2d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x34, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x19, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61,
-	0x73, 0x65, 0x46, 0x65, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c,
-	0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b,
-	0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x32, 0x8a, 0x0f, 0x0a, 0x05,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9e, 0x01,
-	0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
-	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xaf,
-	0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x12, 0x86, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x63,
+	0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52, 0x08,
+	0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xb7, 0x03, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x31, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x74,
+	0x78, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x48, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x5d, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x22, 0x2d,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x15, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73,
+	0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x49, 0x50, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0x3d, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x49, 0x50, 0x37, 0x31, 0x32,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x1f, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x80, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x11, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x7f, 0x0a, 0x1b, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00,
+	0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x32, 0xcc, 0x16, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x85, 0x01, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x23, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xaf, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x63, 0x6f, 0x6e, 0x73, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
 	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
-	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x8b, 0x01, 0x0a, 0x07, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x8b, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x7a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0xa6,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x7a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
 	0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
 	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
@@ -15079,68 +22519,118 @@ var file_cosmos_evm_vm_v1_query_proto_rawDesc = []byte{
 	0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74,
-	0x68, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x7e, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
-	0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54,
-	0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63,
-	0x65, 0x5f, 0x74, 0x78, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
-	0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x7c, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x68, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x8a, 0x01, 0x0a, 0x0c, 0x45, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01,
+	0x2a, 0x22, 0x20, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x74, 0x68, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x7e, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f,
+	0x67, 0x61, 0x73, 0x12, 0x7c, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x25,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x74,
+	0x78, 0x12, 0x88, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x12, 0x1a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76,
-	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x77, 0x0a,
-	0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x7c, 0x0a, 0x07,
+	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x47, 0x6c, 0x6f, 0x62, 0x61,
-	0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61,
-	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47,
-	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x67,
-	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0xad, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
+	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x77, 0x0a, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76,
+	0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x9f, 0x01, 0x0a, 0x11, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69,
+	0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x0f, 0x45, 0x49, 0x50, 0x37, 0x31, 0x32,
+	0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x49, 0x50, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x49, 0x50, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d,
+	0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x69, 0x70, 0x37, 0x31, 0x32, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0xa4, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2f,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76,
-	0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f,
-	0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d,
-	0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d,
-	0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0xa2, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x41,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2f, 0x7b, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x42, 0xad, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x6d, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x43, 0x45, 0x56, 0xaa, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x45, 0x76, 0x6d, 0x2e, 0x56, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x6d, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x6d, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -15155,7 +22645,7 @@ func file_cosmos_evm_vm_v1_query_proto_rawDescGZIP() []byte {
 	return file_cosmos_evm_vm_v1_query_proto_rawDescData
 }
 
-var file_cosmos_evm_vm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_cosmos_evm_vm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_cosmos_evm_vm_v1_query_proto_goTypes = []interface{}{
 	(*QueryConfigRequest)(nil),             // 0: cosmos.evm.vm.v1.QueryConfigRequest
 	(*QueryConfigResponse)(nil),            // 1: cosmos.evm.vm.v1.QueryConfigResponse
@@ -15169,78 +22659,116 @@ var file_cosmos_evm_vm_v1_query_proto_goTypes = []interface{}{
 	(*QueryBalanceResponse)(nil),           // 9: cosmos.evm.vm.v1.QueryBalanceResponse
 	(*QueryStorageRequest)(nil),            // 10: cosmos.evm.vm.v1.QueryStorageRequest
 	(*QueryStorageResponse)(nil),           // 11: cosmos.evm.vm.v1.QueryStorageResponse
-	(*QueryCodeRequest)(nil),               // 12: cosmos.evm.vm.v1.QueryCodeRequest
-	(*QueryCodeResponse)(nil),              // 13: cosmos.evm.vm.v1.QueryCodeResponse
-	(*QueryTxLogsRequest)(nil),             // 14: cosmos.evm.vm.v1.QueryTxLogsRequest
-	(*QueryTxLogsResponse)(nil),            // 15: cosmos.evm.vm.v1.QueryTxLogsResponse
-	(*QueryParamsRequest)(nil),             // 16: cosmos.evm.vm.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),            // 17: cosmos.evm.vm.v1.QueryParamsResponse
-	(*EthCallRequest)(nil),                 // 18: cosmos.evm.vm.v1.EthCallRequest
-	(*EstimateGasResponse)(nil),            // 19: cosmos.evm.vm.v1.EstimateGasResponse
-	(*QueryTraceTxRequest)(nil),            // 20: cosmos.evm.vm.v1.QueryTraceTxRequest
-	(*QueryTraceTxResponse)(nil),           // 21: cosmos.evm.vm.v1.QueryTraceTxResponse
-	(*QueryTraceBlockRequest)(nil),         // 22: cosmos.evm.vm.v1.QueryTraceBlockRequest
-	(*QueryTraceBlockResponse)(nil),        // 23: cosmos.evm.vm.v1.QueryTraceBlockResponse
-	(*QueryBaseFeeRequest)(nil),            // 24: cosmos.evm.vm.v1.QueryBaseFeeRequest
-	(*QueryBaseFeeResponse)(nil),           // 25: cosmos.evm.vm.v1.QueryBaseFeeResponse
-	(*QueryGlobalMinGasPriceRequest)(nil),  // 26: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
-	(*QueryGlobalMinGasPriceResponse)(nil), // 27: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
-	(*ChainConfig)(nil),                    // 28: cosmos.evm.vm.v1.ChainConfig
-	(*v1beta1.PageRequest)(nil),            // 29: cosmos.base.query.v1beta1.PageRequest
-	(*Log)(nil),                            // 30: cosmos.evm.vm.v1.Log
-	(*v1beta1.PageResponse)(nil),           // 31: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                         // 32: cosmos.evm.vm.v1.Params
-	(*MsgEthereumTx)(nil),                  // 33: cosmos.evm.vm.v1.MsgEthereumTx
-	(*TraceConfig)(nil),                    // 34: cosmos.evm.vm.v1.TraceConfig
-	(*timestamppb.Timestamp)(nil),          // 35: google.protobuf.Timestamp
-	(*MsgEthereumTxResponse)(nil),          // 36: cosmos.evm.vm.v1.MsgEthereumTxResponse
+	(*QueryContractStorageRequest)(nil),    // 12: cosmos.evm.vm.v1.QueryContractStorageRequest
+	(*QueryContractStorageResponse)(nil),   // 13: cosmos.evm.vm.v1.QueryContractStorageResponse
+	(*QueryCodeRequest)(nil),               // 14: cosmos.evm.vm.v1.QueryCodeRequest
+	(*QueryCodeResponse)(nil),              // 15: cosmos.evm.vm.v1.QueryCodeResponse
+	(*QueryTxLogsRequest)(nil),             // 16: cosmos.evm.vm.v1.QueryTxLogsRequest
+	(*QueryTxLogsResponse)(nil),            // 17: cosmos.evm.vm.v1.QueryTxLogsResponse
+	(*QueryParamsRequest)(nil),             // 18: cosmos.evm.vm.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),            // 19: cosmos.evm.vm.v1.QueryParamsResponse
+	(*EthCallRequest)(nil),                 // 20: cosmos.evm.vm.v1.EthCallRequest
+	(*EthCallBatchRequest)(nil),            // 21: cosmos.evm.vm.v1.EthCallBatchRequest
+	(*EthCallBatchResult)(nil),             // 22: cosmos.evm.vm.v1.EthCallBatchResult
+	(*EthCallBatchResponse)(nil),           // 23: cosmos.evm.vm.v1.EthCallBatchResponse
+	(*EstimateGasResponse)(nil),            // 24: cosmos.evm.vm.v1.EstimateGasResponse
+	(*QueryTraceTxRequest)(nil),            // 25: cosmos.evm.vm.v1.QueryTraceTxRequest
+	(*QueryTraceTxResponse)(nil),           // 26: cosmos.evm.vm.v1.QueryTraceTxResponse
+	(*QueryTraceBlockRequest)(nil),         // 27: cosmos.evm.vm.v1.QueryTraceBlockRequest
+	(*QueryTraceBlockResponse)(nil),        // 28: cosmos.evm.vm.v1.QueryTraceBlockResponse
+	(*QueryBaseFeeRequest)(nil),            // 29: cosmos.evm.vm.v1.QueryBaseFeeRequest
+	(*QueryBaseFeeResponse)(nil),           // 30: cosmos.evm.vm.v1.QueryBaseFeeResponse
+	(*QueryGlobalMinGasPriceRequest)(nil),  // 31: cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
+	(*QueryGlobalMinGasPriceResponse)(nil), // 32: cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
+	(*QueryEIP712TypedDataRequest)(nil),    // 33: cosmos.evm.vm.v1.QueryEIP712TypedDataRequest
+	(*QueryEIP712TypedDataResponse)(nil),   // 34: cosmos.evm.vm.v1.QueryEIP712TypedDataResponse
+	(*QueryParamsAuthoritiesRequest)(nil),  // 35: cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest
+	(*QueryParamsAuthoritiesResponse)(nil), // 36: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse
+	(*QueryParamsAtHeightRequest)(nil),     // 37: cosmos.evm.vm.v1.QueryParamsAtHeightRequest
+	(*QueryParamsAtHeightResponse)(nil),    // 38: cosmos.evm.vm.v1.QueryParamsAtHeightResponse
+	(*QueryCodeMetadataRequest)(nil),       // 39: cosmos.evm.vm.v1.QueryCodeMetadataRequest
+	(*QueryCodeMetadataResponse)(nil),      // 40: cosmos.evm.vm.v1.QueryCodeMetadataResponse
+	(*ChainConfig)(nil),                    // 41: cosmos.evm.vm.v1.ChainConfig
+	(*v1beta1.PageRequest)(nil),            // 42: cosmos.base.query.v1beta1.PageRequest
+	(*State)(nil),                          // 43: cosmos.evm.vm.v1.State
+	(*v1beta1.PageResponse)(nil),           // 44: cosmos.base.query.v1beta1.PageResponse
+	(*Log)(nil),                            // 45: cosmos.evm.vm.v1.Log
+	(*Params)(nil),                         // 46: cosmos.evm.vm.v1.Params
+	(*MsgEthereumTxResponse)(nil),          // 47: cosmos.evm.vm.v1.MsgEthereumTxResponse
+	(*MsgEthereumTx)(nil),                  // 48: cosmos.evm.vm.v1.MsgEthereumTx
+	(*TraceConfig)(nil),                    // 49: cosmos.evm.vm.v1.TraceConfig
+	(*timestamppb.Timestamp)(nil),          // 50: google.protobuf.Timestamp
+	(*v1.ParamsAuthority)(nil),             // 51: cosmos.evm.types.v1.ParamsAuthority
+	(*CodeMetadata)(nil),                   // 52: cosmos.evm.vm.v1.CodeMetadata
 }
 var file_cosmos_evm_vm_v1_query_proto_depIdxs = []int32{
-	28, // 0: cosmos.evm.vm.v1.QueryConfigResponse.config:type_name -> cosmos.evm.vm.v1.ChainConfig
-	29, // 1: cosmos.evm.vm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	30, // 2: cosmos.evm.vm.v1.QueryTxLogsResponse.logs:type_name -> cosmos.evm.vm.v1.Log
-	31, // 3: cosmos.evm.vm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	32, // 4: cosmos.evm.vm.v1.QueryParamsResponse.params:type_name -> cosmos.evm.vm.v1.Params
-	33, // 5: cosmos.evm.vm.v1.QueryTraceTxRequest.msg:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	34, // 6: cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	33, // 7: cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	35, // 8: cosmos.evm.vm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
-	33, // 9: cosmos.evm.vm.v1.QueryTraceBlockRequest.txs:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
-	34, // 10: cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
-	35, // 11: cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
-	2,  // 12: cosmos.evm.vm.v1.Query.Account:input_type -> cosmos.evm.vm.v1.QueryAccountRequest
-	4,  // 13: cosmos.evm.vm.v1.Query.CosmosAccount:input_type -> cosmos.evm.vm.v1.QueryCosmosAccountRequest
-	6,  // 14: cosmos.evm.vm.v1.Query.ValidatorAccount:input_type -> cosmos.evm.vm.v1.QueryValidatorAccountRequest
-	8,  // 15: cosmos.evm.vm.v1.Query.Balance:input_type -> cosmos.evm.vm.v1.QueryBalanceRequest
-	10, // 16: cosmos.evm.vm.v1.Query.Storage:input_type -> cosmos.evm.vm.v1.QueryStorageRequest
-	12, // 17: cosmos.evm.vm.v1.Query.Code:input_type -> cosmos.evm.vm.v1.QueryCodeRequest
-	16, // 18: cosmos.evm.vm.v1.Query.Params:input_type -> cosmos.evm.vm.v1.QueryParamsRequest
-	18, // 19: cosmos.evm.vm.v1.Query.EthCall:input_type -> cosmos.evm.vm.v1.EthCallRequest
-	18, // 20: cosmos.evm.vm.v1.Query.EstimateGas:input_type -> cosmos.evm.vm.v1.EthCallRequest
-	20, // 21: cosmos.evm.vm.v1.Query.TraceTx:input_type -> cosmos.evm.vm.v1.QueryTraceTxRequest
-	22, // 22: cosmos.evm.vm.v1.Query.TraceBlock:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
-	24, // 23: cosmos.evm.vm.v1.Query.BaseFee:input_type -> cosmos.evm.vm.v1.QueryBaseFeeRequest
-	0,  // 24: cosmos.evm.vm.v1.Query.Config:input_type -> cosmos.evm.vm.v1.QueryConfigRequest
-	26, // 25: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:input_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
-	3,  // 26: cosmos.evm.vm.v1.Query.Account:output_type -> cosmos.evm.vm.v1.QueryAccountResponse
-	5,  // 27: cosmos.evm.vm.v1.Query.CosmosAccount:output_type -> cosmos.evm.vm.v1.QueryCosmosAccountResponse
-	7,  // 28: cosmos.evm.vm.v1.Query.ValidatorAccount:output_type -> cosmos.evm.vm.v1.QueryValidatorAccountResponse
-	9,  // 29: cosmos.evm.vm.v1.Query.Balance:output_type -> cosmos.evm.vm.v1.QueryBalanceResponse
-	11, // 30: cosmos.evm.vm.v1.Query.Storage:output_type -> cosmos.evm.vm.v1.QueryStorageResponse
-	13, // 31: cosmos.evm.vm.v1.Query.Code:output_type -> cosmos.evm.vm.v1.QueryCodeResponse
-	17, // 32: cosmos.evm.vm.v1.Query.Params:output_type -> cosmos.evm.vm.v1.QueryParamsResponse
-	36, // 33: cosmos.evm.vm.v1.Query.EthCall:output_type -> cosmos.evm.vm.v1.MsgEthereumTxResponse
-	19, // 34: cosmos.evm.vm.v1.Query.EstimateGas:output_type -> cosmos.evm.vm.v1.EstimateGasResponse
-	21, // 35: cosmos.evm.vm.v1.Query.TraceTx:output_type -> cosmos.evm.vm.v1.QueryTraceTxResponse
-	23, // 36: cosmos.evm.vm.v1.Query.TraceBlock:output_type -> cosmos.evm.vm.v1.QueryTraceBlockResponse
-	25, // 37: cosmos.evm.vm.v1.Query.BaseFee:output_type -> cosmos.evm.vm.v1.QueryBaseFeeResponse
-	1,  // 38: cosmos.evm.vm.v1.Query.Config:output_type -> cosmos.evm.vm.v1.QueryConfigResponse
-	27, // 39: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:output_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
-	26, // [26:40] is the sub-list for method output_type
-	12, // [12:26] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	41, // 0: cosmos.evm.vm.v1.QueryConfigResponse.config:type_name -> cosmos.evm.vm.v1.ChainConfig
+	42, // 1: cosmos.evm.vm.v1.QueryContractStorageRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	43, // 2: cosmos.evm.vm.v1.QueryContractStorageResponse.storage:type_name -> cosmos.evm.vm.v1.State
+	44, // 3: cosmos.evm.vm.v1.QueryContractStorageResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	42, // 4: cosmos.evm.vm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	45, // 5: cosmos.evm.vm.v1.QueryTxLogsResponse.logs:type_name -> cosmos.evm.vm.v1.Log
+	44, // 6: cosmos.evm.vm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	46, // 7: cosmos.evm.vm.v1.QueryParamsResponse.params:type_name -> cosmos.evm.vm.v1.Params
+	47, // 8: cosmos.evm.vm.v1.EthCallBatchResult.response:type_name -> cosmos.evm.vm.v1.MsgEthereumTxResponse
+	22, // 9: cosmos.evm.vm.v1.EthCallBatchResponse.results:type_name -> cosmos.evm.vm.v1.EthCallBatchResult
+	48, // 10: cosmos.evm.vm.v1.QueryTraceTxRequest.msg:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	49, // 11: cosmos.evm.vm.v1.QueryTraceTxRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	48, // 12: cosmos.evm.vm.v1.QueryTraceTxRequest.predecessors:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	50, // 13: cosmos.evm.vm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
+	48, // 14: cosmos.evm.vm.v1.QueryTraceBlockRequest.txs:type_name -> cosmos.evm.vm.v1.MsgEthereumTx
+	49, // 15: cosmos.evm.vm.v1.QueryTraceBlockRequest.trace_config:type_name -> cosmos.evm.vm.v1.TraceConfig
+	50, // 16: cosmos.evm.vm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
+	51, // 17: cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse.params_authorities:type_name -> cosmos.evm.types.v1.ParamsAuthority
+	46, // 18: cosmos.evm.vm.v1.QueryParamsAtHeightResponse.params:type_name -> cosmos.evm.vm.v1.Params
+	42, // 19: cosmos.evm.vm.v1.QueryCodeMetadataRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	52, // 20: cosmos.evm.vm.v1.QueryCodeMetadataResponse.metadata:type_name -> cosmos.evm.vm.v1.CodeMetadata
+	44, // 21: cosmos.evm.vm.v1.QueryCodeMetadataResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	2,  // 22: cosmos.evm.vm.v1.Query.Account:input_type -> cosmos.evm.vm.v1.QueryAccountRequest
+	4,  // 23: cosmos.evm.vm.v1.Query.CosmosAccount:input_type -> cosmos.evm.vm.v1.QueryCosmosAccountRequest
+	6,  // 24: cosmos.evm.vm.v1.Query.ValidatorAccount:input_type -> cosmos.evm.vm.v1.QueryValidatorAccountRequest
+	8,  // 25: cosmos.evm.vm.v1.Query.Balance:input_type -> cosmos.evm.vm.v1.QueryBalanceRequest
+	10, // 26: cosmos.evm.vm.v1.Query.Storage:input_type -> cosmos.evm.vm.v1.QueryStorageRequest
+	12, // 27: cosmos.evm.vm.v1.Query.ContractStorage:input_type -> cosmos.evm.vm.v1.QueryContractStorageRequest
+	14, // 28: cosmos.evm.vm.v1.Query.Code:input_type -> cosmos.evm.vm.v1.QueryCodeRequest
+	18, // 29: cosmos.evm.vm.v1.Query.Params:input_type -> cosmos.evm.vm.v1.QueryParamsRequest
+	20, // 30: cosmos.evm.vm.v1.Query.EthCall:input_type -> cosmos.evm.vm.v1.EthCallRequest
+	21, // 31: cosmos.evm.vm.v1.Query.EthCallBatch:input_type -> cosmos.evm.vm.v1.EthCallBatchRequest
+	20, // 32: cosmos.evm.vm.v1.Query.EstimateGas:input_type -> cosmos.evm.vm.v1.EthCallRequest
+	25, // 33: cosmos.evm.vm.v1.Query.TraceTx:input_type -> cosmos.evm.vm.v1.QueryTraceTxRequest
+	27, // 34: cosmos.evm.vm.v1.Query.TraceBlock:input_type -> cosmos.evm.vm.v1.QueryTraceBlockRequest
+	29, // 35: cosmos.evm.vm.v1.Query.BaseFee:input_type -> cosmos.evm.vm.v1.QueryBaseFeeRequest
+	0,  // 36: cosmos.evm.vm.v1.Query.Config:input_type -> cosmos.evm.vm.v1.QueryConfigRequest
+	31, // 37: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:input_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest
+	33, // 38: cosmos.evm.vm.v1.Query.EIP712TypedData:input_type -> cosmos.evm.vm.v1.QueryEIP712TypedDataRequest
+	35, // 39: cosmos.evm.vm.v1.Query.ParamsAuthorities:input_type -> cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest
+	37, // 40: cosmos.evm.vm.v1.Query.ParamsAtHeight:input_type -> cosmos.evm.vm.v1.QueryParamsAtHeightRequest
+	39, // 41: cosmos.evm.vm.v1.Query.CodeMetadata:input_type -> cosmos.evm.vm.v1.QueryCodeMetadataRequest
+	3,  // 42: cosmos.evm.vm.v1.Query.Account:output_type -> cosmos.evm.vm.v1.QueryAccountResponse
+	5,  // 43: cosmos.evm.vm.v1.Query.CosmosAccount:output_type -> cosmos.evm.vm.v1.QueryCosmosAccountResponse
+	7,  // 44: cosmos.evm.vm.v1.Query.ValidatorAccount:output_type -> cosmos.evm.vm.v1.QueryValidatorAccountResponse
+	9,  // 45: cosmos.evm.vm.v1.Query.Balance:output_type -> cosmos.evm.vm.v1.QueryBalanceResponse
+	11, // 46: cosmos.evm.vm.v1.Query.Storage:output_type -> cosmos.evm.vm.v1.QueryStorageResponse
+	13, // 47: cosmos.evm.vm.v1.Query.ContractStorage:output_type -> cosmos.evm.vm.v1.QueryContractStorageResponse
+	15, // 48: cosmos.evm.vm.v1.Query.Code:output_type -> cosmos.evm.vm.v1.QueryCodeResponse
+	19, // 49: cosmos.evm.vm.v1.Query.Params:output_type -> cosmos.evm.vm.v1.QueryParamsResponse
+	47, // 50: cosmos.evm.vm.v1.Query.EthCall:output_type -> cosmos.evm.vm.v1.MsgEthereumTxResponse
+	23, // 51: cosmos.evm.vm.v1.Query.EthCallBatch:output_type -> cosmos.evm.vm.v1.EthCallBatchResponse
+	24, // 52: cosmos.evm.vm.v1.Query.EstimateGas:output_type -> cosmos.evm.vm.v1.EstimateGasResponse
+	26, // 53: cosmos.evm.vm.v1.Query.TraceTx:output_type -> cosmos.evm.vm.v1.QueryTraceTxResponse
+	28, // 54: cosmos.evm.vm.v1.Query.TraceBlock:output_type -> cosmos.evm.vm.v1.QueryTraceBlockResponse
+	30, // 55: cosmos.evm.vm.v1.Query.BaseFee:output_type -> cosmos.evm.vm.v1.QueryBaseFeeResponse
+	1,  // 56: cosmos.evm.vm.v1.Query.Config:output_type -> cosmos.evm.vm.v1.QueryConfigResponse
+	32, // 57: cosmos.evm.vm.v1.Query.GlobalMinGasPrice:output_type -> cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse
+	34, // 58: cosmos.evm.vm.v1.Query.EIP712TypedData:output_type -> cosmos.evm.vm.v1.QueryEIP712TypedDataResponse
+	36, // 59: cosmos.evm.vm.v1.Query.ParamsAuthorities:output_type -> cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse
+	38, // 60: cosmos.evm.vm.v1.Query.ParamsAtHeight:output_type -> cosmos.evm.vm.v1.QueryParamsAtHeightResponse
+	40, // 61: cosmos.evm.vm.v1.Query.CodeMetadata:output_type -> cosmos.evm.vm.v1.QueryCodeMetadataResponse
+	42, // [42:62] is the sub-list for method output_type
+	22, // [22:42] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_evm_vm_v1_query_proto_init() }
@@ -15396,7 +22924,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCodeRequest); i {
+			switch v := v.(*QueryContractStorageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15408,7 +22936,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryCodeResponse); i {
+			switch v := v.(*QueryContractStorageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15420,7 +22948,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsRequest); i {
+			switch v := v.(*QueryCodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15432,7 +22960,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTxLogsResponse); i {
+			switch v := v.(*QueryCodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15444,7 +22972,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryTxLogsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15456,7 +22984,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryTxLogsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15468,7 +22996,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthCallRequest); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15480,7 +23008,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasResponse); i {
+			switch v := v.(*QueryParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15492,7 +23020,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxRequest); i {
+			switch v := v.(*EthCallRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15504,7 +23032,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxResponse); i {
+			switch v := v.(*EthCallBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15516,7 +23044,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockRequest); i {
+			switch v := v.(*EthCallBatchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15528,7 +23056,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockResponse); i {
+			switch v := v.(*EthCallBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15540,7 +23068,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeRequest); i {
+			switch v := v.(*EstimateGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15552,7 +23080,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeResponse); i {
+			switch v := v.(*QueryTraceTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15564,7 +23092,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGlobalMinGasPriceRequest); i {
+			switch v := v.(*QueryTraceTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15576,6 +23104,66 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			}
 		}
 		file_cosmos_evm_vm_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBaseFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGlobalMinGasPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryGlobalMinGasPriceResponse); i {
 			case 0:
 				return &v.state
@@ -15587,6 +23175,102 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEIP712TypedDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEIP712TypedDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsAuthoritiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsAuthoritiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsAtHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsAtHeightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCodeMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_evm_vm_v1_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCodeMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -15594,7 +23278,7 @@ func file_cosmos_evm_vm_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_evm_vm_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
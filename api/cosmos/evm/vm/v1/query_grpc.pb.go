@@ -24,15 +24,21 @@ const (
 	Query_ValidatorAccount_FullMethodName  = "/cosmos.evm.vm.v1.Query/ValidatorAccount"
 	Query_Balance_FullMethodName           = "/cosmos.evm.vm.v1.Query/Balance"
 	Query_Storage_FullMethodName           = "/cosmos.evm.vm.v1.Query/Storage"
+	Query_ContractStorage_FullMethodName   = "/cosmos.evm.vm.v1.Query/ContractStorage"
 	Query_Code_FullMethodName              = "/cosmos.evm.vm.v1.Query/Code"
 	Query_Params_FullMethodName            = "/cosmos.evm.vm.v1.Query/Params"
 	Query_EthCall_FullMethodName           = "/cosmos.evm.vm.v1.Query/EthCall"
+	Query_EthCallBatch_FullMethodName      = "/cosmos.evm.vm.v1.Query/EthCallBatch"
 	Query_EstimateGas_FullMethodName       = "/cosmos.evm.vm.v1.Query/EstimateGas"
 	Query_TraceTx_FullMethodName           = "/cosmos.evm.vm.v1.Query/TraceTx"
 	Query_TraceBlock_FullMethodName        = "/cosmos.evm.vm.v1.Query/TraceBlock"
 	Query_BaseFee_FullMethodName           = "/cosmos.evm.vm.v1.Query/BaseFee"
 	Query_Config_FullMethodName            = "/cosmos.evm.vm.v1.Query/Config"
 	Query_GlobalMinGasPrice_FullMethodName = "/cosmos.evm.vm.v1.Query/GlobalMinGasPrice"
+	Query_EIP712TypedData_FullMethodName   = "/cosmos.evm.vm.v1.Query/EIP712TypedData"
+	Query_ParamsAuthorities_FullMethodName = "/cosmos.evm.vm.v1.Query/ParamsAuthorities"
+	Query_ParamsAtHeight_FullMethodName    = "/cosmos.evm.vm.v1.Query/ParamsAtHeight"
+	Query_CodeMetadata_FullMethodName      = "/cosmos.evm.vm.v1.Query/CodeMetadata"
 )
 
 // QueryClient is the client API for Query service.
@@ -51,12 +57,17 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// ContractStorage queries all the storage slots of a contract.
+	ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// EthCallBatch executes a batch of `eth_call` requests against the state of
+	// a single height
+	EthCallBatch(ctx context.Context, in *EthCallBatchRequest, opts ...grpc.CallOption) (*EthCallBatchResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
@@ -75,6 +86,17 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// EIP712TypedData returns the EIP-712 typed data that must be signed to
+	// authorize the given unsigned Cosmos transaction.
+	EIP712TypedData(ctx context.Context, in *QueryEIP712TypedDataRequest, opts ...grpc.CallOption) (*QueryEIP712TypedDataResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/vm module parameters.
+	ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/vm module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
+	// CodeMetadata queries the metadata published for the code of a contract.
+	CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error)
 }
 
 type queryClient struct {
@@ -130,6 +152,15 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error) {
+	out := new(QueryContractStorageResponse)
+	err := c.cc.Invoke(ctx, Query_ContractStorage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
 	out := new(QueryCodeResponse)
 	err := c.cc.Invoke(ctx, Query_Code_FullMethodName, in, out, opts...)
@@ -157,6 +188,15 @@ func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) EthCallBatch(ctx context.Context, in *EthCallBatchRequest, opts ...grpc.CallOption) (*EthCallBatchResponse, error) {
+	out := new(EthCallBatchResponse)
+	err := c.cc.Invoke(ctx, Query_EthCallBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
 	out := new(EstimateGasResponse)
 	err := c.cc.Invoke(ctx, Query_EstimateGas_FullMethodName, in, out, opts...)
@@ -211,6 +251,42 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) EIP712TypedData(ctx context.Context, in *QueryEIP712TypedDataRequest, opts ...grpc.CallOption) (*QueryEIP712TypedDataResponse, error) {
+	out := new(QueryEIP712TypedDataResponse)
+	err := c.cc.Invoke(ctx, Query_EIP712TypedData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error) {
+	out := new(QueryParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAuthorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ParamsAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error) {
+	out := new(QueryCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, Query_CodeMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -227,12 +303,17 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// ContractStorage queries all the storage slots of a contract.
+	ContractStorage(context.Context, *QueryContractStorageRequest) (*QueryContractStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
+	// EthCallBatch executes a batch of `eth_call` requests against the state of
+	// a single height
+	EthCallBatch(context.Context, *EthCallBatchRequest) (*EthCallBatchResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
@@ -251,6 +332,17 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// EIP712TypedData returns the EIP-712 typed data that must be signed to
+	// authorize the given unsigned Cosmos transaction.
+	EIP712TypedData(context.Context, *QueryEIP712TypedDataRequest) (*QueryEIP712TypedDataResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/vm module parameters.
+	ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/vm module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
+	// CodeMetadata queries the metadata published for the code of a contract.
+	CodeMetadata(context.Context, *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -273,6 +365,9 @@ func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (
 func (UnimplementedQueryServer) Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (UnimplementedQueryServer) ContractStorage(context.Context, *QueryContractStorageRequest) (*QueryContractStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorage not implemented")
+}
 func (UnimplementedQueryServer) Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -282,6 +377,9 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
 }
+func (UnimplementedQueryServer) EthCallBatch(context.Context, *EthCallBatchRequest) (*EthCallBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthCallBatch not implemented")
+}
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
@@ -300,6 +398,18 @@ func (UnimplementedQueryServer) Config(context.Context, *QueryConfigRequest) (*Q
 func (UnimplementedQueryServer) GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (UnimplementedQueryServer) EIP712TypedData(context.Context, *QueryEIP712TypedDataRequest) (*QueryEIP712TypedDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EIP712TypedData not implemented")
+}
+func (UnimplementedQueryServer) ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAuthorities not implemented")
+}
+func (UnimplementedQueryServer) ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
+func (UnimplementedQueryServer) CodeMetadata(context.Context, *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeMetadata not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -403,6 +513,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorage(ctx, req.(*QueryContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -457,6 +585,24 @@ func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EthCallBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthCallBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EthCallBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthCallBatch(ctx, req.(*EthCallBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
@@ -565,6 +711,78 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EIP712TypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEIP712TypedDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EIP712TypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EIP712TypedData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EIP712TypedData(ctx, req.(*QueryEIP712TypedDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAuthorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAuthorities(ctx, req.(*QueryParamsAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ParamsAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CodeMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeMetadata(ctx, req.(*QueryCodeMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -592,6 +810,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "ContractStorage",
+			Handler:    _Query_ContractStorage_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -604,6 +826,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EthCall",
 			Handler:    _Query_EthCall_Handler,
 		},
+		{
+			MethodName: "EthCallBatch",
+			Handler:    _Query_EthCallBatch_Handler,
+		},
 		{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
@@ -628,6 +854,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "EIP712TypedData",
+			Handler:    _Query_EIP712TypedData_Handler,
+		},
+		{
+			MethodName: "ParamsAuthorities",
+			Handler:    _Query_ParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
+		{
+			MethodName: "CodeMetadata",
+			Handler:    _Query_CodeMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_EthereumTx_FullMethodName          = "/cosmos.evm.vm.v1.Msg/EthereumTx"
-	Msg_UpdateParams_FullMethodName        = "/cosmos.evm.vm.v1.Msg/UpdateParams"
-	Msg_RegisterPreinstalls_FullMethodName = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_EthereumTx_FullMethodName              = "/cosmos.evm.vm.v1.Msg/EthereumTx"
+	Msg_UpdateParams_FullMethodName            = "/cosmos.evm.vm.v1.Msg/UpdateParams"
+	Msg_RegisterPreinstalls_FullMethodName     = "/cosmos.evm.vm.v1.Msg/RegisterPreinstalls"
+	Msg_UpdateParamsAuthorities_FullMethodName = "/cosmos.evm.vm.v1.Msg/UpdateParamsAuthorities"
+	Msg_RegisterCodeMetadata_FullMethodName    = "/cosmos.evm.vm.v1.Msg/RegisterCodeMetadata"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +40,14 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/vm module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error)
+	// RegisterCodeMetadata defines a method for publishing the metadata of a
+	// contract code hash stored on-chain, to be used as a source verification
+	// anchor. Any account can publish metadata for a code hash.
+	RegisterCodeMetadata(ctx context.Context, in *MsgRegisterCodeMetadata, opts ...grpc.CallOption) (*MsgRegisterCodeMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -75,6 +85,24 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	out := new(MsgUpdateParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParamsAuthorities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCodeMetadata(ctx context.Context, in *MsgRegisterCodeMetadata, opts ...grpc.CallOption) (*MsgRegisterCodeMetadataResponse, error) {
+	out := new(MsgRegisterCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterCodeMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -89,6 +117,14 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/vm module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error)
+	// RegisterCodeMetadata defines a method for publishing the metadata of a
+	// contract code hash stored on-chain, to be used as a source verification
+	// anchor. Any account can publish metadata for a code hash.
+	RegisterCodeMetadata(context.Context, *MsgRegisterCodeMetadata) (*MsgRegisterCodeMetadataResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -105,6 +141,12 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (UnimplementedMsgServer) UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsAuthorities not implemented")
+}
+func (UnimplementedMsgServer) RegisterCodeMetadata(context.Context, *MsgRegisterCodeMetadata) (*MsgRegisterCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCodeMetadata not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +214,42 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParamsAuthorities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, req.(*MsgUpdateParamsAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCodeMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterCodeMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCodeMetadata(ctx, req.(*MsgRegisterCodeMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +269,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "UpdateParamsAuthorities",
+			Handler:    _Msg_UpdateParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "RegisterCodeMetadata",
+			Handler:    _Msg_RegisterCodeMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -17,6 +17,7 @@ import (
 	cosmosclientdebug "github.com/cosmos/cosmos-sdk/client/debug"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
@@ -40,6 +41,7 @@ func Cmd() *cobra.Command {
 		// Cosmos EVM adjusted debug commands
 		PubkeyCmd(),
 		AddrCmd(),
+		AddrConvertCmd(),
 		RawBytesCmd(),
 		LegacyEIP712Cmd(),
 	)
@@ -135,6 +137,68 @@ $ %s debug addr 0x00000Be6819f41400225702D32d3dd23663Dd690 --prefix cosmosevmtyp
 	return cmd
 }
 
+// AddrConvertCmd converts an address between hex and bech32 with any prefix
+func AddrConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "addr-convert [address]",
+		Short: "Convert an address between hex and bech32 with any prefix",
+		Long: `Convert a hex address to bech32, using the account prefix if --prefix is not set, or a bech32
+address of any prefix to its EIP-55 hex encoding, or to bech32 with the prefix given by --prefix.
+Only the converted address is printed.`,
+		Example: fmt.Sprintf(
+			`$ %s debug addr-convert 0x00000Be6819f41400225702D32d3dd23663Dd690
+$ %s debug addr-convert 0x00000Be6819f41400225702D32d3dd23663Dd690 --prefix cosmosvaloper
+$ %s debug addr-convert cosmos1qqqqhe5pnaq5qq39wqkn957aydnrm45s0jk6ae
+$ %s debug addr-convert cosmos1qqqqhe5pnaq5qq39wqkn957aydnrm45s0jk6ae --prefix osmo`,
+			version.AppName, version.AppName, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, err := cmd.Flags().GetString(flagPrefix)
+			if err != nil {
+				return err
+			}
+
+			addr, err := ConvertAddress(args[0], prefix)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(addr)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, "", "Bech32 prefix to convert the address to, for example cosmos, cosmosvaloper")
+	return cmd
+}
+
+// ConvertAddress converts a hex address to bech32 with the given prefix, or the
+// account prefix if empty, and a bech32 address to hex if the prefix is empty or
+// to bech32 with the given prefix otherwise.
+func ConvertAddress(addr, prefix string) (string, error) {
+	var bz []byte
+	if common.IsHexAddress(addr) {
+		if prefix == "" {
+			prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+		}
+		bz = common.HexToAddress(addr).Bytes()
+	} else {
+		_, decoded, err := bech32.DecodeAndConvert(addr)
+		if err != nil {
+			return "", fmt.Errorf("%s is neither a hex nor a bech32 address: %w", addr, err)
+		}
+		if prefix == "" {
+			if len(decoded) != common.AddressLength {
+				return "", fmt.Errorf("address %s is %d bytes long, expected %d bytes", addr, len(decoded), common.AddressLength)
+			}
+			return common.BytesToAddress(decoded).Hex(), nil
+		}
+		bz = decoded
+	}
+
+	return bech32.ConvertAndEncode(prefix, bz)
+}
+
 func RawBytesCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:     "raw-bytes [raw-bytes]",
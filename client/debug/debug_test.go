@@ -0,0 +1,43 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAddress(t *testing.T) {
+	const (
+		hexAddr = "0x00000Be6819f41400225702D32d3dd23663Dd690"
+		accAddr = "cosmos1qqqqhe5pnaq5qq39wqkn957aydnrm45s0jk6ae"
+		valAddr = "cosmosvaloper1qqqqhe5pnaq5qq39wqkn957aydnrm45s2xz032"
+	)
+
+	testCases := []struct {
+		name    string
+		addr    string
+		prefix  string
+		exp     string
+		expPass bool
+	}{
+		{"hex to account bech32", hexAddr, "", accAddr, true},
+		{"lowercase hex to bech32 with prefix", "0x00000be6819f41400225702d32d3dd23663dd690", "cosmosvaloper", valAddr, true},
+		{"bech32 to hex", accAddr, "", hexAddr, true},
+		{"bech32 with other prefix to hex", valAddr, "", hexAddr, true},
+		{"bech32 to bech32 with prefix", accAddr, "cosmosvaloper", valAddr, true},
+		{"invalid address", "invalid", "", "", false},
+		{"invalid bech32 checksum", accAddr[:len(accAddr)-1] + "x", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := ConvertAddress(tc.addr, tc.prefix)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, addr)
+		})
+	}
+}
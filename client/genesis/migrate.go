@@ -0,0 +1,545 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	// ethermintEthAccountTypeURL is the type URL of the Ethermint and Evmos
+	// account, which holds the code hash of the contracts.
+	ethermintEthAccountTypeURL = "/ethermint.types.v1.EthAccount"
+	// ethermintPubKeyTypeURL is the type URL of the Ethermint and Evmos
+	// eth_secp256k1 public key.
+	ethermintPubKeyTypeURL = "/ethermint.crypto.v1.ethsecp256k1.PubKey"
+)
+
+var (
+	baseAccountTypeURL = sdk.MsgTypeURL(&authtypes.BaseAccount{})
+	pubKeyTypeURL      = sdk.MsgTypeURL(&ethsecp256k1.PubKey{})
+)
+
+// MigrateGenesisCmd returns the command to migrate an exported Ethermint or
+// Evmos genesis file to the genesis schema of the Cosmos EVM modules.
+func MigrateGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-genesis [genesis-file]",
+		Short: "Migrate an Ethermint or Evmos genesis to the Cosmos EVM genesis",
+		Long: `Migrate an exported Ethermint or Evmos genesis to the Cosmos EVM genesis and print it to STDOUT.
+The evm, erc20 and feemarket module states are converted to their Cosmos EVM schema, the EthAccount
+accounts to base accounts and the Ethermint eth_secp256k1 public keys to the Cosmos EVM ones.
+The migrated genesis is validated: the module states, the EVM accounts having an auth account,
+the code hashes of the migrated EthAccount accounts and the external ERC-20 contracts having code.
+
+The Cosmos SDK module states are left untouched, use the genesis migrate command to migrate them.`,
+		Example: fmt.Sprintf("%s migrate-genesis /path/to/genesis.json --chain-id=cosmos_9001-1", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var appState genutiltypes.AppMap
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to JSON unmarshal initial genesis state: %w", err)
+			}
+
+			appState, err = Migrate(appState, clientCtx)
+			if err != nil {
+				return fmt.Errorf("failed to migrate genesis state: %w", err)
+			}
+
+			appGenesis.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to JSON marshal migrated genesis state: %w", err)
+			}
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			if chainID != "" {
+				appGenesis.ChainID = chainID
+			}
+
+			outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+			if outputDocument != "" {
+				return appGenesis.SaveAs(outputDocument)
+			}
+
+			bz, err := json.Marshal(appGenesis)
+			if err != nil {
+				return fmt.Errorf("failed to marshal app genesis: %w", err)
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "Override chain_id with this flag")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "Migrated genesis is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// Migrate migrates the Ethermint or Evmos app state to the Cosmos EVM one and
+// validates the migrated state. It can be used as a genutil migration
+// callback.
+func Migrate(appState genutiltypes.AppMap, clientCtx client.Context) (genutiltypes.AppMap, error) {
+	cdc := clientCtx.Codec
+
+	codeHashes, err := migrateAuth(appState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate %s genesis: %w", authtypes.ModuleName, err)
+	}
+
+	for module, raw := range appState {
+		appState[module] = bytes.ReplaceAll(raw, []byte(ethermintPubKeyTypeURL), []byte(pubKeyTypeURL))
+	}
+
+	evmGenesis, err := migrateEVM(cdc, appState[evmtypes.ModuleName])
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate %s genesis: %w", evmtypes.ModuleName, err)
+	}
+
+	feeMarketGenesis, err := migrateFeeMarket(cdc, appState[feemarkettypes.ModuleName])
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate %s genesis: %w", feemarkettypes.ModuleName, err)
+	}
+
+	erc20Genesis, err := migrateERC20(cdc, appState[erc20types.ModuleName])
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate %s genesis: %w", erc20types.ModuleName, err)
+	}
+
+	var authGenesis authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[authtypes.ModuleName], &authGenesis); err != nil {
+		return nil, fmt.Errorf("invalid migrated %s genesis: %w", authtypes.ModuleName, err)
+	}
+
+	if err := validateState(appState[authtypes.ModuleName], codeHashes, evmGenesis, erc20Genesis); err != nil {
+		return nil, err
+	}
+
+	appState[evmtypes.ModuleName] = cdc.MustMarshalJSON(evmGenesis)
+	appState[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(feeMarketGenesis)
+	appState[erc20types.ModuleName] = cdc.MustMarshalJSON(erc20Genesis)
+
+	return appState, nil
+}
+
+// migrateAuth replaces the EthAccount accounts of the auth genesis with their
+// base account, and returns the code hashes they held by address.
+func migrateAuth(appState genutiltypes.AppMap) (map[common.Address]common.Hash, error) {
+	var authGenesis map[string]any
+	if err := unmarshalJSON(appState[authtypes.ModuleName], &authGenesis); err != nil {
+		return nil, err
+	}
+
+	accounts, _ := authGenesis["accounts"].([]any)
+	codeHashes := make(map[common.Address]common.Hash)
+
+	for i, acc := range accounts {
+		account, ok := acc.(map[string]any)
+		if !ok || account["@type"] != ethermintEthAccountTypeURL {
+			continue
+		}
+
+		baseAccount, ok := account["base_account"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("EthAccount at index %d has no base account", i)
+		}
+
+		addr, err := decodeBech32Address(baseAccount["address"])
+		if err != nil {
+			return nil, fmt.Errorf("EthAccount at index %d: %w", i, err)
+		}
+
+		codeHash, _ := account["code_hash"].(string)
+		codeHashes[addr] = common.HexToHash(codeHash)
+
+		baseAccount["@type"] = baseAccountTypeURL
+		accounts[i] = baseAccount
+	}
+
+	bz, err := json.Marshal(authGenesis)
+	if err != nil {
+		return nil, err
+	}
+
+	appState[authtypes.ModuleName] = bz
+	return codeHashes, nil
+}
+
+// migrateEVM converts the evm genesis. The chain config, the
+// allow_unprotected_txs parameter and the enable_create and enable_call
+// parameters replaced by the access control are dropped, the parameters that
+// didn't exist are set to their default value.
+func migrateEVM(cdc codec.JSONCodec, bz json.RawMessage) (*evmtypes.GenesisState, error) {
+	if bz == nil {
+		return nil, fmt.Errorf("%s genesis not found", evmtypes.ModuleName)
+	}
+
+	var legacyGenesis map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &legacyGenesis); err != nil {
+		return nil, err
+	}
+
+	var legacyParams map[string]json.RawMessage
+	if err := json.Unmarshal(legacyGenesis["params"], &legacyParams); err != nil {
+		return nil, err
+	}
+
+	defaultParams := evmtypes.DefaultParams()
+	defaultParams.ExtraEIPs = nil
+	defaultParams.ActiveStaticPrecompiles = nil
+	params, err := mergeFields(cdc, &defaultParams, legacyParams, "evm_denom", "evm_channels", "access_control", "active_static_precompiles")
+	if err != nil {
+		return nil, err
+	}
+
+	// the active precompiles of the Evmos versions before the static ones
+	if _, ok := legacyParams["active_static_precompiles"]; !ok {
+		if activePrecompiles, ok := legacyParams["active_precompiles"]; ok {
+			params["active_static_precompiles"] = activePrecompiles
+		}
+	}
+
+	if _, ok := legacyParams["access_control"]; !ok {
+		accessControl, err := legacyAccessControl(legacyParams)
+		if err != nil {
+			return nil, err
+		}
+		params["access_control"] = cdc.MustMarshalJSON(&accessControl)
+	}
+
+	if extraEIPs, ok := legacyParams["extra_eips"]; ok {
+		eips, err := legacyExtraEIPs(extraEIPs)
+		if err != nil {
+			return nil, err
+		}
+		params["extra_eips"], _ = json.Marshal(eips)
+	}
+
+	state := map[string]json.RawMessage{
+		"params":      mustMarshal(params),
+		"preinstalls": json.RawMessage("[]"),
+	}
+	if accounts, ok := legacyGenesis["accounts"]; ok {
+		state["accounts"] = accounts
+	}
+
+	genesis := evmtypes.DefaultGenesisState()
+	if err := cdc.UnmarshalJSON(mustMarshal(state), genesis); err != nil {
+		return nil, err
+	}
+
+	if err := genesis.Validate(); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// legacyAccessControl returns the access control matching the enable_create
+// and enable_call parameters of the Ethermint versions.
+func legacyAccessControl(legacyParams map[string]json.RawMessage) (evmtypes.AccessControl, error) {
+	accessType := func(field string) (evmtypes.AccessType, error) {
+		bz, ok := legacyParams[field]
+		if !ok {
+			return evmtypes.AccessTypePermissionless, nil
+		}
+
+		var enabled bool
+		if err := json.Unmarshal(bz, &enabled); err != nil {
+			return 0, fmt.Errorf("invalid %s parameter: %w", field, err)
+		}
+
+		if enabled {
+			return evmtypes.AccessTypePermissionless, nil
+		}
+		return evmtypes.AccessTypeRestricted, nil
+	}
+
+	create, err := accessType("enable_create")
+	if err != nil {
+		return evmtypes.AccessControl{}, err
+	}
+
+	call, err := accessType("enable_call")
+	if err != nil {
+		return evmtypes.AccessControl{}, err
+	}
+
+	return evmtypes.AccessControl{
+		Create: evmtypes.AccessControlType{AccessType: create, AccessControlList: []string{}},
+		Call:   evmtypes.AccessControlType{AccessType: call, AccessControlList: []string{}},
+	}, nil
+}
+
+// legacyExtraEIPs parses the extra EIPs of the Ethermint versions, encoded as
+// integers, and of the Evmos versions, encoded as "ethereum_<eip>" strings.
+func legacyExtraEIPs(bz json.RawMessage) ([]string, error) {
+	var values []json.RawMessage
+	if err := json.Unmarshal(bz, &values); err != nil {
+		return nil, fmt.Errorf("invalid extra_eips parameter: %w", err)
+	}
+
+	eips := make([]string, len(values))
+	for i, value := range values {
+		var eip string
+		if err := json.Unmarshal(value, &eip); err != nil {
+			eip = string(value)
+		}
+
+		eip = strings.TrimPrefix(eip, "ethereum_")
+		if _, err := strconv.ParseInt(eip, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid extra EIP %s: %w", value, err)
+		}
+		eips[i] = eip
+	}
+
+	return eips, nil
+}
+
+// migrateFeeMarket converts the feemarket genesis, the parameters that didn't
+// exist are set to their default value.
+func migrateFeeMarket(cdc codec.JSONCodec, bz json.RawMessage) (*feemarkettypes.GenesisState, error) {
+	genesis := feemarkettypes.DefaultGenesisState()
+	if bz == nil {
+		return genesis, nil
+	}
+
+	var legacyGenesis map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &legacyGenesis); err != nil {
+		return nil, err
+	}
+
+	var legacyParams map[string]json.RawMessage
+	if err := json.Unmarshal(legacyGenesis["params"], &legacyParams); err != nil {
+		return nil, err
+	}
+
+	params, err := mergeFields(
+		cdc, &genesis.Params, legacyParams,
+		"no_base_fee", "base_fee_change_denominator", "elasticity_multiplier", "enable_height",
+		"base_fee", "min_gas_price", "min_gas_multiplier",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	state := map[string]json.RawMessage{"params": mustMarshal(params)}
+	if blockGas, ok := legacyGenesis["block_gas"]; ok {
+		state["block_gas"] = blockGas
+	}
+
+	if err := cdc.UnmarshalJSON(mustMarshal(state), genesis); err != nil {
+		return nil, err
+	}
+
+	if err := genesis.Validate(); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// migrateERC20 converts the Evmos erc20 genesis. The enable_evm_hook parameter
+// is dropped and the precompiles parameters are moved to the genesis state.
+func migrateERC20(cdc codec.JSONCodec, bz json.RawMessage) (*erc20types.GenesisState, error) {
+	genesis := erc20types.DefaultGenesisState()
+	if bz == nil {
+		return genesis, nil
+	}
+
+	var legacyGenesis map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &legacyGenesis); err != nil {
+		return nil, err
+	}
+
+	var legacyParams map[string]json.RawMessage
+	if err := json.Unmarshal(legacyGenesis["params"], &legacyParams); err != nil {
+		return nil, err
+	}
+
+	params, err := mergeFields(cdc, &genesis.Params, legacyParams, "enable_erc20", "permissionless_registration")
+	if err != nil {
+		return nil, err
+	}
+
+	state := map[string]json.RawMessage{"params": mustMarshal(params)}
+	for _, field := range []string{"token_pairs", "allowances", "native_precompiles", "dynamic_precompiles"} {
+		if value, ok := legacyGenesis[field]; ok {
+			state[field] = value
+		} else if value, ok := legacyParams[field]; ok {
+			state[field] = value
+		}
+	}
+
+	if err := cdc.UnmarshalJSON(mustMarshal(state), genesis); err != nil {
+		return nil, err
+	}
+
+	if err := genesis.Validate(); err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+
+// validateState checks the invariants between the migrated module states: the
+// EVM accounts have an auth account, the migrated EthAccount accounts code hash
+// matches the code of their EVM account and the external ERC-20 contracts have
+// code.
+func validateState(
+	authState json.RawMessage,
+	codeHashes map[common.Address]common.Hash,
+	evmGenesis *evmtypes.GenesisState,
+	erc20Genesis *erc20types.GenesisState,
+) error {
+	addresses, err := authAddresses(authState)
+	if err != nil {
+		return err
+	}
+
+	codes := make(map[common.Address]string, len(evmGenesis.Accounts))
+	for _, account := range evmGenesis.Accounts {
+		addr := common.HexToAddress(account.Address)
+		if !addresses[addr] {
+			return fmt.Errorf("%s account %s has no %s account", evmtypes.ModuleName, account.Address, authtypes.ModuleName)
+		}
+		codes[addr] = account.Code
+	}
+
+	emptyCodeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	for addr, codeHash := range codeHashes {
+		// accounts without code may have no code hash
+		if codeHash == (common.Hash{}) {
+			codeHash = emptyCodeHash
+		}
+
+		expHash := emptyCodeHash
+		if code := codes[addr]; code != "" {
+			expHash = crypto.Keccak256Hash(common.FromHex(code))
+		}
+
+		if codeHash != expHash {
+			return fmt.Errorf("code hash %s of account %s doesn't match the hash %s of its code", codeHash, addr, expHash)
+		}
+	}
+
+	for _, pair := range erc20Genesis.TokenPairs {
+		if pair.ContractOwner == erc20types.OWNER_EXTERNAL && codes[common.HexToAddress(pair.Erc20Address)] == "" {
+			return fmt.Errorf("ERC-20 contract %s of token pair %s has no code", pair.Erc20Address, pair.Denom)
+		}
+	}
+
+	return nil
+}
+
+// authAddresses returns the addresses of the accounts of the auth genesis,
+// regardless of their bech32 prefix.
+func authAddresses(authState json.RawMessage) (map[common.Address]bool, error) {
+	var authGenesis struct {
+		Accounts []map[string]json.RawMessage `json:"accounts"`
+	}
+	if err := json.Unmarshal(authState, &authGenesis); err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[common.Address]bool, len(authGenesis.Accounts))
+	for i, account := range authGenesis.Accounts {
+		// vesting and module accounts embed their base account
+		for _, field := range []string{"base_vesting_account", "base_account"} {
+			if embedded, ok := account[field]; ok {
+				account = nil
+				if err := json.Unmarshal(embedded, &account); err != nil {
+					return nil, err
+				}
+				if nested, ok := account["base_account"]; ok {
+					if err := json.Unmarshal(nested, &account); err != nil {
+						return nil, err
+					}
+				}
+				break
+			}
+		}
+
+		var address string
+		if err := json.Unmarshal(account["address"], &address); err != nil {
+			return nil, fmt.Errorf("account at index %d has no address", i)
+		}
+
+		addr, err := decodeBech32Address(address)
+		if err != nil {
+			return nil, fmt.Errorf("account at index %d: %w", i, err)
+		}
+		addresses[addr] = true
+	}
+
+	return addresses, nil
+}
+
+// mergeFields returns the JSON fields of the given parameters, with the given
+// fields replaced by the legacy ones.
+func mergeFields(cdc codec.JSONCodec, params proto.Message, legacyParams map[string]json.RawMessage, fields ...string) (map[string]json.RawMessage, error) {
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(cdc.MustMarshalJSON(params), &merged); err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if value, ok := legacyParams[field]; ok {
+			merged[field] = value
+		}
+	}
+
+	return merged, nil
+}
+
+func decodeBech32Address(address any) (common.Address, error) {
+	bech32Addr, _ := address.(string)
+	_, bz, err := bech32.DecodeAndConvert(bech32Addr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid address %q: %w", bech32Addr, err)
+	}
+	return common.BytesToAddress(bz), nil
+}
+
+func unmarshalJSON(bz []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func mustMarshal(v any) json.RawMessage {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -0,0 +1,242 @@
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/encoding"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+)
+
+const (
+	contractCode     = "0x608060405260043610"
+	nativePrecompile = "0xD4949664cD82660AaE99bEdc034a0deA8A0bd517"
+)
+
+var (
+	eoaAddr      = common.HexToAddress("0x00000Be6819f41400225702D32d3dd23663Dd690")
+	contractAddr = common.HexToAddress("0x1D54EcB8583Ca25895c512A8308389fFD581F9c9")
+)
+
+func newTestClientCtx() client.Context {
+	encodingConfig := encoding.MakeConfig(9001)
+	authtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return client.Context{}.WithCodec(encodingConfig.Codec)
+}
+
+// authGenesis returns an auth genesis with an EOA and a contract EthAccount.
+func authGenesis(codeHash string) json.RawMessage {
+	return json.RawMessage(fmt.Sprintf(`{
+	"params": {"max_memo_characters": "256", "tx_sig_limit": "7", "tx_size_cost_per_byte": "10", "sig_verify_cost_ed25519": "590", "sig_verify_cost_secp256k1": "1000"},
+	"accounts": [
+		{
+			"@type": "/ethermint.types.v1.EthAccount",
+			"base_account": {
+				"address": "%s",
+				"pub_key": {"@type": "/ethermint.crypto.v1.ethsecp256k1.PubKey", "key": "AoNXv+T4b1jfjfvXwqW+7gYDWBDXu0rRSVRxSnxCbDcH"},
+				"account_number": "0",
+				"sequence": "1"
+			},
+			"code_hash": "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"
+		},
+		{
+			"@type": "/ethermint.types.v1.EthAccount",
+			"base_account": {"address": "%s", "pub_key": null, "account_number": "1", "sequence": "1"},
+			"code_hash": "%s"
+		},
+		{
+			"@type": "/cosmos.auth.v1beta1.ModuleAccount",
+			"base_account": {"address": "%s", "pub_key": null, "account_number": "2", "sequence": "0"},
+			"name": "fee_collector",
+			"permissions": []
+		}
+	]
+}`, sdk.AccAddress(eoaAddr.Bytes()), sdk.AccAddress(contractAddr.Bytes()), codeHash, authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+}
+
+func ethermintAppState() genutiltypes.AppMap {
+	return genutiltypes.AppMap{
+		authtypes.ModuleName: authGenesis(crypto.Keccak256Hash(common.FromHex(contractCode)).Hex()),
+		evmtypes.ModuleName: json.RawMessage(fmt.Sprintf(`{
+	"accounts": [{"address": "%s", "code": "%s", "storage": [{"key": "0x0000000000000000000000000000000000000000000000000000000000000001", "value": "0x0000000000000000000000000000000000000000000000000000000000000002"}]}],
+	"params": {
+		"evm_denom": "aphoton",
+		"enable_create": false,
+		"enable_call": true,
+		"extra_eips": ["3855"],
+		"chain_config": {"homestead_block": "0", "london_block": "0"},
+		"allow_unprotected_txs": false
+	}
+}`, contractAddr.Hex(), contractCode)),
+		feemarkettypes.ModuleName: json.RawMessage(`{
+	"params": {
+		"no_base_fee": false,
+		"base_fee_change_denominator": 8,
+		"elasticity_multiplier": 2,
+		"enable_height": "0",
+		"base_fee": "875000000",
+		"min_gas_price": "0.000000000000000000",
+		"min_gas_multiplier": "0.500000000000000000"
+	},
+	"block_gas": "21000"
+}`),
+	}
+}
+
+func evmosAppState() genutiltypes.AppMap {
+	appState := ethermintAppState()
+	appState[evmtypes.ModuleName] = json.RawMessage(fmt.Sprintf(`{
+	"accounts": [{"address": "%s", "code": "%s", "storage": []}],
+	"params": {
+		"evm_denom": "aevmos",
+		"extra_eips": ["ethereum_3855"],
+		"allow_unprotected_txs": false,
+		"evm_channels": ["channel-0"],
+		"access_control": {
+			"create": {"access_type": "ACCESS_TYPE_PERMISSIONED", "access_control_list": ["%s"]},
+			"call": {"access_type": "ACCESS_TYPE_PERMISSIONLESS", "access_control_list": []}
+		},
+		"active_static_precompiles": ["0x0000000000000000000000000000000000000800"]
+	}
+}`, contractAddr.Hex(), contractCode, eoaAddr.Hex()))
+	appState[erc20types.ModuleName] = json.RawMessage(fmt.Sprintf(`{
+	"params": {
+		"enable_erc20": true,
+		"enable_evm_hook": true,
+		"native_precompiles": ["%s"],
+		"dynamic_precompiles": []
+	},
+	"token_pairs": [
+		{"erc20_address": "%s", "denom": "aevmos", "enabled": true, "contract_owner": "OWNER_MODULE"},
+		{"erc20_address": "%s", "denom": "erc20/%s", "enabled": true, "contract_owner": "OWNER_EXTERNAL"}
+	]
+}`, nativePrecompile, nativePrecompile, contractAddr.Hex(), contractAddr.Hex()))
+	return appState
+}
+
+func TestMigrate(t *testing.T) {
+	clientCtx := newTestClientCtx()
+	cdc := clientCtx.Codec
+
+	t.Run("Ethermint genesis", func(t *testing.T) {
+		appState, err := Migrate(ethermintAppState(), clientCtx)
+		require.NoError(t, err)
+
+		var authGenesis authtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[authtypes.ModuleName], &authGenesis)
+		accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+		require.NoError(t, err)
+		require.Len(t, accounts, 3)
+		require.IsType(t, &authtypes.BaseAccount{}, accounts[0])
+		require.Equal(t, "/cosmos.evm.crypto.v1.ethsecp256k1.PubKey", authGenesis.Accounts[0].GetCachedValue().(*authtypes.BaseAccount).PubKey.TypeUrl)
+		require.IsType(t, &authtypes.BaseAccount{}, accounts[1])
+		require.IsType(t, &authtypes.ModuleAccount{}, accounts[2])
+
+		var evmGenesis evmtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[evmtypes.ModuleName], &evmGenesis)
+		require.Len(t, evmGenesis.Accounts, 1)
+		require.Len(t, evmGenesis.Accounts[0].Storage, 1)
+		require.Equal(t, "aphoton", evmGenesis.Params.EvmDenom)
+		require.Equal(t, []int64{3855}, evmGenesis.Params.ExtraEIPs)
+		require.Equal(t, evmtypes.AccessTypeRestricted, evmGenesis.Params.AccessControl.Create.AccessType)
+		require.Equal(t, evmtypes.AccessTypePermissionless, evmGenesis.Params.AccessControl.Call.AccessType)
+		require.Empty(t, evmGenesis.Params.ActiveStaticPrecompiles)
+		require.Equal(t, evmtypes.DefaultParams().HistoryServeWindow, evmGenesis.Params.HistoryServeWindow)
+
+		var feeMarketGenesis feemarkettypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[feemarkettypes.ModuleName], &feeMarketGenesis)
+		require.Equal(t, math.LegacyNewDec(875_000_000), feeMarketGenesis.Params.BaseFee)
+		require.Equal(t, uint64(21_000), feeMarketGenesis.BlockGas)
+
+		var erc20Genesis erc20types.GenesisState
+		cdc.MustUnmarshalJSON(appState[erc20types.ModuleName], &erc20Genesis)
+		require.Equal(t, erc20types.DefaultParams(), erc20Genesis.Params)
+		require.Empty(t, erc20Genesis.TokenPairs)
+	})
+
+	t.Run("Evmos genesis", func(t *testing.T) {
+		appState, err := Migrate(evmosAppState(), clientCtx)
+		require.NoError(t, err)
+
+		var evmGenesis evmtypes.GenesisState
+		cdc.MustUnmarshalJSON(appState[evmtypes.ModuleName], &evmGenesis)
+		require.Equal(t, "aevmos", evmGenesis.Params.EvmDenom)
+		require.Equal(t, []int64{3855}, evmGenesis.Params.ExtraEIPs)
+		require.Equal(t, []string{"channel-0"}, evmGenesis.Params.EVMChannels)
+		require.Equal(t, evmtypes.AccessTypePermissioned, evmGenesis.Params.AccessControl.Create.AccessType)
+		require.Equal(t, []string{eoaAddr.Hex()}, evmGenesis.Params.AccessControl.Create.AccessControlList)
+		require.Equal(t, []string{"0x0000000000000000000000000000000000000800"}, evmGenesis.Params.ActiveStaticPrecompiles)
+
+		var erc20Genesis erc20types.GenesisState
+		cdc.MustUnmarshalJSON(appState[erc20types.ModuleName], &erc20Genesis)
+		require.True(t, erc20Genesis.Params.EnableErc20)
+		require.Len(t, erc20Genesis.TokenPairs, 2)
+		require.Equal(t, []string{nativePrecompile}, erc20Genesis.NativePrecompiles)
+	})
+
+	testCases := []struct {
+		name     string
+		malleate func(appState genutiltypes.AppMap)
+		errMsg   string
+	}{
+		{
+			"code hash mismatch",
+			func(appState genutiltypes.AppMap) {
+				appState[authtypes.ModuleName] = authGenesis(common.Hash{1}.Hex())
+			},
+			"doesn't match the hash",
+		},
+		{
+			"evm account without auth account",
+			func(appState genutiltypes.AppMap) {
+				appState[evmtypes.ModuleName] = json.RawMessage(`{"accounts": [{"address": "0x000000000000000000000000000000000000dEaD", "code": "0x60", "storage": []}], "params": {}}`)
+			},
+			"has no auth account",
+		},
+		{
+			"external token pair without code",
+			func(appState genutiltypes.AppMap) {
+				appState[erc20types.ModuleName] = json.RawMessage(`{"params": {}, "token_pairs": [{"erc20_address": "0x000000000000000000000000000000000000dEaD", "denom": "erc20/0x000000000000000000000000000000000000dEaD", "enabled": true, "contract_owner": "OWNER_EXTERNAL"}]}`)
+			},
+			"has no code",
+		},
+		{
+			"invalid extra EIP",
+			func(appState genutiltypes.AppMap) {
+				appState[evmtypes.ModuleName] = json.RawMessage(`{"accounts": [], "params": {"extra_eips": ["evmos_0"]}}`)
+			},
+			"invalid extra EIP",
+		},
+		{
+			"missing evm genesis",
+			func(appState genutiltypes.AppMap) {
+				delete(appState, evmtypes.ModuleName)
+			},
+			"evm genesis not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appState := evmosAppState()
+			tc.malleate(appState)
+
+			_, err := Migrate(appState, clientCtx)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package client
 
 import (
 	"bufio"
+	"fmt"
+	"strings"
 
-	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/evm/crypto/ethsecp256k1"
@@ -19,7 +21,7 @@ func UnsafeImportKeyCommand() *cobra.Command {
 	return &cobra.Command{
 		Use:   "unsafe-import-eth-key <name> <pk>",
 		Short: "**UNSAFE** Import Ethereum private keys into the local keybase",
-		Long:  "**UNSAFE** Import a hex-encoded Ethereum private key into the local keybase.",
+		Long:  "**UNSAFE** Import a hex-encoded Ethereum private key, with or without the 0x prefix, into the local keybase.",
 		Args:  cobra.ExactArgs(2),
 		RunE:  runImportCmd,
 	}
@@ -32,6 +34,12 @@ func runImportCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// verify that the private key is a valid secp256k1 key
+	key, err := ethcrypto.HexToECDSA(strings.TrimPrefix(args[1], "0x"))
+	if err != nil {
+		return fmt.Errorf("invalid private key: %w", err)
+	}
+
 	inBuf := bufio.NewReader(cmd.InOrStdin())
 	passphrase, err := input.GetPassword("Enter passphrase to encrypt your key:", inBuf)
 	if err != nil {
@@ -39,10 +47,10 @@ func runImportCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	privKey := &ethsecp256k1.PrivKey{
-		Key: common.FromHex(args[1]),
+		Key: ethcrypto.FromECDSA(key),
 	}
 
-	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, "eth_secp256k1")
+	armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
 
 	return clientCtx.Keyring.ImportPrivKey(args[0], armor, passphrase)
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bufio"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -9,6 +10,7 @@ import (
 
 	clientkeys "github.com/cosmos/evm/client/keys"
 	"github.com/cosmos/evm/crypto/hd"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -16,6 +18,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
+const flagCoinType = "coin-type"
+
 // KeyCommands registers a subtree of commands to interact with
 // local private key storage.
 //
@@ -62,6 +66,15 @@ The pass backend requires GnuPG: https://gnupg.org/
 		if err != nil {
 			panic(err)
 		}
+
+		// update the default coin type to the Ethereum one, for keys to be
+		// derived from the Ethereum BIP44 path
+		coinTypeStr := strconv.FormatUint(uint64(cosmosevmtypes.Bip44CoinType), 10)
+		coinTypeFlag := addCmd.Flag(flagCoinType)
+		coinTypeFlag.DefValue = coinTypeStr
+		if err := coinTypeFlag.Value.Set(coinTypeStr); err != nil {
+			panic(err)
+		}
 	}
 
 	addCmd.RunE = runAddCmd
@@ -71,9 +84,9 @@ The pass backend requires GnuPG: https://gnupg.org/
 		addCmd,
 		keys.ExportKeyCommand(),
 		keys.ImportKeyCommand(),
-		keys.ListKeysCmd(),
+		clientkeys.ListKeysCmd(),
 		keys.ListKeyTypesCmd(),
-		keys.ShowKeysCmd(),
+		clientkeys.ShowKeysCmd(),
 		keys.DeleteKeyCommand(),
 		keys.RenameKeyCommand(),
 		keys.ParseKeyStringCommand(),
@@ -81,6 +94,9 @@ The pass backend requires GnuPG: https://gnupg.org/
 		flags.LineBreak,
 		UnsafeExportEthKeyCommand(),
 		UnsafeImportKeyCommand(),
+		ImportEthKeystoreCommand(),
+		ExportEthKeystoreCommand(),
+		SignEthMessageCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
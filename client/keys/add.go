@@ -275,7 +275,7 @@ func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemo
 	switch outputFormat {
 	case OutputFormatText:
 		cmd.PrintErrln()
-		if err := printKeyringRecord(cmd.OutOrStdout(), k, keys.MkAccKeyOutput, outputFormat); err != nil {
+		if err := printKeyringRecord(cmd.OutOrStdout(), k, MkEthKeyOutput, outputFormat); err != nil {
 			return err
 		}
 
@@ -288,7 +288,7 @@ func printCreate(cmd *cobra.Command, k *keyring.Record, showMnemonic bool, mnemo
 			}
 		}
 	case OutputFormatJSON:
-		out, err := keys.MkAccKeyOutput(k)
+		out, err := MkEthKeyOutput(k)
 		if err != nil {
 			return err
 		}
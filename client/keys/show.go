@@ -0,0 +1,109 @@
+package keys
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/hd"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagListNames = "list-names"
+
+// ShowKeysCmd shows key information for a given key name, bech32 or 0x
+// address, with the EIP-55 hex address of the key alongside its bech32 address.
+// The multisig keys and the address, public key and device only outputs are
+// delegated to the Cosmos SDK command.
+func ShowKeysCmd() *cobra.Command {
+	cmd := keys.ShowKeysCmd()
+	sdkRunE := cmd.RunE
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		bechPrefix, _ := cmd.Flags().GetString(keys.FlagBechPrefix)
+		showAddress, _ := cmd.Flags().GetBool(keys.FlagAddress)
+		showPubKey, _ := cmd.Flags().GetBool(keys.FlagPublicKey)
+		showDevice, _ := cmd.Flags().GetBool(keys.FlagDevice)
+		if len(args) != 1 || bechPrefix != sdk.PrefixAccount || showAddress || showPubKey || showDevice {
+			return sdkRunE(cmd, args)
+		}
+
+		clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+		clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		k, err := fetchKey(clientCtx.Keyring, args[0])
+		if err != nil {
+			return fmt.Errorf("%s is not a valid name or address: %w", args[0], err)
+		}
+
+		return printKeyringRecord(cmd.OutOrStdout(), k, MkEthKeyOutput, clientCtx.OutputFormat)
+	}
+
+	return cmd
+}
+
+// ListKeysCmd lists all keys in the key store, with the EIP-55 hex address of
+// the keys alongside their bech32 address.
+func ListKeysCmd() *cobra.Command {
+	cmd := keys.ListKeysCmd()
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+		clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		records, err := clientCtx.Keyring.List()
+		if err != nil {
+			return err
+		}
+
+		if len(records) == 0 && clientCtx.OutputFormat == OutputFormatText {
+			cmd.Println("No records were found in keyring")
+			return nil
+		}
+
+		if ok, _ := cmd.Flags().GetBool(flagListNames); !ok {
+			return printKeyringRecords(cmd.OutOrStdout(), records, clientCtx.OutputFormat)
+		}
+
+		for _, k := range records {
+			cmd.Println(k.Name)
+		}
+
+		return nil
+	}
+
+	return cmd
+}
+
+// fetchKey returns the keyring record of the given key name, bech32 or 0x
+// address.
+func fetchKey(kb keyring.Keyring, keyref string) (*keyring.Record, error) {
+	k, err := kb.Key(keyref)
+	if err == nil {
+		return k, nil
+	}
+
+	var addr sdk.AccAddress
+	if strings.HasPrefix(keyref, "0x") && common.IsHexAddress(keyref) {
+		addr = common.HexToAddress(keyref).Bytes()
+	} else {
+		addr, err = sdk.AccAddressFromBech32(keyref)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return kb.KeyByAddress(addr)
+}
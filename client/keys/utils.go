@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/ethereum/go-ethereum/common"
 	"sigs.k8s.io/yaml"
 
 	"github.com/cosmos/cosmos-sdk/client/keys"
@@ -17,7 +18,33 @@ const (
 	OutputFormatJSON = "json"
 )
 
-type bechKeyOutFn func(k *cryptokeyring.Record) (keys.KeyOutput, error)
+type bechKeyOutFn func(k *cryptokeyring.Record) (EthKeyOutput, error)
+
+// EthKeyOutput defines the key output with the EIP-55 hex address of the key
+// alongside its bech32 address.
+type EthKeyOutput struct {
+	keys.KeyOutput
+	EthAddress string `json:"eth_address" yaml:"eth_address"`
+}
+
+// MkEthKeyOutput creates the key output of a keyring record, with its bech32
+// account address and its EIP-55 hex address.
+func MkEthKeyOutput(k *cryptokeyring.Record) (EthKeyOutput, error) {
+	ko, err := keys.MkAccKeyOutput(k)
+	if err != nil {
+		return EthKeyOutput{}, err
+	}
+
+	addr, err := k.GetAddress()
+	if err != nil {
+		return EthKeyOutput{}, err
+	}
+
+	return EthKeyOutput{
+		KeyOutput:  ko,
+		EthAddress: common.BytesToAddress(addr).Hex(),
+	}, nil
+}
 
 func printKeyringRecord(w io.Writer, k *cryptokeyring.Record, bechKeyOut bechKeyOutFn, output string) error {
 	ko, err := bechKeyOut(k)
@@ -27,7 +54,7 @@ func printKeyringRecord(w io.Writer, k *cryptokeyring.Record, bechKeyOut bechKey
 
 	switch output {
 	case OutputFormatText:
-		if err := printTextRecords(w, []keys.KeyOutput{ko}); err != nil {
+		if err := printTextRecords(w, []EthKeyOutput{ko}); err != nil {
 			return err
 		}
 
@@ -45,7 +72,37 @@ func printKeyringRecord(w io.Writer, k *cryptokeyring.Record, bechKeyOut bechKey
 	return nil
 }
 
-func printTextRecords(w io.Writer, kos []keys.KeyOutput) error {
+func printKeyringRecords(w io.Writer, records []*cryptokeyring.Record, output string) error {
+	kos := make([]EthKeyOutput, len(records))
+	for i, k := range records {
+		ko, err := MkEthKeyOutput(k)
+		if err != nil {
+			return err
+		}
+		kos[i] = ko
+	}
+
+	switch output {
+	case OutputFormatText:
+		if err := printTextRecords(w, kos); err != nil {
+			return err
+		}
+
+	case OutputFormatJSON:
+		out, err := json.Marshal(kos)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintln(w, string(out)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func printTextRecords(w io.Writer, kos []EthKeyOutput) error {
 	out, err := yaml.Marshal(&kos)
 	if err != nil {
 		return err
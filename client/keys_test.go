@@ -0,0 +1,145 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	clientkeys "github.com/cosmos/evm/client/keys"
+	"github.com/cosmos/evm/crypto/hd"
+	"github.com/cosmos/evm/encoding"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const testPrivKey = "0xe1d5df48a0d9f5e0ec9d11be8cd4e5ac9d2f3a9e4b6f3d5e37b3a6a0c07ed1f2"
+
+func newTestClientCtx(t *testing.T) client.Context {
+	t.Helper()
+
+	encodingConfig := encoding.MakeConfig(9001)
+	kr := keyring.NewInMemory(encodingConfig.Codec, hd.EthSecp256k1Option())
+
+	return client.Context{}.WithKeyring(kr).WithCodec(encodingConfig.Codec)
+}
+
+// execCmd executes the command with the given input, as the keyring commands
+// read the passwords from the command input.
+func execCmd(clientCtx client.Context, cmd *cobra.Command, in string, args []string) (*bytes.Buffer, error) {
+	out := new(bytes.Buffer)
+	cmd.SetArgs(args)
+	cmd.SetIn(strings.NewReader(in))
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	return out, cmd.ExecuteContext(ctx)
+}
+
+func TestEthKeyCommands(t *testing.T) {
+	clientCtx := newTestClientCtx(t)
+	key, err := ethcrypto.HexToECDSA(testPrivKey[2:])
+	require.NoError(t, err)
+	address := ethcrypto.PubkeyToAddress(key.PublicKey)
+
+	// invalid raw private key
+	_, err = execCmd(clientCtx, UnsafeImportKeyCommand(), "password\n", []string{"invalid", "0x1234"})
+	require.Error(t, err)
+
+	// raw private key with 0x prefix
+	_, err = execCmd(clientCtx, UnsafeImportKeyCommand(), "password\n", []string{"raw", testPrivKey})
+	require.NoError(t, err)
+
+	record, err := clientCtx.Keyring.Key("raw")
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, common.BytesToAddress(addr))
+
+	// EIP-55 address alongside the bech32 one, the key can be shown by 0x address
+	out, err := execCmd(clientCtx.WithOutputFormat(clientkeys.OutputFormatJSON), clientkeys.ShowKeysCmd(), "", []string{address.Hex()})
+	require.NoError(t, err)
+	var ko clientkeys.EthKeyOutput
+	require.NoError(t, json.Unmarshal(out.Bytes(), &ko))
+	require.Equal(t, "raw", ko.Name)
+	require.Equal(t, sdk.AccAddress(address.Bytes()).String(), ko.Address)
+	require.Equal(t, address.Hex(), ko.EthAddress)
+
+	// keystore export and import
+	keyFile := filepath.Join(t.TempDir(), "keystore.json")
+	_, err = execCmd(clientCtx, ExportEthKeystoreCommand(), "keystore\n", []string{"raw", keyFile})
+	require.NoError(t, err)
+
+	_, err = execCmd(clientCtx, ExportEthKeystoreCommand(), "keystore\n", []string{"raw", keyFile})
+	require.ErrorContains(t, err, "already exists")
+
+	_, err = execCmd(clientCtx, ImportEthKeystoreCommand(), "wrong-password\npassword\n", []string{"keystore", keyFile})
+	require.ErrorContains(t, err, "failed to decrypt keystore file")
+
+	_, err = execCmd(clientCtx, ImportEthKeystoreCommand(), "keystore\npassword\n", []string{"keystore", keyFile})
+	require.NoError(t, err)
+
+	record, err = clientCtx.Keyring.Key("keystore")
+	require.NoError(t, err)
+	addr, err = record.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, address, common.BytesToAddress(addr))
+
+	// eth_sign compatible message signing
+	testCases := []struct {
+		name string
+		args []string
+		msg  []byte
+	}{
+		{"text message", []string{"raw", "hello world"}, []byte("hello world")},
+		{"hex message", []string{"raw", "0x68656c6c6f", "--hex"}, []byte("hello")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := execCmd(clientCtx, SignEthMessageCommand(), "", tc.args)
+			require.NoError(t, err)
+
+			sig, err := hexutil.Decode(strings.TrimSpace(out.String()))
+			require.NoError(t, err)
+			require.Len(t, sig, ethcrypto.SignatureLength)
+			require.Contains(t, []byte{27, 28}, sig[ethcrypto.RecoveryIDOffset])
+
+			sig[ethcrypto.RecoveryIDOffset] -= 27
+			pubKey, err := ethcrypto.SigToPub(accounts.TextHash(tc.msg), sig)
+			require.NoError(t, err)
+			require.Equal(t, address, ethcrypto.PubkeyToAddress(*pubKey))
+		})
+	}
+}
+
+func TestExportedKeystoreFile(t *testing.T) {
+	clientCtx := newTestClientCtx(t)
+
+	_, err := execCmd(clientCtx, UnsafeImportKeyCommand(), "password\n", []string{"raw", testPrivKey[2:]})
+	require.NoError(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "keystore.json")
+	_, err = execCmd(clientCtx, ExportEthKeystoreCommand(), "keystore\n", []string{"raw", keyFile})
+	require.NoError(t, err)
+
+	keyJSON, err := os.ReadFile(keyFile)
+	require.NoError(t, err)
+	key, err := keystore.DecryptKey(keyJSON, "keystore")
+	require.NoError(t, err)
+	require.Equal(t, testPrivKey, hexutil.Encode(ethcrypto.FromECDSA(key.PrivateKey)))
+}
@@ -0,0 +1,149 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/hd"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+// ImportEthKeystoreCommand imports a key from a geth keystore V3 JSON file.
+func ImportEthKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import-eth-keystore <name> <keyfile>",
+		Short: "Import an Ethereum private key from a keystore file",
+		Long:  "Import an Ethereum private key from a geth keystore V3 JSON file into the local keybase.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			decryptPassword, err := input.GetPassword("Enter password to decrypt the keystore file:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			key, err := keystore.DecryptKey(keyJSON, decryptPassword)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt keystore file: %w", err)
+			}
+
+			passphrase, err := input.GetPassword("Enter passphrase to encrypt your key:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			privKey := &ethsecp256k1.PrivKey{
+				Key: ethcrypto.FromECDSA(key.PrivateKey),
+			}
+
+			armor := crypto.EncryptArmorPrivKey(privKey, passphrase, ethsecp256k1.KeyType)
+
+			return clientCtx.Keyring.ImportPrivKey(args[0], armor, passphrase)
+		},
+	}
+}
+
+// ExportEthKeystoreCommand exports a key with the given name to a geth keystore
+// V3 JSON file.
+func ExportEthKeystoreCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export-eth-keystore <name> <keyfile>",
+		Short: "Export an Ethereum private key to a keystore file",
+		Long:  "Export an Ethereum private key to a geth keystore V3 JSON file, encrypted with the given password.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if _, err := os.Stat(args[1]); err == nil {
+				return fmt.Errorf("keystore file %s already exists", args[1])
+			}
+
+			decryptPassword := ""
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			if clientCtx.Keyring.Backend() == keyring.BackendFile {
+				decryptPassword, err = input.GetPassword("Enter key password:", inBuf)
+				if err != nil {
+					return err
+				}
+			}
+
+			armor, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], decryptPassword)
+			if err != nil {
+				return err
+			}
+
+			privKey, algo, err := crypto.UnarmorDecryptPrivKey(armor, decryptPassword)
+			if err != nil {
+				return err
+			}
+
+			if algo != ethsecp256k1.KeyType {
+				return fmt.Errorf("invalid key algorithm, got %s, expected %s", algo, ethsecp256k1.KeyType)
+			}
+
+			ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+			if !ok {
+				return fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+			}
+
+			ecdsaKey, err := ethPrivKey.ToECDSA()
+			if err != nil {
+				return err
+			}
+
+			encryptPassword, err := input.GetPassword("Enter password to encrypt the keystore file:", inBuf)
+			if err != nil {
+				return err
+			}
+
+			id, err := uuid.NewRandom()
+			if err != nil {
+				return err
+			}
+
+			key := &keystore.Key{
+				Id:         id,
+				Address:    ethcrypto.PubkeyToAddress(ecdsaKey.PublicKey),
+				PrivateKey: ecdsaKey,
+			}
+
+			keyJSON, err := keystore.EncryptKey(key, encryptPassword, keystore.StandardScryptN, keystore.StandardScryptP)
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(args[1], keyJSON, 0o600); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("Exported key %s to %s\n", key.Address.Hex(), args[1])
+			return nil
+		},
+	}
+}
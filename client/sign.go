@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/crypto/hd"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const flagHex = "hex"
+
+// SignEthMessageCommand signs a message with the key of the given name, as the
+// eth_sign and personal_sign JSON-RPC methods do.
+func SignEthMessageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-eth-message <name> <message>",
+		Short: "Sign a message with an Ethereum key",
+		Long: `Sign a message with an Ethereum key, as the eth_sign and personal_sign JSON-RPC methods do.
+The signature is computed over keccak256("\x19Ethereum Signed Message:\n" + len(message) + message)
+and its V value is 27 or 28.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd).WithKeyringOptions(hd.EthSecp256k1Option())
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := []byte(args[1])
+			if isHex, _ := cmd.Flags().GetBool(flagHex); isHex {
+				msg, err = hexutil.Decode(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			signature, _, err := clientCtx.Keyring.Sign(args[0], accounts.TextHash(msg), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+			if err != nil {
+				return err
+			}
+
+			if len(signature) != ethcrypto.SignatureLength {
+				return fmt.Errorf("key %s is not an Ethereum key, expected a %d bytes signature, got %d", args[0], ethcrypto.SignatureLength, len(signature))
+			}
+
+			signature[ethcrypto.RecoveryIDOffset] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+			cmd.Println(hexutil.Encode(signature))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagHex, false, "Decode the message from a 0x prefixed hex string")
+	return cmd
+}
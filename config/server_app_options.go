@@ -4,10 +4,14 @@ import (
 	"math"
 	"path/filepath"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	srvflags "github.com/cosmos/evm/server/flags"
+	"github.com/cosmos/evm/x/vm/store/querycache"
 
 	"cosmossdk.io/log"
 
@@ -92,3 +96,110 @@ func GetMinTip(appOpts servertypes.AppOptions, logger log.Logger) *uint256.Int {
 	logger.Error("invalid min tip value in app.toml or flag, falling back to nil", "min_tip", minTipUint64)
 	return nil
 }
+
+// GetLegacyPoolConfig reads the EVM mempool configuration from the app options,
+// set from app.toml or cli flags, on top of the default legacy pool configuration.
+func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *legacypool.Config {
+	legacyPoolConfig := legacypool.DefaultConfig
+
+	if priceBump := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceBump)); priceBump > 0 {
+		legacyPoolConfig.PriceBump = priceBump
+	} else {
+		logger.Debug("mempool price bump not set in app.toml or flag, using default", "price_bump", legacyPoolConfig.PriceBump)
+	}
+
+	// limits left unset fall back to the default legacy pool configuration
+	if accountSlots := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountSlots)); accountSlots > 0 {
+		legacyPoolConfig.AccountSlots = accountSlots
+	}
+	if globalSlots := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalSlots)); globalSlots > 0 {
+		legacyPoolConfig.GlobalSlots = globalSlots
+	}
+	if accountQueue := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolAccountQueue)); accountQueue > 0 {
+		legacyPoolConfig.AccountQueue = accountQueue
+	}
+	if globalQueue := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolGlobalQueue)); globalQueue > 0 {
+		legacyPoolConfig.GlobalQueue = globalQueue
+	}
+	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolLifetime)); lifetime > 0 {
+		legacyPoolConfig.Lifetime = lifetime
+	}
+
+	// the minimum gas price for acceptance is disabled unless explicitly set
+	legacyPoolConfig.PriceLimit = cast.ToUint64(appOpts.Get(srvflags.EVMMempoolPriceLimit))
+
+	legacyPoolConfig.Journal = ""
+	if journal := cast.ToString(appOpts.Get(srvflags.EVMMempoolJournal)); journal != "" {
+		homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+		switch {
+		case filepath.IsAbs(journal):
+			legacyPoolConfig.Journal = journal
+		case homeDir != "":
+			legacyPoolConfig.Journal = filepath.Join(homeDir, "data", journal)
+		default:
+			logger.Error("home directory not found in app options, disabling the mempool journal")
+		}
+	}
+
+	if rejournal := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolRejournal)); rejournal > 0 {
+		legacyPoolConfig.Rejournal = rejournal
+	}
+
+	return &legacyPoolConfig
+}
+
+// GetSenderGasQuotaConfig reads the per-sender block gas quota of the EVM mempool
+// from the app options, set from app.toml or cli flags. It returns nil if the
+// quota is disabled.
+func GetSenderGasQuotaConfig(appOpts servertypes.AppOptions, logger log.Logger) *evmmempool.SenderGasQuotaConfig {
+	percent := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolSenderGasQuota))
+	if percent == 0 {
+		return nil
+	}
+	if percent > 100 {
+		logger.Error("invalid mempool sender gas quota in app.toml or flag, disabling it", "sender_gas_quota", percent)
+		return nil
+	}
+
+	config := &evmmempool.SenderGasQuotaConfig{Percent: percent}
+	for _, addr := range cast.ToStringSlice(appOpts.Get(srvflags.EVMMempoolSenderGasQuotaAllowlist)) {
+		if !common.IsHexAddress(addr) {
+			logger.Error("invalid mempool sender gas quota allowlist address, skipping it", "address", addr)
+			continue
+		}
+		config.Allowlist = append(config.Allowlist, common.HexToAddress(addr))
+	}
+	return config
+}
+
+// GetStreamingFile returns the file the committed Ethereum-formatted blocks are
+// streamed to, resolved against the node data directory. Empty if disabled.
+func GetStreamingFile(appOpts servertypes.AppOptions, logger log.Logger) string {
+	file := cast.ToString(appOpts.Get(srvflags.EVMStreamingFile))
+	if file == "" || filepath.IsAbs(file) {
+		return file
+	}
+
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	if homeDir == "" {
+		logger.Error("home directory not found in app options, disabling the EVM block streaming")
+		return ""
+	}
+	return filepath.Join(homeDir, "data", file)
+}
+
+// GetParallelExecutionWorkers returns the number of workers executing the
+// transactions of the finalized blocks concurrently, 0 if disabled.
+func GetParallelExecutionWorkers(appOpts servertypes.AppOptions) int {
+	return cast.ToInt(appOpts.Get(srvflags.EVMParallelExecutionWorkers))
+}
+
+// GetQueryCacheConfig returns the sizes of the read-through caches of the
+// queries against the committed state.
+func GetQueryCacheConfig(appOpts servertypes.AppOptions) querycache.Config {
+	return querycache.Config{
+		CodeSize:    cast.ToInt(appOpts.Get(srvflags.EVMQueryCacheCodeSize)),
+		AccountSize: cast.ToInt(appOpts.Get(srvflags.EVMQueryCacheAccountSize)),
+		StorageSize: cast.ToInt(appOpts.Get(srvflags.EVMQueryCacheStorageSize)),
+	}
+}
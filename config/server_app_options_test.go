@@ -7,9 +7,16 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
+	evmmempool "github.com/cosmos/evm/mempool"
+	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	srvflags "github.com/cosmos/evm/server/flags"
+	"github.com/cosmos/evm/x/vm/store/querycache"
+
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 
@@ -230,6 +237,142 @@ func TestGetMinGasPrices(t *testing.T) {
 	}
 }
 
+func TestGetLegacyPoolConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		setupFn  func() servertypes.AppOptions
+		expected func() legacypool.Config
+	}{
+		{
+			name:    "missing options fall back to the defaults",
+			setupFn: func() servertypes.AppOptions { return newMockAppOptions() },
+			expected: func() legacypool.Config {
+				cfg := legacypool.DefaultConfig
+				cfg.Journal = ""
+				cfg.PriceLimit = 0
+				return cfg
+			},
+		},
+		{
+			name: "custom limits",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMMempoolPriceBump, uint64(25))
+				opts.Set(srvflags.EVMMempoolPriceLimit, uint64(100))
+				opts.Set(srvflags.EVMMempoolAccountSlots, uint64(4))
+				opts.Set(srvflags.EVMMempoolGlobalSlots, uint64(256))
+				opts.Set(srvflags.EVMMempoolAccountQueue, uint64(8))
+				opts.Set(srvflags.EVMMempoolGlobalQueue, uint64(128))
+				opts.Set(srvflags.EVMMempoolLifetime, time.Minute)
+				return opts
+			},
+			expected: func() legacypool.Config {
+				cfg := legacypool.DefaultConfig
+				cfg.Journal = ""
+				cfg.PriceBump = 25
+				cfg.PriceLimit = 100
+				cfg.AccountSlots = 4
+				cfg.GlobalSlots = 256
+				cfg.AccountQueue = 8
+				cfg.GlobalQueue = 128
+				cfg.Lifetime = time.Minute
+				return cfg
+			},
+		},
+		{
+			name: "relative journal is resolved in the data directory",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/home/evmd")
+				opts.Set(srvflags.EVMMempoolJournal, "transactions.rlp")
+				opts.Set(srvflags.EVMMempoolRejournal, 10*time.Minute)
+				return opts
+			},
+			expected: func() legacypool.Config {
+				cfg := legacypool.DefaultConfig
+				cfg.Journal = "/home/evmd/data/transactions.rlp"
+				cfg.Rejournal = 10 * time.Minute
+				cfg.PriceLimit = 0
+				return cfg
+			},
+		},
+		{
+			name: "relative journal without home directory is disabled",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMMempoolJournal, "transactions.rlp")
+				return opts
+			},
+			expected: func() legacypool.Config {
+				cfg := legacypool.DefaultConfig
+				cfg.Journal = ""
+				cfg.PriceLimit = 0
+				return cfg
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := GetLegacyPoolConfig(tc.setupFn(), log.NewNopLogger())
+			require.Equal(t, tc.expected(), *result)
+		})
+	}
+}
+
+func TestGetStreamingFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		setupFn  func() servertypes.AppOptions
+		expected string
+	}{
+		{
+			name: "disabled by default",
+			setupFn: func() servertypes.AppOptions {
+				return newMockAppOptions()
+			},
+			expected: "",
+		},
+		{
+			name: "absolute file",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/home/evmd")
+				opts.Set(srvflags.EVMStreamingFile, "/var/lib/evm/blocks.jsonl")
+				return opts
+			},
+			expected: "/var/lib/evm/blocks.jsonl",
+		},
+		{
+			name: "relative file is resolved in the data directory",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(flags.FlagHome, "/home/evmd")
+				opts.Set(srvflags.EVMStreamingFile, "blocks.jsonl")
+				return opts
+			},
+			expected: "/home/evmd/data/blocks.jsonl",
+		},
+		{
+			name: "relative file without home directory is disabled",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMStreamingFile, "blocks.jsonl")
+				return opts
+			},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, GetStreamingFile(tc.setupFn(), log.NewNopLogger()))
+		})
+	}
+}
+
 func createGenesisWithMaxGas(t *testing.T, maxGas int64) string {
 	t.Helper()
 	tempDir := t.TempDir()
@@ -330,3 +473,59 @@ func createInvalidGenesis(t *testing.T) string {
 
 	return tempDir
 }
+
+func TestGetQueryCacheConfig(t *testing.T) {
+	opts := newMockAppOptions()
+	require.Equal(t, querycache.Config{}, GetQueryCacheConfig(opts))
+	require.False(t, GetQueryCacheConfig(opts).Enabled())
+
+	opts.Set(srvflags.EVMQueryCacheCodeSize, uint64(128))
+	opts.Set(srvflags.EVMQueryCacheStorageSize, "4096")
+	cfg := GetQueryCacheConfig(opts)
+	require.Equal(t, querycache.Config{CodeSize: 128, StorageSize: 4096}, cfg)
+	require.True(t, cfg.Enabled())
+}
+
+func TestGetSenderGasQuotaConfig(t *testing.T) {
+	allowed := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	tests := []struct {
+		name     string
+		setupFn  func() servertypes.AppOptions
+		expected *evmmempool.SenderGasQuotaConfig
+	}{
+		{
+			name:     "disabled by default",
+			setupFn:  func() servertypes.AppOptions { return newMockAppOptions() },
+			expected: nil,
+		},
+		{
+			name: "quota above 100 percent is disabled",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMMempoolSenderGasQuota, uint64(150))
+				return opts
+			},
+			expected: nil,
+		},
+		{
+			name: "quota with allowlist",
+			setupFn: func() servertypes.AppOptions {
+				opts := newMockAppOptions()
+				opts.Set(srvflags.EVMMempoolSenderGasQuota, uint64(10))
+				opts.Set(srvflags.EVMMempoolSenderGasQuotaAllowlist, []string{allowed.Hex(), "invalid"})
+				return opts
+			},
+			expected: &evmmempool.SenderGasQuotaConfig{
+				Percent:   10,
+				Allowlist: []common.Address{allowed},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, GetSenderGasQuotaConfig(tc.setupFn(), log.NewNopLogger()))
+		})
+	}
+}
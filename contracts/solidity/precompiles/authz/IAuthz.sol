@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IAuthz contract's address.
+address constant AUTHZ_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000809;
+
+/// @dev The IAuthz contract's instance.
+IAuthz constant AUTHZ_CONTRACT = IAuthz(AUTHZ_PRECOMPILE_ADDRESS);
+
+/// @dev GrantData defines a grant of authorization from a granter to a grantee.
+struct GrantData {
+    /// the address of the granter
+    address granter;
+    /// the address of the grantee
+    address grantee;
+    /// the type URL of the authorization, e.g. /cosmos.authz.v1beta1.GenericAuthorization
+    string authorizationType;
+    /// the type URL of the message the authorization applies to
+    string msgTypeUrl;
+    /// the expiration time of the grant as a unix timestamp, 0 if the grant doesn't expire
+    int64 expiration;
+}
+
+/// @author Evmos Team
+/// @title Authz Precompiled Contract
+/// @dev The interface through which solidity contracts can create, revoke and query
+/// x/authz grants for arbitrary message types.
+/// @custom:address 0x0000000000000000000000000000000000000809
+interface IAuthz {
+    /// @dev Grant defines an Event emitted when a grant is created.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message the grant applies to
+    event Grant(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Revoke defines an Event emitted when a grant is revoked.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message the grant applied to
+    event Revoke(
+        address indexed granter,
+        address indexed grantee,
+        string msgTypeUrl
+    );
+
+    /// @dev Grants a generic authorization to the grantee to execute the given message type
+    /// on behalf of the granter. An existing grant for the same message type is overwritten.
+    /// @param granter The address of the granter, must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+    /// @param expiration The expiration time of the grant as a unix timestamp, 0 for no expiration
+    /// @return success Whether the grant was successful
+    function grant(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        int64 expiration
+    ) external returns (bool success);
+
+    /// @dev Revokes the grant of the given message type from the granter to the grantee.
+    /// @param granter The address of the granter, must be the caller
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message of the grant
+    /// @return success Whether the revocation was successful
+    function revoke(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl
+    ) external returns (bool success);
+
+    /// @dev Returns the grants from the granter to the grantee.
+    /// @param granter The address of the granter
+    /// @param grantee The address of the grantee
+    /// @param msgTypeUrl The type URL of the message, empty to return the grants of all message types
+    /// @param pagination The pagination details
+    /// @return grants The grants from the granter to the grantee
+    /// @return pageResponse The pagination response
+    function grants(
+        address granter,
+        address grantee,
+        string calldata msgTypeUrl,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants given by the granter.
+    /// @param granter The address of the granter
+    /// @param pagination The pagination details
+    /// @return grants The grants given by the granter
+    /// @return pageResponse The pagination response
+    function granterGrants(
+        address granter,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+
+    /// @dev Returns the grants received by the grantee.
+    /// @param grantee The address of the grantee
+    /// @param pagination The pagination details
+    /// @return grants The grants received by the grantee
+    /// @return pageResponse The pagination response
+    function granteeGrants(
+        address grantee,
+        PageRequest calldata pagination
+    ) external view returns (GrantData[] memory grants, PageResponse memory pageResponse);
+}
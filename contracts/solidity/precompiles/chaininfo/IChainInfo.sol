@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IChainInfo contract's address.
+address constant CHAIN_INFO_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080d;
+
+/// @dev The IChainInfo contract's instance.
+IChainInfo constant CHAIN_INFO_CONTRACT = IChainInfo(CHAIN_INFO_PRECOMPILE_ADDRESS);
+
+/// @dev CoinInfo defines the native coin of the EVM.
+/// @param denom The base denomination of the coin in the Cosmos SDK, e.g. "uatom"
+/// @param extendedDenom The 18 decimals denomination used by the EVM, e.g. "aatom"
+/// @param displayDenom The display denomination of the coin, e.g. "atom"
+/// @param decimals The number of decimals of the base denomination
+struct CoinInfo {
+    string denom;
+    string extendedDenom;
+    string displayDenom;
+    uint8 decimals;
+}
+
+/// @dev ModuleVersion defines the consensus version of a module of the chain.
+/// @param name The name of the module
+/// @param version The consensus version of the module
+struct ModuleVersion {
+    string name;
+    uint64 version;
+}
+
+/// @author Evmos Team
+/// @title Chain Info Precompiled Contract
+/// @dev The interface through which solidity contracts read the effective configuration of the
+/// chain and its EVM, so they can adapt their behavior without off-chain configuration.
+/// @custom:address 0x000000000000000000000000000000000000080d
+interface IChainInfo {
+    /// @dev Returns the EIP-155 chain ID of the EVM.
+    /// @return chainId The chain ID
+    function chainId() external view returns (uint256 chainId);
+
+    /// @dev Returns the Ethereum forks active at the current block height and time.
+    /// @return forks The names of the active forks, from the oldest to the newest, e.g. "london"
+    function activeForks() external view returns (string[] memory forks);
+
+    /// @dev Returns the EIPs enabled on top of the active forks.
+    /// @return eips The numbers of the extra EIPs
+    function extraEIPs() external view returns (int64[] memory eips);
+
+    /// @dev Returns the information of the native coin of the EVM.
+    /// @return coinInfo The denominations and decimals of the native coin
+    function coinInfo() external view returns (CoinInfo memory coinInfo);
+
+    /// @dev Returns the consensus versions of the modules of the chain.
+    /// @return versions The modules and their versions, sorted by module name
+    function moduleVersions() external view returns (ModuleVersion[] memory versions);
+}
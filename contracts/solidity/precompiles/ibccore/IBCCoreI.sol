@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IBCCoreI contract's address.
+address constant IBC_CORE_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000808;
+
+/// @dev The IBCCoreI contract's instance.
+IBCCoreI constant IBC_CORE_CONTRACT = IBCCoreI(IBC_CORE_PRECOMPILE_ADDRESS);
+
+/// @dev Channel states, matching the IBC channel State enum.
+uint8 constant CHANNEL_STATE_UNINITIALIZED = 0;
+uint8 constant CHANNEL_STATE_INIT = 1;
+uint8 constant CHANNEL_STATE_TRYOPEN = 2;
+uint8 constant CHANNEL_STATE_OPEN = 3;
+uint8 constant CHANNEL_STATE_CLOSED = 4;
+
+/// @dev Connection states, matching the IBC connection State enum.
+uint8 constant CONNECTION_STATE_UNINITIALIZED = 0;
+uint8 constant CONNECTION_STATE_INIT = 1;
+uint8 constant CONNECTION_STATE_TRYOPEN = 2;
+uint8 constant CONNECTION_STATE_OPEN = 3;
+
+/// @dev Channel defines the state of an IBC channel end.
+struct Channel {
+    /// the state of the channel end, UNINITIALIZED if the channel doesn't exist
+    uint8 state;
+    /// the ordering of the channel: 0 NONE, 1 UNORDERED, 2 ORDERED
+    uint8 ordering;
+    /// the port identifier of the counterparty channel end
+    string counterpartyPortId;
+    /// the identifier of the counterparty channel end
+    string counterpartyChannelId;
+    /// the connection the channel is built on
+    string connectionId;
+    /// the version of the channel
+    string version;
+}
+
+/// @dev Connection defines the state of an IBC connection end.
+struct Connection {
+    /// the state of the connection end, UNINITIALIZED if the connection doesn't exist
+    uint8 state;
+    /// the identifier of the client of the counterparty chain
+    string clientId;
+    /// the identifier of the client of this chain on the counterparty chain
+    string counterpartyClientId;
+    /// the identifier of the counterparty connection end
+    string counterpartyConnectionId;
+}
+
+/// @author Evmos Team
+/// @title IBC Core Precompiled Contract
+/// @dev The read-only interface through which solidity contracts can inspect the
+/// IBC clients, connections and channels, e.g. to verify that a channel is open
+/// and its client active before initiating a transfer.
+/// @custom:address 0x0000000000000000000000000000000000000808
+interface IBCCoreI {
+    /// @dev Returns the channel end of the given port and channel.
+    /// @param portId The port identifier of the channel
+    /// @param channelId The channel identifier
+    /// @return channel The channel end, with an UNINITIALIZED state if not found
+    function channel(
+        string calldata portId,
+        string calldata channelId
+    ) external view returns (Channel memory channel);
+
+    /// @dev Returns the next sequences of the given channel.
+    /// @param portId The port identifier of the channel
+    /// @param channelId The channel identifier
+    /// @return nextSequenceSend The sequence of the next packet to send
+    /// @return nextSequenceRecv The sequence of the next packet to receive, on ordered channels
+    /// @return nextSequenceAck The sequence of the next packet to acknowledge, on ordered channels
+    function nextSequences(
+        string calldata portId,
+        string calldata channelId
+    )
+        external
+        view
+        returns (
+            uint64 nextSequenceSend,
+            uint64 nextSequenceRecv,
+            uint64 nextSequenceAck
+        );
+
+    /// @dev Returns the client of the given channel, along with its status.
+    /// @param portId The port identifier of the channel
+    /// @param channelId The channel identifier
+    /// @return clientId The client identifier, empty if the channel or its connection is not found
+    /// @return status The status of the client, see clientStatus
+    function channelClient(
+        string calldata portId,
+        string calldata channelId
+    ) external view returns (string memory clientId, string memory status);
+
+    /// @dev Returns whether the given channel is open, and its client active.
+    /// @param portId The port identifier of the channel
+    /// @param channelId The channel identifier
+    /// @return active True if packets can be sent on the channel
+    function isChannelActive(
+        string calldata portId,
+        string calldata channelId
+    ) external view returns (bool active);
+
+    /// @dev Returns the connection end of the given connection.
+    /// @param connectionId The connection identifier
+    /// @return connection The connection end, with an UNINITIALIZED state if not found
+    function connection(
+        string calldata connectionId
+    ) external view returns (Connection memory connection);
+
+    /// @dev Returns the status of the given client.
+    /// @param clientId The client identifier
+    /// @return status One of "Active", "Frozen", "Expired", "Unknown" or "Unauthorized"
+    function clientStatus(
+        string calldata clientId
+    ) external view returns (string memory status);
+
+    /// @dev Returns the latest height of the given client.
+    /// @param clientId The client identifier
+    /// @return latestHeight The latest height, zero if the client is not found
+    function clientLatestHeight(
+        string calldata clientId
+    ) external view returns (Height memory latestHeight);
+}
@@ -33,32 +33,40 @@ interface ICS20I {
     /// @dev Emitted when an ICS-20 transfer is executed.
     /// @param sender The address of the sender.
     /// @param receiver The address of the receiver.
+    /// @param sequence The sequence number of the transfer packet sent, to correlate its acknowledgement.
     /// @param sourcePort The source port of the IBC transaction, For v2 packets, leave it empty.
     /// @param sourceChannel The source channel of the IBC transaction, For v2 packets, set the client ID.
     /// @param denom The denomination of the tokens transferred.
     /// @param amount The amount of tokens transferred.
+    /// @param timeoutHeight The timeout height of the transfer packet, zero if disabled.
+    /// @param timeoutTimestamp The timeout timestamp of the transfer packet, zero if disabled.
     /// @param memo The IBC transaction memo.
     event IBCTransfer(
         address indexed sender,
         string indexed receiver,
+        uint64 indexed sequence,
         string sourcePort,
         string sourceChannel,
         string denom,
         uint256 amount,
+        Height timeoutHeight,
+        uint64 timeoutTimestamp,
         string memo
     );
 
     /// @dev Transfer defines a method for performing an IBC transfer.
     /// @param sourcePort the port on which the packet will be sent
     /// @param sourceChannel the channel by which the packet will be sent
-    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param denom the denomination of the Coin to be transferred to the receiver, or the
+    /// address of a registered ERC20 contract, whose tokens are converted to their Cosmos
+    /// representation before being transferred
     /// @param amount the amount of the Coin to be transferred to the receiver
     /// @param sender the hex address of the sender
     /// @param receiver the bech32 address of the receiver
     /// @param timeoutHeight the timeout height relative to the current block height. 
-    /// The timeout is disabled when set to 0
+    /// The timeout is disabled when set to 0, it must be 0 for v2 packets
     /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch. 
-    /// The timeout is disabled when set to 0
+    /// The timeout is disabled when set to 0, it's required, in seconds, for v2 packets
     /// @param memo optional memo
     /// @return nextSequence sequence number of the transfer packet sent
     function transfer(
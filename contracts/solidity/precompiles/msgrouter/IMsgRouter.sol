@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IMsgRouter contract's address.
+address constant MSG_ROUTER_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080a;
+
+/// @dev The IMsgRouter contract's instance.
+IMsgRouter constant MSG_ROUTER_CONTRACT = IMsgRouter(MSG_ROUTER_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Message Router Precompiled Contract
+/// @dev The interface through which solidity contracts can execute any Cosmos SDK message
+/// allowed by governance, without a dedicated precompile for its module.
+/// @custom:address 0x000000000000000000000000000000000000080a
+interface IMsgRouter {
+    /// @dev Execute defines an Event emitted when a message is executed.
+    /// @param signer The address of the signer of the message
+    /// @param msgTypeUrl The type URL of the executed message
+    event Execute(address indexed signer, string msgTypeUrl);
+
+    /// @dev Executes a Cosmos SDK message signed by the caller. The message must be allowed
+    /// in the allowed_msg_type_urls EVM parameter.
+    /// @param signer The address of the signer of the message, must be the caller
+    /// @param msgJson The proto-JSON encoded message, including its "@type", e.g.
+    /// {"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1...","to_address":"cosmos1...","amount":[...]}
+    /// @return responseJson The proto-JSON encoded response of the message, including its "@type"
+    function execute(
+        address signer,
+        string calldata msgJson
+    ) external returns (string memory responseJson);
+
+    /// @dev Returns whether the message of the given type URL can be executed.
+    /// @param msgTypeUrl The type URL of the message, e.g. /cosmos.bank.v1beta1.MsgSend
+    /// @return allowed Whether the message is allowed
+    function isAllowed(
+        string calldata msgTypeUrl
+    ) external view returns (bool allowed);
+}
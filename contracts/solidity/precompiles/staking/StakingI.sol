@@ -295,6 +295,35 @@ interface StakingI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev Queries all the unbonding delegations of a given delegator.
+    /// @param delegatorAddress The address of the delegator.
+    /// @param pageRequest Defines an optional pagination for the request.
+    /// @return unbondingDelegations The unbonding delegations of the delegator.
+    function delegatorUnbondingDelegations(
+        address delegatorAddress,
+        PageRequest calldata pageRequest
+    )
+        external
+        view
+        returns (
+            UnbondingDelegationOutput[] calldata unbondingDelegations,
+            PageResponse calldata pageResponse
+        );
+
+    /// @dev Queries the latest completion times of the unbonding delegations and
+    /// redelegations of a given delegator, i.e. when all of them are completed.
+    /// @param delegatorAddress The address of the delegator.
+    /// @return unbondingCompletionTime The latest completion time of the unbonding delegation
+    /// entries, or 0 if the delegator has no unbonding delegations.
+    /// @return redelegationCompletionTime The latest completion time of the redelegation
+    /// entries, or 0 if the delegator has no redelegations.
+    function completionTimes(
+        address delegatorAddress
+    )
+        external
+        view
+        returns (int64 unbondingCompletionTime, int64 redelegationCompletionTime);
+
     /// @dev CreateValidator defines an Event emitted when a create a new validator.
     /// @param validatorAddress The address of the validator
     /// @param value The amount of coin being self delegated
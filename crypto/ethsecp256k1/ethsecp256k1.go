@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/crypto"
 
+	"github.com/cosmos/evm/ethereum/eip191"
 	"github.com/cosmos/evm/ethereum/eip712"
 
 	errorsmod "cosmossdk.io/errors"
@@ -207,18 +208,21 @@ func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
 // VerifySignature verifies that the ECDSA public key created a given signature over
 // the provided message. It will calculate the Keccak256 hash of the message
 // prior to verification and approve verification if the signature can be verified
-// from either the original message or its EIP-712 representation.
+// from either the original message, its EIP-712 representation or its EIP-191
+// (personal_sign) representation.
 //
 // CONTRACT: The signature should be in [R || S] format.
 func (pubKey PubKey) VerifySignature(msg, sig []byte) bool {
-	return pubKey.verifySignatureECDSA(msg, sig) || pubKey.verifySignatureAsEIP712(msg, sig)
+	return pubKey.verifySignatureECDSA(msg, sig) ||
+		pubKey.verifySignatureAsEIP712(msg, sig) ||
+		pubKey.verifySignatureAsEIP191(msg, sig)
 }
 
 // Verifies the signature as an EIP-712 signature by first converting the message payload
 // to EIP-712 object bytes, then performing ECDSA verification on the hash. This is to support
 // signing a Cosmos payload using EIP-712.
 func (pubKey PubKey) verifySignatureAsEIP712(msg, sig []byte) bool {
-	eip712Bytes, err := eip712.GetEIP712BytesForMsg(msg)
+	eip712Bytes, err := eip712.GetEIP712BytesForSigner(msg, &pubKey)
 	if err != nil {
 		return false
 	}
@@ -236,6 +240,19 @@ func (pubKey PubKey) verifySignatureAsEIP712(msg, sig []byte) bool {
 	return pubKey.verifySignatureECDSA(legacyEIP712Bytes, sig)
 }
 
+// Verifies the signature as an EIP-191 signature by first rendering the message payload
+// into its human-readable representation, then performing ECDSA verification on the hash
+// of the prefixed text. This is to support signing a Cosmos payload using personal_sign,
+// e.g. with hardware wallets that do not implement EIP-712.
+func (pubKey PubKey) verifySignatureAsEIP191(msg, sig []byte) bool {
+	eip191Bytes, err := eip191.GetEIP191BytesForSigner(msg, &pubKey)
+	if err != nil {
+		return false
+	}
+
+	return pubKey.verifySignatureECDSA(eip191Bytes, sig)
+}
+
 // Perform standard ECDSA signature verification for the given raw bytes and signature.
 func (pubKey PubKey) verifySignatureECDSA(msg, sig []byte) bool {
 	if len(sig) == crypto.SignatureLength {
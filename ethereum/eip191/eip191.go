@@ -0,0 +1,48 @@
+package eip191
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+
+	"github.com/cosmos/evm/ethereum/eip712"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// signDocHeader is the header of the human-readable representation of a Cosmos
+// transaction sign doc.
+const signDocHeader = "Cosmos Transaction\n\n"
+
+// GetEIP191BytesForSigner returns the EIP-191 (personal_sign) bytes signed by the given
+// signer for the given SignDoc bytes. The sign doc is rendered into a human-readable message, which is then prefixed
+// with "\x19Ethereum Signed Message:\n" and its length, as done by eth_sign and
+// personal_sign. See https://eips.ethereum.org/EIPS/eip-191 for more.
+func GetEIP191BytesForSigner(signDocBytes []byte, signer cryptotypes.PubKey) ([]byte, error) {
+	text, err := GetTextForSigner(signDocBytes, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	_, msg := accounts.TextAndHash([]byte(text))
+	return []byte(msg), nil
+}
+
+// GetTextForSigner returns the human-readable representation signed by the given signer of
+// either Amino or Protobuf encoded signature doc bytes. This is the text displayed by wallets
+// when signing the transaction using personal_sign.
+func GetTextForSigner(signDocBytes []byte, signer cryptotypes.PubKey) (string, error) {
+	aminoSignBytes, err := eip712.GetAminoSignBytesForSigner(signDocBytes, signer)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, aminoSignBytes, "", "  "); err != nil {
+		return "", fmt.Errorf("could not render sign doc: %w", err)
+	}
+
+	return signDocHeader + buf.String(), nil
+}
@@ -0,0 +1,60 @@
+package eip191_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/ethereum/eip191"
+	"github.com/cosmos/evm/testutil/constants"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestVerifyEIP191Signature(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(uint64(constants.ExampleEIP155ChainID))
+	cdc := encodingConfig.Amino
+	banktypes.RegisterLegacyAminoCodec(cdc)
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := sdk.AccAddress(privKey.PubKey().Address())
+
+	msg := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(1))))
+	fee := legacytx.StdFee{Amount: sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(200))), Gas: 200_000}
+	signBytes := sdk.MustSortJSON(cdc.MustMarshalJSON(legacytx.StdSignDoc{
+		AccountNumber: 1,
+		ChainID:       constants.ExampleChainID.ChainID,
+		Fee:           cdc.MustMarshalJSON(fee),
+		Memo:          "memo",
+		Msgs:          []json.RawMessage{cdc.MustMarshalJSON(msg)},
+	}))
+
+	text, err := eip191.GetTextForSigner(signBytes, privKey.PubKey())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(text, "Cosmos Transaction"))
+	require.Contains(t, text, "\"memo\": \"memo\"")
+
+	key, err := privKey.ToECDSA()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(accounts.TextHash([]byte(text)), key)
+	require.NoError(t, err)
+
+	require.True(t, privKey.PubKey().VerifySignature(signBytes, sig))
+
+	// a signature over a different payload must fail
+	sig, err = crypto.Sign(accounts.TextHash([]byte(text+" ")), key)
+	require.NoError(t, err)
+	require.False(t, privKey.PubKey().VerifySignature(signBytes, sig))
+}
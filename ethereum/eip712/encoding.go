@@ -1,6 +1,7 @@
 package eip712
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -8,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
@@ -32,8 +35,17 @@ func SetEncodingConfig(cdc *codec.LegacyAmino, interfaceRegistry types.Interface
 
 // GetEIP712BytesForMsg returns the EIP-712 object bytes for the given SignDoc bytes by decoding the bytes into
 // an EIP-712 object, then converting via WrapTxToTypedData. See https://eips.ethereum.org/EIPS/eip-712 for more.
+//
+// A Protobuf sign doc of a transaction with several signers does not identify its signer, use
+// GetEIP712BytesForSigner to get the EIP-712 object bytes of one of them.
 func GetEIP712BytesForMsg(signDocBytes []byte) ([]byte, error) {
-	typedData, err := GetEIP712TypedDataForMsg(signDocBytes)
+	return GetEIP712BytesForSigner(signDocBytes, nil)
+}
+
+// GetEIP712BytesForSigner returns the EIP-712 object bytes signed by the given signer for the given
+// SignDoc bytes. The payload of a Protobuf sign doc uses the sequence of the signer info of the signer.
+func GetEIP712BytesForSigner(signDocBytes []byte, signer cryptotypes.PubKey) ([]byte, error) {
+	typedData, err := GetEIP712TypedDataForSigner(signDocBytes, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -49,13 +61,20 @@ func GetEIP712BytesForMsg(signDocBytes []byte) ([]byte, error) {
 // GetEIP712TypedDataForMsg returns the EIP-712 TypedData representation for either
 // Amino or Protobuf encoded signature doc bytes.
 func GetEIP712TypedDataForMsg(signDocBytes []byte) (apitypes.TypedData, error) {
+	return GetEIP712TypedDataForSigner(signDocBytes, nil)
+}
+
+// GetEIP712TypedDataForSigner returns the EIP-712 TypedData representation signed by the given
+// signer for either Amino or Protobuf encoded signature doc bytes. The signer may only be nil if
+// the transaction has a single signer info.
+func GetEIP712TypedDataForSigner(signDocBytes []byte, signer cryptotypes.PubKey) (apitypes.TypedData, error) {
 	// Attempt to decode as both Amino and Protobuf since the message format is unknown.
 	// If either decode works, we can move forward with the corresponding typed data.
 	typedDataAmino, errAmino := decodeAminoSignDoc(signDocBytes)
 	if errAmino == nil && isValidEIP712Payload(typedDataAmino) {
 		return typedDataAmino, nil
 	}
-	typedDataProtobuf, errProtobuf := decodeProtobufSignDoc(signDocBytes)
+	typedDataProtobuf, errProtobuf := decodeProtobufSignDoc(signDocBytes, signer)
 	if errProtobuf == nil && isValidEIP712Payload(typedDataProtobuf) {
 		return typedDataProtobuf, nil
 	}
@@ -72,19 +91,73 @@ func isValidEIP712Payload(typedData apitypes.TypedData) bool {
 // decodeAminoSignDoc attempts to decode the provided sign doc (bytes) as an Amino payload
 // and returns a signable EIP-712 TypedData object.
 func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+	signBytes, err := aminoSignBytesFromAminoSignDoc(signDocBytes)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData, err := WrapTxToTypedData(
+		eip155ChainID,
+		signBytes,
+	)
+	if err != nil {
+		return apitypes.TypedData{}, fmt.Errorf("could not convert to EIP712 representation: %w", err)
+	}
+
+	return typedData, nil
+}
+
+// decodeProtobufSignDoc attempts to decode the provided sign doc (bytes) as a Protobuf payload
+// and returns a signable EIP-712 TypedData object.
+func decodeProtobufSignDoc(signDocBytes []byte, signer cryptotypes.PubKey) (apitypes.TypedData, error) {
+	signBytes, err := aminoSignBytesFromProtobufSignDoc(signDocBytes, signer)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData, err := WrapTxToTypedData(
+		eip155ChainID,
+		signBytes,
+	)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	return typedData, nil
+}
+
+// GetAminoSignBytesForSigner returns the Amino JSON sign bytes of the given signer for either
+// Amino or Protobuf encoded signature doc bytes. The Amino JSON sign doc is the canonical
+// representation of the transaction used to build the EIP-712 payload.
+func GetAminoSignBytesForSigner(signDocBytes []byte, signer cryptotypes.PubKey) ([]byte, error) {
+	signBytes, errAmino := aminoSignBytesFromAminoSignDoc(signDocBytes)
+	if errAmino == nil {
+		return signBytes, nil
+	}
+	signBytes, errProtobuf := aminoSignBytesFromProtobufSignDoc(signDocBytes, signer)
+	if errProtobuf == nil {
+		return signBytes, nil
+	}
+
+	return nil, fmt.Errorf("could not decode sign doc as either Amino or Protobuf.\n amino: %v\n protobuf: %v", errAmino, errProtobuf)
+}
+
+// aminoSignBytesFromAminoSignDoc validates the provided sign doc (bytes) as an Amino payload
+// and returns it.
+func aminoSignBytesFromAminoSignDoc(signDocBytes []byte) ([]byte, error) {
 	// Ensure codecs have been initialized
 	if err := validateCodecInit(); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	var aminoDoc legacytx.StdSignDoc
 	if err := aminoCodec.UnmarshalJSON(signDocBytes, &aminoDoc); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	var fees legacytx.StdFee
 	if err := aminoCodec.UnmarshalJSON(aminoDoc.Fee, &fees); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	// Validate payload messages
@@ -92,56 +165,49 @@ func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 	for i, jsonMsg := range aminoDoc.Msgs {
 		var m sdk.Msg
 		if err := aminoCodec.UnmarshalJSON(jsonMsg, &m); err != nil {
-			return apitypes.TypedData{}, fmt.Errorf("failed to unmarshal sign doc message: %w", err)
+			return nil, fmt.Errorf("failed to unmarshal sign doc message: %w", err)
 		}
 		msgs[i] = m
 	}
 
 	if err := validatePayloadMessages(msgs); err != nil {
-		return apitypes.TypedData{}, err
-	}
-
-	typedData, err := WrapTxToTypedData(
-		eip155ChainID,
-		signDocBytes,
-	)
-	if err != nil {
-		return apitypes.TypedData{}, fmt.Errorf("could not convert to EIP712 representation: %w", err)
+		return nil, err
 	}
 
-	return typedData, nil
+	return signDocBytes, nil
 }
 
-// decodeProtobufSignDoc attempts to decode the provided sign doc (bytes) as a Protobuf payload
-// and returns a signable EIP-712 TypedData object.
-func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+// aminoSignBytesFromProtobufSignDoc decodes the provided sign doc (bytes) as a Protobuf payload
+// and returns the corresponding Amino JSON sign bytes of the given signer.
+func aminoSignBytesFromProtobufSignDoc(signDocBytes []byte, signer cryptotypes.PubKey) ([]byte, error) {
 	// Ensure codecs have been initialized
 	if err := validateCodecInit(); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	signDoc := &txTypes.SignDoc{}
 	if err := signDoc.Unmarshal(signDocBytes); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	authInfo := &txTypes.AuthInfo{}
 	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	body := &txTypes.TxBody{}
 	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
 	// Until support for these fields is added, throw an error at their presence
 	if body.TimeoutHeight != 0 || len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
-		return apitypes.TypedData{}, errors.New("body contains unsupported fields: TimeoutHeight, ExtensionOptions, or NonCriticalExtensionOptions")
+		return nil, errors.New("body contains unsupported fields: TimeoutHeight, ExtensionOptions, or NonCriticalExtensionOptions")
 	}
 
-	if len(authInfo.SignerInfos) != 1 {
-		return apitypes.TypedData{}, fmt.Errorf("invalid number of signer infos provided, expected 1 got %v", len(authInfo.SignerInfos))
+	signerInfo, err := signerInfoOf(authInfo, signer)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate payload messages
@@ -149,17 +215,15 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 	for i, protoMsg := range body.Messages {
 		var m sdk.Msg
 		if err := protoCodec.UnpackAny(protoMsg, &m); err != nil {
-			return apitypes.TypedData{}, fmt.Errorf("could not unpack message object with error %w", err)
+			return nil, fmt.Errorf("could not unpack message object with error %w", err)
 		}
 		msgs[i] = m
 	}
 
 	if err := validatePayloadMessages(msgs); err != nil {
-		return apitypes.TypedData{}, err
+		return nil, err
 	}
 
-	signerInfo := authInfo.SignerInfos[0]
-
 	stdFee := &legacytx.StdFee{
 		Amount: authInfo.Fee.Amount,
 		Gas:    authInfo.Fee.GasLimit,
@@ -176,15 +240,71 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		body.Memo,
 	)
 
-	typedData, err := WrapTxToTypedData(
-		eip155ChainID,
-		signBytes,
-	)
-	if err != nil {
-		return apitypes.TypedData{}, err
+	return signBytes, nil
+}
+
+// signerInfoOf returns the signer info of the given signer, matched by the address of its public
+// key like the signer data of the SDK. A member of a multisig account signs with the signer info
+// of the account. The signer may only be nil, or the public keys of the signer infos unset (e.g.
+// when simulating the transaction), if the transaction has a single signer info.
+func signerInfoOf(authInfo *txTypes.AuthInfo, signer cryptotypes.PubKey) (*txTypes.SignerInfo, error) {
+	if len(authInfo.SignerInfos) == 0 {
+		return nil, errors.New("invalid number of signer infos provided, expected at least 1 got 0")
+	}
+	if signer == nil {
+		if len(authInfo.SignerInfos) != 1 {
+			return nil, fmt.Errorf("a signer is required to select its signer info among %d", len(authInfo.SignerInfos))
+		}
+		return authInfo.SignerInfos[0], nil
 	}
 
-	return typedData, nil
+	var signerInfo *txTypes.SignerInfo
+	for _, info := range authInfo.SignerInfos {
+		if info.PublicKey == nil {
+			continue
+		}
+
+		var pubKey cryptotypes.PubKey
+		if err := protoCodec.UnpackAny(info.PublicKey, &pubKey); err != nil {
+			return nil, fmt.Errorf("could not unpack signer public key with error %w", err)
+		}
+		if !isSignerOf(pubKey, signer) {
+			continue
+		}
+		if signerInfo != nil {
+			return nil, fmt.Errorf("signer %s matches several signer infos", sdk.AccAddress(signer.Address()))
+		}
+		signerInfo = info
+	}
+
+	if signerInfo == nil {
+		if len(authInfo.SignerInfos) == 1 && authInfo.SignerInfos[0].PublicKey == nil {
+			return authInfo.SignerInfos[0], nil
+		}
+		return nil, fmt.Errorf("no signer info found for signer %s", sdk.AccAddress(signer.Address()))
+	}
+
+	return signerInfo, nil
+}
+
+// isSignerOf returns true if the signer is the owner of the public key, or one of its members
+// for a multisig public key.
+func isSignerOf(pubKey, signer cryptotypes.PubKey) bool {
+	if bytes.Equal(pubKey.Address(), signer.Address()) {
+		return true
+	}
+
+	multisigKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return false
+	}
+	for _, member := range multisigKey.GetPubKeys() {
+		if bytes.Equal(member.Address(), signer.Address()) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // validateCodecInit ensures that both Amino and Protobuf encoding codecs have been set on app init,
@@ -198,30 +318,23 @@ func validateCodecInit() error {
 }
 
 // validatePayloadMessages ensures that the transaction messages can be represented in an EIP-712
-// encoding by checking that messages exist and share a single signer.
+// encoding by checking that messages exist and that each of them declares its signers.
+//
+// NOTE: messages with different signers are supported, since each signer signs the EIP-712
+// representation of its own sign doc (i.e. with its account number and sequence). This also
+// allows the members of a multisig account to sign its transactions using EIP-712.
 func validatePayloadMessages(msgs []sdk.Msg) error {
 	if len(msgs) == 0 {
 		return errors.New("unable to build EIP-712 payload: transaction does contain any messages")
 	}
 
-	var msgSigner sdk.AccAddress
-
-	for i, m := range msgs {
+	for _, m := range msgs {
 		signers, _, err := protoCodec.GetMsgV1Signers(m)
 		if err != nil {
 			return fmt.Errorf("error getting signers. %w", err)
 		}
-		if len(signers) != 1 {
-			return errors.New("unable to build EIP-712 payload: expect exactly 1 signer")
-		}
-
-		if i == 0 {
-			msgSigner = signers[0]
-			continue
-		}
-
-		if !msgSigner.Equals(sdk.AccAddress(signers[0])) {
-			return errors.New("unable to build EIP-712 payload: multiple signers detected")
+		if len(signers) == 0 {
+			return errors.New("unable to build EIP-712 payload: expect at least 1 signer")
 		}
 	}
 
@@ -34,9 +34,15 @@ func PreprocessLedgerTx(evmChainID uint64, keyType cosmoskr.KeyType, txBuilder c
 		return fmt.Errorf("could not get signatures: %w", err)
 	}
 
-	// Verify single-signer
-	if len(sigs) != 1 {
-		return fmt.Errorf("invalid number of signatures, expected 1 and got %v", len(sigs))
+	if len(sigs) == 0 {
+		return fmt.Errorf("invalid number of signatures, expected at least 1 and got 0")
+	}
+
+	// The Web3Tx extension only carries the fee payer signature, so multi-signer
+	// transactions are left as is: each EIP-712 signature is verified against the
+	// sign doc of its signer by the eth_secp256k1 public keys.
+	if len(sigs) > 1 {
+		return nil
 	}
 
 	signature := sigs[0]
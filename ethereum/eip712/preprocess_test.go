@@ -153,6 +153,45 @@ func TestNonLedgerTxBuilder(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMultiSignerLedgerTxBuilder(t *testing.T) {
+	txBuilder := ctx.TxConfig.NewTxBuilder()
+
+	signatureBytes, err := hex.DecodeString(strings.Repeat("01", 65))
+	require.NoError(t, err)
+
+	sigsV2 := make([]signing.SignatureV2, 2)
+	for i := range sigsV2 {
+		_, privKey := utiltx.NewAddrKey()
+		sigsV2[i] = signing.SignatureV2{
+			PubKey: privKey.PubKey(),
+			Data: &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
+				Signature: signatureBytes,
+			},
+			Sequence: uint64(i),
+		}
+	}
+
+	err = txBuilder.SetSignatures(sigsV2...)
+	require.NoError(t, err)
+
+	err = eip712.PreprocessLedgerTx(
+		chainID,
+		keyring.TypeLedger,
+		txBuilder,
+	)
+	require.NoError(t, err)
+
+	// Multi-signer transactions keep their signatures and no Web3 extension is set
+	hasExtOptsTx, ok := txBuilder.(ante.HasExtensionOptionsTx)
+	require.True(t, ok)
+	require.Empty(t, hasExtOptsTx.GetExtensionOptions())
+
+	signatures, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, sigsV2, signatures)
+}
+
 func TestInvalidChainId(t *testing.T) {
 	txBuilder := ctx.TxConfig.NewTxBuilder()
 
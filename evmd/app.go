@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 
+	revenueprecompile "github.com/cosmos/evm/precompiles/revenue"
 	precompiletypes "github.com/cosmos/evm/precompiles/types"
 
 	"os"
+	"sort"
 
 	"github.com/spf13/cast"
 
@@ -26,6 +28,7 @@ import (
 	evmosencoding "github.com/cosmos/evm/encoding"
 	evmmempool "github.com/cosmos/evm/mempool"
 	srvflags "github.com/cosmos/evm/server/flags"
+	evmstreaming "github.com/cosmos/evm/streaming"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20"
 	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
@@ -35,6 +38,8 @@ import (
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	ibccallbackskeeper "github.com/cosmos/evm/x/ibc/callbacks/keeper"
+	"github.com/cosmos/evm/x/ibc/evmhooks"
+	evmhookskeeper "github.com/cosmos/evm/x/ibc/evmhooks/keeper"
 
 	// NOTE: override ICS20 keeper to support IBC transfers of ERC20 tokens
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
@@ -44,10 +49,20 @@ import (
 	"github.com/cosmos/evm/x/precisebank"
 	precisebankkeeper "github.com/cosmos/evm/x/precisebank/keeper"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	"github.com/cosmos/evm/x/revenue"
+	revenuekeeper "github.com/cosmos/evm/x/revenue/keeper"
+	revenuetypes "github.com/cosmos/evm/x/revenue/types"
 	"github.com/cosmos/evm/x/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/parallel"
+	"github.com/cosmos/evm/x/vm/store/querycache"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -64,6 +79,7 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/evidence"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
@@ -114,6 +130,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/consensus"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -164,6 +183,10 @@ type EVMD struct {
 
 	pendingTxListeners []evmante.PendingTxListener
 
+	// postHandler is kept to execute the transactions the same way when
+	// prefetching them
+	postHandler sdk.PostHandler
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -172,6 +195,7 @@ type EVMD struct {
 	// keepers
 	AccountKeeper         authkeeper.AccountKeeper
 	BankKeeper            bankkeeper.Keeper
+	CrisisKeeper          *crisiskeeper.Keeper
 	StakingKeeper         *stakingkeeper.Keeper
 	SlashingKeeper        slashingkeeper.Keeper
 	MintKeeper            mintkeeper.Keeper
@@ -184,15 +208,18 @@ type EVMD struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// IBC keepers
-	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
-	TransferKeeper transferkeeper.Keeper
-	CallbackKeeper ibccallbackskeeper.ContractKeeper
+	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper      transferkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	CallbackKeeper      ibccallbackskeeper.ContractKeeper
+	EVMHooksKeeper      evmhookskeeper.Keeper
 
 	// Cosmos EVM keepers
 	FeeMarketKeeper   feemarketkeeper.Keeper
 	EVMKeeper         *evmkeeper.Keeper
 	Erc20Keeper       erc20keeper.Keeper
 	PreciseBankKeeper precisebankkeeper.Keeper
+	RevenueKeeper     revenuekeeper.Keeper
 	EVMMempool        *evmmempool.ExperimentalEVMMempool
 
 	// the module manager
@@ -271,12 +298,13 @@ func NewExampleApp(
 	keys := storetypes.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
-		govtypes.StoreKey, consensusparamtypes.StoreKey,
+		govtypes.StoreKey, consensusparamtypes.StoreKey, crisistypes.StoreKey,
 		upgradetypes.StoreKey, feegrant.StoreKey, evidencetypes.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey,
 		// Cosmos EVM store keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey, erc20types.StoreKey, precisebanktypes.StoreKey,
+		revenuetypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -387,6 +415,19 @@ func NewExampleApp(
 		authAddr,
 	)
 
+	// the registered invariants are asserted every inv-check-period blocks, halting
+	// the chain if any of them is broken
+	invCheckPeriod := cast.ToUint(appOpts.Get(sdkserver.FlagInvCheckPeriod))
+	app.CrisisKeeper = crisiskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[crisistypes.StoreKey]),
+		invCheckPeriod,
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+		authAddr,
+		app.AccountKeeper.AddressCodec(),
+	)
+
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
 
 	// register the staking hooks
@@ -456,11 +497,6 @@ func NewExampleApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	// Cosmos EVM keepers
-	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
-		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
-		keys[feemarkettypes.StoreKey],
-		tkeys[feemarkettypes.TransientKey],
-	)
 
 	// Set up PreciseBank keeper
 	//
@@ -472,6 +508,16 @@ func NewExampleApp(
 		app.AccountKeeper,
 	)
 
+	// NOTE: the fee market keeper uses PreciseBank to handle the base fees collected
+	// in the EVM extended denomination
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		keys[feemarkettypes.StoreKey],
+		tkeys[feemarkettypes.TransientKey],
+		app.PreciseBankKeeper,
+		app.DistrKeeper,
+	)
+
 	// Set up EVM keeper
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 
@@ -487,19 +533,44 @@ func NewExampleApp(
 		&app.ConsensusParamsKeeper,
 		&app.Erc20Keeper,
 		tracer,
-	).WithStaticPrecompiles(
-		precompiletypes.DefaultStaticPrecompiles(
-			*app.StakingKeeper,
-			app.DistrKeeper,
-			app.PreciseBankKeeper,
-			&app.Erc20Keeper,
-			&app.TransferKeeper,
-			app.IBCKeeper.ChannelKeeper,
-			app.GovKeeper,
-			app.SlashingKeeper,
-			appCodec,
-		),
 	)
+	app.RevenueKeeper = revenuekeeper.NewKeeper(
+		keys[revenuetypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.PreciseBankKeeper,
+		app.EVMKeeper,
+		authtypes.FeeCollectorName,
+	)
+	// NOTE: the revenue hooks fail open, so that an error distributing the revenue of a
+	// contract never reverts the transactions interacting with it.
+	app.EVMKeeper.RegisterHooks(revenuetypes.ModuleName, 0, evmtypes.HookFailOpen, app.RevenueKeeper.Hooks())
+
+	// NOTE: the static precompiles are set once the EVM keeper is instantiated, since it is
+	// used by the message router and chain info precompiles.
+	precompiles := precompiletypes.DefaultStaticPrecompiles(
+		*app.StakingKeeper,
+		app.DistrKeeper,
+		app.PreciseBankKeeper,
+		&app.Erc20Keeper,
+		&app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		&app.ICAControllerKeeper,
+		app.GovKeeper,
+		app.SlashingKeeper,
+		&app.EvidenceKeeper,
+		app.AuthzKeeper,
+		app.AccountKeeper,
+		vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper),
+		app.EVMKeeper,
+		app.UpgradeKeeper,
+		app.MsgServiceRouter(),
+		appCodec,
+	)
+	revenuePrecompile := revenueprecompile.NewPrecompile(app.RevenueKeeper, app.PreciseBankKeeper)
+	precompiles[revenuePrecompile.Address()] = revenuePrecompile
+	app.EVMKeeper.WithStaticPrecompiles(precompiles)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -525,11 +596,22 @@ func NewExampleApp(
 		authAddr,
 	)
 
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		nil,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authAddr,
+	)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
 			- IBC Callbacks Middleware (with EVM ContractKeeper)
+			- EVM Hooks Middleware
 			- ERC-20 Middleware
 			- IBC Transfer
 
@@ -537,7 +619,7 @@ func NewExampleApp(
 		 	transferKeeper.SendPacket ->  erc20.SendPacket -> callbacks.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> callbacks.OnRecvPacket -> evmhooks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -546,6 +628,12 @@ func NewExampleApp(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	maxCallbackGas := uint64(1_000_000)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	app.EVMHooksKeeper = evmhookskeeper.NewKeeper(
+		app.AccountKeeper,
+		app.EVMKeeper,
+		app.Erc20Keeper,
+	)
+	transferStack = evmhooks.NewIBCMiddleware(app.EVMHooksKeeper, transferStack, maxCallbackGas)
 	app.CallbackKeeper = ibccallbackskeeper.NewKeeper(
 		app.AccountKeeper,
 		app.EVMKeeper,
@@ -560,6 +648,7 @@ func NewExampleApp(
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icacontroller.NewIBCMiddleware(app.ICAControllerKeeper))
 	ibcRouterV2 := ibcapi.NewRouter()
 	ibcRouterV2.AddRoute(ibctransfertypes.ModuleName, transferStackV2)
 
@@ -585,6 +674,7 @@ func NewExampleApp(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, nil),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, nil),
+		crisis.NewAppModule(app.CrisisKeeper, cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants)), nil),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, nil),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, nil),
@@ -600,11 +690,13 @@ func NewExampleApp(
 		ibc.NewAppModule(app.IBCKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
 		transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, nil),
 		// Cosmos EVM modules
 		vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.AccountKeeper.AddressCodec()),
 		feemarket.NewAppModule(app.FeeMarketKeeper),
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper),
 		precisebank.NewAppModule(app.PreciseBankKeeper, app.BankKeeper, app.AccountKeeper),
+		revenue.NewAppModule(app.RevenueKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager which is in charge of setting up basic,
@@ -639,7 +731,7 @@ func NewExampleApp(
 		minttypes.ModuleName,
 
 		// IBC modules
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 
 		// Cosmos EVM BeginBlockers
 		erc20types.ModuleName, feemarkettypes.ModuleName,
@@ -653,25 +745,27 @@ func NewExampleApp(
 		consensusparamtypes.ModuleName,
 		precisebanktypes.ModuleName,
 		vestingtypes.ModuleName,
+		revenuetypes.ModuleName,
 	)
 
 	// NOTE: the feemarket module should go last in order of end blockers that are actually doing something,
 	// to get the full block gas used.
 	app.ModuleManager.SetOrderEndBlockers(
-		govtypes.ModuleName, stakingtypes.ModuleName,
+		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName,
 		authtypes.ModuleName, banktypes.ModuleName,
 
 		// Cosmos EVM EndBlockers
 		evmtypes.ModuleName, erc20types.ModuleName, feemarkettypes.ModuleName,
 
 		// no-ops
-		ibcexported.ModuleName, ibctransfertypes.ModuleName,
+		ibcexported.ModuleName, ibctransfertypes.ModuleName, icatypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName, minttypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, consensusparamtypes.ModuleName,
 		precisebanktypes.ModuleName,
 		vestingtypes.ModuleName,
+		revenuetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -691,10 +785,14 @@ func NewExampleApp(
 		feemarkettypes.ModuleName,
 		erc20types.ModuleName,
 		precisebanktypes.ModuleName,
+		revenuetypes.ModuleName,
 
-		ibctransfertypes.ModuleName,
+		ibctransfertypes.ModuleName, icatypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
 		feegrant.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+
+		// NOTE: crisis module must go at the end to check for invariants on each module
+		crisistypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -702,6 +800,7 @@ func NewExampleApp(
 	// Uncomment if you want to set a custom migration order here.
 	// app.ModuleManager.SetOrderMigrations(custom order)
 
+	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	if err = app.ModuleManager.RegisterServices(app.configurator); err != nil {
 		panic(fmt.Sprintf("failed to register services in module manager: %s", err.Error()))
@@ -737,7 +836,15 @@ func NewExampleApp(
 	app.MountKVStores(keys)
 	app.MountTransientStores(tkeys)
 
+	// cache the EVM state read by the queries against the committed state if enabled
+	if queryCacheConfig := evmconfig.GetQueryCacheConfig(appOpts); queryCacheConfig.Enabled() {
+		if rs, ok := app.CommitMultiStore().(*rootmulti.Store); ok {
+			app.SetQueryMultiStore(querycache.NewMultiStore(rs, queryCacheConfig, querycache.DefaultRoutes()))
+		}
+	}
+
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
+	rejectOversizedTxs := cast.ToBool(appOpts.Get(srvflags.EVMRejectOversizedTxs))
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
@@ -745,7 +852,7 @@ func NewExampleApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	app.setAnteHandler(app.txConfig, maxGasWanted)
+	app.setAnteHandler(app.txConfig, maxGasWanted, rejectOversizedTxs)
 
 	// set the EVM priority nonce mempool
 	// If you wish to use the noop mempool, remove this codeblock
@@ -756,9 +863,11 @@ func NewExampleApp(
 		mipTip := evmconfig.GetMinTip(appOpts, logger)
 
 		mempoolConfig := &evmmempool.EVMMempoolConfig{
-			AnteHandler:   app.GetAnteHandler(),
-			BlockGasLimit: blockGasLimit,
-			MinTip:        mipTip,
+			LegacyPoolConfig: evmconfig.GetLegacyPoolConfig(appOpts, logger),
+			AnteHandler:      app.GetAnteHandler(),
+			BlockGasLimit:    blockGasLimit,
+			MinTip:           mipTip,
+			SenderGasQuota:   evmconfig.GetSenderGasQuotaConfig(appOpts, logger),
 		}
 
 		evmMempool := evmmempool.NewExperimentalEVMMempool(app.CreateQueryContext, logger, app.EVMKeeper, app.FeeMarketKeeper, app.txConfig, app.clientCtx, mempoolConfig)
@@ -773,6 +882,20 @@ func NewExampleApp(
 		app.SetPrepareProposal(abciProposalHandler.PrepareProposalHandler())
 	}
 
+	// stream the committed Ethereum-formatted blocks and receipts if enabled
+	if streamingFile := evmconfig.GetStreamingFile(appOpts, logger); streamingFile != "" {
+		publisher, err := evmstreaming.NewFilePublisher(streamingFile)
+		if err != nil {
+			panic(fmt.Errorf("failed to open the EVM streaming file: %w", err))
+		}
+		streamingManager := app.StreamingManager()
+		streamingManager.ABCIListeners = append(
+			streamingManager.ABCIListeners,
+			evmstreaming.NewABCIListener(app.txConfig.TxDecoder(), app.EVMKeeper, publisher, logger),
+		)
+		app.SetStreamingManager(streamingManager)
+	}
+
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
 	// defined as a chain, and have the same signature as antehandlers.
@@ -793,6 +916,11 @@ func NewExampleApp(
 	// upgrade.
 	app.setPostHandler()
 
+	// prefetch the transactions of the finalized blocks concurrently if enabled
+	if workers := evmconfig.GetParallelExecutionWorkers(appOpts); workers > 0 {
+		app.setPrefetcher(workers)
+	}
+
 	// At startup, after all modules have been registered, check that all prot
 	// annotations are correct.
 	protoFiles, err := proto.MergedRegistry()
@@ -816,19 +944,21 @@ func NewExampleApp(
 	return app
 }
 
-func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, rejectOversizedTxs bool) {
 	options := evmante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
 		BankKeeper:             app.BankKeeper,
 		ExtensionOptionChecker: cosmosevmtypes.HasDynamicFeeExtensionOption,
 		EvmKeeper:              app.EVMKeeper,
+		Erc20Keeper:            app.Erc20Keeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
 		IBCKeeper:              app.IBCKeeper,
 		FeeMarketKeeper:        app.FeeMarketKeeper,
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         evmante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
+		RejectOversizedTxs:     rejectOversizedTxs,
 		TxFeeChecker:           cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
 		PendingTxListener:      app.onPendingTx,
 	}
@@ -858,15 +988,56 @@ func (app *EVMD) setPostHandler() {
 		panic(err)
 	}
 
+	app.postHandler = postHandler
 	app.SetPostHandler(postHandler)
 }
 
+// setPrefetcher sets the prefetcher executing the transactions of each
+// finalized block concurrently at the beginning of the block, so that the EVM
+// reuses their results during the serial execution of the block.
+func (app *EVMD) setPrefetcher(workers int) {
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return
+	}
+	keysByName := rs.StoreKeysByName()
+	storeKeys := make([]storetypes.StoreKey, 0, len(keysByName))
+	for _, key := range keysByName {
+		storeKeys = append(storeKeys, key)
+	}
+	sort.Slice(storeKeys, func(i, j int) bool { return storeKeys[i].Name() < storeKeys[j].Name() })
+
+	// the index of the next Ethereum transaction and log of the block are
+	// increased by every transaction
+	transientKey := app.GetTKey(evmtypes.TransientKey)
+	counters := []parallel.Counter{
+		{Key: transientKey, Path: evmtypes.KeyPrefixTransientTxIndex},
+		{Key: transientKey, Path: evmtypes.KeyPrefixTransientLogSize},
+	}
+
+	app.EVMKeeper.SetPrefetcher(parallel.NewPrefetcher(storeKeys, counters, workers, parallel.PrefetcherOptions{
+		TxDecoder:   app.txConfig.TxDecoder(),
+		AnteHandler: app.AnteHandler(),
+		PostHandler: app.postHandler,
+		Router:      app.MsgServiceRouter(),
+	}))
+}
+
 // Name returns the name of the App
 func (app *EVMD) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *EVMD) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
-	return app.ModuleManager.BeginBlock(ctx)
+	res, err := app.ModuleManager.BeginBlock(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	// prefetch the transactions of the block on the state left by the begin blockers
+	if prefetcher := app.EVMKeeper.GetPrefetcher(); prefetcher != nil {
+		prefetcher.Prefetch(ctx)
+	}
+	return res, nil
 }
 
 // EndBlocker application updates every end block
@@ -896,7 +1067,10 @@ func (app *EVMD) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci
 	return app.ModuleManager.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
-func (app *EVMD) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *EVMD) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	if prefetcher := app.EVMKeeper.GetPrefetcher(); prefetcher != nil {
+		prefetcher.SetBlock(req.GetTxs())
+	}
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -938,6 +1112,9 @@ func (app *EVMD) DefaultGenesis() map[string]json.RawMessage {
 	mintGenState := NewMintGenesisState()
 	genesis[minttypes.ModuleName] = app.appCodec.MustMarshalJSON(mintGenState)
 
+	crisisGenState := NewCrisisGenesisState()
+	genesis[crisistypes.ModuleName] = app.appCodec.MustMarshalJSON(crisisGenState)
+
 	evmGenState := NewEVMGenesisState()
 	genesis[evmtypes.ModuleName] = app.appCodec.MustMarshalJSON(evmGenState)
 
@@ -1025,6 +1202,11 @@ func (app *EVMD) GetBaseApp() *baseapp.BaseApp {
 	return app.BaseApp
 }
 
+// GetCrisisKeeper returns the crisis keeper asserting the registered invariants.
+func (app *EVMD) GetCrisisKeeper() *crisiskeeper.Keeper {
+	return app.CrisisKeeper
+}
+
 // GetStakingKeeperSDK implements the TestingApp interface.
 func (app *EVMD) GetStakingKeeperSDK() stakingkeeper.Keeper {
 	return *app.StakingKeeper
@@ -1099,6 +1281,10 @@ func (app *EVMD) GetPreciseBankKeeper() *precisebankkeeper.Keeper {
 	return &app.PreciseBankKeeper
 }
 
+func (app *EVMD) GetRevenueKeeper() *revenuekeeper.Keeper {
+	return &app.RevenueKeeper
+}
+
 func (app *EVMD) GetCallbackKeeper() ibccallbackskeeper.ContractKeeper {
 	return app.CallbackKeeper
 }
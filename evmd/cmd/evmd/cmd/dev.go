@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+
+	cosmosevmhd "github.com/cosmos/evm/crypto/hd"
+	cosmosevmkeyring "github.com/cosmos/evm/crypto/keyring"
+	"github.com/cosmos/evm/evmd"
+	cosmosevmserver "github.com/cosmos/evm/server"
+	"github.com/cosmos/evm/server/dev"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// devChainID is the chain id of the development chains.
+	devChainID = "local-dev"
+	// devValidatorName is the keyring name of the development chain validator.
+	devValidatorName = "validator"
+	// devBlockMaxGas is the block gas limit of the development chains, which is
+	// the default one of Hardhat and Anvil.
+	devBlockMaxGas = 30_000_000
+)
+
+// newDevInitializer returns the initializer of the development chains started
+// with --dev. The genesis has a single validator, funds the dev accounts with
+// 10000 tokens each, and uses the EVM denom as the only denom of the chain. The
+// dev accounts are imported in the test keyring of the node home as dev0, dev1,
+// etc.
+func newDevInitializer(evmApp *evmd.EVMD) cosmosevmserver.DevInitializer {
+	return func(cfg *cmtcfg.Config, accounts []dev.Account) error {
+		cdc := evmApp.AppCodec()
+		txConfig := evmApp.TxConfig()
+
+		_, valPubKey, err := genutil.InitializeNodeValidatorFiles(cfg)
+		if err != nil {
+			return err
+		}
+
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, cfg.RootDir, nil, cdc, cosmosevmkeyring.Option())
+		if err != nil {
+			return err
+		}
+
+		var (
+			genAccounts []authtypes.GenesisAccount
+			genBalances []banktypes.Balance
+		)
+		fund := func(addr sdk.AccAddress, amount math.Int) {
+			genAccounts = append(genAccounts, authtypes.NewBaseAccount(addr, nil, 0, 0))
+			genBalances = append(genBalances, banktypes.Balance{
+				Address: addr.String(),
+				Coins:   sdk.NewCoins(sdk.NewCoin(TEST_DENOM, amount)),
+			})
+		}
+
+		for i, account := range accounts {
+			name := fmt.Sprintf("dev%d", i)
+			if err := kb.ImportPrivKeyHex(name, hex.EncodeToString(account.PrivKey.Bytes()), string(cosmosevmhd.EthSecp256k1Type)); err != nil {
+				return fmt.Errorf("failed to import the dev account %d: %w", i, err)
+			}
+			fund(account.Address.Bytes(), sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
+		}
+
+		// the validator has its own account, so that the nonces of the dev accounts
+		// start at zero
+		valAddr, _, err := testutil.GenerateSaveCoinKey(kb, devValidatorName, "", true, cosmosevmhd.EthSecp256k1)
+		if err != nil {
+			return err
+		}
+		fund(valAddr, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction))
+
+		appGenState := evmApp.DefaultGenesis()
+		if err := setDevGenesisState(cdc, appGenState, genAccounts, genBalances); err != nil {
+			return err
+		}
+
+		createValMsg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(valAddr).String(),
+			valPubKey,
+			sdk.NewCoin(TEST_DENOM, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)),
+			stakingtypes.NewDescription(devValidatorName, "", "", "", ""),
+			stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+			math.OneInt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		txBuilder := txConfig.NewTxBuilder()
+		if err := txBuilder.SetMsgs(createValMsg); err != nil {
+			return err
+		}
+
+		txFactory := tx.Factory{}.
+			WithChainID(devChainID).
+			WithKeybase(kb).
+			WithTxConfig(txConfig)
+		if err := tx.Sign(context.Background(), txFactory, devValidatorName, txBuilder, true); err != nil {
+			return err
+		}
+
+		appGenState, err = genutil.SetGenTxsInAppGenesisState(cdc, txConfig.TxJSONEncoder(), appGenState, []sdk.Tx{txBuilder.GetTx()})
+		if err != nil {
+			return err
+		}
+
+		appState, err := json.MarshalIndent(appGenState, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		consensusParams := cmttypes.DefaultConsensusParams()
+		consensusParams.Block.MaxGas = devBlockMaxGas
+
+		appGenesis := genutiltypes.NewAppGenesisWithVersion(devChainID, appState)
+		appGenesis.GenesisTime = cmttime.Now()
+		appGenesis.Consensus.Params = consensusParams
+
+		return genutil.ExportGenesisFile(appGenesis, cfg.GenesisFile())
+	}
+}
+
+// setDevGenesisState sets the accounts and balances in the genesis state, and the
+// EVM denom as the staking, minting and governance denom.
+func setDevGenesisState(
+	cdc codec.JSONCodec,
+	appGenState map[string]json.RawMessage,
+	genAccounts []authtypes.GenesisAccount,
+	genBalances []banktypes.Balance,
+) error {
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+	accounts, err := authtypes.PackAccounts(genAccounts)
+	if err != nil {
+		return err
+	}
+	authGenState.Accounts = accounts
+	appGenState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenState)
+
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[banktypes.ModuleName], &bankGenState)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(genBalances)
+	for _, bal := range bankGenState.Balances {
+		bankGenState.Supply = bankGenState.Supply.Add(bal.Coins...)
+	}
+	bankGenState.DenomMetadata = []banktypes.Metadata{{
+		Description: "The native staking token for evmd.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: TEST_DENOM, Exponent: 0, Aliases: []string{"attotest"}},
+			{Denom: "test", Exponent: 18},
+		},
+		Base:    TEST_DENOM,
+		Display: "test",
+		Name:    "Test Token",
+		Symbol:  "TEST",
+	}}
+	appGenState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenState)
+
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[stakingtypes.ModuleName], &stakingGenState)
+	stakingGenState.Params.BondDenom = TEST_DENOM
+	appGenState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
+
+	var mintGenState minttypes.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[minttypes.ModuleName], &mintGenState)
+	mintGenState.Params.MintDenom = TEST_DENOM
+	appGenState[minttypes.ModuleName] = cdc.MustMarshalJSON(&mintGenState)
+
+	var govGenState govv1.GenesisState
+	cdc.MustUnmarshalJSON(appGenState[govtypes.ModuleName], &govGenState)
+	for i := range govGenState.Params.MinDeposit {
+		govGenState.Params.MinDeposit[i].Denom = TEST_DENOM
+	}
+	for i := range govGenState.Params.ExpeditedMinDeposit {
+		govGenState.Params.ExpeditedMinDeposit[i].Denom = TEST_DENOM
+	}
+	appGenState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	cosmosevmcmd "github.com/cosmos/evm/client"
+	cosmosevmgenesis "github.com/cosmos/evm/client/genesis"
 	cosmosevmkeyring "github.com/cosmos/evm/crypto/keyring"
 	"github.com/cosmos/evm/evmd"
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
@@ -45,6 +46,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
@@ -176,11 +178,17 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 	sdkAppCreator := func(l log.Logger, d dbm.DB, w io.Writer, ao servertypes.AppOptions) servertypes.Application {
 		return newApp(l, d, w, ao)
 	}
+
+	// add the Cosmos EVM block replay to the debug commands
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(cosmosevmserver.NewReplayBlockCmd(appReplay))
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(evmApp.BasicModuleManager, defaultNodeHome),
 		genutilcli.Commands(evmApp.TxConfig(), evmApp.BasicModuleManager, defaultNodeHome),
+		cosmosevmgenesis.MigrateGenesisCmd(),
 		cmtcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(sdkAppCreator, defaultNodeHome),
 		snapshot.Cmd(sdkAppCreator),
@@ -188,9 +196,12 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 	)
 
 	// add Cosmos EVM' flavored TM commands to start server, etc.
+	startOpts := cosmosevmserver.NewDefaultStartOptions(newApp, defaultNodeHome)
+	startOpts.EVMExporter = appExportEVM
+	startOpts.DevInitializer = newDevInitializer(evmApp)
 	cosmosevmserver.AddCommands(
 		rootCmd,
-		cosmosevmserver.NewDefaultStartOptions(newApp, defaultNodeHome),
+		startOpts,
 		appExport,
 		addModuleInitFlags,
 	)
@@ -215,7 +226,9 @@ func initRootCmd(rootCmd *cobra.Command, evmApp *evmd.EVMD) {
 	}
 }
 
-func addModuleInitFlags(_ *cobra.Command) {}
+func addModuleInitFlags(startCmd *cobra.Command) {
+	crisis.AddModuleInitFlags(startCmd)
+}
 
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -373,6 +386,56 @@ func appExport(
 	return exampleApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 }
 
+// appExportEVM creates a new application (optionally at a given height) and
+// streams the evm module state.
+func appExportEVM(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+	w io.Writer,
+) error {
+	// get the chain id
+	chainID, err := getChainIDFromOpts(appOpts)
+	if err != nil {
+		return err
+	}
+
+	loadLatest := height == -1
+	exampleApp := evmd.NewExampleApp(logger, db, traceStore, loadLatest, appOpts, evmdconfig.EVMChainID, evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
+	if !loadLatest {
+		if err := exampleApp.LoadHeight(height); err != nil {
+			return err
+		}
+	}
+
+	return exampleApp.ExportEVMGenesis(w)
+}
+
+// appReplay creates a new application with the state loaded at the given
+// height, to re-execute the following block.
+func appReplay(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (cosmosevmserver.ReplayApp, error) {
+	// get the chain id
+	chainID, err := getChainIDFromOpts(appOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	exampleApp := evmd.NewExampleApp(logger, db, traceStore, false, appOpts, evmdconfig.EVMChainID, evmdconfig.EvmAppOptions, baseapp.SetChainID(chainID))
+	if err := exampleApp.LoadHeight(height); err != nil {
+		return nil, err
+	}
+
+	return exampleApp, nil
+}
+
 // getChainIDFromOpts returns the chain Id from app Opts
 // It first tries to get from the chainId flag, if not available
 // it will load from home
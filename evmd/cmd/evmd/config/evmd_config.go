@@ -2,6 +2,7 @@ package config
 
 import (
 	"maps"
+	"slices"
 	"sort"
 
 	corevm "github.com/ethereum/go-ethereum/core/vm"
@@ -44,7 +45,7 @@ var maccPerms = map[string][]string{
 
 	// Cosmos EVM modules
 	evmtypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-	feemarkettypes.ModuleName:   nil,
+	feemarkettypes.ModuleName:   {authtypes.Burner},
 	erc20types.ModuleName:       {authtypes.Minter, authtypes.Burner},
 	precisebanktypes.ModuleName: {authtypes.Minter, authtypes.Burner},
 }
@@ -69,7 +70,8 @@ func BlockedAddresses() map[string]bool {
 		blockedAddrs[authtypes.NewModuleAddress(acc).String()] = true
 	}
 
-	blockedPrecompilesHex := evmtypes.AvailableStaticPrecompiles
+	// NOTE: the revenue precompile is registered by the example chain on top of the available ones.
+	blockedPrecompilesHex := append(slices.Clone(evmtypes.AvailableStaticPrecompiles), evmtypes.RevenuePrecompileAddress)
 	for _, addr := range corevm.PrecompiledAddressesPrague {
 		blockedPrecompilesHex = append(blockedPrecompilesHex, addr.Hex())
 	}
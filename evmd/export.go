@@ -3,10 +3,13 @@ package evmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/evm/x/vm"
+
 	storetypes "cosmossdk.io/store/types"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -51,6 +54,13 @@ func (app *EVMD) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddr
 	}, err
 }
 
+// ExportEVMGenesis streams the genesis state of the evm module to the given
+// writer, without exporting the state of the other modules.
+func (app *EVMD) ExportEVMGenesis(w io.Writer) error {
+	ctx := app.NewContextLegacy(true, tmproto.Header{Height: app.LastBlockHeight()})
+	return vm.ExportGenesisTo(ctx, app.EVMKeeper, app.appCodec, w)
+}
+
 // prepare for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
@@ -9,6 +9,7 @@ import (
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -55,6 +56,17 @@ func NewMintGenesisState() *minttypes.GenesisState {
 	return mintGenState
 }
 
+// NewCrisisGenesisState returns the default genesis state for the crisis module.
+//
+// NOTE: for the example chain implementation the constant fee to verify an
+// invariant is paid in the chain denomination.
+func NewCrisisGenesisState() *crisistypes.GenesisState {
+	crisisGenState := crisistypes.DefaultGenesisState()
+	crisisGenState.ConstantFee.Denom = config.ExampleChainDenom
+
+	return crisisGenState
+}
+
 // NewFeeMarketGenesisState returns the default genesis state for the feemarket module.
 //
 // NOTE: for the example chain implementation we are disabling the base fee.
@@ -0,0 +1,204 @@
+package evmd
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/evmd/cmd/evmd/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+	testconfig "github.com/cosmos/evm/testutil/config"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const parallelChainID = "parallel-1"
+
+// TestParallelExecutionDeterminism finalizes the same blocks on an application
+// executing the transactions serially and on one prefetching them with the
+// parallel executor, and checks that they commit the same app hashes and
+// results.
+func TestParallelExecutionDeterminism(t *testing.T) {
+	newApp := func(workers int) *EVMD {
+		appOptions := simtestutil.AppOptionsMap{
+			flags.FlagHome:                       t.TempDir(),
+			srvflags.EVMParallelExecutionWorkers: workers,
+		}
+		return NewExampleApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, config.EVMChainID, testconfig.EvmAppOptions, baseapp.SetChainID(parallelChainID))
+	}
+	serialApp := newApp(0)
+	parallelApp := newApp(4)
+	require.Nil(t, serialApp.EVMKeeper.GetPrefetcher())
+	require.NotNil(t, parallelApp.EVMKeeper.GetPrefetcher())
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := cmttypes.NewValidator(pubKey, 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+
+	const senders = 8
+	privs := make([]*ethsecp256k1.PrivKey, senders)
+	accs := make([]authtypes.GenesisAccount, senders)
+	balances := make([]banktypes.Balance, senders)
+	for i := range privs {
+		privs[i], err = ethsecp256k1.GenerateKey()
+		require.NoError(t, err)
+		acc := authtypes.NewBaseAccountWithAddress(privs[i].PubKey().Address().Bytes())
+		accs[i] = acc
+		balances[i] = banktypes.Balance{
+			Address: acc.GetAddress().String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(config.ExampleChainDenom, math.NewIntWithDecimal(1, 18))),
+		}
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(serialApp.AppCodec(), serialApp.DefaultGenesis(), valSet, accs, balances...)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	genesisTime := time.Unix(1_700_000_000, 0).UTC()
+	for _, app := range []*EVMD{serialApp, parallelApp} {
+		_, err = app.InitChain(&abci.RequestInitChain{
+			Time:            genesisTime,
+			ChainId:         parallelChainID,
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+			AppStateBytes:   stateBytes,
+			InitialHeight:   1,
+		})
+		require.NoError(t, err)
+	}
+
+	signTx := func(sender int, nonce uint64, to *common.Address, data []byte, gasLimit uint64) []byte {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  new(big.Int).SetUint64(config.EVMChainID),
+			Nonce:    nonce,
+			To:       to,
+			Amount:   big.NewInt(1000),
+			GasLimit: gasLimit,
+			GasPrice: big.NewInt(10_000_000_000),
+			Input:    data,
+		})
+		require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(new(big.Int).SetUint64(config.EVMChainID)), utiltx.NewSigner(privs[sender])))
+		tx, err := msg.BuildTx(serialApp.TxConfig().NewTxBuilder(), config.ExampleChainDenom)
+		require.NoError(t, err)
+		txBz, err := serialApp.TxConfig().TxEncoder()(tx)
+		require.NoError(t, err)
+		return txBz
+	}
+	transfer := func(sender int, nonce uint64, to common.Address) []byte {
+		return signTx(sender, nonce, &to, nil, 21000)
+	}
+	// the deployed contracts store a value and emit a log from their init code
+	deploy := func(sender int, nonce uint64) []byte {
+		return signTx(sender, nonce, nil, common.FromHex("0x602a60005560006000a000"), 100000)
+	}
+
+	recipient := utiltx.GenerateAddress()
+	blockTxs := map[int64][][]byte{}
+	for i := 0; i < senders; i++ {
+		// independent transfers, then transfers to the same recipient
+		to := utiltx.GenerateAddress()
+		if i >= senders/2 {
+			to = recipient
+		}
+		blockTxs[2] = append(blockTxs[2], transfer(i, 0, to))
+	}
+	for i := 0; i < senders/2; i++ {
+		// contract creations reading the accounts of the preceding transfers
+		blockTxs[2] = append(blockTxs[2], deploy(i, 1))
+	}
+	for i := 0; i < senders; i++ {
+		// contract creations from all the senders
+		nonce := uint64(1)
+		if i < senders/2 {
+			nonce = 2
+		}
+		blockTxs[3] = append(blockTxs[3], deploy(i, nonce))
+	}
+	// a transaction reusing a nonce, failing in the ante handler
+	blockTxs[3] = append(blockTxs[3], transfer(0, 0, recipient))
+
+	var lastBlockID cmttypes.BlockID
+	for height := int64(1); height <= 3; height++ {
+		var lastCommit *cmttypes.Commit
+		if height > 1 {
+			lastCommit = &cmttypes.Commit{
+				Height:  height - 1,
+				BlockID: lastBlockID,
+				Signatures: []cmttypes.CommitSig{{
+					BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+					ValidatorAddress: validator.Address,
+					Timestamp:        genesisTime.Add(time.Duration(height-1) * time.Second),
+				}},
+			}
+		}
+
+		txs := make([]cmttypes.Tx, len(blockTxs[height]))
+		for i, txBz := range blockTxs[height] {
+			txs[i] = txBz
+		}
+		block := cmttypes.MakeBlock(height, txs, lastCommit, nil)
+		block.ChainID = parallelChainID
+		block.Time = genesisTime.Add(time.Duration(height) * time.Second)
+		block.ProposerAddress = validator.Address
+		block.ValidatorsHash = valSet.Hash()
+		block.NextValidatorsHash = valSet.Hash()
+
+		finalize := func(app *EVMD) *abci.ResponseFinalizeBlock {
+			res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Hash:               block.Hash(),
+				NextValidatorsHash: block.NextValidatorsHash,
+				ProposerAddress:    block.ProposerAddress,
+				Height:             block.Height,
+				Time:               block.Time,
+				DecidedLastCommit:  sm.BuildLastCommitInfo(block, valSet, 1),
+				Txs:                block.Txs.ToSliceOfBytes(),
+			})
+			require.NoError(t, err)
+			_, err = app.Commit()
+			require.NoError(t, err)
+			return res
+		}
+		serialRes := finalize(serialApp)
+		parallelRes := finalize(parallelApp)
+
+		require.Equal(t, serialRes.AppHash, parallelRes.AppHash, "app hash at height %d", height)
+		require.Equal(t, serialRes.TxResults, parallelRes.TxResults, "tx results at height %d", height)
+		require.Equal(t, serialRes.Events, parallelRes.Events, "block events at height %d", height)
+
+		// the results of the successful Ethereum transactions are all reused
+		succeeded := 0
+		for _, txResult := range serialRes.TxResults {
+			if txResult.Code == abci.CodeTypeOK {
+				succeeded++
+			}
+		}
+		stats := parallelApp.EVMKeeper.GetPrefetcher().Stats()
+		require.Equal(t, len(txs), stats.Executed)
+		require.Equal(t, succeeded, stats.Reused, "reused results at height %d", height)
+		lastBlockID = cmttypes.BlockID{Hash: block.Hash()}
+	}
+}
@@ -0,0 +1,203 @@
+package evmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/evmd/cmd/evmd/config"
+	cosmosevmserver "github.com/cosmos/evm/server"
+	testconfig "github.com/cosmos/evm/testutil/config"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const replayChainID = "replay-1"
+
+// TestReplayBlock commits blocks with an Ethereum transaction, then replays
+// one of them on an application loaded at the previous height, as done by the
+// debug replay-block command, and checks that tampered results are detected.
+func TestReplayBlock(t *testing.T) {
+	db := dbm.NewMemDB()
+	appOptions := simtestutil.NewAppOptionsWithFlagHome(t.TempDir())
+	newApp := func(loadLatest bool) *EVMD {
+		return NewExampleApp(log.NewNopLogger(), db, nil, loadLatest, appOptions, config.EVMChainID, testconfig.EvmAppOptions, baseapp.SetChainID(replayChainID))
+	}
+	app := newApp(true)
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := cmttypes.NewValidator(pubKey, 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	acc := authtypes.NewBaseAccountWithAddress(priv.PubKey().Address().Bytes())
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(config.ExampleChainDenom, math.NewIntWithDecimal(1, 18))),
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), app.DefaultGenesis(), valSet, []authtypes.GenesisAccount{acc}, balance)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	genesisTime := time.Unix(1_700_000_000, 0).UTC()
+	_, err = app.InitChain(&abci.RequestInitChain{
+		Time:            genesisTime,
+		ChainId:         replayChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+		InitialHeight:   1,
+	})
+	require.NoError(t, err)
+
+	// an Ethereum transfer executed in the replayed block
+	to := utiltx.GenerateAddress()
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  new(big.Int).SetUint64(config.EVMChainID),
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(10_000_000_000),
+	})
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(new(big.Int).SetUint64(config.EVMChainID)), utiltx.NewSigner(priv)))
+	tx, err := msg.BuildTx(app.TxConfig().NewTxBuilder(), config.ExampleChainDenom)
+	require.NoError(t, err)
+	txBz, err := app.TxConfig().TxEncoder()(tx)
+	require.NoError(t, err)
+
+	// commit the blocks the same way CometBFT does, keeping them along with
+	// their results
+	blocks := make(map[int64]*cmttypes.Block)
+	results := make(map[int64]*abci.ResponseFinalizeBlock)
+	var lastBlockID cmttypes.BlockID
+	for height := int64(1); height <= 3; height++ {
+		var (
+			txs        []cmttypes.Tx
+			lastCommit *cmttypes.Commit
+		)
+		if height == 2 {
+			txs = []cmttypes.Tx{txBz}
+		}
+		if height > 1 {
+			lastCommit = &cmttypes.Commit{
+				Height:  height - 1,
+				BlockID: lastBlockID,
+				Signatures: []cmttypes.CommitSig{{
+					BlockIDFlag:      cmttypes.BlockIDFlagCommit,
+					ValidatorAddress: validator.Address,
+					Timestamp:        genesisTime.Add(time.Duration(height-1) * time.Second),
+				}},
+			}
+		}
+
+		block := cmttypes.MakeBlock(height, txs, lastCommit, nil)
+		block.ChainID = replayChainID
+		block.Time = genesisTime.Add(time.Duration(height) * time.Second)
+		block.ProposerAddress = validator.Address
+		block.ValidatorsHash = valSet.Hash()
+		block.NextValidatorsHash = valSet.Hash()
+
+		res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Hash:               block.Hash(),
+			NextValidatorsHash: block.NextValidatorsHash,
+			ProposerAddress:    block.ProposerAddress,
+			Height:             block.Height,
+			Time:               block.Time,
+			DecidedLastCommit:  sm.BuildLastCommitInfo(block, valSet, 1),
+			Txs:                block.Txs.ToSliceOfBytes(),
+		})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+
+		blocks[height] = block
+		results[height] = res
+		lastBlockID = cmttypes.BlockID{Hash: block.Hash()}
+	}
+	require.Len(t, results[2].TxResults, 1)
+	require.Equal(t, abci.CodeTypeOK, results[2].TxResults[0].Code, results[2].TxResults[0].Log)
+
+	// replay re-executes the block on an application loaded at the previous height
+	replay := func(block *cmttypes.Block, stored *abci.ResponseFinalizeBlock) *cosmosevmserver.ReplayResult {
+		replayApp := newApp(false)
+		require.NoError(t, replayApp.LoadHeight(block.Height-1))
+		defer replayApp.Close()
+
+		res, err := cosmosevmserver.ReplayBlock(replayApp, block, valSet, 1, stored)
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("replayed block matches the stored results", func(t *testing.T) {
+		res := replay(blocks[2], results[2])
+		require.True(t, res.Matches(), "store diffs: %v, tx diffs: %v", res.StoreDiffs, res.TxDiffs)
+		require.Equal(t, results[2].AppHash, res.AppHash)
+		require.Equal(t, res.AppHash, res.StoredAppHash)
+	})
+
+	t.Run("store hashes are compared without the stored results", func(t *testing.T) {
+		res := replay(blocks[2], nil)
+		require.True(t, res.Matches())
+		require.Empty(t, res.TxDiffs)
+	})
+
+	t.Run("tampered tx results are detected", func(t *testing.T) {
+		stored := *results[2]
+		txResult := *stored.TxResults[0]
+		txResult.GasUsed++
+		stored.TxResults = []*abci.ExecTxResult{&txResult}
+
+		res := replay(blocks[2], &stored)
+		require.False(t, res.Matches())
+		require.Empty(t, res.StoreDiffs)
+		require.Equal(t, []cosmosevmserver.TxResultDiff{{
+			Index:    0,
+			Field:    "gas_used",
+			Stored:   fmt.Sprint(txResult.GasUsed),
+			Replayed: fmt.Sprint(results[2].TxResults[0].GasUsed),
+		}}, res.TxDiffs)
+	})
+
+	t.Run("tampered block is detected", func(t *testing.T) {
+		// drop the transaction from the block, so that its execution differs
+		block := cmttypes.MakeBlock(2, nil, blocks[2].LastCommit, nil)
+		block.Header = blocks[2].Header
+
+		res := replay(block, results[2])
+		require.False(t, res.Matches())
+		require.NotEqual(t, res.StoredAppHash, res.AppHash)
+		require.NotEmpty(t, res.StoreDiffs)
+		require.Equal(t, []cosmosevmserver.TxResultDiff{{
+			Index:    -1,
+			Field:    "tx_results",
+			Stored:   "1",
+			Replayed: "0",
+		}}, res.TxDiffs)
+	})
+}
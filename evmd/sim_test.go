@@ -0,0 +1,183 @@
+package evmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/evmd/cmd/evmd/config"
+	testconfig "github.com/cosmos/evm/testutil/config"
+	erc20keeper "github.com/cosmos/evm/x/erc20/keeper"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	vmkeeper "github.com/cosmos/evm/x/vm/keeper"
+	vmsimulation "github.com/cosmos/evm/x/vm/simulation"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+)
+
+// simChainID is the Cosmos chain ID used by the simulations
+const simChainID = "simulation-app"
+
+func init() {
+	simcli.GetSimulatorFlags()
+
+	// the simulated accounts are funded with the bond denomination, which has
+	// to be the EVM denomination to pay for the Ethereum transactions
+	sdk.DefaultBondDenom = config.ExampleChainDenom
+}
+
+// TestFullAppSimulation runs the weighted operations of all the modules,
+// including the EVM ones, on randomized genesis states and checks the EVM
+// invariants at the end of the simulation.
+//
+// Run with: go test -tags=test -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50
+func TestFullAppSimulation(t *testing.T) {
+	simConfig := simcli.NewConfigFromFlags()
+	simConfig.ChainID = simChainID
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(simConfig, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = dir
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+	app := NewExampleApp(logger, db, nil, true, appOptions, config.EVMChainID, testconfig.EvmAppOptions, baseapp.SetChainID(simChainID))
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), simGenesisState(app)),
+		vmsimulation.RandomAccounts,
+		simOperations(app),
+		config.BlockedAddresses(),
+		simConfig,
+		app.AppCodec(),
+	)
+	require.NoError(t, simErr)
+	require.NoError(t, simtestutil.CheckExportSimulation(app, simConfig, simParams))
+
+	ctx := app.NewContext(true)
+	for name, invariant := range map[string]sdk.Invariant{
+		"evm":   vmkeeper.AllInvariants(app.EVMKeeper),
+		"erc20": erc20keeper.AllInvariants(app.Erc20Keeper),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, "%s invariant broken: %s", name, msg)
+	}
+
+	if simConfig.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
+// simGenesisState returns the default genesis state of the app with the base
+// fee disabled, since the operations of the Cosmos SDK modules pay random fees.
+func simGenesisState(app *EVMD) GenesisState {
+	genesis := app.DefaultGenesis()
+	genesis[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(NewFeeMarketGenesisState())
+
+	return genesis
+}
+
+// simOperations returns the weighted operations of all the modules. Unlike
+// simtestutil.BuildSimulationOperations, it doesn't include the legacy
+// governance proposals, since the app doesn't register a legacy gov router.
+func simOperations(app *EVMD) []simtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simtypes.AppParams),
+		Cdc:       app.AppCodec(),
+		TxConfig:  app.TxConfig(),
+		BondDenom: sdk.DefaultBondDenom,
+	}
+	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
+
+	return app.SimulationManager().WeightedOperations(simState)
+}
+
+// TestAppStateDeterminism runs the same simulations several times and checks
+// that the resulting application hashes match.
+//
+// Run with: go test -tags=test -run TestAppStateDeterminism -Enabled=true -NumBlocks=20 -BlockSize=50
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	simConfig := simcli.NewConfigFromFlags()
+	simConfig.ChainID = simChainID
+	simConfig.AllInvariants = false
+	simConfig.DBBackend = "memdb"
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 3
+	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		simConfig.Seed = rand.Int63() //nolint:gosec // the seeds don't need to be secure
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			db, dir, _, _, err := simtestutil.SetupSimulation(simConfig, "leveldb-app-sim", "Simulation", false, true)
+			require.NoError(t, err)
+
+			appOptions := make(simtestutil.AppOptionsMap, 0)
+			appOptions[flags.FlagHome] = dir
+			appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
+
+			app := NewExampleApp(log.NewNopLogger(), db, nil, true, appOptions, config.EVMChainID, testconfig.EvmAppOptions, baseapp.SetChainID(simChainID))
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				simConfig.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err = simulation.SimulateFromSeed(
+				t,
+				os.Stdout,
+				app.BaseApp,
+				simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), simGenesisState(app)),
+				vmsimulation.RandomAccounts,
+				simOperations(app),
+				config.BlockedAddresses(),
+				simConfig,
+				app.AppCodec(),
+			)
+			require.NoError(t, err)
+
+			appHashList[j] = app.LastCommitID().Hash
+
+			require.NoError(t, db.Close())
+			require.NoError(t, os.RemoveAll(dir))
+
+			if j != 0 {
+				require.Equal(
+					t, string(appHashList[0]), string(appHashList[j]),
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", simConfig.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
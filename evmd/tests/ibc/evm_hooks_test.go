@@ -0,0 +1,184 @@
+package ibc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/testutil"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/erc20/types"
+	testutil2 "github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	evmhookstypes "github.com/cosmos/evm/x/ibc/evmhooks/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestOnRecvPacketWithEVMHook checks the execution of the EVM hooks set in the memo of the received transfers.
+func (suite *MiddlewareTestSuite) TestOnRecvPacketWithEVMHook() {
+	var (
+		contractAddr  common.Address
+		erc20Contract common.Address
+		receiver      string
+	)
+	contractData, err := testutil2.LoadCounterWithCallbacksContract()
+	suite.Require().NoError(err)
+
+	addCalldata := func() []byte {
+		bz, err := contractData.ABI.Pack("add", erc20Contract, ibctesting.DefaultCoinAmount.BigInt())
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		memo     func() string
+		expError string
+	}{
+		{
+			name: "success - hook calling the add function",
+			memo: func() string {
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0x%x", "gas": 1000000}}`, contractAddr, addCalldata())
+			},
+		},
+		{
+			name: "success - bech32 receiver",
+			malleate: func() {
+				receiver = sdk.AccAddress(contractAddr.Bytes()).String()
+			},
+			memo: func() string {
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0x%x", "gas": 1000000}}`, contractAddr, addCalldata())
+			},
+		},
+		{
+			name: "failure - invalid memo",
+			memo: func() string {
+				return `{"evm": {"contract": "not_hex_address", "gas": 1000000}}`
+			},
+			expError: fmt.Sprintf("ABCI code: %d", evmhookstypes.ErrInvalidMemo.ABCICode()),
+		},
+		{
+			name: "failure - receiver is not the contract",
+			malleate: func() {
+				receiver = suite.evmChainA.SenderAccount.GetAddress().String()
+			},
+			memo: func() string {
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0x%x", "gas": 1000000}}`, contractAddr, addCalldata())
+			},
+			expError: fmt.Sprintf("ABCI code: %d", evmhookstypes.ErrInvalidReceiverAddress.ABCICode()),
+		},
+		{
+			name: "failure - contract has no code",
+			malleate: func() {
+				contractAddr = common.HexToAddress("0x1234567890123456789012345678901234567890")
+				receiver = contractAddr.Hex()
+			},
+			memo: func() string {
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "gas": 1000000}}`, contractAddr)
+			},
+			expError: fmt.Sprintf("ABCI code: %d", evmhookstypes.ErrContractHasNoCode.ABCICode()),
+		},
+		{
+			name: "failure - reverted call",
+			memo: func() string {
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0xffffffff", "gas": 1000000}}`, contractAddr)
+			},
+			expError: fmt.Sprintf("ABCI code: %d", evmhookstypes.ErrEVMCallFailed.ABCICode()),
+		},
+		{
+			name: "failure - tokens left on the intermediate sender",
+			memo: func() string {
+				bz, err := contractData.ABI.Pack("getCounter")
+				suite.Require().NoError(err)
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0x%x", "gas": 1000000}}`, contractAddr, bz)
+			},
+			expError: fmt.Sprintf("ABCI code: %d", evmhookstypes.ErrUnrecoverableFunds.ABCICode()),
+		},
+		{
+			name: "failure - insufficient gas",
+			memo: func() string {
+				return fmt.Sprintf(`{"evm": {"contract": "%s", "calldata": "0x%x", "gas": 1000}}`, contractAddr, addCalldata())
+			},
+			expError: "ABCI code:",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path := suite.path
+			evmCtx := suite.evmChainA.GetContext()
+			bondDenom, err := suite.chainB.GetSimApp().StakingKeeper.BondDenom(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+
+			contractAddr, err = DeployContract(suite.T(), suite.evmChainA, testutiltypes.ContractDeploymentData{Contract: contractData})
+			suite.Require().NoError(err)
+			deployedAddr := contractAddr
+			receiver = contractAddr.Hex()
+
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				bondDenom,
+				ibctesting.DefaultCoinAmount.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				receiver,
+				"",
+			)
+			data, err := transfertypes.UnmarshalPacketData(packetData.GetBytes(), transfertypes.V1, "")
+			suite.Require().NoError(err)
+			voucherDenom := testutil.GetVoucherDenomFromPacketData(data, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			tokenPair, err := types.NewTokenPairSTRv2(voucherDenom)
+			suite.Require().NoError(err)
+			erc20Contract = tokenPair.GetERC20Contract()
+
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+			packetData.Receiver = receiver
+			packetData.Memo = tc.memo()
+
+			packet := channeltypes.Packet{
+				Sequence:           1,
+				SourcePort:         path.EndpointB.ChannelConfig.PortID,
+				SourceChannel:      path.EndpointB.ChannelID,
+				DestinationPort:    path.EndpointA.ChannelConfig.PortID,
+				DestinationChannel: path.EndpointA.ChannelID,
+				Data:               packetData.GetBytes(),
+				TimeoutHeight:      suite.evmChainA.GetTimeoutHeight(),
+			}
+
+			transferStack, ok := suite.evmChainA.App.GetIBCKeeper().PortKeeper.Route(transfertypes.ModuleName)
+			suite.Require().True(ok)
+
+			ack := transferStack.OnRecvPacket(evmCtx, transfertypes.V1, packet, suite.evmChainA.SenderAccount.GetAddress())
+
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			contractBalance := evmApp.Erc20Keeper.BalanceOf(evmCtx, contracts.ERC20MinterBurnerDecimalsContract.ABI, erc20Contract, deployedAddr)
+
+			ackObj, ok := ack.(channeltypes.Acknowledgement)
+			suite.Require().True(ok)
+			if tc.expError == "" {
+				suite.Require().True(ack.Success(), "expected success, got %s", ackObj.GetError())
+				suite.Require().Equal(ibctesting.DefaultCoinAmount.String(), contractBalance.String())
+
+				var hookAck evmhookstypes.HookAcknowledgement
+				suite.Require().NoError(json.Unmarshal(ackObj.GetResult(), &hookAck))
+				suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), hookAck.IBCAck)
+
+				intermediateSender := evmhookstypes.GenerateIntermediateSender(path.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String())
+				balance := evmApp.BankKeeper.GetBalance(evmCtx, intermediateSender, voucherDenom)
+				suite.Require().True(balance.IsZero())
+			} else {
+				suite.Require().False(ack.Success(), "expected failure but got success")
+				suite.Require().Contains(ackObj.GetError(), tc.expError)
+				suite.Require().True(contractBalance == nil || contractBalance.Sign() == 0)
+			}
+		})
+	}
+}
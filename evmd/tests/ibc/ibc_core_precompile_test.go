@@ -0,0 +1,171 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ibccore"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+)
+
+// IBCCorePrecompileTestSuite tests the IBC core precompile of the evm chain.
+type IBCCorePrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA  *evmibctesting.TestChain
+	chainB     *evmibctesting.TestChain
+	precompile *ibccore.Precompile
+
+	path *evmibctesting.Path
+}
+
+func (suite *IBCCorePrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	evmAppA := suite.evmChainA.App.(*evmd.EVMD)
+	suite.precompile = ibccore.NewPrecompile(evmAppA.IBCKeeper.ChannelKeeper, evmAppA.IBCKeeper.ClientKeeper)
+
+	suite.path = evmibctesting.NewTransferPath(suite.evmChainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestIBCCorePrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCCorePrecompileTestSuite))
+}
+
+// query calls the given query method of the precompile and returns the unpacked outputs.
+func (suite *IBCCorePrecompileTestSuite) query(method string, args ...interface{}) []interface{} {
+	evmAppA := suite.evmChainA.App.(*evmd.EVMD)
+	ctx := evmante.BuildEvmExecutionCtx(suite.evmChainA.GetContext())
+	caller := common.BytesToAddress(suite.evmChainA.SenderAccount.GetAddress().Bytes())
+
+	res, err := evmAppA.EVMKeeper.CallEVM(ctx, suite.precompile.ABI, caller, suite.precompile.Address(), false, nil, method, args...)
+	suite.Require().NoError(err)
+
+	out, err := suite.precompile.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	return out
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestChannel() {
+	endpointA := suite.path.EndpointA
+
+	out := suite.query(ibccore.ChannelMethod, endpointA.ChannelConfig.PortID, endpointA.ChannelID)
+	channel := *abi.ConvertType(out[0], new(ibccore.Channel)).(*ibccore.Channel)
+	suite.Require().Equal(ibccore.Channel{
+		State:                 uint8(channeltypes.OPEN),
+		Ordering:              uint8(channeltypes.UNORDERED),
+		CounterpartyPortId:    suite.path.EndpointB.ChannelConfig.PortID,
+		CounterpartyChannelId: suite.path.EndpointB.ChannelID,
+		ConnectionId:          endpointA.ConnectionID,
+		Version:               transfertypes.V1,
+	}, channel)
+
+	out = suite.query(ibccore.ChannelMethod, endpointA.ChannelConfig.PortID, "channel-100")
+	channel = *abi.ConvertType(out[0], new(ibccore.Channel)).(*ibccore.Channel)
+	suite.Require().Equal(ibccore.Channel{}, channel)
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestNextSequences() {
+	endpointA := suite.path.EndpointA
+
+	out := suite.query(ibccore.NextSequencesMethod, endpointA.ChannelConfig.PortID, endpointA.ChannelID)
+	suite.Require().Equal([]interface{}{uint64(1), uint64(1), uint64(1)}, out)
+
+	// send a packet on the channel
+	msg := transfertypes.NewMsgTransfer(
+		endpointA.ChannelConfig.PortID,
+		endpointA.ChannelID,
+		ibctesting.TestCoin,
+		suite.evmChainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(),
+		0,
+		"",
+	)
+	_, err := suite.evmChainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	out = suite.query(ibccore.NextSequencesMethod, endpointA.ChannelConfig.PortID, endpointA.ChannelID)
+	suite.Require().Equal([]interface{}{uint64(2), uint64(1), uint64(1)}, out)
+
+	out = suite.query(ibccore.NextSequencesMethod, endpointA.ChannelConfig.PortID, "channel-100")
+	suite.Require().Equal([]interface{}{uint64(0), uint64(0), uint64(0)}, out)
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestChannelClient() {
+	endpointA := suite.path.EndpointA
+
+	out := suite.query(ibccore.ChannelClientMethod, endpointA.ChannelConfig.PortID, endpointA.ChannelID)
+	suite.Require().Equal([]interface{}{endpointA.ClientID, ibcexported.Active.String()}, out)
+
+	out = suite.query(ibccore.ChannelClientMethod, endpointA.ChannelConfig.PortID, "channel-100")
+	suite.Require().Equal([]interface{}{"", ibcexported.Unknown.String()}, out)
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestIsChannelActive() {
+	endpointA := suite.path.EndpointA
+
+	out := suite.query(ibccore.IsChannelActiveMethod, endpointA.ChannelConfig.PortID, endpointA.ChannelID)
+	suite.Require().Equal([]interface{}{true}, out)
+
+	out = suite.query(ibccore.IsChannelActiveMethod, endpointA.ChannelConfig.PortID, "channel-100")
+	suite.Require().Equal([]interface{}{false}, out)
+
+	// the channel is inactive once its client is frozen
+	endpointA.FreezeClient()
+
+	out = suite.query(ibccore.IsChannelActiveMethod, endpointA.ChannelConfig.PortID, endpointA.ChannelID)
+	suite.Require().Equal([]interface{}{false}, out)
+	out = suite.query(ibccore.ClientStatusMethod, endpointA.ClientID)
+	suite.Require().Equal([]interface{}{ibcexported.Frozen.String()}, out)
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestConnection() {
+	endpointA := suite.path.EndpointA
+
+	out := suite.query(ibccore.ConnectionMethod, endpointA.ConnectionID)
+	connection := *abi.ConvertType(out[0], new(ibccore.Connection)).(*ibccore.Connection)
+	suite.Require().Equal(ibccore.Connection{
+		State:                    uint8(connectiontypes.OPEN),
+		ClientId:                 endpointA.ClientID,
+		CounterpartyClientId:     suite.path.EndpointB.ClientID,
+		CounterpartyConnectionId: suite.path.EndpointB.ConnectionID,
+	}, connection)
+
+	out = suite.query(ibccore.ConnectionMethod, "connection-100")
+	connection = *abi.ConvertType(out[0], new(ibccore.Connection)).(*ibccore.Connection)
+	suite.Require().Equal(ibccore.Connection{}, connection)
+}
+
+func (suite *IBCCorePrecompileTestSuite) TestClient() {
+	endpointA := suite.path.EndpointA
+
+	out := suite.query(ibccore.ClientStatusMethod, endpointA.ClientID)
+	suite.Require().Equal([]interface{}{ibcexported.Active.String()}, out)
+
+	out = suite.query(ibccore.ClientLatestHeightMethod, endpointA.ClientID)
+	latestHeight := *abi.ConvertType(out[0], new(clienttypes.Height)).(*clienttypes.Height)
+	suite.Require().Equal(endpointA.GetClientLatestHeight(), latestHeight)
+
+	out = suite.query(ibccore.ClientLatestHeightMethod, "07-tendermint-100")
+	latestHeight = *abi.ConvertType(out[0], new(clienttypes.Height)).(*clienttypes.Height)
+	suite.Require().Equal(clienttypes.ZeroHeight(), latestHeight)
+}
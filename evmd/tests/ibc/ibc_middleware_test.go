@@ -1993,6 +1993,134 @@ func (suite *MiddlewareTestSuite) TestOnTimeoutPacketWithCallback() {
 	}
 }
 
+// TestOnTimeoutPacketWithFailingCallback checks that a timeout callback that
+// reverts or runs out of gas leaves no state behind, while the tokens of the
+// timed out packet are still refunded to the sender.
+func (suite *MiddlewareTestSuite) TestOnTimeoutPacketWithFailingCallback() {
+	testCases := []struct {
+		name string
+		// deploy deploys the callback contract and returns its address along with
+		// a function checking that the callback left no state
+		deploy   func() (common.Address, func())
+		gasLimit uint64
+		expError string
+	}{
+		{
+			name: "callback running out of gas after updating the counter",
+			deploy: func() (common.Address, func()) {
+				contractData, err := testutil2.LoadCounterWithCallbacksContract()
+				suite.Require().NoError(err)
+				contractAddr, err := DeployContract(suite.T(), suite.evmChainA, testutiltypes.ContractDeploymentData{
+					Contract: contractData,
+				})
+				suite.Require().NoError(err)
+
+				return contractAddr, func() {
+					evmApp := suite.evmChainA.App.(*evmd.EVMD)
+					counterRes, err := evmApp.EVMKeeper.CallEVM(
+						suite.evmChainA.GetContext(),
+						contractData.ABI,
+						common.BytesToAddress(suite.evmChainA.SenderAccount.GetAddress()),
+						contractAddr,
+						false,
+						big.NewInt(100000),
+						"getCounter",
+					)
+					suite.Require().NoError(err)
+
+					var counter *big.Int
+					err = contractData.ABI.UnpackIntoInterface(&counter, "getCounter", counterRes.Ret)
+					suite.Require().NoError(err)
+					// the counter decremented by the callback before running out of gas is discarded
+					suite.Require().Equal(big.NewInt(0).String(), counter.String())
+				}
+			},
+			// enough to execute the callback on the EVM, but not to pay for its gas
+			gasLimit: 30_000,
+			expError: "out of gas",
+		},
+		{
+			name: "reverting callback",
+			deploy: func() (common.Address, func()) {
+				// the ERC20 contract doesn't implement the callbacks, so the call reverts
+				contractData := contracts.ERC20MinterBurnerDecimalsContract
+				contractAddr, err := DeployContract(suite.T(), suite.evmChainA, testutiltypes.ContractDeploymentData{
+					Contract:        contractData,
+					ConstructorArgs: []interface{}{"coin", "token", uint8(18)},
+				})
+				suite.Require().NoError(err)
+
+				evmApp := suite.evmChainA.App.(*evmd.EVMD)
+				codeHash := evmApp.EVMKeeper.GetAccount(suite.evmChainA.GetContext(), contractAddr).CodeHash
+				return contractAddr, func() {
+					account := evmApp.EVMKeeper.GetAccount(suite.evmChainA.GetContext(), contractAddr)
+					suite.Require().NotNil(account)
+					suite.Require().Equal(codeHash, account.CodeHash)
+					suite.Require().Equal(uint64(1), account.Nonce)
+				}
+			},
+			gasLimit: 1_000_000,
+			expError: "ABCI code",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctxA := suite.evmChainA.GetContext()
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			path := suite.path
+
+			bondDenom, err := evmApp.StakingKeeper.BondDenom(ctxA)
+			suite.Require().NoError(err)
+
+			contractAddr, requireNoCallbackState := tc.deploy()
+
+			sendAmt := ibctesting.DefaultCoinAmount
+			sender := suite.evmChainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			memo := fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d"}}`, contractAddr, tc.gasLimit)
+
+			balBeforeTransfer := evmApp.BankKeeper.GetBalance(ctxA, sender, bondDenom)
+			msg := transfertypes.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoin(bondDenom, sendAmt),
+				sender.String(),
+				receiver.String(),
+				clienttypes.NewHeight(1, 110), 0, memo,
+			)
+			err = suite.evmChainA.SenderAccount.SetSequence(suite.evmChainA.SenderAccount.GetSequence() + 1)
+			suite.Require().NoError(err)
+			res, err := suite.evmChainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParseV1PacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			feeAmt := evmibctesting.FeeCoins().AmountOf(bondDenom)
+			balAfterTransfer := evmApp.BankKeeper.GetBalance(ctxA, sender, bondDenom)
+			suite.Require().Equal(balBeforeTransfer.Amount.Sub(sendAmt).Sub(feeAmt).String(), balAfterTransfer.Amount.String())
+
+			transferStack, ok := evmApp.GetIBCKeeper().PortKeeper.Route(transfertypes.ModuleName)
+			suite.Require().True(ok)
+
+			err = transferStack.OnTimeoutPacket(ctxA, path.EndpointA.GetChannel().Version, packet, receiver)
+			suite.Require().Error(err)
+			suite.Require().Contains(err.Error(), tc.expError)
+
+			requireNoCallbackState()
+
+			// the escrowed tokens are refunded despite the callback failure
+			escrowAddr := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(evmApp.BankKeeper.GetBalance(ctxA, escrowAddr, bondDenom).IsZero())
+			balAfterTimeout := evmApp.BankKeeper.GetBalance(ctxA, sender, bondDenom)
+			suite.Require().Equal(balAfterTransfer.Amount.Add(sendAmt).String(), balAfterTimeout.Amount.String())
+		})
+	}
+}
+
 // TestOnTimeoutPacketNativeErc20 tests the OnTimeoutPacket method for native ERC20 tokens.
 func (suite *MiddlewareTestSuite) TestOnTimeoutPacketNativeErc20() {
 	var packet channeltypes.Packet
@@ -0,0 +1,232 @@
+package ibc
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/icacontroller"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ICAControllerPrecompileTestSuite tests the ICA controller precompile of the evm chain,
+// controlling interchain accounts on a cosmos chain hosting them.
+type ICAControllerPrecompileTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA  *evmibctesting.TestChain
+	chainB     *evmibctesting.TestChain
+	precompile *icacontroller.Precompile
+
+	path *evmibctesting.Path
+}
+
+func (suite *ICAControllerPrecompileTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	evmAppA := suite.evmChainA.App.(*evmd.EVMD)
+	suite.precompile = icacontroller.NewPrecompile(
+		&evmAppA.ICAControllerKeeper,
+		icacontrollerkeeper.NewMsgServerImpl(&evmAppA.ICAControllerKeeper),
+		evmAppA.BankKeeper,
+		evmAppA.AppCodec(),
+	)
+
+	suite.path = evmibctesting.NewPath(suite.evmChainA, suite.chainB)
+	suite.path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	suite.path.SetupConnections()
+}
+
+func TestICAControllerPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(ICAControllerPrecompileTestSuite))
+}
+
+// registerInterchainAccount registers the interchain account of the sender through the
+// precompile, and completes the handshake of its channel.
+func (suite *ICAControllerPrecompileTestSuite) registerInterchainAccount(senderIdx int) {
+	senderAcc := suite.evmChainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAcc.SenderAccount.GetAddress().Bytes())
+
+	data, err := suite.precompile.Pack(
+		icacontroller.RegisterInterchainAccountMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+		"",
+		false,
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.evmChainA.SendEvmTx(senderAcc, senderIdx, suite.precompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), res.Log)
+
+	channelID, err := evmibctesting.ParseChannelIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	portID, err := icatypes.NewControllerPortID(senderAcc.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	endpointA := suite.path.EndpointA
+	endpointA.ChannelID = channelID
+	endpointA.ChannelConfig.PortID = portID
+	endpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	endpointA.ChannelConfig.Version = endpointA.GetChannel().Version
+	suite.path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	suite.path.EndpointB.ChannelConfig.Version = endpointA.ChannelConfig.Version
+
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(endpointA.ChanOpenAck())
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+}
+
+// interchainAccount queries the address of the interchain account of the owner through
+// the precompile.
+func (suite *ICAControllerPrecompileTestSuite) interchainAccount(owner common.Address) string {
+	evmAppA := suite.evmChainA.App.(*evmd.EVMD)
+	ctx := evmante.BuildEvmExecutionCtx(suite.evmChainA.GetContext())
+	res, err := evmAppA.EVMKeeper.CallEVM(
+		ctx,
+		suite.precompile.ABI,
+		owner,
+		suite.precompile.Address(),
+		false,
+		nil,
+		icacontroller.InterchainAccountMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+	)
+	suite.Require().NoError(err)
+
+	out, err := suite.precompile.Unpack(icacontroller.InterchainAccountMethod, res.Ret)
+	suite.Require().NoError(err)
+	return out[0].(string)
+}
+
+func (suite *ICAControllerPrecompileTestSuite) TestRegisterInterchainAccount() {
+	senderIdx := 1
+	senderAcc := suite.evmChainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAcc.SenderAccount.GetAddress().Bytes())
+
+	suite.Require().Empty(suite.interchainAccount(owner))
+
+	suite.registerInterchainAccount(senderIdx)
+
+	icaAddr := suite.interchainAccount(owner)
+	suite.Require().NotEmpty(icaAddr)
+
+	// the account is registered on the host chain
+	portID, err := icatypes.NewControllerPortID(senderAcc.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	hostAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(
+		suite.chainB.GetContext(), suite.path.EndpointB.ConnectionID, portID,
+	)
+	suite.Require().True(found)
+	suite.Require().Equal(hostAddr, icaAddr)
+}
+
+func (suite *ICAControllerPrecompileTestSuite) TestRegisterInterchainAccountOwnerMismatch() {
+	senderIdx := 1
+	senderAcc := suite.evmChainA.SenderAccounts[senderIdx]
+	otherOwner := common.BytesToAddress(suite.evmChainA.SenderAccounts[2].SenderAccount.GetAddress().Bytes())
+
+	data, err := suite.precompile.Pack(
+		icacontroller.RegisterInterchainAccountMethod,
+		otherOwner,
+		suite.path.EndpointA.ConnectionID,
+		"",
+		false,
+	)
+	suite.Require().NoError(err)
+
+	_, _, _, err = suite.evmChainA.SendEvmTx(senderAcc, senderIdx, suite.precompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().Error(err)
+	suite.Require().Empty(suite.interchainAccount(otherOwner))
+}
+
+func (suite *ICAControllerPrecompileTestSuite) TestSendTx() {
+	senderIdx := 1
+	senderAcc := suite.evmChainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAcc.SenderAccount.GetAddress().Bytes())
+
+	suite.registerInterchainAccount(senderIdx)
+	icaAddr := suite.interchainAccount(owner)
+
+	// fund the interchain account on the host chain
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000)))
+	_, err := suite.chainB.SendMsgs(banktypes.NewMsgSend(
+		suite.chainB.SenderAccount.GetAddress(),
+		sdk.MustAccAddressFromBech32(icaAddr),
+		amount,
+	))
+	suite.Require().NoError(err)
+
+	receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+	bankMsg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(icaAddr), receiver, amount)
+	value, err := suite.chainB.Codec.Marshal(bankMsg)
+	suite.Require().NoError(err)
+
+	data, err := suite.precompile.Pack(
+		icacontroller.SendTxMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+		[]icacontroller.ICAMsg{{TypeUrl: sdk.MsgTypeURL(bankMsg), Value: value}},
+		"",
+		uint64(time.Hour.Nanoseconds()),
+	)
+	suite.Require().NoError(err)
+
+	res, _, _, err := suite.evmChainA.SendEvmTx(senderAcc, senderIdx, suite.precompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().NoError(err)
+	suite.Require().True(res.IsOK(), res.Log)
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	receiverBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom)
+
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+
+	// the messages were executed by the interchain account on the host chain
+	afterReceiverBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom)
+	suite.Require().Equal(receiverBalance.Add(amount[0]), afterReceiverBalance)
+	icaBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(icaAddr), sdk.DefaultBondDenom)
+	suite.Require().True(icaBalance.IsZero())
+}
+
+func (suite *ICAControllerPrecompileTestSuite) TestSendTxWithoutInterchainAccount() {
+	senderIdx := 1
+	senderAcc := suite.evmChainA.SenderAccounts[senderIdx]
+	owner := common.BytesToAddress(senderAcc.SenderAccount.GetAddress().Bytes())
+
+	data, err := suite.precompile.Pack(
+		icacontroller.SendTxMethod,
+		owner,
+		suite.path.EndpointA.ConnectionID,
+		[]icacontroller.ICAMsg{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{}}},
+		"",
+		uint64(time.Hour.Nanoseconds()),
+	)
+	suite.Require().NoError(err)
+
+	_, _, _, err = suite.evmChainA.SendEvmTx(senderAcc, senderIdx, suite.precompile.Address(), big.NewInt(0), data, 0)
+	suite.Require().Error(err)
+}
@@ -70,6 +70,7 @@ func (suite *ICS20TransferTestSuite) TestHandleMsgTransfer() {
 		err                   error
 		nativeErc20           *NativeErc20Info
 		erc20                 bool
+		byContractAddress     bool
 	)
 
 	// originally a basic test case from the IBC testing package, and it has been added as-is to ensure that
@@ -113,6 +114,16 @@ func (suite *ICS20TransferTestSuite) TestHandleMsgTransfer() {
 				erc20 = true
 			},
 		},
+		{
+			"native erc20 case by contract address",
+			func(senderAcc evmibctesting.SenderAccount) {
+				nativeErc20 = SetupNativeErc20(suite.T(), suite.chainA, senderAcc)
+				sourceDenomToTransfer = nativeErc20.Denom
+				msgAmount = sdkmath.NewIntFromBigInt(nativeErc20.InitialBal)
+				erc20 = true
+				byContractAddress = true
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -153,10 +164,16 @@ func (suite *ICS20TransferTestSuite) TestHandleMsgTransfer() {
 			timeoutHeight := clienttypes.NewHeight(1, 110)
 			originalCoin := sdk.NewCoin(sourceDenomToTransfer, msgAmount)
 
+			denom := originalCoin.Denom
+			if byContractAddress {
+				// the tokens are converted by the precompile, without prior conversion
+				denom = nativeErc20.ContractAddr.Hex()
+			}
+
 			data, err := suite.chainAPrecompile.Pack("transfer",
 				pathAToB.EndpointA.ChannelConfig.PortID,
 				pathAToB.EndpointA.ChannelID,
-				originalCoin.Denom,
+				denom,
 				originalCoin.Amount.BigInt(),
 				common.BytesToAddress(senderAddr.Bytes()),        // source addr should be evm hex addr
 				suite.chainB.SenderAccount.GetAddress().String(), // receiver should be cosmos bech32 addr
@@ -151,7 +151,8 @@ func (suite *ICS20TransferV2TestSuite) TestHandleMsgTransfer() {
 			senderBalance := GetBalance(senderAddr)
 			suite.Require().NoError(err)
 
-			timeoutHeight := clienttypes.NewHeight(1, 110)
+			// Note: v2 packets only support timestamp-based timeouts
+			timeoutHeight := clienttypes.ZeroHeight()
 			timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
 			originalCoin := sdk.NewCoin(sourceDenomToTransfer, msgAmount)
 
@@ -9,3 +9,7 @@ import (
 func TestKVIndexer(t *testing.T) {
 	indexer.TestKVIndexer(t, CreateEvmd)
 }
+
+func TestPSQLIndexer(t *testing.T) {
+	indexer.TestPSQLIndexer(t, CreateEvmd)
+}
@@ -0,0 +1,15 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/authz"
+)
+
+func TestAuthzPrecompileTestSuite(t *testing.T) {
+	s := authz.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
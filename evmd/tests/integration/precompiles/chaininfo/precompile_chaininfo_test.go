@@ -0,0 +1,15 @@
+package chaininfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/chaininfo"
+)
+
+func TestChainInfoPrecompileTestSuite(t *testing.T) {
+	s := chaininfo.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
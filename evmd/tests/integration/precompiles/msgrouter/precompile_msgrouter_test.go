@@ -0,0 +1,15 @@
+package msgrouter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/msgrouter"
+)
+
+func TestMsgRouterPrecompileTestSuite(t *testing.T) {
+	s := msgrouter.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
@@ -0,0 +1,15 @@
+package revenue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/tests/integration/precompiles/revenue"
+)
+
+func TestRevenuePrecompileTestSuite(t *testing.T) {
+	s := revenue.NewPrecompileTestSuite(integration.CreateEvmd)
+	suite.Run(t, s)
+}
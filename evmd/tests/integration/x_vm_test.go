@@ -107,3 +107,11 @@ func TestVmAnteTestSuite(t *testing.T) {
 func TestIterateContracts(t *testing.T) {
 	vm.TestIterateContracts(t, CreateEvmd)
 }
+
+func TestStateTests(t *testing.T) {
+	vm.TestStateTests(t, CreateEvmd)
+}
+
+func TestParallelExecution(t *testing.T) {
+	vm.TestParallelExecution(t, CreateEvmd)
+}
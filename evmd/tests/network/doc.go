@@ -19,6 +19,12 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+The JSON-RPC server can however be enabled on every validator by setting
+EnableJSONRPCOnAllValidators in the Config. Each Validator then exposes its
+JSONRPCAddress and WebsocketAddress, along with a JSONRPCClient, allowing tests
+to exercise the EVM behavior across nodes, such as the propagation of
+transactions, nonce races or WebSocket subscriptions.
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
@@ -0,0 +1,236 @@
+//go:build norace
+// +build norace
+
+package network_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/suite"
+
+	cosmosevmnetwork "github.com/cosmos/evm/evmd/tests/network"
+)
+
+// MultiNodeTestSuite runs a network of several validators exposing a JSON-RPC
+// server each, to test the EVM behavior across nodes.
+type MultiNodeTestSuite struct {
+	suite.Suite
+
+	network *cosmosevmnetwork.Network
+	chainID *big.Int
+	key     *ecdsa.PrivateKey
+}
+
+func (s *MultiNodeTestSuite) SetupSuite() {
+	s.T().Log("setting up multi-node test suite")
+
+	var err error
+	cfg := cosmosevmnetwork.DefaultConfig()
+	cfg.NumValidators = 3
+	cfg.TimeoutCommit = time.Second
+	cfg.EnableJSONRPCOnAllValidators = true
+
+	s.network, err = cosmosevmnetwork.New(s.T(), s.T().TempDir(), cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	s.chainID = new(big.Int).SetUint64(cfg.EVMChainID)
+	s.key, err = s.network.Validators[0].EthPrivateKey()
+	s.Require().NoError(err)
+}
+
+func (s *MultiNodeTestSuite) TearDownSuite() {
+	s.T().Log("tearing down multi-node test suite")
+	s.network.Cleanup()
+}
+
+// signTransfer signs a transfer of 1 wei to a random address with the given
+// nonce, paying the gas price suggested by the given client.
+func (s *MultiNodeTestSuite) signTransfer(client *ethclient.Client, nonce uint64) *ethtypes.Transaction {
+	gasPrice, err := client.SuggestGasPrice(context.Background())
+	s.Require().NoError(err)
+
+	to := common.BytesToAddress(crypto.Keccak256(big.NewInt(time.Now().UnixNano()).Bytes()))
+	tx, err := ethtypes.SignNewTx(s.key, ethtypes.LatestSignerForChainID(s.chainID), &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Value:    big.NewInt(1),
+		Gas:      21_000,
+		GasPrice: gasPrice,
+	})
+	s.Require().NoError(err)
+	return tx
+}
+
+// waitForReceipt waits for the receipt of the given transaction on the given
+// client, or returns an error on timeout.
+func waitForReceipt(client *ethclient.Client, hash common.Hash) (*ethtypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := client.TransactionReceipt(ctx, hash)
+		if err == nil {
+			return receipt, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *MultiNodeTestSuite) TestTxPropagation() {
+	sender := crypto.PubkeyToAddress(s.key.PublicKey)
+	vals := s.network.Validators
+
+	nonce, err := vals[1].JSONRPCClient.PendingNonceAt(context.Background(), sender)
+	s.Require().NoError(err)
+
+	// send the transaction to a validator and check it's included in a block
+	// that is the same on every node
+	tx := s.signTransfer(vals[1].JSONRPCClient, nonce)
+	s.Require().NoError(vals[1].JSONRPCClient.SendTransaction(context.Background(), tx))
+
+	var blockHash common.Hash
+	for _, val := range vals {
+		receipt, err := waitForReceipt(val.JSONRPCClient, tx.Hash())
+		s.Require().NoError(err, "receipt not found on %s", val.Moniker)
+		s.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+		if blockHash == (common.Hash{}) {
+			blockHash = receipt.BlockHash
+		}
+		s.Require().Equal(blockHash, receipt.BlockHash, "block hash mismatch on %s", val.Moniker)
+	}
+}
+
+func (s *MultiNodeTestSuite) TestNonceRace() {
+	sender := crypto.PubkeyToAddress(s.key.PublicKey)
+	vals := s.network.Validators
+
+	startHeight, err := vals[0].JSONRPCClient.BlockNumber(context.Background())
+	s.Require().NoError(err)
+	nonce, err := vals[0].JSONRPCClient.PendingNonceAt(context.Background(), sender)
+	s.Require().NoError(err)
+
+	// send two different transactions with the same nonce to different nodes
+	txs := []*ethtypes.Transaction{
+		s.signTransfer(vals[0].JSONRPCClient, nonce),
+		s.signTransfer(vals[2].JSONRPCClient, nonce),
+	}
+	s.Require().NotEqual(txs[0].Hash(), txs[1].Hash())
+
+	errs := make(chan error, len(txs))
+	for i, val := range []*cosmosevmnetwork.Validator{vals[0], vals[2]} {
+		go func(client *ethclient.Client, tx *ethtypes.Transaction) {
+			errs <- client.SendTransaction(context.Background(), tx)
+		}(val.JSONRPCClient, txs[i])
+	}
+	for range txs {
+		// the second transaction may be rejected by the mempool of the node that
+		// already received the first one
+		<-errs
+	}
+
+	// wait for the nonce to be consumed on every node
+	for _, val := range vals {
+		s.Require().Eventually(func() bool {
+			n, err := val.JSONRPCClient.NonceAt(context.Background(), sender, nil)
+			return err == nil && n > nonce
+		}, 30*time.Second, 500*time.Millisecond, "nonce not consumed on %s", val.Moniker)
+	}
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// exactly one of the transactions is included, and no node sees the other.
+	// The blocks are scanned since the receipt lookup of a transaction unknown
+	// to the chain is retried by the node until the request times out.
+	for _, val := range vals {
+		endHeight, err := val.JSONRPCClient.BlockNumber(context.Background())
+		s.Require().NoError(err)
+
+		var included []common.Hash
+		for height := startHeight; height <= endHeight; height++ {
+			block, err := val.JSONRPCClient.BlockByNumber(context.Background(), new(big.Int).SetUint64(height))
+			s.Require().NoError(err)
+
+			for _, tx := range txs {
+				if block.Transaction(tx.Hash()) != nil {
+					included = append(included, tx.Hash())
+				}
+			}
+		}
+		s.Require().Len(included, 1, "unexpected included transactions on %s", val.Moniker)
+
+		n, err := val.JSONRPCClient.NonceAt(context.Background(), sender, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(nonce+1, n, "unexpected nonce on %s", val.Moniker)
+	}
+}
+
+func (s *MultiNodeTestSuite) TestNewHeadsSubscription() {
+	vals := s.network.Validators
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	wsClient, err := vals[2].DialWebsocket(ctx)
+	s.Require().NoError(err)
+	defer wsClient.Close()
+
+	// subscribe with the raw client to get the fields reported by the node, as
+	// the header hash computed by ethclient doesn't match the CometBFT block hash
+	heads := make(chan map[string]interface{})
+	sub, err := wsClient.Client().EthSubscribe(ctx, heads, "newHeads")
+	s.Require().NoError(err)
+	defer sub.Unsubscribe()
+
+	// the blocks notified by a node are final, so they match the blocks of the
+	// other nodes
+	var last uint64
+	for i := 0; i < 3; i++ {
+		select {
+		case err := <-sub.Err():
+			s.Require().NoError(err)
+		case <-ctx.Done():
+			s.FailNow("timeout waiting for new heads")
+		case head := <-heads:
+			number, err := hexutil.DecodeUint64(head["number"].(string))
+			s.Require().NoError(err)
+			s.Require().Greater(number, last)
+			last = number
+
+			for _, val := range vals[:2] {
+				// the other nodes may not have committed the block yet
+				var block map[string]interface{}
+				s.Require().Eventually(func() bool {
+					err := val.JSONRPCClient.Client().CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.EncodeUint64(number), false)
+					return err == nil && block != nil
+				}, 10*time.Second, 200*time.Millisecond, "block %d not found on %s", number, val.Moniker)
+				for _, field := range []string{"parentHash", "stateRoot", "timestamp"} {
+					s.Require().Equal(head[field], block[field], "%s mismatch at height %d on %s", field, number, val.Moniker)
+				}
+			}
+		}
+	}
+}
+
+func TestMultiNodeTestSuite(t *testing.T) {
+	suite.Run(t, new(MultiNodeTestSuite))
+}
@@ -3,6 +3,7 @@ package network
 import (
 	"bufio"
 	"context"
+	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,11 +23,11 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
-	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/node"
 	cmtclient "github.com/cometbft/cometbft/rpc/client"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/crypto/hd"
 	"github.com/cosmos/evm/evmd"
 	evmdconfig "github.com/cosmos/evm/evmd/cmd/evmd/config"
@@ -44,6 +45,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -60,10 +62,7 @@ import (
 )
 
 // package-wide network lock to only allow one test network at a time
-var (
-	lock     = new(sync.Mutex)
-	portPool = make(chan string, 200)
-)
+var lock = new(sync.Mutex)
 
 // AppConstructor defines a function which accepts a network configuration and
 // creates an ABCI Application to provide to CometBFT.
@@ -93,18 +92,24 @@ type Config struct {
 	SigningAlgo       string // signing algorithm for keys
 	RPCAddress        string // RPC listen address (including port)
 	JSONRPCAddress    string // JSON-RPC listen address (including port)
+	JSONRPCWsAddress  string // JSON-RPC WebSocket listen address (including port)
 	APIAddress        string // REST API listen address (including port)
 	GRPCAddress       string // GRPC server listen address (including port)
 	EnableCMTLogging  bool   // enable CometBFT logging to STDOUT
 	CleanupDir        bool   // remove base temporary directory during cleanup
 	PrintMnemonic     bool   // print the mnemonic of first validator as log output for testing
+
+	// EnableJSONRPCOnAllValidators exposes the JSON-RPC and WebSocket servers on
+	// every validator instead of only the first one, e.g. to test the
+	// propagation of transactions between nodes.
+	EnableJSONRPCOnAllValidators bool
 }
 
 // DefaultConfig returns a sane default configuration suitable for nearly all
 // testing requirements.
 func DefaultConfig() Config {
-	chainID := "evmos-1"
-	evmChainID := uint64(cmtrand.Int63n(9999999999999) + 1) //nolint:gosec // G115 // won't exceed uint64
+	chainID := testconstants.ExampleChainID.ChainID
+	evmChainID := testconstants.ExampleChainID.EVMChainID
 	dir, err := os.MkdirTemp("", "simapp")
 	if err != nil {
 		panic(fmt.Sprintf("failed creating temporary directory: %v", err))
@@ -122,6 +127,7 @@ func DefaultConfig() Config {
 		GenesisState:      tempApp.DefaultGenesis(),
 		TimeoutCommit:     3 * time.Second,
 		ChainID:           chainID,
+		EVMChainID:        evmChainID,
 		NumValidators:     4,
 		BondDenom:         testconstants.ExampleAttoDenom,
 		MinGasPrices:      fmt.Sprintf("0.000006%s", testconstants.ExampleAttoDenom),
@@ -162,7 +168,8 @@ type (
 	// may only be one test network running at a time. Thus, any caller must be
 	// sure to Cleanup after testing is finished in order to allow other tests
 	// to create networks. In addition, only the first validator will have a valid
-	// RPC and API server/client.
+	// RPC and API server/client, while the JSON-RPC servers of the other
+	// validators are only started with Config.EnableJSONRPCOnAllValidators.
 	Network struct {
 		Logger     Logger
 		BaseDir    string
@@ -190,6 +197,11 @@ type (
 		RPCClient     cmtclient.Client
 		JSONRPCClient *ethclient.Client
 
+		// JSONRPCAddress and WebsocketAddress are the URLs of the JSON-RPC and
+		// WebSocket servers, empty if they are disabled for the validator.
+		JSONRPCAddress   string
+		WebsocketAddress string
+
 		app      servertypes.Application
 		tmNode   *node.Node
 		api      *api.Server
@@ -265,6 +277,7 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		appCfg.API.Swagger = false
 		appCfg.Telemetry.Enabled = false
 		appCfg.Telemetry.GlobalLabels = [][]string{{"chain_id", cfg.ChainID}}
+		appCfg.EVM.EVMChainID = cfg.EVMChainID
 
 		ctx := server.NewDefaultContext()
 		cmtCfg := ctx.Config
@@ -282,10 +295,10 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			if cfg.APIAddress != "" {
 				apiListenAddr = cfg.APIAddress
 			} else {
-				if len(portPool) == 0 {
-					return nil, fmt.Errorf("failed to get port for API server")
+				port, err := freePort()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get port for API server: %w", err)
 				}
-				port := <-portPool
 				apiListenAddr = fmt.Sprintf("tcp://0.0.0.0:%s", port)
 			}
 
@@ -299,36 +312,52 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			if cfg.RPCAddress != "" {
 				cmtCfg.RPC.ListenAddress = cfg.RPCAddress
 			} else {
-				if len(portPool) == 0 {
-					return nil, fmt.Errorf("failed to get port for RPC server")
+				port, err := freePort()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get port for RPC server: %w", err)
 				}
-				port := <-portPool
 				cmtCfg.RPC.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%s", port)
 			}
 
 			if cfg.GRPCAddress != "" {
 				appCfg.GRPC.Address = cfg.GRPCAddress
 			} else {
-				if len(portPool) == 0 {
-					return nil, fmt.Errorf("failed to get port for GRPC server")
+				port, err := freePort()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get port for GRPC server: %w", err)
 				}
-				port := <-portPool
 				appCfg.GRPC.Address = fmt.Sprintf("0.0.0.0:%s", port)
 			}
 			appCfg.GRPC.Enable = true
 			appCfg.GRPCWeb.Enable = true
+		}
 
-			if cfg.JSONRPCAddress != "" {
+		jsonrpcAddr, wsAddr := "", ""
+		if i == 0 || cfg.EnableJSONRPCOnAllValidators {
+			if i == 0 && cfg.JSONRPCAddress != "" {
 				appCfg.JSONRPC.Address = cfg.JSONRPCAddress
 			} else {
-				if len(portPool) == 0 {
-					return nil, fmt.Errorf("failed to get port for JSON-RPC server")
+				port, err := freePort()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get port for JSON-RPC server: %w", err)
 				}
-				port := <-portPool
 				appCfg.JSONRPC.Address = fmt.Sprintf("0.0.0.0:%s", port)
 			}
+
+			if i == 0 && cfg.JSONRPCWsAddress != "" {
+				appCfg.JSONRPC.WsAddress = cfg.JSONRPCWsAddress
+			} else {
+				port, err := freePort()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get port for JSON-RPC WebSocket server: %w", err)
+				}
+				appCfg.JSONRPC.WsAddress = fmt.Sprintf("0.0.0.0:%s", port)
+			}
 			appCfg.JSONRPC.Enable = true
 			appCfg.JSONRPC.API = config.GetAPINamespaces()
+
+			jsonrpcAddr = fmt.Sprintf("http://%s", appCfg.JSONRPC.Address)
+			wsAddr = fmt.Sprintf("ws://%s", appCfg.JSONRPC.WsAddress)
 		}
 
 		logger := log.NewNopLogger()
@@ -357,17 +386,17 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 		cmtCfg.Moniker = nodeDirName
 		monikers[i] = nodeDirName
 
-		if len(portPool) == 0 {
-			return nil, fmt.Errorf("failed to get port for Proxy server")
+		port, err := freePort()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get port for Proxy server: %w", err)
 		}
-		port := <-portPool
 		proxyAddr := fmt.Sprintf("tcp://0.0.0.0:%s", port)
 		cmtCfg.ProxyApp = proxyAddr
 
-		if len(portPool) == 0 {
-			return nil, fmt.Errorf("failed to get port for Proxy server")
+		port, err = freePort()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get port for P2P server: %w", err)
 		}
-		port = <-portPool
 		p2pAddr := fmt.Sprintf("tcp://0.0.0.0:%s", port)
 		cmtCfg.P2P.ListenAddress = p2pAddr
 		cmtCfg.P2P.AddrBookStrict = false
@@ -511,6 +540,9 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			APIAddress: apiAddr,
 			Address:    addr,
 			ValAddress: sdk.ValAddress(addr),
+
+			JSONRPCAddress:   jsonrpcAddr,
+			WebsocketAddress: wsAddr,
 		}
 	}
 
@@ -648,6 +680,39 @@ func (n *Network) Cleanup() {
 	n.Logger.Log("finished cleaning up test network")
 }
 
+// EthPrivateKey returns the private key of the validator account, to sign
+// Ethereum transactions.
+func (v *Validator) EthPrivateKey() (*ecdsa.PrivateKey, error) {
+	// the keyring of the test network uses the test backend, whose keys don't
+	// have a passphrase
+	armor, err := v.ClientCtx.Keyring.ExportPrivKeyArmor(v.Moniker, "")
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ethPrivKey, ok := privKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid private key type %T, expected %T", privKey, &ethsecp256k1.PrivKey{})
+	}
+
+	return ethPrivKey.ToECDSA()
+}
+
+// DialWebsocket returns a client connected to the JSON-RPC WebSocket server of
+// the validator, which supports subscriptions. The caller must close it.
+func (v *Validator) DialWebsocket(ctx context.Context) (*ethclient.Client, error) {
+	if v.WebsocketAddress == "" {
+		return nil, fmt.Errorf("validator %s doesn't expose a JSON-RPC WebSocket server", v.Moniker)
+	}
+
+	return ethclient.DialContext(ctx, v.WebsocketAddress)
+}
+
 // printMnemonic prints a provided mnemonic seed phrase on a network logger
 // for debugging and manual testing
 func printMnemonic(l Logger, secret string) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"path/filepath"
 
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -77,12 +78,14 @@ func startInProcess(cfg Config, val *Validator) error {
 
 	val.tmNode = tmNode
 
-	if val.RPCAddress != "" {
+	// The JSON-RPC server queries the node through a local client even if the
+	// validator doesn't expose a CometBFT RPC server.
+	if val.RPCAddress != "" || val.AppConfig.JSONRPC.Enable {
 		val.RPCClient = local.New(tmNode)
 	}
 
-	// We'll need a RPC client if the validator exposes a gRPC or REST endpoint.
-	if val.APIAddress != "" || val.AppConfig.GRPC.Enable {
+	// We'll need a RPC client if the validator exposes a gRPC, REST or JSON-RPC endpoint.
+	if val.APIAddress != "" || val.AppConfig.GRPC.Enable || val.AppConfig.JSONRPC.Enable {
 		val.ClientCtx = val.ClientCtx.
 			WithClient(val.RPCClient)
 
@@ -138,14 +141,13 @@ func startInProcess(cfg Config, val *Validator) error {
 			nil,
 			app.(server.AppWithPendingTxStream),
 			nil,
+			nil,
 		)
 		if err != nil {
 			return err
 		}
 
-		address := fmt.Sprintf("http://%s", val.AppConfig.JSONRPC.Address)
-
-		val.JSONRPCClient, err = ethclient.Dial(address)
+		val.JSONRPCClient, err = ethclient.Dial(val.JSONRPCAddress)
 		if err != nil {
 			return fmt.Errorf("failed to dial JSON-RPC at %s: %w", val.AppConfig.JSONRPC.Address, err)
 		}
@@ -251,6 +253,18 @@ func initGenFiles(cfg Config, genAccounts []authtypes.GenesisAccount, genBalance
 	return nil
 }
 
+// freePort returns a port that is free to listen on, on the local host.
+func freePort() (string, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer ln.Close()
+
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	return port, err
+}
+
 func WriteFile(name string, dir string, contents []byte) error {
 	file := filepath.Join(dir, name)
 
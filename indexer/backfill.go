@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+var (
+	// ErrBlockNotIndexed is returned when verifying a block that was never indexed.
+	ErrBlockNotIndexed = errors.New("block not indexed")
+	// ErrCorruptedBlock is returned when the entries indexed for a block are inconsistent.
+	ErrCorruptedBlock = errors.New("corrupted indexed block")
+)
+
+// BackfillIndexer defines the indexer methods required to backfill a range of blocks.
+type BackfillIndexer interface {
+	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
+	// VerifyBlock returns ErrBlockNotIndexed if the block was never indexed and
+	// an error wrapping ErrCorruptedBlock if its indexed entries are inconsistent.
+	VerifyBlock(height int64) error
+}
+
+// BlockLoader loads the block and its tx results at the given height.
+type BlockLoader func(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error)
+
+// BackfillStatus is the outcome of the backfill of a single block.
+type BackfillStatus string
+
+const (
+	// BackfillStatusSkipped is reported for blocks already indexed and consistent.
+	BackfillStatusSkipped BackfillStatus = "skipped"
+	// BackfillStatusIndexed is reported for missing blocks that got indexed.
+	BackfillStatusIndexed BackfillStatus = "indexed"
+	// BackfillStatusRepaired is reported for corrupted blocks that got re-indexed.
+	BackfillStatusRepaired BackfillStatus = "repaired"
+)
+
+// BackfillResult summarizes the backfill of a range of blocks.
+type BackfillResult struct {
+	From     int64 `json:"from"`
+	To       int64 `json:"to"`
+	Skipped  int64 `json:"skipped"`
+	Indexed  int64 `json:"indexed"`
+	Repaired int64 `json:"repaired"`
+}
+
+// Backfill indexes the blocks within the [from, to] range that are missing from
+// the indexer, and re-indexes the ones with corrupted entries. The progress
+// callback, if set, is called after each processed block.
+func Backfill(
+	idxer BackfillIndexer,
+	loadBlock BlockLoader,
+	from, to int64,
+	progress func(height int64, status BackfillStatus),
+) (BackfillResult, error) {
+	result := BackfillResult{From: from, To: to}
+	if from <= 0 || to < from {
+		return result, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+
+	for height := from; height <= to; height++ {
+		status := BackfillStatusSkipped
+
+		err := idxer.VerifyBlock(height)
+		switch {
+		case err == nil:
+			result.Skipped++
+		case errors.Is(err, ErrBlockNotIndexed):
+			status = BackfillStatusIndexed
+			result.Indexed++
+		case errors.Is(err, ErrCorruptedBlock):
+			status = BackfillStatusRepaired
+			result.Repaired++
+		default:
+			return result, err
+		}
+
+		if status != BackfillStatusSkipped {
+			block, txResults, err := loadBlock(height)
+			if err != nil {
+				return result, fmt.Errorf("failed to load block %d: %w", height, err)
+			}
+			if err := idxer.IndexBlock(block, txResults); err != nil {
+				return result, err
+			}
+		}
+
+		if progress != nil {
+			progress(height, status)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package indexer
+
+import (
+	"bytes"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// FinalityIndexer is implemented by the EVM indexers recording the finality of the
+// indexed blocks.
+//
+// A block is recorded as finalized once the block including its commit is indexed,
+// and its indexed hash matches the committed one. The indexed blocks whose hash
+// doesn't match the committed one, e.g. after a rollback of the node, are dropped
+// along with the blocks above them, so that they're indexed again.
+type FinalityIndexer interface {
+	// LastFinalizedBlock returns the latest finalized block number, returns -1 if none
+	LastFinalizedBlock() (int64, error)
+}
+
+// lastCommitOf returns the height and hash of the block committed by the last
+// commit of block, the height is 0 if missing.
+func lastCommitOf(block *cmttypes.Block) (int64, []byte) {
+	if block.LastCommit == nil || block.LastCommit.Height <= 0 || block.LastCommit.Height != block.Height-1 {
+		return 0, nil
+	}
+	return block.LastCommit.Height, block.LastCommit.BlockID.Hash
+}
+
+// hashMismatch returns true if the indexed block hash doesn't match the hash of the
+// block, both hashes being known.
+func hashMismatch(indexed, hash []byte) bool {
+	return len(indexed) > 0 && len(hash) > 0 && !bytes.Equal(indexed, hash)
+}
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -9,9 +10,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	dbm "github.com/cosmos/cosmos-db"
-	rpctypes "github.com/cosmos/evm/rpc/types"
 	cosmosevmtypes "github.com/cosmos/evm/types"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -23,14 +22,22 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash       = 1
+	KeyPrefixTxIndex      = 2
+	KeyPrefixIndexedBlock = 3
+	KeyPrefixFinalized    = 4
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+
+	// pruneBatchSize is the max number of deletions written in a single batch when pruning
+	pruneBatchSize = 10_000
 )
 
-var _ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
+var (
+	_ cosmosevmtypes.EVMTxIndexer = &KVIndexer{}
+	_ FinalityIndexer             = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
@@ -44,78 +51,73 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 	return &KVIndexer{db, logger, clientCtx}
 }
 
-// IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
+// IndexBlock index all the eth txs in a block, parsed with ParseBlock, by storing
+// a indexer.TxResult for every eth tx.
+//
+// The parent block is recorded as finalized if its indexed hash matches the one of
+// the last commit of the block. Otherwise, as well as when the block replaces an
+// indexed block with a different hash, the stale blocks are dropped, see FinalityIndexer.
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
+	blockHash := block.Hash()
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
-		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
+	finalized, err := kv.LastFinalizedBlock()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 
-		if !isEthTx(tx) {
-			continue
+	indexedHash, _, err := kv.indexedBlockHash(height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if hashMismatch(indexedHash, blockHash) {
+		kv.logger.Info("indexed block was replaced, dropping the blocks from its height", "height", height)
+		if err := rollbackBlocks(kv.db, batch, height); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, rollback", height)
 		}
+		finalized = min(finalized, height-1)
+	} else if err := deleteTxEntries(kv.db, batch, TxIndexKey(height, 0), TxIndexKey(height+1, 0)); err != nil {
+		// drop any previous entry of the block, in case it's re-indexed
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 
-		txs, err := rpctypes.ParseTxResult(result, tx)
+	if parent, parentHash := lastCommitOf(block); parent > 0 {
+		indexedParentHash, found, err := kv.indexedBlockHash(parent)
 		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
 		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
-
-			txResult := cosmosevmtypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
+		switch {
+		case hashMismatch(indexedParentHash, parentHash):
+			kv.logger.Error("indexed block doesn't match the committed one, dropping the blocks from its height", "height", parent)
+			if err := rollbackBlocks(kv.db, batch, parent); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d, rollback", height)
 			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
+			finalized = min(finalized, parent-1)
+		case found:
+			finalized = max(finalized, parent)
+		}
+	}
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+	indexedTxs := ParseBlock(kv.clientCtx.TxConfig.TxDecoder(), kv.logger, block, txResults)
+	for i := range indexedTxs {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, indexedTxs[i].Hash, &indexedTxs[i].Result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	// mark the block as indexed along with its number of eth txs, to detect gaps and corruption,
+	// and its time, to prune the blocks out of the retention window
+	if err := batch.Set(IndexedBlockKey(height), encodeIndexedBlock(len(indexedTxs), block.Time, blockHash)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set indexed-block key", height)
+	}
+	if finalized >= 0 {
+		if err := batch.Set(FinalizedKey(), sdk.Uint64ToBigEndian(uint64(finalized))); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set finalized key", height)
 		}
+	} else if err := batch.Delete(FinalizedKey()); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, delete finalized key", height)
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
@@ -133,6 +135,50 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// Rollback deletes the entries of the blocks above height, the latest finalized
+// block being lowered to height if above.
+func (kv *KVIndexer) Rollback(height int64) error {
+	finalized, err := kv.LastFinalizedBlock()
+	if err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+
+	batch := kv.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	if err := rollbackBlocks(kv.db, batch, height+1); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	if finalized > height {
+		if height > 0 {
+			err = batch.Set(FinalizedKey(), sdk.Uint64ToBigEndian(uint64(height))) //#nosec G115 -- height is positive
+		} else {
+			err = batch.Delete(FinalizedKey())
+		}
+		if err != nil {
+			return errorsmod.Wrapf(err, "Rollback %d, update finalized key", height)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d, write batch", height)
+	}
+	return nil
+}
+
+// LastFinalizedBlock returns the latest finalized block number, returns -1 if none
+func (kv *KVIndexer) LastFinalizedBlock() (int64, error) {
+	bz, err := kv.db.Get(FinalizedKey())
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastFinalizedBlock")
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil //#nosec G115 -- encoded from a non-negative int64
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -161,6 +207,162 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosm
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// VerifyBlock checks the consistency of the entries indexed for the given block.
+// It returns ErrBlockNotIndexed if the block was never indexed, or an error wrapping
+// ErrCorruptedBlock if its entries are missing or don't match.
+func (kv *KVIndexer) VerifyBlock(height int64) error {
+	bz, err := kv.db.Get(IndexedBlockKey(height))
+	if err != nil {
+		return errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	if len(bz) == 0 {
+		return errorsmod.Wrapf(ErrBlockNotIndexed, "block %d", height)
+	}
+	ethTxCount, _, _ := decodeIndexedBlock(bz)
+
+	it, err := kv.db.Iterator(TxIndexKey(height, 0), TxIndexKey(height+1, 0))
+	if err != nil {
+		return errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	defer it.Close()
+
+	var count uint64
+	for ; it.Valid(); it.Next() {
+		txHash := common.BytesToHash(it.Value())
+		txResult, err := kv.GetByTxHash(txHash)
+		if err != nil {
+			return errorsmod.Wrapf(ErrCorruptedBlock, "block %d, tx %s: %s", height, txHash.Hex(), err)
+		}
+		if txResult.Height != height || uint64(txResult.EthTxIndex) != count { //nolint:gosec // G115 // index is never negative
+			return errorsmod.Wrapf(
+				ErrCorruptedBlock, "block %d, tx %s indexed at block %d, eth-index %d, expected eth-index %d",
+				height, txHash.Hex(), txResult.Height, txResult.EthTxIndex, count,
+			)
+		}
+		count++
+	}
+
+	if count != ethTxCount {
+		return errorsmod.Wrapf(ErrCorruptedBlock, "block %d, expected %d eth txs, found %d", height, ethTxCount, count)
+	}
+	return nil
+}
+
+// Prune deletes the entries of the blocks below retainHeight, along with the ones of
+// the blocks older than retainTime if set. The deletions are written in batches of
+// pruneBatchSize entries and, if the db supports it, the pruned ranges are compacted.
+func (kv *KVIndexer) Prune(retainHeight int64, retainTime time.Time) (PruneResult, error) {
+	if !retainTime.IsZero() {
+		height, err := kv.retainHeightByTime(retainTime)
+		if err != nil {
+			return PruneResult{}, errorsmod.Wrap(err, "Prune")
+		}
+		retainHeight = max(retainHeight, height)
+	}
+
+	result := PruneResult{RetainHeight: retainHeight}
+	if retainHeight <= 1 {
+		return result, nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer func() {
+		_ = batch.Close()
+	}()
+
+	var pending int
+	deleteKey := func(key []byte) error {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+		pending++
+		if pending < pruneBatchSize {
+			return nil
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		_ = batch.Close()
+		batch = kv.db.NewBatch()
+		pending = 0
+		return nil
+	}
+
+	txIndexEnd := TxIndexKey(retainHeight, 0)
+	it, err := kv.db.Iterator(TxIndexKey(0, 0), txIndexEnd)
+	if err != nil {
+		return result, errorsmod.Wrap(err, "Prune")
+	}
+	for ; it.Valid(); it.Next() {
+		if err := deleteKey(TxHashKey(common.BytesToHash(it.Value()))); err != nil {
+			it.Close()
+			return result, errorsmod.Wrap(err, "Prune, delete tx-hash key")
+		}
+		if err := deleteKey(it.Key()); err != nil {
+			it.Close()
+			return result, errorsmod.Wrap(err, "Prune, delete tx-index key")
+		}
+		result.Txs++
+	}
+	it.Close()
+
+	it, err = kv.db.Iterator(IndexedBlockKey(0), IndexedBlockKey(retainHeight))
+	if err != nil {
+		return result, errorsmod.Wrap(err, "Prune")
+	}
+	for ; it.Valid(); it.Next() {
+		if err := deleteKey(it.Key()); err != nil {
+			it.Close()
+			return result, errorsmod.Wrap(err, "Prune, delete indexed-block key")
+		}
+	}
+	it.Close()
+
+	if err := batch.Write(); err != nil {
+		return result, errorsmod.Wrap(err, "Prune, write batch")
+	}
+
+	if compacter, ok := kv.db.(interface {
+		ForceCompact(start, limit []byte) error
+	}); ok && result.Txs > 0 {
+		// the pruned tx-hash keys are spread over the whole tx-hash prefix
+		if err := compacter.ForceCompact([]byte{KeyPrefixTxHash}, txIndexEnd); err != nil {
+			kv.logger.Error("failed to compact the pruned entries", "err", err)
+		}
+	}
+	return result, nil
+}
+
+// retainHeightByTime returns the height of the first indexed block not older than retainTime.
+func (kv *KVIndexer) retainHeightByTime(retainTime time.Time) (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixIndexedBlock}, []byte{KeyPrefixIndexedBlock + 1})
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var retainHeight int64
+	for ; it.Valid(); it.Next() {
+		_, blockTime, _ := decodeIndexedBlock(it.Value())
+		if !blockTime.Before(retainTime) {
+			break
+		}
+		retainHeight = int64(sdk.BigEndianToUint64(it.Key()[1:])) + 1 //#nosec G115 -- block number won't exceed int64
+	}
+	return retainHeight, nil
+}
+
+// indexedBlockHash returns the hash recorded for the indexed block at the given height,
+// found is false if the block was never indexed.
+func (kv *KVIndexer) indexedBlockHash(height int64) (hash []byte, found bool, err error) {
+	bz, err := kv.db.Get(IndexedBlockKey(height))
+	if err != nil || len(bz) == 0 {
+		return nil, false, err
+	}
+	_, _, hash = decodeIndexedBlock(bz)
+	return hash, true, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -173,6 +375,16 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// IndexedBlockKey returns the key for db entry: `block number -> number of eth txs, block time, block hash`
+func IndexedBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixIndexedBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+// FinalizedKey returns the key for db entry: `-> latest finalized block number`
+func FinalizedKey() []byte {
+	return []byte{KeyPrefixFinalized}
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -224,6 +436,67 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// deleteTxEntries deletes the tx-index entries within the [start, end) key range in
+// the kv db batch, along with the tx-hash entries they point to.
+func deleteTxEntries(db dbm.DB, batch dbm.Batch, start, end []byte) error {
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(it.Value()))); err != nil {
+			return errorsmod.Wrap(err, "delete tx-hash key")
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete tx-index key")
+		}
+	}
+	return nil
+}
+
+// rollbackBlocks deletes the entries of the blocks from the given height in the kv db batch
+func rollbackBlocks(db dbm.DB, batch dbm.Batch, height int64) error {
+	if err := deleteTxEntries(db, batch, TxIndexKey(height, 0), []byte{KeyPrefixTxIndex + 1}); err != nil {
+		return err
+	}
+
+	it, err := db.Iterator(IndexedBlockKey(height), []byte{KeyPrefixIndexedBlock + 1})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return errorsmod.Wrap(err, "delete indexed-block key")
+		}
+	}
+	return nil
+}
+
+// encodeIndexedBlock encodes the value of the indexed-block db entry
+func encodeIndexedBlock(ethTxCount int, blockTime time.Time, blockHash []byte) []byte {
+	bz := sdk.Uint64ToBigEndian(uint64(ethTxCount))                     //nolint:gosec // G115 // count is never negative
+	bz = append(bz, sdk.Uint64ToBigEndian(uint64(blockTime.Unix()))...) //nolint:gosec // G115 // decoded back as int64
+	return append(bz, blockHash...)
+}
+
+// decodeIndexedBlock decodes the value of the indexed-block db entry, the block time
+// is zero and the block hash is empty if missing.
+func decodeIndexedBlock(bz []byte) (uint64, time.Time, []byte) {
+	var (
+		blockTime time.Time
+		blockHash []byte
+	)
+	if len(bz) >= 16 {
+		blockTime = time.Unix(int64(sdk.BigEndianToUint64(bz[8:16])), 0) //nolint:gosec // G115 // encoded from an int64
+		blockHash = bz[16:]
+	}
+	return sdk.BigEndianToUint64(bz[:8]), blockTime, blockHash
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
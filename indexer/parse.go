@@ -0,0 +1,116 @@
+package indexer
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IndexedTx is an eth tx parsed from a block, along with the logs it emitted.
+type IndexedTx struct {
+	Hash   common.Hash
+	Msg    *evmtypes.MsgEthereumTx
+	Result cosmosevmtypes.TxResult
+	Logs   []*ethtypes.Log
+}
+
+// ParseBlock parses the eth txs of a block through the following steps, so that
+// every indexer backend indexes the same entries:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a TxResult based on parsed events for every message
+//
+// The txs that can't be decoded or parsed are logged and skipped.
+func ParseBlock(
+	txDecoder sdk.TxDecoder,
+	logger log.Logger,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+) []IndexedTx {
+	height := block.Height
+
+	var indexedTxs []IndexedTx
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := txDecoder(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+			indexedTx := IndexedTx{
+				Hash: ethMsg.Hash(),
+				Msg:  ethMsg,
+				Result: cosmosevmtypes.TxResult{
+					Height:     height,
+					TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+					MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+					EthTxIndex: ethTxIndex,
+				},
+			}
+			txResult := &indexedTx.Result
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+
+				if len(result.Data) > 0 {
+					logs, err := evmtypes.DecodeMsgLogs(result.Data, msgIndex, uint64(height)) //#nosec G115 -- block height is never negative
+					if err != nil {
+						logger.Error("Fail to decode logs", "err", err, "block", height, "txIndex", txIndex, "msgIndex", msgIndex)
+					}
+					indexedTx.Logs = logs
+				}
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			indexedTxs = append(indexedTxs, indexedTx)
+		}
+	}
+	return indexedTxs
+}
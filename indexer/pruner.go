@@ -0,0 +1,114 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// DefaultPruneInterval is the default interval between two prunings of the indexer.
+const DefaultPruneInterval = 10 * time.Minute
+
+// PruneResult summarizes the pruning of an indexer.
+type PruneResult struct {
+	// RetainHeight is the lowest height retained by the indexer.
+	RetainHeight int64
+	// Txs is the number of pruned eth txs.
+	Txs int64
+}
+
+// PruningIndexer defines the indexer methods required to prune the blocks out of
+// the retention window.
+type PruningIndexer interface {
+	LastIndexedBlock() (int64, error)
+	// Prune deletes the entries of the blocks below retainHeight, along with the
+	// ones of the blocks older than retainTime if set.
+	Prune(retainHeight int64, retainTime time.Time) (PruneResult, error)
+}
+
+// Pruner periodically prunes the blocks of an indexer that are out of the retention
+// window, i.e. not within the last retainBlocks blocks or older than retainDuration.
+// A zero value disables the corresponding retention policy.
+type Pruner struct {
+	idxer          PruningIndexer
+	retainBlocks   uint64
+	retainDuration time.Duration
+	interval       time.Duration
+	logger         log.Logger
+}
+
+// NewPruner creates the Pruner of the given indexer.
+func NewPruner(
+	idxer PruningIndexer,
+	retainBlocks uint64,
+	retainDuration time.Duration,
+	interval time.Duration,
+	logger log.Logger,
+) *Pruner {
+	if interval <= 0 {
+		interval = DefaultPruneInterval
+	}
+	return &Pruner{
+		idxer:          idxer,
+		retainBlocks:   retainBlocks,
+		retainDuration: retainDuration,
+		interval:       interval,
+		logger:         logger,
+	}
+}
+
+// Run prunes the indexer every interval until the context is done.
+func (p *Pruner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Prune(); err != nil {
+			p.logger.Error("failed to prune the EVM indexer", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Prune prunes the blocks of the indexer out of the retention window once.
+func (p *Pruner) Prune() (PruneResult, error) {
+	defer telemetry.MeasureSince(time.Now(), "evm_indexer", "prune")
+
+	var retainHeight int64
+	if p.retainBlocks > 0 {
+		last, err := p.idxer.LastIndexedBlock()
+		if err != nil {
+			return PruneResult{}, err
+		}
+		retainHeight = last - int64(p.retainBlocks) + 1 //#nosec G115 -- retained blocks won't exceed int64
+	}
+
+	var retainTime time.Time
+	if p.retainDuration > 0 {
+		retainTime = time.Now().Add(-p.retainDuration)
+	}
+
+	if retainHeight <= 0 && retainTime.IsZero() {
+		return PruneResult{}, nil
+	}
+
+	res, err := p.idxer.Prune(retainHeight, retainTime)
+	if err != nil {
+		return res, err
+	}
+
+	telemetry.SetGauge(float32(res.RetainHeight), "evm_indexer", "retain_height")
+	telemetry.IncrCounter(float32(res.Txs), "evm_indexer", "pruned_txs")
+	if res.Txs > 0 {
+		p.logger.Info("pruned EVM indexer", "retain_height", res.RetainHeight, "pruned_txs", res.Txs)
+	}
+	return res, nil
+}
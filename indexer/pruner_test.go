@@ -0,0 +1,63 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+type mockPruningIndexer struct {
+	last         int64
+	retainHeight int64
+	retainTime   time.Time
+	calls        int
+}
+
+func (m *mockPruningIndexer) LastIndexedBlock() (int64, error) {
+	return m.last, nil
+}
+
+func (m *mockPruningIndexer) Prune(retainHeight int64, retainTime time.Time) (PruneResult, error) {
+	m.calls++
+	m.retainHeight = retainHeight
+	m.retainTime = retainTime
+	return PruneResult{RetainHeight: retainHeight}, nil
+}
+
+func TestPrunerPrune(t *testing.T) {
+	testCases := []struct {
+		name           string
+		last           int64
+		retainBlocks   uint64
+		retainDuration time.Duration
+		expCalls       int
+		expHeight      int64
+		expTime        bool
+	}{
+		{"retain all blocks", 100, 0, 0, 0, 0, false},
+		{"retain last blocks", 100, 10, 0, 1, 91, false},
+		{"less blocks than retained", 5, 10, 0, 0, 0, false},
+		{"retain duration", 100, 0, time.Hour, 1, 0, true},
+		{"retain last blocks and duration", 100, 10, time.Hour, 1, 91, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			idxer := &mockPruningIndexer{last: tc.last}
+			pruner := NewPruner(idxer, tc.retainBlocks, tc.retainDuration, 0, log.NewNopLogger())
+
+			_, err := pruner.Prune()
+			require.NoError(t, err)
+			require.Equal(t, tc.expCalls, idxer.calls)
+			require.Equal(t, tc.expHeight, idxer.retainHeight)
+			if tc.expTime {
+				require.WithinDuration(t, time.Now().Add(-tc.retainDuration), idxer.retainTime, time.Minute)
+			} else {
+				require.True(t, idxer.retainTime.IsZero())
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package indexer
+
+import (
+	"database/sql"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	_ "github.com/lib/pq" // postgres driver
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const psqlDriverName = "postgres"
+
+// PSQLSchema is the database schema of the PSQLIndexer, installed on creation if missing.
+//
+//go:embed psql_schema.sql
+var PSQLSchema string
+
+var (
+	_ cosmosevmtypes.EVMTxIndexer = &PSQLIndexer{}
+	_ FinalityIndexer             = &PSQLIndexer{}
+)
+
+// PSQLIndexer implements a eth tx indexer on a PostgreSQL database, using the schema
+// defined in PSQLSchema, so that the indexed txs and logs can be queried with SQL.
+type PSQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewPSQLIndexer creates the PSQLIndexer connected to the PostgreSQL database
+// specified by connStr, installing the indexer schema if missing.
+func NewPSQLIndexer(connStr string, logger log.Logger, clientCtx client.Context) (*PSQLIndexer, error) {
+	db, err := sql.Open(psqlDriverName, connStr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(PSQLSchema); err != nil {
+		_ = db.Close()
+		return nil, errorsmod.Wrap(err, "failed to install the indexer schema")
+	}
+	return &PSQLIndexer{db, logger, clientCtx}, nil
+}
+
+// Close closes the database connection.
+func (p *PSQLIndexer) Close() error {
+	return p.db.Close()
+}
+
+// IndexBlock index all the eth txs in a block, parsed with ParseBlock, along
+// with their logs. The previous entries of the block are replaced.
+//
+// The parent block is recorded as finalized if its indexed hash matches the one of
+// the last commit of the block. Otherwise, as well as when the block replaces an
+// indexed block with a different hash, the stale blocks are dropped, see FinalityIndexer.
+func (p *PSQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+	blockHash := block.Hash()
+	indexedTxs := ParseBlock(p.clientCtx.TxConfig.TxDecoder(), p.logger, block, txResults)
+
+	err := runInTransaction(p.db, func(dbtx *sql.Tx) error {
+		indexedHash, _, err := indexedBlockHash(dbtx, height)
+		if err != nil {
+			return err
+		}
+		if hashMismatch(indexedHash, blockHash) {
+			p.logger.Info("indexed block was replaced, dropping the blocks from its height", "height", height)
+			if err := rollbackPSQLBlocks(dbtx, height); err != nil {
+				return err
+			}
+		}
+
+		if parent, parentHash := lastCommitOf(block); parent > 0 {
+			indexedParentHash, found, err := indexedBlockHash(dbtx, parent)
+			if err != nil {
+				return err
+			}
+			switch {
+			case hashMismatch(indexedParentHash, parentHash):
+				p.logger.Error("indexed block doesn't match the committed one, dropping the blocks from its height", "height", parent)
+				if err := rollbackPSQLBlocks(dbtx, parent); err != nil {
+					return err
+				}
+			case found:
+				if _, err := dbtx.Exec(`UPDATE eth_blocks SET finalized = TRUE WHERE height = $1;`, parent); err != nil {
+					return err
+				}
+			}
+		}
+
+		if _, err := dbtx.Exec(`DELETE FROM eth_logs WHERE height = $1`, height); err != nil {
+			return err
+		}
+		if _, err := dbtx.Exec(`DELETE FROM eth_txs WHERE height = $1`, height); err != nil {
+			return err
+		}
+
+		for _, tx := range indexedTxs {
+			res := tx.Result
+			if _, err := dbtx.Exec(`
+INSERT INTO eth_txs (tx_hash, height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+  ON CONFLICT (tx_hash) DO UPDATE SET
+    height = EXCLUDED.height, tx_index = EXCLUDED.tx_index, msg_index = EXCLUDED.msg_index,
+    eth_tx_index = EXCLUDED.eth_tx_index, failed = EXCLUDED.failed, gas_used = EXCLUDED.gas_used,
+    cumulative_gas_used = EXCLUDED.cumulative_gas_used;`,
+				tx.Hash.Hex(), res.Height, int64(res.TxIndex), int64(res.MsgIndex), res.EthTxIndex, res.Failed,
+				int64(res.GasUsed), int64(res.CumulativeGasUsed), //#nosec G115 -- gas won't exceed int64
+			); err != nil {
+				return errorsmod.Wrapf(err, "indexing tx %s", tx.Hash.Hex())
+			}
+
+			for _, l := range tx.Logs {
+				var topics [4]sql.NullString
+				for i := 0; i < len(l.Topics) && i < len(topics); i++ {
+					topics[i] = sql.NullString{String: l.Topics[i].Hex(), Valid: true}
+				}
+				if _, err := dbtx.Exec(`
+INSERT INTO eth_logs (height, tx_hash, log_index, address, topic0, topic1, topic2, topic3, data)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`,
+					height, tx.Hash.Hex(), int64(l.Index), strings.ToLower(l.Address.Hex()), //#nosec G115 -- log index won't exceed int64
+					topics[0], topics[1], topics[2], topics[3], l.Data,
+				); err != nil {
+					return errorsmod.Wrapf(err, "indexing logs of tx %s", tx.Hash.Hex())
+				}
+			}
+		}
+
+		_, err = dbtx.Exec(`
+INSERT INTO eth_blocks (height, eth_tx_count, block_time, block_hash, indexed_at)
+  VALUES ($1, $2, $3, $4, $5)
+  ON CONFLICT (height) DO UPDATE SET
+    eth_tx_count = EXCLUDED.eth_tx_count, block_time = EXCLUDED.block_time,
+    block_hash = EXCLUDED.block_hash, indexed_at = EXCLUDED.indexed_at;`,
+			height, len(indexedTxs), block.Time.UTC(), []byte(blockHash), time.Now().UTC(),
+		)
+		return err
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (p *PSQLIndexer) LastIndexedBlock() (int64, error) {
+	var height int64
+	if err := p.db.QueryRow(`SELECT COALESCE(MAX(height), -1) FROM eth_blocks;`).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	return height, nil
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (p *PSQLIndexer) FirstIndexedBlock() (int64, error) {
+	var height int64
+	if err := p.db.QueryRow(`SELECT COALESCE(MIN(height), -1) FROM eth_blocks;`).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	return height, nil
+}
+
+// Rollback deletes the entries of the blocks above height.
+func (p *PSQLIndexer) Rollback(height int64) error {
+	err := runInTransaction(p.db, func(dbtx *sql.Tx) error {
+		return rollbackPSQLBlocks(dbtx, height+1)
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "Rollback %d", height)
+	}
+	return nil
+}
+
+// LastFinalizedBlock returns the latest finalized block number, returns -1 if none
+func (p *PSQLIndexer) LastFinalizedBlock() (int64, error) {
+	var height int64
+	if err := p.db.QueryRow(`SELECT COALESCE(MAX(height), -1) FROM eth_blocks WHERE finalized;`).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "LastFinalizedBlock")
+	}
+	return height, nil
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (p *PSQLIndexer) GetByTxHash(hash common.Hash) (*cosmosevmtypes.TxResult, error) {
+	row := p.db.QueryRow(`
+SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used
+  FROM eth_txs WHERE tx_hash = $1;`, hash.Hex())
+	res, err := scanTxResult(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (p *PSQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*cosmosevmtypes.TxResult, error) {
+	row := p.db.QueryRow(`
+SELECT height, tx_index, msg_index, eth_tx_index, failed, gas_used, cumulative_gas_used
+  FROM eth_txs WHERE height = $1 AND eth_tx_index = $2;`, blockNumber, txIndex)
+	res, err := scanTxResult(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// VerifyBlock checks the consistency of the entries indexed for the given block.
+// It returns ErrBlockNotIndexed if the block was never indexed, or an error wrapping
+// ErrCorruptedBlock if its entries are missing or don't match.
+func (p *PSQLIndexer) VerifyBlock(height int64) error {
+	var ethTxCount int64
+	err := p.db.QueryRow(`SELECT eth_tx_count FROM eth_blocks WHERE height = $1;`, height).Scan(&ethTxCount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errorsmod.Wrapf(ErrBlockNotIndexed, "block %d", height)
+	}
+	if err != nil {
+		return errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+
+	rows, err := p.db.Query(`SELECT eth_tx_index FROM eth_txs WHERE height = $1 ORDER BY eth_tx_index;`, height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var ethTxIndex int64
+		if err := rows.Scan(&ethTxIndex); err != nil {
+			return errorsmod.Wrapf(err, "VerifyBlock %d", height)
+		}
+		if ethTxIndex != count {
+			return errorsmod.Wrapf(ErrCorruptedBlock, "block %d, found eth-index %d, expected eth-index %d", height, ethTxIndex, count)
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return errorsmod.Wrapf(err, "VerifyBlock %d", height)
+	}
+
+	if count != ethTxCount {
+		return errorsmod.Wrapf(ErrCorruptedBlock, "block %d, expected %d eth txs, found %d", height, ethTxCount, count)
+	}
+	return nil
+}
+
+// Prune deletes the entries of the blocks below retainHeight, along with the ones of
+// the blocks older than retainTime if set.
+func (p *PSQLIndexer) Prune(retainHeight int64, retainTime time.Time) (PruneResult, error) {
+	if !retainTime.IsZero() {
+		var height int64
+		if err := p.db.QueryRow(
+			`SELECT COALESCE(MAX(height) + 1, 0) FROM eth_blocks WHERE block_time < $1;`, retainTime.UTC(),
+		).Scan(&height); err != nil {
+			return PruneResult{}, errorsmod.Wrap(err, "Prune")
+		}
+		retainHeight = max(retainHeight, height)
+	}
+
+	result := PruneResult{RetainHeight: retainHeight}
+	if retainHeight <= 1 {
+		return result, nil
+	}
+
+	err := runInTransaction(p.db, func(dbtx *sql.Tx) error {
+		if _, err := dbtx.Exec(`DELETE FROM eth_logs WHERE height < $1;`, retainHeight); err != nil {
+			return err
+		}
+		res, err := dbtx.Exec(`DELETE FROM eth_txs WHERE height < $1;`, retainHeight)
+		if err != nil {
+			return err
+		}
+		if result.Txs, err = res.RowsAffected(); err != nil {
+			return err
+		}
+		_, err = dbtx.Exec(`DELETE FROM eth_blocks WHERE height < $1;`, retainHeight)
+		return err
+	})
+	if err != nil {
+		return result, errorsmod.Wrap(err, "Prune")
+	}
+	return result, nil
+}
+
+// scanTxResult scans a row of the eth_txs table into a TxResult.
+func scanTxResult(row *sql.Row) (*cosmosevmtypes.TxResult, error) {
+	var (
+		res                        cosmosevmtypes.TxResult
+		txIndex, msgIndex          int64
+		gasUsed, cumulativeGasUsed int64
+	)
+	if err := row.Scan(&res.Height, &txIndex, &msgIndex, &res.EthTxIndex, &res.Failed, &gasUsed, &cumulativeGasUsed); err != nil {
+		return nil, err
+	}
+	res.TxIndex = uint32(txIndex)                     //#nosec G115 -- stored from an uint32
+	res.MsgIndex = uint32(msgIndex)                   //#nosec G115 -- stored from an uint32
+	res.GasUsed = uint64(gasUsed)                     //#nosec G115 -- stored from an uint64
+	res.CumulativeGasUsed = uint64(cumulativeGasUsed) //#nosec G115 -- stored from an uint64
+	return &res, nil
+}
+
+// indexedBlockHash returns the hash recorded for the indexed block at the given height,
+// found is false if the block was never indexed.
+func indexedBlockHash(dbtx *sql.Tx, height int64) (hash []byte, found bool, err error) {
+	err = dbtx.QueryRow(`SELECT block_hash FROM eth_blocks WHERE height = $1;`, height).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return hash, true, nil
+}
+
+// rollbackPSQLBlocks deletes the entries of the blocks from the given height.
+func rollbackPSQLBlocks(dbtx *sql.Tx, height int64) error {
+	for _, table := range []string{"eth_logs", "eth_txs", "eth_blocks"} {
+		if _, err := dbtx.Exec(`DELETE FROM `+table+` WHERE height >= $1;`, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
@@ -0,0 +1,63 @@
+/*
+  This file defines the database schema of the PostgreSQL EVM tx indexer. It's
+  installed by the indexer on startup if missing, and can be used to query the
+  indexed eth txs and logs with SQL.
+ */
+
+-- The eth_blocks table records the indexed blocks, along with their number of
+-- eth txs, to detect gaps and corruption, their time, to prune them, and their
+-- hash, to detect the blocks replaced after a rollback of the node.
+CREATE TABLE IF NOT EXISTS eth_blocks (
+  height       BIGINT PRIMARY KEY,
+  eth_tx_count INTEGER NOT NULL,
+  block_time   TIMESTAMPTZ NOT NULL,
+  -- The CometBFT block hash, NULL if unknown.
+  block_hash   BYTEA NULL,
+  -- Whether the commit of the block was included in the next indexed block.
+  finalized    BOOLEAN NOT NULL DEFAULT FALSE,
+  -- When this block was indexed, in UTC.
+  indexed_at   TIMESTAMPTZ NOT NULL
+);
+
+-- The eth_txs table records the result of every indexed eth tx.
+CREATE TABLE IF NOT EXISTS eth_txs (
+  -- The hex-encoded hash of the eth tx.
+  tx_hash             VARCHAR PRIMARY KEY,
+  height              BIGINT NOT NULL,
+  -- The index of the cosmos tx within the block.
+  tx_index            INTEGER NOT NULL,
+  -- The index of the eth msg within the cosmos tx.
+  msg_index           INTEGER NOT NULL,
+  -- The index of the eth tx within the eth txs of the block.
+  eth_tx_index        INTEGER NOT NULL,
+  failed              BOOLEAN NOT NULL,
+  gas_used            BIGINT NOT NULL,
+  cumulative_gas_used BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_eth_blocks_time ON eth_blocks(block_time);
+CREATE INDEX IF NOT EXISTS idx_eth_blocks_finalized ON eth_blocks(height) WHERE finalized;
+
+CREATE INDEX IF NOT EXISTS idx_eth_txs_height ON eth_txs(height, eth_tx_index);
+
+-- The eth_logs table records the logs emitted by the indexed eth txs.
+CREATE TABLE IF NOT EXISTS eth_logs (
+  rowid     BIGSERIAL PRIMARY KEY,
+
+  height    BIGINT NOT NULL,
+  tx_hash   VARCHAR NOT NULL,
+  -- The index of the log within the block.
+  log_index INTEGER NOT NULL,
+  -- The hex-encoded address of the contract that emitted the log.
+  address   VARCHAR NOT NULL,
+  -- The hex-encoded topics of the log, NULL if missing.
+  topic0    VARCHAR NULL,
+  topic1    VARCHAR NULL,
+  topic2    VARCHAR NULL,
+  topic3    VARCHAR NULL,
+  data      BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_eth_logs_height ON eth_logs(height);
+CREATE INDEX IF NOT EXISTS idx_eth_logs_tx_hash ON eth_logs(tx_hash);
+CREATE INDEX IF NOT EXISTS idx_eth_logs_address ON eth_logs(address, topic0);
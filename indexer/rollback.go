@@ -0,0 +1,8 @@
+package indexer
+
+// RollbackIndexer is implemented by the EVM indexers able to roll back their
+// entries, along with the node state, e.g. to recover from a bad block.
+type RollbackIndexer interface {
+	// Rollback deletes the entries of the blocks above height.
+	Rollback(height int64) error
+}
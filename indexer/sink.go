@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+var _ cosmosevmtypes.EVMTxIndexer = &SinkIndexer{}
+
+// TxSink receives the eth txs of every indexed block, to stream them to an
+// external system.
+type TxSink interface {
+	WriteBlock(height int64, txs []IndexedTx) error
+}
+
+// SinkIndexer wraps an EVMTxIndexer, streaming the eth txs of every block it
+// indexes to a TxSink. Queries are served by the wrapped indexer.
+type SinkIndexer struct {
+	cosmosevmtypes.EVMTxIndexer
+
+	sink      TxSink
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewSinkIndexer creates the SinkIndexer streaming the blocks indexed by idxer to sink.
+func NewSinkIndexer(idxer cosmosevmtypes.EVMTxIndexer, sink TxSink, logger log.Logger, clientCtx client.Context) *SinkIndexer {
+	return &SinkIndexer{
+		EVMTxIndexer: idxer,
+		sink:         sink,
+		logger:       logger,
+		clientCtx:    clientCtx,
+	}
+}
+
+// IndexBlock indexes the block with the wrapped indexer, then streams its eth txs to the sink.
+func (s *SinkIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	if err := s.EVMTxIndexer.IndexBlock(block, txResults); err != nil {
+		return err
+	}
+
+	txs := ParseBlock(s.clientCtx.TxConfig.TxDecoder(), s.logger, block, txResults)
+	if err := s.sink.WriteBlock(block.Height, txs); err != nil {
+		return errorsmod.Wrapf(err, "failed to stream block %d", block.Height)
+	}
+	return nil
+}
+
+// VerifyBlock verifies the block with the wrapped indexer, if it supports backfill.
+func (s *SinkIndexer) VerifyBlock(height int64) error {
+	idxer, ok := s.EVMTxIndexer.(BackfillIndexer)
+	if !ok {
+		return errors.New("wrapped EVM indexer does not support backfill")
+	}
+	return idxer.VerifyBlock(height)
+}
+
+// Prune prunes the wrapped indexer, if it supports pruning.
+func (s *SinkIndexer) Prune(retainHeight int64, retainTime time.Time) (PruneResult, error) {
+	idxer, ok := s.EVMTxIndexer.(PruningIndexer)
+	if !ok {
+		return PruneResult{}, errors.New("wrapped EVM indexer does not support pruning")
+	}
+	return idxer.Prune(retainHeight, retainTime)
+}
+
+// Rollback rolls back the wrapped indexer, if it supports rollback.
+func (s *SinkIndexer) Rollback(height int64) error {
+	idxer, ok := s.EVMTxIndexer.(RollbackIndexer)
+	if !ok {
+		return errors.New("wrapped EVM indexer does not support rollback")
+	}
+	return idxer.Rollback(height)
+}
+
+// LastFinalizedBlock returns the latest finalized block of the wrapped indexer, if it
+// records the finality of the blocks.
+func (s *SinkIndexer) LastFinalizedBlock() (int64, error) {
+	idxer, ok := s.EVMTxIndexer.(FinalityIndexer)
+	if !ok {
+		return 0, errors.New("wrapped EVM indexer does not record finality")
+	}
+	return idxer.LastFinalizedBlock()
+}
+
+// JSONSink is a TxSink writing every eth tx as a line of JSON, e.g. to a file or
+// a named pipe consumed by an external indexer.
+type JSONSink struct {
+	mtx sync.Mutex
+	enc *json.Encoder
+}
+
+// NewJSONSink creates the JSONSink writing to w.
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{enc: json.NewEncoder(w)}
+}
+
+// JSONSinkTx is the record written by the JSONSink for every eth tx.
+type JSONSinkTx struct {
+	Hash              common.Hash     `json:"hash"`
+	Height            int64           `json:"height"`
+	TxIndex           uint32          `json:"txIndex"`
+	MsgIndex          uint32          `json:"msgIndex"`
+	EthTxIndex        int32           `json:"ethTxIndex"`
+	Failed            bool            `json:"failed"`
+	GasUsed           uint64          `json:"gasUsed"`
+	CumulativeGasUsed uint64          `json:"cumulativeGasUsed"`
+	Logs              []*ethtypes.Log `json:"logs"`
+}
+
+// WriteBlock implements TxSink.
+func (s *JSONSink) WriteBlock(_ int64, txs []IndexedTx) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, tx := range txs {
+		logs := tx.Logs
+		if logs == nil {
+			logs = []*ethtypes.Log{}
+		}
+		if err := s.enc.Encode(JSONSinkTx{
+			Hash:              tx.Hash,
+			Height:            tx.Result.Height,
+			TxIndex:           tx.Result.TxIndex,
+			MsgIndex:          tx.Result.MsgIndex,
+			EthTxIndex:        tx.Result.EthTxIndex,
+			Failed:            tx.Result.Failed,
+			GasUsed:           tx.Result.GasUsed,
+			CumulativeGasUsed: tx.Result.CumulativeGasUsed,
+			Logs:              logs,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+)
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONSink(&buf)
+
+	txs := []IndexedTx{
+		{
+			Hash:   common.HexToHash("0x01"),
+			Result: cosmosevmtypes.TxResult{Height: 10, TxIndex: 0, EthTxIndex: 0, GasUsed: 21000, CumulativeGasUsed: 21000},
+		},
+		{
+			Hash:   common.HexToHash("0x02"),
+			Result: cosmosevmtypes.TxResult{Height: 10, TxIndex: 1, EthTxIndex: 1, GasUsed: 30000, CumulativeGasUsed: 30000},
+			Logs: []*ethtypes.Log{
+				{Address: common.HexToAddress("0x03"), Topics: []common.Hash{common.HexToHash("0x04")}, Data: []byte{0x05}},
+			},
+		},
+	}
+	require.NoError(t, sink.WriteBlock(10, txs))
+	require.NoError(t, sink.WriteBlock(11, nil))
+
+	dec := json.NewDecoder(&buf)
+	for _, tx := range txs {
+		var record JSONSinkTx
+		require.NoError(t, dec.Decode(&record))
+		require.Equal(t, tx.Hash, record.Hash)
+		require.Equal(t, tx.Result.Height, record.Height)
+		require.Equal(t, tx.Result.EthTxIndex, record.EthTxIndex)
+		require.Equal(t, tx.Result.GasUsed, record.GasUsed)
+		require.Len(t, record.Logs, len(tx.Logs))
+		for i, l := range tx.Logs {
+			require.Equal(t, l.Address, record.Logs[i].Address)
+			require.Equal(t, l.Topics, record.Logs[i].Topics)
+			require.Equal(t, l.Data, record.Logs[i].Data)
+		}
+	}
+	require.False(t, dec.More())
+}
@@ -5,6 +5,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -20,9 +22,13 @@ func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 			// detect if there is a nonce gap error (only returned for EVM transactions)
 			if errors.Is(err, ErrNonceGap) || errors.Is(err, ErrNonceLow) {
 				// send it to the mempool for further triage
-				err := mempool.InsertInvalidNonce(request.Tx)
-				if err != nil {
-					return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
+				if insertErr := mempool.InsertInvalidNonce(request.Tx); insertErr != nil {
+					return sdkerrors.ResponseCheckTxWithEvents(insertErr, gInfo.GasWanted, gInfo.GasUsed, anteEvents, false), nil
+				}
+				if errors.Is(err, ErrNonceGap) {
+					// the transaction is kept locally and will be rebroadcasted once
+					// executable, flag it so clients can tell it apart from a rejection
+					err = errorsmod.Wrap(ErrTxQueued, err.Error())
 				}
 			}
 			// anything else, return regular error
@@ -34,7 +40,9 @@ func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 			GasUsed:   int64(gInfo.GasUsed),   // #nosec G115 -- this is copied from the Cosmos SDK
 			Log:       result.Log,
 			Data:      result.Data,
-			Events:    types.MarkEventsToIndex(result.Events, nil),
+			// NOTE: the ante handler events are included since they carry the
+			// priority assigned to the transaction.
+			Events: types.MarkEventsToIndex(append(anteEvents, result.Events...), nil),
 		}, nil
 	}
 }
@@ -1,6 +1,14 @@
 package mempool
 
-import "errors"
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of the errors returned to the clients by the
+// mempool CheckTx handler.
+const Codespace = "mempool"
 
 var (
 	ErrNoMessages         = errors.New("transaction has no messages")
@@ -9,4 +17,15 @@ var (
 	ErrNotEVMTransaction  = errors.New("transaction is not an EVM transaction")
 	ErrNonceGap           = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow           = errors.New("tx nonce is lower than account nonce")
+	// ErrTxQueued is registered so that its ABCI code survives the CheckTx
+	// response and can be matched by the clients decoding it.
+	ErrTxQueued = errorsmod.Register(Codespace, 2, "tx queued in the local mempool")
 )
+
+// IsTxQueuedError returns true if the given error was returned by CheckTx for
+// a transaction that was not executable yet but was queued in the local
+// mempool for future execution. The error decoded from the CheckTx response
+// with errorsmod.ABCIError matches ErrTxQueued by its codespace and code.
+func IsTxQueuedError(err error) bool {
+	return errors.Is(err, ErrTxQueued)
+}
@@ -248,7 +248,23 @@ func (i *EVMMempoolIterator) advanceCurrentIterator() {
 
 // extractCosmosEffectiveTip extracts the effective gas tip from a Cosmos transaction
 // This aligns with EVM transaction prioritization by calculating: gas_price - base_fee
+// The Ethereum transactions kept in the Cosmos pool use the lowest effective tip of
+// their messages instead.
 func (i *EVMMempoolIterator) extractCosmosEffectiveTip(tx sdk.Tx) *uint256.Int {
+	if ethTxs := getEthereumTxs(tx); len(ethTxs) > 0 {
+		var baseFee *big.Int
+		if baseFeeUint := i.getCurrentBaseFee(); baseFeeUint != nil {
+			baseFee = baseFeeUint.ToBig()
+		}
+		effectiveTip, overflow := uint256.FromBig(getEffectiveGasTip(ethTxs, baseFee))
+		if overflow {
+			i.logger.Debug("overflowed on effective tip calculation")
+			return nil
+		}
+		i.logger.Debug("calculated effective tip of Ethereum transactions", "effective_tip", effectiveTip.String())
+		return effectiveTip
+	}
+
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		i.logger.Debug("Cosmos transaction doesn't implement FeeTx interface")
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/holiman/uint256"
 
@@ -14,7 +15,9 @@ import (
 	"github.com/cosmos/evm/mempool/miner"
 	"github.com/cosmos/evm/mempool/txpool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
+	"github.com/cosmos/evm/mempool/txpool/locals"
 	"github.com/cosmos/evm/rpc/stream"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -50,6 +53,10 @@ type (
 		legacyTxPool *legacypool.LegacyPool
 		cosmosPool   sdkmempool.ExtMempool
 
+		// localTxTracker journals the EVM transactions submitted to this node and
+		// resubmits them to the pool if they get lost, e.g. after a restart
+		localTxTracker *locals.TxTracker
+
 		/** Utils **/
 		logger        log.Logger
 		txConfig      client.TxConfig
@@ -58,6 +65,7 @@ type (
 		evmDenom      string
 		blockGasLimit uint64 // Block gas limit from consensus parameters
 		minTip        *uint256.Int
+		senderQuota   *senderGasQuota
 
 		/** Verification **/
 		anteHandler sdk.AnteHandler
@@ -66,7 +74,16 @@ type (
 		mtx sync.Mutex
 
 		eventBus *cmttypes.EventBus
+
+		/** Listeners **/
+		droppedTxListeners []DroppedTxListener
+		listenersMtx       sync.RWMutex
 	}
+
+	// DroppedTxListener is notified with the hash of an EVM transaction dropped
+	// from the mempool in favour of a same nonce replacement, along with the
+	// hash of the replacement transaction.
+	DroppedTxListener func(hash, replacedBy common.Hash)
 )
 
 // EVMMempoolConfig contains configuration options for creating an EVMsdkmempool.
@@ -79,6 +96,7 @@ type EVMMempoolConfig struct {
 	BroadCastTxFn    func(txs []*ethtypes.Transaction) error
 	BlockGasLimit    uint64 // Block gas limit from consensus parameters
 	MinTip           *uint256.Int
+	SenderGasQuota   *SenderGasQuotaConfig
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
@@ -112,6 +130,8 @@ func NewExperimentalEVMMempool(getCtxCallback func(height int64, prove bool) (sd
 
 	// Create txPool from configuration
 	legacyConfig := legacypool.DefaultConfig
+	// the local transactions journal must be explicitly configured with an absolute path
+	legacyConfig.Journal = ""
 	if config.LegacyPoolConfig != nil {
 		legacyConfig = *config.LegacyPoolConfig
 	}
@@ -131,7 +151,13 @@ func NewExperimentalEVMMempool(getCtxCallback func(height int64, prove bool) (sd
 		}
 	}
 
-	txPool, err := txpool.New(uint64(0), blockchain, []txpool.SubPool{legacyPool})
+	// the minimum gas price for acceptance is only enforced when explicitly configured
+	var priceLimit uint64
+	if config.LegacyPoolConfig != nil {
+		priceLimit = config.LegacyPoolConfig.PriceLimit
+	}
+
+	txPool, err := txpool.New(priceLimit, blockchain, []txpool.SubPool{legacyPool})
 	if err != nil {
 		panic(err)
 	}
@@ -146,28 +172,7 @@ func NewExperimentalEVMMempool(getCtxCallback func(height int64, prove bool) (sd
 	// Create Cosmos Mempool from configuration
 	cosmosPoolConfig := config.CosmosPoolConfig
 	if cosmosPoolConfig == nil {
-		// Default configuration
-		defaultConfig := sdkmempool.PriorityNonceMempoolConfig[math.Int]{}
-		defaultConfig.TxPriority = sdkmempool.TxPriority[math.Int]{
-			GetTxPriority: func(goCtx context.Context, tx sdk.Tx) math.Int {
-				cosmosTxFee, ok := tx.(sdk.FeeTx)
-				if !ok {
-					return math.ZeroInt()
-				}
-				found, coin := cosmosTxFee.GetFee().Find(bondDenom)
-				if !found {
-					return math.ZeroInt()
-				}
-
-				gasPrice := coin.Amount.Quo(math.NewIntFromUint64(cosmosTxFee.GetGas()))
-
-				return gasPrice
-			},
-			Compare: func(a, b math.Int) int {
-				return a.BigInt().Cmp(b.BigInt())
-			},
-			MinValue: math.ZeroInt(),
-		}
+		defaultConfig := defaultCosmosPoolConfig(vmKeeper, bondDenom)
 		cosmosPoolConfig = &defaultConfig
 	}
 
@@ -185,9 +190,26 @@ func NewExperimentalEVMMempool(getCtxCallback func(height int64, prove bool) (sd
 		evmDenom:      evmDenom,
 		blockGasLimit: config.BlockGasLimit,
 		minTip:        config.MinTip,
+		senderQuota:   newSenderGasQuota(config.SenderGasQuota),
 		anteHandler:   config.AnteHandler,
 	}
 
+	evmMempool.legacyTxPool.ReplacedTxFn = func(old, replacement *ethtypes.Transaction) {
+		logger.Debug("EVM transaction replaced", "tx_hash", old.Hash(), "replaced_by", replacement.Hash())
+		evmMempool.listenersMtx.RLock()
+		defer evmMempool.listenersMtx.RUnlock()
+		for _, listener := range evmMempool.droppedTxListeners {
+			listener(old.Hash(), replacement.Hash())
+		}
+	}
+
+	if !legacyConfig.NoLocals {
+		evmMempool.localTxTracker = locals.New(legacyConfig.Journal, legacyConfig.Rejournal, blockchain.Config(), txPool)
+		if err := evmMempool.localTxTracker.Start(); err != nil {
+			panic(err)
+		}
+	}
+
 	vmKeeper.SetEvmMempool(evmMempool)
 
 	return evmMempool
@@ -205,6 +227,24 @@ func (m *ExperimentalEVMMempool) GetTxPool() *txpool.TxPool {
 	return m.txPool
 }
 
+// TrackLocalTx tracks an EVM transaction submitted to this node, so that it
+// survives node restarts through the journal and gets resubmitted to the pool
+// until included in a block. It is a no-op if local transactions are disabled.
+func (m *ExperimentalEVMMempool) TrackLocalTx(tx *ethtypes.Transaction) {
+	if m.localTxTracker != nil {
+		m.localTxTracker.Track(tx)
+	}
+}
+
+// RegisterDroppedTxListener registers a listener notified when an EVM transaction
+// is replaced by a same nonce transaction paying the required price bump.
+// Listeners are called while the EVM pool is locked and must not block.
+func (m *ExperimentalEVMMempool) RegisterDroppedTxListener(listener DroppedTxListener) {
+	m.listenersMtx.Lock()
+	defer m.listenersMtx.Unlock()
+	m.droppedTxListeners = append(m.droppedTxListeners, listener)
+}
+
 // Insert adds a transaction to the appropriate mempool (EVM or Cosmos).
 // EVM transactions are routed to the EVM transaction pool, while all other
 // transactions are inserted into the Cosmos sdkmempool. The method assumes
@@ -218,7 +258,7 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 
 	m.logger.Debug("inserting transaction into mempool", "block_height", blockHeight)
 	ethMsg, err := m.getEVMMessage(tx)
-	if err == nil {
+	if err == nil && !hasCustomFeePayment(tx) {
 		// Insert into EVM pool
 		hash := ethMsg.Hash()
 		m.logger.Debug("inserting EVM transaction", "tx_hash", hash)
@@ -246,6 +286,8 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 // InsertInvalidNonce handles transactions that failed with nonce gap errors.
 // It attempts to insert EVM transactions into the pool as non-local transactions,
 // allowing them to be queued for future execution when the nonce gap is filled.
+// Batches of EVM transactions are inserted into the Cosmos pool, where they are
+// kept, and are dropped by the proposal verification if still not executable.
 // Non-EVM transactions are discarded as regular Cosmos flows do not support nonce gaps.
 func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 	tx, err := m.txConfig.TxDecoder()(txBytes)
@@ -255,6 +297,9 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 
 	var ethTxs []*ethtypes.Transaction
 	msgs := tx.GetMsgs()
+	if len(msgs) > 1 {
+		return m.insertInvalidNonceBatch(tx)
+	}
 	if len(msgs) != 1 {
 		return fmt.Errorf("%w, got %d", ErrExpectedOneMessage, len(msgs))
 	}
@@ -275,6 +320,31 @@ func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 	return nil
 }
 
+// insertInvalidNonceBatch inserts a batch of EVM transactions with a nonce gap
+// into the Cosmos pool, unless the nonce of any of them is already used.
+func (m *ExperimentalEVMMempool) insertInvalidNonceBatch(tx sdk.Tx) error {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return err
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ErrNotEVMTransaction
+		}
+		account := m.vmKeeper.GetAccount(ctx, common.BytesToAddress(ethMsg.GetFrom()))
+		if account != nil && ethMsg.AsTransaction().Nonce() < account.Nonce {
+			return ErrNonceLow
+		}
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.cosmosPool.Insert(ctx, tx)
+}
+
 // Select returns a unified iterator over both EVM and Cosmos transactions.
 // The iterator prioritizes transactions based on their fees and manages proper
 // sequencing. The i parameter contains transaction hashes to exclude from selection.
@@ -306,7 +376,7 @@ func (m *ExperimentalEVMMempool) Remove(tx sdk.Tx) error {
 	m.logger.Debug("removing transaction from mempool")
 
 	msg, err := m.getEVMMessage(tx)
-	if err == nil {
+	if err == nil && !hasCustomFeePayment(tx) {
 		// Comet will attempt to remove transactions from the mempool after completing successfully.
 		// We should not do this with EVM transactions because removing them causes the subsequent ones to
 		// be dequeued as temporarily invalid, only to be requeued a block later.
@@ -415,6 +485,12 @@ func (m *ExperimentalEVMMempool) Close() error {
 		}
 	}
 
+	if m.localTxTracker != nil {
+		if err := m.localTxTracker.Stop(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop local tx tracker: %w", err))
+		}
+	}
+
 	if err := m.txPool.Close(); err != nil {
 		errs = append(errs, fmt.Errorf("failed to close txpool: %w", err))
 	}
@@ -439,6 +515,27 @@ func (m *ExperimentalEVMMempool) getEVMMessage(tx sdk.Tx) (*evmtypes.MsgEthereum
 	return ethMsg, nil
 }
 
+// hasCustomFeePayment returns true if the transaction pays its fees in a
+// denomination other than the EVM one (fee abstraction) or through a fee grant.
+// The EVM pool rebuilds the Cosmos transaction wrapping an Ethereum transaction
+// with fees in the EVM denomination paid by the sender, so these transactions
+// are kept in the Cosmos pool instead.
+func hasCustomFeePayment(tx sdk.Tx) bool {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return false
+	}
+
+	if len(feeTx.FeeGranter()) > 0 {
+		return true
+	}
+
+	fees := feeTx.GetFee()
+	return len(fees) == 1 &&
+		fees[0].Denom != evmtypes.GetEVMCoinDenom() &&
+		fees[0].Denom != evmtypes.GetEVMCoinExtendedDenom()
+}
+
 // getIterators prepares iterators over pending EVM and Cosmos transactions.
 // It configures EVM transactions with proper base fee filtering and priority ordering,
 // while setting up the Cosmos iterator with the provided exclusion list.
@@ -460,6 +557,7 @@ func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte)
 		OnlyBlobTxs:  false,
 	}
 	evmPendingTxes := m.txPool.Pending(pendingFilter)
+	m.senderQuota.apply(evmPendingTxes, m.getBlockGasLimit(ctx))
 	orderedEVMPendingTxes := miner.NewTransactionsByPriceAndNonce(nil, evmPendingTxes, baseFee)
 
 	cosmosPendingTxes := m.cosmosPool.Select(ctx, i)
@@ -467,6 +565,16 @@ func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte)
 	return orderedEVMPendingTxes, cosmosPendingTxes
 }
 
+// getBlockGasLimit returns the block gas limit of the consensus parameters of
+// the context, which may be updated by governance, or the configured one if
+// the context has none.
+func (m *ExperimentalEVMMempool) getBlockGasLimit(ctx sdk.Context) uint64 {
+	if blockGasLimit := cosmosevmtypes.BlockGasLimit(ctx); blockGasLimit > 0 {
+		return blockGasLimit
+	}
+	return m.blockGasLimit
+}
+
 // broadcastEVMTransactions converts Ethereum transactions to Cosmos SDK format and broadcasts them.
 // This function wraps EVM transactions in MsgEthereumTx messages and submits them to the network
 // using the provided client context. It handles encoding and error reporting for each transaction.
@@ -0,0 +1,64 @@
+package mempool
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/mempool/miner"
+	"github.com/cosmos/evm/mempool/txpool"
+)
+
+// PendingBlockTxs returns the executable EVM transactions of the pool in the
+// order a block proposal would select them, until the block gas limit is
+// reached. It is used to assemble the synthetic `pending` block served by the
+// JSON-RPC.
+func (m *ExperimentalEVMMempool) PendingBlockTxs() ([]*ethtypes.Transaction, error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	baseFee := m.vmKeeper.GetBaseFee(ctx)
+	var baseFeeUint *uint256.Int
+	if baseFee != nil {
+		baseFeeUint = uint256.MustFromBig(baseFee)
+	}
+
+	pending := m.txPool.Pending(txpool.PendingFilter{
+		MinTip:       m.minTip,
+		BaseFee:      baseFeeUint,
+		OnlyPlainTxs: true,
+	})
+	blockGasLimit := m.getBlockGasLimit(ctx)
+	m.senderQuota.apply(pending, blockGasLimit)
+	ordered := miner.NewTransactionsByPriceAndNonce(nil, pending, baseFee)
+
+	var (
+		txs     []*ethtypes.Transaction
+		gasLeft = blockGasLimit
+	)
+	for !ordered.Empty() {
+		lazyTx, _ := ordered.Peek()
+		if lazyTx.Gas > gasLeft {
+			// the following transactions of the sender can't be included either
+			ordered.Pop()
+			continue
+		}
+
+		tx := lazyTx.Resolve()
+		if tx == nil {
+			// the transaction was evicted from the pool in the meantime
+			ordered.Pop()
+			continue
+		}
+
+		txs = append(txs, tx)
+		gasLeft -= lazyTx.Gas
+		ordered.Shift()
+	}
+
+	return txs, nil
+}
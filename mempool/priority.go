@@ -0,0 +1,112 @@
+package mempool
+
+import (
+	"context"
+	"math/big"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// defaultCosmosPoolConfig returns the configuration of the Cosmos pool ordering
+// the transactions by gas price. The Ethereum transactions kept in the Cosmos
+// pool, i.e. batches and transactions paying custom fees, are ordered by their
+// effective gas price at the current base fee, which is equivalent to ordering
+// them by effective tip, while the other transactions fall back to the gas
+// price of their fees in the bond denomination.
+func defaultCosmosPoolConfig(vmKeeper VMKeeperI, bondDenom string) sdkmempool.PriorityNonceMempoolConfig[math.Int] {
+	config := sdkmempool.PriorityNonceMempoolConfig[math.Int]{}
+	config.TxPriority = sdkmempool.TxPriority[math.Int]{
+		GetTxPriority: func(goCtx context.Context, tx sdk.Tx) math.Int {
+			if ethTxs := getEthereumTxs(tx); len(ethTxs) > 0 {
+				baseFee := vmKeeper.GetBaseFee(sdk.UnwrapSDKContext(goCtx))
+				return math.NewIntFromBigInt(getEffectiveGasPrice(ethTxs, baseFee))
+			}
+
+			cosmosTxFee, ok := tx.(sdk.FeeTx)
+			if !ok {
+				return math.ZeroInt()
+			}
+			found, coin := cosmosTxFee.GetFee().Find(bondDenom)
+			if !found {
+				return math.ZeroInt()
+			}
+
+			gasPrice := coin.Amount.Quo(math.NewIntFromUint64(cosmosTxFee.GetGas()))
+
+			return gasPrice
+		},
+		Compare: func(a, b math.Int) int {
+			return a.BigInt().Cmp(b.BigInt())
+		},
+		MinValue: math.ZeroInt(),
+	}
+	// the Ethereum transactions are signed by the sender of their messages
+	config.SignerExtractor = NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter())
+
+	return config
+}
+
+// getEthereumTxs returns the Ethereum transactions wrapped by the given
+// transaction, or nil if any of its messages is not a MsgEthereumTx.
+func getEthereumTxs(tx sdk.Tx) []*ethtypes.Transaction {
+	msgs := tx.GetMsgs()
+	ethTxs := make([]*ethtypes.Transaction, 0, len(msgs))
+	for _, msg := range msgs {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil
+		}
+		ethTxs = append(ethTxs, ethMsg.AsTransaction())
+	}
+	return ethTxs
+}
+
+// getEffectiveGasPrice returns the lowest effective gas price of the given
+// Ethereum transactions at the base fee, i.e. the base fee plus their tip cap,
+// capped by their fee cap.
+func getEffectiveGasPrice(ethTxs []*ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	var minPrice *big.Int
+	for _, ethTx := range ethTxs {
+		price := new(big.Int).Set(ethTx.GasTipCap())
+		if baseFee != nil {
+			price.Add(price, baseFee)
+		}
+		if price.Cmp(ethTx.GasFeeCap()) > 0 {
+			price.Set(ethTx.GasFeeCap())
+		}
+		if minPrice == nil || price.Cmp(minPrice) < 0 {
+			minPrice = price
+		}
+	}
+	if minPrice == nil {
+		return new(big.Int)
+	}
+	return minPrice
+}
+
+// getEffectiveGasTip returns the lowest effective tip over the base fee of the
+// given Ethereum transactions. A transaction whose fee cap is below the base
+// fee has a zero effective tip.
+func getEffectiveGasTip(ethTxs []*ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	var minTip *big.Int
+	for _, ethTx := range ethTxs {
+		tip, err := ethTx.EffectiveGasTip(baseFee)
+		if err != nil {
+			return new(big.Int)
+		}
+		if minTip == nil || tip.Cmp(minTip) < 0 {
+			minTip = tip
+		}
+	}
+	if minTip == nil {
+		return new(big.Int)
+	}
+	return minTip
+}
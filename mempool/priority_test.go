@@ -0,0 +1,223 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type baseFeeVMKeeper struct {
+	VMKeeperI
+	baseFee *big.Int
+}
+
+func (k baseFeeVMKeeper) GetBaseFee(_ sdk.Context) *big.Int { return k.baseFee }
+
+type mockFeeTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx mockFeeTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockFeeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockFeeTx) GetGas() uint64                        { return tx.gas }
+func (tx mockFeeTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx mockFeeTx) FeePayer() []byte                      { return nil }
+func (tx mockFeeTx) FeeGranter() []byte                    { return nil }
+
+// mockEthTx is a Cosmos transaction wrapping Ethereum messages, identified by
+// its bytes.
+type mockEthTx struct {
+	mockFeeTx
+	bz []byte
+}
+
+func (tx mockEthTx) GetExtensionOptions() []*codectypes.Any {
+	return []*codectypes.Any{{TypeUrl: "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx"}}
+}
+func (tx mockEthTx) GetNonCriticalExtensionOptions() []*codectypes.Any { return nil }
+
+// mockTxVerifier accepts all the transactions proposed.
+type mockTxVerifier struct{}
+
+func (mockTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	return tx.(mockEthTx).bz, nil
+}
+func (mockTxVerifier) ProcessProposalVerifyTx(_ []byte) (sdk.Tx, error) { return nil, nil }
+func (mockTxVerifier) TxDecode(_ []byte) (sdk.Tx, error)                { return nil, nil }
+func (mockTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error)               { return tx.(mockEthTx).bz, nil }
+
+func dynamicFeeMsg(gasFeeCap, gasTipCap int64) *evmtypes.MsgEthereumTx {
+	return evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9001),
+		GasLimit:  21000,
+		GasFeeCap: big.NewInt(gasFeeCap),
+		GasTipCap: big.NewInt(gasTipCap),
+	})
+}
+
+func TestGetEffectiveGasTip(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msgs    []*evmtypes.MsgEthereumTx
+		baseFee *big.Int
+		expTip  *big.Int
+	}{
+		{
+			name:    "tip capped by the fee cap over the base fee",
+			msgs:    []*evmtypes.MsgEthereumTx{dynamicFeeMsg(150, 100)},
+			baseFee: big.NewInt(100),
+			expTip:  big.NewInt(50),
+		},
+		{
+			name:    "lowest tip of the messages",
+			msgs:    []*evmtypes.MsgEthereumTx{dynamicFeeMsg(1000, 30), dynamicFeeMsg(1000, 10), dynamicFeeMsg(1000, 20)},
+			baseFee: big.NewInt(100),
+			expTip:  big.NewInt(10),
+		},
+		{
+			name:    "zero tip when a fee cap is below the base fee",
+			msgs:    []*evmtypes.MsgEthereumTx{dynamicFeeMsg(1000, 30), dynamicFeeMsg(50, 10)},
+			baseFee: big.NewInt(100),
+			expTip:  big.NewInt(0),
+		},
+		{
+			name:   "tip cap without base fee",
+			msgs:   []*evmtypes.MsgEthereumTx{dynamicFeeMsg(1000, 30)},
+			expTip: big.NewInt(30),
+		},
+		{
+			name: "gas price over the base fee for legacy transactions",
+			msgs: []*evmtypes.MsgEthereumTx{evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				GasLimit: 21000,
+				GasPrice: big.NewInt(300),
+			})},
+			baseFee: big.NewInt(100),
+			expTip:  big.NewInt(200),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ethTxs := make([]*ethtypes.Transaction, len(tc.msgs))
+			for i, msg := range tc.msgs {
+				ethTxs[i] = msg.AsTransaction()
+			}
+			require.Equal(t, tc.expTip, getEffectiveGasTip(ethTxs, tc.baseFee))
+		})
+	}
+}
+
+func TestDefaultCosmosPoolPriority(t *testing.T) {
+	config := defaultCosmosPoolConfig(baseFeeVMKeeper{baseFee: big.NewInt(100)}, "aatom")
+	getTxPriority := config.TxPriority.GetTxPriority
+
+	testCases := []struct {
+		name        string
+		tx          sdk.Tx
+		expPriority math.Int
+	}{
+		{
+			name: "Ethereum transactions at their effective gas price",
+			tx: mockFeeTx{
+				msgs: []sdk.Msg{dynamicFeeMsg(1000, 30), dynamicFeeMsg(1000, 10)},
+				// the fees of the Cosmos transaction are ignored
+				fee: sdk.NewCoins(sdk.NewInt64Coin("aatom", 42000000)),
+				gas: 42000,
+			},
+			expPriority: math.NewInt(110),
+		},
+		{
+			name: "Ethereum transactions below the base fee at their fee cap",
+			tx: mockFeeTx{
+				msgs: []sdk.Msg{dynamicFeeMsg(1000, 30), dynamicFeeMsg(50, 10)},
+			},
+			expPriority: math.NewInt(50),
+		},
+		{
+			name: "Cosmos transactions at the gas price of their fees",
+			tx: mockFeeTx{
+				msgs: []sdk.Msg{&banktypes.MsgSend{}},
+				fee:  sdk.NewCoins(sdk.NewInt64Coin("aatom", 500000)),
+				gas:  2000,
+			},
+			expPriority: math.NewInt(250),
+		},
+		{
+			name: "Cosmos transactions without fees in the bond denom",
+			tx: mockFeeTx{
+				msgs: []sdk.Msg{&banktypes.MsgSend{}},
+				fee:  sdk.NewCoins(sdk.NewInt64Coin("ufoo", 500000)),
+				gas:  2000,
+			},
+			expPriority: math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPriority, getTxPriority(sdk.Context{}, tc.tx))
+		})
+	}
+}
+
+func TestProposalSequencesBatchedSenders(t *testing.T) {
+	sender := sdk.AccAddress("sender")
+	legacyMsg := func(nonce uint64, gasPrice int64) sdk.Msg {
+		msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+			ChainID:  big.NewInt(9001),
+			Nonce:    nonce,
+			GasLimit: 21000,
+			GasPrice: big.NewInt(gasPrice),
+		})
+		msg.From = sender.Bytes()
+		return msg
+	}
+
+	batch := mockEthTx{
+		mockFeeTx: mockFeeTx{msgs: []sdk.Msg{legacyMsg(5, 100), legacyMsg(6, 100)}, gas: 42000},
+		bz:        []byte("batch"),
+	}
+	// the following tx pays a higher price, but is sequenced after the batch
+	next := mockEthTx{
+		mockFeeTx: mockFeeTx{msgs: []sdk.Msg{legacyMsg(7, 200)}, gas: 21000},
+		bz:        []byte("next"),
+	}
+
+	signerAdapter := NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter())
+	signers, err := signerAdapter.GetSigners(batch)
+	require.NoError(t, err)
+	require.Equal(t, []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(sender, 5),
+		sdkmempool.NewSignerData(sender, 6),
+	}, signers)
+
+	pool := sdkmempool.NewPriorityMempool(defaultCosmosPoolConfig(baseFeeVMKeeper{}, "aatom"))
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, pool.Insert(ctx, next))
+	require.NoError(t, pool.Insert(ctx, batch))
+
+	handler := baseapp.NewDefaultProposalHandler(pool, mockTxVerifier{})
+	handler.SetSignerExtractionAdapter(signerAdapter)
+	res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{batch.bz, next.bz}, res.Txs)
+}
@@ -0,0 +1,92 @@
+package mempool
+
+import (
+	"math/bits"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/cosmos/evm/mempool/txpool"
+)
+
+// senderQuotaMeter counts the pending EVM transactions deferred to a later
+// block because their sender exceeded its block gas quota.
+var senderQuotaMeter = metrics.NewRegisteredMeter("txpool/pending/senderquota", nil)
+
+// SenderGasQuotaConfig caps the share of the block gas limit the EVM
+// transactions of a single sender can occupy in a block proposal, so that a
+// single actor flooding a low-fee chain can't fill whole blocks. The deferred
+// transactions stay in the pool and are selected in the following blocks.
+//
+// The quota is a local policy of the block proposer: it only applies to the
+// proposals built by the nodes enabling it, and the other validators don't
+// reject a proposal exceeding it.
+type SenderGasQuotaConfig struct {
+	// Percent is the maximum percentage of the block gas limit a single sender
+	// can occupy. 0 disables the quota.
+	Percent uint64
+	// Allowlist contains the senders exempted from the quota, e.g. relayers or
+	// oracles.
+	Allowlist []common.Address
+}
+
+// senderGasQuota is the per-block gas quota enforced on the senders of the
+// EVM transactions selected from the pool.
+type senderGasQuota struct {
+	percent   uint64
+	allowlist map[common.Address]struct{}
+}
+
+// newSenderGasQuota returns the sender gas quota, or nil if the quota is
+// disabled.
+func newSenderGasQuota(config *SenderGasQuotaConfig) *senderGasQuota {
+	if config == nil || config.Percent == 0 || config.Percent >= 100 {
+		return nil
+	}
+
+	quota := &senderGasQuota{
+		percent:   config.Percent,
+		allowlist: make(map[common.Address]struct{}, len(config.Allowlist)),
+	}
+	for _, addr := range config.Allowlist {
+		quota.allowlist[addr] = struct{}{}
+	}
+	return quota
+}
+
+// limit returns the gas a single sender can use in a block with the given gas
+// limit.
+func (q *senderGasQuota) limit(blockGasLimit uint64) uint64 {
+	// the percentage is below 100, so the quotient fits in 64 bits
+	hi, lo := bits.Mul64(blockGasLimit, q.percent)
+	limit, _ := bits.Div64(hi, lo, 100)
+	return limit
+}
+
+// apply truncates the nonce ordered pending transactions of each sender not in
+// the allowlist once their cumulative gas limit exceeds the quota of a block
+// with the given gas limit. The first transaction of a sender is always kept,
+// so that a sender is never starved.
+func (q *senderGasQuota) apply(pending map[common.Address][]*txpool.LazyTransaction, blockGasLimit uint64) {
+	if q == nil {
+		return
+	}
+
+	limit := q.limit(blockGasLimit)
+
+	for sender, txs := range pending {
+		if _, ok := q.allowlist[sender]; ok || len(txs) == 0 {
+			continue
+		}
+
+		gasUsed := txs[0].Gas
+		for i := 1; i < len(txs); i++ {
+			if gasUsed+txs[i].Gas > limit {
+				senderQuotaMeter.Mark(int64(len(txs) - i))
+				pending[sender] = txs[:i]
+				break
+			}
+			gasUsed += txs[i].Gas
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package mempool
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/mempool/txpool"
+)
+
+func lazyTxs(gas ...uint64) []*txpool.LazyTransaction {
+	txs := make([]*txpool.LazyTransaction, 0, len(gas))
+	for _, g := range gas {
+		txs = append(txs, &txpool.LazyTransaction{Gas: g})
+	}
+	return txs
+}
+
+func TestNewSenderGasQuota(t *testing.T) {
+	require.Nil(t, newSenderGasQuota(nil))
+	require.Nil(t, newSenderGasQuota(&SenderGasQuotaConfig{}))
+	require.Nil(t, newSenderGasQuota(&SenderGasQuotaConfig{Percent: 100}))
+
+	quota := newSenderGasQuota(&SenderGasQuotaConfig{Percent: 25})
+	require.NotNil(t, quota)
+	require.Equal(t, uint64(250_000), quota.limit(1_000_000))
+	// the limit isn't rounded down to a multiple of the percentage
+	require.Equal(t, uint64(24), quota.limit(99))
+	// nor overflows for unlimited blocks
+	require.Equal(t, uint64(math.MaxUint64/4), quota.limit(math.MaxUint64))
+}
+
+func TestSenderGasQuotaApply(t *testing.T) {
+	var (
+		flooder = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		allowed = common.HexToAddress("0x1000000000000000000000000000000000000002")
+		regular = common.HexToAddress("0x1000000000000000000000000000000000000003")
+		large   = common.HexToAddress("0x1000000000000000000000000000000000000004")
+	)
+
+	quota := newSenderGasQuota(&SenderGasQuotaConfig{
+		Percent:   10,
+		Allowlist: []common.Address{allowed},
+	})
+
+	pending := map[common.Address][]*txpool.LazyTransaction{
+		flooder: lazyTxs(40_000, 40_000, 20_000, 21_000, 21_000),
+		allowed: lazyTxs(60_000, 60_000, 60_000),
+		regular: lazyTxs(21_000, 21_000),
+		large:   lazyTxs(500_000, 21_000),
+	}
+	quota.apply(pending, 1_000_000)
+
+	require.Len(t, pending[flooder], 3, "transactions beyond the quota are deferred")
+	require.Len(t, pending[allowed], 3, "allowlisted senders are exempted")
+	require.Len(t, pending[regular], 2, "transactions within the quota are kept")
+	require.Len(t, pending[large], 1, "the first transaction of a sender is always kept")
+
+	// a disabled quota is a no-op
+	var disabled *senderGasQuota
+	pending = map[common.Address][]*txpool.LazyTransaction{flooder: lazyTxs(1_000_000, 1_000_000)}
+	disabled.apply(pending, 1_000_000)
+	require.Len(t, pending[flooder], 2)
+}
+
+func TestSenderGasQuotaFollowsBlockGasLimit(t *testing.T) {
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	quota := newSenderGasQuota(&SenderGasQuotaConfig{Percent: 10})
+
+	// the quota is a share of the block gas limit of the current consensus
+	// parameters, so raising it makes room for more transactions
+	pending := map[common.Address][]*txpool.LazyTransaction{sender: lazyTxs(21_000, 21_000, 21_000, 21_000)}
+	quota.apply(pending, 500_000)
+	require.Len(t, pending[sender], 2)
+
+	pending = map[common.Address][]*txpool.LazyTransaction{sender: lazyTxs(21_000, 21_000, 21_000, 21_000)}
+	quota.apply(pending, 1_000_000)
+	require.Len(t, pending[sender], 4)
+}
@@ -21,22 +21,27 @@ func NewEthSignerExtractionAdapter(fallback mempool.SignerExtractionAdapter) Eth
 	return EthSignerExtractionAdapter{fallback}
 }
 
-// GetSigners implements the Adapter interface
-// NOTE: only the first item is used by the mempool
+// GetSigners implements the Adapter interface. It returns the sender and nonce of
+// every Ethereum message of the transaction, in order, so that the transactions
+// following a batch of Ethereum messages are sequenced after all its messages.
+// NOTE: the Cosmos pool only orders the transactions by their first signer, while
+// the proposal handler checks the sequences of all of them.
 func (s EthSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]mempool.SignerData, error) {
 	if txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx); ok {
 		opts := txWithExtensions.GetExtensionOptions()
 		if len(opts) > 0 && opts[0].GetTypeUrl() == "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx" {
+			var signers []mempool.SignerData
 			for _, msg := range tx.GetMsgs() {
 				if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
-					return []mempool.SignerData{
-						mempool.NewSignerData(
-							ethMsg.GetFrom(),
-							ethMsg.AsTransaction().Nonce(),
-						),
-					}, nil
+					signers = append(signers, mempool.NewSignerData(
+						ethMsg.GetFrom(),
+						ethMsg.AsTransaction().Nonce(),
+					))
 				}
 			}
+			if len(signers) > 0 {
+				return signers, nil
+			}
 		}
 	}
 
@@ -102,6 +102,7 @@ var (
 	invalidTxMeter     = metrics.NewRegisteredMeter("txpool/invalid", nil)
 	underpricedTxMeter = metrics.NewRegisteredMeter("txpool/underpriced", nil)
 	overflowedTxMeter  = metrics.NewRegisteredMeter("txpool/overflowed", nil)
+	evictedTxMeter     = metrics.NewRegisteredMeter("txpool/evicted", nil) // Dropped to make room for better priced transactions
 
 	// throttleTxMeter counts how many transactions are rejected due to too-many-changes between
 	// txpool reorgs.
@@ -256,6 +257,11 @@ type LegacyPool struct {
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
 	BroadcastTxFn func(txs []*types.Transaction) error
+
+	// ReplacedTxFn is called when a transaction is dropped in favour of a same
+	// nonce transaction paying the required price bump. It is invoked while the
+	// pool lock is held and must not block.
+	ReplacedTxFn func(old, replacement *types.Transaction)
 }
 
 type txpoolResetRequest struct {
@@ -768,6 +774,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		for _, tx := range drop {
 			log.Trace("Discarding freshly underpriced transaction", "hash", tx.Hash(), "gasTipCap", tx.GasTipCap(), "gasFeeCap", tx.GasFeeCap())
 			underpricedTxMeter.Mark(1)
+			evictedTxMeter.Mark(1)
 
 			sender, _ := types.Sender(pool.signer, tx)
 			dropped := pool.RemoveTx(tx.Hash(), false, sender != from) // Don't unreserve the sender of the tx being added if last from the acc
@@ -789,6 +796,7 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 			pool.all.Remove(old.Hash())
 			pool.priced.Removed(1)
 			pendingReplaceMeter.Mark(1)
+			pool.notifyReplaced(old, tx)
 		}
 		pool.all.Add(tx)
 		pool.priced.Put(tx)
@@ -853,6 +861,7 @@ func (pool *LegacyPool) enqueueTx(hash common.Hash, tx *types.Transaction, addAl
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		queuedReplaceMeter.Mark(1)
+		pool.notifyReplaced(old, tx)
 	} else {
 		// Nothing was replaced, bump the queued counter
 		queuedGauge.Inc(1)
@@ -897,6 +906,7 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 		pool.all.Remove(old.Hash())
 		pool.priced.Removed(1)
 		pendingReplaceMeter.Mark(1)
+		pool.notifyReplaced(old, tx)
 	} else {
 		// Nothing was replaced, bump the pending counter
 		pendingGauge.Inc(1)
@@ -909,6 +919,14 @@ func (pool *LegacyPool) promoteTx(addr common.Address, hash common.Hash, tx *typ
 	return true
 }
 
+// notifyReplaced notifies the replacement of a transaction to the registered
+// callback, if any.
+func (pool *LegacyPool) notifyReplaced(old, replacement *types.Transaction) {
+	if pool.ReplacedTxFn != nil {
+		pool.ReplacedTxFn(old, replacement)
+	}
+}
+
 // addRemotes enqueues a batch of transactions into the pool if they are valid.
 // Full pricing constraints will apply.
 //
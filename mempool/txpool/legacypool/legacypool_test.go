@@ -2048,6 +2048,59 @@ func TestReplacement(t *testing.T) {
 	}
 }
 
+// Tests that the replacement callback is notified with the dropped and the
+// replacement transactions, for both pending and queued transactions.
+func TestReplacementNotification(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+	testAddBalance(pool, crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1000000000))
+
+	var replaced [][2]common.Hash
+	pool.ReplacedTxFn = func(old, replacement *types.Transaction) {
+		replaced = append(replaced, [2]common.Hash{old.Hash(), replacement.Hash()})
+	}
+
+	pending := pricedTransaction(0, 100000, big.NewInt(100), key)
+	queued := pricedTransaction(2, 100000, big.NewInt(100), key)
+	if err := pool.addRemoteSync(pending); err != nil {
+		t.Fatalf("failed to add pending transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(queued); err != nil {
+		t.Fatalf("failed to add queued transaction: %v", err)
+	}
+	// an underpriced replacement must not be notified
+	if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(101), key)); !errors.Is(err, txpool.ErrReplaceUnderpriced) {
+		t.Fatalf("underpriced replacement error mismatch: have %v, want %v", err, txpool.ErrReplaceUnderpriced)
+	}
+	if len(replaced) != 0 {
+		t.Fatalf("unexpected replacement notifications: have %d, want 0", len(replaced))
+	}
+
+	pendingReplacement := pricedTransaction(0, 100000, big.NewInt(200), key)
+	queuedReplacement := pricedTransaction(2, 100000, big.NewInt(200), key)
+	if err := pool.addRemoteSync(pendingReplacement); err != nil {
+		t.Fatalf("failed to replace pending transaction: %v", err)
+	}
+	if err := pool.addRemoteSync(queuedReplacement); err != nil {
+		t.Fatalf("failed to replace queued transaction: %v", err)
+	}
+
+	want := [][2]common.Hash{
+		{pending.Hash(), pendingReplacement.Hash()},
+		{queued.Hash(), queuedReplacement.Hash()},
+	}
+	if len(replaced) != len(want) {
+		t.Fatalf("replacement notifications mismatch: have %d, want %d", len(replaced), len(want))
+	}
+	for i := range want {
+		if replaced[i] != want[i] {
+			t.Fatalf("replacement notification %d mismatch: have %v, want %v", i, replaced[i], want[i])
+		}
+	}
+}
+
 // Tests that the pool rejects replacement dynamic fee transactions that don't
 // meet the minimum price bump required.
 func TestReplacementDynamicFee(t *testing.T) {
@@ -0,0 +1,62 @@
+package locals
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	txs := make(types.Transactions, 3)
+	for i := range txs {
+		txs[i], err = types.SignTx(types.NewTransaction(uint64(i), common.Address{}, big.NewInt(100), 21000, big.NewInt(1), nil), types.HomesteadSigner{}, key)
+		require.NoError(t, err)
+	}
+
+	load := func(j *journal) types.Transactions {
+		var loaded types.Transactions
+		err := j.load(func(txs []*types.Transaction) []error {
+			loaded = append(loaded, txs...)
+			return nil
+		})
+		require.NoError(t, err)
+		return loaded
+	}
+
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+	j := newTxJournal(path)
+
+	// loading a missing journal is a no-op
+	require.Empty(t, load(j))
+	// inserting without an active journal fails
+	require.ErrorIs(t, j.insert(txs[0]), errNoActiveJournal)
+
+	// rotating opens the journal with the given transactions
+	require.NoError(t, j.rotate(map[common.Address]types.Transactions{addr: txs[:1]}))
+	require.NoError(t, j.insert(txs[1]))
+	require.NoError(t, j.insert(txs[2]))
+	require.NoError(t, j.close())
+
+	loaded := load(newTxJournal(path))
+	require.Len(t, loaded, len(txs))
+	for i, tx := range loaded {
+		require.Equal(t, txs[i].Hash(), tx.Hash())
+	}
+
+	// rotating drops the transactions that are not tracked anymore
+	require.NoError(t, j.rotate(map[common.Address]types.Transactions{addr: txs[2:]}))
+	require.NoError(t, j.close())
+
+	loaded = load(newTxJournal(path))
+	require.Len(t, loaded, 1)
+	require.Equal(t, txs[2].Hash(), loaded[0].Hash())
+}
@@ -0,0 +1,12 @@
+package authz
+
+const (
+	// ErrInvalidGranter is raised when the granter address is invalid.
+	ErrInvalidGranter = "invalid granter address: %v"
+	// ErrInvalidGrantee is raised when the grantee address is invalid.
+	ErrInvalidGrantee = "invalid grantee address: %v"
+	// ErrInvalidMsgTypeURL is raised when the message type URL is invalid.
+	ErrInvalidMsgTypeURL = "invalid msg type url: %v"
+	// ErrInvalidExpiration is raised when the expiration time is negative.
+	ErrInvalidExpiration = "invalid expiration: %d"
+)
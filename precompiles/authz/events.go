@@ -0,0 +1,51 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeGrant defines the event type for the authz Grant transaction.
+	EventTypeGrant = "Grant"
+	// EventTypeRevoke defines the event type for the authz Revoke transaction.
+	EventTypeRevoke = "Revoke"
+)
+
+// EventGrant is the event emitted on a Grant transaction.
+type EventGrant struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EventRevoke is the event emitted on a Revoke transaction.
+type EventRevoke struct {
+	Granter    common.Address
+	Grantee    common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EmitGrantEvent emits the Grant event.
+func (p Precompile) EmitGrantEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitGrantEvent(ctx, stateDB, EventTypeGrant, granter, grantee, msgTypeURL)
+}
+
+// EmitRevokeEvent emits the Revoke event.
+func (p Precompile) EmitRevokeEvent(ctx sdk.Context, stateDB vm.StateDB, granter, grantee common.Address, msgTypeURL string) error {
+	return p.emitGrantEvent(ctx, stateDB, EventTypeRevoke, granter, grantee, msgTypeURL)
+}
+
+// emitGrantEvent emits the given grant event, both Grant and Revoke events having
+// the same arguments.
+func (p Precompile) emitGrantEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	eventType string,
+	granter, grantee common.Address,
+	msgTypeURL string,
+) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[eventType], granter, grantee, msgTypeURL)
+}
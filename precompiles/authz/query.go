@@ -0,0 +1,90 @@
+package authz
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantsMethod defines the ABI method name for the authz Grants query.
+	GrantsMethod = "grants"
+	// GranterGrantsMethod defines the ABI method name for the authz GranterGrants query.
+	GranterGrantsMethod = "granterGrants"
+	// GranteeGrantsMethod defines the ABI method name for the authz GranteeGrants query.
+	GranteeGrantsMethod = "granteeGrants"
+)
+
+// Grants returns the grants from the granter to the grantee, optionally filtered by
+// message type.
+func (p *Precompile) Grants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, input, err := ParseGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.Grants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantsResponse(res, input.Granter, input.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GranterGrants returns the grants given by the granter.
+func (p *Precompile) GranterGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranterGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranterGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
+
+// GranteeGrants returns the grants received by the grantee.
+func (p *Precompile) GranteeGrants(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseGranteeGrantsArgs(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.authzKeeper.GranteeGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(GrantsOutput).FromGrantAuthorizations(res.Grants, res.Pagination, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Grants, out.PageResponse)
+}
@@ -0,0 +1,83 @@
+package authz
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GrantMethod defines the ABI method name for the authz Grant transaction.
+	GrantMethod = "grant"
+	// RevokeMethod defines the ABI method name for the authz Revoke transaction.
+	RevokeMethod = "revoke"
+)
+
+// Grant grants a generic authorization to the grantee to execute the given message
+// type on behalf of the granter.
+func (p *Precompile) Grant(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgGrant(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err = p.authzMsgServer.Grant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	authorization, err := msg.GetAuthorization()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitGrantEvent(ctx, stateDB, granter, grantee, authorization.MsgTypeURL()); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke revokes the grant of the given message type from the granter to the grantee.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, granter, grantee, err := NewMsgRevoke(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != granter {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), granter.String())
+	}
+
+	if _, err = p.authzMsgServer.Revoke(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRevokeEvent(ctx, stateDB, granter, grantee, msg.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
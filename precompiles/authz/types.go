@@ -0,0 +1,301 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// GrantData is a grant of authorization from a granter to a grantee.
+type GrantData struct {
+	Granter           common.Address `abi:"granter"`
+	Grantee           common.Address `abi:"grantee"`
+	AuthorizationType string         `abi:"authorizationType"`
+	MsgTypeUrl        string         `abi:"msgTypeUrl"` //nolint:revive
+	Expiration        int64          `abi:"expiration"`
+}
+
+// GrantsOutput represents the output of the grants queries
+type GrantsOutput struct {
+	Grants       []GrantData        `abi:"grants"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// GrantsInput represents the input for the grants query
+type GrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Grantee    common.Address    `abi:"grantee"`
+	MsgTypeUrl string            `abi:"msgTypeUrl"` //nolint:revive
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranterGrantsInput represents the input for the granter grants query
+type GranterGrantsInput struct {
+	Granter    common.Address    `abi:"granter"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// GranteeGrantsInput represents the input for the grantee grants query
+type GranteeGrantsInput struct {
+	Grantee    common.Address    `abi:"grantee"`
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// NewMsgGrant creates a new MsgGrant of a generic authorization from the given arguments,
+// along with the granter and grantee addresses.
+func NewMsgGrant(args []interface{}, addrCdc address.Codec) (*authz.MsgGrant, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	granter, grantee, msgTypeURL, err := parseGrantArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	expiration, ok := args[3].(int64)
+	if !ok || expiration < 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidExpiration, args[3])
+	}
+
+	// a zero expiration is a grant that doesn't expire
+	var expirationTime *time.Time
+	if expiration != 0 {
+		t := time.Unix(expiration, 0).UTC()
+		expirationTime = &t
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	grant, err := authz.NewGrant(time.Time{}, authz.NewGenericAuthorization(msgTypeURL), expirationTime)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &authz.MsgGrant{
+		Granter: granterAddr,
+		Grantee: granteeAddr,
+		Grant:   grant,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// NewMsgRevoke creates a new MsgRevoke from the given arguments, along with the granter
+// and grantee addresses.
+func NewMsgRevoke(args []interface{}, addrCdc address.Codec) (*authz.MsgRevoke, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	granter, grantee, msgTypeURL, err := parseGrantArgs(args)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	granterAddr, err := addrCdc.BytesToString(granter.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	granteeAddr, err := addrCdc.BytesToString(grantee.Bytes())
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	msg := &authz.MsgRevoke{
+		Granter:    granterAddr,
+		Grantee:    granteeAddr,
+		MsgTypeUrl: msgTypeURL,
+	}
+
+	return msg, granter, grantee, nil
+}
+
+// parseGrantArgs parses the granter, grantee and message type URL arguments shared by
+// the grant and revoke transactions.
+func parseGrantArgs(args []interface{}) (common.Address, common.Address, string, error) {
+	granter, ok := args[0].(common.Address)
+	if !ok || granter == (common.Address{}) {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(ErrInvalidGranter, args[0])
+	}
+
+	grantee, ok := args[1].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(ErrInvalidGrantee, args[1])
+	}
+
+	msgTypeURL, ok := args[2].(string)
+	if !ok || msgTypeURL == "" {
+		return common.Address{}, common.Address{}, "", fmt.Errorf(ErrInvalidMsgTypeURL, args[2])
+	}
+
+	return granter, grantee, msgTypeURL, nil
+}
+
+// ParseGrantsArgs parses the arguments for the grants query
+func ParseGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGrantsRequest, *GrantsInput, error) {
+	if len(args) != 4 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input GrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, nil, fmt.Errorf("error while unpacking args to GrantsInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &authz.QueryGrantsRequest{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypeUrl: input.MsgTypeUrl,
+		Pagination: &input.Pagination,
+	}, &input, nil
+}
+
+// ParseGranterGrantsArgs parses the arguments for the granter grants query
+func ParseGranterGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranterGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranterGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranterGrantsInput: %s", err)
+	}
+
+	granter, err := addrCdc.BytesToString(input.Granter.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGranter, err)
+	}
+
+	return &authz.QueryGranterGrantsRequest{
+		Granter:    granter,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// ParseGranteeGrantsArgs parses the arguments for the grantee grants query
+func ParseGranteeGrantsArgs(method *abi.Method, args []interface{}, addrCdc address.Codec) (*authz.QueryGranteeGrantsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input GranteeGrantsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to GranteeGrantsInput: %s", err)
+	}
+
+	grantee, err := addrCdc.BytesToString(input.Grantee.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidGrantee, err)
+	}
+
+	return &authz.QueryGranteeGrantsRequest{
+		Grantee:    grantee,
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewGrantData creates a GrantData from the given authorization and expiration.
+func NewGrantData(granter, grantee common.Address, authorization *codectypes.Any, expiration *time.Time) (GrantData, error) {
+	a, err := authz.Grant{Authorization: authorization}.GetAuthorization()
+	if err != nil {
+		return GrantData{}, err
+	}
+
+	var exp int64
+	if expiration != nil {
+		exp = expiration.Unix()
+	}
+
+	return GrantData{
+		Granter:           granter,
+		Grantee:           grantee,
+		AuthorizationType: authorization.TypeUrl,
+		MsgTypeUrl:        a.MsgTypeURL(),
+		Expiration:        exp,
+	}, nil
+}
+
+// FromGrantsResponse populates the GrantsOutput from a QueryGrantsResponse, whose grants
+// are all from the given granter to the given grantee.
+func (gro *GrantsOutput) FromGrantsResponse(res *authz.QueryGrantsResponse, granter, grantee common.Address) (*GrantsOutput, error) {
+	gro.Grants = make([]GrantData, len(res.Grants))
+	for i, grant := range res.Grants {
+		data, err := NewGrantData(granter, grantee, grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		gro.Grants[i] = data
+	}
+
+	gro.setPageResponse(res.Pagination)
+	return gro, nil
+}
+
+// FromGrantAuthorizations populates the GrantsOutput from the grant authorizations of a
+// QueryGranterGrantsResponse or QueryGranteeGrantsResponse.
+func (gro *GrantsOutput) FromGrantAuthorizations(
+	grants []*authz.GrantAuthorization,
+	pageRes *query.PageResponse,
+	addrCdc address.Codec,
+) (*GrantsOutput, error) {
+	gro.Grants = make([]GrantData, len(grants))
+	for i, grant := range grants {
+		granter, err := addrCdc.StringToBytes(grant.Granter)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGranter, err)
+		}
+
+		grantee, err := addrCdc.StringToBytes(grant.Grantee)
+		if err != nil {
+			return nil, fmt.Errorf(ErrInvalidGrantee, err)
+		}
+
+		data, err := NewGrantData(common.BytesToAddress(granter), common.BytesToAddress(grantee), grant.Authorization, grant.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		gro.Grants[i] = data
+	}
+
+	gro.setPageResponse(pageRes)
+	return gro, nil
+}
+
+func (gro *GrantsOutput) setPageResponse(pageRes *query.PageResponse) {
+	if pageRes != nil {
+		gro.PageResponse = query.PageResponse{
+			NextKey: pageRes.NextKey,
+			Total:   pageRes.Total,
+		}
+	}
+}
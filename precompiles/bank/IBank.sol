@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: LGPL-3.0-only
 pragma solidity >=0.8.18;
 
+import "../common/Types.sol";
+
 /// @dev The IBank contract's address.
 address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
 
@@ -15,12 +17,51 @@ struct Balance {
     uint256 amount;
 }
 
+/// @dev DenomUnit represents a unit of a denomination with its exponent.
+struct DenomUnit {
+    /// denom of the unit
+    string denom;
+    /// exponent is the power of 10 of the unit relative to the base denomination
+    uint32 exponent;
+    /// aliases of the unit
+    string[] aliases;
+}
+
+/// @dev DenomMetadata represents the metadata of a native denomination.
+struct DenomMetadata {
+    string description;
+    DenomUnit[] denomUnits;
+    /// base is the base denomination, with the smallest unit
+    string base;
+    /// display is the suggested denomination to display to users
+    string display;
+    string name;
+    string symbol;
+    string uri;
+    string uriHash;
+}
+
+/// @dev Output specifies the recipient and the native coins of a multi-send.
+struct Output {
+    /// to is the recipient of the coins
+    address to;
+    /// amount of native coins sent to the recipient
+    Coin[] amount;
+}
+
 /**
  * @author Evmos Team
  * @title Bank Interface
- * @dev Interface for querying balances and supply from the Bank module.
+ * @dev Interface for querying balances, supply and metadata from the Bank module,
+ * and sending native coins.
  */
 interface IBank {
+    /// @dev Send defines an Event emitted for each recipient of a multi-send.
+    /// @param sender the address of the sender of the coins.
+    /// @param recipient the address of the recipient of the coins.
+    /// @param amount the native coins sent to the recipient.
+    event Send(address indexed sender, address indexed recipient, Coin[] amount);
+
     /// @dev balances defines a method for retrieving all the native token balances
     /// for a given account.
     /// @param account the address of the account to query balances for.
@@ -39,4 +80,41 @@ interface IBank {
     function supplyOf(
         address erc20Address
     ) external view returns (uint256 totalSupply);
+
+    /// @dev balanceOf defines a method for retrieving the balance of an account for
+    /// any native denomination, including the ones without an ERC-20 token pair.
+    /// @param account the address of the account to query the balance for.
+    /// @param denom the native denomination.
+    /// @return balance the balance of the account in the native denomination.
+    function balanceOf(
+        address account,
+        string calldata denom
+    ) external view returns (uint256 balance);
+
+    /// @dev supplyOfDenom defines a method for retrieving the total supply of any
+    /// native denomination, including the ones without an ERC-20 token pair.
+    /// @param denom the native denomination.
+    /// @return totalSupply the supply of the native denomination.
+    function supplyOfDenom(
+        string calldata denom
+    ) external view returns (uint256 totalSupply);
+
+    /// @dev denomMetadata defines a method for retrieving the metadata of a native
+    /// denomination.
+    /// @param denom the native denomination.
+    /// @return metadata the metadata of the denomination.
+    /// @return found whether the denomination has metadata.
+    function denomMetadata(
+        string calldata denom
+    ) external view returns (DenomMetadata memory metadata, bool found);
+
+    /// @dev multiSend defines a method for sending native coins from the sender to
+    /// multiple recipients in a single call.
+    /// @param sender the address of the sender of the coins, must be the caller.
+    /// @param outputs the recipients and the native coins sent to each of them.
+    /// @return success whether the coins were sent.
+    function multiSend(
+        address sender,
+        Output[] calldata outputs
+    ) external returns (bool success);
 }
@@ -30,6 +30,15 @@ const (
 
 	// GasSupplyOf defines the gas cost for a single ERC-20 supplyOf query, taken from totalSupply of ERC20
 	GasSupplyOf = 2_477
+
+	// GasBalanceOf defines the gas cost for a single balanceOf query, taken from balanceOf of ERC20
+	GasBalanceOf = 2_851
+
+	// GasSupplyOfDenom defines the gas cost for a single supplyOfDenom query, taken from totalSupply of ERC20
+	GasSupplyOfDenom = 2_477
+
+	// GasDenomMetadata defines the gas cost for a single denomMetadata query
+	GasDenomMetadata = 3_000
 )
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -40,6 +49,9 @@ var (
 	//go:embed abi.json
 	f   embed.FS
 	ABI abi.ABI
+
+	// cacheableQueries are the IDs of the queries memoized within a block.
+	cacheableQueries map[string]bool
 )
 
 func init() {
@@ -48,6 +60,7 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	cacheableQueries = cmn.NewCacheableQueries(ABI, Precompile{}.IsTransaction)
 }
 
 // Precompile defines the bank precompile
@@ -72,6 +85,8 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.GasConfig{},
 			TransientKVGasConfig: storetypes.GasConfig{},
 			ContractAddress:      common.HexToAddress(evmtypes.BankPrecompileAddress),
+			BalanceHandler:       cmn.NewBalanceHandler(bankKeeper),
+			CacheableQueries:     cacheableQueries,
 		},
 		ABI:         ABI,
 		bankKeeper:  bankKeeper,
@@ -101,6 +116,15 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 		return GasTotalSupply
 	case SupplyOfMethod:
 		return GasSupplyOf
+	case BalanceOfMethod:
+		return GasBalanceOf
+	case SupplyOfDenomMethod:
+		return GasSupplyOfDenom
+	case DenomMetadataMethod:
+		return GasDenomMetadata
+	case MultiSendMethod:
+		// NOTE: the multiSend transaction is charged with the default KV gas configuration
+		return storetypes.KVGasConfig().WriteCostFlat + (storetypes.KVGasConfig().WriteCostPerByte * uint64(len(input)))
 	}
 
 	return 0
@@ -108,12 +132,12 @@ func (p Precompile) RequiredGas(input []byte) uint64 {
 
 func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
 	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
-		return p.Execute(ctx, contract, readonly)
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
 	})
 }
 
-// Execute executes the precompiled contract bank query methods defined in the ABI.
-func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+// Execute executes the precompiled contract bank methods defined in the ABI.
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
 	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
 	if err != nil {
 		return nil, err
@@ -128,6 +152,15 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 		bz, err = p.TotalSupply(ctx, method, args)
 	case SupplyOfMethod:
 		bz, err = p.SupplyOf(ctx, method, args)
+	case BalanceOfMethod:
+		bz, err = p.BalanceOf(ctx, method, args)
+	case SupplyOfDenomMethod:
+		bz, err = p.SupplyOfDenom(ctx, method, args)
+	case DenomMetadataMethod:
+		bz, err = p.DenomMetadata(ctx, method, args)
+	// Bank transactions
+	case MultiSendMethod:
+		bz, err = p.MultiSend(ctx, method, stateDB, contract, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -136,7 +169,14 @@ func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly boo
 }
 
 // IsTransaction checks if the given method name corresponds to a transaction or query.
-// It returns false since all bank methods are queries.
-func (Precompile) IsTransaction(_ *abi.Method) bool {
-	return false
+//
+// Available bank transactions are:
+//   - MultiSend
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case MultiSendMethod:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,27 @@
+package bank
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeSend defines the event type for each recipient of the bank MultiSend transaction.
+	EventTypeSend = "Send"
+)
+
+// EventSend is the event emitted for each recipient of a MultiSend transaction.
+type EventSend struct {
+	Sender    common.Address
+	Recipient common.Address
+	Amount    []cmn.Coin
+}
+
+// EmitSendEvent emits the Send event.
+func (p Precompile) EmitSendEvent(ctx sdk.Context, stateDB vm.StateDB, sender, recipient common.Address, amount []cmn.Coin) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeSend], sender, recipient, amount)
+}
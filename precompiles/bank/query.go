@@ -19,6 +19,15 @@ const (
 	// SupplyOfMethod defines the ABI method name for the bank SupplyOf
 	// query.
 	SupplyOfMethod = "supplyOf"
+	// BalanceOfMethod defines the ABI method name for the bank BalanceOf
+	// query.
+	BalanceOfMethod = "balanceOf"
+	// SupplyOfDenomMethod defines the ABI method name for the bank SupplyOfDenom
+	// query.
+	SupplyOfDenomMethod = "supplyOfDenom"
+	// DenomMetadataMethod defines the ABI method name for the bank DenomMetadata
+	// query.
+	DenomMetadataMethod = "denomMetadata"
 )
 
 // Balances returns given account's balances of all tokens registered in the x/bank module
@@ -127,3 +136,56 @@ func (p Precompile) SupplyOf(
 
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
+
+// BalanceOf returns the balance of the given account for any native denomination,
+// including the ones without a registered ERC-20 token pair. The amount returned
+// has the original decimals precision stored in the x/bank.
+func (p Precompile) BalanceOf(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	account, denom, err := ParseBalanceOfArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the balance in bank precompile: %s", err)
+	}
+
+	balance := p.bankKeeper.GetBalance(ctx, account, denom)
+
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+// SupplyOfDenom returns the total supply of any native denomination, including
+// the ones without a registered ERC-20 token pair. The amount returned has the
+// original decimals precision stored in the x/bank.
+func (p Precompile) SupplyOfDenom(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the supply in bank precompile: %s", err)
+	}
+
+	supply := p.bankKeeper.GetSupply(ctx, denom)
+
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// DenomMetadata returns the metadata of a native denomination, and whether the
+// denomination has metadata.
+func (p Precompile) DenomMetadata(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := ParseDenomArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the denom metadata in bank precompile: %s", err)
+	}
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
+
+	return method.Outputs.Pack(NewDenomMetadata(metadata), found)
+}
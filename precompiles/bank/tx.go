@@ -0,0 +1,67 @@
+package bank
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// MultiSendMethod defines the ABI method name for the bank MultiSend
+	// transaction.
+	MultiSendMethod = "multiSend"
+)
+
+// MultiSend sends native coins from the sender to multiple recipients. Each output is
+// sent with a bank MsgSend, so that the send enabled denominations and the blocked
+// recipients are enforced. The coins don't need an ERC-20 token pair.
+func (p Precompile) MultiSend(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	sender, outputs, err := ParseMultiSendArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != sender {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
+	}
+
+	// NOTE: the bank queries are charged a fixed gas amount, while the transaction
+	// is charged for its store reads and writes.
+	ctx = ctx.WithKVGasConfig(storetypes.KVGasConfig()).
+		WithTransientKVGasConfig(storetypes.TransientGasConfig())
+
+	msgSrv := erc20.NewMsgServerImpl(p.bankKeeper)
+	for _, output := range outputs {
+		amount, err := cmn.NewSdkCoinsFromCoins(output.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount for recipient %s: %w", output.To, err)
+		}
+
+		msg := banktypes.NewMsgSend(sender.Bytes(), output.To.Bytes(), amount)
+		if err := msgSrv.Send(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		if err := p.EmitSendEvent(ctx, stateDB, sender, output.To, output.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
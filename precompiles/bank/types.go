@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Balance contains the amount for a corresponding ERC-20 contract address.
@@ -17,6 +19,60 @@ type Balance struct {
 	Amount          *big.Int
 }
 
+// DenomUnit contains a unit of a denomination with its exponent.
+type DenomUnit struct {
+	Denom    string
+	Exponent uint32
+	Aliases  []string
+}
+
+// DenomMetadata contains the metadata of a native denomination.
+type DenomMetadata struct {
+	Description string
+	DenomUnits  []DenomUnit
+	Base        string
+	Display     string
+	Name        string
+	Symbol      string
+	Uri         string //nolint:revive
+	UriHash     string //nolint:revive
+}
+
+// Output contains the recipient and the native coins of a multi-send.
+type Output struct {
+	To     common.Address
+	Amount []cmn.Coin
+}
+
+// NewDenomMetadata converts the x/bank metadata of a denomination to its ABI
+// representation.
+func NewDenomMetadata(metadata banktypes.Metadata) DenomMetadata {
+	denomUnits := make([]DenomUnit, len(metadata.DenomUnits))
+	for i, unit := range metadata.DenomUnits {
+		aliases := unit.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+
+		denomUnits[i] = DenomUnit{
+			Denom:    unit.Denom,
+			Exponent: unit.Exponent,
+			Aliases:  aliases,
+		}
+	}
+
+	return DenomMetadata{
+		Description: metadata.Description,
+		DenomUnits:  denomUnits,
+		Base:        metadata.Base,
+		Display:     metadata.Display,
+		Name:        metadata.Name,
+		Symbol:      metadata.Symbol,
+		Uri:         metadata.URI,
+		UriHash:     metadata.URIHash,
+	}
+}
+
 // ParseBalancesArgs parses the call arguments for the bank Balances query.
 func ParseBalancesArgs(args []interface{}) (sdk.AccAddress, error) {
 	if len(args) != 1 {
@@ -44,3 +100,69 @@ func ParseSupplyOfArgs(args []interface{}) (common.Address, error) {
 
 	return erc20Address, nil
 }
+
+// ParseBalanceOfArgs parses the call arguments for the bank BalanceOf query.
+func ParseBalanceOfArgs(args []interface{}) (sdk.AccAddress, string, error) {
+	if len(args) != 2 {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[1])
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, "", err
+	}
+
+	return account.Bytes(), denom, nil
+}
+
+// ParseDenomArgs parses the call arguments for the bank queries taking a single
+// denomination.
+func ParseDenomArgs(args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, "denom", "", args[0])
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// ParseMultiSendArgs parses the call arguments for the bank MultiSend transaction.
+func ParseMultiSendArgs(method *abi.Method, args []interface{}) (common.Address, []Output, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	sender, ok := args[0].(common.Address)
+	if !ok || sender == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "sender", common.Address{}, args[0])
+	}
+
+	var outputs []Output
+	arguments := abi.Arguments{method.Inputs[1]}
+	if err := arguments.Copy(&outputs, []interface{}{args[1]}); err != nil {
+		return common.Address{}, nil, fmt.Errorf("error while unpacking args to Output struct: %s", err)
+	}
+
+	if len(outputs) == 0 {
+		return common.Address{}, nil, fmt.Errorf("no outputs to send coins to")
+	}
+
+	return sender, outputs, nil
+}
@@ -76,10 +76,12 @@ func (p Precompile) Bech32ToHex(
 		return nil, fmt.Errorf("invalid bech32 address: %v", args[0])
 	}
 
-	bech32Prefix := strings.SplitN(address, "1", 2)[0]
-	if bech32Prefix == address {
+	// NOTE: the separator is the last "1" of the address, since the HRP can contain it.
+	sepIndex := strings.LastIndex(address, "1")
+	if sepIndex < 1 {
 		return nil, fmt.Errorf("invalid bech32 address: %s", address)
 	}
+	bech32Prefix := address[:sepIndex]
 
 	addressBz, err := sdk.GetFromBech32(address, bech32Prefix)
 	if err != nil {
@@ -90,5 +92,11 @@ func (p Precompile) Bech32ToHex(
 		return nil, err
 	}
 
+	// NOTE: longer addresses (e.g. module or interchain accounts) can't be converted to
+	// a hex address without being truncated.
+	if len(addressBz) != common.AddressLength {
+		return nil, fmt.Errorf("invalid address length %d, expected %d bytes", len(addressBz), common.AddressLength)
+	}
+
 	return method.Outputs.Pack(common.BytesToAddress(addressBz))
 }
@@ -0,0 +1,6 @@
+package chaininfo
+
+const (
+	// ErrModuleVersions is raised when the module versions cannot be read from the upgrade module.
+	ErrModuleVersions = "failed to get module versions: %v"
+)
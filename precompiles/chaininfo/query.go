@@ -0,0 +1,100 @@
+package chaininfo
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ChainIDMethod defines the ABI method name for the chain info ChainID query.
+	ChainIDMethod = "chainId"
+	// ActiveForksMethod defines the ABI method name for the chain info ActiveForks query.
+	ActiveForksMethod = "activeForks"
+	// ExtraEIPsMethod defines the ABI method name for the chain info ExtraEIPs query.
+	ExtraEIPsMethod = "extraEIPs"
+	// CoinInfoMethod defines the ABI method name for the chain info CoinInfo query.
+	CoinInfoMethod = "coinInfo"
+	// ModuleVersionsMethod defines the ABI method name for the chain info ModuleVersions query.
+	ModuleVersionsMethod = "moduleVersions"
+)
+
+// ChainID returns the EIP-155 chain ID of the EVM.
+func (p Precompile) ChainID(
+	_ sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	chainID := evmtypes.GetEthChainConfig().ChainID
+	if chainID == nil {
+		chainID = new(big.Int)
+	}
+
+	return method.Outputs.Pack(chainID)
+}
+
+// ActiveForks returns the names of the Ethereum forks active at the current block height
+// and time.
+func (p Precompile) ActiveForks(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	// NOTE: the merge is always considered active, as done by the EVM state transition.
+	rules := evmtypes.GetEthChainConfig().Rules(
+		big.NewInt(ctx.BlockHeight()),
+		true,
+		uint64(ctx.BlockTime().Unix()), //#nosec G115 -- block time is never negative
+	)
+
+	return method.Outputs.Pack(ActiveForkNames(rules))
+}
+
+// ExtraEIPs returns the EIPs enabled on top of the active forks by the EVM params.
+func (p Precompile) ExtraEIPs(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	extraEIPs := p.evmKeeper.GetParams(ctx).ExtraEIPs
+	if extraEIPs == nil {
+		extraEIPs = []int64{}
+	}
+
+	return method.Outputs.Pack(extraEIPs)
+}
+
+// CoinInfo returns the denominations and decimals of the native coin of the EVM.
+func (p Precompile) CoinInfo(
+	_ sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	return method.Outputs.Pack(NewCoinInfo())
+}
+
+// ModuleVersions returns the consensus versions of the modules of the chain, as stored
+// by the upgrade module.
+func (p Precompile) ModuleVersions(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	versions, err := p.upgradeKeeper.GetModuleVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(ErrModuleVersions, err)
+	}
+
+	return method.Outputs.Pack(NewModuleVersions(versions))
+}
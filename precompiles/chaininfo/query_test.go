@@ -0,0 +1,79 @@
+package chaininfo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockEVMKeeper is an EVM keeper with fixed params.
+type mockEVMKeeper struct {
+	params evmtypes.Params
+}
+
+func (k mockEVMKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return k.params
+}
+
+// mockUpgradeKeeper is an upgrade keeper with a fixed set of module versions.
+type mockUpgradeKeeper struct {
+	versions []*upgradetypes.ModuleVersion
+	err      error
+}
+
+func (k mockUpgradeKeeper) GetModuleVersions(context.Context) ([]*upgradetypes.ModuleVersion, error) {
+	return k.versions, k.err
+}
+
+func TestExtraEIPs(t *testing.T) {
+	params := evmtypes.DefaultParams()
+	params.ExtraEIPs = []int64{3855, 7702}
+	p := NewPrecompile(mockEVMKeeper{params: params}, mockUpgradeKeeper{})
+	ctx := sdk.Context{}
+
+	method := p.Methods[ExtraEIPsMethod]
+	bz, err := p.ExtraEIPs(ctx, &method, nil, nil)
+	require.NoError(t, err)
+	out, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]int64{3855, 7702}}, out)
+
+	p = NewPrecompile(mockEVMKeeper{}, mockUpgradeKeeper{})
+	bz, err = p.ExtraEIPs(ctx, &method, nil, nil)
+	require.NoError(t, err)
+	out, err = method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{[]int64{}}, out)
+}
+
+func TestModuleVersions(t *testing.T) {
+	p := NewPrecompile(mockEVMKeeper{}, mockUpgradeKeeper{
+		versions: []*upgradetypes.ModuleVersion{
+			{Name: "bank", Version: 4},
+			{Name: "evm", Version: 1},
+		},
+	})
+	ctx := sdk.Context{}
+
+	method := p.Methods[ModuleVersionsMethod]
+	bz, err := p.ModuleVersions(ctx, &method, nil, nil)
+	require.NoError(t, err)
+
+	out, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	var versions []ModuleVersion
+	require.NoError(t, method.Outputs.Copy(&versions, out))
+	require.Equal(t, []ModuleVersion{{Name: "bank", Version: 4}, {Name: "evm", Version: 1}}, versions)
+
+	p = NewPrecompile(mockEVMKeeper{}, mockUpgradeKeeper{err: errors.New("store error")})
+	_, err = p.ModuleVersions(ctx, &method, nil, nil)
+	require.ErrorContains(t, err, "failed to get module versions: store error")
+}
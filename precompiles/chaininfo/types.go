@@ -0,0 +1,84 @@
+package chaininfo
+
+import (
+	geth "github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+// CoinInfo is the information of the native coin of the EVM as returned by the CoinInfo query.
+type CoinInfo struct {
+	Denom         string `abi:"denom"`
+	ExtendedDenom string `abi:"extendedDenom"`
+	DisplayDenom  string `abi:"displayDenom"`
+	Decimals      uint8  `abi:"decimals"`
+}
+
+// ModuleVersion is the consensus version of a module as returned by the ModuleVersions query.
+type ModuleVersion struct {
+	Name    string `abi:"name"`
+	Version uint64 `abi:"version"`
+}
+
+// NewCoinInfo creates a new CoinInfo from the EVM coin configured for the chain.
+func NewCoinInfo() CoinInfo {
+	return CoinInfo{
+		Denom:         evmtypes.GetEVMCoinDenom(),
+		ExtendedDenom: evmtypes.GetEVMCoinExtendedDenom(),
+		DisplayDenom:  evmtypes.GetEVMCoinDisplayDenom(),
+		Decimals:      uint8(evmtypes.GetEVMCoinDecimals()),
+	}
+}
+
+// NewModuleVersions converts the module versions stored by the upgrade module into
+// their ABI representation.
+func NewModuleVersions(versions []*upgradetypes.ModuleVersion) []ModuleVersion {
+	moduleVersions := make([]ModuleVersion, 0, len(versions))
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+		moduleVersions = append(moduleVersions, ModuleVersion{
+			Name:    v.Name,
+			Version: v.Version,
+		})
+	}
+
+	return moduleVersions
+}
+
+// ActiveForkNames returns the names of the forks active in the given rules, from the
+// oldest to the newest.
+func ActiveForkNames(rules geth.Rules) []string {
+	forks := []struct {
+		name   string
+		active bool
+	}{
+		{"homestead", rules.IsHomestead},
+		{"tangerineWhistle", rules.IsEIP150},
+		{"spuriousDragon", rules.IsEIP158},
+		{"byzantium", rules.IsByzantium},
+		{"constantinople", rules.IsConstantinople},
+		{"petersburg", rules.IsPetersburg},
+		{"istanbul", rules.IsIstanbul},
+		{"berlin", rules.IsBerlin},
+		{"london", rules.IsLondon},
+		{"merge", rules.IsMerge},
+		{"shanghai", rules.IsShanghai},
+		{"cancun", rules.IsCancun},
+		{"prague", rules.IsPrague},
+		{"osaka", rules.IsOsaka},
+		{"verkle", rules.IsVerkle},
+	}
+
+	names := make([]string, 0, len(forks))
+	for _, fork := range forks {
+		if fork.active {
+			names = append(names, fork.name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,66 @@
+package chaininfo
+
+import (
+	"testing"
+
+	geth "github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+func TestActiveForkNames(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rules geth.Rules
+		exp   []string
+	}{
+		{
+			"no active fork",
+			geth.Rules{},
+			[]string{},
+		},
+		{
+			"up to london",
+			geth.Rules{
+				IsHomestead: true, IsEIP150: true, IsEIP155: true, IsEIP158: true,
+				IsByzantium: true, IsConstantinople: true, IsPetersburg: true, IsIstanbul: true,
+				IsBerlin: true, IsEIP2929: true, IsLondon: true,
+			},
+			[]string{
+				"homestead", "tangerineWhistle", "spuriousDragon", "byzantium", "constantinople",
+				"petersburg", "istanbul", "berlin", "london",
+			},
+		},
+		{
+			"up to prague",
+			geth.Rules{
+				IsHomestead: true, IsEIP150: true, IsEIP155: true, IsEIP158: true,
+				IsByzantium: true, IsConstantinople: true, IsPetersburg: true, IsIstanbul: true,
+				IsBerlin: true, IsEIP2929: true, IsLondon: true, IsMerge: true,
+				IsShanghai: true, IsCancun: true, IsPrague: true,
+			},
+			[]string{
+				"homestead", "tangerineWhistle", "spuriousDragon", "byzantium", "constantinople",
+				"petersburg", "istanbul", "berlin", "london", "merge", "shanghai", "cancun", "prague",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, ActiveForkNames(tc.rules))
+		})
+	}
+}
+
+func TestNewModuleVersions(t *testing.T) {
+	versions := NewModuleVersions([]*upgradetypes.ModuleVersion{
+		{Name: "bank", Version: 4},
+		nil,
+		{Name: "evm", Version: 1},
+	})
+	require.Equal(t, []ModuleVersion{{Name: "bank", Version: 4}, {Name: "evm", Version: 1}}, versions)
+
+	require.Empty(t, NewModuleVersions(nil))
+}
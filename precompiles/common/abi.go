@@ -129,7 +129,8 @@ func PackNum(value reflect.Value) []byte {
 	}
 }
 
-// LoadABI read the ABI file described by the path and parse it as JSON.
+// LoadABI read the ABI file described by the path and parse it as JSON. The events of
+// the ABI are added to the precompile event registry.
 func LoadABI(fs embed.FS, path string) (abi.ABI, error) {
 	abiBz, err := fs.ReadFile(path)
 	if err != nil {
@@ -141,5 +142,7 @@ func LoadABI(fs embed.FS, path string) (abi.ABI, error) {
 		return abi.ABI{}, fmt.Errorf(ErrInvalidABI, err)
 	}
 
+	RegisterEvents(contract.ABI)
+
 	return contract.ABI, nil
 }
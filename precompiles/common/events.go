@@ -0,0 +1,225 @@
+package common
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypePrefix is the prefix of the type of the Cosmos events emitted for the
+	// precompile EVM logs, e.g. "precompile_delegate" for the staking Delegate log.
+	EventTypePrefix = "precompile_"
+
+	// AttributeKeyPrecompileAddress is the Cosmos event attribute holding the address of
+	// the precompile that emitted the log.
+	AttributeKeyPrecompileAddress = "precompile_address"
+	// AttributeKeyEventSignature is the Cosmos event attribute holding the signature of the
+	// log, e.g. "Delegate(address,address,uint256,uint256)".
+	AttributeKeyEventSignature = "event_signature"
+	// AttributeKeyEventID is the Cosmos event attribute holding the first topic of the log.
+	AttributeKeyEventID = "event_id"
+)
+
+// eventRegistry maps the signatures of the precompile events, i.e. the first topic of
+// their EVM logs, to their ABI definition and thus to their Cosmos event type. It allows
+// indexers to decode one side from the other.
+type eventRegistry struct {
+	mu     sync.RWMutex
+	events map[common.Hash]abi.Event
+}
+
+// registry is the registry of the events of all the precompile ABIs loaded with LoadABI.
+var registry = &eventRegistry{events: make(map[common.Hash]abi.Event)}
+
+// RegisterEvents adds the events of the given ABI to the precompile event registry.
+func RegisterEvents(contractABI abi.ABI) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	for _, event := range contractABI.Events {
+		registry.events[event.ID] = event
+	}
+}
+
+// LookupEvent returns the precompile event registered for the given event ID, i.e. the
+// first topic of its EVM log.
+func LookupEvent(id common.Hash) (abi.Event, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	event, found := registry.events[id]
+	return event, found
+}
+
+// LookupCosmosEventType returns the precompile event registered for the given Cosmos event
+// type and signature.
+func LookupCosmosEventType(eventType, signature string) (abi.Event, bool) {
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	for _, event := range registry.events {
+		if event.Sig == signature && CosmosEventType(event) == eventType {
+			return event, true
+		}
+	}
+	return abi.Event{}, false
+}
+
+// CosmosEventType returns the type of the Cosmos event emitted together with the EVM log
+// of the given event, i.e. the snake cased event name with the EventTypePrefix.
+func CosmosEventType(event abi.Event) string {
+	var b strings.Builder
+	b.WriteString(EventTypePrefix)
+	runes := []rune(event.RawName)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// add a separator at the start of a word, e.g. "DelegatorWithdraw" or "IBCTransfer"
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// EmitEvent emits the EVM log of the given event from the precompile address together with
+// the corresponding Cosmos event. See EmitEvent.
+func (p Precompile) EmitEvent(ctx sdk.Context, stateDB vm.StateDB, event abi.Event, args ...interface{}) error {
+	return EmitEvent(ctx, stateDB, p.Address(), event, args...)
+}
+
+// EmitEvent emits the EVM log of the given event together with the corresponding Cosmos
+// event, so both carry the same data. The arguments must be provided in the order of the
+// event inputs, with the Go types expected by the ABI encoding.
+//
+// NOTE: Both the log and the Cosmos event are built before emitting any of them, so either
+// both are emitted or none. The Cosmos events of the precompile calls are reverted with the
+// EVM state, like the logs.
+func EmitEvent(ctx sdk.Context, stateDB vm.StateDB, address common.Address, event abi.Event, args ...interface{}) error {
+	log, err := NewEventLog(address, uint64(ctx.BlockHeight()), event, args...) //nolint:gosec // G115
+	if err != nil {
+		return err
+	}
+
+	cosmosEvent, err := NewCosmosEvent(address, event, args...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(log)
+	ctx.EventManager().EmitEvent(cosmosEvent)
+
+	return nil
+}
+
+// NewEventLog returns the EVM log of the given event. The indexed arguments are converted
+// to topics and the other ones are ABI encoded into the log data.
+func NewEventLog(address common.Address, blockNumber uint64, event abi.Event, args ...interface{}) (*ethtypes.Log, error) {
+	if len(args) != len(event.Inputs) {
+		return nil, fmt.Errorf(ErrInvalidNumberOfArgs, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var (
+		nonIndexed     abi.Arguments
+		nonIndexedArgs []interface{}
+	)
+
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, input)
+			nonIndexedArgs = append(nonIndexedArgs, args[i])
+			continue
+		}
+
+		topic, err := MakeTopic(args[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to create topic for %s of event %s: %w", input.Name, event.Name, err)
+		}
+		topics = append(topics, topic)
+	}
+
+	data, err := nonIndexed.Pack(nonIndexedArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack data of event %s: %w", event.Name, err)
+	}
+
+	return &ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: blockNumber,
+	}, nil
+}
+
+// NewCosmosEvent returns the Cosmos event corresponding to the EVM log of the given event.
+// It has one attribute per event input, named after the input and holding the formatted
+// argument, plus the precompile address, the event signature and the event ID.
+func NewCosmosEvent(address common.Address, event abi.Event, args ...interface{}) (sdk.Event, error) {
+	if len(args) != len(event.Inputs) {
+		return sdk.Event{}, fmt.Errorf(ErrInvalidNumberOfArgs, len(event.Inputs), len(args))
+	}
+
+	attributes := make([]sdk.Attribute, 0, len(args)+3)
+	attributes = append(attributes,
+		sdk.NewAttribute(AttributeKeyPrecompileAddress, address.Hex()),
+		sdk.NewAttribute(AttributeKeyEventSignature, event.Sig),
+		sdk.NewAttribute(AttributeKeyEventID, event.ID.Hex()),
+	)
+
+	for i, input := range event.Inputs {
+		value, err := FormatEventValue(args[i])
+		if err != nil {
+			return sdk.Event{}, fmt.Errorf("failed to format %s of event %s: %w", input.Name, event.Name, err)
+		}
+		attributes = append(attributes, sdk.NewAttribute(input.Name, value))
+	}
+
+	return sdk.NewEvent(CosmosEventType(event), attributes...), nil
+}
+
+// FormatEventValue formats an event argument as a Cosmos event attribute value. Addresses,
+// hashes and bytes are hex encoded, numbers are formatted in base 10 and the other types,
+// like tuples and arrays, are JSON encoded.
+func FormatEventValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case common.Address:
+		return v.Hex(), nil
+	case common.Hash:
+		return v.Hex(), nil
+	case []byte:
+		return "0x" + hex.EncodeToString(v), nil
+	case *big.Int:
+		if v == nil {
+			return "0", nil
+		}
+		return v.String(), nil
+	case int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	default:
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(bz), nil
+	}
+}
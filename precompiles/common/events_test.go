@@ -0,0 +1,127 @@
+package common_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+const eventsTestABI = `[
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "internalType": "address", "name": "delegatorAddress", "type": "address"},
+			{"indexed": true, "internalType": "string", "name": "validatorAddress", "type": "string"},
+			{"indexed": false, "internalType": "uint64", "name": "sequence", "type": "uint64"},
+			{"indexed": false, "internalType": "uint256", "name": "amount", "type": "uint256"}
+		],
+		"name": "IBCTransferTest",
+		"type": "event"
+	}
+]`
+
+func loadEventsTestEvent(t *testing.T) abi.Event {
+	t.Helper()
+	contractABI, err := abi.JSON(strings.NewReader(eventsTestABI))
+	require.NoError(t, err)
+	return contractABI.Events["IBCTransferTest"]
+}
+
+func TestCosmosEventType(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"Delegate", "precompile_delegate"},
+		{"CancelUnbondingDelegation", "precompile_cancel_unbonding_delegation"},
+		{"IBCTransfer", "precompile_ibc_transfer"},
+		{"ValidatorUnjailed", "precompile_validator_unjailed"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, cmn.CosmosEventType(abi.Event{RawName: tc.name}), tc.name)
+	}
+}
+
+func TestNewEventLog(t *testing.T) {
+	event := loadEventsTestEvent(t)
+	address := common.HexToAddress("0x0000000000000000000000000000000000000800")
+	delegator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	amount := big.NewInt(1000)
+
+	log, err := cmn.NewEventLog(address, 10, event, delegator, "cosmosvaloper1", uint64(7), amount)
+	require.NoError(t, err)
+	require.Equal(t, address, log.Address)
+	require.Equal(t, uint64(10), log.BlockNumber)
+	require.Equal(t, []common.Hash{
+		event.ID,
+		common.BytesToHash(delegator.Bytes()),
+		crypto.Keccak256Hash([]byte("cosmosvaloper1")),
+	}, log.Topics)
+
+	unpacked, err := event.Inputs.Unpack(log.Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(7), amount}, unpacked)
+
+	_, err = cmn.NewEventLog(address, 10, event, delegator)
+	require.Error(t, err)
+
+	_, err = cmn.NewEventLog(address, 10, event, delegator, "cosmosvaloper1", "invalid", amount)
+	require.Error(t, err)
+}
+
+func TestNewCosmosEvent(t *testing.T) {
+	event := loadEventsTestEvent(t)
+	address := common.HexToAddress("0x0000000000000000000000000000000000000800")
+	delegator := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	cosmosEvent, err := cmn.NewCosmosEvent(address, event, delegator, "cosmosvaloper1", uint64(7), big.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, "precompile_ibc_transfer_test", cosmosEvent.Type)
+
+	attributes := make(map[string]string, len(cosmosEvent.Attributes))
+	for _, attr := range cosmosEvent.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, map[string]string{
+		cmn.AttributeKeyPrecompileAddress: address.Hex(),
+		cmn.AttributeKeyEventSignature:    "IBCTransferTest(address,string,uint64,uint256)",
+		cmn.AttributeKeyEventID:           event.ID.Hex(),
+		"delegatorAddress":                delegator.Hex(),
+		"validatorAddress":                "cosmosvaloper1",
+		"sequence":                        "7",
+		"amount":                          "1000",
+	}, attributes)
+
+	_, err = cmn.NewCosmosEvent(address, event, delegator)
+	require.Error(t, err)
+}
+
+func TestEventRegistry(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(eventsTestABI))
+	require.NoError(t, err)
+	event := contractABI.Events["IBCTransferTest"]
+
+	cmn.RegisterEvents(contractABI)
+
+	found, ok := cmn.LookupEvent(event.ID)
+	require.True(t, ok)
+	require.Equal(t, event.Sig, found.Sig)
+
+	found, ok = cmn.LookupCosmosEventType(cmn.CosmosEventType(event), event.Sig)
+	require.True(t, ok)
+	require.Equal(t, event.ID, found.ID)
+
+	_, ok = cmn.LookupEvent(common.Hash{})
+	require.False(t, ok)
+
+	_, ok = cmn.LookupCosmosEventType("precompile_unknown", event.Sig)
+	require.False(t, ok)
+}
@@ -2,15 +2,24 @@ package common
 
 import (
 	"context"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"cosmossdk.io/math"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -38,16 +47,38 @@ type TransferKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
 	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
+type ClientKeeper interface {
+	GetClientStatus(ctx sdk.Context, clientID string) ibcexported.Status
+	GetClientLatestHeight(ctx sdk.Context, clientID string) clienttypes.Height
+}
+
+type ICAControllerKeeper interface {
+	GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool)
+	GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool)
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
 }
 
 type DistributionKeeper interface {
 	WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	GetDelegatorWithdrawAddr(ctx context.Context, delAddr sdk.AccAddress) (sdk.AccAddress, error)
+	SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr sdk.AccAddress) error
+	SetDelegatorWithdrawAddr(ctx context.Context, delAddr, withdrawAddr sdk.AccAddress) error
+	DeleteDelegatorWithdrawAddr(ctx context.Context, delAddr, withdrawAddr sdk.AccAddress) error
 }
 
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
 	MaxValidators(ctx context.Context) (uint32, error)
 	GetDelegatorValidators(ctx context.Context, delegatorAddr sdk.AccAddress, maxRetrieve uint32) (stakingtypes.Validators, error)
+	IterateDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, cb func(delegation stakingtypes.Delegation) (stop bool)) error
+	IterateDelegatorUnbondingDelegations(ctx context.Context, delegator sdk.AccAddress, cb func(ubd stakingtypes.UnbondingDelegation) (stop bool)) error
+	IterateDelegatorRedelegations(ctx context.Context, delegator sdk.AccAddress, cb func(red stakingtypes.Redelegation) (stop bool)) error
 	GetRedelegation(ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) (red stakingtypes.Redelegation, err error)
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, err error)
 }
@@ -58,6 +89,47 @@ type SlashingKeeper interface {
 	SigningInfos(ctx context.Context, req *slashingtypes.QuerySigningInfosRequest) (*slashingtypes.QuerySigningInfosResponse, error)
 }
 
+type EvidenceKeeper interface {
+	Evidence(ctx context.Context, req *evidencetypes.QueryEvidenceRequest) (*evidencetypes.QueryEvidenceResponse, error)
+	AllEvidence(ctx context.Context, req *evidencetypes.QueryAllEvidenceRequest) (*evidencetypes.QueryAllEvidenceResponse, error)
+}
+
+type AuthzKeeper interface {
+	Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*authz.QueryGrantsResponse, error)
+	GranterGrants(ctx context.Context, req *authz.QueryGranterGrantsRequest) (*authz.QueryGranterGrantsResponse, error)
+	GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error)
+}
+
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+type UpgradeKeeper interface {
+	GetModuleVersions(ctx context.Context) ([]*upgradetypes.ModuleVersion, error)
+}
+
+// WasmKeeper defines the x/wasm keeper methods used by the CosmWasm precompile. They are
+// implemented by the wasmd PermissionedKeeper and Keeper respectively.
+type WasmKeeper interface {
+	Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error)
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+	QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
+
+// OracleKeeper defines the price feed hook used by the oracle precompile. Chains running an
+// oracle module, like x/oracle or a Connect (Slinky) sidecar, implement it on top of their
+// module to expose its prices.
+type OracleKeeper interface {
+	// GetPrice returns the latest price of the base asset quoted in the quote asset, the
+	// number of decimals of the price and the time of its last update. An error is returned
+	// if the currency pair has no price.
+	GetPrice(ctx sdk.Context, base, quote string) (price math.Int, decimals uint8, timestamp time.Time, err error)
+}
+
 type ERC20Keeper interface {
 	GetCoinAddress(ctx sdk.Context, denom string) (ethcommon.Address, error)
 	GetERC20Map(ctx sdk.Context, erc20 ethcommon.Address) []byte
@@ -2,16 +2,20 @@ package common
 
 import (
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/hashicorp/go-metrics"
 
 	"github.com/cosmos/evm/x/vm/statedb"
 
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -30,6 +34,44 @@ type Precompile struct {
 
 	// BalanceHandler is optional
 	BalanceHandler *BalanceHandler
+
+	// CacheableQueries is optional. It holds the IDs of the read-only methods whose
+	// results are memoized within a block, see NewCacheableQueries.
+	CacheableQueries map[string]bool
+}
+
+// NewCacheableQueries returns the IDs of the methods of the given ABI that are not
+// transactions, so their results can be memoized within a block.
+//
+// A memoized result is reused by the calls with the same caller and input made by
+// the following transactions of the block being finalized, as long as the values
+// the original call read from the Cosmos stores are unchanged, so any write to them
+// invalidates it. The calls iterating through or writing to the stores, or emitting
+// events, are not memoized. The gas consumed by the original call is charged again
+// and the call counts against MaxPrecompileCalls, so memoization only saves the
+// keeper work and doesn't change the gas used nor the call limit.
+func NewCacheableQueries(contractABI abi.ABI, isTransaction func(method *abi.Method) bool) map[string]bool {
+	queries := make(map[string]bool)
+	for _, method := range contractABI.Methods {
+		if !isTransaction(&method) {
+			queries[string(method.ID)] = true
+		}
+	}
+	return queries
+}
+
+// queryCacheKey returns the key of the precompile call result when the call is a
+// cacheable query without value.
+func (p Precompile) queryCacheKey(contract *vm.Contract) (string, bool) {
+	if len(contract.Input) < 4 || !p.CacheableQueries[string(contract.Input[:4])] {
+		return "", false
+	}
+	if value := contract.Value(); value != nil && !value.IsZero() {
+		return "", false
+	}
+
+	caller := contract.Caller()
+	return string(p.Address().Bytes()) + string(caller.Bytes()) + string(contract.Input), true
 }
 
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
@@ -46,7 +88,9 @@ func (p Precompile) RequiredGas(input []byte, isTransaction bool) uint64 {
 // Run prepare the native context to execute native action for stateful precompile,
 // it manages the snapshot and revert of the multi-store.
 func (p Precompile) RunNativeAction(evm *vm.EVM, contract *vm.Contract, action NativeAction) ([]byte, error) {
+	start := telemetry.Now()
 	bz, err := p.runNativeAction(evm, contract, action)
+	p.emitTelemetry(start, err)
 	if err != nil {
 		return ReturnRevertError(evm, err)
 	}
@@ -54,6 +98,20 @@ func (p Precompile) RunNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 	return bz, nil
 }
 
+// emitTelemetry reports the invocation of the precompile and its latency.
+func (p Precompile) emitTelemetry(start time.Time, err error) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	labels := []metrics.Label{
+		telemetry.NewLabel("precompile", p.Address().Hex()),
+		telemetry.NewLabel("success", strconv.FormatBool(err == nil)),
+	}
+	telemetry.IncrCounterWithLabels([]string{"evm", "precompile", "calls"}, 1, labels)
+	metrics.MeasureSinceWithLabels([]string{"evm", "precompile", "duration"}, start.UTC(), labels)
+}
+
 func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action NativeAction) (bz []byte, err error) {
 	stateDB, ok := evm.StateDB.(*statedb.StateDB)
 	if !ok {
@@ -85,6 +143,16 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 
 	initialGas := ctx.GasMeter().GasConsumed()
 
+	// reuse the result of an identical query of the block if the values it read
+	// are unchanged, and it fits in the gas left so that it wouldn't run out of gas
+	cacheKey, cacheable := p.queryCacheKey(contract)
+	if cacheable {
+		if bz, gas, found := stateDB.GetPrecompileQuery(cacheKey); found && initialGas+gas <= contract.Gas {
+			_ = contract.UseGas(gas, nil, tracing.GasChangeCallPrecompiledContract)
+			return bz, nil
+		}
+	}
+
 	defer HandleGasError(ctx, contract, initialGas, &err)()
 
 	// set the default SDK gas configuration to track gas usage
@@ -100,6 +168,11 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 		p.BalanceHandler.BeforeBalanceChange(ctx)
 	}
 
+	memoize := func([]byte, uint64) {}
+	if cacheable {
+		ctx, memoize = stateDB.TrackPrecompileQuery(ctx, cacheKey)
+	}
+
 	bz, err = action(ctx)
 	if err != nil {
 		return bz, err
@@ -117,6 +190,11 @@ func (p Precompile) runNativeAction(evm *vm.EVM, contract *vm.Contract, action N
 		}
 	}
 
+	// a query emitting events isn't memoized, as they wouldn't be emitted again
+	if len(ctx.EventManager().Events()) == len(events) {
+		memoize(bz, cost)
+	}
+
 	return bz, nil
 }
 
@@ -0,0 +1,31 @@
+package common_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+const cacheableQueriesTestABI = `[
+	{"inputs": [{"name": "account", "type": "address"}], "name": "balanceOf", "outputs": [{"name": "", "type": "uint256"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [], "name": "totalSupply", "outputs": [{"name": "", "type": "uint256"}], "stateMutability": "view", "type": "function"},
+	{"inputs": [{"name": "to", "type": "address"}, {"name": "amount", "type": "uint256"}], "name": "transfer", "outputs": [{"name": "", "type": "bool"}], "stateMutability": "nonpayable", "type": "function"}
+]`
+
+func TestNewCacheableQueries(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(cacheableQueriesTestABI))
+	require.NoError(t, err)
+
+	queries := cmn.NewCacheableQueries(contractABI, func(method *abi.Method) bool {
+		return method.Name == "transfer"
+	})
+
+	require.Len(t, queries, 2)
+	require.True(t, queries[string(contractABI.Methods["balanceOf"].ID)])
+	require.True(t, queries[string(contractABI.Methods["totalSupply"].ID)])
+	require.False(t, queries[string(contractABI.Methods["transfer"].ID)])
+}
@@ -99,6 +99,17 @@ interface DistributionI {
         uint32 maxRetrieve
     ) external returns (bool success);
 
+    /// @dev Claims the rewards of all the delegations of a delegator, iterating over its
+    /// delegations. The rewards are sent to the delegator's withdraw address.
+    /// @param delegatorAddress The address of the delegator
+    /// @param maxValidators The maximum number of delegations to claim rewards from.
+    /// Zero claims the rewards from all the delegations.
+    /// @return amount The total amount of Coin claimed
+    function claimAllRewards(
+        address delegatorAddress,
+        uint32 maxValidators
+    ) external returns (Coin[] calldata amount);
+
     /// @dev Change the address, that can withdraw the rewards of a delegator.
     /// Note that this address cannot be a module account.
     /// @param delegatorAddress The address of the delegator
@@ -118,6 +129,19 @@ interface DistributionI {
         string memory validatorAddress
     ) external returns (Coin[] calldata amount);
 
+    /// @dev Withdraw the rewards of a delegator from a validator to the given receiver,
+    /// instead of the delegator's withdraw address. The receiver is only temporarily set as
+    /// the withdraw address, so it must be allowed by setWithdrawAddress.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The address of the validator
+    /// @param receiver The address receiving the rewards
+    /// @return amount The amount of Coin withdrawn
+    function withdrawDelegatorRewardsTo(
+        address delegatorAddress,
+        string memory validatorAddress,
+        address receiver
+    ) external returns (Coin[] calldata amount);
+
     /// @dev Withdraws the rewards commission of a validator.
     /// @param validatorAddress The address of the validator
     /// @return amount The amount of Coin withdrawn
@@ -26,6 +26,9 @@ var (
 	//go:embed abi.json
 	f   embed.FS
 	ABI abi.ABI
+
+	// cacheableQueries are the IDs of the queries memoized within a block.
+	cacheableQueries map[string]bool
 )
 
 func init() {
@@ -34,6 +37,7 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	cacheableQueries = cmn.NewCacheableQueries(ABI, Precompile{}.IsTransaction)
 }
 
 // Precompile defines the precompiled contract for distribution.
@@ -64,6 +68,7 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ContractAddress:      common.HexToAddress(evmtypes.DistributionPrecompileAddress),
 			BalanceHandler:       cmn.NewBalanceHandler(bankKeeper),
+			CacheableQueries:     cacheableQueries,
 		},
 		ABI:                   ABI,
 		stakingKeeper:         stakingKeeper,
@@ -112,6 +117,10 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 	// Custom transactions
 	case ClaimRewardsMethod:
 		bz, err = p.ClaimRewards(ctx, contract, stateDB, method, args)
+	case ClaimAllRewardsMethod:
+		bz, err = p.ClaimAllRewards(ctx, contract, stateDB, method, args)
+	case WithdrawDelegatorRewardToMethod:
+		bz, err = p.WithdrawDelegatorRewardTo(ctx, contract, stateDB, method, args)
 	// Distribution transactions
 	case SetWithdrawAddressMethod:
 		bz, err = p.SetWithdrawAddress(ctx, contract, stateDB, method, args)
@@ -153,6 +162,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 //
 // Available distribution transactions are:
 //   - ClaimRewards
+//   - ClaimAllRewards
+//   - WithdrawDelegatorRewardTo
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorReward
 //   - WithdrawValidatorCommission
@@ -161,6 +172,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
 	case ClaimRewardsMethod,
+		ClaimAllRewardsMethod,
+		WithdrawDelegatorRewardToMethod,
 		SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardMethod,
 		WithdrawValidatorCommissionMethod,
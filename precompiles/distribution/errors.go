@@ -5,4 +5,6 @@ const (
 	ErrDifferentValidator = "origin address %s is not the same as validator address %s"
 	// ErrInvalidAmount is raised when the given sdk coins amount is invalid
 	ErrInvalidAmount = "invalid amount %s"
+	// ErrInvalidReceiver is raised when the receiver of the withdrawn rewards is invalid.
+	ErrInvalidReceiver = "invalid receiver address: %v"
 )
@@ -9,6 +9,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -25,6 +26,11 @@ const (
 	FundCommunityPoolMethod = "fundCommunityPool"
 	// ClaimRewardsMethod defines the ABI method name for the custom ClaimRewards transaction
 	ClaimRewardsMethod = "claimRewards"
+	// ClaimAllRewardsMethod defines the ABI method name for the custom ClaimAllRewards transaction
+	ClaimAllRewardsMethod = "claimAllRewards"
+	// WithdrawDelegatorRewardToMethod defines the ABI method name for the custom
+	// WithdrawDelegatorRewardTo transaction.
+	WithdrawDelegatorRewardToMethod = "withdrawDelegatorRewardsTo"
 	// DepositValidatorRewardsPoolMethod defines the ABI method name for the distribution
 	// DepositValidatorRewardsPool transaction
 	DepositValidatorRewardsPoolMethod = "depositValidatorRewardsPool"
@@ -84,6 +90,68 @@ func (p *Precompile) ClaimRewards(
 	return method.Outputs.Pack(true)
 }
 
+// ClaimAllRewards claims the rewards accumulated by a delegator from its delegations, up to
+// maxValidators of them or all of them when maxValidators is zero. Unlike ClaimRewards, it
+// iterates the delegations instead of the delegator's validators and returns the claimed coins.
+func (p *Precompile) ClaimAllRewards(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, maxValidators, err := parseClaimAllRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorAddr.String())
+	}
+
+	// NOTE: the validator addresses are collected first to avoid writing to the
+	// stores while iterating over the delegations.
+	var validatorAddrs []string
+	err = p.stakingKeeper.IterateDelegatorDelegations(ctx, delegatorAddr.Bytes(), func(delegation stakingtypes.Delegation) bool {
+		validatorAddrs = append(validatorAddrs, delegation.ValidatorAddress)
+		return maxValidators != 0 && len(validatorAddrs) >= int(maxValidators)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCoins := sdk.Coins{}
+	for _, validatorAddr := range validatorAddrs {
+		valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		coins, err := p.distributionKeeper.WithdrawDelegationRewards(ctx, delegatorAddr.Bytes(), valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: the withdrawn rewards are padded with a zero coin when empty
+		if coins.IsZero() {
+			continue
+		}
+
+		if err := p.EmitWithdrawDelegatorRewardEvent(ctx, stateDB, delegatorAddr, validatorAddr, coins); err != nil {
+			return nil, err
+		}
+
+		totalCoins = totalCoins.Add(coins...)
+	}
+
+	if err := p.EmitClaimRewardsEvent(ctx, stateDB, delegatorAddr, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(totalCoins))
+}
+
 // SetWithdrawAddress sets the withdrawal address for a delegator (or validator self-delegation).
 func (p Precompile) SetWithdrawAddress(
 	ctx sdk.Context,
@@ -143,6 +211,65 @@ func (p *Precompile) WithdrawDelegatorReward(
 	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Amount))
 }
 
+// WithdrawDelegatorRewardTo withdraws the rewards of a delegator from a single validator
+// to the given receiver. The receiver is set as the delegator's withdraw address for the
+// withdrawal only, so the same restrictions as in SetWithdrawAddress apply to it.
+func (p *Precompile) WithdrawDelegatorRewardTo(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, delegatorHexAddr, receiverHexAddr, err := NewMsgWithdrawDelegatorRewardTo(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), delegatorHexAddr.String())
+	}
+
+	delegatorAddr := sdk.AccAddress(delegatorHexAddr.Bytes())
+	receiverAddr := sdk.AccAddress(receiverHexAddr.Bytes())
+
+	withdrawAddr, err := p.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	swapWithdrawAddr := !withdrawAddr.Equals(receiverAddr)
+	if swapWithdrawAddr {
+		if err := p.distributionKeeper.SetWithdrawAddr(ctx, delegatorAddr, receiverAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := p.distributionMsgServer.WithdrawDelegatorReward(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// restore the previous withdraw address of the delegator
+	if swapWithdrawAddr {
+		if withdrawAddr.Equals(delegatorAddr) {
+			err = p.distributionKeeper.DeleteDelegatorWithdrawAddr(ctx, delegatorAddr, receiverAddr)
+		} else {
+			err = p.distributionKeeper.SetDelegatorWithdrawAddr(ctx, delegatorAddr, withdrawAddr)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = p.EmitWithdrawDelegatorRewardEvent(ctx, stateDB, delegatorHexAddr, msg.ValidatorAddress, res.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Amount))
+}
+
 // WithdrawValidatorCommission withdraws the rewards of a validator.
 func (p *Precompile) WithdrawValidatorCommission(
 	ctx sdk.Context,
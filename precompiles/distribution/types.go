@@ -77,6 +77,25 @@ func parseClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
 	return delegatorAddress, maxRetrieve, nil
 }
 
+// parseClaimAllRewardsArgs parses the arguments for the ClaimAllRewards method.
+func parseClaimAllRewardsArgs(args []interface{}) (common.Address, uint32, error) {
+	if len(args) != 2 {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok || delegatorAddress == (common.Address{}) {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	maxValidators, ok := args[1].(uint32)
+	if !ok {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidType, "maxValidators", uint32(0), args[1])
+	}
+
+	return delegatorAddress, maxValidators, nil
+}
+
 // NewMsgSetWithdrawAddress creates a new MsgSetWithdrawAddress instance.
 func NewMsgSetWithdrawAddress(args []interface{}, addrCdc address.Codec) (*distributiontypes.MsgSetWithdrawAddress, common.Address, error) {
 	if len(args) != 2 {
@@ -136,6 +155,26 @@ func NewMsgWithdrawDelegatorReward(args []interface{}, addrCdc address.Codec) (*
 	return msg, delegatorAddress, nil
 }
 
+// NewMsgWithdrawDelegatorRewardTo creates a new MsgWithdrawDelegatorReward instance and
+// returns the receiver of the withdrawn rewards.
+func NewMsgWithdrawDelegatorRewardTo(args []interface{}, addrCdc address.Codec) (*distributiontypes.MsgWithdrawDelegatorReward, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	msg, delegatorAddress, err := NewMsgWithdrawDelegatorReward(args[:2], addrCdc)
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	receiver, ok := args[2].(common.Address)
+	if !ok || receiver == (common.Address{}) {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[2])
+	}
+
+	return msg, delegatorAddress, receiver, nil
+}
+
 // NewMsgWithdrawValidatorCommission creates a new MsgWithdrawValidatorCommission message.
 func NewMsgWithdrawValidatorCommission(args []interface{}) (*distributiontypes.MsgWithdrawValidatorCommission, common.Address, error) {
 	if len(args) != 1 {
@@ -44,6 +44,9 @@ var (
 	//go:embed abi.json
 	f   embed.FS
 	ABI abi.ABI
+
+	// cacheableQueries are the IDs of the queries memoized within a block.
+	cacheableQueries map[string]bool
 )
 
 func init() {
@@ -52,6 +55,7 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	cacheableQueries = cmn.NewCacheableQueries(ABI, Precompile{}.IsTransaction)
 }
 
 var _ vm.PrecompiledContract = &Precompile{}
@@ -88,6 +92,7 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.GasConfig{},
 			ContractAddress:      tokenPair.GetERC20Contract(),
 			BalanceHandler:       cmn.NewBalanceHandler(bankKeeper),
+			CacheableQueries:     cacheableQueries,
 		},
 		ABI:            ABI,
 		tokenPair:      tokenPair,
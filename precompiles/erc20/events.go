@@ -3,12 +3,12 @@ package erc20
 import (
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -23,72 +23,23 @@ const (
 
 // EmitTransferEvent creates a new Transfer event emitted on transfer and transferFrom transactions.
 func (p Precompile) EmitTransferEvent(ctx sdk.Context, stateDB vm.StateDB, from, to common.Address, value *big.Int) error {
-	// Prepare the event topics
-	event := p.Events[EventTypeTransfer]
-	topics := make([]common.Hash, 3)
-
-	// The first topic is always the signature of the event.
-	topics[0] = event.ID
-
-	var err error
-	topics[1], err = cmn.MakeTopic(from)
-	if err != nil {
-		return err
-	}
-
-	topics[2], err = cmn.MakeTopic(to)
-	if err != nil {
-		return err
-	}
-
-	arguments := abi.Arguments{event.Inputs[2]}
-	packed, err := arguments.Pack(value)
-	if err != nil {
-		return err
-	}
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeTransfer], from, to, value)
+}
 
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
+// EmitPrecompileTransferEvent emits a typed Cosmos event with the sender, recipient,
+// amount and denom of a transfer, so that the indexers tracking the bank transfers
+// see the movements of the precompile without parsing the EVM logs.
+func (p Precompile) EmitPrecompileTransferEvent(ctx sdk.Context, from, to common.Address, value *big.Int) error {
+	return ctx.EventManager().EmitTypedEvent(&erc20types.EventPrecompileTransfer{
+		Sender:       sdk.AccAddress(from.Bytes()).String(),
+		Recipient:    sdk.AccAddress(to.Bytes()).String(),
+		Amount:       math.NewIntFromBigInt(value).String(),
+		Denom:        p.tokenPair.Denom,
+		Erc20Address: p.Address().Hex(),
 	})
-
-	return nil
 }
 
 // EmitApprovalEvent creates a new approval event emitted on Approve transactions.
 func (p Precompile) EmitApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, spender common.Address, value *big.Int) error {
-	// Prepare the event topics
-	event := p.Events[EventTypeApproval]
-	topics := make([]common.Hash, 3)
-
-	// The first topic is always the signature of the event.
-	topics[0] = event.ID
-
-	var err error
-	topics[1], err = cmn.MakeTopic(owner)
-	if err != nil {
-		return err
-	}
-
-	topics[2], err = cmn.MakeTopic(spender)
-	if err != nil {
-		return err
-	}
-
-	arguments := abi.Arguments{event.Inputs[2]}
-	packed, err := arguments.Pack(value)
-	if err != nil {
-		return err
-	}
-
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // block height won't exceed uint64
-	})
-
-	return nil
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeApproval], owner, spender, value)
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,4 +15,5 @@ type Erc20Keeper interface {
 	GetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) (*big.Int, error)
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
+	CheckTransferRestriction(ctx sdk.Context, pair erc20types.TokenPair, spender, from, to common.Address, amount *big.Int) error
 }
@@ -86,6 +86,11 @@ func (p *Precompile) transfer(
 	spenderAddr := contract.Caller()
 	newAllowance := big.NewInt(0)
 
+	// the transfer restrictions set by the application can deny the transfer
+	if err = p.erc20Keeper.CheckTransferRestriction(ctx, p.tokenPair, spenderAddr, from, to, amount); err != nil {
+		return nil, err
+	}
+
 	if isTransferFrom {
 		spenderAddr := contract.Caller()
 
@@ -121,6 +126,10 @@ func (p *Precompile) transfer(
 		return nil, err
 	}
 
+	if err = p.EmitPrecompileTransferEvent(ctx, from, to, amount); err != nil {
+		return nil, err
+	}
+
 	// NOTE: if it's a direct transfer, we return here but if used through transferFrom,
 	// we need to emit the approval event with the new allowance.
 	if isTransferFrom {
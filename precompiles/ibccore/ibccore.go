@@ -0,0 +1,125 @@
+package ibccore
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the read-only precompiled contract exposing the state of the
+// IBC clients, connections and channels.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	channelKeeper cmn.ChannelKeeper
+	clientKeeper  cmn.ClientKeeper
+}
+
+// NewPrecompile creates a new IBC core Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	channelKeeper cmn.ChannelKeeper,
+	clientKeeper cmn.ClientKeeper,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ContractAddress:      common.HexToAddress(evmtypes.IBCCorePrecompileAddress),
+		},
+		ABI:           ABI,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, contract, readonly)
+	})
+}
+
+// Execute executes the precompiled contract IBC core query methods defined in the ABI.
+func (p Precompile) Execute(ctx sdk.Context, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+	switch method.Name {
+	// Channel queries
+	case ChannelMethod:
+		bz, err = p.Channel(ctx, method, args)
+	case NextSequencesMethod:
+		bz, err = p.NextSequences(ctx, method, args)
+	case ChannelClientMethod:
+		bz, err = p.ChannelClient(ctx, method, args)
+	case IsChannelActiveMethod:
+		bz, err = p.IsChannelActive(ctx, method, args)
+	// Connection queries
+	case ConnectionMethod:
+		bz, err = p.Connection(ctx, method, args)
+	// Client queries
+	case ClientStatusMethod:
+		bz, err = p.ClientStatus(ctx, method, args)
+	case ClientLatestHeightMethod:
+		bz, err = p.ClientLatestHeight(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+// It returns false since all IBC core methods are queries.
+func (Precompile) IsTransaction(_ *abi.Method) bool {
+	return false
+}
@@ -0,0 +1,157 @@
+package ibccore
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ChannelMethod defines the ABI method name for the IBC core Channel query.
+	ChannelMethod = "channel"
+	// NextSequencesMethod defines the ABI method name for the IBC core NextSequences query.
+	NextSequencesMethod = "nextSequences"
+	// ChannelClientMethod defines the ABI method name for the IBC core ChannelClient query.
+	ChannelClientMethod = "channelClient"
+	// IsChannelActiveMethod defines the ABI method name for the IBC core IsChannelActive query.
+	IsChannelActiveMethod = "isChannelActive"
+	// ConnectionMethod defines the ABI method name for the IBC core Connection query.
+	ConnectionMethod = "connection"
+	// ClientStatusMethod defines the ABI method name for the IBC core ClientStatus query.
+	ClientStatusMethod = "clientStatus"
+	// ClientLatestHeightMethod defines the ABI method name for the IBC core ClientLatestHeight query.
+	ClientLatestHeightMethod = "clientLatestHeight"
+)
+
+// Channel returns the channel end of the given port and channel. A channel with an
+// UNINITIALIZED state is returned if not found.
+func (p Precompile) Channel(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, _ := p.channelKeeper.GetChannel(ctx, portID, channelID)
+	return method.Outputs.Pack(NewChannel(channel))
+}
+
+// NextSequences returns the next send, receive and acknowledgement sequences of the
+// given channel. The sequences not found are returned as zero.
+func (p Precompile) NextSequences(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	nextSequenceSend, _ := p.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	nextSequenceRecv, _ := p.channelKeeper.GetNextSequenceRecv(ctx, portID, channelID)
+	nextSequenceAck, _ := p.channelKeeper.GetNextSequenceAck(ctx, portID, channelID)
+	return method.Outputs.Pack(nextSequenceSend, nextSequenceRecv, nextSequenceAck)
+}
+
+// ChannelClient returns the client of the given channel along with its status. An empty
+// client identifier and an Unknown status are returned if the channel or its connection
+// is not found.
+func (p Precompile) ChannelClient(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, status := p.channelClientStatus(ctx, portID, channelID)
+	return method.Outputs.Pack(clientID, status.String())
+}
+
+// IsChannelActive returns true if the given channel is open and its client is active,
+// i.e. packets can be sent on the channel.
+func (p Precompile) IsChannelActive(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, channelID, err := ParseChannelArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := p.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found || channel.State != channeltypes.OPEN {
+		return method.Outputs.Pack(false)
+	}
+
+	_, status := p.channelClientStatus(ctx, portID, channelID)
+	return method.Outputs.Pack(status == ibcexported.Active)
+}
+
+// Connection returns the connection end of the given connection. A connection with an
+// UNINITIALIZED state is returned if not found.
+func (p Precompile) Connection(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	connectionID, err := ParseIdentifierArg("connectionId", args)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty connection is returned if not found
+	connection, _ := p.channelKeeper.GetConnection(ctx, connectionID)
+	return method.Outputs.Pack(NewConnection(connection))
+}
+
+// ClientStatus returns the status of the given client.
+func (p Precompile) ClientStatus(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := ParseIdentifierArg("clientId", args)
+	if err != nil {
+		return nil, err
+	}
+
+	status := p.clientKeeper.GetClientStatus(ctx, clientID)
+	return method.Outputs.Pack(status.String())
+}
+
+// ClientLatestHeight returns the latest height of the given client, or a zero height if
+// not found.
+func (p Precompile) ClientLatestHeight(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	clientID, err := ParseIdentifierArg("clientId", args)
+	if err != nil {
+		return nil, err
+	}
+
+	latestHeight := p.clientKeeper.GetClientLatestHeight(ctx, clientID)
+	return method.Outputs.Pack(latestHeight)
+}
+
+// channelClientStatus returns the client of the given channel along with its status.
+func (p Precompile) channelClientStatus(ctx sdk.Context, portID, channelID string) (string, ibcexported.Status) {
+	clientID, _, err := p.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return "", ibcexported.Unknown
+	}
+
+	return clientID, p.clientKeeper.GetClientStatus(ctx, clientID)
+}
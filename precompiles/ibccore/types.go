@@ -0,0 +1,88 @@
+package ibccore
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// Channel is the channel end returned by the channel query.
+type Channel struct {
+	State                 uint8  `abi:"state"`
+	Ordering              uint8  `abi:"ordering"`
+	CounterpartyPortId    string `abi:"counterpartyPortId"`    //nolint:revive
+	CounterpartyChannelId string `abi:"counterpartyChannelId"` //nolint:revive
+	ConnectionId          string `abi:"connectionId"`          //nolint:revive
+	Version               string `abi:"version"`
+}
+
+// Connection is the connection end returned by the connection query.
+type Connection struct {
+	State                    uint8  `abi:"state"`
+	ClientId                 string `abi:"clientId"`                 //nolint:revive
+	CounterpartyClientId     string `abi:"counterpartyClientId"`     //nolint:revive
+	CounterpartyConnectionId string `abi:"counterpartyConnectionId"` //nolint:revive
+}
+
+// NewChannel creates the Channel output from the given channel end.
+func NewChannel(channel channeltypes.Channel) Channel {
+	var connectionID string
+	if len(channel.ConnectionHops) > 0 {
+		connectionID = channel.ConnectionHops[0]
+	}
+
+	return Channel{
+		State:                 uint8(channel.State),    //#nosec G115 -- the channel states fit into uint8
+		Ordering:              uint8(channel.Ordering), //#nosec G115 -- the channel orderings fit into uint8
+		CounterpartyPortId:    channel.Counterparty.PortId,
+		CounterpartyChannelId: channel.Counterparty.ChannelId,
+		ConnectionId:          connectionID,
+		Version:               channel.Version,
+	}
+}
+
+// NewConnection creates the Connection output from the given connection end.
+func NewConnection(connection connectiontypes.ConnectionEnd) Connection {
+	return Connection{
+		State:                    uint8(connection.State), //#nosec G115 -- the connection states fit into uint8
+		ClientId:                 connection.ClientId,
+		CounterpartyClientId:     connection.Counterparty.ClientId,
+		CounterpartyConnectionId: connection.Counterparty.ConnectionId,
+	}
+}
+
+// ParseChannelArgs parses the port and channel identifiers of the channel queries.
+func ParseChannelArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	portID, ok := args[0].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidType, "portId", "", args[0])
+	}
+
+	channelID, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(cmn.ErrInvalidType, "channelId", "", args[1])
+	}
+
+	return portID, channelID, nil
+}
+
+// ParseIdentifierArg parses the single identifier argument of the connection and client
+// queries.
+func ParseIdentifierArg(name string, args []interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	id, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidType, name, "", args[0])
+	}
+
+	return id, nil
+}
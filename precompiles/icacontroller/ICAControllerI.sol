@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICAControllerI contract's address.
+address constant ICA_CONTROLLER_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The ICAControllerI contract's instance.
+ICAControllerI constant ICA_CONTROLLER_CONTRACT = ICAControllerI(
+    ICA_CONTROLLER_PRECOMPILE_ADDRESS
+);
+
+/// @dev ICAMsg is a Cosmos SDK message executed by the interchain account on the host chain.
+struct ICAMsg {
+    /// @dev The type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend"
+    string typeUrl;
+    /// @dev The protobuf encoded message
+    bytes value;
+}
+
+/// @author Evmos Team
+/// @title ICA Controller Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the
+/// IBC interchain accounts controller, to control accounts on remote IBC chains.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface ICAControllerI {
+    /// @dev Emitted when an interchain account is registered, i.e. the channel handshake is initiated.
+    /// @param owner The owner of the interchain account
+    /// @param connectionId The connection identifier to the host chain
+    /// @param portId The controller port identifier of the owner
+    /// @param channelId The identifier of the channel being opened
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string portId,
+        string channelId
+    );
+
+    /// @dev Emitted when a transaction is sent to be executed by an interchain account.
+    /// @param owner The owner of the interchain account
+    /// @param sequence The sequence of the IBC packet
+    /// @param connectionId The connection identifier to the host chain
+    event SendTx(
+        address indexed owner,
+        uint64 indexed sequence,
+        string connectionId
+    );
+
+    /// @dev Registers an interchain account for the owner on the host chain of the connection,
+    /// by initiating the handshake of its channel. The account address is available once the
+    /// handshake is completed by the relayers.
+    /// @param owner The owner of the interchain account, must be the caller
+    /// @param connectionId The connection identifier to the host chain
+    /// @param version The channel version, the default metadata is used if empty
+    /// @param ordered Whether the channel is ordered, an unordered channel is used otherwise
+    /// @return channelId The identifier of the channel being opened
+    function registerInterchainAccount(
+        address owner,
+        string calldata connectionId,
+        string calldata version,
+        bool ordered
+    ) external returns (string memory channelId);
+
+    /// @dev Sends the messages to be executed atomically by the interchain account of the owner.
+    /// @param owner The owner of the interchain account, must be the caller
+    /// @param connectionId The connection identifier to the host chain
+    /// @param msgs The messages to execute on the host chain
+    /// @param memo The memo of the packet
+    /// @param relativeTimeout The timeout of the packet in nanoseconds, relative to the block time
+    /// @return sequence The sequence of the IBC packet
+    function sendTx(
+        address owner,
+        string calldata connectionId,
+        ICAMsg[] calldata msgs,
+        string calldata memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev Returns the address of the interchain account of the owner on the host chain.
+    /// @param owner The owner of the interchain account
+    /// @param connectionId The connection identifier to the host chain
+    /// @return accountAddress The address of the interchain account, empty if not registered
+    function interchainAccount(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
@@ -0,0 +1,14 @@
+package icacontroller
+
+const (
+	// ErrInvalidOwner is raised when the owner address is invalid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidConnectionID is raised when the connection identifier is invalid.
+	ErrInvalidConnectionID = "invalid connection id: %v"
+	// ErrNoMsgs is raised when no message is provided to be executed on the host chain.
+	ErrNoMsgs = "no messages to execute on the host chain"
+	// ErrNoActiveChannel is raised when the interchain account of the owner has no active channel.
+	ErrNoActiveChannel = "no active channel for the interchain account of %s on connection %s"
+	// ErrInvalidTimeout is raised when the relative timeout is zero.
+	ErrInvalidTimeout = "relative timeout cannot be zero"
+)
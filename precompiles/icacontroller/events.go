@@ -0,0 +1,52 @@
+package icacontroller
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterInterchainAccount defines the event type for the ICA controller
+	// RegisterInterchainAccount transaction.
+	EventTypeRegisterInterchainAccount = "RegisterInterchainAccount"
+	// EventTypeSendTx defines the event type for the ICA controller SendTx transaction.
+	EventTypeSendTx = "SendTx"
+)
+
+// EventRegisterInterchainAccount is the event emitted on a RegisterInterchainAccount transaction.
+type EventRegisterInterchainAccount struct {
+	Owner        common.Address
+	ConnectionId string //nolint:revive
+	PortId       string //nolint:revive
+	ChannelId    string //nolint:revive
+}
+
+// EventSendTx is the event emitted on a SendTx transaction.
+type EventSendTx struct {
+	Owner        common.Address
+	Sequence     uint64
+	ConnectionId string //nolint:revive
+}
+
+// EmitRegisterInterchainAccountEvent emits the RegisterInterchainAccount event.
+func (p Precompile) EmitRegisterInterchainAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	connectionID, portID, channelID string,
+) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeRegisterInterchainAccount], owner, connectionID, portID, channelID)
+}
+
+// EmitSendTxEvent emits the SendTx event.
+func (p Precompile) EmitSendTxEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	owner common.Address,
+	sequence uint64,
+	connectionID string,
+) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeSendTx], owner, sequence, connectionID)
+}
@@ -0,0 +1,136 @@
+package icacontroller
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   embed.FS
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = cmn.LoadABI(f, "abi.json")
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract for the ICS-27 interchain accounts controller.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	icaControllerKeeper    cmn.ICAControllerKeeper
+	icaControllerMsgServer icacontrollertypes.MsgServer
+	codec                  codec.Codec
+}
+
+// NewPrecompile creates a new ICA controller Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	icaControllerKeeper cmn.ICAControllerKeeper,
+	icaControllerMsgServer icacontrollertypes.MsgServer,
+	bankKeeper cmn.BankKeeper,
+	codec codec.Codec,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ContractAddress:      common.HexToAddress(evmtypes.ICAControllerPrecompileAddress),
+			BalanceHandler:       cmn.NewBalanceHandler(bankKeeper),
+		},
+		ABI:                    ABI,
+		icaControllerKeeper:    icaControllerKeeper,
+		icaControllerMsgServer: icaControllerMsgServer,
+		codec:                  codec,
+	}
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+
+	switch method.Name {
+	// ICA controller transactions
+	case RegisterInterchainAccountMethod:
+		bz, err = p.RegisterInterchainAccount(ctx, method, stateDB, contract, args)
+	case SendTxMethod:
+		bz, err = p.SendTx(ctx, method, stateDB, contract, args)
+	// ICA controller queries
+	case InterchainAccountMethod:
+		bz, err = p.InterchainAccount(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	return bz, err
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available ICA controller transactions are:
+//   - RegisterInterchainAccount
+//   - SendTx
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case RegisterInterchainAccountMethod, SendTxMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("evm extension", "ica controller")
+}
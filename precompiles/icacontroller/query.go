@@ -0,0 +1,52 @@
+package icacontroller
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InterchainAccountMethod defines the ABI method name for the ICA controller
+	// InterchainAccount query.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount returns the address of the interchain account of the owner on the host
+// chain of the given connection, or an empty string if not registered.
+func (p Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty address is returned if the account is not registered
+	address, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	return method.Outputs.Pack(address)
+}
@@ -0,0 +1,126 @@
+package icacontroller
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICA controller
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICA controller SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the handshake of the channel of the interchain
+// account of the owner on the host chain of the given connection.
+func (p *Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgRegisterInterchainAccount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), owner.String())
+	}
+
+	res, err := p.icaControllerMsgServer.RegisterInterchainAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.PortId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId)
+}
+
+// SendTx sends the messages to be executed by the interchain account of the owner on
+// the host chain of the given connection.
+func (p *Precompile) SendTx(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	input, err := ParseSendTxArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != input.Owner {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), input.Owner.String())
+	}
+
+	owner := sdk.AccAddress(input.Owner.Bytes()).String()
+	encoding, err := p.channelEncoding(ctx, owner, input.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	packetData, err := NewInterchainAccountPacketData(p.codec, input.Msgs, input.Memo, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(owner, input.ConnectionId, input.RelativeTimeout, packetData)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.icaControllerMsgServer.SendTx(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, input.Owner, res.Sequence, input.ConnectionId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
+
+// channelEncoding returns the encoding of the messages executed by the interchain account of
+// the owner, set in the metadata of its active channel.
+func (p *Precompile) channelEncoding(ctx sdk.Context, owner, connectionID string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	channelID, found := p.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", fmt.Errorf(ErrNoActiveChannel, owner, connectionID)
+	}
+
+	version, found := p.icaControllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return "", fmt.Errorf(ErrNoActiveChannel, owner, connectionID)
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	return metadata.Encoding, nil
+}
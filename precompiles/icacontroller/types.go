@@ -0,0 +1,135 @@
+package icacontroller
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ICAMsg is the Cosmos SDK message executed by the interchain account on the host chain.
+type ICAMsg struct {
+	TypeUrl string `abi:"typeUrl"` //nolint:revive
+	Value   []byte `abi:"value"`
+}
+
+// SendTxInput is the input of the sendTx transaction.
+type SendTxInput struct {
+	Owner           common.Address `abi:"owner"`
+	ConnectionId    string         `abi:"connectionId"` //nolint:revive
+	Msgs            []ICAMsg       `abi:"msgs"`
+	Memo            string         `abi:"memo"`
+	RelativeTimeout uint64         `abi:"relativeTimeout"`
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance
+// from the given arguments, and returns it along with the owner address.
+func NewMsgRegisterInterchainAccount(args []interface{}) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "version", "", args[2])
+	}
+
+	ordered, ok := args[3].(bool)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "ordered", false, args[3])
+	}
+
+	ordering := channeltypes.UNORDERED
+	if ordered {
+		ordering = channeltypes.ORDERED
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, sdk.AccAddress(owner.Bytes()).String(), version, ordering)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// ParseSendTxArgs parses the arguments of the sendTx transaction.
+func ParseSendTxArgs(method *abi.Method, args []interface{}) (*SendTxInput, error) {
+	if len(args) != 5 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	var input SendTxInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to SendTxInput: %s", err)
+	}
+
+	if input.Owner == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidOwner, input.Owner)
+	}
+	if err := host.ConnectionIdentifierValidator(input.ConnectionId); err != nil {
+		return nil, fmt.Errorf(ErrInvalidConnectionID, err)
+	}
+	if len(input.Msgs) == 0 {
+		return nil, fmt.Errorf(ErrNoMsgs)
+	}
+	if input.RelativeTimeout == 0 {
+		return nil, fmt.Errorf(ErrInvalidTimeout)
+	}
+
+	return &input, nil
+}
+
+// NewInterchainAccountPacketData creates the packet data executing the given messages
+// on the host chain, serialized with the encoding of the interchain account channel.
+func NewInterchainAccountPacketData(cdc codec.Codec, msgs []ICAMsg, memo, encoding string) (icatypes.InterchainAccountPacketData, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anys[i] = &codectypes.Any{TypeUrl: msg.TypeUrl, Value: msg.Value}
+	}
+	cosmosTx := &icatypes.CosmosTx{Messages: anys}
+
+	var (
+		data []byte
+		err  error
+	)
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		data, err = cdc.Marshal(cosmosTx)
+	case icatypes.EncodingProto3JSON:
+		// NOTE: the messages types must be registered on this chain to be encoded in JSON
+		data, err = cdc.MarshalJSON(cosmosTx)
+	default:
+		return icatypes.InterchainAccountPacketData{}, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, errorsmod.Wrapf(err, "cannot marshal CosmosTx with %s", encoding)
+	}
+
+	return icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}, nil
+}
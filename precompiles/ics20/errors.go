@@ -4,13 +4,19 @@ const (
 	// ErrInvalidSourcePort is raised when the source port is invalid.
 	ErrInvalidSourcePort = "invalid source port"
 	// ErrInvalidSourceChannel is raised when the source channel is invalid.
-	ErrInvalidSourceChannel = "invalid source port"
+	ErrInvalidSourceChannel = "invalid source channel"
 	// ErrInvalidSender is raised when the sender is invalid.
 	ErrInvalidSender = "invalid sender: %s"
 	// ErrInvalidReceiver is raised when the receiver is invalid.
 	ErrInvalidReceiver = "invalid receiver: %s"
 	// ErrInvalidTimeoutTimestamp is raised when the timeout timestamp is invalid.
 	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %d"
+	// ErrNoTimeout is raised when neither the timeout height nor the timeout timestamp is set.
+	ErrNoTimeout = "timeout height and timeout timestamp cannot both be 0"
+	// ErrTimeoutHeightOnV2 is raised when a timeout height is set on a v2 packet.
+	ErrTimeoutHeightOnV2 = "timeout height (%s) is not supported on v2 packets, use the timeout timestamp"
+	// ErrNoTimeoutTimestampOnV2 is raised when the timeout timestamp is not set on a v2 packet.
+	ErrNoTimeoutTimestampOnV2 = "timeout timestamp cannot be 0 on v2 packets"
 	// ErrInvalidMemo is raised when the memo is invalid.
 	ErrInvalidMemo = "invalid memo: %s"
 	// ErrInvalidHash is raised when the hash is invalid.
@@ -3,10 +3,10 @@ package ics20
 import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,47 +16,26 @@ const (
 	EventTypeIBCTransfer = "IBCTransfer"
 )
 
-// EmitIBCTransferEvent creates a new IBC transfer event emitted on a Transfer transaction.
+// EmitIBCTransferEvent creates a new IBC transfer event emitted on a Transfer transaction,
+// along with the sequence of the packet sent, so that its acknowledgement can be correlated.
 func EmitIBCTransferEvent(
 	ctx sdk.Context,
 	stateDB vm.StateDB,
 	event abi.Event,
 	precompileAddr, senderAddr common.Address,
-	receiver string,
-	sourcePort, sourceChannel string,
-	token sdk.Coin,
-	memo string,
+	msg *transfertypes.MsgTransfer,
+	sequence uint64,
 ) error {
-	// Prepare the event topics
-	topics := make([]common.Hash, 3)
-
-	// The first topic is always the signature of the event.
-	topics[0] = event.ID
-
-	var err error
-	// sender and receiver are indexed
-	topics[1], err = cmn.MakeTopic(senderAddr)
-	if err != nil {
-		return err
-	}
-	topics[2], err = cmn.MakeTopic(receiver)
-	if err != nil {
-		return err
-	}
-
-	// Prepare the event data: denom, amount, memo
-	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3], event.Inputs[4], event.Inputs[5], event.Inputs[6]}
-	packed, err := arguments.Pack(sourcePort, sourceChannel, token.Denom, token.Amount.BigInt(), memo)
-	if err != nil {
-		return err
-	}
-
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     precompileAddr,
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
-	})
-
-	return nil
+	return cmn.EmitEvent(ctx, stateDB, precompileAddr, event,
+		senderAddr,
+		msg.Receiver,
+		sequence,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.Token.Denom,
+		msg.Token.Amount.BigInt(),
+		msg.TimeoutHeight,
+		msg.TimeoutTimestamp,
+		msg.Memo,
+	)
 }
@@ -26,6 +26,7 @@ const (
 )
 
 // validateV1TransferChannel does the following validation on an ibc v1 channel specified in a MsgTransfer:
+// - check if the source port is the transfer port
 // - check if the channel exists
 // - check if the channel is OPEN
 // - check if the underlying connection exists
@@ -39,6 +40,16 @@ func (p *Precompile) validateV1TransferChannel(ctx sdk.Context, msg *transfertyp
 		return fmt.Errorf("msg invalid: %w", err)
 	}
 
+	// the transfer keeper always sends v1 packets from the transfer port
+	if msg.SourcePort != transfertypes.PortID {
+		return errorsmod.Wrapf(
+			channeltypes.ErrInvalidChannel,
+			"invalid source port (%s), expected (%s)",
+			msg.SourcePort,
+			transfertypes.PortID,
+		)
+	}
+
 	// check if channel exists and is open
 	channel, found := p.channelKeeper.GetChannel(ctx, msg.SourcePort, msg.SourceChannel)
 	if !found {
@@ -87,6 +98,25 @@ func (p *Precompile) validateV1TransferChannel(ctx sdk.Context, msg *transfertyp
 	return nil
 }
 
+// validateTransferTimeout checks that the timeout of a MsgTransfer is set, and that
+// v2 packets, which only support timestamp-based timeouts, don't set a timeout height.
+func validateTransferTimeout(msg *transfertypes.MsgTransfer, isV1 bool) error {
+	if isV1 {
+		if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+			return errorsmod.Wrap(channeltypes.ErrInvalidPacket, ErrNoTimeout)
+		}
+		return nil
+	}
+
+	if !msg.TimeoutHeight.IsZero() {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidPacket, ErrTimeoutHeightOnV2, msg.TimeoutHeight)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(channeltypes.ErrInvalidPacket, ErrNoTimeoutTimestampOnV2)
+	}
+	return nil
+}
+
 // Transfer implements the ICS20 transfer transactions.
 func (p *Precompile) Transfer(
 	ctx sdk.Context,
@@ -101,7 +131,8 @@ func (p *Precompile) Transfer(
 	}
 
 	// If the channel is in v1 format, check if channel exists and is open
-	if channeltypes.IsChannelIDFormat(msg.SourceChannel) {
+	isV1 := channeltypes.IsChannelIDFormat(msg.SourceChannel)
+	if isV1 {
 		if err := p.validateV1TransferChannel(ctx, msg); err != nil {
 			return nil, err
 		}
@@ -114,6 +145,10 @@ func (p *Precompile) Transfer(
 		)
 	}
 
+	if err := validateTransferTimeout(msg, isV1); err != nil {
+		return nil, err
+	}
+
 	msgSender := contract.Caller()
 	if msgSender != sender {
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
@@ -130,11 +165,8 @@ func (p *Precompile) Transfer(
 		p.Events[EventTypeIBCTransfer],
 		p.Address(),
 		sender,
-		msg.Receiver,
-		msg.SourcePort,
-		msg.SourceChannel,
-		msg.Token,
-		msg.Memo,
+		msg,
+		res.Sequence,
 	); err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 
@@ -36,13 +37,16 @@ var DefaultTimeoutHeight = clienttypes.NewHeight(DefaultRevisionNumber, DefaultR
 
 // EventIBCTransfer is the event type emitted when a transfer is executed.
 type EventIBCTransfer struct {
-	Sender        common.Address
-	Receiver      common.Hash
-	SourcePort    string
-	SourceChannel string
-	Denom         string
-	Amount        *big.Int
-	Memo          string
+	Sender           common.Address
+	Receiver         common.Hash
+	Sequence         uint64
+	SourcePort       string
+	SourceChannel    string
+	Denom            string
+	Amount           *big.Int
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+	Memo             string
 }
 
 // EventTransferAuthorization is the event type emitted when a transfer authorization is created.
@@ -126,6 +130,12 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[8])
 	}
 
+	// the ERC20 contract address of a registered token pair is accepted as denom,
+	// the tokens are then converted to their Cosmos representation by the transfer keeper
+	if common.IsHexAddress(denom) {
+		denom = erc20types.CreateDenom(common.HexToAddress(denom).Hex())
+	}
+
 	// Use instance to prevent errors on denom or amount
 	token := sdk.Coin{
 		Denom:  denom,
@@ -0,0 +1,16 @@
+package msgrouter
+
+const (
+	// ErrInvalidSigner is raised when the signer address is invalid.
+	ErrInvalidSigner = "invalid signer address: %v"
+	// ErrInvalidMsg is raised when the message cannot be decoded.
+	ErrInvalidMsg = "invalid msg: %v"
+	// ErrMsgNotAllowed is raised when the message type is not allowed by the EVM parameters.
+	ErrMsgNotAllowed = "msg type %s is not allowed"
+	// ErrInvalidSigners is raised when the message is not only signed by the signer.
+	ErrInvalidSigners = "msg %s must only be signed by the signer"
+	// ErrNoHandler is raised when no message service handles the message.
+	ErrNoHandler = "no handler for msg type %s"
+	// ErrInvalidMsgResponse is raised when the response of the message cannot be decoded.
+	ErrInvalidMsgResponse = "invalid response of msg %s"
+)
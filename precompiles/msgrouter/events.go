@@ -0,0 +1,24 @@
+package msgrouter
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeExecute defines the event type for the message router Execute transaction.
+	EventTypeExecute = "Execute"
+)
+
+// EventExecute is the event emitted on an Execute transaction.
+type EventExecute struct {
+	Signer     common.Address
+	MsgTypeUrl string //nolint:revive
+}
+
+// EmitExecuteEvent emits the Execute event.
+func (p Precompile) EmitExecuteEvent(ctx sdk.Context, stateDB vm.StateDB, signer common.Address, msgTypeURL string) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeExecute], signer, msgTypeURL)
+}
@@ -0,0 +1,37 @@
+package msgrouter
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// IsAllowedMethod defines the ABI method name for the message router IsAllowed query.
+	IsAllowedMethod = "isAllowed"
+)
+
+// IsAllowed returns true if the message of the given type URL can be executed through
+// the precompile.
+func (p *Precompile) IsAllowed(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	msgTypeURL, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "msgTypeUrl", "", args[0])
+	}
+
+	return method.Outputs.Pack(p.evmKeeper.GetParams(ctx).IsAllowedMsgTypeURL(msgTypeURL))
+}
@@ -0,0 +1,102 @@
+package msgrouter
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ExecuteMethod defines the ABI method name for the message router Execute transaction.
+	ExecuteMethod = "execute"
+)
+
+// ExecuteMsg executes the given proto-JSON encoded message signed by the signer, routing it
+// to the message service of its module, and returns the proto-JSON encoded response.
+func (p *Precompile) ExecuteMsg(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	signer, msgJSON, err := ParseExecuteArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != signer {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), signer.String())
+	}
+
+	var msg sdk.Msg
+	if err := p.codec.UnmarshalInterfaceJSON([]byte(msgJSON), &msg); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsg, err)
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if !p.evmKeeper.GetParams(ctx).IsAllowedMsgTypeURL(msgTypeURL) {
+		return nil, fmt.Errorf(ErrMsgNotAllowed, msgTypeURL)
+	}
+
+	// the message must only be signed by the caller
+	signers, _, err := p.codec.GetMsgV1Signers(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf(ErrInvalidSigners, msgTypeURL)
+	}
+	for _, s := range signers {
+		if !bytes.Equal(s, signer.Bytes()) {
+			return nil, fmt.Errorf(ErrInvalidSigners, msgTypeURL)
+		}
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	handler := p.msgRouter.Handler(msg)
+	if handler == nil {
+		return nil, fmt.Errorf(ErrNoHandler, msgTypeURL)
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// the events of the message are emitted so that the balance changes are
+	// tracked in the stateDB
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	var responseJSON []byte
+	if len(res.MsgResponses) > 0 {
+		response, ok := res.MsgResponses[0].GetCachedValue().(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf(ErrInvalidMsgResponse, msgTypeURL)
+		}
+
+		responseJSON, err = p.codec.MarshalInterfaceJSON(response)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = p.EmitExecuteEvent(ctx, stateDB, signer, msgTypeURL); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(string(responseJSON))
+}
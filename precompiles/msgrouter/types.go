@@ -0,0 +1,29 @@
+package msgrouter
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+// ParseExecuteArgs parses the signer and the proto-JSON encoded message of the execute
+// transaction.
+func ParseExecuteArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	signer, ok := args[0].(common.Address)
+	if !ok || signer == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidSigner, args[0])
+	}
+
+	msgJSON, ok := args[1].(string)
+	if !ok || msgJSON == "" {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidMsg, args[1])
+	}
+
+	return signer, msgJSON, nil
+}
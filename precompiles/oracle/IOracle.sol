@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IOracle contract's address.
+address constant ORACLE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080c;
+
+/// @dev The IOracle contract's instance.
+IOracle constant ORACLE_CONTRACT = IOracle(ORACLE_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title Oracle Precompiled Contract
+/// @dev The interface through which solidity contracts read the prices provided by the
+/// oracle module of the chain, e.g. x/oracle or a Connect (Slinky) sidecar.
+/// @custom:address 0x000000000000000000000000000000000000080c
+interface IOracle {
+    /// @dev Returns the latest price of a currency pair.
+    /// @param base The base asset of the currency pair, e.g. "BTC"
+    /// @param quote The quote asset of the currency pair, e.g. "USD"
+    /// @return price The price of one unit of the base asset in the quote asset, scaled by 10^decimals
+    /// @return decimals The number of decimals of the price
+    /// @return timestamp The unix time in seconds of the last price update
+    function latestPrice(
+        string calldata base,
+        string calldata quote
+    ) external view returns (uint256 price, uint8 decimals, int64 timestamp);
+
+    /// @dev Returns the number of decimals of the price of a currency pair.
+    /// @param base The base asset of the currency pair
+    /// @param quote The quote asset of the currency pair
+    /// @return decimals The number of decimals of the price
+    function decimals(
+        string calldata base,
+        string calldata quote
+    ) external view returns (uint8 decimals);
+
+    /// @dev Returns the time of the last price update of a currency pair.
+    /// @param base The base asset of the currency pair
+    /// @param quote The quote asset of the currency pair
+    /// @return timestamp The unix time in seconds of the last price update
+    function timestamp(
+        string calldata base,
+        string calldata quote
+    ) external view returns (int64 timestamp);
+}
@@ -0,0 +1,10 @@
+package oracle
+
+const (
+	// ErrInvalidAsset is raised when the base or quote asset of the currency pair is invalid.
+	ErrInvalidAsset = "invalid %s asset: %v"
+	// ErrPriceNotFound is raised when the oracle keeper has no price for the currency pair.
+	ErrPriceNotFound = "price not found for %s/%s: %v"
+	// ErrInvalidPrice is raised when the oracle keeper returns a negative price.
+	ErrInvalidPrice = "invalid price: %s"
+)
@@ -0,0 +1,80 @@
+package oracle
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// LatestPriceMethod defines the ABI method name for the oracle LatestPrice query.
+	LatestPriceMethod = "latestPrice"
+	// DecimalsMethod defines the ABI method name for the oracle Decimals query.
+	DecimalsMethod = "decimals"
+	// TimestampMethod defines the ABI method name for the oracle Timestamp query.
+	TimestampMethod = "timestamp"
+)
+
+// LatestPrice returns the latest price of a currency pair, with its number of decimals
+// and the time of its last update.
+func (p Precompile) LatestPrice(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	price, err := p.getPrice(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(price.Price, price.Decimals, price.Timestamp)
+}
+
+// Decimals returns the number of decimals of the price of a currency pair.
+func (p Precompile) Decimals(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	price, err := p.getPrice(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(price.Decimals)
+}
+
+// Timestamp returns the time of the last price update of a currency pair.
+func (p Precompile) Timestamp(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	price, err := p.getPrice(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(price.Timestamp)
+}
+
+// getPrice parses the currency pair and returns its price from the oracle keeper.
+func (p Precompile) getPrice(ctx sdk.Context, args []interface{}) (Price, error) {
+	base, quote, err := ParseCurrencyPairArgs(args)
+	if err != nil {
+		return Price{}, err
+	}
+
+	price, decimals, timestamp, err := p.oracleKeeper.GetPrice(ctx, base, quote)
+	if err != nil {
+		return Price{}, fmt.Errorf(ErrPriceNotFound, base, quote, err)
+	}
+
+	return NewPrice(price, decimals, timestamp)
+}
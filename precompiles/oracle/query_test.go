@@ -0,0 +1,65 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockOracleKeeper is an oracle keeper with a fixed set of prices.
+type mockOracleKeeper struct {
+	prices map[string]math.Int
+}
+
+func (k mockOracleKeeper) GetPrice(_ sdk.Context, base, quote string) (math.Int, uint8, time.Time, error) {
+	price, ok := k.prices[base+"/"+quote]
+	if !ok {
+		return math.Int{}, 0, time.Time{}, errors.New("currency pair not found")
+	}
+	return price, 8, time.Unix(1_700_000_000, 0), nil
+}
+
+func TestQueries(t *testing.T) {
+	p := NewPrecompile(mockOracleKeeper{
+		prices: map[string]math.Int{
+			"BTC/USD": math.NewInt(6_500_000_000_000),
+			"ETH/USD": math.NewInt(-1),
+		},
+	})
+	ctx := sdk.Context{}
+
+	method := p.Methods[LatestPriceMethod]
+	bz, err := p.LatestPrice(ctx, &method, nil, []interface{}{"BTC", "USD"})
+	require.NoError(t, err)
+	out, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{big.NewInt(6_500_000_000_000), uint8(8), int64(1_700_000_000)}, out)
+
+	method = p.Methods[DecimalsMethod]
+	bz, err = p.Decimals(ctx, &method, nil, []interface{}{"BTC", "USD"})
+	require.NoError(t, err)
+	out, err = method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint8(8)}, out)
+
+	method = p.Methods[TimestampMethod]
+	bz, err = p.Timestamp(ctx, &method, nil, []interface{}{"BTC", "USD"})
+	require.NoError(t, err)
+	out, err = method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1_700_000_000)}, out)
+
+	_, err = p.LatestPrice(ctx, &method, nil, []interface{}{"ATOM", "USD"})
+	require.ErrorContains(t, err, fmt.Sprintf(ErrPriceNotFound, "ATOM", "USD", "currency pair not found"))
+
+	_, err = p.LatestPrice(ctx, &method, nil, []interface{}{"ETH", "USD"})
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidPrice, "-1"))
+}
@@ -0,0 +1,51 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+)
+
+// Price is the price of a currency pair as returned by the oracle queries.
+type Price struct {
+	Price     *big.Int
+	Decimals  uint8
+	Timestamp int64
+}
+
+// NewPrice creates a new Price from the values returned by the oracle keeper.
+func NewPrice(price math.Int, decimals uint8, timestamp time.Time) (Price, error) {
+	if price.IsNil() || price.IsNegative() {
+		return Price{}, fmt.Errorf(ErrInvalidPrice, price)
+	}
+
+	return Price{
+		Price:     price.BigInt(),
+		Decimals:  decimals,
+		Timestamp: timestamp.UTC().Unix(),
+	}, nil
+}
+
+// ParseCurrencyPairArgs parses the base and quote assets of the currency pair of the
+// oracle queries.
+func ParseCurrencyPairArgs(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	base, ok := args[0].(string)
+	if !ok || base == "" {
+		return "", "", fmt.Errorf(ErrInvalidAsset, "base", args[0])
+	}
+
+	quote, ok := args[1].(string)
+	if !ok || quote == "" {
+		return "", "", fmt.Errorf(ErrInvalidAsset, "quote", args[1])
+	}
+
+	return base, quote, nil
+}
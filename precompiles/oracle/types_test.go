@@ -0,0 +1,78 @@
+package oracle
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+)
+
+func TestParseCurrencyPairArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []any
+		wantErr  bool
+		errMsg   string
+		expBase  string
+		expQuote string
+	}{
+		{
+			name:     "valid args",
+			args:     []any{"BTC", "USD"},
+			expBase:  "BTC",
+			expQuote: "USD",
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []any{"BTC"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			name:    "empty base",
+			args:    []any{"", "USD"},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAsset, "base", ""),
+		},
+		{
+			name:    "invalid quote type",
+			args:    []any{"BTC", 1},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(ErrInvalidAsset, "quote", 1),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			base, quote, err := ParseCurrencyPairArgs(tc.args)
+			if tc.wantErr {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expBase, base)
+			require.Equal(t, tc.expQuote, quote)
+		})
+	}
+}
+
+func TestNewPrice(t *testing.T) {
+	timestamp := time.Unix(1_700_000_000, 0)
+
+	price, err := NewPrice(math.NewInt(6_500_000_000_000), 8, timestamp)
+	require.NoError(t, err)
+	require.Equal(t, Price{Price: big.NewInt(6_500_000_000_000), Decimals: 8, Timestamp: 1_700_000_000}, price)
+
+	_, err = NewPrice(math.NewInt(-1), 8, timestamp)
+	require.ErrorContains(t, err, fmt.Sprintf(ErrInvalidPrice, "-1"))
+
+	_, err = NewPrice(math.Int{}, 8, timestamp)
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The IRevenue contract's address.
+address constant REVENUE_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080e;
+
+/// @dev The IRevenue contract's instance.
+IRevenue constant REVENUE_CONTRACT = IRevenue(REVENUE_PRECOMPILE_ADDRESS);
+
+/// @dev Revenue defines the fee distribution conditions of a registered contract.
+/// @param contractAddress The address of the registered contract
+/// @param deployerAddress The address of the contract deployer
+/// @param withdrawerAddress The address receiving the share of the transaction fees
+struct Revenue {
+    address contractAddress;
+    address deployerAddress;
+    address withdrawerAddress;
+}
+
+/// @author Evmos Team
+/// @title Revenue Precompiled Contract
+/// @dev The interface through which solidity contracts register the contracts they
+/// deployed to receive a share of the fees of the transactions interacting with them.
+/// @custom:address 0x000000000000000000000000000000000000080e
+interface IRevenue {
+    /// @dev RegisterRevenue defines an Event emitted when a contract is registered.
+    /// @param deployerAddress The address of the contract deployer
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawerAddress The address receiving the transaction fees
+    event RegisterRevenue(
+        address indexed deployerAddress,
+        address indexed contractAddress,
+        address withdrawerAddress
+    );
+
+    /// @dev UpdateRevenue defines an Event emitted when the withdrawer of a contract is updated.
+    /// @param deployerAddress The address of the contract deployer
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawerAddress The new address receiving the transaction fees
+    event UpdateRevenue(
+        address indexed deployerAddress,
+        address indexed contractAddress,
+        address withdrawerAddress
+    );
+
+    /// @dev CancelRevenue defines an Event emitted when a contract registration is canceled.
+    /// @param deployerAddress The address of the contract deployer
+    /// @param contractAddress The address of the canceled contract
+    event CancelRevenue(
+        address indexed deployerAddress,
+        address indexed contractAddress
+    );
+
+    /// @dev Registers a contract deployed by the caller to receive a share of the transaction fees.
+    /// @param contractAddress The address of the contract to register
+    /// @param withdrawerAddress The address receiving the transaction fees, the caller if zero
+    /// @param nonces The nonces of the deployment path from the caller to the contract, where
+    /// the last nonce is the one of the account that created the contract
+    /// @return success Whether the registration succeeded
+    function registerRevenue(
+        address contractAddress,
+        address withdrawerAddress,
+        uint64[] calldata nonces
+    ) external returns (bool success);
+
+    /// @dev Updates the withdrawer address of a contract registered by the caller.
+    /// @param contractAddress The address of the registered contract
+    /// @param withdrawerAddress The new address receiving the transaction fees
+    /// @return success Whether the update succeeded
+    function updateRevenue(
+        address contractAddress,
+        address withdrawerAddress
+    ) external returns (bool success);
+
+    /// @dev Cancels the registration of a contract registered by the caller.
+    /// @param contractAddress The address of the registered contract
+    /// @return success Whether the cancellation succeeded
+    function cancelRevenue(
+        address contractAddress
+    ) external returns (bool success);
+
+    /// @dev Returns the fee distribution conditions of a contract. All the addresses are zero
+    /// if the contract is not registered.
+    /// @param contractAddress The address of the contract
+    /// @return revenue The fee distribution conditions of the contract
+    function revenue(
+        address contractAddress
+    ) external view returns (Revenue memory revenue);
+}
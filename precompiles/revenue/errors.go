@@ -0,0 +1,10 @@
+package revenue
+
+const (
+	// ErrInvalidContract is raised when the contract address is invalid.
+	ErrInvalidContract = "invalid contract address: %v"
+	// ErrInvalidWithdrawer is raised when the withdrawer address is invalid.
+	ErrInvalidWithdrawer = "invalid withdrawer address: %v"
+	// ErrInvalidNonces is raised when the nonces are invalid.
+	ErrInvalidNonces = "invalid nonces: %v"
+)
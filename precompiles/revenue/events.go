@@ -0,0 +1,52 @@
+package revenue
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeRegisterRevenue defines the event type for the revenue RegisterRevenue transaction.
+	EventTypeRegisterRevenue = "RegisterRevenue"
+	// EventTypeUpdateRevenue defines the event type for the revenue UpdateRevenue transaction.
+	EventTypeUpdateRevenue = "UpdateRevenue"
+	// EventTypeCancelRevenue defines the event type for the revenue CancelRevenue transaction.
+	EventTypeCancelRevenue = "CancelRevenue"
+)
+
+// EventRegisterRevenue is the event emitted on a RegisterRevenue transaction.
+type EventRegisterRevenue struct {
+	DeployerAddress   common.Address
+	ContractAddress   common.Address
+	WithdrawerAddress common.Address
+}
+
+// EventUpdateRevenue is the event emitted on an UpdateRevenue transaction.
+type EventUpdateRevenue struct {
+	DeployerAddress   common.Address
+	ContractAddress   common.Address
+	WithdrawerAddress common.Address
+}
+
+// EventCancelRevenue is the event emitted on a CancelRevenue transaction.
+type EventCancelRevenue struct {
+	DeployerAddress common.Address
+	ContractAddress common.Address
+}
+
+// EmitRegisterRevenueEvent emits the RegisterRevenue event.
+func (p Precompile) EmitRegisterRevenueEvent(ctx sdk.Context, stateDB vm.StateDB, deployer, contract, withdrawer common.Address) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeRegisterRevenue], deployer, contract, withdrawer)
+}
+
+// EmitUpdateRevenueEvent emits the UpdateRevenue event.
+func (p Precompile) EmitUpdateRevenueEvent(ctx sdk.Context, stateDB vm.StateDB, deployer, contract, withdrawer common.Address) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeUpdateRevenue], deployer, contract, withdrawer)
+}
+
+// EmitCancelRevenueEvent emits the CancelRevenue event.
+func (p Precompile) EmitCancelRevenueEvent(ctx sdk.Context, stateDB vm.StateDB, deployer, contract common.Address) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeCancelRevenue], deployer, contract)
+}
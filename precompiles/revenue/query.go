@@ -0,0 +1,34 @@
+package revenue
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RevenueMethod defines the ABI method name for the revenue Revenue query.
+	RevenueMethod = "revenue"
+)
+
+// Revenue returns the fee distribution conditions of the given contract. All the
+// addresses are zero if the contract is not registered.
+func (p *Precompile) Revenue(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, err := ParseContractArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue, found := p.revenueKeeper.GetRevenue(ctx, contractAddr)
+	if !found {
+		return method.Outputs.Pack(RevenueOutput{})
+	}
+
+	return method.Outputs.Pack(NewRevenueOutput(revenue))
+}
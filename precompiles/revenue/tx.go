@@ -0,0 +1,117 @@
+package revenue
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	revenuetypes "github.com/cosmos/evm/x/revenue/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RegisterRevenueMethod defines the ABI method name for the revenue RegisterRevenue transaction.
+	RegisterRevenueMethod = "registerRevenue"
+	// UpdateRevenueMethod defines the ABI method name for the revenue UpdateRevenue transaction.
+	UpdateRevenueMethod = "updateRevenue"
+	// CancelRevenueMethod defines the ABI method name for the revenue CancelRevenue transaction.
+	CancelRevenueMethod = "cancelRevenue"
+)
+
+// RegisterRevenue registers a contract deployed by the caller to receive a share
+// of the fees of the transactions interacting with it. The fees are sent to the
+// caller if the withdrawer address is zero.
+func (p *Precompile) RegisterRevenue(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, withdrawer, nonces, err := ParseRegisterRevenueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	deployer := contract.Caller()
+	if withdrawer == (common.Address{}) {
+		withdrawer = deployer
+	}
+
+	msg := revenuetypes.NewMsgRegisterRevenue(contractAddr, deployer.Bytes(), withdrawer.Bytes(), nonces)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.revenueKeeper.RegisterRevenue(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterRevenueEvent(ctx, stateDB, deployer, contractAddr, withdrawer); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UpdateRevenue updates the withdrawer address of a contract registered by the
+// caller.
+func (p *Precompile) UpdateRevenue(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, withdrawer, err := ParseUpdateRevenueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	deployer := contract.Caller()
+	msg := revenuetypes.NewMsgUpdateRevenue(contractAddr, deployer.Bytes(), withdrawer.Bytes())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.revenueKeeper.UpdateRevenue(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitUpdateRevenueEvent(ctx, stateDB, deployer, contractAddr, withdrawer); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CancelRevenue cancels the registration of a contract registered by the caller.
+func (p *Precompile) CancelRevenue(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, err := ParseContractArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	deployer := contract.Caller()
+	msg := revenuetypes.NewMsgCancelRevenue(contractAddr, deployer.Bytes())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.revenueKeeper.CancelRevenue(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCancelRevenueEvent(ctx, stateDB, deployer, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,92 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	revenuetypes "github.com/cosmos/evm/x/revenue/types"
+)
+
+// RevenueOutput defines the fee distribution conditions of a registered contract
+// returned by the revenue query.
+type RevenueOutput struct {
+	ContractAddress   common.Address
+	DeployerAddress   common.Address
+	WithdrawerAddress common.Address
+}
+
+// NewRevenueOutput returns the revenue query output of the given revenue.
+func NewRevenueOutput(revenue revenuetypes.Revenue) RevenueOutput {
+	return RevenueOutput{
+		ContractAddress:   revenue.GetContractAddr(),
+		DeployerAddress:   common.BytesToAddress(revenue.GetDeployerAddr()),
+		WithdrawerAddress: common.BytesToAddress(revenue.GetWithdrawerAddr()),
+	}
+}
+
+// ParseRegisterRevenueArgs parses the contract address, the optional withdrawer
+// address and the deployment nonces of the registerRevenue transaction.
+func ParseRegisterRevenueArgs(args []interface{}) (common.Address, common.Address, []uint64, error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	contract, err := parseContract(args[0])
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, err
+	}
+
+	withdrawer, ok := args[1].(common.Address)
+	if !ok {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(ErrInvalidWithdrawer, args[1])
+	}
+
+	nonces, ok := args[2].([]uint64)
+	if !ok || len(nonces) == 0 {
+		return common.Address{}, common.Address{}, nil, fmt.Errorf(ErrInvalidNonces, args[2])
+	}
+
+	return contract, withdrawer, nonces, nil
+}
+
+// ParseUpdateRevenueArgs parses the contract address and the new withdrawer
+// address of the updateRevenue transaction.
+func ParseUpdateRevenueArgs(args []interface{}) (common.Address, common.Address, error) {
+	if len(args) != 2 {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	contract, err := parseContract(args[0])
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+
+	withdrawer, ok := args[1].(common.Address)
+	if !ok || withdrawer == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidWithdrawer, args[1])
+	}
+
+	return contract, withdrawer, nil
+}
+
+// ParseContractArgs parses the contract address of the cancelRevenue transaction
+// and of the revenue query.
+func ParseContractArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	return parseContract(args[0])
+}
+
+// parseContract parses a non-zero contract address argument.
+func parseContract(arg interface{}) (common.Address, error) {
+	contract, ok := arg.(common.Address)
+	if !ok || contract == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidContract, arg)
+	}
+
+	return contract, nil
+}
@@ -26,6 +26,21 @@ struct SigningInfo {
     int64 missedBlocksCounter;
 }
 
+/// @dev Equivocation defines the evidence of a validator double signing,
+/// submitted to the evidence module.
+struct Equivocation {
+    /// @dev Hash of the evidence
+    bytes32 hash;
+    /// @dev Height at which the validator double signed
+    int64 height;
+    /// @dev Timestamp at which the validator double signed
+    int64 time;
+    /// @dev Voting power of the validator at the time of the infraction
+    int64 power;
+    /// @dev Consensus address of the validator
+    address consensusAddress;
+}
+
 /// @dev Params defines the parameters for the slashing module.
 struct Params {
     /// @dev SignedBlocksWindow defines how many blocks the validator should have signed
@@ -74,4 +89,19 @@ interface ISlashing {
     /// @dev GetParams returns the slashing module parameters
     /// @return params The slashing module parameters
     function getParams() external view returns (Params memory params);
+
+    /// @dev GetEvidence returns the evidence of the given hash from the evidence module.
+    /// @param evidenceHash The hash of the evidence
+    /// @return evidence The evidence
+    function getEvidence(
+        bytes32 evidenceHash
+    ) external view returns (Equivocation memory evidence);
+
+    /// @dev GetAllEvidence returns all the evidence from the evidence module.
+    /// @param pagination Pagination configuration for the query
+    /// @return evidence The list of evidence
+    /// @return pageResponse Pagination information for the response
+    function getAllEvidence(
+        PageRequest calldata pagination
+    ) external view returns (Equivocation[] memory evidence, PageResponse memory pageResponse);
 }
@@ -15,6 +15,10 @@ const (
 	GetSigningInfosMethod = "getSigningInfos"
 	// GetParamsMethod defines the ABI method name for the slashing Params query
 	GetParamsMethod = "getParams"
+	// GetEvidenceMethod defines the ABI method name for the evidence Evidence query
+	GetEvidenceMethod = "getEvidence"
+	// GetAllEvidenceMethod defines the ABI method name for the evidence AllEvidence query
+	GetAllEvidenceMethod = "getAllEvidence"
 )
 
 // GetSigningInfo handles the `getSigningInfo` precompile call.
@@ -83,3 +87,52 @@ func (p *Precompile) GetParams(
 	out := new(ParamsOutput).FromResponse(res)
 	return method.Outputs.Pack(out.Params)
 }
+
+// GetEvidence implements the query to get the evidence of the given hash from the
+// evidence module.
+func (p *Precompile) GetEvidence(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseEvidenceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.evidenceKeeper.Evidence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(EvidenceOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Evidence)
+}
+
+// GetAllEvidence implements the query to get all the evidence from the evidence module.
+func (p *Precompile) GetAllEvidence(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := ParseAllEvidenceArgs(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.evidenceKeeper.AllEvidence(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := new(AllEvidenceOutput).FromResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(out.Evidence, out.PageResponse)
+}
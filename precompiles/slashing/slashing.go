@@ -45,6 +45,7 @@ type Precompile struct {
 	abi.ABI
 	slashingKeeper    cmn.SlashingKeeper
 	slashingMsgServer slashingtypes.MsgServer
+	evidenceKeeper    cmn.EvidenceKeeper
 	consCodec         runtime.ConsensusAddressCodec
 	valCodec          runtime.ValidatorAddressCodec
 }
@@ -54,6 +55,7 @@ type Precompile struct {
 func NewPrecompile(
 	slashingKeeper cmn.SlashingKeeper,
 	slashingMsgServer slashingtypes.MsgServer,
+	evidenceKeeper cmn.EvidenceKeeper,
 	bankKeeper cmn.BankKeeper,
 	valCdc, consCdc address.Codec,
 ) *Precompile {
@@ -67,6 +69,7 @@ func NewPrecompile(
 		ABI:               ABI,
 		slashingKeeper:    slashingKeeper,
 		slashingMsgServer: slashingMsgServer,
+		evidenceKeeper:    evidenceKeeper,
 		valCodec:          valCdc,
 		consCodec:         consCdc,
 	}
@@ -114,6 +117,11 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 		bz, err = p.GetSigningInfos(ctx, method, contract, args)
 	case GetParamsMethod:
 		bz, err = p.GetParams(ctx, method, contract, args)
+	// evidence queries
+	case GetEvidenceMethod:
+		bz, err = p.GetEvidence(ctx, method, contract, args)
+	case GetAllEvidenceMethod:
+		bz, err = p.GetAllEvidence(ctx, method, contract, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
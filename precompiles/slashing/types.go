@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -10,7 +11,9 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
+	evidencetypes "cosmossdk.io/x/evidence/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -121,6 +124,112 @@ func (sio *SigningInfosOutput) FromResponse(res *slashingtypes.QuerySigningInfos
 	return sio, nil
 }
 
+// Equivocation represents the evidence of a validator double signing
+type Equivocation struct {
+	Hash             common.Hash    `abi:"hash"`
+	Height           int64          `abi:"height"`
+	Time             int64          `abi:"time"`
+	Power            int64          `abi:"power"`
+	ConsensusAddress common.Address `abi:"consensusAddress"`
+}
+
+// EvidenceOutput represents the output of the evidence query
+type EvidenceOutput struct {
+	Evidence Equivocation
+}
+
+// AllEvidenceOutput represents the output of the all evidence query
+type AllEvidenceOutput struct {
+	Evidence     []Equivocation     `abi:"evidence"`
+	PageResponse query.PageResponse `abi:"pageResponse"`
+}
+
+// AllEvidenceInput represents the input for the all evidence query
+type AllEvidenceInput struct {
+	Pagination query.PageRequest `abi:"pagination"`
+}
+
+// ParseEvidenceArgs parses the arguments for the evidence query
+func ParseEvidenceArgs(args []interface{}) (*evidencetypes.QueryEvidenceRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	hash, ok := args[0].([32]byte)
+	if !ok || hash == [32]byte{} {
+		return nil, fmt.Errorf("invalid evidence hash")
+	}
+
+	return &evidencetypes.QueryEvidenceRequest{
+		Hash: hex.EncodeToString(hash[:]),
+	}, nil
+}
+
+// ParseAllEvidenceArgs parses the arguments for the all evidence query
+func ParseAllEvidenceArgs(method *abi.Method, args []interface{}) (*evidencetypes.QueryAllEvidenceRequest, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	var input AllEvidenceInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to AllEvidenceInput: %s", err)
+	}
+
+	return &evidencetypes.QueryAllEvidenceRequest{
+		Pagination: &input.Pagination,
+	}, nil
+}
+
+// NewEquivocation creates the Equivocation output from the given evidence, only
+// equivocations being supported.
+func NewEquivocation(evidence *codectypes.Any) (Equivocation, error) {
+	equivocation, ok := evidence.GetCachedValue().(*evidencetypes.Equivocation)
+	if !ok {
+		return Equivocation{}, fmt.Errorf("unsupported evidence type: %s", evidence.TypeUrl)
+	}
+
+	consAddr, err := types.ConsAddressFromBech32(equivocation.ConsensusAddress)
+	if err != nil {
+		return Equivocation{}, fmt.Errorf("error parsing consensus address: %w", err)
+	}
+
+	return Equivocation{
+		Hash:             common.BytesToHash(equivocation.Hash()),
+		Height:           equivocation.Height,
+		Time:             equivocation.Time.Unix(),
+		Power:            equivocation.Power,
+		ConsensusAddress: common.BytesToAddress(consAddr.Bytes()),
+	}, nil
+}
+
+func (eo *EvidenceOutput) FromResponse(res *evidencetypes.QueryEvidenceResponse) (*EvidenceOutput, error) {
+	evidence, err := NewEquivocation(res.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	eo.Evidence = evidence
+	return eo, nil
+}
+
+func (aeo *AllEvidenceOutput) FromResponse(res *evidencetypes.QueryAllEvidenceResponse) (*AllEvidenceOutput, error) {
+	aeo.Evidence = make([]Equivocation, len(res.Evidence))
+	for i, evidence := range res.Evidence {
+		equivocation, err := NewEquivocation(evidence)
+		if err != nil {
+			return nil, err
+		}
+		aeo.Evidence[i] = equivocation
+	}
+	if res.Pagination != nil {
+		aeo.PageResponse = query.PageResponse{
+			NextKey: res.Pagination.NextKey,
+			Total:   res.Pagination.Total,
+		}
+	}
+	return aeo, nil
+}
+
 // ValidatorUnjailed defines the data structure for the ValidatorUnjailed event.
 type ValidatorUnjailed struct {
 	Validator common.Address
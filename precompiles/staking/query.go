@@ -11,6 +11,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -32,6 +33,12 @@ const (
 	// RedelegationsMethod defines the ABI method name for the staking
 	// Redelegations query.
 	RedelegationsMethod = "redelegations"
+	// DelegatorUnbondingDelegationsMethod defines the ABI method name for the staking
+	// DelegatorUnbondingDelegations query.
+	DelegatorUnbondingDelegationsMethod = "delegatorUnbondingDelegations"
+	// CompletionTimesMethod defines the ABI method name for the staking
+	// CompletionTimes query.
+	CompletionTimesMethod = "completionTimes"
 )
 
 // Delegation returns the delegation that a delegator has with a specific validator.
@@ -185,3 +192,64 @@ func (p Precompile) Redelegations(
 
 	return out.Pack(method.Outputs)
 }
+
+// DelegatorUnbondingDelegations returns all the unbonding delegations of a delegator
+// with pagination.
+func (p Precompile) DelegatorUnbondingDelegations(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	req, err := NewDelegatorUnbondingDelegationsRequest(method, args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.stakingQuerier.DelegatorUnbondingDelegations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(DelegatorUnbondingDelegationsOutput).FromResponse(res)
+
+	return out.Pack(method.Outputs)
+}
+
+// CompletionTimes returns the latest completion times of the unbonding delegations and
+// redelegations of a delegator. A zero value is returned when there are no entries.
+func (p Precompile) CompletionTimes(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	delegatorAddr, err := NewCompletionTimesRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var unbondingCompletionTime, redelegationCompletionTime int64
+
+	err = p.stakingKeeper.IterateDelegatorUnbondingDelegations(ctx, delegatorAddr, func(ubd stakingtypes.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			unbondingCompletionTime = max(unbondingCompletionTime, entry.CompletionTime.UTC().Unix())
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.stakingKeeper.IterateDelegatorRedelegations(ctx, delegatorAddr, func(red stakingtypes.Redelegation) bool {
+		for _, entry := range red.Entries {
+			redelegationCompletionTime = max(redelegationCompletionTime, entry.CompletionTime.UTC().Unix())
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(unbondingCompletionTime, redelegationCompletionTime)
+}
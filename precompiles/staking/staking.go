@@ -27,6 +27,9 @@ var (
 	//go:embed abi.json
 	f   embed.FS
 	ABI abi.ABI
+
+	// cacheableQueries are the IDs of the queries memoized within a block.
+	cacheableQueries map[string]bool
 )
 
 func init() {
@@ -35,6 +38,7 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	cacheableQueries = cmn.NewCacheableQueries(ABI, Precompile{}.IsTransaction)
 }
 
 // Precompile defines the precompiled contract for staking.
@@ -63,6 +67,7 @@ func NewPrecompile(
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ContractAddress:      common.HexToAddress(evmtypes.StakingPrecompileAddress),
 			BalanceHandler:       cmn.NewBalanceHandler(bankKeeper),
+			CacheableQueries:     cacheableQueries,
 		},
 		ABI:              ABI,
 		stakingKeeper:    stakingKeeper,
@@ -131,6 +136,10 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 		bz, err = p.Redelegation(ctx, method, contract, args)
 	case RedelegationsMethod:
 		bz, err = p.Redelegations(ctx, method, contract, args)
+	case DelegatorUnbondingDelegationsMethod:
+		bz, err = p.DelegatorUnbondingDelegations(ctx, method, contract, args)
+	case CompletionTimesMethod:
+		bz, err = p.CompletionTimes(ctx, method, contract, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -899,3 +899,85 @@ func FormatConsensusPubkey(consensusPubkey *codectypes.Any) string {
 	}
 	return consensusPubkey.String()
 }
+
+// DelegatorUnbondingDelegationsInput is a struct to represent the input information for
+// the delegator unbonding delegations query. Needed to unpack arguments into the
+// PageRequest struct.
+type DelegatorUnbondingDelegationsInput struct {
+	DelegatorAddress common.Address
+	PageRequest      query.PageRequest
+}
+
+// NewDelegatorUnbondingDelegationsRequest creates a new QueryDelegatorUnbondingDelegationsRequest
+// instance and does sanity checks on the given arguments before populating the request.
+func NewDelegatorUnbondingDelegationsRequest(method *abi.Method, args []interface{}, addrCdc address.Codec) (*stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	var input DelegatorUnbondingDelegationsInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to DelegatorUnbondingDelegationsInput struct: %s", err)
+	}
+
+	if input.DelegatorAddress == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, input.DelegatorAddress)
+	}
+
+	delegatorAddr, err := addrCdc.BytesToString(input.DelegatorAddress.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode delegator address: %w", err)
+	}
+
+	if bytes.Equal(input.PageRequest.Key, []byte{0}) {
+		input.PageRequest.Key = nil
+	}
+
+	return &stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
+		DelegatorAddr: delegatorAddr,
+		Pagination:    &input.PageRequest,
+	}, nil
+}
+
+// DelegatorUnbondingDelegationsOutput is a struct to represent the key information from
+// a delegator unbonding delegations response.
+type DelegatorUnbondingDelegationsOutput struct {
+	UnbondingDelegations []UnbondingDelegationResponse
+	PageResponse         query.PageResponse
+}
+
+// FromResponse populates the DelegatorUnbondingDelegationsOutput from a
+// QueryDelegatorUnbondingDelegationsResponse.
+func (do *DelegatorUnbondingDelegationsOutput) FromResponse(res *stakingtypes.QueryDelegatorUnbondingDelegationsResponse) *DelegatorUnbondingDelegationsOutput {
+	do.UnbondingDelegations = make([]UnbondingDelegationResponse, len(res.UnbondingResponses))
+	for i, ubd := range res.UnbondingResponses {
+		out := new(UnbondingDelegationOutput).FromResponse(&stakingtypes.QueryUnbondingDelegationResponse{Unbond: ubd})
+		do.UnbondingDelegations[i] = out.UnbondingDelegation
+	}
+
+	if res.Pagination != nil {
+		do.PageResponse.Total = res.Pagination.Total
+		do.PageResponse.NextKey = res.Pagination.NextKey
+	}
+
+	return do
+}
+
+// Pack packs a given slice of abi arguments into a byte array.
+func (do *DelegatorUnbondingDelegationsOutput) Pack(args abi.Arguments) ([]byte, error) {
+	return args.Pack(do.UnbondingDelegations, do.PageResponse)
+}
+
+// NewCompletionTimesRequest parses the delegator address of the completion times query.
+func NewCompletionTimesRequest(args []interface{}) (sdk.AccAddress, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	return delegatorAddr.Bytes(), nil
+}
@@ -7,24 +7,37 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	authzprecompile "github.com/cosmos/evm/precompiles/authz"
 	bankprecompile "github.com/cosmos/evm/precompiles/bank"
 	"github.com/cosmos/evm/precompiles/bech32"
+	chaininfoprecompile "github.com/cosmos/evm/precompiles/chaininfo"
 	cmn "github.com/cosmos/evm/precompiles/common"
 	distprecompile "github.com/cosmos/evm/precompiles/distribution"
 	govprecompile "github.com/cosmos/evm/precompiles/gov"
+	ibccoreprecompile "github.com/cosmos/evm/precompiles/ibccore"
+	icacontrollerprecompile "github.com/cosmos/evm/precompiles/icacontroller"
 	ics20precompile "github.com/cosmos/evm/precompiles/ics20"
+	msgrouterprecompile "github.com/cosmos/evm/precompiles/msgrouter"
 	"github.com/cosmos/evm/precompiles/p256"
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
+	vestingprecompile "github.com/cosmos/evm/precompiles/vesting"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	clientkeeper "github.com/cosmos/ibc-go/v10/modules/core/02-client/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v10/modules/core/04-channel/keeper"
 
 	"cosmossdk.io/core/address"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -35,7 +48,7 @@ import (
 // Extend this struct, add a sane default to defaultOptionals, and an Option function to provide users with a non-breaking
 // way to provide custom args to certain precompiles.
 type Optionals struct {
-	AddressCodec       address.Codec // used by gov/staking
+	AddressCodec       address.Codec // used by gov/staking/authz
 	ValidatorAddrCodec address.Codec // used by slashing
 	ConsensusAddrCodec address.Codec // used by slashing
 }
@@ -80,8 +93,17 @@ func DefaultStaticPrecompiles(
 	erc20Keeper *erc20Keeper.Keeper,
 	transferKeeper *transferkeeper.Keeper,
 	channelKeeper *channelkeeper.Keeper,
+	clientKeeper *clientkeeper.Keeper,
+	icaControllerKeeper *icacontrollerkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
 	slashingKeeper slashingkeeper.Keeper,
+	evidenceKeeper *evidencekeeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
+	vestingMsgServer vestingtypes.MsgServer,
+	evmKeeper cmn.EVMKeeper,
+	upgradeKeeper cmn.UpgradeKeeper,
+	msgRouter baseapp.MessageRouter,
 	codec codec.Codec,
 	opts ...Option,
 ) map[common.Address]vm.PrecompiledContract {
@@ -137,11 +159,43 @@ func DefaultStaticPrecompiles(
 	slashingPrecompile := slashingprecompile.NewPrecompile(
 		slashingKeeper,
 		slashingkeeper.NewMsgServerImpl(slashingKeeper),
+		evidencekeeper.NewQuerier(evidenceKeeper),
 		bankKeeper,
 		options.ValidatorAddrCodec,
 		options.ConsensusAddrCodec,
 	)
 
+	icaControllerPrecompile := icacontrollerprecompile.NewPrecompile(
+		icaControllerKeeper,
+		icacontrollerkeeper.NewMsgServerImpl(icaControllerKeeper),
+		bankKeeper,
+		codec,
+	)
+
+	ibcCorePrecompile := ibccoreprecompile.NewPrecompile(channelKeeper, clientKeeper)
+
+	authzPrecompile := authzprecompile.NewPrecompile(
+		authzKeeper,
+		authzKeeper,
+		bankKeeper,
+		options.AddressCodec,
+	)
+
+	vestingPrecompile := vestingprecompile.NewPrecompile(
+		accountKeeper,
+		vestingMsgServer,
+		bankKeeper,
+	)
+
+	msgRouterPrecompile := msgrouterprecompile.NewPrecompile(
+		evmKeeper,
+		msgRouter,
+		bankKeeper,
+		codec,
+	)
+
+	chainInfoPrecompile := chaininfoprecompile.NewPrecompile(evmKeeper, upgradeKeeper)
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -153,6 +207,12 @@ func DefaultStaticPrecompiles(
 	precompiles[bankPrecompile.Address()] = bankPrecompile
 	precompiles[govPrecompile.Address()] = govPrecompile
 	precompiles[slashingPrecompile.Address()] = slashingPrecompile
+	precompiles[icaControllerPrecompile.Address()] = icaControllerPrecompile
+	precompiles[ibcCorePrecompile.Address()] = ibcCorePrecompile
+	precompiles[authzPrecompile.Address()] = authzPrecompile
+	precompiles[vestingPrecompile.Address()] = vestingPrecompile
+	precompiles[msgRouterPrecompile.Address()] = msgRouterPrecompile
+	precompiles[chainInfoPrecompile.Address()] = chainInfoPrecompile
 
 	return precompiles
 }
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IVesting contract's address.
+address constant VESTING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000803;
+
+/// @dev The IVesting contract's instance.
+IVesting constant VESTING_CONTRACT = IVesting(VESTING_PRECOMPILE_ADDRESS);
+
+/// @dev Period defines a vesting period of a periodic vesting account.
+struct Period {
+    /// @dev Duration of the period in seconds
+    int64 length;
+    /// @dev Amount of coins vested at the end of the period
+    Coin[] amount;
+}
+
+/// @author Evmos Team
+/// @title Vesting Precompiled Contract
+/// @dev The interface through which solidity contracts create vesting accounts and query
+/// their vesting schedules using the x/auth/vesting module.
+/// @custom:address 0x0000000000000000000000000000000000000803
+interface IVesting {
+    /// @dev Emitted when a vesting account is created and funded.
+    /// @param funder The address of the account funding the vesting account
+    /// @param vestingAddress The address of the created vesting account
+    /// @param accountType The type of the vesting account, e.g. "continuous"
+    event CreateVestingAccount(
+        address indexed funder,
+        address indexed vestingAddress,
+        string accountType
+    );
+
+    /// @dev Creates a continuous or delayed vesting account, funded by the funder. The coins
+    /// vest linearly from the current block time until the end time, or all at once at the end
+    /// time if delayed.
+    /// @param funder The address of the account funding the vesting account, must be the caller
+    /// @param to The address of the vesting account, which must not exist yet
+    /// @param amount The coins to vest
+    /// @param endTime The unix time in seconds at which all the coins are vested
+    /// @param delayed Whether all the coins vest at once at the end time
+    /// @return success Whether the account was created
+    function createVestingAccount(
+        address funder,
+        address to,
+        Coin[] calldata amount,
+        int64 endTime,
+        bool delayed
+    ) external returns (bool success);
+
+    /// @dev Creates a periodic vesting account, funded by the funder with the sum of the
+    /// amounts of the vesting periods.
+    /// @param funder The address of the account funding the vesting account, must be the caller
+    /// @param to The address of the vesting account, which must not exist yet
+    /// @param startTime The unix time in seconds at which the first period starts
+    /// @param periods The vesting periods, each one starting at the end of the previous one
+    /// @return success Whether the account was created
+    function createPeriodicVestingAccount(
+        address funder,
+        address to,
+        int64 startTime,
+        Period[] calldata periods
+    ) external returns (bool success);
+
+    /// @dev Creates a permanently locked account, funded by the funder. The coins never vest,
+    /// but they can be delegated.
+    /// @param funder The address of the account funding the vesting account, must be the caller
+    /// @param to The address of the locked account, which must not exist yet
+    /// @param amount The coins to lock
+    /// @return success Whether the account was created
+    function createPermanentLockedAccount(
+        address funder,
+        address to,
+        Coin[] calldata amount
+    ) external returns (bool success);
+
+    /// @dev Returns the vesting balances of a vesting account at the current block time.
+    /// @param account The address of the vesting account
+    /// @return locked The coins that cannot be spent, i.e. the vesting coins not delegated
+    /// @return unvested The coins that are still vesting
+    /// @return vested The coins that are already vested
+    function balances(
+        address account
+    ) external view returns (Coin[] memory locked, Coin[] memory unvested, Coin[] memory vested);
+}
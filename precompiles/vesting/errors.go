@@ -0,0 +1,12 @@
+package vesting
+
+const (
+	// ErrAccountNotFound is raised when the queried account does not exist.
+	ErrAccountNotFound = "account %s does not exist"
+	// ErrNotVestingAccount is raised when the queried account is not a vesting account.
+	ErrNotVestingAccount = "account %s is not a vesting account"
+	// ErrInvalidVestingAddress is raised when the address of the vesting account is invalid.
+	ErrInvalidVestingAddress = "invalid vesting account address: %v"
+	// ErrNoVestingPeriods is raised when a periodic vesting account is created without periods.
+	ErrNoVestingPeriods = "no vesting periods"
+)
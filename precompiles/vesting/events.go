@@ -0,0 +1,23 @@
+package vesting
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeCreateVestingAccount defines the event type for the vesting account creation.
+	EventTypeCreateVestingAccount = "CreateVestingAccount"
+)
+
+// EmitCreateVestingAccountEvent emits the CreateVestingAccount event.
+func (p Precompile) EmitCreateVestingAccountEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	funder, vestingAddress common.Address,
+	accountType string,
+) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeCreateVestingAccount], funder, vestingAddress, accountType)
+}
@@ -0,0 +1,49 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+const (
+	// BalancesMethod defines the ABI method name for the vesting Balances query.
+	BalancesMethod = "balances"
+)
+
+// Balances returns the locked, unvested and vested coins of a vesting account at the
+// current block time.
+func (p Precompile) Balances(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	account, err := ParseBalancesArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := p.accountKeeper.GetAccount(ctx, account.Bytes())
+	if acc == nil {
+		return nil, fmt.Errorf(ErrAccountNotFound, account)
+	}
+
+	vestingAcc, ok := acc.(vestingexported.VestingAccount)
+	if !ok {
+		return nil, fmt.Errorf(ErrNotVestingAccount, account)
+	}
+
+	blockTime := ctx.BlockTime()
+	return method.Outputs.Pack(
+		cmn.NewCoinsResponse(vestingAcc.LockedCoins(blockTime)),
+		cmn.NewCoinsResponse(vestingAcc.GetVestingCoins(blockTime)),
+		cmn.NewCoinsResponse(vestingAcc.GetVestedCoins(blockTime)),
+	)
+}
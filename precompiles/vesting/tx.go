@@ -0,0 +1,123 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// CreateVestingAccountMethod defines the ABI method name for the vesting
+	// CreateVestingAccount transaction.
+	CreateVestingAccountMethod = "createVestingAccount"
+	// CreatePeriodicVestingAccountMethod defines the ABI method name for the vesting
+	// CreatePeriodicVestingAccount transaction.
+	CreatePeriodicVestingAccountMethod = "createPeriodicVestingAccount"
+	// CreatePermanentLockedAccountMethod defines the ABI method name for the vesting
+	// CreatePermanentLockedAccount transaction.
+	CreatePermanentLockedAccountMethod = "createPermanentLockedAccount"
+)
+
+// CreateVestingAccount creates a continuous or delayed vesting account funded by the caller.
+func (p Precompile) CreateVestingAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, to, err := NewMsgCreateVestingAccount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkFunder(contract, funder); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.vestingMsgServer.CreateVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	accountType := AccountTypeContinuous
+	if msg.Delayed {
+		accountType = AccountTypeDelayed
+	}
+
+	if err := p.EmitCreateVestingAccountEvent(ctx, stateDB, funder, to, accountType); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePeriodicVestingAccount creates a periodic vesting account funded by the caller.
+func (p Precompile) CreatePeriodicVestingAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, to, err := NewMsgCreatePeriodicVestingAccount(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkFunder(contract, funder); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.vestingMsgServer.CreatePeriodicVestingAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCreateVestingAccountEvent(ctx, stateDB, funder, to, AccountTypePeriodic); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CreatePermanentLockedAccount creates a permanently locked account funded by the caller.
+func (p Precompile) CreatePermanentLockedAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	msg, funder, to, err := NewMsgCreatePermanentLockedAccount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkFunder(contract, funder); err != nil {
+		return nil, err
+	}
+
+	if _, err := p.vestingMsgServer.CreatePermanentLockedAccount(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitCreateVestingAccountEvent(ctx, stateDB, funder, to, AccountTypePermanentLocked); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkFunder checks that the funder of the vesting account is the caller of the precompile.
+func checkFunder(contract *vm.Contract, funder common.Address) error {
+	msgSender := contract.Caller()
+	if msgSender != funder {
+		return fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), funder.String())
+	}
+	return nil
+}
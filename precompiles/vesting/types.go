@@ -0,0 +1,188 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	// AccountTypeContinuous is the type of a continuous vesting account.
+	AccountTypeContinuous = "continuous"
+	// AccountTypeDelayed is the type of a delayed vesting account.
+	AccountTypeDelayed = "delayed"
+	// AccountTypePeriodic is the type of a periodic vesting account.
+	AccountTypePeriodic = "periodic"
+	// AccountTypePermanentLocked is the type of a permanently locked account.
+	AccountTypePermanentLocked = "permanentLocked"
+)
+
+// Period is a vesting period as defined in the Solidity interface.
+type Period struct {
+	Length int64      `abi:"length"`
+	Amount []cmn.Coin `abi:"amount"`
+}
+
+// NewMsgCreateVestingAccount creates a new MsgCreateVestingAccount instance and returns
+// the funder and vesting account addresses.
+func NewMsgCreateVestingAccount(args []interface{}) (*vestingtypes.MsgCreateVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	funder, to, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	amount, err := parseCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	endTime, ok := args[3].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "endTime", int64(0), args[3])
+	}
+
+	delayed, ok := args[4].(bool)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "delayed", false, args[4])
+	}
+
+	msg := &vestingtypes.MsgCreateVestingAccount{
+		FromAddress: sdk.AccAddress(funder.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(to.Bytes()).String(),
+		Amount:      amount,
+		EndTime:     endTime,
+		Delayed:     delayed,
+	}
+
+	return msg, funder, to, nil
+}
+
+// NewMsgCreatePeriodicVestingAccount creates a new MsgCreatePeriodicVestingAccount instance
+// and returns the funder and vesting account addresses.
+func NewMsgCreatePeriodicVestingAccount(method *abi.Method, args []interface{}) (*vestingtypes.MsgCreatePeriodicVestingAccount, common.Address, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	funder, to, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	startTime, ok := args[2].(int64)
+	if !ok {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "startTime", int64(0), args[2])
+	}
+
+	var periods []Period
+	arguments := abi.Arguments{method.Inputs[3]}
+	if err := arguments.Copy(&periods, []interface{}{args[3]}); err != nil {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf("error while unpacking args to Period struct: %s", err)
+	}
+
+	if len(periods) == 0 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(ErrNoVestingPeriods)
+	}
+
+	vestingPeriods := make([]vestingtypes.Period, len(periods))
+	for i, period := range periods {
+		amount, err := cmn.NewSdkCoinsFromCoins(period.Amount)
+		if err != nil {
+			return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, err)
+		}
+
+		vestingPeriods[i] = vestingtypes.Period{
+			Length: period.Length,
+			Amount: amount,
+		}
+	}
+
+	msg := &vestingtypes.MsgCreatePeriodicVestingAccount{
+		FromAddress:    sdk.AccAddress(funder.Bytes()).String(),
+		ToAddress:      sdk.AccAddress(to.Bytes()).String(),
+		StartTime:      startTime,
+		VestingPeriods: vestingPeriods,
+	}
+
+	return msg, funder, to, nil
+}
+
+// NewMsgCreatePermanentLockedAccount creates a new MsgCreatePermanentLockedAccount instance
+// and returns the funder and locked account addresses.
+func NewMsgCreatePermanentLockedAccount(args []interface{}) (*vestingtypes.MsgCreatePermanentLockedAccount, common.Address, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	funder, to, err := parseAddresses(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	amount, err := parseCoins(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
+	}
+
+	msg := &vestingtypes.MsgCreatePermanentLockedAccount{
+		FromAddress: sdk.AccAddress(funder.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(to.Bytes()).String(),
+		Amount:      amount,
+	}
+
+	return msg, funder, to, nil
+}
+
+// ParseBalancesArgs parses the call arguments for the vesting Balances query.
+func ParseBalancesArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok || account == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "account", common.Address{}, args[0])
+	}
+
+	return account, nil
+}
+
+// parseAddresses parses the funder and vesting account addresses of the vesting transactions.
+func parseAddresses(funderArg, toArg interface{}) (common.Address, common.Address, error) {
+	funder, ok := funderArg.(common.Address)
+	if !ok || funder == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "funder", common.Address{}, funderArg)
+	}
+
+	to, ok := toArg.(common.Address)
+	if !ok || to == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidVestingAddress, toArg)
+	}
+
+	return funder, to, nil
+}
+
+// parseCoins parses the coins to vest of the vesting transactions.
+func parseCoins(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	amount, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, err)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,89 @@
+package vesting
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewMsgCreateVestingAccount(t *testing.T) {
+	funder := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	amount := []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(100)}}
+
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expError    bool
+		errContains string
+	}{
+		{"invalid number of args", []interface{}{funder}, true, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 1)},
+		{"invalid funder", []interface{}{common.Address{}, to, amount, int64(1), false}, true, "invalid type for funder"},
+		{"invalid vesting address", []interface{}{funder, common.Address{}, amount, int64(1), false}, true, "invalid vesting account address"},
+		{"invalid amount", []interface{}{funder, to, []cmn.Coin{{Denom: "", Amount: big.NewInt(1)}}, int64(1), false}, true, "invalid amount"},
+		{"invalid end time", []interface{}{funder, to, amount, uint64(1), false}, true, "invalid type for endTime"},
+		{"valid", []interface{}{funder, to, amount, int64(1_700_000_000), true}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, gotFunder, gotTo, err := NewMsgCreateVestingAccount(tc.args)
+			if tc.expError {
+				require.ErrorContains(t, err, tc.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, funder, gotFunder)
+			require.Equal(t, to, gotTo)
+			require.Equal(t, sdk.AccAddress(funder.Bytes()).String(), msg.FromAddress)
+			require.Equal(t, sdk.AccAddress(to.Bytes()).String(), msg.ToAddress)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(100))), msg.Amount)
+			require.Equal(t, int64(1_700_000_000), msg.EndTime)
+			require.True(t, msg.Delayed)
+		})
+	}
+}
+
+func TestNewMsgCreatePeriodicVestingAccount(t *testing.T) {
+	method := ABI.Methods[CreatePeriodicVestingAccountMethod]
+	funder := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+
+	_, _, _, err := NewMsgCreatePeriodicVestingAccount(&method, []interface{}{funder, to, int64(1), []Period{}})
+	require.ErrorContains(t, err, ErrNoVestingPeriods)
+
+	periods := []Period{
+		{Length: 10, Amount: []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(100)}}},
+		{Length: 20, Amount: []cmn.Coin{{Denom: "aatom", Amount: big.NewInt(200)}}},
+	}
+	msg, _, _, err := NewMsgCreatePeriodicVestingAccount(&method, []interface{}{funder, to, int64(1), periods})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), msg.StartTime)
+	require.Len(t, msg.VestingPeriods, 2)
+	require.Equal(t, int64(20), msg.VestingPeriods[1].Length)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("aatom", math.NewInt(200))), msg.VestingPeriods[1].Amount)
+}
+
+func TestParseBalancesArgs(t *testing.T) {
+	account := common.HexToAddress("0x1")
+
+	got, err := ParseBalancesArgs([]interface{}{account})
+	require.NoError(t, err)
+	require.Equal(t, account, got)
+
+	_, err = ParseBalancesArgs([]interface{}{common.Address{}})
+	require.ErrorContains(t, err, "invalid type for account")
+
+	_, err = ParseBalancesArgs([]interface{}{})
+	require.ErrorContains(t, err, fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0))
+}
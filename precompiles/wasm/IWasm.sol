@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+import "../common/Types.sol";
+
+/// @dev The IWasm contract's address.
+address constant WASM_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000080b;
+
+/// @dev The IWasm contract's instance.
+IWasm constant WASM_CONTRACT = IWasm(WASM_PRECOMPILE_ADDRESS);
+
+/// @author Evmos Team
+/// @title CosmWasm Precompiled Contract
+/// @dev The interface through which solidity contracts can instantiate, execute and query
+/// CosmWasm contracts on chains running the x/wasm module.
+/// @custom:address 0x000000000000000000000000000000000000080b
+interface IWasm {
+    /// @dev Instantiate defines an Event emitted when a CosmWasm contract is instantiated.
+    /// @param creator The address of the creator of the contract
+    /// @param codeId The code id of the contract
+    /// @param contractAddress The Bech32 address of the new contract
+    event Instantiate(address indexed creator, uint64 indexed codeId, string contractAddress);
+
+    /// @dev Execute defines an Event emitted when a CosmWasm contract is executed.
+    /// @param caller The address of the caller of the contract
+    /// @param contractAddress The Bech32 address of the executed contract
+    event Execute(address indexed caller, string contractAddress);
+
+    /// @dev Instantiates a CosmWasm contract from a stored code.
+    /// @param creator The address of the creator of the contract, must be the caller
+    /// @param admin The address of the admin of the contract, the zero address for no admin
+    /// @param codeId The code id of the contract
+    /// @param label The label of the contract
+    /// @param msg The JSON encoded instantiate message of the contract
+    /// @param funds The coins sent from the creator to the contract
+    /// @return contractAddress The Bech32 address of the new contract
+    /// @return data The data returned by the contract
+    function instantiate(
+        address creator,
+        address admin,
+        uint64 codeId,
+        string calldata label,
+        bytes calldata msg,
+        Coin[] calldata funds
+    ) external returns (string memory contractAddress, bytes memory data);
+
+    /// @dev Executes a CosmWasm contract.
+    /// @param caller The address of the caller of the contract, must be the caller
+    /// @param contractAddress The Bech32 address of the contract
+    /// @param msg The JSON encoded execute message of the contract
+    /// @param funds The coins sent from the caller to the contract
+    /// @return data The data returned by the contract
+    function execute(
+        address caller,
+        string calldata contractAddress,
+        bytes calldata msg,
+        Coin[] calldata funds
+    ) external returns (bytes memory data);
+
+    /// @dev Queries a CosmWasm contract.
+    /// @param contractAddress The Bech32 address of the contract
+    /// @param msg The JSON encoded query message of the contract
+    /// @return data The JSON encoded response of the contract
+    function smartQuery(
+        string calldata contractAddress,
+        bytes calldata msg
+    ) external view returns (bytes memory data);
+}
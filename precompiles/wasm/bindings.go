@@ -0,0 +1,128 @@
+package wasm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// precompileCallKey is the context key flagging the calls made through the precompile.
+type precompileCallKey struct{}
+
+// EVMKeeper defines the EVM keeper methods used by the wasm bindings.
+type EVMKeeper interface {
+	CallEVMWithData(ctx sdk.Context, from common.Address, contract *common.Address, data []byte, commit bool, gasCap *big.Int) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
+// EVMMsg defines the custom message a CosmWasm contract dispatches to call an EVM contract,
+// e.g. {"call":{"contract":"0x...","data":"0x..."}}.
+type EVMMsg struct {
+	Call *CallMsg `json:"call,omitempty"`
+}
+
+// EVMQuery defines the custom query a CosmWasm contract makes to read an EVM contract,
+// e.g. {"static_call":{"contract":"0x...","data":"0x..."}}.
+type EVMQuery struct {
+	StaticCall *CallMsg `json:"static_call,omitempty"`
+}
+
+// CallMsg defines a call to an EVM contract with hex encoded calldata.
+type CallMsg struct {
+	Contract string `json:"contract"`
+	Data     string `json:"data"`
+}
+
+// CallResponse defines the response of a call to an EVM contract, with hex encoded return
+// data.
+type CallResponse struct {
+	Data string `json:"data"`
+}
+
+// Bindings implements the custom message and query handlers that let CosmWasm contracts call
+// EVM contracts. The wasmd message handler and query plugin decorators of the chain should
+// forward the custom messages and queries of the contracts to DispatchMsg and HandleQuery.
+//
+// The EVM contract is called with the address of the CosmWasm contract truncated to its last
+// 20 bytes as the sender.
+type Bindings struct {
+	evmKeeper     EVMKeeper
+	accountKeeper evmtypes.AccountKeeper
+}
+
+// NewBindings creates the wasm bindings calling EVM contracts.
+func NewBindings(evmKeeper EVMKeeper, accountKeeper evmtypes.AccountKeeper) Bindings {
+	return Bindings{
+		evmKeeper:     evmKeeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// DispatchMsg executes the EVMMsg of a CosmWasm contract and returns the JSON encoded
+// CallResponse. The error of a reverted EVM call is returned to the contract.
+func (b Bindings) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, msg json.RawMessage) ([]byte, error) {
+	// a contract called through the precompile can't call back into the EVM, since the
+	// state of the outer EVM call is not committed yet
+	if called, _ := ctx.Value(precompileCallKey{}).(bool); called {
+		return nil, errors.New(ErrCallFromPrecompile)
+	}
+
+	var evmMsg EVMMsg
+	if err := json.Unmarshal(msg, &evmMsg); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsg, err)
+	}
+	if evmMsg.Call == nil {
+		return nil, fmt.Errorf(ErrInvalidMsg, string(msg))
+	}
+
+	from := common.BytesToAddress(contractAddr)
+	if !b.accountKeeper.HasAccount(ctx, from.Bytes()) {
+		b.accountKeeper.SetAccount(ctx, b.accountKeeper.NewAccountWithAddress(ctx, from.Bytes()))
+	}
+
+	return b.call(ctx, from, evmMsg.Call, true)
+}
+
+// HandleQuery executes the EVMQuery of a CosmWasm contract and returns the JSON encoded
+// CallResponse. The state changes of the EVM call are discarded.
+func (b Bindings) HandleQuery(ctx sdk.Context, query json.RawMessage) ([]byte, error) {
+	var evmQuery EVMQuery
+	if err := json.Unmarshal(query, &evmQuery); err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsg, err)
+	}
+	if evmQuery.StaticCall == nil {
+		return nil, fmt.Errorf(ErrInvalidMsg, string(query))
+	}
+
+	// the queries are sent from the EVM module account, which always exists
+	from := common.BytesToAddress(b.accountKeeper.GetModuleAddress(evmtypes.ModuleName))
+
+	return b.call(ctx, from, evmQuery.StaticCall, false)
+}
+
+// call calls the EVM contract of the CallMsg and returns the JSON encoded CallResponse.
+func (b Bindings) call(ctx sdk.Context, from common.Address, callMsg *CallMsg, commit bool) ([]byte, error) {
+	if !common.IsHexAddress(callMsg.Contract) {
+		return nil, fmt.Errorf(ErrInvalidContractAddress, callMsg.Contract)
+	}
+	contract := common.HexToAddress(callMsg.Contract)
+
+	data, err := hexutil.Decode(callMsg.Data)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMsg, err)
+	}
+
+	res, err := b.evmKeeper.CallEVMWithData(ctx, from, &contract, data, commit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(CallResponse{Data: hexutil.Encode(res.Ret)})
+}
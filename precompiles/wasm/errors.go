@@ -0,0 +1,23 @@
+package wasm
+
+const (
+	// ErrInvalidCaller is raised when the creator or caller address is invalid.
+	ErrInvalidCaller = "invalid caller address: %v"
+	// ErrInvalidContractAddress is raised when the CosmWasm contract address is invalid.
+	ErrInvalidContractAddress = "invalid contract address: %v"
+	// ErrInvalidCodeID is raised when the code id of the CosmWasm contract is invalid.
+	ErrInvalidCodeID = "invalid code id: %v"
+	// ErrInvalidLabel is raised when the label of the CosmWasm contract is invalid.
+	ErrInvalidLabel = "invalid label: %v"
+	// ErrInvalidMsg is raised when the CosmWasm contract message is invalid.
+	ErrInvalidMsg = "invalid msg: %v"
+	// ErrInvalidFunds is raised when the funds sent to the CosmWasm contract are invalid.
+	ErrInvalidFunds = "invalid funds: %v"
+	// ErrInstantiateFailed is raised when the instantiation of a CosmWasm contract fails.
+	ErrInstantiateFailed = "instantiate code %d failed: %v"
+	// ErrContractFailed is raised when the CosmWasm contract returns an error.
+	ErrContractFailed = "contract %s failed: %v"
+	// ErrCallFromPrecompile is raised when a CosmWasm contract called through the precompile
+	// dispatches a message to the EVM.
+	ErrCallFromPrecompile = "cannot call the EVM from a contract called by the wasm precompile"
+)
@@ -0,0 +1,38 @@
+package wasm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeInstantiate defines the event type for the CosmWasm Instantiate transaction.
+	EventTypeInstantiate = "Instantiate"
+	// EventTypeExecute defines the event type for the CosmWasm Execute transaction.
+	EventTypeExecute = "Execute"
+)
+
+// EventInstantiate is the event emitted on an Instantiate transaction.
+type EventInstantiate struct {
+	Creator         common.Address
+	CodeId          uint64 //nolint:revive
+	ContractAddress string
+}
+
+// EventExecute is the event emitted on an Execute transaction.
+type EventExecute struct {
+	Caller          common.Address
+	ContractAddress string
+}
+
+// EmitInstantiateEvent emits the Instantiate event.
+func (p Precompile) EmitInstantiateEvent(ctx sdk.Context, stateDB vm.StateDB, creator common.Address, codeID uint64, contractAddress string) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeInstantiate], creator, codeID, contractAddress)
+}
+
+// EmitExecuteEvent emits the Execute event.
+func (p Precompile) EmitExecuteEvent(ctx sdk.Context, stateDB vm.StateDB, caller common.Address, contractAddress string) error {
+	return p.EmitEvent(ctx, stateDB, p.Events[EventTypeExecute], caller, contractAddress)
+}
@@ -0,0 +1,35 @@
+package wasm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// SmartQueryMethod defines the ABI method name for the CosmWasm SmartQuery query.
+	SmartQueryMethod = "smartQuery"
+)
+
+// SmartQuery queries a CosmWasm contract and returns its JSON encoded response.
+func (p *Precompile) SmartQuery(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	contractAddr, msg, err := ParseSmartQueryArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.wasmKeeper.QuerySmart(ctx, contractAddr, msg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrContractFailed, args[0], err)
+	}
+
+	return method.Outputs.Pack(data)
+}
@@ -0,0 +1,105 @@
+package wasm
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// InstantiateMethod defines the ABI method name for the CosmWasm Instantiate transaction.
+	InstantiateMethod = "instantiate"
+	// ExecuteMethod defines the ABI method name for the CosmWasm Execute transaction.
+	ExecuteMethod = "execute"
+)
+
+// Instantiate instantiates a CosmWasm contract from a stored code, sending the given funds
+// from the creator to the new contract.
+func (p *Precompile) Instantiate(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	instantiateArgs, err := ParseInstantiateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != instantiateArgs.Creator {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), instantiateArgs.Creator.String())
+	}
+
+	contractAddr, data, err := p.wasmKeeper.Instantiate(
+		ctx,
+		instantiateArgs.CodeID,
+		instantiateArgs.Creator.Bytes(),
+		instantiateArgs.Admin,
+		instantiateArgs.Msg,
+		instantiateArgs.Label,
+		instantiateArgs.Funds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInstantiateFailed, instantiateArgs.CodeID, err)
+	}
+
+	contractAddress, err := p.addrCdc.BytesToString(contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitInstantiateEvent(ctx, stateDB, instantiateArgs.Creator, instantiateArgs.CodeID, contractAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(contractAddress, data)
+}
+
+// ExecuteContract executes a CosmWasm contract, sending the given funds from the caller to
+// the contract.
+func (p *Precompile) ExecuteContract(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	executeArgs, err := ParseExecuteArgs(args, p.addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSender := contract.Caller()
+	if msgSender != executeArgs.Caller {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), executeArgs.Caller.String())
+	}
+
+	contractAddress, err := p.addrCdc.BytesToString(executeArgs.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.wasmKeeper.Execute(
+		ctx,
+		executeArgs.ContractAddress,
+		executeArgs.Caller.Bytes(),
+		executeArgs.Msg,
+		executeArgs.Funds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(ErrContractFailed, contractAddress, err)
+	}
+
+	if err = p.EmitExecuteEvent(ctx, stateDB, executeArgs.Caller, contractAddress); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(data)
+}
@@ -0,0 +1,181 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InstantiateArgs defines the parsed arguments of the instantiate transaction.
+type InstantiateArgs struct {
+	Creator common.Address
+	// Admin is nil when the contract has no admin.
+	Admin  sdk.AccAddress
+	CodeID uint64
+	Label  string
+	Msg    []byte
+	Funds  sdk.Coins
+}
+
+// ExecuteArgs defines the parsed arguments of the execute transaction.
+type ExecuteArgs struct {
+	Caller          common.Address
+	ContractAddress sdk.AccAddress
+	Msg             []byte
+	Funds           sdk.Coins
+}
+
+// ParseInstantiateArgs parses the arguments of the instantiate transaction.
+func ParseInstantiateArgs(args []interface{}) (*InstantiateArgs, error) {
+	if len(args) != 6 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	creator, ok := args[0].(common.Address)
+	if !ok || creator == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidCaller, args[0])
+	}
+
+	admin, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "admin", common.Address{}, args[1])
+	}
+
+	codeID, ok := args[2].(uint64)
+	if !ok || codeID == 0 {
+		return nil, fmt.Errorf(ErrInvalidCodeID, args[2])
+	}
+
+	label, ok := args[3].(string)
+	if !ok || label == "" {
+		return nil, fmt.Errorf(ErrInvalidLabel, args[3])
+	}
+
+	msg, err := parseMsg(args[4])
+	if err != nil {
+		return nil, err
+	}
+
+	funds, err := parseFunds(args[5])
+	if err != nil {
+		return nil, err
+	}
+
+	instantiateArgs := &InstantiateArgs{
+		Creator: creator,
+		CodeID:  codeID,
+		Label:   label,
+		Msg:     msg,
+		Funds:   funds,
+	}
+	if admin != (common.Address{}) {
+		instantiateArgs.Admin = admin.Bytes()
+	}
+
+	return instantiateArgs, nil
+}
+
+// ParseExecuteArgs parses the arguments of the execute transaction.
+func ParseExecuteArgs(args []interface{}, addrCdc address.Codec) (*ExecuteArgs, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	caller, ok := args[0].(common.Address)
+	if !ok || caller == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidCaller, args[0])
+	}
+
+	contractAddress, err := parseContractAddress(args[1], addrCdc)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := parseMsg(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	funds, err := parseFunds(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExecuteArgs{
+		Caller:          caller,
+		ContractAddress: contractAddress,
+		Msg:             msg,
+		Funds:           funds,
+	}, nil
+}
+
+// ParseSmartQueryArgs parses the contract address and the query message of the smartQuery
+// query.
+func ParseSmartQueryArgs(args []interface{}, addrCdc address.Codec) (sdk.AccAddress, []byte, error) {
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	contractAddress, err := parseContractAddress(args[0], addrCdc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg, err := parseMsg(args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contractAddress, msg, nil
+}
+
+// parseContractAddress parses a Bech32 encoded CosmWasm contract address.
+func parseContractAddress(arg interface{}, addrCdc address.Codec) (sdk.AccAddress, error) {
+	contractAddress, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidContractAddress, arg)
+	}
+
+	bz, err := addrCdc.StringToBytes(contractAddress)
+	if err != nil || len(bz) == 0 {
+		return nil, fmt.Errorf(ErrInvalidContractAddress, contractAddress)
+	}
+
+	return bz, nil
+}
+
+// parseMsg parses a JSON encoded CosmWasm contract message.
+func parseMsg(arg interface{}) ([]byte, error) {
+	msg, ok := arg.([]byte)
+	if !ok || !json.Valid(msg) {
+		return nil, fmt.Errorf(ErrInvalidMsg, arg)
+	}
+
+	return msg, nil
+}
+
+// parseFunds parses the coins sent to a CosmWasm contract.
+func parseFunds(arg interface{}) (sdk.Coins, error) {
+	coins, err := cmn.ToCoins(arg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidFunds, err)
+	}
+
+	funds, err := cmn.NewSdkCoinsFromCoins(coins)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidFunds, err)
+	}
+
+	if err := funds.Validate(); err != nil {
+		return nil, fmt.Errorf(ErrInvalidFunds, err)
+	}
+
+	return funds, nil
+}
@@ -0,0 +1,167 @@
+package wasm
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+)
+
+func TestParseInstantiateArgs(t *testing.T) {
+	creator := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	admin := common.HexToAddress("0x0987654321098765432109876543210987654321")
+	msg := []byte(`{"count":1}`)
+	funds := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+
+	tests := []struct {
+		name      string
+		args      []any
+		wantErr   bool
+		errMsg    string
+		wantAdmin sdk.AccAddress
+	}{
+		{
+			name:      "valid args",
+			args:      []any{creator, admin, uint64(1), "counter", msg, funds},
+			wantAdmin: admin.Bytes(),
+		},
+		{
+			name:      "valid args - no admin",
+			args:      []any{creator, common.Address{}, uint64(1), "counter", msg, []cmn.Coin{}},
+			wantAdmin: nil,
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []any{creator},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 1),
+		},
+		{
+			name:    "empty creator",
+			args:    []any{common.Address{}, admin, uint64(1), "counter", msg, funds},
+			wantErr: true,
+			errMsg:  "invalid caller address",
+		},
+		{
+			name:    "zero code id",
+			args:    []any{creator, admin, uint64(0), "counter", msg, funds},
+			wantErr: true,
+			errMsg:  "invalid code id",
+		},
+		{
+			name:    "empty label",
+			args:    []any{creator, admin, uint64(1), "", msg, funds},
+			wantErr: true,
+			errMsg:  "invalid label",
+		},
+		{
+			name:    "invalid JSON msg",
+			args:    []any{creator, admin, uint64(1), "counter", []byte("{"), funds},
+			wantErr: true,
+			errMsg:  "invalid msg",
+		},
+		{
+			name:    "zero funds",
+			args:    []any{creator, admin, uint64(1), "counter", msg, []cmn.Coin{{Denom: "atest", Amount: big.NewInt(0)}}},
+			wantErr: true,
+			errMsg:  "invalid funds",
+		},
+		{
+			name: "duplicate funds",
+			args: []any{creator, admin, uint64(1), "counter", msg, []cmn.Coin{
+				{Denom: "atest", Amount: big.NewInt(1)},
+				{Denom: "atest", Amount: big.NewInt(2)},
+			}},
+			wantErr: true,
+			errMsg:  "invalid funds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInstantiateArgs(tt.args)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, creator, got.Creator)
+				require.Equal(t, tt.wantAdmin, got.Admin)
+				require.Equal(t, msg, got.Msg)
+			}
+		})
+	}
+}
+
+func TestParseExecuteArgs(t *testing.T) {
+	addrCdc := authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	caller := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contractAddr := sdk.AccAddress(common.LeftPadBytes([]byte{1}, 32))
+	contractAddress, err := addrCdc.BytesToString(contractAddr)
+	require.NoError(t, err)
+	msg := []byte(`{"increment":{}}`)
+	funds := []cmn.Coin{{Denom: "atest", Amount: big.NewInt(100)}}
+
+	tests := []struct {
+		name    string
+		args    []any
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "valid args",
+			args: []any{caller, contractAddress, msg, funds},
+		},
+		{
+			name:    "invalid number of arguments",
+			args:    []any{caller, contractAddress},
+			wantErr: true,
+			errMsg:  fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 2),
+		},
+		{
+			name:    "empty caller",
+			args:    []any{common.Address{}, contractAddress, msg, funds},
+			wantErr: true,
+			errMsg:  "invalid caller address",
+		},
+		{
+			name:    "invalid contract address",
+			args:    []any{caller, "cosmos1invalid", msg, funds},
+			wantErr: true,
+			errMsg:  "invalid contract address",
+		},
+		{
+			name:    "empty msg",
+			args:    []any{caller, contractAddress, []byte{}, funds},
+			wantErr: true,
+			errMsg:  "invalid msg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExecuteArgs(tt.args, addrCdc)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errMsg)
+				require.Nil(t, got)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, caller, got.Caller)
+				require.Equal(t, contractAddr, got.ContractAddress)
+				require.Equal(t, msg, got.Msg)
+				require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atest", 100)), got.Funds)
+			}
+		})
+	}
+}
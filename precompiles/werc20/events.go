@@ -5,11 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	cmn "github.com/cosmos/evm/precompiles/common"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -43,7 +40,7 @@ func (p Precompile) EmitWithdrawalEvent(
 }
 
 // createWERC20Event adds to the StateDB a log representing an event for the
-// WERC20 precompile.
+// WERC20 precompile, along with the corresponding Cosmos event.
 func (p Precompile) createWERC20Event(
 	ctx sdk.Context,
 	stateDB vm.StateDB,
@@ -51,29 +48,5 @@ func (p Precompile) createWERC20Event(
 	address common.Address,
 	amount *big.Int,
 ) error {
-	// Prepare the event topics
-	topics := make([]common.Hash, 2)
-
-	topics[0] = event.ID
-
-	var err error
-	topics[1], err = cmn.MakeTopic(address)
-	if err != nil {
-		return err
-	}
-
-	arguments := abi.Arguments{event.Inputs[1]}
-	packed, err := arguments.Pack(amount)
-	if err != nil {
-		return err
-	}
-
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
-	})
-
-	return nil
+	return p.EmitEvent(ctx, stateDB, event, address, amount)
 }
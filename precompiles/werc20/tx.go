@@ -6,7 +6,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	"github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	"cosmossdk.io/math"
@@ -59,6 +58,10 @@ func (p Precompile) Deposit(
 // Withdraw is a no-op and mock function that provides the same interface as the
 // WETH contract to support equality between the native coin and its wrapped
 // ERC-20 (e.g. ATOM and WEVMOS).
+//
+// As in WETH9, the withdrawn amount is denominated in the same 18 decimals units as
+// the msg.value of the deposits, so it is checked against the spendable balance of
+// the extended EVM denomination, including the fractional balance.
 func (p Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.StateDB, args []interface{}) ([]byte, error) {
 	amount, ok := args[0].(*big.Int)
 	if !ok {
@@ -68,8 +71,8 @@ func (p Precompile) Withdraw(ctx sdk.Context, contract *vm.Contract, stateDB vm.
 
 	caller := contract.Caller()
 	callerAccAddress := sdk.AccAddress(caller.Bytes())
-	nativeBalance := p.BankKeeper.SpendableCoin(ctx, callerAccAddress, evmtypes.GetEVMCoinDenom())
-	if nativeBalance.Amount.Mul(types.ConversionFactor()).LT(amountInt) {
+	nativeBalance := p.BankKeeper.SpendableCoin(ctx, callerAccAddress, evmtypes.GetEVMCoinExtendedDenom())
+	if nativeBalance.Amount.LT(amountInt) {
 		return nil, fmt.Errorf("account balance %v is lower than withdraw balance %v", nativeBalance.Amount, amountInt)
 	}
 
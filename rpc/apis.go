@@ -7,9 +7,11 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/admin"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	evmapi "github.com/cosmos/evm/rpc/namespaces/ethereum/evm"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/miner"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/net"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/personal"
@@ -37,6 +39,8 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	AdminNamespace    = "admin"
+	EVMNamespace      = "evm"
 
 	apiVersion = "1.0"
 )
@@ -167,6 +171,39 @@ func init() {
 				},
 			}
 		},
+		AdminNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewPrivateAPI(ctx.Logger, evmBackend),
+					Public:    false,
+				},
+			}
+		},
+		EVMNamespace: func(ctx *server.Context,
+			_ client.Context,
+			_ *stream.RPCStream,
+			_ bool,
+			_ types.EVMTxIndexer,
+			_ *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: EVMNamespace,
+					Version:   apiVersion,
+					Service:   evmapi.NewPrivateAPI(ctx.Logger),
+					Public:    false,
+				},
+			}
+		},
 	}
 }
 
@@ -144,6 +144,30 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// StorageDiff returns the storage slots of the contract at the given address
+// changed between the two blocks.
+func (b *Backend) StorageDiff(address common.Address, from, to rpctypes.BlockNumberOrHash) ([]rpctypes.StorageDiff, error) {
+	fromNum, err := b.BlockNumberFromComet(from)
+	if err != nil {
+		return nil, err
+	}
+	toNum, err := b.BlockNumberFromComet(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromStorage, err := rpctypes.QueryContractStorage(rpctypes.ContextWithHeight(fromNum.Int64()), b.QueryClient, address)
+	if err != nil {
+		return nil, err
+	}
+	toStorage, err := rpctypes.QueryContractStorage(rpctypes.ContextWithHeight(toNum.Int64()), b.QueryClient, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpctypes.DiffStorage(fromStorage, toStorage), nil
+}
+
 // GetBalance returns the provided account's *spendable* balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromComet(blockNrOrHash)
@@ -181,6 +205,10 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
 func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error) {
 	n := hexutil.Uint64(0)
+	blockNum, err := b.resolveBlockNumber(blockNum)
+	if err != nil {
+		return &n, err
+	}
 	bn, err := b.BlockNumber()
 	if err != nil {
 		return &n, err
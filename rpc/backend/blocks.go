@@ -53,6 +53,10 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		return b.PendingBlock(fullTx)
+	}
+
 	resBlock, err := b.CometBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -175,6 +179,9 @@ func (b *Backend) CometBlockByNumber(blockNum rpctypes.BlockNumber) (*cmtrpctype
 }
 
 func (b *Backend) getHeightByBlockNum(blockNum rpctypes.BlockNumber) (int64, error) {
+	if blockNum.IsFinalityTag() {
+		return b.finalityHeight(blockNum)
+	}
 	height := blockNum.Int64()
 	if height <= 0 {
 		// fetch the latest block number from the app state, more accurate than the CometBFT block store state.
@@ -242,7 +249,7 @@ func (b *Backend) BlockNumberFromComet(blockNrOrHash rpctypes.BlockNumberOrHash)
 		}
 		return rpctypes.NewBlockNumber(blockNumber), nil
 	case blockNrOrHash.BlockNumber != nil:
-		return *blockNrOrHash.BlockNumber, nil
+		return b.resolveBlockNumber(*blockNrOrHash.BlockNumber)
 	default:
 		return rpctypes.EthEarliestBlockNumber, nil
 	}
@@ -668,6 +675,12 @@ func (b *Backend) formatTxReceipt(
 		receipt["contractAddress"] = crypto.CreateAddress(from, ethTx.Nonce())
 	}
 
+	// blob transactions (EIP-4844) are not supported, so no blob gas is used
+	if ethTx.Type() == ethtypes.BlobTxType {
+		receipt["blobGasUsed"] = hexutil.Uint64(0)
+		receipt["blobGasPrice"] = (*hexutil.Big)(big.NewInt(0))
+	}
+
 	if ethTx.Type() >= ethtypes.DynamicFeeTxType {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
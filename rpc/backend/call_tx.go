@@ -108,6 +108,12 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
+	// blob transactions are decoded so that tooling probing EIP-4844 support
+	// gets a deterministic error, but they can't be executed
+	if tx.Type() == ethtypes.BlobTxType {
+		return common.Hash{}, rpctypes.ErrBlobTxNotSupported
+	}
+
 	// check the local node config in case unprotected txs are disabled
 	if !b.UnprotectedAllowed() {
 		if !tx.Protected() {
@@ -133,6 +139,11 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 
 	baseDenom := evmtypes.GetEVMCoinDenom()
 
+	// ensure the fee of the transaction is within the configured cap
+	if err := rpctypes.CheckRawTxFee(tx.GasFeeCap(), tx.Gas(), b.RPCRawTxFeeCap(), baseDenom); err != nil {
+		return common.Hash{}, err
+	}
+
 	cosmosTx, err := ethereumTx.BuildTx(b.ClientCtx.TxConfig.NewTxBuilder(), baseDenom)
 	if err != nil {
 		b.Logger.Error("failed to build cosmos tx", "error", err.Error())
@@ -154,10 +165,11 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
 	}
 	if err != nil {
-		// Check if this is a nonce gap error that was successfully queued
-		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceGap.Error()) {
+		// Check if the transaction has a nonce gap and was successfully queued
+		if b.Mempool != nil && mempool.IsTxQueuedError(err) {
 			// Transaction was successfully queued due to nonce gap, return success to client
 			b.Logger.Debug("transaction queued due to nonce gap", "hash", txHash.Hex())
+			b.trackLocalTx(tx)
 			return txHash, nil
 		}
 		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceLow.Error()) {
@@ -176,6 +188,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 			}
 
 			// SendRawTransaction does not return error when committed nonce <= tx.Nonce < pending nonce
+			b.trackLocalTx(tx)
 			return txHash, nil
 		}
 
@@ -183,6 +196,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return txHash, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
+	b.trackLocalTx(tx)
 	return txHash, nil
 }
 
@@ -299,7 +313,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -314,18 +328,37 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 	return args, nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// EstimateGas returns an estimate of gas usage for the given smart contract call,
+// executed against the state with the given overrides applied.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
 	}
+	blockNr, err := b.resolveBlockNumber(blockNr)
+	if err != nil {
+		return 0, err
+	}
 
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return 0, err
 	}
 
+	var overridesBz []byte
+	if overrides != nil {
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return 0, err
+		}
+	}
+
+	pendingTxs, err := b.pendingTxsForCall(blockNr)
+	if err != nil {
+		return 0, err
+	}
+
 	header, err := b.CometHeaderByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -334,9 +367,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
-		GasCap:          b.RPCGasCap(),
+		GasCap:          b.RPCEstimateGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
+		Overrides:       overridesBz,
+		PendingTxs:      pendingTxs,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -357,10 +392,18 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 func (b *Backend) DoCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
+	blockNr, err := b.resolveBlockNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	pendingTxs, err := b.pendingTxsForCall(blockNr)
+	if err != nil {
+		return nil, err
+	}
 	header, err := b.CometHeaderByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -369,9 +412,10 @@ func (b *Backend) DoCall(
 
 	req := evmtypes.EthCallRequest{
 		Args:            bz,
-		GasCap:          b.RPCGasCap(),
+		GasCap:          b.RPCCallGasCap(),
 		ProposerAddress: sdk.ConsAddress(header.Header.ProposerAddress),
 		ChainId:         b.EvmChainID.Int64(),
+		PendingTxs:      pendingTxs,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -439,15 +483,13 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	return (*hexutil.Big)(result), nil
 }
 
-// handleRevertError returns revert related error.
+// handleRevertError returns revert related error. A revert is returned with the
+// JSON-RPC error code 3 and the revert data, even if empty, like go-ethereum.
 func handleRevertError(vmError string, ret []byte) error {
 	if len(vmError) > 0 {
 		if vmError != vm.ErrExecutionReverted.Error() {
 			return status.Error(codes.Internal, vmError)
 		}
-		if len(ret) == 0 {
-			return errors.New(vmError)
-		}
 		return evmtypes.NewExecErrorWithReason(ret)
 	}
 	return nil
@@ -330,11 +330,56 @@ func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	// ```
 	// MaxDelta = BaseFee * (GasLimit - GasLimit / ElasticityMultiplier) / (GasLimit / ElasticityMultiplier) / Denominator
 	//          = BaseFee * (ElasticityMultiplier - 1) / Denominator
-	// ```t
-	maxDelta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
-	if maxDelta < 0 {
-		// impossible if the parameter validation passed.
-		maxDelta = 0
+	// ```
+	// If the gas target is set independently of the gas limit, it's instead:
+	// ```
+	// MaxDelta = BaseFee * (GasLimit - GasTarget) / GasTarget / Denominator
+	// ```
+	var maxDelta *big.Int
+	if params.Params.BlockGasTarget > 0 {
+		maxDelta, err = b.maxBaseFeeDeltaWithGasTarget(baseFee, params.Params)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		delta := baseFee.Int64() * (int64(params.Params.ElasticityMultiplier) - 1) / int64(params.Params.BaseFeeChangeDenominator) // #nosec G115
+		if delta < 0 {
+			// impossible if the parameter validation passed.
+			delta = 0
+		}
+		maxDelta = big.NewInt(delta)
+	}
+
+	// the delta is further bounded by the maximum base fee change rate, if set
+	if rate := params.Params.MaxBaseFeeChangeRate; !rate.IsNil() && rate.IsPositive() {
+		rateDelta := rate.MulInt(sdkmath.NewIntFromBigInt(baseFee)).TruncateInt().BigInt()
+		if rateDelta.Cmp(maxDelta) < 0 {
+			maxDelta = rateDelta
+		}
 	}
-	return big.NewInt(maxDelta), nil
+	return maxDelta, nil
+}
+
+// maxBaseFeeDeltaWithGasTarget returns the maximum base fee delta for a gas
+// target set independently of the latest block gas limit.
+func (b *Backend) maxBaseFeeDeltaWithGasTarget(baseFee *big.Int, params feemarkettypes.Params) (*big.Int, error) {
+	height, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(b.Ctx, b.ClientCtx, int64(height)) // #nosec G115 -- int overflow is not a concern here
+	if err != nil {
+		return nil, err
+	}
+
+	gasTarget := params.GasTarget(uint64(gasLimit)) // #nosec G115 -- gas limit is non-negative
+	if gasTarget == 0 || uint64(gasLimit) <= gasTarget {
+		return big.NewInt(0), nil
+	}
+
+	maxDelta := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(uint64(gasLimit)-gasTarget))
+	maxDelta.Quo(maxDelta, new(big.Int).SetUint64(gasTarget))
+	maxDelta.Quo(maxDelta, new(big.Int).SetUint64(uint64(params.BaseFeeChangeDenominator)))
+	return maxDelta, nil
 }
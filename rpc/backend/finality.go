@@ -0,0 +1,54 @@
+package backend
+
+import (
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+)
+
+// resolveBlockNumber resolves the "finalized" and "safe" block tags to the number
+// of the corresponding block, the other block numbers are returned as is.
+func (b *Backend) resolveBlockNumber(blockNum rpctypes.BlockNumber) (rpctypes.BlockNumber, error) {
+	if !blockNum.IsFinalityTag() {
+		return blockNum, nil
+	}
+	height, err := b.finalityHeight(blockNum)
+	if err != nil {
+		return blockNum, err
+	}
+	return rpctypes.BlockNumber(height), nil
+}
+
+// finalityHeight returns the height of the block designated by the "finalized" or
+// "safe" block tag.
+//
+// CometBFT provides single-slot finality, the commit of a block being included in
+// the next one, so that:
+//   - the safe block is the latest block whose commit is included in the chain,
+//     i.e. the parent of the latest block.
+//   - the finalized block is the safe block, bounded by the latest block recorded
+//     as finalized by the EVM indexer if enabled, so that the txs of a finalized
+//     block are always indexed.
+func (b *Backend) finalityHeight(blockNum rpctypes.BlockNumber) (int64, error) {
+	n, err := b.BlockNumber()
+	if err != nil {
+		return 0, err
+	}
+	latest, err := cosmosevmtypes.SafeHexToInt64(n)
+	if err != nil {
+		return 0, err
+	}
+
+	height := latest - 1
+	if blockNum == rpctypes.EthFinalizedBlockNumber && b.Indexer != nil {
+		if idxer, ok := b.Indexer.(indexer.FinalityIndexer); ok {
+			finalized, err := idxer.LastFinalizedBlock()
+			if err != nil {
+				return 0, err
+			}
+			height = min(height, finalized)
+		}
+	}
+	// the genesis block is final
+	return max(height, 1), nil
+}
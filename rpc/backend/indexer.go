@@ -0,0 +1,50 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// BackfillIndexer indexes the blocks within the [from, to] range that are missing
+// from the EVM indexer and re-indexes the corrupted ones, loading them from the
+// CometBFT node.
+func (b *Backend) BackfillIndexer(from, to int64) (*indexer.BackfillResult, error) {
+	if b.Indexer == nil {
+		return nil, errors.New("EVM indexer is disabled")
+	}
+	idxer, ok := b.Indexer.(indexer.BackfillIndexer)
+	if !ok {
+		return nil, fmt.Errorf("EVM indexer %T does not support backfill", b.Indexer)
+	}
+
+	loadBlock := func(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
+		resBlock, err := b.CometBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, nil, err
+		}
+		if resBlock == nil || resBlock.Block == nil {
+			return nil, nil, fmt.Errorf("block not found %d", height)
+		}
+		blockRes, err := b.CometBlockResultByNumber(&height)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resBlock.Block, blockRes.TxsResults, nil
+	}
+
+	res, err := indexer.Backfill(idxer, loadBlock, from, to, func(height int64, status indexer.BackfillStatus) {
+		if status != indexer.BackfillStatusSkipped {
+			b.Logger.Info("backfilled EVM indexer block", "height", height, "status", status)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -165,6 +165,80 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest,
 	return r0, r1
 }
 
+// CodeMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CodeMetadata(ctx context.Context, in *types.QueryCodeMetadataRequest, opts ...grpc.CallOption) (*types.QueryCodeMetadataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CodeMetadata")
+	}
+
+	var r0 *types.QueryCodeMetadataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCodeMetadataRequest, ...grpc.CallOption) (*types.QueryCodeMetadataResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCodeMetadataRequest, ...grpc.CallOption) *types.QueryCodeMetadataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryCodeMetadataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCodeMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ContractStorage provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) ContractStorage(ctx context.Context, in *types.QueryContractStorageRequest, opts ...grpc.CallOption) (*types.QueryContractStorageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContractStorage")
+	}
+
+	var r0 *types.QueryContractStorageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractStorageRequest, ...grpc.CallOption) (*types.QueryContractStorageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryContractStorageRequest, ...grpc.CallOption) *types.QueryContractStorageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryContractStorageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryContractStorageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CosmosAccount provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosmosAccountRequest, opts ...grpc.CallOption) (*types.QueryCosmosAccountResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -202,6 +276,43 @@ func (_m *EVMQueryClient) CosmosAccount(ctx context.Context, in *types.QueryCosm
 	return r0, r1
 }
 
+// EIP712TypedData provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) EIP712TypedData(ctx context.Context, in *types.QueryEIP712TypedDataRequest, opts ...grpc.CallOption) (*types.QueryEIP712TypedDataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EIP712TypedData")
+	}
+
+	var r0 *types.QueryEIP712TypedDataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryEIP712TypedDataRequest, ...grpc.CallOption) (*types.QueryEIP712TypedDataResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryEIP712TypedDataRequest, ...grpc.CallOption) *types.QueryEIP712TypedDataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryEIP712TypedDataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryEIP712TypedDataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) EstimateGas(ctx context.Context, in *types.EthCallRequest, opts ...grpc.CallOption) (*types.EstimateGasResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -343,6 +454,43 @@ func (_m *EVMQueryClient) EthCall(ctx context.Context, in *types.EthCallRequest,
 	return r0, r1
 }
 
+// EthCallBatch provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) EthCallBatch(ctx context.Context, in *types.EthCallBatchRequest, opts ...grpc.CallOption) (*types.EthCallBatchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EthCallBatch")
+	}
+
+	var r0 *types.EthCallBatchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallBatchRequest, ...grpc.CallOption) (*types.EthCallBatchResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.EthCallBatchRequest, ...grpc.CallOption) *types.EthCallBatchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.EthCallBatchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.EthCallBatchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -380,6 +528,80 @@ func (_m *EVMQueryClient) Params(ctx context.Context, in *types.QueryParamsReque
 	return r0, r1
 }
 
+// ParamsAtHeight provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) ParamsAtHeight(ctx context.Context, in *types.QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*types.QueryParamsAtHeightResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamsAtHeight")
+	}
+
+	var r0 *types.QueryParamsAtHeightResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsAtHeightRequest, ...grpc.CallOption) (*types.QueryParamsAtHeightResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsAtHeightRequest, ...grpc.CallOption) *types.QueryParamsAtHeightResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryParamsAtHeightResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsAtHeightRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParamsAuthorities provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) ParamsAuthorities(ctx context.Context, in *types.QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*types.QueryParamsAuthoritiesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParamsAuthorities")
+	}
+
+	var r0 *types.QueryParamsAuthoritiesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsAuthoritiesRequest, ...grpc.CallOption) (*types.QueryParamsAuthoritiesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsAuthoritiesRequest, ...grpc.CallOption) *types.QueryParamsAuthoritiesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryParamsAuthoritiesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsAuthoritiesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Storage provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) Storage(ctx context.Context, in *types.QueryStorageRequest, opts ...grpc.CallOption) (*types.QueryStorageResponse, error) {
 	_va := make([]interface{}, len(opts))
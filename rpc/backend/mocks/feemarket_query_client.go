@@ -107,6 +107,66 @@ func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParam
 	return r0, r1
 }
 
+// ParamsAtHeight provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) ParamsAtHeight(ctx context.Context, in *types.QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*types.QueryParamsAtHeightResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryParamsAtHeightResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsAtHeightRequest, ...grpc.CallOption) *types.QueryParamsAtHeightResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryParamsAtHeightResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsAtHeightRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParamsAuthorities provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) ParamsAuthorities(ctx context.Context, in *types.QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*types.QueryParamsAuthoritiesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryParamsAuthoritiesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsAuthoritiesRequest, ...grpc.CallOption) *types.QueryParamsAuthoritiesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryParamsAuthoritiesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsAuthoritiesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewQueryClient interface {
 	mock.TestingT
 	Cleanup(func())
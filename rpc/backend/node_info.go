@@ -317,6 +317,42 @@ func (b *Backend) RPCTxFeeCap() float64 {
 	return b.Cfg.JSONRPC.TxFeeCap
 }
 
+// RPCCallGasCap is the gas cap for eth_call, falling back to the global gas cap.
+func (b *Backend) RPCCallGasCap() uint64 {
+	if b.Cfg.JSONRPC.CallGasCap != 0 {
+		return b.Cfg.JSONRPC.CallGasCap
+	}
+	return b.RPCGasCap()
+}
+
+// RPCEstimateGasCap is the gas cap for eth_estimateGas, falling back to the
+// global gas cap.
+func (b *Backend) RPCEstimateGasCap() uint64 {
+	if b.Cfg.JSONRPC.EstimateGasCap != 0 {
+		return b.Cfg.JSONRPC.EstimateGasCap
+	}
+	return b.RPCGasCap()
+}
+
+// RPCTraceTimeout is the cap on the timeout of the debug_trace* methods. 0
+// means the requested timeouts are used.
+func (b *Backend) RPCTraceTimeout() time.Duration {
+	return b.Cfg.JSONRPC.TraceTimeout
+}
+
+// RPCRawTxFeeCap is the fee cap, in the EVM base denom, of the transactions sent
+// with eth_sendRawTransaction, or nil if there is no cap.
+func (b *Backend) RPCRawTxFeeCap() *big.Int {
+	if b.Cfg.JSONRPC.RawTxFeeCap == "" {
+		return nil
+	}
+	feeCap, ok := new(big.Int).SetString(b.Cfg.JSONRPC.RawTxFeeCap, 10)
+	if !ok {
+		return nil
+	}
+	return feeCap
+}
+
 // RPCFilterCap is the limit for total number of filters that can be created
 func (b *Backend) RPCFilterCap() int32 {
 	return b.Cfg.JSONRPC.FilterCap
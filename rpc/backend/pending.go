@@ -0,0 +1,131 @@
+package backend
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+// PendingBlockTxs returns the transactions of the pending block, assembled from
+// the local mempool in the order a block proposal would include them. It
+// returns no transactions if the node doesn't run the EVM mempool.
+func (b *Backend) PendingBlockTxs() ([]*ethtypes.Transaction, error) {
+	if b.Mempool == nil {
+		return nil, nil
+	}
+	return b.Mempool.PendingBlockTxs()
+}
+
+// pendingTxsForCall returns the binary encoded transactions of the pending
+// block to execute before a call at the given block number, i.e. none unless
+// the call targets the pending block.
+func (b *Backend) pendingTxsForCall(blockNr rpctypes.BlockNumber) ([][]byte, error) {
+	if blockNr != rpctypes.EthPendingBlockNumber {
+		return nil, nil
+	}
+
+	txs, err := b.PendingBlockTxs()
+	if err != nil || len(txs) == 0 {
+		return nil, err
+	}
+
+	rawTxs := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		bz, err := tx.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		rawTxs = append(rawTxs, bz)
+	}
+	return rawTxs, nil
+}
+
+// PendingBlock returns the JSON-RPC compatible pending block, built on top of
+// the latest block with the transactions of the local mempool. As in
+// go-ethereum, the hash, nonce and miner of the pending block are not set.
+// The gas used is the sum of the gas limits of the transactions, as they are
+// not executed. It falls back to the latest block if the node doesn't run the
+// EVM mempool.
+func (b *Backend) PendingBlock(fullTx bool) (map[string]interface{}, error) {
+	if b.Mempool == nil {
+		return b.GetBlockByNumber(rpctypes.EthLatestBlockNumber, fullTx)
+	}
+
+	resBlock, err := b.CometBlockByNumber(rpctypes.EthLatestBlockNumber)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	txs, err := b.PendingBlockTxs()
+	if err != nil {
+		return nil, err
+	}
+
+	header := resBlock.Block.Header
+	header.Height++
+	header.Time = time.Now().UTC()
+	header.LastBlockID = cmttypes.BlockID{Hash: resBlock.Block.Hash()}
+
+	baseFee := b.Mempool.GetBlockchain().CurrentBlock().BaseFee
+	signer := ethtypes.LatestSignerForChainID(b.EvmChainID)
+
+	var (
+		ethRPCTxs = []interface{}{}
+		gasUsed   uint64
+		size      uint64
+	)
+	for i, tx := range txs {
+		gasUsed += tx.Gas()
+		size += tx.Size()
+
+		if !fullTx {
+			ethRPCTxs = append(ethRPCTxs, tx.Hash())
+			continue
+		}
+
+		msg := &evmtypes.MsgEthereumTx{}
+		if err := msg.FromSignedEthereumTx(tx, signer); err != nil {
+			b.Logger.Debug("failed to convert pending transaction", "hash", tx.Hash(), "error", err.Error())
+			continue
+		}
+
+		rpcTx, err := rpctypes.NewRPCTransaction(
+			msg,
+			common.Hash{},
+			uint64(header.Height), //#nosec G115 -- checked for int overflow already
+			uint64(i),             //#nosec G115 -- checked for int overflow already
+			baseFee,
+			b.EvmChainID,
+		)
+		if err != nil {
+			b.Logger.Debug("NewRPCTransaction for pending transaction failed", "hash", tx.Hash(), "error", err.Error())
+			continue
+		}
+		ethRPCTxs = append(ethRPCTxs, rpcTx)
+	}
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(rpctypes.ContextWithHeight(resBlock.Block.Height), b.ClientCtx, resBlock.Block.Height)
+	if err != nil {
+		b.Logger.Error("failed to query consensus params", "error", err.Error())
+	}
+
+	formattedBlock := rpctypes.FormatBlock(
+		header, int(size), //#nosec G115 -- block size won't exceed int
+		gasLimit, new(big.Int).SetUint64(gasUsed),
+		ethRPCTxs, ethtypes.Bloom{}, common.Address{}, baseFee,
+	)
+	formattedBlock["transactionsRoot"] = ethtypes.DeriveSha(ethtypes.Transactions(txs), trie.NewStackTrie(nil))
+	formattedBlock["hash"] = nil
+	formattedBlock["nonce"] = nil
+	formattedBlock["miner"] = nil
+
+	return formattedBlock, nil
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
@@ -14,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
 	"github.com/cosmos/evm/mempool"
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -41,6 +41,10 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.EvmChainID))
 	}
 
+	if args.BlobHashes != nil || args.Blobs != nil {
+		return common.Hash{}, rpctypes.ErrBlobTxNotSupported
+	}
+
 	args, err = b.SetTxDefaults(args)
 	if err != nil {
 		return common.Hash{}, err
@@ -109,16 +113,19 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
 	}
 	if err != nil {
-		// Check if this is a nonce gap error that was successfully queued
-		if b.Mempool != nil && strings.Contains(err.Error(), mempool.ErrNonceGap.Error()) {
+		// Check if the transaction has a nonce gap and was successfully queued
+		if b.Mempool != nil && mempool.IsTxQueuedError(err) {
 			// Transaction was successfully queued due to nonce gap, return success to client
 			b.Logger.Debug("transaction queued due to nonce gap", "hash", txHash.Hex())
+			b.trackLocalTx(ethTx)
 			return txHash, nil
 		}
 		b.Logger.Error("failed to broadcast tx", "error", err.Error())
 		return txHash, err
 	}
 
+	b.trackLocalTx(ethTx)
+
 	// Return transaction hash
 	return txHash, nil
 }
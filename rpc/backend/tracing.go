@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -109,7 +110,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfi
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
 	}
 
-	if config != nil {
+	if config != nil || b.RPCTraceTimeout() > 0 {
 		traceTxRequest.TraceConfig = b.convertConfig(config)
 	}
 
@@ -135,12 +136,22 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfi
 	return decodedResult, nil
 }
 
+// convertConfig converts the trace config of the RPC request to the one of the
+// gRPC query, capping the requested timeout to the configured trace timeout.
 func (b *Backend) convertConfig(config *rpctypes.TraceConfig) *evmtypes.TraceConfig {
-	if config == nil {
-		return &evmtypes.TraceConfig{}
+	cfg := evmtypes.TraceConfig{}
+	if config != nil {
+		cfg = config.TraceConfig
+		cfg.TracerJsonConfig = string(config.TracerConfig)
+	}
+
+	if maxTimeout := b.RPCTraceTimeout(); maxTimeout > 0 {
+		// invalid timeouts are left for the query to reject
+		timeout, err := time.ParseDuration(cfg.Timeout)
+		if cfg.Timeout == "" || (err == nil && timeout > maxTimeout) {
+			cfg.Timeout = maxTimeout.String()
+		}
 	}
-	cfg := config.TraceConfig
-	cfg.TracerJsonConfig = string(config.TracerConfig)
 	return &cfg
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtrpcclient "github.com/cometbft/cometbft/rpc/client"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
@@ -234,6 +235,50 @@ func (b *Backend) GetTransactionLogs(hash common.Hash) ([]*ethtypes.Log, error)
 	return logs, nil
 }
 
+// GetCometTxHash returns the hash of the CometBFT transaction carrying the
+// Ethereum transaction identified by hash.
+func (b *Backend) GetCometTxHash(hash common.Hash) (cmtbytes.HexBytes, error) {
+	res, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.Logger.Debug("tx not found", "hash", hash.Hex(), "error", err.Error())
+		return nil, nil
+	}
+
+	resBlock, err := b.CometBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil || resBlock.Block == nil {
+		b.Logger.Debug("block not found", "height", res.Height)
+		return nil, nil
+	}
+	if int(res.TxIndex) >= len(resBlock.Block.Txs) {
+		return nil, fmt.Errorf("tx index %d out of range for block %d", res.TxIndex, res.Height)
+	}
+
+	return resBlock.Block.Txs[res.TxIndex].Hash(), nil
+}
+
+// GetEthTxHashes returns the hashes of the Ethereum transactions carried by the
+// CometBFT transaction identified by hash, in the order of the transaction messages.
+func (b *Backend) GetEthTxHashes(hash cmtbytes.HexBytes) ([]common.Hash, error) {
+	resTx, err := b.RPCClient.Tx(b.Ctx, hash, false)
+	if err != nil {
+		b.Logger.Debug("tx not found", "hash", hash.String(), "error", err.Error())
+		return nil, nil
+	}
+
+	tx, err := b.ClientCtx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to decode tx %s", hash.String())
+	}
+
+	hashes := make([]common.Hash, 0, len(tx.GetMsgs()))
+	for _, msg := range tx.GetMsgs() {
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			hashes = append(hashes, ethMsg.Hash())
+		}
+	}
+	return hashes, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (b *Backend) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	b.Logger.Debug("eth_getTransactionByBlockHashAndIndex", "hash", hash.Hex(), "index", idx)
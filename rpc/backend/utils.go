@@ -45,6 +45,14 @@ func (s sortGasAndReward) Less(i, j int) bool {
 	return s[i].reward.Cmp(s[j].reward) < 0
 }
 
+// trackLocalTx tracks an EVM transaction submitted through this node in the
+// mempool journal, so that it gets resubmitted if lost before its inclusion.
+func (b *Backend) trackLocalTx(tx *ethtypes.Transaction) {
+	if b.Mempool != nil {
+		b.Mempool.TrackLocalTx(tx)
+	}
+}
+
 // getAccountNonce returns the account nonce for the given account address.
 // If the pending value is true, it will iterate over the mempool (pending)
 // txs in order to compute and return the pending tx sequence.
@@ -79,6 +87,12 @@ func (b *Backend) getAccountNonce(accAddr common.Address, pending bool, height i
 		return nonce, nil
 	}
 
+	// the evm mempool tracks the next nonce of the account with all its
+	// executable txs applied, as the pending block would include them
+	if b.Mempool != nil {
+		return max(nonce, b.Mempool.GetTxPool().PoolNonce(accAddr)), nil
+	}
+
 	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
 	// to manually add them.
 	pendingTxs, err := b.PendingTransactions()
@@ -0,0 +1,269 @@
+package limiter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// errCodeLimitExceeded is the JSON-RPC error code returned for the requests
+	// rejected when their queue is full.
+	errCodeLimitExceeded = -32005
+
+	// maxRequestContentLength is the maximum size of the requests inspected by
+	// the limiter, matching the go-ethereum HTTP server default.
+	maxRequestContentLength = 5 * 1024 * 1024
+)
+
+// errSaturated is returned when all the workers of a class are busy and its
+// queue is full.
+var errSaturated = errors.New("the JSON-RPC server is saturated")
+
+// Class is the class of the JSON-RPC methods sharing the same worker pool.
+type Class int
+
+const (
+	// ClassRead is the class of the cheap read methods, e.g. eth_getBalance.
+	ClassRead Class = iota
+	// ClassCall is the class of the methods executing the EVM, e.g. eth_call.
+	ClassCall
+	// ClassTrace is the class of the debug_trace* methods.
+	ClassTrace
+)
+
+// callMethods are the methods of the ClassCall class.
+var callMethods = map[string]struct{}{
+	"eth_call":             {},
+	"eth_estimateGas":      {},
+	"eth_createAccessList": {},
+	"eth_simulateV1":       {},
+}
+
+// String implements fmt.Stringer.
+func (c Class) String() string {
+	switch c {
+	case ClassRead:
+		return "read"
+	case ClassCall:
+		return "call"
+	case ClassTrace:
+		return "trace"
+	default:
+		return fmt.Sprintf("Class(%d)", int(c))
+	}
+}
+
+// ClassOf returns the class of the given JSON-RPC method.
+func ClassOf(method string) Class {
+	if strings.HasPrefix(method, "debug_trace") {
+		return ClassTrace
+	}
+	if _, ok := callMethods[method]; ok {
+		return ClassCall
+	}
+	return ClassRead
+}
+
+// PoolConfig is the configuration of the worker pool of a class.
+type PoolConfig struct {
+	// Concurrency is the maximum number of requests served concurrently. 0
+	// disables the limit.
+	Concurrency int
+	// QueueSize is the maximum number of requests waiting for a worker. The
+	// requests received when the queue is full are rejected.
+	QueueSize int
+}
+
+// Config is the configuration of the worker pools of each class.
+type Config struct {
+	Read  PoolConfig
+	Call  PoolConfig
+	Trace PoolConfig
+}
+
+// pool limits the number of requests served concurrently, and queued.
+type pool struct {
+	// workers holds a token for each request being served
+	workers chan struct{}
+	// admitted holds a token for each request being served or queued
+	admitted chan struct{}
+}
+
+func newPool(cfg PoolConfig) *pool {
+	if cfg.Concurrency <= 0 {
+		return nil
+	}
+	queueSize := cfg.QueueSize
+	if queueSize < 0 {
+		queueSize = 0
+	}
+	return &pool{
+		workers:  make(chan struct{}, cfg.Concurrency),
+		admitted: make(chan struct{}, cfg.Concurrency+queueSize),
+	}
+}
+
+// acquire waits for a worker, or returns errSaturated without waiting if the
+// queue is full. The returned function releases the worker.
+func (p *pool) acquire(ctx context.Context) (func(), error) {
+	select {
+	case p.admitted <- struct{}{}:
+	default:
+		return nil, errSaturated
+	}
+
+	select {
+	case p.workers <- struct{}{}:
+		return func() {
+			<-p.workers
+			<-p.admitted
+		}, nil
+	case <-ctx.Done():
+		<-p.admitted
+		return nil, ctx.Err()
+	}
+}
+
+// Limiter serves the JSON-RPC requests of each class with a separate worker
+// pool, so that the bursts of expensive requests (e.g. traces) don't starve the
+// cheap ones, and sheds the load when the pools are saturated.
+type Limiter struct {
+	pools map[Class]*pool
+}
+
+// New returns a Limiter with the worker pools of the given configuration.
+func New(cfg Config) *Limiter {
+	return &Limiter{
+		pools: map[Class]*pool{
+			ClassRead:  newPool(cfg.Read),
+			ClassCall:  newPool(cfg.Call),
+			ClassTrace: newPool(cfg.Trace),
+		},
+	}
+}
+
+// Acquire waits for a worker of the given class and returns the function
+// releasing it. It returns an error without waiting if the workers of the class
+// are busy and its queue is full.
+func (l *Limiter) Acquire(ctx context.Context, class Class) (func(), error) {
+	p := l.pools[class]
+	if p == nil {
+		return func() {}, nil
+	}
+	return p.acquire(ctx)
+}
+
+func (l *Limiter) disabled() bool {
+	for _, p := range l.pools {
+		if p != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonrpcMessage is the subset of a JSON-RPC request and response handled by
+// the limiter.
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Error   *jsonError      `json:"error,omitempty"`
+}
+
+type jsonError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Handler returns a handler serving the JSON-RPC requests with next once a worker
+// of their class is available. A batch is served by a worker of the most
+// expensive class of its methods. The requests received when the workers are
+// busy and the queue is full are rejected with a 429 status.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	if l.disabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		msgs, isBatch := parseMessages(body)
+		class := ClassRead
+		for _, msg := range msgs {
+			if c := ClassOf(msg.Method); c > class {
+				class = c
+			}
+		}
+
+		release, err := l.Acquire(r.Context(), class)
+		if err != nil {
+			if !errors.Is(err, errSaturated) {
+				// the request was canceled while queued
+				return
+			}
+			writeSaturated(w, msgs, isBatch, class)
+			return
+		}
+		defer release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseMessages returns the messages of a single or batch request, and whether
+// it is a batch. Malformed requests are left to the JSON-RPC server and served
+// as cheap reads.
+func parseMessages(body []byte) ([]jsonrpcMessage, bool) {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var batch []jsonrpcMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return []jsonrpcMessage{{}}, false
+		}
+		return batch, true
+	}
+
+	var msg jsonrpcMessage
+	_ = json.Unmarshal(body, &msg)
+	return []jsonrpcMessage{msg}, false
+}
+
+func writeSaturated(w http.ResponseWriter, msgs []jsonrpcMessage, isBatch bool, class Class) {
+	resps := make([]jsonrpcMessage, len(msgs))
+	for i, msg := range msgs {
+		id := msg.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		resps[i] = jsonrpcMessage{
+			Version: "2.0",
+			ID:      id,
+			Error: &jsonError{
+				Code:    errCodeLimitExceeded,
+				Message: fmt.Sprintf("%s: too many %s requests", errSaturated, class),
+			},
+		}
+	}
+
+	var resp interface{} = resps
+	if !isBatch && len(resps) == 1 {
+		resp = resps[0]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", "1")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,144 @@
+package limiter
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassOf(t *testing.T) {
+	require.Equal(t, ClassRead, ClassOf("eth_getBalance"))
+	require.Equal(t, ClassRead, ClassOf("debug_getRawBlock"))
+	require.Equal(t, ClassRead, ClassOf(""))
+	require.Equal(t, ClassCall, ClassOf("eth_call"))
+	require.Equal(t, ClassCall, ClassOf("eth_estimateGas"))
+	require.Equal(t, ClassTrace, ClassOf("debug_traceTransaction"))
+	require.Equal(t, ClassTrace, ClassOf("debug_traceBlockByNumber"))
+}
+
+func TestLimiterAcquire(t *testing.T) {
+	l := New(Config{Trace: PoolConfig{Concurrency: 1, QueueSize: 1}})
+
+	// the read and call classes have no limit
+	for i := 0; i < 10; i++ {
+		_, err := l.Acquire(context.Background(), ClassRead)
+		require.NoError(t, err)
+	}
+
+	release, err := l.Acquire(context.Background(), ClassTrace)
+	require.NoError(t, err)
+
+	// the queued request waits for the worker
+	queued := make(chan error, 1)
+	go func() {
+		releaseQueued, err := l.Acquire(context.Background(), ClassTrace)
+		if err == nil {
+			releaseQueued()
+		}
+		queued <- err
+	}()
+	require.Eventually(t, func() bool { return len(l.pools[ClassTrace].admitted) == 2 }, time.Second, time.Millisecond)
+
+	// the queue is full
+	_, err = l.Acquire(context.Background(), ClassTrace)
+	require.ErrorIs(t, err, errSaturated)
+
+	release()
+	require.NoError(t, <-queued)
+
+	// a canceled request leaves the queue
+	release, err = l.Acquire(context.Background(), ClassTrace)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = l.Acquire(ctx, ClassTrace)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, l.pools[ClassTrace].admitted, 1)
+	release()
+}
+
+func TestLimiterHandler(t *testing.T) {
+	unblock := make(chan struct{})
+	served := make(chan struct{}, 1)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.Header.Get("X-Test"), "block") {
+			served <- struct{}{}
+			<-unblock
+		}
+		_, _ = w.Write([]byte("served"))
+	})
+	handler := New(Config{
+		Read:  PoolConfig{Concurrency: 10, QueueSize: 10},
+		Trace: PoolConfig{Concurrency: 1},
+	}).Handler(next)
+
+	serve := func(body string, block bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if block {
+			req.Header.Set("X-Test", "block")
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// a trace request occupies the single trace worker
+	var (
+		wg        sync.WaitGroup
+		traceCode int
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		traceCode = serve(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction","params":[]}`, true).Code
+	}()
+	<-served
+
+	testCases := []struct {
+		name    string
+		body    string
+		expCode int
+		expBody string
+	}{
+		{
+			"read served while the traces are saturated",
+			`{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber","params":[]}`,
+			http.StatusOK,
+			"served",
+		},
+		{
+			"trace rejected",
+			`{"jsonrpc":"2.0","id":3,"method":"debug_traceCall","params":[]}`,
+			http.StatusTooManyRequests,
+			`{"jsonrpc":"2.0","id":3,"error":{"code":-32005,"message":"the JSON-RPC server is saturated: too many trace requests"}}`,
+		},
+		{
+			"batch with a trace rejected",
+			`[{"jsonrpc":"2.0","id":4,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":5,"method":"debug_traceCall"}]`,
+			http.StatusTooManyRequests,
+			`[{"jsonrpc":"2.0","id":4,"error":{"code":-32005,"message":"the JSON-RPC server is saturated: too many trace requests"}},` +
+				`{"jsonrpc":"2.0","id":5,"error":{"code":-32005,"message":"the JSON-RPC server is saturated: too many trace requests"}}]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := serve(tc.body, false)
+			require.Equal(t, tc.expCode, rec.Code)
+			require.Equal(t, tc.expBody, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+
+	close(unblock)
+	wg.Wait()
+	require.Equal(t, http.StatusOK, traceCode)
+
+	rec := serve(`{"jsonrpc":"2.0","id":6,"method":"debug_traceCall","params":[]}`, false)
+	require.Equal(t, http.StatusOK, rec.Code)
+}
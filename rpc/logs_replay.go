@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// defaultReplayRange is the number of blocks whose logs are queried at once when
+// replaying the logs of a subscription, if the JSON-RPC block range cap is unset.
+const defaultReplayRange = 10000
+
+// rpcCaller calls a method of the JSON-RPC server and decodes its result.
+type rpcCaller func(ctx context.Context, result interface{}, method string, params ...interface{}) error
+
+// parseReplayFromBlock parses the fromBlock parameter of a logs subscription. It
+// returns nil if the logs aren't replayed, i.e. if the parameter is missing or
+// refers to the latest or pending block.
+func parseReplayFromBlock(param interface{}) (*uint64, error) {
+	if param == nil {
+		return nil, nil
+	}
+
+	str, ok := param.(string)
+	if !ok {
+		return nil, errors.Errorf("invalid fromBlock: %v", param)
+	}
+
+	var blockNumber rpc.BlockNumber
+	if err := blockNumber.UnmarshalJSON([]byte(strconv.Quote(str))); err != nil {
+		return nil, errors.Wrapf(err, "invalid fromBlock %s", str)
+	}
+
+	var from uint64
+	switch {
+	case blockNumber == rpc.EarliestBlockNumber:
+		from = 0
+	case blockNumber < 0:
+		// latest, pending, safe and finalized blocks: nothing to replay
+		return nil, nil
+	default:
+		from = uint64(blockNumber.Int64()) //#nosec G115 -- non-negative block number
+	}
+
+	return &from, nil
+}
+
+// getLogsArgs returns the eth_getLogs arguments querying the logs matching the
+// criteria within the [from, to] block range.
+func getLogsArgs(crit filters.FilterCriteria, from, to uint64) map[string]interface{} {
+	args := map[string]interface{}{
+		"fromBlock": hexutil.Uint64(from),
+		"toBlock":   hexutil.Uint64(to),
+	}
+	if len(crit.Addresses) > 0 {
+		args["address"] = crit.Addresses
+	}
+	if len(crit.Topics) > 0 {
+		args["topics"] = crit.Topics
+	}
+	return args
+}
+
+// replayLogs notifies the historical logs matching the criteria from the given
+// block up to the latest one, querying them from the JSON-RPC server by ranges
+// of blocks. It returns the last replayed block.
+func (api *pubSubAPI) replayLogs(
+	ctx context.Context,
+	crit filters.FilterCriteria,
+	from uint64,
+	notify func(ethLog json.RawMessage) error,
+) (uint64, error) {
+	var head hexutil.Uint64
+	if err := api.call(ctx, &head, "eth_blockNumber"); err != nil {
+		return 0, errors.Wrap(err, "failed to query the latest block")
+	}
+
+	replayRange := api.replayRange
+	if replayRange <= 0 {
+		replayRange = defaultReplayRange
+	}
+
+	for start := from; start <= uint64(head); start += uint64(replayRange) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		end := start + uint64(replayRange) - 1
+		if end > uint64(head) {
+			end = uint64(head)
+		}
+
+		var logs []json.RawMessage
+		if err := api.call(ctx, &logs, "eth_getLogs", getLogsArgs(crit, start, end)); err != nil {
+			return 0, errors.Wrapf(err, "failed to query the logs of blocks %d to %d", start, end)
+		}
+
+		for _, ethLog := range logs {
+			if err := notify(ethLog); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return uint64(head), nil
+}
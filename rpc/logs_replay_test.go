@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReplayFromBlock(t *testing.T) {
+	testCases := []struct {
+		name    string
+		param   interface{}
+		expFrom *uint64
+		expErr  bool
+	}{
+		{"missing", nil, nil, false},
+		{"latest", "latest", nil, false},
+		{"pending", "pending", nil, false},
+		{"earliest", "earliest", func() *uint64 { v := uint64(0); return &v }(), false},
+		{"hex block number", "0x10", func() *uint64 { v := uint64(16); return &v }(), false},
+		{"invalid block number", "sixteen", nil, true},
+		{"invalid type", 16.0, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			from, err := parseReplayFromBlock(tc.param)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFrom, from)
+		})
+	}
+}
+
+func TestReplayLogs(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	crit := filters.FilterCriteria{Addresses: []common.Address{addr}}
+
+	var ranges [][2]uint64
+	call := func(_ context.Context, result interface{}, method string, params ...interface{}) error {
+		switch method {
+		case "eth_blockNumber":
+			*result.(*hexutil.Uint64) = 25
+			return nil
+		case "eth_getLogs":
+			args := params[0].(map[string]interface{})
+			require.Equal(t, crit.Addresses, args["address"])
+			from, to := uint64(args["fromBlock"].(hexutil.Uint64)), uint64(args["toBlock"].(hexutil.Uint64))
+			ranges = append(ranges, [2]uint64{from, to})
+			*result.(*[]json.RawMessage) = []json.RawMessage{json.RawMessage(`{"blockNumber":"` + hexutil.EncodeUint64(from) + `"}`)}
+			return nil
+		default:
+			return errors.New("unexpected method")
+		}
+	}
+
+	api := &pubSubAPI{call: call, replayRange: 10}
+
+	var notified []string
+	replayedTo, err := api.replayLogs(context.Background(), crit, 3, func(ethLog json.RawMessage) error {
+		notified = append(notified, string(ethLog))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), replayedTo)
+	require.Equal(t, [][2]uint64{{3, 12}, {13, 22}, {23, 25}}, ranges)
+	require.Equal(t, []string{`{"blockNumber":"0x3"}`, `{"blockNumber":"0xd"}`, `{"blockNumber":"0x17"}`}, notified)
+
+	// nothing to replay from a future block
+	ranges = nil
+	replayedTo, err = api.replayLogs(context.Background(), crit, 30, func(json.RawMessage) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), replayedTo)
+	require.Empty(t, ranges)
+
+	// canceled replay
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = api.replayLogs(ctx, crit, 3, func(json.RawMessage) error { return nil })
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/rs/zerolog"
+
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/rpc/backend"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"cosmossdk.io/log"
+)
+
+// errNoMethodGate is returned when the admin API isn't bound to a JSON-RPC server.
+var errNoMethodGate = errors.New("the admin API is not bound to a JSON-RPC server method gate")
+
+// API is the private admin prefixed set of APIs to operate the node.
+type API struct {
+	logger         log.Logger
+	backend        backend.EVMBackend
+	gate           *MethodGate
+	filterFlushers []func() int
+}
+
+// MempoolDump is the content and status of the node mempool.
+type MempoolDump struct {
+	Status  map[string]hexutil.Uint                                   `json:"status"`
+	Content map[string]map[string]map[string]*rpctypes.RPCTransaction `json:"content"`
+}
+
+// NewPrivateAPI creates an instance of the Admin API.
+func NewPrivateAPI(
+	logger log.Logger,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		logger:  logger.With("api", "admin"),
+		backend: backend,
+	}
+}
+
+// Bind binds the admin API to the method gate of the node JSON-RPC server and
+// to the filter flushers of its eth filter APIs. It isn't an API method so that
+// it isn't exposed in the admin namespace.
+func Bind(api *API, gate *MethodGate, filterFlushers ...func() int) {
+	api.gate = gate
+	api.filterFlushers = filterFlushers
+}
+
+// BackfillIndexer indexes the blocks within the [from, to] range that are missing
+// from the EVM indexer and re-indexes the corrupted ones.
+func (api *API) BackfillIndexer(from, to hexutil.Uint64) (*indexer.BackfillResult, error) {
+	api.logger.Debug("admin_backfillIndexer", "from", from, "to", to)
+	return api.backend.BackfillIndexer(int64(from), int64(to)) //#nosec G115 -- block numbers won't exceed int64
+}
+
+// DisableMethods disables the given namespaces (e.g. "debug") or methods (e.g.
+// "eth_call") on the node JSON-RPC server and returns the disabled ones.
+func (api *API) DisableMethods(names []string) ([]string, error) {
+	api.logger.Debug("admin_disableMethods", "names", names)
+	if api.gate == nil {
+		return nil, errNoMethodGate
+	}
+	if err := api.gate.Disable(names...); err != nil {
+		return nil, err
+	}
+	api.logger.Info("disabled JSON-RPC methods", "names", names)
+	return api.gate.Disabled(), nil
+}
+
+// EnableMethods enables back the given namespaces or methods on the node JSON-RPC
+// server and returns the ones still disabled.
+func (api *API) EnableMethods(names []string) ([]string, error) {
+	api.logger.Debug("admin_enableMethods", "names", names)
+	if api.gate == nil {
+		return nil, errNoMethodGate
+	}
+	api.gate.Enable(names...)
+	api.logger.Info("enabled JSON-RPC methods", "names", names)
+	return api.gate.Disabled(), nil
+}
+
+// DisabledMethods returns the namespaces and methods disabled on the node JSON-RPC server.
+func (api *API) DisabledMethods() ([]string, error) {
+	api.logger.Debug("admin_disabledMethods")
+	if api.gate == nil {
+		return nil, errNoMethodGate
+	}
+	return api.gate.Disabled(), nil
+}
+
+// DumpMempool returns the status and the pending and queued transactions of the
+// node mempool.
+func (api *API) DumpMempool() (*MempoolDump, error) {
+	api.logger.Debug("admin_dumpMempool")
+	status, err := api.backend.Status()
+	if err != nil {
+		return nil, err
+	}
+	content, err := api.backend.Content()
+	if err != nil {
+		return nil, err
+	}
+	return &MempoolDump{Status: status, Content: content}, nil
+}
+
+// FlushFilters uninstalls all the filters created on the node JSON-RPC server and
+// returns their number.
+func (api *API) FlushFilters() hexutil.Uint {
+	api.logger.Debug("admin_flushFilters")
+	flushed := 0
+	for _, flush := range api.filterFlushers {
+		flushed += flush()
+	}
+	api.logger.Info("flushed JSON-RPC filters", "count", flushed)
+	return hexutil.Uint(flushed) //#nosec G115 -- the number of filters is never negative
+}
+
+// SetLogLevel sets the minimum level of the node logs (trace, debug, info, warn,
+// error, fatal, panic or disabled). It cannot lower the level below the one set
+// with the --log_level flag at startup.
+func (api *API) SetLogLevel(level string) error {
+	api.logger.Debug("admin_setLogLevel", "level", level)
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	zerolog.SetGlobalLevel(lvl)
+	api.logger.Info("set the node log level", "level", lvl.String())
+	return nil
+}
@@ -0,0 +1,201 @@
+package admin
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// namespace is the JSON-RPC namespace of the admin API.
+	namespace = "admin"
+
+	// errCodeMethodNotFound is the JSON-RPC error code returned for the disabled methods.
+	errCodeMethodNotFound = -32601
+
+	// maxRequestContentLength is the maximum size of the requests inspected by
+	// the method gate, matching the go-ethereum HTTP server default.
+	maxRequestContentLength = 5 * 1024 * 1024
+)
+
+// MethodGate holds the JSON-RPC namespaces and methods disabled at runtime on
+// the node JSON-RPC server.
+type MethodGate struct {
+	mu       sync.RWMutex
+	disabled map[string]struct{}
+}
+
+// NewMethodGate returns a MethodGate with all the methods enabled.
+func NewMethodGate() *MethodGate {
+	return &MethodGate{disabled: make(map[string]struct{})}
+}
+
+// Disable disables the given namespaces (e.g. "debug") or methods (e.g. "eth_call").
+// The admin namespace cannot be disabled.
+func (g *MethodGate) Disable(names ...string) error {
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("empty namespace or method name")
+		}
+		if name == namespace || strings.HasPrefix(name, namespace+"_") {
+			return fmt.Errorf("the %s namespace cannot be disabled", namespace)
+		}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, name := range names {
+		g.disabled[name] = struct{}{}
+	}
+	return nil
+}
+
+// Enable enables back the given namespaces or methods.
+func (g *MethodGate) Enable(names ...string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, name := range names {
+		delete(g.disabled, name)
+	}
+}
+
+// Disabled returns the sorted list of the disabled namespaces and methods.
+func (g *MethodGate) Disabled() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	names := make([]string, 0, len(g.disabled))
+	for name := range g.disabled {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsDisabled returns true if the given method or its namespace is disabled.
+func (g *MethodGate) IsDisabled(method string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if _, ok := g.disabled[method]; ok {
+		return true
+	}
+	ns, _, found := strings.Cut(method, "_")
+	if !found {
+		return false
+	}
+	_, ok := g.disabled[ns]
+	return ok
+}
+
+func (g *MethodGate) empty() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return len(g.disabled) == 0
+}
+
+// jsonrpcMessage is the subset of a JSON-RPC request and response handled by
+// the method gate.
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Error   *jsonError      `json:"error,omitempty"`
+}
+
+type jsonError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Handler returns a handler rejecting the JSON-RPC requests calling a disabled
+// method before forwarding the others to next. A batch calling a disabled method
+// is rejected as a whole.
+func (g *MethodGate) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.empty() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		resp := g.reject(body)
+		if resp == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// reject returns the error response of the request body if it calls a disabled
+// method, nil otherwise. Malformed requests are left to the JSON-RPC server.
+func (g *MethodGate) reject(body []byte) interface{} {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var batch []jsonrpcMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			return nil
+		}
+		for _, msg := range batch {
+			if !g.IsDisabled(msg.Method) {
+				continue
+			}
+			resps := make([]jsonrpcMessage, len(batch))
+			for i := range batch {
+				resps[i] = errorResponse(batch[i].ID, msg.Method)
+			}
+			return resps
+		}
+		return nil
+	}
+
+	var msg jsonrpcMessage
+	if err := json.Unmarshal(body, &msg); err != nil || !g.IsDisabled(msg.Method) {
+		return nil
+	}
+	return errorResponse(msg.ID, msg.Method)
+}
+
+func errorResponse(id json.RawMessage, method string) jsonrpcMessage {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return jsonrpcMessage{
+		Version: "2.0",
+		ID:      id,
+		Error: &jsonError{
+			Code:    errCodeMethodNotFound,
+			Message: fmt.Sprintf("the method %s is disabled", method),
+		},
+	}
+}
+
+// AuthHandler returns a handler only forwarding to next the requests carrying
+// the given bearer token in their Authorization header.
+func AuthHandler(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,104 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMethodGate(t *testing.T) {
+	gate := NewMethodGate()
+	require.False(t, gate.IsDisabled("eth_call"))
+
+	require.NoError(t, gate.Disable("debug", "eth_call"))
+	require.Equal(t, []string{"debug", "eth_call"}, gate.Disabled())
+	require.True(t, gate.IsDisabled("eth_call"))
+	require.True(t, gate.IsDisabled("debug_traceTransaction"))
+	require.False(t, gate.IsDisabled("eth_getBalance"))
+	require.False(t, gate.IsDisabled("debug"))
+
+	require.Error(t, gate.Disable("admin"))
+	require.Error(t, gate.Disable("admin_setLogLevel"))
+	require.Error(t, gate.Disable("txpool", ""))
+	require.False(t, gate.IsDisabled("txpool_content"))
+
+	gate.Enable("debug")
+	require.Equal(t, []string{"eth_call"}, gate.Disabled())
+	require.False(t, gate.IsDisabled("debug_traceTransaction"))
+}
+
+func TestMethodGateHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("served"))
+	})
+	gate := NewMethodGate()
+	handler := gate.Handler(next)
+	require.NoError(t, gate.Disable("eth_call"))
+
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			"enabled method",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`,
+			"served",
+		},
+		{
+			"disabled method",
+			`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method eth_call is disabled"}}`,
+		},
+		{
+			"batch with enabled methods",
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`,
+			"served",
+		},
+		{
+			"batch with a disabled method",
+			`[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":"a","method":"eth_call"}]`,
+			`[{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method eth_call is disabled"}},` +
+				`{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"the method eth_call is disabled"}}]`,
+		},
+		{
+			"malformed request",
+			`{"jsonrpc":`,
+			"served",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.expected, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func TestAuthHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := AuthHandler("secret", next)
+
+	for header, status := range map[string]int{
+		"":              http.StatusUnauthorized,
+		"secret":        http.StatusUnauthorized,
+		"Bearer wrong":  http.StatusUnauthorized,
+		"Bearer secret": http.StatusOK,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, status, rec.Code, header)
+	}
+}
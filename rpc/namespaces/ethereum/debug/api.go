@@ -102,6 +102,27 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *rpctypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// StorageDiff returns the storage slots of the contract at the given address
+// changed between the two blocks. If the storage layout of the contract, as
+// output by solc, is given, the state variables stored in the changed slots are
+// decoded.
+func (a *API) StorageDiff(
+	address common.Address,
+	from, to rpctypes.BlockNumberOrHash,
+	layout *rpctypes.StorageLayout,
+) ([]rpctypes.StorageDiff, error) {
+	a.logger.Debug("debug_storageDiff", "address", address, "from", from, "to", to)
+	diffs, err := a.backend.StorageDiff(address, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rpctypes.DecodeStorageDiffs(diffs, layout); err != nil {
+		return nil, err
+	}
+	return diffs, nil
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
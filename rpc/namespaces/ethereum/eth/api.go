@@ -11,6 +11,8 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+
 	"github.com/cosmos/evm/rpc/backend"
 	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/types"
@@ -75,7 +77,7 @@ type EthereumAPI interface {
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
 	FeeHistory(blockCount math.HexOrDecimal64, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -92,6 +94,8 @@ type EthereumAPI interface {
 	Syncing() (interface{}, error)
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
+	GetCometTxHash(txHash common.Hash) (tmbytes.HexBytes, error)
+	GetEthTxHashes(cometTxHash tmbytes.HexBytes) ([]common.Hash, error)
 	SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error)
 	FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.SignTransactionResult, error)
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
@@ -307,9 +311,14 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// The optional state overrides are applied before the call is executed.
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
 }
 
 func (e *PublicAPI) FeeHistory(
@@ -394,6 +403,22 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 	return e.backend.GetTransactionLogs(txHash)
 }
 
+// GetCometTxHash returns the hash of the CometBFT transaction carrying the
+// Ethereum transaction identified by hash.
+func (e *PublicAPI) GetCometTxHash(txHash common.Hash) (tmbytes.HexBytes, error) {
+	e.logger.Debug("eth_getCometTxHash", "hash", txHash)
+
+	return e.backend.GetCometTxHash(txHash)
+}
+
+// GetEthTxHashes returns the hashes of the Ethereum transactions carried by the
+// CometBFT transaction identified by hash.
+func (e *PublicAPI) GetEthTxHashes(cometTxHash tmbytes.HexBytes) ([]common.Hash, error) {
+	e.logger.Debug("eth_getEthTxHashes", "hash", cometTxHash)
+
+	return e.backend.GetEthTxHashes(cometTxHash)
+}
+
 // SignTypedData signs EIP-712 conformant typed data
 func (e *PublicAPI) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_signTypedData", "address", address.Hex(), "data", typedData)
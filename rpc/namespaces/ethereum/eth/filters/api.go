@@ -268,6 +268,18 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	return found
 }
 
+// FlushFilters uninstalls all the filters created through the given API and
+// returns their number. It isn't a PublicFilterAPI method so that it isn't
+// exposed in the eth namespace.
+func FlushFilters(api *PublicFilterAPI) int {
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	flushed := len(api.filters)
+	api.filters = make(map[rpc.ID]*filter)
+	return flushed
+}
+
 // GetFilterLogs returns the logs for the filter with the given id.
 // If the filter could not be found an empty array of logs is returned.
 //
@@ -38,3 +38,19 @@ func TestTimeoutLoop_PanicOnNilCancel(t *testing.T) {
 	}
 	require.False(t, panicked)
 }
+
+func TestFlushFilters(t *testing.T) {
+	api := &PublicFilterAPI{
+		filters: make(map[rpc.ID]*filter),
+	}
+	for i := 0; i < 3; i++ {
+		api.filters[rpc.NewID()] = &filter{
+			typ:      filters.LogsSubscription,
+			deadline: time.NewTimer(time.Minute),
+		}
+	}
+
+	require.Equal(t, 3, FlushFilters(api))
+	require.Empty(t, api.filters)
+	require.Equal(t, 0, FlushFilters(api))
+}
@@ -135,8 +135,17 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) (logs [
 	head := header.Number.Uint64()
 	resolveSpecial := func(number int64) (uint64, error) {
 		switch number {
-		case rpc.LatestBlockNumber.Int64(), rpc.FinalizedBlockNumber.Int64(), rpc.SafeBlockNumber.Int64():
+		case rpc.LatestBlockNumber.Int64():
 			return head, nil
+		case rpc.FinalizedBlockNumber.Int64(), rpc.SafeBlockNumber.Int64():
+			header, err := f.backend.HeaderByNumber(types.BlockNumber(number))
+			if err != nil {
+				return 0, fmt.Errorf("failed to fetch header by number (%d): %w", number, err)
+			}
+			if header == nil || header.Number == nil {
+				return 0, errors.New("finalized block not found")
+			}
+			return header.Number.Uint64(), nil
 		case rpc.EarliestBlockNumber.Int64():
 			return 1, nil
 		default:
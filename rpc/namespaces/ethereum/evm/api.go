@@ -0,0 +1,138 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"cosmossdk.io/log"
+)
+
+// errNoDevChain is returned when the evm API isn't bound to a development chain.
+var errNoDevChain = errors.New("the evm namespace is only available on a node started with --dev")
+
+// Chain is the development chain the evm API drives.
+type Chain interface {
+	// Mine mines a block and returns once it is committed.
+	Mine(ctx context.Context) error
+	// SetNextBlockTimestamp sets the timestamp of the next mined block.
+	SetNextBlockTimestamp(timestamp time.Time) error
+	// IncreaseTime shifts the timestamps of the next blocks and returns the
+	// total shift.
+	IncreaseTime(d time.Duration) time.Duration
+	// Snapshot snapshots the state and returns the snapshot id.
+	Snapshot() uint64
+	// Revert reverts the state to a snapshot. It returns false if the snapshot
+	// doesn't exist.
+	Revert(ctx context.Context, id uint64) (bool, error)
+}
+
+// API is the private evm prefixed set of APIs to control the block production
+// and the state of a development chain, compatible with Hardhat and Anvil.
+type API struct {
+	logger log.Logger
+	chain  Chain
+}
+
+// NewPrivateAPI creates an instance of the evm API.
+func NewPrivateAPI(logger log.Logger) *API {
+	return &API{
+		logger: logger.With("api", "evm"),
+	}
+}
+
+// Bind binds the evm API to the development chain. It isn't an API method so
+// that it isn't exposed in the evm namespace.
+func Bind(api *API, chain Chain) {
+	api.chain = chain
+}
+
+// Mine mines a block, with the given timestamp if any, and returns once it is
+// committed.
+func (api *API) Mine(ctx context.Context, timestamp *Quantity) (string, error) {
+	api.logger.Debug("evm_mine", "timestamp", timestamp)
+	if api.chain == nil {
+		return "", errNoDevChain
+	}
+	if timestamp != nil {
+		if err := api.chain.SetNextBlockTimestamp(timestamp.Time()); err != nil {
+			return "", err
+		}
+	}
+	if err := api.chain.Mine(ctx); err != nil {
+		return "", err
+	}
+	return "0x0", nil
+}
+
+// SetNextBlockTimestamp sets the timestamp, in seconds, of the next mined block.
+func (api *API) SetNextBlockTimestamp(timestamp Quantity) error {
+	api.logger.Debug("evm_setNextBlockTimestamp", "timestamp", timestamp)
+	if api.chain == nil {
+		return errNoDevChain
+	}
+	return api.chain.SetNextBlockTimestamp(timestamp.Time())
+}
+
+// IncreaseTime shifts the timestamps of the next blocks by the given number of
+// seconds and returns the total shift in seconds.
+func (api *API) IncreaseTime(seconds Quantity) (int64, error) {
+	api.logger.Debug("evm_increaseTime", "seconds", seconds)
+	if api.chain == nil {
+		return 0, errNoDevChain
+	}
+	offset := api.chain.IncreaseTime(time.Duration(seconds) * time.Second) //#nosec G115 -- durations won't exceed int64
+	return int64(offset / time.Second), nil
+}
+
+// Snapshot snapshots the state of the latest block and returns the snapshot id.
+func (api *API) Snapshot() (hexutil.Uint64, error) {
+	api.logger.Debug("evm_snapshot")
+	if api.chain == nil {
+		return 0, errNoDevChain
+	}
+	return hexutil.Uint64(api.chain.Snapshot()), nil
+}
+
+// Revert reverts the state to the given snapshot, deleting it and the later
+// ones. It returns false if the snapshot doesn't exist.
+func (api *API) Revert(ctx context.Context, id Quantity) (bool, error) {
+	api.logger.Debug("evm_revert", "id", id)
+	if api.chain == nil {
+		return false, errNoDevChain
+	}
+	return api.chain.Revert(ctx, uint64(id))
+}
+
+// Quantity is an unsigned integer parameter, encoded either as a hex string or
+// as a JSON number, as sent by the Hardhat and Anvil clients.
+type Quantity uint64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (q *Quantity) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		var v hexutil.Uint64
+		if err := json.Unmarshal(input, &v); err != nil {
+			return err
+		}
+		*q = Quantity(v)
+		return nil
+	}
+
+	v, err := strconv.ParseUint(string(input), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid quantity %s: %w", input, err)
+	}
+	*q = Quantity(v)
+	return nil
+}
+
+// Time returns the quantity as a timestamp in seconds.
+func (q Quantity) Time() time.Time {
+	return time.Unix(int64(q), 0).UTC() //#nosec G115 -- timestamps won't exceed int64
+}
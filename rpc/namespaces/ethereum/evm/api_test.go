@@ -0,0 +1,118 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func TestQuantityUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected Quantity
+		expErr   bool
+	}{
+		{`"0x0"`, 0, false},
+		{`"0x2a"`, 42, false},
+		{`42`, 42, false},
+		{`1700000000`, 1700000000, false},
+		{`"42"`, 0, true},
+		{`-1`, 0, true},
+		{`1.5`, 0, true},
+		{`null`, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			var q Quantity
+			err := json.Unmarshal([]byte(tc.input), &q)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, q)
+		})
+	}
+
+	require.Equal(t, time.Unix(1700000000, 0).UTC(), Quantity(1700000000).Time())
+}
+
+type mockChain struct {
+	mined     int
+	timestamp time.Time
+	offset    time.Duration
+	snapshots uint64
+}
+
+func (c *mockChain) Mine(context.Context) error {
+	c.mined++
+	return nil
+}
+
+func (c *mockChain) SetNextBlockTimestamp(timestamp time.Time) error {
+	c.timestamp = timestamp
+	return nil
+}
+
+func (c *mockChain) IncreaseTime(d time.Duration) time.Duration {
+	c.offset += d
+	return c.offset
+}
+
+func (c *mockChain) Snapshot() uint64 {
+	c.snapshots++
+	return c.snapshots
+}
+
+func (c *mockChain) Revert(_ context.Context, id uint64) (bool, error) {
+	return id <= c.snapshots, nil
+}
+
+func TestAPI(t *testing.T) {
+	ctx := context.Background()
+	api := NewPrivateAPI(log.NewNopLogger())
+
+	_, err := api.Mine(ctx, nil)
+	require.ErrorIs(t, err, errNoDevChain)
+	require.ErrorIs(t, api.SetNextBlockTimestamp(1), errNoDevChain)
+	_, err = api.IncreaseTime(1)
+	require.ErrorIs(t, err, errNoDevChain)
+	_, err = api.Snapshot()
+	require.ErrorIs(t, err, errNoDevChain)
+	_, err = api.Revert(ctx, 1)
+	require.ErrorIs(t, err, errNoDevChain)
+
+	chain := &mockChain{}
+	Bind(api, chain)
+
+	timestamp := Quantity(1700000000)
+	res, err := api.Mine(ctx, &timestamp)
+	require.NoError(t, err)
+	require.Equal(t, "0x0", res)
+	require.Equal(t, 1, chain.mined)
+	require.Equal(t, timestamp.Time(), chain.timestamp)
+
+	offset, err := api.IncreaseTime(60)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), offset)
+	offset, err = api.IncreaseTime(30)
+	require.NoError(t, err)
+	require.Equal(t, int64(90), offset)
+
+	id, err := api.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), uint64(id))
+
+	reverted, err := api.Revert(ctx, Quantity(id))
+	require.NoError(t, err)
+	require.True(t, reverted)
+	reverted, err = api.Revert(ctx, 2)
+	require.NoError(t, err)
+	require.False(t, reverted)
+}
@@ -45,6 +45,13 @@ var (
 	NewBlockHeaderEvents = cmtquery.MustCompile(fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, cmttypes.EventNewBlockHeader))
 )
 
+// DroppedTx describes a pending transaction dropped from the mempool in favour
+// of a same nonce replacement.
+type DroppedTx struct {
+	Hash       common.Hash `json:"hash"`
+	ReplacedBy common.Hash `json:"replacedBy"`
+}
+
 type RPCHeader struct {
 	EthHeader *ethtypes.Header
 	Hash      common.Hash
@@ -62,6 +69,8 @@ type RPCStream struct {
 
 	// pendingTxStream is backed by check-tx ante handler
 	pendingTxStream *Stream[common.Hash]
+	// droppedTxStream is backed by the mempool replacement notifications
+	droppedTxStream *Stream[DroppedTx]
 
 	wg sync.WaitGroup
 }
@@ -72,6 +81,7 @@ func NewRPCStreams(evtClient rpcclient.EventsClient, logger log.Logger, txDecode
 		logger:          logger,
 		txDecoder:       txDecoder,
 		pendingTxStream: NewStream[common.Hash](txStreamSegmentSize, txStreamCapacity),
+		droppedTxStream: NewStream[DroppedTx](txStreamSegmentSize, txStreamCapacity),
 	}
 }
 
@@ -124,6 +134,10 @@ func (s *RPCStream) PendingTxStream() *Stream[common.Hash] {
 	return s.pendingTxStream
 }
 
+func (s *RPCStream) DroppedTxStream() *Stream[DroppedTx] {
+	return s.droppedTxStream
+}
+
 func (s *RPCStream) LogStream() *Stream[*ethtypes.Log] {
 	s.initSubscriptions()
 	return s.logStream
@@ -134,6 +148,12 @@ func (s *RPCStream) ListenPendingTx(hash common.Hash) {
 	s.PendingTxStream().Add(hash)
 }
 
+// ListenDroppedTx is a callback passed to the mempool to listen for pending transactions
+// dropped in favour of a same nonce replacement.
+func (s *RPCStream) ListenDroppedTx(hash, replacedBy common.Hash) {
+	s.DroppedTxStream().Add(DroppedTx{Hash: hash, ReplacedBy: replacedBy})
+}
+
 func (s *RPCStream) start(
 	wg *sync.WaitGroup,
 	chBlocks <-chan coretypes.ResultEvent,
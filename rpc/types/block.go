@@ -23,9 +23,11 @@ import (
 type BlockNumber int64
 
 const (
-	EthPendingBlockNumber  = BlockNumber(-2)
-	EthLatestBlockNumber   = BlockNumber(-1)
-	EthEarliestBlockNumber = BlockNumber(0)
+	EthSafeBlockNumber      = BlockNumber(-4)
+	EthFinalizedBlockNumber = BlockNumber(-3)
+	EthPendingBlockNumber   = BlockNumber(-2)
+	EthLatestBlockNumber    = BlockNumber(-1)
+	EthEarliestBlockNumber  = BlockNumber(0)
 )
 
 const (
@@ -58,7 +60,7 @@ func ContextWithHeight(height int64) context.Context {
 }
 
 // UnmarshalJSON parses the given JSON fragment into a BlockNumber. It supports:
-// - "latest", "finalized", "earliest" or "pending" as string arguments
+// - "latest", "finalized", "safe", "earliest" or "pending" as string arguments
 // - the block number
 // Returned errors:
 // - an invalid block number error when the given argument isn't a known strings
@@ -73,9 +75,15 @@ func (bn *BlockNumber) UnmarshalJSON(data []byte) error {
 	case BlockParamEarliest:
 		*bn = EthEarliestBlockNumber
 		return nil
-	case BlockParamLatest, BlockParamFinalized, BlockParamSafe:
+	case BlockParamLatest:
 		*bn = EthLatestBlockNumber
 		return nil
+	case BlockParamFinalized:
+		*bn = EthFinalizedBlockNumber
+		return nil
+	case BlockParamSafe:
+		*bn = EthSafeBlockNumber
+		return nil
 	case BlockParamPending:
 		*bn = EthPendingBlockNumber
 		return nil
@@ -107,6 +115,12 @@ func (bn BlockNumber) Int64() int64 {
 	return int64(bn)
 }
 
+// IsFinalityTag returns true if the block number is the "finalized" or "safe" tag,
+// which must be resolved to the height of the corresponding block.
+func (bn BlockNumber) IsFinalityTag() bool {
+	return bn == EthFinalizedBlockNumber || bn == EthSafeBlockNumber
+}
+
 // CmtHeight is a util function used for the CometBFT RPC client. It returns
 // nil if the block number is "latest". Otherwise, it returns the pointer of the
 // int64 value of the height.
@@ -159,9 +173,15 @@ func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
 	case BlockParamEarliest:
 		bn := EthEarliestBlockNumber
 		bnh.BlockNumber = &bn
-	case BlockParamLatest, BlockParamFinalized:
+	case BlockParamLatest:
 		bn := EthLatestBlockNumber
 		bnh.BlockNumber = &bn
+	case BlockParamFinalized:
+		bn := EthFinalizedBlockNumber
+		bnh.BlockNumber = &bn
+	case BlockParamSafe:
+		bn := EthSafeBlockNumber
+		bnh.BlockNumber = &bn
 	case BlockParamPending:
 		bn := EthPendingBlockNumber
 		bnh.BlockNumber = &bn
@@ -44,6 +44,24 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"JSON input with block number finalized",
+			[]byte("{\"blockNumber\": \"finalized\"}"),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthFinalizedBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"JSON input with block number safe",
+			[]byte("{\"blockNumber\": \"safe\"}"),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthSafeBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
 		{
 			"JSON input with both block hash and block number",
 			[]byte("{\"blockHash\": \"0x579917054e325746fda5c3ee431d73d26255bc4e10b51163862368629ae19739\", \"blockNumber\": \"0x35\"}"),
@@ -78,6 +96,24 @@ func TestUnmarshalBlockNumberOrHash(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"String input with block number finalized",
+			[]byte("\"finalized\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthFinalizedBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
+		{
+			"String input with block number safe",
+			[]byte("\"safe\""),
+			func() {
+				require.Equal(t, *bnh.BlockNumber, EthSafeBlockNumber)
+				require.Nil(t, bnh.BlockHash)
+			},
+			true,
+		},
 		{
 			"String input with block number overflow",
 			[]byte("\"0xffffffffffffffffffffffffffffffffffffff\""),
@@ -1,5 +1,48 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
 
-var ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// ErrCodeTxRejected is the JSON-RPC error code of the transactions rejected by
+// the node, as defined by EIP-1474.
+const ErrCodeTxRejected = -32003
+
+var (
+	ErrProfilingDisabled = errors.New("profiling disabled in the debug namespace")
+
+	// ErrBlobTxNotSupported is returned for the EIP-4844 blob transactions, as
+	// the chain doesn't provide data availability for blobs.
+	ErrBlobTxNotSupported = NewTxRejectedError(
+		fmt.Errorf("%w: blob transactions (EIP-4844) are not supported", core.ErrTxTypeNotSupported),
+	)
+)
+
+// TxRejectedError is a JSON-RPC error returned for the transactions rejected
+// by the node before being broadcast.
+type TxRejectedError struct {
+	err error
+}
+
+// NewTxRejectedError returns a new TxRejectedError wrapping the given error.
+func NewTxRejectedError(err error) *TxRejectedError {
+	return &TxRejectedError{err: err}
+}
+
+// Error implements the error interface.
+func (e *TxRejectedError) Error() string {
+	return e.err.Error()
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *TxRejectedError) ErrorCode() int {
+	return ErrCodeTxRejected
+}
+
+// Unwrap returns the wrapped error.
+func (e *TxRejectedError) Unwrap() error {
+	return e.err
+}
@@ -36,8 +36,8 @@ const (
 
 	// Event Format 2 (the format used after PR #1062):
 	// ```
-	// ethereum_tx(ethereumTxHash, txIndex)
-	// ethereum_tx(ethereumTxHash, txIndex)
+	// ethereum_tx(ethereumTxHash, txIndex, [txHash])
+	// ethereum_tx(ethereumTxHash, txIndex, [txHash])
 	// ...
 	// ethereum_tx(amount, ethereumTxHash, txIndex, txGasUsed, txHash, [recipient], ethereumTxFailed)
 	// tx_log(txLog, txLog, ...)
@@ -90,16 +90,18 @@ func ParseTxResult(result *abci.ExecTxResult, tx sdk.Tx) (*ParsedTxs, error) {
 			continue
 		}
 
+		// only the events of format 1 and the second part of format 2 carry the amount
+		firstPart := !hasAttribute(event.Attributes, sdk.AttributeKeyAmount)
 		if format == eventFormatUnknown {
 			// discover the format version by inspect the first ethereum_tx event.
-			if len(event.Attributes) > 2 {
-				format = eventFormat1
-			} else {
+			if firstPart {
 				format = eventFormat2
+			} else {
+				format = eventFormat1
 			}
 		}
 
-		if len(event.Attributes) == 2 {
+		if firstPart {
 			// the first part of format 2
 			if err := p.newTx(event.Attributes); err != nil {
 				return nil, err
@@ -253,6 +255,15 @@ func fillTxAttribute(tx *ParsedTx, key string, value string) error {
 	return nil
 }
 
+func hasAttribute(attrs []abci.EventAttribute, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
 func fillTxAttributes(tx *ParsedTx, attrs []abci.EventAttribute) error {
 	for _, attr := range attrs {
 		if err := fillTxAttribute(tx, attr.Key, attr.Value); err != nil {
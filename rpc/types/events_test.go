@@ -120,6 +120,56 @@ func TestParseTxResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			"format 2 events with CometBFT tx hash",
+			abci.ExecTxResult{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash2.Hex()},
+						{Key: "txIndex", Value: "1"},
+						{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "amount", Value: "1000"},
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "amount", Value: "1000"},
+						{Key: "ethereumTxHash", Value: txHash2.Hex()},
+						{Key: "txIndex", Value: "1"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: "14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57"},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+					}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:   0,
+					Hash:       txHash,
+					EthTxIndex: 0,
+					GasUsed:    21000,
+					Failed:     false,
+				},
+				{
+					MsgIndex:   1,
+					Hash:       txHash2,
+					EthTxIndex: 1,
+					GasUsed:    21000,
+					Failed:     false,
+				},
+			},
+		},
 		{
 			"format 1 events, failed",
 			abci.ExecTxResult{
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+const (
+	// contractStoragePageLimit is the number of storage slots queried per page.
+	contractStoragePageLimit = 1_000
+	// maxContractStorageSlots is the maximum number of storage slots of a
+	// contract that can be loaded to compute a storage diff.
+	maxContractStorageSlots = 1_000_000
+)
+
+// eip1967Slots are the EIP-1967 proxy slots, labeled in the storage diffs even
+// without the storage layout of the contract.
+var eip1967Slots = map[common.Hash]string{
+	common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"): "eip1967.proxy.implementation",
+	common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103"): "eip1967.proxy.admin",
+	common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50"): "eip1967.proxy.beacon",
+}
+
+// StorageDiff defines a storage slot of a contract changed between two heights.
+type StorageDiff struct {
+	Slot common.Hash `json:"slot"`
+	From common.Hash `json:"from"`
+	To   common.Hash `json:"to"`
+	// Variables are the decoded state variables stored in the slot, if known.
+	Variables []StorageVariableDiff `json:"variables,omitempty"`
+}
+
+// StorageVariableDiff defines the change of a state variable stored in a
+// changed storage slot.
+type StorageVariableDiff struct {
+	Label string `json:"label"`
+	Type  string `json:"type"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// StorageLayout defines the storage layout of a contract, as output by solc
+// with the storageLayout output selection.
+type StorageLayout struct {
+	Storage []StorageLayoutEntry         `json:"storage"`
+	Types   map[string]StorageLayoutType `json:"types"`
+}
+
+// StorageLayoutEntry defines a state variable of a storage layout.
+type StorageLayoutEntry struct {
+	Label  string `json:"label"`
+	Offset uint64 `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// StorageLayoutType defines a type of the state variables of a storage layout.
+type StorageLayoutType struct {
+	Encoding      string `json:"encoding"`
+	Label         string `json:"label"`
+	NumberOfBytes string `json:"numberOfBytes"`
+}
+
+// QueryContractStorage queries all the storage slots of the contract at the
+// height of the context.
+func QueryContractStorage(ctx context.Context, queryClient evmtypes.QueryClient, address common.Address) (evmtypes.Storage, error) {
+	var (
+		storage evmtypes.Storage
+		nextKey []byte
+	)
+	for {
+		res, err := queryClient.ContractStorage(ctx, &evmtypes.QueryContractStorageRequest{
+			Address:    address.Hex(),
+			Pagination: &query.PageRequest{Key: nextKey, Limit: contractStoragePageLimit},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		storage = append(storage, res.Storage...)
+		if len(storage) > maxContractStorageSlots {
+			return nil, fmt.Errorf("contract %s has more than %d storage slots", address, maxContractStorageSlots)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return storage, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// DiffStorage returns the slots changed between the two storages of a contract,
+// ordered by slot. The slots missing from a storage are zero.
+func DiffStorage(from, to evmtypes.Storage) []StorageDiff {
+	changes := make(map[common.Hash]*StorageDiff)
+	for _, state := range from {
+		slot := common.HexToHash(state.Key)
+		changes[slot] = &StorageDiff{Slot: slot, From: common.HexToHash(state.Value)}
+	}
+	for _, state := range to {
+		slot := common.HexToHash(state.Key)
+		value := common.HexToHash(state.Value)
+		if diff, ok := changes[slot]; ok {
+			if diff.From == value {
+				delete(changes, slot)
+				continue
+			}
+			diff.To = value
+		} else {
+			changes[slot] = &StorageDiff{Slot: slot, To: value}
+		}
+	}
+
+	diffs := make([]StorageDiff, 0, len(changes))
+	for _, diff := range changes {
+		diffs = append(diffs, *diff)
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return bytes.Compare(diffs[i].Slot.Bytes(), diffs[j].Slot.Bytes()) < 0
+	})
+	return diffs
+}
+
+// DecodeStorageDiffs sets the variables of the storage diffs stored in the slots
+// of the EIP-1967 proxies and, if given, in the statically assigned slots of the
+// storage layout. The variables of the mappings and dynamic arrays are stored in
+// hashed slots, so they aren't decoded.
+func DecodeStorageDiffs(diffs []StorageDiff, layout *StorageLayout) error {
+	entries := make(map[common.Hash][]StorageLayoutEntry)
+	if layout != nil {
+		for _, entry := range layout.Storage {
+			slot, ok := new(big.Int).SetString(entry.Slot, 10)
+			if !ok {
+				return fmt.Errorf("invalid slot %q of variable %s", entry.Slot, entry.Label)
+			}
+			key := common.BigToHash(slot)
+			entries[key] = append(entries[key], entry)
+		}
+	}
+
+	for i, diff := range diffs {
+		if label, ok := eip1967Slots[diff.Slot]; ok {
+			diffs[i].Variables = append(diffs[i].Variables, StorageVariableDiff{
+				Label: label,
+				Type:  "address",
+				From:  common.BytesToAddress(diff.From.Bytes()).Hex(),
+				To:    common.BytesToAddress(diff.To.Bytes()).Hex(),
+			})
+		}
+
+		for _, entry := range entries[diff.Slot] {
+			typ, ok := layout.Types[entry.Type]
+			if !ok {
+				return fmt.Errorf("unknown type %s of variable %s", entry.Type, entry.Label)
+			}
+			if typ.Encoding != "inplace" {
+				continue
+			}
+			size, err := strconv.ParseUint(typ.NumberOfBytes, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid size %q of type %s: %w", typ.NumberOfBytes, entry.Type, err)
+			}
+			// the variables spanning several slots, e.g. structs, are left raw
+			if size == 0 || entry.Offset+size > common.HashLength {
+				continue
+			}
+
+			from := decodeStorageValue(diff.From, entry.Offset, size, typ.Label)
+			to := decodeStorageValue(diff.To, entry.Offset, size, typ.Label)
+			if from == to {
+				continue
+			}
+			diffs[i].Variables = append(diffs[i].Variables, StorageVariableDiff{
+				Label: entry.Label,
+				Type:  typ.Label,
+				From:  from,
+				To:    to,
+			})
+		}
+	}
+
+	return nil
+}
+
+// decodeStorageValue decodes the value of the type with the given label stored
+// at the offset of the slot. The values are packed in the slot from its lowest
+// order bytes.
+func decodeStorageValue(slot common.Hash, offset, size uint64, label string) string {
+	end := common.HashLength - offset
+	bz := slot.Bytes()[end-size : end]
+
+	switch {
+	case label == "bool":
+		return strconv.FormatBool(bz[len(bz)-1] != 0)
+	case strings.HasPrefix(label, "address"), strings.HasPrefix(label, "contract "):
+		return common.BytesToAddress(bz).Hex()
+	case strings.HasPrefix(label, "uint"), strings.HasPrefix(label, "enum "):
+		return new(big.Int).SetBytes(bz).String()
+	case strings.HasPrefix(label, "int"):
+		value := new(big.Int).SetBytes(bz)
+		if bz[0]&0x80 != 0 {
+			value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(size*8)))
+		}
+		return value.String()
+	default:
+		return hexutil.Encode(bz)
+	}
+}
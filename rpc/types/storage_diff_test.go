@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestDiffStorage(t *testing.T) {
+	from := evmtypes.Storage{
+		evmtypes.NewState(common.HexToHash("0x03"), common.HexToHash("0x01")),
+		evmtypes.NewState(common.HexToHash("0x01"), common.HexToHash("0x01")),
+		evmtypes.NewState(common.HexToHash("0x02"), common.HexToHash("0x02")),
+	}
+	to := evmtypes.Storage{
+		evmtypes.NewState(common.HexToHash("0x01"), common.HexToHash("0x01")),
+		evmtypes.NewState(common.HexToHash("0x02"), common.HexToHash("0x05")),
+		evmtypes.NewState(common.HexToHash("0x04"), common.HexToHash("0x04")),
+	}
+
+	require.Equal(t, []StorageDiff{
+		{Slot: common.HexToHash("0x02"), From: common.HexToHash("0x02"), To: common.HexToHash("0x05")},
+		{Slot: common.HexToHash("0x03"), From: common.HexToHash("0x01")},
+		{Slot: common.HexToHash("0x04"), To: common.HexToHash("0x04")},
+	}, DiffStorage(from, to))
+	require.Empty(t, DiffStorage(from, from))
+}
+
+func TestDecodeStorageDiffs(t *testing.T) {
+	owner := common.HexToAddress("0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E")
+	implementation := common.HexToAddress("0xA2A8B87390F8F2D188242656BFb6852914073D06")
+
+	layout := &StorageLayout{
+		Storage: []StorageLayoutEntry{
+			{Label: "totalSupply", Offset: 0, Slot: "0", Type: "t_uint256"},
+			{Label: "owner", Offset: 0, Slot: "1", Type: "t_address"},
+			{Label: "paused", Offset: 20, Slot: "1", Type: "t_bool"},
+			{Label: "delta", Offset: 21, Slot: "1", Type: "t_int8"},
+			{Label: "balances", Offset: 0, Slot: "2", Type: "t_mapping(t_address,t_uint256)"},
+		},
+		Types: map[string]StorageLayoutType{
+			"t_uint256":                      {Encoding: "inplace", Label: "uint256", NumberOfBytes: "32"},
+			"t_address":                      {Encoding: "inplace", Label: "address", NumberOfBytes: "20"},
+			"t_bool":                         {Encoding: "inplace", Label: "bool", NumberOfBytes: "1"},
+			"t_int8":                         {Encoding: "inplace", Label: "int8", NumberOfBytes: "1"},
+			"t_mapping(t_address,t_uint256)": {Encoding: "mapping", Label: "mapping(address => uint256)", NumberOfBytes: "32"},
+		},
+	}
+
+	// owner is set, paused is unchanged and delta goes from 0 to -1
+	packed := common.Hash{}
+	copy(packed[12:], owner.Bytes())
+	packed[11] = 1
+	packed[10] = 0xff
+	unchanged := common.Hash{}
+	unchanged[11] = 1
+
+	diffs := []StorageDiff{
+		{Slot: common.HexToHash("0x00"), From: common.HexToHash("0x0a"), To: common.HexToHash("0x14")},
+		{Slot: common.HexToHash("0x01"), From: unchanged, To: packed},
+		{Slot: common.HexToHash("0x02"), To: common.HexToHash("0x01")},
+		{
+			Slot: common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"),
+			To:   common.BytesToHash(implementation.Bytes()),
+		},
+	}
+
+	require.NoError(t, DecodeStorageDiffs(diffs, layout))
+	require.Equal(t, []StorageVariableDiff{
+		{Label: "totalSupply", Type: "uint256", From: "10", To: "20"},
+	}, diffs[0].Variables)
+	require.Equal(t, []StorageVariableDiff{
+		{Label: "owner", Type: "address", From: common.Address{}.Hex(), To: owner.Hex()},
+		{Label: "delta", Type: "int8", From: "0", To: "-1"},
+	}, diffs[1].Variables)
+	require.Empty(t, diffs[2].Variables)
+	require.Equal(t, []StorageVariableDiff{
+		{Label: "eip1967.proxy.implementation", Type: "address", From: common.Address{}.Hex(), To: implementation.Hex()},
+	}, diffs[3].Variables)
+
+	layout.Storage[0].Slot = "0x00"
+	require.Error(t, DecodeStorageDiffs(diffs, layout))
+}
@@ -60,21 +60,11 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
-}
+type OverrideAccount = evmtypes.OverrideAccount
 
 type FeeHistoryResult struct {
 	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
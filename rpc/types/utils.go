@@ -60,23 +60,27 @@ func EthHeaderFromComet(header cmttypes.Header, bloom ethtypes.Bloom, baseFee *b
 	}
 
 	time := uint64(header.Time.UTC().Unix()) //nolint:gosec // G115 // won't exceed uint64
+	// blob transactions (EIP-4844) are not supported, so no blob gas is used
+	blobGasUsed, excessBlobGas := uint64(0), uint64(0)
 	return &ethtypes.Header{
-		ParentHash:  common.BytesToHash(header.LastBlockID.Hash.Bytes()),
-		UncleHash:   ethtypes.EmptyUncleHash,
-		Coinbase:    common.BytesToAddress(header.ProposerAddress),
-		Root:        common.BytesToHash(header.AppHash),
-		TxHash:      txHash,
-		ReceiptHash: ethtypes.EmptyRootHash,
-		Bloom:       bloom,
-		Difficulty:  big.NewInt(0),
-		Number:      big.NewInt(header.Height),
-		GasLimit:    0,
-		GasUsed:     0,
-		Time:        time,
-		Extra:       []byte{},
-		MixDigest:   common.Hash{},
-		Nonce:       ethtypes.BlockNonce{},
-		BaseFee:     baseFee,
+		ParentHash:    common.BytesToHash(header.LastBlockID.Hash.Bytes()),
+		UncleHash:     ethtypes.EmptyUncleHash,
+		Coinbase:      common.BytesToAddress(header.ProposerAddress),
+		Root:          common.BytesToHash(header.AppHash),
+		TxHash:        txHash,
+		ReceiptHash:   ethtypes.EmptyRootHash,
+		Bloom:         bloom,
+		Difficulty:    big.NewInt(0),
+		Number:        big.NewInt(header.Height),
+		GasLimit:      0,
+		GasUsed:       0,
+		Time:          time,
+		Extra:         []byte{},
+		MixDigest:     common.Hash{},
+		Nonce:         ethtypes.BlockNonce{},
+		BaseFee:       baseFee,
+		BlobGasUsed:   &blobGasUsed,
+		ExcessBlobGas: &excessBlobGas,
 	}
 }
 
@@ -139,6 +143,10 @@ func FormatBlock(
 		"uncles":          []common.Hash{},
 		"transactions":    transactions,
 		"totalDifficulty": (*hexutil.Big)(big.NewInt(0)),
+
+		// blob transactions (EIP-4844) are not supported
+		"blobGasUsed":   hexutil.Uint64(0),
+		"excessBlobGas": hexutil.Uint64(0),
 	}
 
 	if baseFee != nil {
@@ -209,12 +217,16 @@ func NewRPCTransaction(
 	switch tx.Type() {
 	case ethtypes.AccessListTxType:
 		al := tx.AccessList()
+		yparity := hexutil.Uint64(v.Sign()) //nolint:gosec
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
+		result.YParity = &yparity
 	case ethtypes.DynamicFeeTxType:
 		al := tx.AccessList()
+		yparity := hexutil.Uint64(v.Sign()) //nolint:gosec
 		result.Accesses = &al
 		result.ChainID = (*hexutil.Big)(tx.ChainId())
+		result.YParity = &yparity
 		result.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
 		result.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
 		// if the transaction has been mined, compute the effective gas price
@@ -319,6 +331,20 @@ func CheckTxFee(gasPrice *big.Int, gas uint64, minCap float64) error {
 	return nil
 }
 
+// CheckRawTxFee checks whether the fee of a raw transaction (gas fee cap * gas
+// limit), in the EVM base denom, is within the given cap. A nil cap disables
+// the check.
+func CheckRawTxFee(gasFeeCap *big.Int, gas uint64, feeCap *big.Int, denom string) error {
+	if feeCap == nil || feeCap.Sign() == 0 {
+		return nil
+	}
+	fee := new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gas))
+	if fee.Cmp(feeCap) > 0 {
+		return fmt.Errorf("tx fee (%s%s) exceeds the configured cap (%s%s)", fee, denom, feeCap, denom)
+	}
+	return nil
+}
+
 // TxExceedBlockGasLimit returns true if the tx exceeds block gas limit.
 func TxExceedBlockGasLimit(res *abci.ExecTxResult) bool {
 	return strings.Contains(res.Log, ExceedBlockGasLimitError)
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func TestNewRPCTransactionAccessList(t *testing.T) {
+	chainID := big.NewInt(9001)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	accessList := ethtypes.AccessList{
+		{Address: to, StorageKeys: []common.Hash{common.HexToHash("0x01")}},
+	}
+
+	testCases := []struct {
+		name   string
+		txData ethtypes.TxData
+	}{
+		{
+			"access list tx",
+			&ethtypes.AccessListTx{
+				ChainID:    chainID,
+				To:         &to,
+				Gas:        50_000,
+				GasPrice:   big.NewInt(1),
+				AccessList: accessList,
+			},
+		},
+		{
+			"dynamic fee tx",
+			&ethtypes.DynamicFeeTx{
+				ChainID:    chainID,
+				To:         &to,
+				Gas:        50_000,
+				GasFeeCap:  big.NewInt(1),
+				GasTipCap:  big.NewInt(1),
+				AccessList: accessList,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), tc.txData)
+			require.NoError(t, err)
+
+			msg := &evmtypes.MsgEthereumTx{}
+			msg.FromEthereumTx(tx)
+
+			rpcTx, err := NewRPCTransaction(msg, common.Hash{}, 0, 0, nil, chainID)
+			require.NoError(t, err)
+
+			require.Equal(t, hexutil.Uint64(tx.Type()), rpcTx.Type)
+			require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+			require.NotNil(t, rpcTx.Accesses)
+			require.Equal(t, accessList, *rpcTx.Accesses)
+			require.Equal(t, chainID, rpcTx.ChainID.ToInt())
+
+			v, _, _ := tx.RawSignatureValues()
+			require.NotNil(t, rpcTx.YParity)
+			require.Equal(t, hexutil.Uint64(v.Uint64()), *rpcTx.YParity)
+		})
+	}
+}
+
+func TestCheckRawTxFee(t *testing.T) {
+	gasFeeCap := big.NewInt(1_000_000_000)
+
+	require.NoError(t, CheckRawTxFee(gasFeeCap, 21_000, nil, "aatom"))
+	require.NoError(t, CheckRawTxFee(gasFeeCap, 21_000, big.NewInt(0), "aatom"))
+	require.NoError(t, CheckRawTxFee(gasFeeCap, 21_000, big.NewInt(21_000_000_000_000), "aatom"))
+
+	err := CheckRawTxFee(gasFeeCap, 21_001, big.NewInt(21_000_000_000_000), "aatom")
+	require.ErrorContains(t, err, "tx fee (21001000000000aatom) exceeds the configured cap (21000000000000aatom)")
+}
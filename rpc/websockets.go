@@ -57,6 +57,17 @@ type SubscriptionResult struct {
 	Result       interface{} `json:"result"`
 }
 
+// droppedTxStatus is the status reported for pending transactions dropped in
+// favour of a same nonce replacement.
+const droppedTxStatus = "dropped"
+
+// DroppedTxResult is the result notified to the newPendingTransactions subscribers
+// when a pending transaction is replaced.
+type DroppedTxResult struct {
+	stream.DroppedTx
+	Status string `json:"status"`
+}
+
 type ErrorResponseJSON struct {
 	Jsonrpc string            `json:"jsonrpc"`
 	Error   *ErrorMessageJSON `json:"error"`
@@ -80,15 +91,16 @@ type websocketsServer struct {
 
 func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
-	return &websocketsServer{
+	s := &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
-		api:            newPubSubAPI(clientCtx, logger, stream),
 		logger:         logger,
 	}
+	s.api = newPubSubAPI(clientCtx, logger, stream, s.call, int64(cfg.JSONRPC.BlockRangeCap))
+	return s
 }
 
 func (s *websocketsServer) Start() {
@@ -308,7 +320,7 @@ readLoop:
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			unsubFn, start, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -325,6 +337,11 @@ readLoop:
 				s.logger.Error("error writing subscription response", "error", err.Error())
 				break readLoop
 			}
+
+			// the notifications are only sent once the subscription ID is returned
+			if start != nil {
+				start()
+			}
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
@@ -410,45 +427,105 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	return wsConn.WriteJSON(wsSend)
 }
 
+// call calls a method of the JSON-RPC server and decodes its result.
+func (s *websocketsServer) call(ctx context.Context, result interface{}, method string, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	mb, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
+	if err != nil {
+		return errors.Wrap(err, "Could not build request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "Could not perform request")
+	}
+
+	defer resp.Body.Close()
+
+	var res struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return errors.Wrap(err, "failed to unmarshal rest-server response")
+	}
+	if res.Error != nil {
+		return errors.New(res.Error.Message)
+	}
+
+	return json.Unmarshal(res.Result, result)
+}
+
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *stream.RPCStream
 	logger    log.Logger
 	clientCtx client.Context
+	// call calls the JSON-RPC server to replay the historical logs
+	call rpcCaller
+	// replayRange is the number of blocks whose logs are queried at once when
+	// replaying the historical logs
+	replayRange int64
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, call rpcCaller, replayRange int64) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    stream,
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:      stream,
+		logger:      logger,
+		clientCtx:   clientCtx,
+		call:        call,
+		replayRange: replayRange,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (context.CancelFunc, error) {
+// subscribe creates the subscription and returns the function canceling it. It
+// also returns the function starting the notifications, if they must wait for
+// the subscription ID to be returned to the client.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (context.CancelFunc, func(), error) {
 	method, ok := params[0].(string)
 	if !ok {
-		return nil, errors.New("invalid parameters")
+		return nil, nil, errors.New("invalid parameters")
 	}
 
+	var (
+		cancel context.CancelFunc
+		err    error
+	)
 	switch method {
 	case "newHeads":
 		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
+		cancel, err = api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
 			return api.subscribeLogs(wsConn, subID, params[1])
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		cancel, err = api.subscribePendingTransactions(wsConn, subID)
 	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
+		cancel, err = api.subscribeSyncing(wsConn, subID)
 	default:
-		return nil, errors.Errorf("unsupported method %s", method)
+		err = errors.Errorf("unsupported method %s", method)
 	}
+	return cancel, nil, err
 }
 
 func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
@@ -500,15 +577,26 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, error) {
-	crit := filters.FilterCriteria{}
+// subscribeLogs subscribes to the logs matching the criteria. If the criteria
+// set a past fromBlock, the historical logs are replayed from it before the new
+// ones are notified.
+func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (context.CancelFunc, func(), error) {
+	var (
+		crit       filters.FilterCriteria
+		replayFrom *uint64
+	)
 
 	if extra != nil {
 		params, ok := extra.(map[string]interface{})
 		if !ok {
 			err := errors.New("invalid criteria")
 			api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
-			return nil, err
+			return nil, nil, err
+		}
+
+		var err error
+		if replayFrom, err = parseReplayFromBlock(params["fromBlock"]); err != nil {
+			return nil, nil, err
 		}
 
 		if params["address"] != nil {
@@ -519,13 +607,13 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				for _, addr := range address {
 					address, ok := addr.(string)
 					if !ok {
-						return nil, errors.New("invalid address")
+						return nil, nil, errors.New("invalid address")
 					}
 
 					crit.Addresses = append(crit.Addresses, common.HexToAddress(address))
 				}
 			default:
-				return nil, errors.New("invalid addresses; must be address or array of addresses")
+				return nil, nil, errors.New("invalid addresses; must be address or array of addresses")
 			}
 		}
 
@@ -534,7 +622,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			if !ok {
 				err := errors.Errorf("invalid topics: %s", topics)
 				api.logger.Error("invalid topics", "type", fmt.Sprintf("%T", topics))
-				return nil, err
+				return nil, nil, err
 			}
 
 			crit.Topics = make([][]common.Hash, len(topics))
@@ -559,7 +647,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				// in case we don't have list, but a single topic value
 				if topic, ok := subtopics.(string); ok {
 					if err := addCritTopic(topicIdx, topic); err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 
 					continue
@@ -570,7 +658,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				if !ok {
 					err := errors.New("invalid subtopics")
 					api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopics))
-					return nil, err
+					return nil, nil, err
 				}
 
 				subtopicsCollect := make([]common.Hash, len(subtopicsList))
@@ -579,7 +667,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					if !ok {
 						err := errors.Errorf("invalid subtopic: %s", subtopic)
 						api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopic))
-						return nil, err
+						return nil, nil, err
 					}
 
 					subtopicsCollect[idx] = common.HexToHash(tstr)
@@ -590,60 +678,137 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 	}
 
+	notify := func(result interface{}) error {
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		err := wsConn.WriteJSON(res)
+		if err != nil {
+			api.logger.Error("error writing header, will drop peer", "error", err.Error())
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+		return err
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
-	//nolint: errcheck
-	go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
-		logs := rpcfilters.FilterLogs(txLogs, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-		if len(logs) == 0 {
+	if replayFrom == nil {
+		//nolint: errcheck
+		go api.events.LogStream().Subscribe(ctx, func(txLogs []*ethtypes.Log, _ int) error {
+			for _, ethLog := range rpcfilters.FilterLogs(txLogs, nil, nil, crit.Addresses, crit.Topics) {
+				if err := notify(ethLog); err != nil {
+					return err
+				}
+			}
 			return nil
-		}
+		})
+
+		return cancel, nil, nil
+	}
+
+	// the new logs are read from the current end of the stream once the
+	// historical ones are replayed, skipping the ones already replayed
+	logStream := api.events.LogStream()
+	_, offset := logStream.ReadNonBlocking(-1)
 
-		for _, ethLog := range logs {
+	start := func() {
+		go func() {
+			replayedTo, err := api.replayLogs(ctx, crit, *replayFrom, func(ethLog json.RawMessage) error {
+				return notify(ethLog)
+			})
+			if err != nil {
+				if ctx.Err() == nil {
+					api.logger.Debug("failed to replay logs", "subscription", subID, "error", err.Error())
+					_ = wsConn.WriteJSON(&ErrorResponseJSON{ // #nosec G703
+						Jsonrpc: "2.0",
+						Error: &ErrorMessageJSON{
+							Code:    big.NewInt(-32000),
+							Message: fmt.Sprintf("failed to replay the logs of subscription %s: %s", subID, err),
+						},
+					})
+				}
+				cancel()
+				return
+			}
+
+			for {
+				var txLogs []*ethtypes.Log
+				txLogs, offset = logStream.ReadBlocking(ctx, offset)
+				if len(txLogs) == 0 {
+					// canceled
+					return
+				}
+
+				for _, ethLog := range rpcfilters.FilterLogs(txLogs, nil, nil, crit.Addresses, crit.Topics) {
+					if ethLog.BlockNumber <= replayedTo {
+						continue
+					}
+					if err := notify(ethLog); err != nil {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	return cancel, start, nil
+}
+
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	//nolint: errcheck
+	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
+		for _, hash := range items {
+			// write to ws conn
 			res := &SubscriptionNotification{
 				Jsonrpc: "2.0",
 				Method:  "eth_subscription",
 				Params: &SubscriptionResult{
 					Subscription: subID,
-					Result:       ethLog,
+					Result:       hash,
 				},
 			}
 
 			err := wsConn.WriteJSON(res)
 			if err != nil {
-				api.logger.Error("error writing header, will drop peer", "error", err.Error())
+				api.logger.Debug("error writing header, will drop peer", "error", err.Error())
+
 				try(func() {
 					if err != websocket.ErrCloseSent {
 						_ = wsConn.Close()
 					}
 				}, api.logger, "closing websocket peer sub")
-
 				return err
 			}
 		}
 		return nil
 	})
 
-	return cancel, nil
-}
-
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (context.CancelFunc, error) {
-	ctx, cancel := context.WithCancel(context.Background())
+	// notify the pending transactions dropped in favour of a same nonce replacement
 	//nolint: errcheck
-	go api.events.PendingTxStream().Subscribe(ctx, func(items []common.Hash, _ int) error {
-		for _, hash := range items {
-			// write to ws conn
+	go api.events.DroppedTxStream().Subscribe(ctx, func(items []stream.DroppedTx, _ int) error {
+		for _, droppedTx := range items {
 			res := &SubscriptionNotification{
 				Jsonrpc: "2.0",
 				Method:  "eth_subscription",
 				Params: &SubscriptionResult{
 					Subscription: subID,
-					Result:       hash,
+					Result:       &DroppedTxResult{DroppedTx: droppedTx, Status: droppedTxStatus},
 				},
 			}
 
 			err := wsConn.WriteJSON(res)
 			if err != nil {
-				api.logger.Debug("error writing header, will drop peer", "error", err.Error())
+				api.logger.Debug("error writing dropped tx, will drop peer", "error", err.Error())
 
 				try(func() {
 					if err != websocket.ErrCloseSent {
@@ -31,7 +31,7 @@ func newTestWebsocketServer() *websocketsServer {
 		wsAddr:         cfg.JSONRPC.WsAddress,
 		certFile:       cfg.TLS.CertificatePath,
 		keyFile:        cfg.TLS.KeyPath,
-		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}),
+		api:            newPubSubAPI(client.Context{}, log.NewNopLogger(), &stream.RPCStream{}, nil, 0),
 		logger:         log.NewNopLogger(),
 		allowedOrigins: []string{"*"},
 	}
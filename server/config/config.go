@@ -3,10 +3,13 @@ package config
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"net"
 	"net/netip"
 	"path"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
 	"github.com/cometbft/cometbft/libs/strings"
@@ -52,6 +55,9 @@ const (
 	// DefaultJsonRPCMetricsAddress is the default address the JSON-RPC Metrics server binds to.
 	DefaultJSONRPCMetricsAddress = "127.0.0.1:6065"
 
+	// DefaultJSONRPCAdminAddress is the default address the JSON-RPC admin namespace server binds to.
+	DefaultJSONRPCAdminAddress = "127.0.0.1:8547"
+
 	// DefaultEVMTracer is the default vm.Tracer type
 	DefaultEVMTracer = ""
 
@@ -61,12 +67,56 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultRejectOversizedTxs is the default for rejecting in check tx mode the eth txs whose
+	// gas limit exceeds the max tx gas wanted
+	DefaultRejectOversizedTxs = false
+
 	// DefaultEVMChainID is the default EVM Chain ID if one is not provided
 	DefaultEVMChainID = 262144
 
 	// DefaultEVMMinTip is the default minimum priority fee for the mempool
 	DefaultEVMMinTip = 0
 
+	// DefaultEVMMempoolPriceBump is the default minimum price bump percentage
+	// required to replace a pending EVM transaction with the same nonce
+	DefaultEVMMempoolPriceBump = 10
+
+	// DefaultEVMMempoolJournal is the default file, relative to the node data
+	// directory, used to journal the local EVM transactions
+	DefaultEVMMempoolJournal = "transactions.rlp"
+
+	// DefaultEVMMempoolRejournal is the default interval to regenerate the local
+	// EVM transactions journal
+	DefaultEVMMempoolRejournal = time.Hour
+
+	// DefaultEVMMempoolPriceLimit is the default minimum gas tip required for the
+	// acceptance of EVM transactions in the mempool
+	DefaultEVMMempoolPriceLimit = 0
+
+	// DefaultEVMMempoolAccountSlots is the default number of executable EVM
+	// transactions guaranteed per account in the mempool
+	DefaultEVMMempoolAccountSlots = 16
+
+	// DefaultEVMMempoolGlobalSlots is the default maximum number of executable EVM
+	// transactions for all accounts in the mempool
+	DefaultEVMMempoolGlobalSlots = 4096 + 1024
+
+	// DefaultEVMMempoolAccountQueue is the default maximum number of non-executable
+	// EVM transactions permitted per account in the mempool
+	DefaultEVMMempoolAccountQueue = 64
+
+	// DefaultEVMMempoolGlobalQueue is the default maximum number of non-executable
+	// EVM transactions for all accounts in the mempool
+	DefaultEVMMempoolGlobalQueue = 1024
+
+	// DefaultEVMMempoolLifetime is the default maximum amount of time non-executable
+	// EVM transactions are queued in the mempool
+	DefaultEVMMempoolLifetime = 3 * time.Hour
+
+	// DefaultEVMMempoolSenderGasQuota is the default maximum percentage of the block
+	// gas limit the EVM transactions of a single sender can occupy, 0 disables it
+	DefaultEVMMempoolSenderGasQuota = 0
+
 	// DefaultGethMetricsAddress is the default port for the geth metrics server.
 	DefaultGethMetricsAddress = "127.0.0.1:8100"
 
@@ -76,6 +126,15 @@ const (
 	// DefaultJSONRPCAllowInsecureUnlock is true
 	DefaultJSONRPCAllowInsecureUnlock bool = true
 
+	// DefaultReadQueueSize is the default number of read requests waiting for a JSON-RPC worker
+	DefaultReadQueueSize = 1000
+
+	// DefaultCallQueueSize is the default number of eth_call/estimateGas requests waiting for a JSON-RPC worker
+	DefaultCallQueueSize = 100
+
+	// DefaultTraceQueueSize is the default number of debug_trace* requests waiting for a JSON-RPC worker
+	DefaultTraceQueueSize = 10
+
 	// DefaultFilterCap is the default cap for total number of filters that can be created
 	DefaultFilterCap int32 = 200
 
@@ -122,9 +181,24 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// IndexerBackendKV stores the indexed EVM txs in a local KV db
+	IndexerBackendKV = "kv"
+
+	// IndexerBackendPSQL stores the indexed EVM txs and logs in a PostgreSQL database
+	IndexerBackendPSQL = "psql"
+
+	// DefaultIndexerBackend is the default backend of the EVM tx indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// DefaultIndexerPruneInterval is the default interval between two prunings of the EVM tx indexer
+	DefaultIndexerPruneInterval = 10 * time.Minute
 )
 
-var evmTracers = []string{"json", "markdown", "struct", "access_list"}
+var (
+	evmTracers      = []string{"json", "markdown", "struct", "access_list"}
+	indexerBackends = []string{IndexerBackendKV, IndexerBackendPSQL}
+)
 
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
@@ -143,14 +217,56 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// RejectOversizedTxs rejects in check tx mode the eth txs whose gas limit exceeds
+	// MaxTxGasWanted instead of capping their gas wanted to it.
+	RejectOversizedTxs bool `mapstructure:"reject-oversized-txs"`
 	// Enables tracking of SHA3 preimages in the VM
 	EnablePreimageRecording bool `mapstructure:"cache-preimage"`
 	// EVMChainID defines the EIP-155 replay-protection chain ID.
 	EVMChainID uint64 `mapstructure:"evm-chain-id"`
 	// MinTip defines the minimum priority fee for the mempool
 	MinTip uint64 `mapstructure:"min-tip"`
+	// MempoolPriceBump defines the minimum price bump percentage required to replace
+	// an EVM transaction with the same nonce in the mempool
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolJournal defines the file used to journal the EVM transactions submitted
+	// to this node, so they survive node restarts. Empty disables the journal
+	MempoolJournal string `mapstructure:"mempool-journal"`
+	// MempoolRejournal defines the interval to regenerate the local transactions journal
+	MempoolRejournal time.Duration `mapstructure:"mempool-rejournal"`
+	// MempoolPriceLimit defines the minimum gas tip required for an EVM transaction to be
+	// accepted in the mempool
+	MempoolPriceLimit uint64 `mapstructure:"mempool-price-limit"`
+	// MempoolAccountSlots defines the number of executable EVM transactions guaranteed per account
+	MempoolAccountSlots uint64 `mapstructure:"mempool-account-slots"`
+	// MempoolGlobalSlots defines the maximum number of executable EVM transactions for all accounts
+	MempoolGlobalSlots uint64 `mapstructure:"mempool-global-slots"`
+	// MempoolAccountQueue defines the maximum number of non-executable EVM transactions per account
+	MempoolAccountQueue uint64 `mapstructure:"mempool-account-queue"`
+	// MempoolGlobalQueue defines the maximum number of non-executable EVM transactions for all accounts
+	MempoolGlobalQueue uint64 `mapstructure:"mempool-global-queue"`
+	// MempoolLifetime defines the maximum amount of time non-executable EVM transactions are queued
+	MempoolLifetime time.Duration `mapstructure:"mempool-lifetime"`
+	// MempoolSenderGasQuota defines the maximum percentage of the block gas limit the EVM
+	// transactions of a single sender can occupy in a block proposal. 0 disables the quota
+	MempoolSenderGasQuota uint64 `mapstructure:"mempool-sender-gas-quota"`
+	// MempoolSenderGasQuotaAllowlist defines the hex addresses of the senders exempted from
+	// the sender gas quota
+	MempoolSenderGasQuotaAllowlist []string `mapstructure:"mempool-sender-gas-quota-allowlist"`
 	// GethMetricsAddress is the address the geth metrics server will bind to. Default 127.0.0.1:8100
 	GethMetricsAddress string `mapstructure:"geth-metrics-address"`
+	// StreamingFile defines the file, relative to the node data directory, the committed
+	// Ethereum-formatted blocks and receipts are streamed to. Empty disables it.
+	StreamingFile string `mapstructure:"streaming-file"`
+	// QueryCacheCodeSize defines the number of contract codes cached for the queries. 0 disables the cache
+	QueryCacheCodeSize uint64 `mapstructure:"query-cache-code-size"`
+	// QueryCacheAccountSize defines the number of account records cached for the queries. 0 disables the cache
+	QueryCacheAccountSize uint64 `mapstructure:"query-cache-account-size"`
+	// QueryCacheStorageSize defines the number of storage slots cached for the queries. 0 disables the cache
+	QueryCacheStorageSize uint64 `mapstructure:"query-cache-storage-size"`
+	// ParallelExecutionWorkers defines the number of workers prefetching the EVM transactions of
+	// the finalized blocks concurrently. 0 disables the parallel execution
+	ParallelExecutionWorkers uint64 `mapstructure:"parallel-execution-workers"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -169,6 +285,33 @@ type JSONRPCConfig struct {
 	EVMTimeout time.Duration `mapstructure:"evm-timeout"`
 	// TxFeeCap is the global tx-fee cap for send transaction
 	TxFeeCap float64 `mapstructure:"txfee-cap"`
+	// CallGasCap is the gas cap for eth_call. 0 uses the global gas cap.
+	CallGasCap uint64 `mapstructure:"call-gas-cap"`
+	// EstimateGasCap is the gas cap for eth_estimateGas. 0 uses the global gas cap.
+	EstimateGasCap uint64 `mapstructure:"estimate-gas-cap"`
+	// TraceTimeout is the timeout of the debug_trace* methods, capping the timeout
+	// requested by the callers. 0 keeps the requested timeouts.
+	TraceTimeout time.Duration `mapstructure:"trace-timeout"`
+	// RawTxFeeCap is the fee cap, as an integer amount of the EVM base denom, of the
+	// transactions submitted with eth_sendRawTransaction. Empty or 0 disables the cap.
+	RawTxFeeCap string `mapstructure:"raw-txfee-cap"`
+	// ReadConcurrency is the maximum number of cheap read requests served concurrently. 0 disables the limit.
+	ReadConcurrency int `mapstructure:"read-concurrency"`
+	// ReadQueueSize is the maximum number of cheap read requests waiting for a worker when
+	// ReadConcurrency is reached. The requests received when the queue is full are rejected.
+	ReadQueueSize int `mapstructure:"read-queue-size"`
+	// CallConcurrency is the maximum number of eth_call, eth_estimateGas and eth_createAccessList
+	// requests served concurrently. 0 disables the limit.
+	CallConcurrency int `mapstructure:"call-concurrency"`
+	// CallQueueSize is the maximum number of eth_call, eth_estimateGas and eth_createAccessList
+	// requests waiting for a worker when CallConcurrency is reached.
+	CallQueueSize int `mapstructure:"call-queue-size"`
+	// TraceConcurrency is the maximum number of debug_trace* requests served concurrently. 0
+	// disables the limit.
+	TraceConcurrency int `mapstructure:"trace-concurrency"`
+	// TraceQueueSize is the maximum number of debug_trace* requests waiting for a worker when
+	// TraceConcurrency is reached.
+	TraceQueueSize int `mapstructure:"trace-queue-size"`
 	// FilterCap is the global cap for total number of filters that can be created.
 	FilterCap int32 `mapstructure:"filter-cap"`
 	// FeeHistoryCap is the global cap for total number of blocks that can be fetched
@@ -195,12 +338,33 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackend defines the backend of the custom indexer service (kv|psql).
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerPSQLConn defines the PostgreSQL connection string used by the psql indexer backend.
+	IndexerPSQLConn string `mapstructure:"indexer-psql-conn"`
+	// IndexerSinkFile defines the file, relative to the node data directory, the indexed
+	// EVM txs are streamed to as JSON lines. Empty disables it.
+	IndexerSinkFile string `mapstructure:"indexer-sink-file"`
+	// IndexerRetainBlocks defines the number of latest blocks retained by the indexer. 0 keeps all of them.
+	IndexerRetainBlocks uint64 `mapstructure:"indexer-retain-blocks"`
+	// IndexerRetainDuration defines how long the indexed blocks are retained. 0 keeps all of them.
+	IndexerRetainDuration time.Duration `mapstructure:"indexer-retain-duration"`
+	// IndexerPruneInterval defines the interval between two prunings of the indexer.
+	IndexerPruneInterval time.Duration `mapstructure:"indexer-prune-interval"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// AdminAddress defines the loopback address the `admin` namespace is served on. The `admin`
+	// namespace is never served on the HTTP and WebSocket servers. Empty disables it
+	AdminAddress string `mapstructure:"admin-address"`
+	// AdminIPCPath defines the unix socket path the `admin` namespace is served on. Empty disables it
+	AdminIPCPath string `mapstructure:"admin-ipc-path"`
+	// AdminAuthTokenFile defines the file holding the bearer token required by the `admin`
+	// namespace server listening on AdminAddress
+	AdminAuthTokenFile string `mapstructure:"admin-auth-token-file"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -216,9 +380,20 @@ func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
 		Tracer:                  DefaultEVMTracer,
 		MaxTxGasWanted:          DefaultMaxTxGasWanted,
+		RejectOversizedTxs:      DefaultRejectOversizedTxs,
 		EVMChainID:              DefaultEVMChainID,
 		EnablePreimageRecording: DefaultEnablePreimageRecording,
 		MinTip:                  DefaultEVMMinTip,
+		MempoolPriceBump:        DefaultEVMMempoolPriceBump,
+		MempoolJournal:          DefaultEVMMempoolJournal,
+		MempoolRejournal:        DefaultEVMMempoolRejournal,
+		MempoolPriceLimit:       DefaultEVMMempoolPriceLimit,
+		MempoolAccountSlots:     DefaultEVMMempoolAccountSlots,
+		MempoolGlobalSlots:      DefaultEVMMempoolGlobalSlots,
+		MempoolAccountQueue:     DefaultEVMMempoolAccountQueue,
+		MempoolGlobalQueue:      DefaultEVMMempoolGlobalQueue,
+		MempoolLifetime:         DefaultEVMMempoolLifetime,
+		MempoolSenderGasQuota:   DefaultEVMMempoolSenderGasQuota,
 		GethMetricsAddress:      DefaultGethMetricsAddress,
 	}
 }
@@ -229,6 +404,24 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolRejournal < 0 {
+		return errors.New("EVM mempool rejournal interval cannot be negative")
+	}
+
+	if c.MempoolLifetime < 0 {
+		return errors.New("EVM mempool lifetime cannot be negative")
+	}
+
+	if c.MempoolSenderGasQuota > 100 {
+		return fmt.Errorf("EVM mempool sender gas quota must be a percentage, got %d", c.MempoolSenderGasQuota)
+	}
+
+	for _, addr := range c.MempoolSenderGasQuotaAllowlist {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid EVM mempool sender gas quota allowlist address %q", addr)
+		}
+	}
+
 	if _, err := netip.ParseAddrPort(c.GethMetricsAddress); err != nil {
 		return fmt.Errorf("invalid geth metrics address %q: %w", c.GethMetricsAddress, err)
 	}
@@ -243,7 +436,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "admin", "evm"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -262,6 +455,9 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowInsecureUnlock:  DefaultJSONRPCAllowInsecureUnlock,
 		EVMTimeout:           DefaultEVMTimeout,
 		TxFeeCap:             DefaultTxFeeCap,
+		ReadQueueSize:        DefaultReadQueueSize,
+		CallQueueSize:        DefaultCallQueueSize,
+		TraceQueueSize:       DefaultTraceQueueSize,
 		FilterCap:            DefaultFilterCap,
 		FeeHistoryCap:        DefaultFeeHistoryCap,
 		BlockRangeCap:        DefaultBlockRangeCap,
@@ -273,9 +469,12 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
 		MaxOpenConnections:   DefaultMaxOpenConnections,
 		EnableIndexer:        false,
+		IndexerBackend:       DefaultIndexerBackend,
+		IndexerPruneInterval: DefaultIndexerPruneInterval,
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		AdminAddress:         DefaultJSONRPCAdminAddress,
 	}
 }
 
@@ -301,6 +500,24 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC EVM timeout duration cannot be negative")
 	}
 
+	if c.TraceTimeout < 0 {
+		return errors.New("JSON-RPC trace timeout duration cannot be negative")
+	}
+
+	if c.ReadConcurrency < 0 || c.CallConcurrency < 0 || c.TraceConcurrency < 0 {
+		return errors.New("JSON-RPC concurrency limits cannot be negative")
+	}
+
+	if c.ReadQueueSize < 0 || c.CallQueueSize < 0 || c.TraceQueueSize < 0 {
+		return errors.New("JSON-RPC queue sizes cannot be negative")
+	}
+
+	if c.RawTxFeeCap != "" {
+		if feeCap, ok := new(big.Int).SetString(c.RawTxFeeCap, 10); !ok || feeCap.Sign() < 0 {
+			return fmt.Errorf("JSON-RPC raw tx fee cap %q is not a non-negative integer", c.RawTxFeeCap)
+		}
+	}
+
 	if c.LogsCap < 0 {
 		return errors.New("JSON-RPC logs cap cannot be negative")
 	}
@@ -325,6 +542,38 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	if c.IndexerBackend != "" && !strings.StringInSlice(c.IndexerBackend, indexerBackends) {
+		return fmt.Errorf("invalid indexer backend %s, available backends: %v", c.IndexerBackend, indexerBackends)
+	}
+
+	if c.IndexerBackend == IndexerBackendPSQL && c.IndexerPSQLConn == "" {
+		return errors.New("JSON-RPC psql indexer backend requires a connection string")
+	}
+
+	if c.IndexerRetainDuration < 0 {
+		return errors.New("JSON-RPC indexer retain duration cannot be negative")
+	}
+
+	if c.IndexerPruneInterval < 0 {
+		return errors.New("JSON-RPC indexer prune interval cannot be negative")
+	}
+
+	if c.AdminAddress != "" {
+		if err := validateLoopbackAddress(c.AdminAddress); err != nil {
+			return fmt.Errorf("invalid JSON-RPC admin address: %w", err)
+		}
+	}
+
+	if c.Enable && strings.StringInSlice("admin", c.API) {
+		if c.AdminAddress == "" && c.AdminIPCPath == "" {
+			return errors.New("JSON-RPC admin namespace requires an admin address or an admin IPC path")
+		}
+
+		if c.AdminAddress != "" && c.AdminAuthTokenFile == "" {
+			return errors.New("JSON-RPC admin address requires an admin auth token file")
+		}
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -338,6 +587,25 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// validateLoopbackAddress returns an error if the given address doesn't bind to
+// a loopback interface.
+func validateLoopbackAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("%s is not a loopback address", address)
+	}
+
+	return nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
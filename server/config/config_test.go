@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,43 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal JSONRPC indexer backend",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.indexer-backend", "psql")
+				v.Set("json-rpc.indexer-psql-conn", "postgresql://localhost:5432/evm")
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				require.Equal(t, serverconfig.IndexerBackendKV, cfg.JSONRPC.IndexerBackend)
+				cfg.JSONRPC.IndexerBackend = serverconfig.IndexerBackendPSQL
+				cfg.JSONRPC.IndexerPSQLConn = "postgresql://localhost:5432/evm"
+				return *cfg
+			},
+			false,
+		},
+		{
+			"test unmarshal JSONRPC method class caps",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.call-gas-cap", 10_000_000)
+				v.Set("json-rpc.estimate-gas-cap", 20_000_000)
+				v.Set("json-rpc.trace-timeout", "10s")
+				v.Set("json-rpc.raw-txfee-cap", "1000000000000000000")
+				return v
+			},
+			func() serverconfig.Config {
+				cfg := serverconfig.DefaultConfig()
+				cfg.JSONRPC.CallGasCap = 10_000_000
+				cfg.JSONRPC.EstimateGasCap = 20_000_000
+				cfg.JSONRPC.TraceTimeout = 10 * time.Second
+				cfg.JSONRPC.RawTxFeeCap = "1000000000000000000"
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -63,9 +101,65 @@ func TestGetConfig(t *testing.T) {
 				t.Errorf("GetConfig() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			require.NoError(t, got.JSONRPC.Validate())
 			if !reflect.DeepEqual(got, tt.want()) {
 				t.Errorf("GetConfig() got = %v, want %v", got, tt.want())
 			}
 		})
 	}
 }
+
+func TestJSONRPCConfigValidateRawTxFeeCap(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	for _, feeCap := range []string{"", "0", "1000000000000000000"} {
+		cfg.RawTxFeeCap = feeCap
+		require.NoError(t, cfg.Validate())
+	}
+	for _, feeCap := range []string{"-1", "1.5", "1eth"} {
+		cfg.RawTxFeeCap = feeCap
+		require.Error(t, cfg.Validate())
+	}
+}
+
+func TestJSONRPCConfigValidateAdmin(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	cfg.Enable = true
+	cfg.API = append(cfg.API, "admin")
+
+	// the admin address requires an auth token file
+	require.Error(t, cfg.Validate())
+	cfg.AdminAuthTokenFile = "admin.token"
+	require.NoError(t, cfg.Validate())
+
+	for _, address := range []string{"localhost:8547", "[::1]:8547", "127.0.0.2:8547"} {
+		cfg.AdminAddress = address
+		require.NoError(t, cfg.Validate(), address)
+	}
+	for _, address := range []string{"0.0.0.0:8547", "192.168.1.1:8547", ":8547", "127.0.0.1"} {
+		cfg.AdminAddress = address
+		require.Error(t, cfg.Validate(), address)
+	}
+
+	// the admin namespace can be served on the IPC path only
+	cfg.AdminAddress = ""
+	require.Error(t, cfg.Validate())
+	cfg.AdminIPCPath = "admin.ipc"
+	cfg.AdminAuthTokenFile = ""
+	require.NoError(t, cfg.Validate())
+}
+
+func TestJSONRPCConfigValidateWorkerPools(t *testing.T) {
+	cfg := serverconfig.DefaultJSONRPCConfig()
+	cfg.ReadConcurrency = 100
+	cfg.CallConcurrency = 10
+	cfg.TraceConcurrency = 1
+	cfg.TraceQueueSize = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.TraceConcurrency = -1
+	require.Error(t, cfg.Validate())
+
+	cfg.TraceConcurrency = 1
+	cfg.CallQueueSize = -1
+	require.Error(t, cfg.Validate())
+}
@@ -0,0 +1,74 @@
+package dev
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/crypto/hd"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMnemonic is the mnemonic the funded accounts of the development
+	// chains are derived from. It is the one used by Hardhat and Anvil, so that
+	// their default accounts are funded.
+	DefaultMnemonic = "test test test test test test test test test test test junk"
+
+	// DefaultAccounts is the default number of funded accounts.
+	DefaultAccounts = 10
+)
+
+// Account is a funded account of a development chain.
+type Account struct {
+	Address common.Address
+	PrivKey *ethsecp256k1.PrivKey
+}
+
+// DeriveAccounts derives the given number of accounts from the mnemonic, along
+// the m/44'/60'/0'/0/i Ethereum derivation paths.
+func DeriveAccounts(mnemonic string, n int) ([]Account, error) {
+	derive := hd.EthSecp256k1.Derive()
+
+	accounts := make([]Account, 0, n)
+	for i := 0; i < n; i++ {
+		bz, err := derive(mnemonic, "", fmt.Sprintf("m/44'/60'/0'/0/%d", i))
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive the dev account %d: %w", i, err)
+		}
+
+		privKey := &ethsecp256k1.PrivKey{Key: bz}
+		key, err := privKey.ToECDSA()
+		if err != nil {
+			return nil, fmt.Errorf("invalid dev account %d key: %w", i, err)
+		}
+
+		accounts = append(accounts, Account{
+			Address: crypto.PubkeyToAddress(key.PublicKey),
+			PrivKey: privKey,
+		})
+	}
+
+	return accounts, nil
+}
+
+// PrintAccounts prints the addresses and the private keys of the accounts.
+func PrintAccounts(w io.Writer, accounts []Account) {
+	fmt.Fprintln(w, "Accounts")
+	fmt.Fprintln(w, "========")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "WARNING: These accounts, and their private keys, are publicly known.")
+	fmt.Fprintln(w, "Any funds sent to them on a public network WILL BE LOST.")
+
+	for i, account := range accounts {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "Account #%d: %s (%s)\n", i, account.Address.Hex(), sdk.AccAddress(account.Address.Bytes()))
+		fmt.Fprintf(w, "Private Key: %s\n", hexutil.Encode(account.PrivKey.Bytes()))
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,34 @@
+package dev
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveAccounts(t *testing.T) {
+	accounts, err := DeriveAccounts(DefaultMnemonic, 2)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+
+	// the Hardhat and Anvil default accounts
+	require.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", accounts[0].Address.Hex())
+	require.Equal(t, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", hexutil.Encode(accounts[0].PrivKey.Bytes()))
+	require.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", accounts[1].Address.Hex())
+
+	_, err = DeriveAccounts("invalid mnemonic", 1)
+	require.Error(t, err)
+}
+
+func TestPrintAccounts(t *testing.T) {
+	accounts, err := DeriveAccounts(DefaultMnemonic, 2)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	PrintAccounts(&buf, accounts)
+	require.Contains(t, buf.String(), "Account #0: 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	require.Contains(t, buf.String(), "Private Key: 0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Contains(t, buf.String(), "Account #1: 0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+}
@@ -0,0 +1,234 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/evm"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// pollInterval is the interval at which the paused consensus checks whether the
+// mempool holds transactions to mine.
+const pollInterval = 10 * time.Millisecond
+
+// errStopped is returned when the node stops while waiting for a block.
+var errStopped = errors.New("the dev chain is stopped")
+
+var _ evm.Chain = (*Chain)(nil)
+
+// Chain drives the block production of a single validator development chain.
+//
+// The consensus is paused before the validator precommits a block, until the
+// mempool holds transactions or blocks are mined on demand. The precommit sets
+// the timestamp of the next block, so each time the consensus resumes the
+// pending block is committed, and the next one, which includes the mempool
+// transactions, gets the current (or requested) timestamp.
+type Chain struct {
+	logger log.Logger
+	cms    storetypes.CommitMultiStore
+
+	mtx sync.Mutex
+	// mempoolSize returns the number of transactions waiting to be mined
+	mempoolSize func() int
+	// height is the latest committed height
+	height int64
+	// committed is closed on the next commit
+	committed chan struct{}
+	// mineTo is the height up to which the blocks are mined on demand
+	mineTo int64
+	// offset is the offset of the block timestamps from the local clock
+	offset time.Duration
+	// nextTimestamp is the timestamp requested for the next block, if any
+	nextTimestamp *time.Time
+	// lastTimestamp is the timestamp of the pending block
+	lastTimestamp time.Time
+	// snapshots are the state versions of the snapshots, by id
+	snapshots      map[uint64]int64
+	lastSnapshotID uint64
+	// revert is the pending revert request, if any
+	revert *revertRequest
+
+	wake     chan struct{}
+	quit     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewChain returns a Chain driving the development chain whose application
+// state is stored in the given multistore.
+func NewChain(logger log.Logger, cms storetypes.CommitMultiStore) *Chain {
+	return &Chain{
+		logger:    logger,
+		cms:       cms,
+		height:    cms.LastCommitID().Version,
+		committed: make(chan struct{}),
+		snapshots: make(map[uint64]int64),
+		wake:      make(chan struct{}, 1),
+		quit:      make(chan struct{}),
+	}
+}
+
+// ConfigureConsensus configures the consensus of the node to commit the blocks
+// as soon as the validator precommits them, and to accept the transactions
+// replayed after a revert.
+func ConfigureConsensus(cfg *cmtcfg.Config) {
+	cfg.Consensus.TimeoutCommit = 0
+	cfg.Consensus.SkipTimeoutCommit = true
+	cfg.Mempool.CacheSize = 0
+}
+
+// SetMempoolSize sets the function returning the number of transactions waiting
+// to be mined.
+func (c *Chain) SetMempoolSize(size func() int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.mempoolSize = size
+}
+
+// Stop resumes the paused consensus and aborts the pending requests, so that
+// the node can stop.
+func (c *Chain) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.quit)
+	})
+}
+
+// Mine commits the pending block and mines the next one, so that the latest
+// block has the timestamp set with SetNextBlockTimestamp or IncreaseTime. It
+// returns once the mined block is committed.
+func (c *Chain) Mine(ctx context.Context) error {
+	c.mtx.Lock()
+	height := c.height + 2
+	if height > c.mineTo {
+		c.mineTo = height
+	}
+	c.mtx.Unlock()
+	c.notify()
+
+	return c.waitForHeight(ctx, height)
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next mined block. The
+// following blocks keep the same offset from the local clock.
+func (c *Chain) SetNextBlockTimestamp(timestamp time.Time) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !timestamp.After(c.lastTimestamp) {
+		return fmt.Errorf(
+			"timestamp %d is not after the latest block timestamp %d",
+			timestamp.Unix(), c.lastTimestamp.Unix(),
+		)
+	}
+	c.nextTimestamp = &timestamp
+	return nil
+}
+
+// IncreaseTime shifts the timestamps of the next blocks by the given duration
+// and returns the total shift from the local clock.
+func (c *Chain) IncreaseTime(d time.Duration) time.Duration {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.offset += d
+	return c.offset
+}
+
+// waitForBlock blocks until the block of the given height can be precommitted,
+// i.e. when the mempool holds transactions or the block is mined on demand.
+func (c *Chain) waitForBlock(height int64) {
+	// the first block is committed right away, so that the chain has a latest block
+	if height <= 1 {
+		return
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.mtx.Lock()
+		mined := height <= c.mineTo || c.revert != nil
+		mempoolSize := c.mempoolSize
+		c.mtx.Unlock()
+
+		if mined || (mempoolSize != nil && mempoolSize() > 0) {
+			return
+		}
+
+		select {
+		case <-c.quit:
+			return
+		case <-c.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// nextBlockTime returns the timestamp of the next block, which must not be
+// before the given one.
+func (c *Chain) nextBlockTime(minTime time.Time) time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := cmttime.Now()
+	timestamp := now.Add(c.offset)
+	if c.nextTimestamp != nil {
+		timestamp = *c.nextTimestamp
+		c.offset = timestamp.Sub(now)
+		c.nextTimestamp = nil
+	}
+
+	// the block timestamps must increase
+	if timestamp.Before(minTime) {
+		timestamp = minTime
+	}
+
+	c.lastTimestamp = timestamp
+	return timestamp
+}
+
+// onCommit records the commit of the given height.
+func (c *Chain) onCommit(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.height = height
+	close(c.committed)
+	c.committed = make(chan struct{})
+}
+
+// waitForHeight waits until the given height is committed.
+func (c *Chain) waitForHeight(ctx context.Context, height int64) error {
+	for {
+		c.mtx.Lock()
+		if c.height >= height {
+			c.mtx.Unlock()
+			return nil
+		}
+		committed := c.committed
+		c.mtx.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.quit:
+			return errStopped
+		case <-committed:
+		}
+	}
+}
+
+// notify wakes up the paused consensus.
+func (c *Chain) notify() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,120 @@
+package dev
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var testKey = storetypes.NewKVStoreKey("test")
+
+func newTestStore(t *testing.T) *rootmulti.Store {
+	t.Helper()
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(testKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	return cms
+}
+
+func TestNextBlockTime(t *testing.T) {
+	chain := NewChain(log.NewNopLogger(), newTestStore(t))
+	now := time.Now()
+
+	// the timestamps must increase
+	minTime := now.Add(time.Hour)
+	require.Equal(t, minTime, chain.nextBlockTime(minTime))
+	require.Error(t, chain.SetNextBlockTimestamp(minTime))
+
+	timestamp := now.Add(2 * time.Hour).Truncate(time.Second)
+	require.NoError(t, chain.SetNextBlockTimestamp(timestamp))
+	require.Equal(t, timestamp, chain.nextBlockTime(minTime))
+
+	// the next blocks keep the offset
+	require.False(t, chain.nextBlockTime(time.Time{}).Before(timestamp))
+
+	offset := chain.IncreaseTime(time.Hour)
+	require.Greater(t, offset, 2*time.Hour)
+	require.False(t, chain.nextBlockTime(time.Time{}).Before(timestamp.Add(time.Hour)))
+}
+
+func TestWaitForBlock(t *testing.T) {
+	chain := NewChain(log.NewNopLogger(), newTestStore(t))
+	defer chain.Stop()
+
+	// the first block isn't paused
+	chain.waitForBlock(1)
+
+	// the block is mined once the mempool holds transactions
+	var mempoolSize atomic.Int32
+	chain.SetMempoolSize(func() int { return int(mempoolSize.Load()) })
+	released := make(chan struct{})
+	go func() {
+		chain.waitForBlock(2)
+		close(released)
+	}()
+	select {
+	case <-released:
+		t.Fatal("block mined with an empty mempool")
+	case <-time.After(5 * pollInterval):
+	}
+	mempoolSize.Store(1)
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		t.Fatal("block not mined with a non empty mempool")
+	}
+}
+
+func TestMine(t *testing.T) {
+	chain := NewChain(log.NewNopLogger(), newTestStore(t))
+	defer chain.Stop()
+
+	mined := make(chan error)
+	go func() {
+		mined <- chain.Mine(context.Background())
+	}()
+
+	// the pending block and the next one are mined
+	chain.waitForBlock(1)
+	chain.onCommit(1)
+	chain.waitForBlock(2)
+	chain.onCommit(2)
+	select {
+	case err := <-mined:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("mine not returned once the block is committed")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*pollInterval)
+	defer cancel()
+	require.ErrorIs(t, chain.Mine(ctx), context.DeadlineExceeded)
+}
+
+func TestStop(t *testing.T) {
+	chain := NewChain(log.NewNopLogger(), newTestStore(t))
+
+	mined := make(chan error)
+	go func() {
+		mined <- chain.Mine(context.Background())
+	}()
+
+	chain.Stop()
+	chain.waitForBlock(10)
+	select {
+	case err := <-mined:
+		require.ErrorIs(t, err, errStopped)
+	case <-time.After(time.Second):
+		t.Fatal("mine not aborted when the chain stops")
+	}
+}
@@ -0,0 +1,141 @@
+package dev
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// revertRequest is a request to revert the state to a snapshot version.
+type revertRequest struct {
+	version int64
+	done    chan revertResult
+}
+
+// revertResult is the result of a revert, applied at the given height.
+type revertResult struct {
+	height int64
+	err    error
+}
+
+// Snapshot snapshots the state of the latest block and returns the snapshot id.
+// The state versions are never pruned on the development chains.
+func (c *Chain) Snapshot() uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.lastSnapshotID++
+	c.snapshots[c.lastSnapshotID] = c.height
+	return c.lastSnapshotID
+}
+
+// Revert reverts the state to the given snapshot, deleting it and the later
+// ones, and returns once the reverted state is committed. It returns false if
+// the snapshot doesn't exist.
+//
+// The state is reverted in a new block, so the chain keeps the blocks mined
+// since the snapshot, as well as their timestamps.
+func (c *Chain) Revert(ctx context.Context, id uint64) (bool, error) {
+	c.mtx.Lock()
+	version, ok := c.snapshots[id]
+	if !ok {
+		c.mtx.Unlock()
+		return false, nil
+	}
+	for snapshotID := range c.snapshots {
+		if snapshotID >= id {
+			delete(c.snapshots, snapshotID)
+		}
+	}
+
+	req := &revertRequest{version: version, done: make(chan revertResult, 1)}
+	c.revert = req
+	c.mtx.Unlock()
+	c.notify()
+
+	var res revertResult
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-c.quit:
+		return false, errStopped
+	case res = <-req.done:
+	}
+	if res.err != nil {
+		return false, res.err
+	}
+
+	return true, c.waitForHeight(ctx, res.height)
+}
+
+// applyRevert reverts the working state to the requested snapshot version, if
+// any, before the block of the given height is executed.
+func (c *Chain) applyRevert(height int64) {
+	c.mtx.Lock()
+	req := c.revert
+	c.revert = nil
+	c.mtx.Unlock()
+
+	if req == nil {
+		return
+	}
+
+	err := restoreVersion(c.cms, req.version)
+	if err != nil {
+		c.logger.Error("failed to revert the state", "version", req.version, "error", err.Error())
+	} else {
+		c.logger.Info("reverted the state", "version", req.version, "height", height)
+	}
+	req.done <- revertResult{height: height, err: err}
+}
+
+// restoreVersion overwrites the working state of the IAVL stores of the
+// multistore with their state at the given version.
+func restoreVersion(cms storetypes.CommitMultiStore, version int64) error {
+	rs, ok := cms.(*rootmulti.Store)
+	if !ok {
+		return fmt.Errorf("unsupported commit multi store %T", cms)
+	}
+
+	snapshot, err := rs.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return fmt.Errorf("state not available at height %d: %w", version, err)
+	}
+
+	for _, key := range rs.StoreKeysByName() {
+		if rs.GetCommitKVStore(key).GetStoreType() != storetypes.StoreTypeIAVL {
+			continue
+		}
+		restoreStore(rs.GetKVStore(key), snapshot.GetKVStore(key))
+	}
+
+	return nil
+}
+
+// restoreStore overwrites the content of the store with the target one, only
+// writing the entries that differ.
+func restoreStore(store, target storetypes.KVStore) {
+	var stale [][]byte
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		if !target.Has(it.Key()) {
+			stale = append(stale, bytes.Clone(it.Key()))
+		}
+	}
+	it.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+
+	it = target.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if !bytes.Equal(store.Get(it.Key()), it.Value()) {
+			store.Set(bytes.Clone(it.Key()), bytes.Clone(it.Value()))
+		}
+	}
+}
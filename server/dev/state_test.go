@@ -0,0 +1,111 @@
+package dev
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/dbadapter"
+)
+
+func TestRestoreStore(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+	store.Set([]byte("c"), []byte("3"))
+
+	target := dbadapter.Store{DB: dbm.NewMemDB()}
+	target.Set([]byte("a"), []byte("1"))
+	target.Set([]byte("c"), []byte("4"))
+	target.Set([]byte("d"), []byte("5"))
+
+	restoreStore(store, target)
+
+	var keys, values []string
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+		values = append(values, string(it.Value()))
+	}
+	require.Equal(t, []string{"a", "c", "d"}, keys)
+	require.Equal(t, []string{"1", "4", "5"}, values)
+}
+
+func TestRestoreVersion(t *testing.T) {
+	cms := newTestStore(t)
+	store := cms.GetKVStore(testKey)
+
+	store.Set([]byte("a"), []byte("1"))
+	store.Set([]byte("b"), []byte("2"))
+	cms.Commit()
+
+	store.Set([]byte("a"), []byte("3"))
+	store.Delete([]byte("b"))
+	store.Set([]byte("c"), []byte("4"))
+	cms.Commit()
+
+	require.NoError(t, restoreVersion(cms, 1))
+	cms.Commit()
+
+	store = cms.GetKVStore(testKey)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("c")))
+
+	require.Error(t, restoreVersion(cms, 10))
+}
+
+func TestSnapshotRevert(t *testing.T) {
+	cms := newTestStore(t)
+	store := cms.GetKVStore(testKey)
+	store.Set([]byte("a"), []byte("1"))
+	cms.Commit()
+
+	chain := NewChain(log.NewNopLogger(), cms)
+	defer chain.Stop()
+	id := chain.Snapshot()
+	laterID := chain.Snapshot()
+
+	store.Set([]byte("a"), []byte("2"))
+	cms.Commit()
+	chain.onCommit(2)
+
+	type result struct {
+		reverted bool
+		err      error
+	}
+	reverted := make(chan result)
+	go func() {
+		ok, err := chain.Revert(context.Background(), id)
+		reverted <- result{ok, err}
+	}()
+
+	// the pending revert resumes the consensus, and is applied in the next block
+	chain.waitForBlock(3)
+	chain.applyRevert(3)
+	cms.Commit()
+	chain.onCommit(3)
+
+	select {
+	case res := <-reverted:
+		require.NoError(t, res.err)
+		require.True(t, res.reverted)
+	case <-time.After(time.Second):
+		t.Fatal("revert not returned once the block is committed")
+	}
+	require.Equal(t, []byte("1"), cms.GetKVStore(testKey).Get([]byte("a")))
+
+	// the snapshot and the later ones are deleted
+	ok, err := chain.Revert(context.Background(), id)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ok, err = chain.Revert(context.Background(), laterID)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
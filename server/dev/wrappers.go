@@ -0,0 +1,59 @@
+package dev
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// WrapApplication returns the ABCI application reverting the state to the
+// requested snapshots, and recording the commits.
+func (c *Chain) WrapApplication(app abci.Application) abci.Application {
+	return application{Application: app, chain: c}
+}
+
+// WrapPrivValidator returns the validator signer pausing the consensus until
+// there are blocks to mine, and setting their timestamps.
+func (c *Chain) WrapPrivValidator(pv cmttypes.PrivValidator) cmttypes.PrivValidator {
+	return privValidator{PrivValidator: pv, chain: c}
+}
+
+type application struct {
+	abci.Application
+	chain *Chain
+}
+
+// FinalizeBlock implements abci.Application, reverting the state before the
+// block is executed.
+func (app application) FinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	app.chain.applyRevert(req.Height)
+	return app.Application.FinalizeBlock(ctx, req)
+}
+
+// Commit implements abci.Application.
+func (app application) Commit(ctx context.Context, req *abci.RequestCommit) (*abci.ResponseCommit, error) {
+	res, err := app.Application.Commit(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	app.chain.onCommit(app.chain.cms.LastCommitID().Version)
+	return res, nil
+}
+
+type privValidator struct {
+	cmttypes.PrivValidator
+	chain *Chain
+}
+
+// SignVote implements types.PrivValidator. It waits for a block to mine before
+// precommitting the pending one, and sets the precommit timestamp, which is the
+// timestamp of the next block on a single validator chain.
+func (pv privValidator) SignVote(chainID string, vote *cmtproto.Vote) error {
+	if vote.Type == cmtproto.PrecommitType && len(vote.BlockID.Hash) > 0 {
+		pv.chain.waitForBlock(vote.Height)
+		vote.Timestamp = pv.chain.nextBlockTime(vote.Timestamp)
+	}
+	return pv.PrivValidator.SignVote(chainID, vote)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cosmos/cosmos-db"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const flagModules = "modules"
+
+// EVMExporter creates an application with the state loaded at the given height,
+// or the latest one if it's -1, and writes its evm module genesis state to the
+// given writer.
+type EVMExporter func(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts types.AppOptions, w io.Writer) error
+
+// NewExportCmd creates the Cosmos SDK export command, with a --modules flag
+// selecting the exported modules. When the evm module is the only one
+// selected, its genesis state is streamed by the EVM exporter, if set, instead
+// of the genesis document being built in memory.
+func NewExportCmd(appExporter types.AppExporter, evmExporter EVMExporter, defaultNodeHome string) *cobra.Command {
+	cmd := server.ExportCmd(appExporter, defaultNodeHome)
+	exportAppState := cmd.RunE
+
+	cmd.Example = fmt.Sprintf("%s export --modules %s --output-document evm.json", version.AppName, evmtypes.ModuleName)
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		modules, err := cmd.Flags().GetStringSlice(flagModules)
+		if err != nil {
+			return err
+		}
+
+		if evmExporter != nil && len(modules) == 1 && modules[0] == evmtypes.ModuleName {
+			return exportEVMGenesis(cmd, evmExporter)
+		}
+
+		if len(modules) > 0 {
+			if err := cmd.Flags().Set(server.FlagModulesToExport, strings.Join(modules, ",")); err != nil {
+				return err
+			}
+		}
+		return exportAppState(cmd, args)
+	}
+
+	cmd.Flags().StringSlice(flagModules, []string{}, fmt.Sprintf(
+		"Comma-separated list of modules to export, as --%s. If only the %s module is given, its genesis state is streamed instead of the genesis document",
+		server.FlagModulesToExport, evmtypes.ModuleName,
+	))
+	return cmd
+}
+
+// exportEVMGenesis writes the evm module genesis state to the output document
+// or to STDOUT.
+func exportEVMGenesis(cmd *cobra.Command, evmExporter EVMExporter) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	db, err := cosmosevmserverconfig.OpenDB(serverCtx.Viper, config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return err
+	}
+
+	traceWriter, err := openTraceWriter(serverCtx.Viper.GetString(srvflags.TraceStore))
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	outputDocument, _ := cmd.Flags().GetString(flags.FlagOutputDocument)
+	if outputDocument != "" {
+		f, err := os.Create(outputDocument)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+	w := bufio.NewWriter(out)
+	if err := evmExporter(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper, w); err != nil {
+		return fmt.Errorf("error exporting %s state: %w", evmtypes.ModuleName, err)
+	}
+
+	return w.Flush()
+}
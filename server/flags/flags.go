@@ -37,26 +37,45 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable               = "json-rpc.enable"
-	JSONRPCAPI                  = "json-rpc.api"
-	JSONRPCAddress              = "json-rpc.address"
-	JSONWsAddress               = "json-rpc.ws-address"
-	JSONRPCWSOrigins            = "json-rpc.ws-origins"
-	JSONRPCGasCap               = "json-rpc.gas-cap"
-	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
-	JSONRPCEVMTimeout           = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap             = "json-rpc.txfee-cap"
-	JSONRPCFilterCap            = "json-rpc.filter-cap"
-	JSONRPCLogsCap              = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap        = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout          = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout      = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
-	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
-	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
-	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+	JSONRPCEnable                = "json-rpc.enable"
+	JSONRPCAPI                   = "json-rpc.api"
+	JSONRPCAddress               = "json-rpc.address"
+	JSONWsAddress                = "json-rpc.ws-address"
+	JSONRPCWSOrigins             = "json-rpc.ws-origins"
+	JSONRPCGasCap                = "json-rpc.gas-cap"
+	JSONRPCAllowInsecureUnlock   = "json-rpc.allow-insecure-unlock"
+	JSONRPCEVMTimeout            = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap              = "json-rpc.txfee-cap"
+	JSONRPCCallGasCap            = "json-rpc.call-gas-cap"
+	JSONRPCEstimateGasCap        = "json-rpc.estimate-gas-cap"
+	JSONRPCTraceTimeout          = "json-rpc.trace-timeout"
+	JSONRPCRawTxFeeCap           = "json-rpc.raw-txfee-cap"
+	JSONRPCReadConcurrency       = "json-rpc.read-concurrency"
+	JSONRPCReadQueueSize         = "json-rpc.read-queue-size"
+	JSONRPCCallConcurrency       = "json-rpc.call-concurrency"
+	JSONRPCCallQueueSize         = "json-rpc.call-queue-size"
+	JSONRPCTraceConcurrency      = "json-rpc.trace-concurrency"
+	JSONRPCTraceQueueSize        = "json-rpc.trace-queue-size"
+	JSONRPCFilterCap             = "json-rpc.filter-cap"
+	JSONRPCLogsCap               = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap         = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout           = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout       = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs   = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections    = "json-rpc.max-open-connections"
+	JSONRPCEnableIndexer         = "json-rpc.enable-indexer"
+	JSONRPCIndexerBackend        = "json-rpc.indexer-backend"
+	JSONRPCIndexerPSQLConn       = "json-rpc.indexer-psql-conn"
+	JSONRPCIndexerSinkFile       = "json-rpc.indexer-sink-file"
+	JSONRPCIndexerRetainBlocks   = "json-rpc.indexer-retain-blocks"
+	JSONRPCIndexerRetainDuration = "json-rpc.indexer-retain-duration"
+	JSONRPCIndexerPruneInterval  = "json-rpc.indexer-prune-interval"
+	JSONRPCBatchRequestLimit     = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize  = "json-rpc.batch-response-max-size"
+	JSONRPCEnableProfiling       = "json-rpc.enable-profiling"
+	JSONRPCAdminAddress          = "json-rpc.admin-address"
+	JSONRPCAdminIPCPath          = "json-rpc.admin-ipc-path"
+	JSONRPCAdminAuthTokenFile    = "json-rpc.admin-auth-token-file"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -65,12 +84,36 @@ const (
 
 // EVM flags
 const (
-	EVMTracer                  = "evm.tracer"
-	EVMMaxTxGasWanted          = "evm.max-tx-gas-wanted"
-	EVMEnablePreimageRecording = "evm.cache-preimage"
-	EVMChainID                 = "evm.evm-chain-id"
-	EVMMinTip                  = "evm.min-tip"
-	EvmGethMetricsAddress      = "evm.geth-metrics-address"
+	EVMTracer                         = "evm.tracer"
+	EVMMaxTxGasWanted                 = "evm.max-tx-gas-wanted"
+	EVMRejectOversizedTxs             = "evm.reject-oversized-txs"
+	EVMEnablePreimageRecording        = "evm.cache-preimage"
+	EVMChainID                        = "evm.evm-chain-id"
+	EVMMinTip                         = "evm.min-tip"
+	EVMMempoolPriceBump               = "evm.mempool-price-bump"
+	EVMMempoolJournal                 = "evm.mempool-journal"
+	EVMMempoolRejournal               = "evm.mempool-rejournal"
+	EVMMempoolPriceLimit              = "evm.mempool-price-limit"
+	EVMMempoolAccountSlots            = "evm.mempool-account-slots"
+	EVMMempoolGlobalSlots             = "evm.mempool-global-slots"
+	EVMMempoolAccountQueue            = "evm.mempool-account-queue"
+	EVMMempoolGlobalQueue             = "evm.mempool-global-queue"
+	EVMMempoolLifetime                = "evm.mempool-lifetime"
+	EVMMempoolSenderGasQuota          = "evm.mempool-sender-gas-quota"
+	EVMMempoolSenderGasQuotaAllowlist = "evm.mempool-sender-gas-quota-allowlist"
+	EvmGethMetricsAddress             = "evm.geth-metrics-address"
+	EVMStreamingFile                  = "evm.streaming-file"
+	EVMQueryCacheCodeSize             = "evm.query-cache-code-size"
+	EVMQueryCacheAccountSize          = "evm.query-cache-account-size"
+	EVMQueryCacheStorageSize          = "evm.query-cache-storage-size"
+	EVMParallelExecutionWorkers       = "evm.parallel-execution-workers"
+)
+
+// Dev mode flags
+const (
+	DevMode     = "dev"
+	DevAccounts = "dev.accounts"
+	DevMnemonic = "dev.mnemonic"
 )
 
 // TLS flags
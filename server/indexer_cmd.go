@@ -2,14 +2,18 @@ package server
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -18,16 +22,20 @@ import (
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|backfill] [from] [to]",
 		Short: "Index historical eth txs",
-		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
+		Long: `Index historical eth txs, it supports two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		The backfill mode checks the blocks of the given range (defaults to the whole chain), indexing the missing
+		blocks and re-indexing the corrupted ones, so gaps left by running the node with the indexer disabled can be filled.
 		`,
-		Args: cobra.ExactArgs(1),
+		Example: "index-eth-tx backfill 1000 2000",
+		Args:    cobra.RangeArgs(1, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -36,19 +44,28 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 
 			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			switch direction {
+			case "backward", "forward":
+				if len(args) != 1 {
+					return fmt.Errorf("block range is only supported in backfill mode")
+				}
+			case "backfill":
+			default:
+				return fmt.Errorf("unknown index direction, expect: backward|forward|backfill, got: %s", direction)
 			}
 
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			evmCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			idxer, err := NewEVMIndexer(evmCfg.JSONRPC, home, server.GetAppDBBackend(serverCtx.Viper), logger.With("module", "evmindex"), clientCtx)
 			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				logger.Error("failed to open evm indexer", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local CometBFT db, because the local rpc won't be available.
 			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
@@ -65,16 +82,24 @@ func NewIndexTxCmd() *cobra.Command {
 				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
 			})
 
-			indexBlock := func(height int64) error {
+			loadBlock := func(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
 				blk := blockStore.LoadBlock(height)
 				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
+					return nil, nil, fmt.Errorf("block not found %d", height)
 				}
 				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
+				if err != nil {
+					return nil, nil, err
+				}
+				return blk, resBlk.TxResults, nil
+			}
+
+			indexBlock := func(height int64) error {
+				blk, txResults, err := loadBlock(height)
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
+				if err := idxer.IndexBlock(blk, txResults); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -110,6 +135,35 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "backfill":
+				from, to := blockStore.Base(), blockStore.Height()
+				if len(args) > 1 {
+					if from, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+						return fmt.Errorf("invalid from block %s: %w", args[1], err)
+					}
+				}
+				if len(args) > 2 {
+					if to, err = strconv.ParseInt(args[2], 10, 64); err != nil {
+						return fmt.Errorf("invalid to block %s: %w", args[2], err)
+					}
+				}
+				if from < blockStore.Base() || to > blockStore.Height() {
+					return fmt.Errorf("block range [%d, %d] not available in the block store [%d, %d]", from, to, blockStore.Base(), blockStore.Height())
+				}
+
+				backfillIdxer, ok := idxer.(indexer.BackfillIndexer)
+				if !ok {
+					return fmt.Errorf("evm indexer %T does not support backfill", idxer)
+				}
+				res, err := indexer.Backfill(backfillIdxer, loadBlock, from, to, func(height int64, status indexer.BackfillStatus) {
+					if status != indexer.BackfillStatusSkipped {
+						fmt.Println(height, status)
+					}
+				})
+				if err != nil {
+					return err
+				}
+				fmt.Printf("backfilled blocks [%d, %d]: %d indexed, %d repaired, %d skipped\n", res.From, res.To, res.Indexed, res.Repaired, res.Skipped)
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
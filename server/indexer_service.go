@@ -81,6 +81,11 @@ func (eis *EVMIndexerService) OnStart() error {
 	if lastBlock == -1 {
 		lastBlock = latestBlock
 	}
+	// re-index the latest block, which is replayed on startup after a rollback of the node,
+	// the indexed blocks it replaces being dropped by the indexer
+	if lastBlock >= latestBlock {
+		lastBlock = latestBlock - 1
+	}
 
 	// blockErr indicates an error fetching an expected block or its results
 	var blockErr error
@@ -2,9 +2,13 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,8 +21,13 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/limiter"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/admin"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	evmapi "github.com/cosmos/evm/rpc/namespaces/ethereum/evm"
 	"github.com/cosmos/evm/rpc/stream"
 	serverconfig "github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/server/dev"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -41,6 +50,7 @@ func StartJSONRPC(
 	indexer cosmosevmtypes.EVMTxIndexer,
 	app AppWithPendingTxStream,
 	mempool *evmmempool.ExperimentalEVMMempool,
+	devChain *dev.Chain,
 ) (*http.Server, error) {
 	logger := srvCtx.Logger.With("module", "geth")
 
@@ -51,6 +61,9 @@ func StartJSONRPC(
 
 	stream := stream.NewRPCStreams(evtClient, logger, clientCtx.TxConfig.TxDecoder())
 	app.RegisterPendingTxListener(stream.ListenPendingTx)
+	if mempool != nil {
+		mempool.RegisterDroppedTxListener(stream.ListenDroppedTx)
+	}
 
 	// Set Geth's global logger to use this handler
 	handler := &CustomSlogHandler{logger: logger}
@@ -64,7 +77,30 @@ func StartJSONRPC(
 
 	apis := rpc.GetRPCAPIs(srvCtx, clientCtx, stream, allowUnprotectedTxs, indexer, rpcAPIArr, mempool)
 
+	// the admin namespace is only served on the admin address and IPC path, and
+	// manages the methods served by this server through the method gate
+	gate := admin.NewMethodGate()
+	var filterFlushers []func() int
+	for _, api := range apis {
+		if filterAPI, ok := api.Service.(*filters.PublicFilterAPI); ok {
+			filterFlushers = append(filterFlushers, func() int { return filters.FlushFilters(filterAPI) })
+		}
+	}
+
+	var adminAPIs []ethrpc.API
 	for _, api := range apis {
+		if api.Namespace == rpc.AdminNamespace {
+			if adminAPI, ok := api.Service.(*admin.API); ok {
+				admin.Bind(adminAPI, gate, filterFlushers...)
+			}
+			adminAPIs = append(adminAPIs, api)
+			continue
+		}
+		// the evm namespace drives the development chain, if the node runs one
+		if evmAPI, ok := api.Service.(*evmapi.API); ok && devChain != nil {
+			evmapi.Bind(evmAPI, devChain)
+		}
+
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
 			logger.Error(
 				"failed to register service in JSON RPC namespace",
@@ -75,8 +111,15 @@ func StartJSONRPC(
 		}
 	}
 
+	// the requests are served by separate worker pools for each method class
+	limit := limiter.New(limiter.Config{
+		Read:  limiter.PoolConfig{Concurrency: config.JSONRPC.ReadConcurrency, QueueSize: config.JSONRPC.ReadQueueSize},
+		Call:  limiter.PoolConfig{Concurrency: config.JSONRPC.CallConcurrency, QueueSize: config.JSONRPC.CallQueueSize},
+		Trace: limiter.PoolConfig{Concurrency: config.JSONRPC.TraceConcurrency, QueueSize: config.JSONRPC.TraceQueueSize},
+	})
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", gate.Handler(limit.Handler(rpcServer))).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -129,9 +172,144 @@ func StartJSONRPC(
 		}
 	})
 
+	if len(adminAPIs) > 0 {
+		if err := startAdminJSONRPC(ctx, srvCtx, g, config, adminAPIs); err != nil {
+			return nil, err
+		}
+	}
+
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
 	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
 	wsSrv.Start()
 	return httpSrv, nil
 }
+
+// startAdminJSONRPC serves the admin namespace on the admin loopback address,
+// requiring the admin auth token, and on the admin IPC path.
+func startAdminJSONRPC(
+	ctx context.Context,
+	srvCtx *server.Context,
+	g *errgroup.Group,
+	config *serverconfig.Config,
+	apis []ethrpc.API,
+) error {
+	logger := srvCtx.Logger.With("module", "geth")
+
+	rpcServer := ethrpc.NewServer()
+	for _, api := range apis {
+		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
+			logger.Error(
+				"failed to register service in JSON RPC admin namespace",
+				"namespace", api.Namespace,
+				"service", api.Service,
+			)
+			return err
+		}
+	}
+
+	if address := config.JSONRPC.AdminAddress; address != "" {
+		token, err := readAdminAuthToken(config.JSONRPC.AdminAuthTokenFile)
+		if err != nil {
+			return err
+		}
+
+		httpSrv := &http.Server{
+			Addr:              address,
+			Handler:           admin.AuthHandler(token, rpcServer),
+			ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
+			ReadTimeout:       config.JSONRPC.HTTPTimeout,
+			WriteTimeout:      config.JSONRPC.HTTPTimeout,
+			IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
+		}
+
+		ln, err := net.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+
+		g.Go(func() error {
+			srvCtx.Logger.Info("Starting JSON-RPC admin server", "address", address)
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- httpSrv.Serve(ln)
+			}()
+
+			select {
+			case <-ctx.Done():
+				logger.Info("stopping JSON-RPC admin server...", "address", address, "timeout", shutdownTimeout)
+				ctxShutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+				defer cancel()
+				if err := httpSrv.Shutdown(ctxShutdown); err != nil {
+					logger.Error("failed to shutdown JSON-RPC admin server", "error", err.Error())
+				}
+				return nil
+			case err := <-errCh:
+				if err == http.ErrServerClosed {
+					return nil
+				}
+
+				srvCtx.Logger.Error("failed to start JSON-RPC admin server", "error", err.Error())
+				return err
+			}
+		})
+	}
+
+	if path := config.JSONRPC.AdminIPCPath; path != "" {
+		// remove the socket left over by an unclean shutdown
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove the stale JSON-RPC admin IPC socket: %w", err)
+		}
+
+		ln, err := net.Listen("unix", path)
+		if err != nil {
+			return err
+		}
+
+		if err := os.Chmod(path, 0o600); err != nil {
+			_ = ln.Close()
+			return err
+		}
+
+		g.Go(func() error {
+			srvCtx.Logger.Info("Starting JSON-RPC admin IPC server", "path", path)
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- rpcServer.ServeListener(ln)
+			}()
+
+			select {
+			case <-ctx.Done():
+				logger.Info("stopping JSON-RPC admin IPC server...", "path", path)
+				rpcServer.Stop()
+				_ = ln.Close()
+				return nil
+			case err := <-errCh:
+				srvCtx.Logger.Error("failed to start JSON-RPC admin IPC server", "error", err.Error())
+				return err
+			}
+		})
+	}
+
+	return nil
+}
+
+// readAdminAuthToken reads the bearer token required by the admin server from
+// the given file.
+func readAdminAuthToken(file string) (string, error) {
+	if file == "" {
+		return "", errors.New("JSON-RPC admin address requires an admin auth token file")
+	}
+
+	bz, err := os.ReadFile(file) //#nosec G304 -- the file is set by the node operator
+	if err != nil {
+		return "", fmt.Errorf("failed to read the JSON-RPC admin auth token: %w", err)
+	}
+
+	token := strings.TrimSpace(string(bz))
+	if token == "" {
+		return "", fmt.Errorf("the JSON-RPC admin auth token file %s is empty", file)
+	}
+
+	return token, nil
+}
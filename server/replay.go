@@ -0,0 +1,238 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// ReplayApp defines the application used to re-execute a block. The block is
+// finalized but never committed, so the application database isn't modified.
+type ReplayApp interface {
+	FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error)
+	CommitMultiStore() storetypes.CommitMultiStore
+	Close() error
+}
+
+// ReplayAppCreator creates an application with the state loaded at the given
+// height, to replay the following block.
+type ReplayAppCreator func(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts types.AppOptions) (ReplayApp, error)
+
+// ReplayResult is the result of the re-execution of a block, compared against
+// the stored results of its original execution.
+type ReplayResult struct {
+	Height int64 `json:"height"`
+	// AppHash is the app hash resulting from the re-execution.
+	AppHash []byte `json:"app_hash"`
+	// StoredAppHash is the app hash committed by the node for the block.
+	StoredAppHash []byte `json:"stored_app_hash"`
+	// StoreDiffs lists the module stores whose root hash differs.
+	StoreDiffs []StoreHashDiff `json:"store_diffs,omitempty"`
+	// TxDiffs lists the fields of the transaction results that differ, empty
+	// if the results of the original execution aren't stored.
+	TxDiffs []TxResultDiff `json:"tx_diffs,omitempty"`
+}
+
+// StoreHashDiff is a module store root hash mismatch.
+type StoreHashDiff struct {
+	Name     string `json:"name"`
+	Stored   []byte `json:"stored"`
+	Replayed []byte `json:"replayed"`
+}
+
+// TxResultDiff is a mismatch on a field of a transaction result or of the
+// Ethereum receipt it contains.
+type TxResultDiff struct {
+	Index    int    `json:"index"`
+	Field    string `json:"field"`
+	Stored   string `json:"stored"`
+	Replayed string `json:"replayed"`
+}
+
+// Matches returns true if the re-execution reproduced the stored results.
+func (r ReplayResult) Matches() bool {
+	return bytes.Equal(r.AppHash, r.StoredAppHash) && len(r.StoreDiffs) == 0 && len(r.TxDiffs) == 0
+}
+
+// ReplayBlock re-executes the block on the application, which must have the
+// state of the previous block loaded, and compares the resulting store hashes
+// with the ones committed by the node. The transaction results and Ethereum
+// receipts are compared as well if the original results are provided.
+//
+// The last validator set is the one of the previous block, used to rebuild the
+// last commit info the same way CometBFT does.
+func ReplayBlock(
+	app ReplayApp,
+	block *cmttypes.Block,
+	lastValSet *cmttypes.ValidatorSet,
+	initialHeight int64,
+	stored *abci.ResponseFinalizeBlock,
+) (*ReplayResult, error) {
+	cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("unsupported commit multi store %T", app.CommitMultiStore())
+	}
+
+	commitInfo, err := cms.GetCommitInfo(block.Height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the commit info of block %d: %w", block.Height, err)
+	}
+
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Hash:               block.Hash(),
+		NextValidatorsHash: block.NextValidatorsHash,
+		ProposerAddress:    block.ProposerAddress,
+		Height:             block.Height,
+		Time:               block.Time,
+		DecidedLastCommit:  sm.BuildLastCommitInfo(block, lastValSet, initialHeight),
+		Misbehavior:        block.Evidence.Evidence.ToABCI(),
+		Txs:                block.Txs.ToSliceOfBytes(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize block %d: %w", block.Height, err)
+	}
+
+	result := &ReplayResult{
+		Height:        block.Height,
+		AppHash:       res.AppHash,
+		StoredAppHash: commitInfo.Hash(),
+	}
+
+	// the working hashes of the stores aren't committed, so they are compared
+	// against the ones stored in the commit info of the block
+	storeKeys := cms.StoreKeysByName()
+	for _, storeInfo := range commitInfo.StoreInfos {
+		key, ok := storeKeys[storeInfo.Name]
+		if !ok {
+			result.StoreDiffs = append(result.StoreDiffs, StoreHashDiff{Name: storeInfo.Name, Stored: storeInfo.CommitId.Hash})
+			continue
+		}
+
+		hash := cms.GetCommitKVStore(key).WorkingHash()
+		if !bytes.Equal(hash, storeInfo.CommitId.Hash) {
+			result.StoreDiffs = append(result.StoreDiffs, StoreHashDiff{Name: storeInfo.Name, Stored: storeInfo.CommitId.Hash, Replayed: hash})
+		}
+	}
+	sort.Slice(result.StoreDiffs, func(i, j int) bool {
+		return result.StoreDiffs[i].Name < result.StoreDiffs[j].Name
+	})
+
+	if stored != nil {
+		result.TxDiffs, err = diffTxResults(stored.TxResults, res.TxResults)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// diffTxResults compares the stored transaction results with the replayed ones,
+// including the Ethereum transaction responses they contain.
+func diffTxResults(stored, replayed []*abci.ExecTxResult) ([]TxResultDiff, error) {
+	if len(stored) != len(replayed) {
+		return []TxResultDiff{{
+			Index:    -1,
+			Field:    "tx_results",
+			Stored:   fmt.Sprint(len(stored)),
+			Replayed: fmt.Sprint(len(replayed)),
+		}}, nil
+	}
+
+	var diffs []TxResultDiff
+	for i := range stored {
+		fields, err := txResultFields(stored[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode stored result of tx %d: %w", i, err)
+		}
+		replayedFields, err := txResultFields(replayed[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode replayed result of tx %d: %w", i, err)
+		}
+
+		for _, field := range sortedKeys(fields, replayedFields) {
+			if fields[field] != replayedFields[field] {
+				diffs = append(diffs, TxResultDiff{Index: i, Field: field, Stored: fields[field], Replayed: replayedFields[field]})
+			}
+		}
+	}
+
+	return diffs, nil
+}
+
+// txResultFields flattens a transaction result and the Ethereum receipts it
+// contains into comparable fields.
+func txResultFields(res *abci.ExecTxResult) (map[string]string, error) {
+	events, err := json.Marshal(res.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"code":       fmt.Sprint(res.Code),
+		"codespace":  res.Codespace,
+		"log":        res.Log,
+		"gas_wanted": fmt.Sprint(res.GasWanted),
+		"gas_used":   fmt.Sprint(res.GasUsed),
+		"events":     string(events),
+	}
+
+	// failed transactions have no data
+	if res.Code != abci.CodeTypeOK {
+		return fields, nil
+	}
+
+	ethResponses, err := evmtypes.DecodeTxResponses(res.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, ethRes := range ethResponses {
+		logs, err := json.Marshal(evmtypes.LogsToEthereum(ethRes.Logs))
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := fmt.Sprintf("eth_tx[%d].", i)
+		fields[prefix+"hash"] = ethRes.Hash
+		fields[prefix+"gas_used"] = fmt.Sprint(ethRes.GasUsed)
+		fields[prefix+"ret"] = fmt.Sprintf("%x", ethRes.Ret)
+		fields[prefix+"vm_error"] = ethRes.VmError
+		fields[prefix+"logs"] = string(logs)
+	}
+
+	return fields, nil
+}
+
+// sortedKeys returns the sorted union of the keys of the given maps.
+func sortedKeys(maps ...map[string]string) []string {
+	set := make(map[string]struct{})
+	for _, m := range maps {
+		for k := range m {
+			set[k] = struct{}{}
+		}
+	}
+
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtconfig "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	srvflags "github.com/cosmos/evm/server/flags"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// flagTracer is distinct from the evm.tracer flag of the start command, whose
+// default would be overridden by the node configuration.
+const flagTracer = "tracer"
+
+// NewReplayBlockCmd creates a new Cobra command to re-execute a block against
+// the state of the previous block and diff the results with the stored ones.
+func NewReplayBlockCmd(appCreator ReplayAppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block [height]",
+		Short: "Re-execute a block with tracing enabled and diff the results with the stored ones",
+		Long: `Re-execute a block against the state of the previous block, with the EVM tracer enabled, and
+compare the resulting store hashes, transaction results and Ethereum receipts with the ones stored by the
+node, to help diagnosing app hash mismatches caused by a non-deterministic execution.
+
+The node must be stopped, and the state of the previous block must not have been pruned. The replayed block
+is never committed. The EVM traces are written to stderr, and the store operations to the --trace-store file
+if set.
+		`,
+		Example: "replay-block 1000 --tracer json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+			home := cfg.RootDir
+
+			// open local CometBFT db, because the local rpc won't be available.
+			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := cmtstore.NewBlockStore(tmdb)
+			defer blockStore.Close()
+
+			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			})
+			defer stateStore.Close()
+
+			block := blockStore.LoadBlock(height)
+			if block == nil {
+				return fmt.Errorf("block not found %d", height)
+			}
+			state, err := stateStore.Load()
+			if err != nil {
+				return err
+			}
+			if height == state.InitialHeight {
+				return fmt.Errorf("cannot replay the initial block %d", height)
+			}
+			lastValSet, err := stateStore.LoadValidators(height - 1)
+			if err != nil {
+				return err
+			}
+
+			// the results of the original execution are discarded if the node
+			// is configured to do so, then only the store hashes are compared
+			stored, err := stateStore.LoadFinalizeBlockResponse(height)
+			if err != nil {
+				serverCtx.Logger.Info("stored block results not available, only comparing store hashes", "error", err.Error())
+				stored = nil
+			}
+
+			// enable the EVM tracer, regardless of the node configuration, and
+			// disable the streaming of the replayed block
+			tracer, err := cmd.Flags().GetString(flagTracer)
+			if err != nil {
+				return err
+			}
+			serverCtx.Viper.Set(srvflags.EVMTracer, tracer)
+			serverCtx.Viper.Set(srvflags.EVMStreamingFile, "")
+
+			traceWriter, err := openTraceWriter(serverCtx.Viper.GetString(srvflags.TraceStore))
+			if err != nil {
+				return err
+			}
+
+			db, err := cosmosevmserverconfig.OpenDB(serverCtx.Viper, home, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+
+			app, err := appCreator(serverCtx.Logger, db, traceWriter, height-1, serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			defer app.Close()
+
+			res, err := ReplayBlock(app, block, lastValSet, state.InitialHeight, stored)
+			if err != nil {
+				return err
+			}
+
+			printReplayResult(cmd, res, stored)
+			if !res.Matches() {
+				return fmt.Errorf("replayed block %d doesn't match the stored results", height)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagTracer, evmtypes.TracerJSON, "the EVM tracer type used during the re-execution (json|markdown|struct|access_list)")
+	cmd.Flags().String(srvflags.TraceStore, "", "the file to write the store operations to")
+	return cmd
+}
+
+// printReplayResult prints the differences found by the re-execution of a block.
+func printReplayResult(cmd *cobra.Command, res *ReplayResult, stored *abci.ResponseFinalizeBlock) {
+	cmd.Printf("block %d\n", res.Height)
+	cmd.Printf("  stored app hash:   %X\n", res.StoredAppHash)
+	cmd.Printf("  replayed app hash: %X\n", res.AppHash)
+
+	for _, diff := range res.StoreDiffs {
+		cmd.Printf("store %s hash mismatch\n  stored:   %X\n  replayed: %X\n", diff.Name, diff.Stored, diff.Replayed)
+	}
+
+	if stored == nil {
+		cmd.Println("tx results not compared, the stored block results are not available")
+	}
+	for _, diff := range res.TxDiffs {
+		cmd.Printf("tx %d %s mismatch\n  stored:   %s\n  replayed: %s\n", diff.Index, diff.Field, diff.Stored, diff.Replayed)
+	}
+
+	if res.Matches() {
+		cmd.Println("replayed block matches the stored results")
+	}
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	cmtconfig "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	cmtstore "github.com/cometbft/cometbft/store"
+
+	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const flagHard = "hard"
+
+// NewRollbackEVMCmd creates a command to roll back the CometBFT state, the
+// application state and the EVM indexer to a given height.
+func NewRollbackEVMCmd(opts StartOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback-evm [height]",
+		Short: "Roll back the CometBFT, application and EVM indexer state to the given height",
+		Long: `Roll back the CometBFT, application and EVM indexer state to the given height, to recover a node
+which committed a bad block, e.g. during an upgrade. The node must be stopped.
+
+The application state, including the EVM and fee market module stores, is rolled back to the given
+height, and the EVM indexer entries of the blocks above it are deleted. The blocks above the given
+height are removed from the block store, except the one right above it, which is re-executed when
+restarting the node, unless --hard is set.
+`,
+		Example: "rollback-evm 1000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			target, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			if target <= 0 {
+				return fmt.Errorf("invalid height %d, must be positive", target)
+			}
+			hard, err := cmd.Flags().GetBool(flagHard)
+			if err != nil {
+				return err
+			}
+
+			cfg := serverCtx.Config
+			home := cfg.RootDir
+			db, err := opts.DBOpener(serverCtx.Viper, home, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			app := opts.AppCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			// check the application can be rolled back before touching the CometBFT state
+			cms := app.CommitMultiStore()
+			if latest := cms.LastCommitID().Version; target >= latest {
+				return fmt.Errorf("height %d is not below the latest application height %d", target, latest)
+			}
+			if _, err := cms.CacheMultiStoreWithVersion(target); err != nil {
+				return fmt.Errorf("application state not available at height %d: %w", target, err)
+			}
+
+			// open local CometBFT db, because the local rpc won't be available.
+			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := cmtstore.NewBlockStore(tmdb)
+			defer blockStore.Close()
+
+			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			})
+			defer stateStore.Close()
+
+			// rollback CometBFT state one height at a time, removing the blocks above the
+			// target height so that the next rollback doesn't stop at the pending block
+			var appHash []byte
+			for {
+				state, err := stateStore.Load()
+				if err != nil {
+					return err
+				}
+				last := state.LastBlockHeight
+				if last < target {
+					return fmt.Errorf("CometBFT state height %d is below height %d", last, target)
+				}
+				if last == target {
+					if hard && blockStore.Height() > target {
+						if _, _, err := sm.Rollback(blockStore, stateStore, true); err != nil {
+							return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+						}
+					}
+					appHash = state.AppHash
+					break
+				}
+
+				removeBlock := hard || last > target+1 || blockStore.Height() > last
+				if _, _, err := sm.Rollback(blockStore, stateStore, removeBlock); err != nil {
+					return fmt.Errorf("failed to rollback CometBFT state: %w", err)
+				}
+			}
+
+			// rollback the multistore, holding the EVM and fee market state
+			if err := cms.RollbackToVersion(target); err != nil {
+				return fmt.Errorf("failed to rollback to version: %w", err)
+			}
+
+			evmCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			if evmCfg.JSONRPC.EnableIndexer {
+				idxer, err := NewEVMIndexer(evmCfg.JSONRPC, home, server.GetAppDBBackend(serverCtx.Viper), serverCtx.Logger.With("module", "evmindex"), clientCtx)
+				if err != nil {
+					return fmt.Errorf("failed to open evm indexer: %w", err)
+				}
+				rollbackIdxer, ok := idxer.(indexer.RollbackIndexer)
+				if !ok {
+					return fmt.Errorf("evm indexer %T does not support rollback", idxer)
+				}
+				if err := rollbackIdxer.Rollback(target); err != nil {
+					return fmt.Errorf("failed to rollback evm indexer: %w", err)
+				}
+			}
+
+			fmt.Printf("Rolled back state to height %d and hash %X\n", target, appHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, opts.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Bool(flagHard, false, "remove the block above the target height as well as the state")
+	return cmd
+}
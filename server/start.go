@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 	"github.com/spf13/cobra"
@@ -16,6 +18,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	abciserver "github.com/cometbft/cometbft/abci/server"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/node"
@@ -30,8 +33,10 @@ import (
 	"github.com/cosmos/evm/indexer"
 	evmmempool "github.com/cosmos/evm/mempool"
 	evmmetrics "github.com/cosmos/evm/metrics"
+	"github.com/cosmos/evm/rpc"
 	ethdebug "github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/server/dev"
 	srvflags "github.com/cosmos/evm/server/flags"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 
@@ -67,11 +72,20 @@ type Application interface {
 // AppCreator is a function that allows us to lazily initialize an application implementing with AppWithPendingTxStream.
 type AppCreator func(log.Logger, dbm.DB, io.Writer, types.AppOptions) Application
 
+// DevInitializer writes the node files and the genesis of a single validator
+// development chain funding the given accounts.
+type DevInitializer func(cfg *cmtcfg.Config, accounts []dev.Account) error
+
 // StartOptions defines options that can be customized in `StartCmd`
 type StartOptions struct {
 	AppCreator      types.AppCreator
 	DefaultNodeHome string
 	DBOpener        DBOpener
+	// EVMExporter streams the evm module genesis state on export, if set.
+	EVMExporter EVMExporter
+	// DevInitializer initializes the development chain started with --dev when
+	// the node has no genesis file, if set.
+	DevInitializer DevInitializer
 }
 
 // NewDefaultStartOptions use the default db opener provided in tm-db.
@@ -112,6 +126,12 @@ will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+For local development, the '--dev' flag runs a single validator chain which mines the blocks on demand,
+as soon as transactions are received or evm_mine is called. The chain is initialized on the first start
+with funded accounts derived from the '--dev.mnemonic' flag, and the JSON-RPC server exposes the evm
+namespace (evm_mine, evm_setNextBlockTimestamp, evm_increaseTime, evm_snapshot and evm_revert).
+Each mined block is preceded by an empty block, and the reverted states are committed in new blocks.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
@@ -204,6 +224,16 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, cosmosevmserverconfig.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 evmos)")                    //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, cosmosevmserverconfig.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
 	cmd.Flags().Duration(srvflags.JSONRPCEVMTimeout, cosmosevmserverconfig.DefaultEVMTimeout, "Sets a timeout used for eth_call (0=infinite)")
+	cmd.Flags().Uint64(srvflags.JSONRPCCallGasCap, 0, "Sets a cap on gas that can be used in eth_call (0=use gas-cap)")
+	cmd.Flags().Uint64(srvflags.JSONRPCEstimateGasCap, 0, "Sets a cap on gas that can be used in eth_estimateGas (0=use gas-cap)")
+	cmd.Flags().Duration(srvflags.JSONRPCTraceTimeout, 0, "Sets a cap on the timeout of the debug_trace* methods (0=use the requested timeouts)")
+	cmd.Flags().String(srvflags.JSONRPCRawTxFeeCap, "", "Sets a cap on the fee of the transactions sent with eth_sendRawTransaction, in the EVM base denom (empty=no cap)") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCReadConcurrency, 0, "Sets the number of cheap read requests served concurrently (0=no limit)")
+	cmd.Flags().Int(srvflags.JSONRPCReadQueueSize, cosmosevmserverconfig.DefaultReadQueueSize, "Sets the number of cheap read requests waiting for a worker")
+	cmd.Flags().Int(srvflags.JSONRPCCallConcurrency, 0, "Sets the number of eth_call/estimateGas requests served concurrently (0=no limit)")
+	cmd.Flags().Int(srvflags.JSONRPCCallQueueSize, cosmosevmserverconfig.DefaultCallQueueSize, "Sets the number of eth_call/estimateGas requests waiting for a worker")
+	cmd.Flags().Int(srvflags.JSONRPCTraceConcurrency, 0, "Sets the number of debug_trace* requests served concurrently (0=no limit)")
+	cmd.Flags().Int(srvflags.JSONRPCTraceQueueSize, cosmosevmserverconfig.DefaultTraceQueueSize, "Sets the number of debug_trace* requests waiting for a worker")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPTimeout, cosmosevmserverconfig.DefaultHTTPTimeout, "Sets a read/write timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, cosmosevmserverconfig.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, cosmosevmserverconfig.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
@@ -213,15 +243,45 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "the backend of the custom tx indexer (kv|psql)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerPSQLConn, "", "the PostgreSQL connection string used by the psql tx indexer backend")
+	cmd.Flags().String(srvflags.JSONRPCIndexerSinkFile, "", "the file, relative to the node data directory, the indexed eth txs are streamed to as JSON lines (empty disables it)") //nolint:lll
+	cmd.Flags().Uint64(srvflags.JSONRPCIndexerRetainBlocks, 0, "the number of latest blocks retained by the custom tx indexer (0=all)")
+	cmd.Flags().Duration(srvflags.JSONRPCIndexerRetainDuration, 0, "how long the blocks are retained by the custom tx indexer (0=forever)")
+	cmd.Flags().Duration(srvflags.JSONRPCIndexerPruneInterval, cosmosevmserverconfig.DefaultIndexerPruneInterval, "the interval between two prunings of the custom tx indexer")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().String(srvflags.JSONRPCAdminAddress, cosmosevmserverconfig.DefaultJSONRPCAdminAddress, "the loopback address the JSON-RPC admin namespace server listens on") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAdminIPCPath, "", "the unix socket path the JSON-RPC admin namespace is served on (empty disables it)")                                //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAdminAuthTokenFile, "", "the file holding the bearer token required by the JSON-RPC admin namespace server")                           //nolint:lll
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMRejectOversizedTxs, cosmosevmserverconfig.DefaultRejectOversizedTxs, "reject in check tx mode the eth txs whose gas limit exceeds the max tx gas wanted")                  //nolint:lll
 	cmd.Flags().Bool(srvflags.EVMEnablePreimageRecording, cosmosevmserverconfig.DefaultEnablePreimageRecording, "Enables tracking of SHA3 preimages in the EVM (not implemented yet)")                      //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMChainID, cosmosevmserverconfig.DefaultEVMChainID, "the EIP-155 compatible replay protection chain ID")
 	cmd.Flags().Uint64(srvflags.EVMMinTip, cosmosevmserverconfig.DefaultEVMMinTip, "the minimum priority fee for the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, cosmosevmserverconfig.DefaultEVMMempoolPriceBump, "the minimum price bump percentage required to replace an EVM transaction with the same nonce in the mempool") //nolint:lll
+	cmd.Flags().String(srvflags.EVMMempoolJournal, cosmosevmserverconfig.DefaultEVMMempoolJournal, "the file, relative to the node data directory, used to journal the local EVM transactions (empty disables it)")   //nolint:lll
+	cmd.Flags().Duration(srvflags.EVMMempoolRejournal, cosmosevmserverconfig.DefaultEVMMempoolRejournal, "the interval to regenerate the local EVM transactions journal")
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceLimit, cosmosevmserverconfig.DefaultEVMMempoolPriceLimit, "the minimum gas tip required for an EVM transaction to be accepted in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolAccountSlots, cosmosevmserverconfig.DefaultEVMMempoolAccountSlots, "the number of executable EVM transactions guaranteed per account in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalSlots, cosmosevmserverconfig.DefaultEVMMempoolGlobalSlots, "the maximum number of executable EVM transactions for all accounts in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultEVMMempoolAccountQueue, "the maximum number of non-executable EVM transactions per account in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultEVMMempoolGlobalQueue, "the maximum number of non-executable EVM transactions for all accounts in the mempool")
+	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultEVMMempoolLifetime, "the maximum amount of time non-executable EVM transactions are queued in the mempool")
+	cmd.Flags().Uint64(srvflags.EVMMempoolSenderGasQuota, cosmosevmserverconfig.DefaultEVMMempoolSenderGasQuota, "the maximum percentage of the block gas limit the EVM transactions of a single sender can occupy in a block proposal (0 disables it)") //nolint:lll
+	cmd.Flags().StringSlice(srvflags.EVMMempoolSenderGasQuotaAllowlist, nil, "the hex addresses of the senders exempted from the EVM mempool sender gas quota")
 	cmd.Flags().String(srvflags.EvmGethMetricsAddress, cosmosevmserverconfig.DefaultGethMetricsAddress, "the address to bind the geth metrics server to")
+	cmd.Flags().String(srvflags.EVMStreamingFile, "", "the file, relative to the node data directory, the committed Ethereum-formatted blocks are streamed to (empty disables it)") //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMQueryCacheCodeSize, 0, "the number of contract codes cached for the queries against the committed state (0 disables it)")
+	cmd.Flags().Uint64(srvflags.EVMQueryCacheAccountSize, 0, "the number of account records cached for the queries against the committed state (0 disables it)")
+	cmd.Flags().Uint64(srvflags.EVMQueryCacheStorageSize, 0, "the number of storage slots cached for the queries against the committed state (0 disables it)")
+	cmd.Flags().Uint64(srvflags.EVMParallelExecutionWorkers, 0, "the number of workers executing the transactions of the finalized blocks concurrently (0 disables it)")
+
+	cmd.Flags().Bool(srvflags.DevMode, false, "Run a single validator development chain mining the blocks on demand, with funded accounts and the evm JSON-RPC namespace") //nolint:lll
+	cmd.Flags().Int(srvflags.DevAccounts, dev.DefaultAccounts, "the number of funded accounts of the development chain")
+	cmd.Flags().String(srvflags.DevMnemonic, dev.DefaultMnemonic, "the mnemonic the funded accounts of the development chain are derived from")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -349,6 +409,19 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		}()
 	}
 
+	var (
+		devMode     = svrCtx.Viper.GetBool(srvflags.DevMode)
+		devAccounts []dev.Account
+		devChain    *dev.Chain
+	)
+	if devMode {
+		devAccounts, err = setupDevMode(svrCtx, opts)
+		if err != nil {
+			logger.Error("failed to set up the dev mode", "error", err.Error())
+			return err
+		}
+	}
+
 	db, err := opts.DBOpener(svrCtx.Viper, home, server.GetAppDBBackend(svrCtx.Viper))
 	if err != nil {
 		logger.Error("failed to open DB", "error", err.Error())
@@ -394,6 +467,10 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	}
 	evmApp.SetClientCtx(clientCtx)
 
+	if devMode {
+		devChain = dev.NewChain(logger.With("module", "dev"), app.CommitMultiStore())
+	}
+
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
 	if err != nil {
 		logger.Error("failed load or gen node key", "error", err.Error())
@@ -414,10 +491,18 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	} else {
 		logger.Info("starting node with ABCI CometBFT in-process")
 
-		cmtApp := server.NewCometABCIWrapper(app)
+		var (
+			cmtApp abci.Application       = server.NewCometABCIWrapper(app)
+			pv     cmttypes.PrivValidator = pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile())
+		)
+		if devChain != nil {
+			cmtApp = devChain.WrapApplication(cmtApp)
+			pv = devChain.WrapPrivValidator(pv)
+		}
+
 		bftNode, err = node.NewNode(
 			cfg,
-			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+			pv,
 			nodeKey,
 			proxy.NewLocalClientCreator(cmtApp),
 			genDocProvider,
@@ -443,6 +528,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 				_ = bftNode.Stop()
 			}
 		}()
+
+		if devChain != nil {
+			devChain.SetMempoolSize(bftNode.Mempool().Size)
+			// resume the paused consensus first so that the node can stop
+			defer devChain.Stop()
+		}
 	}
 
 	// Add the tx service to the gRPC router. We only need to register this
@@ -469,14 +560,30 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer cosmosevmtypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = NewEVMIndexer(config.JSONRPC, home, server.GetAppDBBackend(svrCtx.Viper), idxLogger, clientCtx)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			logger.Error("failed to open evm indexer", "error", err.Error())
 			return err
 		}
 
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		if config.JSONRPC.IndexerRetainBlocks > 0 || config.JSONRPC.IndexerRetainDuration > 0 {
+			prunableIdxer, ok := idxer.(indexer.PruningIndexer)
+			if !ok {
+				return fmt.Errorf("evm indexer %T does not support pruning", idxer)
+			}
+			pruner := indexer.NewPruner(
+				prunableIdxer,
+				config.JSONRPC.IndexerRetainBlocks,
+				config.JSONRPC.IndexerRetainDuration,
+				config.JSONRPC.IndexerPruneInterval,
+				idxLogger,
+			)
+			g.Go(func() error {
+				return pruner.Run(ctx)
+			})
+		}
+
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -511,12 +618,16 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 		if !ok {
 			return fmt.Errorf("json-rpc server requires AppWithPendingTxStream")
 		}
-		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, txApp, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool))
+		_, err = StartJSONRPC(ctx, svrCtx, clientCtx, g, &config, idxer, txApp, evmApp.GetMempool().(*evmmempool.ExperimentalEVMMempool), devChain)
 		if err != nil {
 			return err
 		}
 	}
 
+	if devMode {
+		dev.PrintAccounts(os.Stdout, devAccounts)
+	}
+
 	// At this point it is safe to block the process if we're in query only mode as
 	// we do not need to start Rosetta or handle any CometBFT related processes.
 	if gRPCOnly {
@@ -530,12 +641,103 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 	return g.Wait()
 }
 
+// setupDevMode configures the node to run a single validator development chain,
+// initializing it if the node has no genesis file, and returns its funded
+// accounts.
+func setupDevMode(svrCtx *server.Context, opts StartOptions) ([]dev.Account, error) {
+	v := svrCtx.Viper
+	cfg := svrCtx.Config
+
+	if v.GetBool(srvflags.GRPCOnly) {
+		return nil, errors.New("the dev mode can't run in gRPC only mode")
+	}
+
+	accounts, err := dev.DeriveAccounts(v.GetString(srvflags.DevMnemonic), v.GetInt(srvflags.DevAccounts))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(cfg.GenesisFile()); os.IsNotExist(err) {
+		if opts.DevInitializer == nil {
+			return nil, fmt.Errorf("genesis file %s not found and the dev chain can't be initialized", cfg.GenesisFile())
+		}
+		svrCtx.Logger.Info("initializing the dev chain", "home", cfg.RootDir)
+		if err := opts.DevInitializer(cfg, accounts); err != nil {
+			return nil, fmt.Errorf("failed to initialize the dev chain: %w", err)
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// the app reads the chain id from the options
+	if v.GetString(flags.FlagChainID) == "" {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+		if err != nil {
+			return nil, err
+		}
+		v.Set(flags.FlagChainID, appGenesis.ChainID)
+	}
+
+	dev.ConfigureConsensus(cfg)
+
+	// the states are kept for the snapshots to be reverted
+	v.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+	v.Set(srvflags.JSONRPCEnable, true)
+	if apis := v.GetStringSlice(srvflags.JSONRPCAPI); !slices.Contains(apis, rpc.EVMNamespace) {
+		v.Set(srvflags.JSONRPCAPI, append(apis, rpc.EVMNamespace))
+	}
+
+	return accounts, nil
+}
+
 // OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
 func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// NewEVMIndexer creates the custom eth indexer on the backend defined in the json-rpc config,
+// streaming the indexed txs to the configured sink file if any.
+func NewEVMIndexer(
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	rootDir string,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (cosmosevmtypes.EVMTxIndexer, error) {
+	var idxer cosmosevmtypes.EVMTxIndexer
+	switch cfg.IndexerBackend {
+	case cosmosevmserverconfig.IndexerBackendPSQL:
+		psqlIdxer, err := indexer.NewPSQLIndexer(cfg.IndexerPSQLConn, logger, clientCtx)
+		if err != nil {
+			return nil, err
+		}
+		idxer = psqlIdxer
+	case cosmosevmserverconfig.IndexerBackendKV, "":
+		idxDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, err
+		}
+		idxer = indexer.NewKVIndexer(idxDB, logger, clientCtx)
+	default:
+		return nil, fmt.Errorf("unknown evm indexer backend %s", cfg.IndexerBackend)
+	}
+
+	if cfg.IndexerSinkFile == "" {
+		return idxer, nil
+	}
+	sinkPath := cfg.IndexerSinkFile
+	if !filepath.IsAbs(sinkPath) {
+		sinkPath = filepath.Join(rootDir, "data", sinkPath)
+	}
+	sinkFile, err := os.OpenFile(filepath.Clean(sinkPath), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open evm indexer sink file: %w", err)
+	}
+	return indexer.NewSinkIndexer(idxer, indexer.NewJSONSink(sinkFile), logger, clientCtx), nil
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
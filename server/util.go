@@ -49,9 +49,10 @@ func AddCommands(
 	rootCmd.AddCommand(
 		startCmd,
 		cometbftCmd,
-		sdkserver.ExportCmd(appExport, opts.DefaultNodeHome),
+		NewExportCmd(appExport, opts.EVMExporter, opts.DefaultNodeHome),
 		version.NewVersionCommand(),
 		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
+		NewRollbackEVMCmd(opts),
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
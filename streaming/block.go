@@ -0,0 +1,25 @@
+package streaming
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+)
+
+// Block is the Ethereum-formatted block emitted once committed, along with the
+// receipts of its transactions.
+type Block struct {
+	Number     hexutil.Uint64 `json:"number"`
+	Hash       common.Hash    `json:"hash"`
+	ParentHash common.Hash    `json:"parentHash"`
+	// Miner is the consensus address of the block proposer.
+	Miner        common.Address             `json:"miner"`
+	Timestamp    hexutil.Uint64             `json:"timestamp"`
+	GasLimit     hexutil.Uint64             `json:"gasLimit"`
+	GasUsed      hexutil.Uint64             `json:"gasUsed"`
+	BaseFee      *hexutil.Big               `json:"baseFeePerGas,omitempty"`
+	Transactions []*rpctypes.RPCTransaction `json:"transactions"`
+	Receipts     []*ethtypes.Receipt        `json:"receipts"`
+}
@@ -0,0 +1,183 @@
+package streaming
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/indexer"
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ storetypes.ABCIListener = &ABCIListener{}
+
+// EVMKeeper defines the EVM keeper methods used by the ABCIListener.
+type EVMKeeper interface {
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
+
+// ABCIListener is an ADR-038 streaming listener building the Ethereum-formatted
+// block and receipts of every finalized block, and publishing them once the block
+// is committed.
+//
+// NOTE: the traces of the transactions are not emitted, since they require the
+// transactions to be re-executed, see the debug JSON-RPC namespace instead.
+type ABCIListener struct {
+	txDecoder sdk.TxDecoder
+	evmKeeper EVMKeeper
+	publisher Publisher
+	logger    log.Logger
+
+	mtx     sync.Mutex
+	pending *Block
+}
+
+// NewABCIListener creates the ABCIListener publishing the committed blocks to the publisher.
+func NewABCIListener(txDecoder sdk.TxDecoder, evmKeeper EVMKeeper, publisher Publisher, logger log.Logger) *ABCIListener {
+	return &ABCIListener{
+		txDecoder: txDecoder,
+		evmKeeper: evmKeeper,
+		publisher: publisher,
+		logger:    logger.With("module", "evm-streaming"),
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener by building the
+// Ethereum-formatted block, published once committed.
+func (l *ABCIListener) ListenFinalizeBlock(ctx context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	block, err := l.buildBlock(sdkCtx, req, res)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to build block %d", req.Height)
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.pending = block
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener by publishing the block built
+// in ListenFinalizeBlock.
+func (l *ABCIListener) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	l.mtx.Lock()
+	block := l.pending
+	l.pending = nil
+	l.mtx.Unlock()
+
+	if block == nil {
+		return nil
+	}
+	if err := l.publisher.Publish(block); err != nil {
+		return errorsmod.Wrapf(err, "failed to publish block %d", block.Number)
+	}
+	return nil
+}
+
+// buildBlock builds the Ethereum-formatted block from the FinalizeBlock messages.
+func (l *ABCIListener) buildBlock(ctx sdk.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) (*Block, error) {
+	txs := make(cmttypes.Txs, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = tx
+	}
+	cmtBlock := &cmttypes.Block{
+		Header: cmttypes.Header{Height: req.Height, Time: req.Time},
+		Data:   cmttypes.Data{Txs: txs},
+	}
+	indexedTxs := indexer.ParseBlock(l.txDecoder, l.logger, cmtBlock, res.TxResults)
+
+	height := uint64(req.Height) //#nosec G115 -- block height is never negative
+	blockHash := common.BytesToHash(req.Hash)
+	baseFee := l.evmKeeper.GetBaseFee(ctx)
+	var chainID *big.Int
+	if chainConfig := evmtypes.GetEthChainConfig(); chainConfig != nil {
+		chainID = chainConfig.ChainID
+	}
+
+	block := &Block{
+		Number:       hexutil.Uint64(height),
+		Hash:         blockHash,
+		ParentHash:   common.BytesToHash(ctx.BlockHeader().LastBlockId.Hash),
+		Miner:        common.BytesToAddress(req.ProposerAddress),
+		Timestamp:    hexutil.Uint64(req.Time.Unix()), //#nosec G115 -- block time is never negative
+		Transactions: make([]*rpctypes.RPCTransaction, 0, len(indexedTxs)),
+		Receipts:     make([]*ethtypes.Receipt, 0, len(indexedTxs)),
+	}
+	if baseFee != nil {
+		block.BaseFee = (*hexutil.Big)(baseFee)
+	}
+	if cp := ctx.ConsensusParams(); cp.Block != nil && cp.Block.MaxGas > 0 {
+		block.GasLimit = hexutil.Uint64(cp.Block.MaxGas)
+	}
+
+	var cumulativeGasUsed uint64
+	for _, indexedTx := range indexedTxs {
+		index := uint64(indexedTx.Result.EthTxIndex) //#nosec G115 -- eth tx index is never negative
+		rpcTx, err := rpctypes.NewRPCTransaction(indexedTx.Msg, blockHash, height, index, baseFee, chainID)
+		if err != nil {
+			return nil, err
+		}
+		block.Transactions = append(block.Transactions, rpcTx)
+
+		cumulativeGasUsed += indexedTx.Result.GasUsed
+		block.Receipts = append(block.Receipts, newReceipt(indexedTx, rpcTx, cumulativeGasUsed, blockHash, height))
+	}
+	block.GasUsed = hexutil.Uint64(cumulativeGasUsed)
+
+	return block, nil
+}
+
+// newReceipt builds the receipt of the given eth tx.
+func newReceipt(
+	indexedTx indexer.IndexedTx,
+	rpcTx *rpctypes.RPCTransaction,
+	cumulativeGasUsed uint64,
+	blockHash common.Hash,
+	height uint64,
+) *ethtypes.Receipt {
+	tx := indexedTx.Msg.AsTransaction()
+
+	status := ethtypes.ReceiptStatusSuccessful
+	if indexedTx.Result.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	logs := indexedTx.Logs
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	receipt := &ethtypes.Receipt{
+		Type:              tx.Type(),
+		Status:            status,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Logs:              logs,
+		TxHash:            indexedTx.Hash,
+		GasUsed:           indexedTx.Result.GasUsed,
+		EffectiveGasPrice: rpcTx.GasPrice.ToInt(),
+		BlockHash:         blockHash,
+		BlockNumber:       new(big.Int).SetUint64(height),
+		TransactionIndex:  uint(indexedTx.Result.EthTxIndex), //#nosec G115 -- eth tx index is never negative
+	}
+	if tx.To() == nil {
+		receipt.ContractAddress = crypto.CreateAddress(rpcTx.From, tx.Nonce())
+	}
+	receipt.Bloom = ethtypes.CreateBloom(receipt)
+	return receipt
+}
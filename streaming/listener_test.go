@@ -0,0 +1,145 @@
+package streaming
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/testutil/config"
+	"github.com/cosmos/evm/testutil/constants"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockEVMKeeper struct {
+	baseFee *big.Int
+}
+
+func (m mockEVMKeeper) GetBaseFee(sdk.Context) *big.Int {
+	return m.baseFee
+}
+
+type mockPublisher struct {
+	blocks []*Block
+}
+
+func (m *mockPublisher) Publish(block *Block) error {
+	m.blocks = append(m.blocks, block)
+	return nil
+}
+
+func (m *mockPublisher) Close() error {
+	return nil
+}
+
+func TestABCIListener(t *testing.T) {
+	chainID := uint64(config.EighteenDecimalsChainID)
+	encodingConfig := encoding.MakeConfig(chainID)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	require.NoError(t, config.EvmAppOptions(chainID))
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	// contract creation, to check the receipt contract address
+	tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		Nonce:    3,
+		Amount:   big.NewInt(0),
+		GasLimit: 100000,
+		GasPrice: big.NewInt(10),
+		Input:    []byte{0x60, 0x00},
+	})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	cosmosTx, err := tx.BuildTx(encodingConfig.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := encodingConfig.TxConfig.TxEncoder()(cosmosTx)
+	require.NoError(t, err)
+
+	blockTime := time.Unix(1_700_000_000, 0)
+	req := abci.RequestFinalizeBlock{
+		Height:          10,
+		Hash:            common.HexToHash("0x0a").Bytes(),
+		Time:            blockTime,
+		ProposerAddress: common.HexToAddress("0x0b").Bytes(),
+		Txs:             [][]byte{txBz},
+	}
+	res := abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{
+				Code:    0,
+				GasUsed: 53000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "0"},
+						{Key: "txGasUsed", Value: "53000"},
+						{Key: "txHash", Value: ""},
+					}},
+				},
+			},
+		},
+	}
+	ctx := sdk.Context{}.
+		WithBlockHeader(cmtproto.Header{
+			Height:      10,
+			LastBlockId: cmtproto.BlockID{Hash: common.HexToHash("0x09").Bytes()},
+		}).
+		WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 10_000_000}})
+
+	publisher := &mockPublisher{}
+	listener := NewABCIListener(encodingConfig.TxConfig.TxDecoder(), mockEVMKeeper{baseFee: big.NewInt(7)}, publisher, log.NewNopLogger())
+
+	require.NoError(t, listener.ListenFinalizeBlock(ctx, req, res))
+	// the block is only published once committed
+	require.Empty(t, publisher.blocks)
+	require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	require.Len(t, publisher.blocks, 1)
+	// nothing left to publish
+	require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+	require.Len(t, publisher.blocks, 1)
+
+	block := publisher.blocks[0]
+	require.Equal(t, uint64(10), uint64(block.Number))
+	require.Equal(t, common.HexToHash("0x0a"), block.Hash)
+	require.Equal(t, common.HexToHash("0x09"), block.ParentHash)
+	require.Equal(t, common.HexToAddress("0x0b"), block.Miner)
+	require.Equal(t, uint64(blockTime.Unix()), uint64(block.Timestamp))
+	require.Equal(t, uint64(10_000_000), uint64(block.GasLimit))
+	require.Equal(t, uint64(53000), uint64(block.GasUsed))
+	require.Equal(t, big.NewInt(7), block.BaseFee.ToInt())
+
+	require.Len(t, block.Transactions, 1)
+	require.Equal(t, txHash, block.Transactions[0].Hash)
+	require.Equal(t, from, block.Transactions[0].From)
+
+	require.Len(t, block.Receipts, 1)
+	receipt := block.Receipts[0]
+	require.Equal(t, txHash, receipt.TxHash)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, uint64(53000), receipt.GasUsed)
+	require.Equal(t, uint64(53000), receipt.CumulativeGasUsed)
+	require.Equal(t, big.NewInt(10), receipt.EffectiveGasPrice)
+	require.Equal(t, block.Hash, receipt.BlockHash)
+	require.Equal(t, big.NewInt(10), receipt.BlockNumber)
+	require.Equal(t, crypto.CreateAddress(from, 3), receipt.ContractAddress)
+	require.NotNil(t, receipt.Logs)
+}
@@ -0,0 +1,49 @@
+package streaming
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Publisher publishes the committed blocks to an external system, e.g. a file or
+// a message bus such as Kafka or NATS.
+type Publisher interface {
+	Publish(block *Block) error
+	Close() error
+}
+
+var _ Publisher = &FilePublisher{}
+
+// FilePublisher is a Publisher appending every block as a line of JSON to a file.
+type FilePublisher struct {
+	mtx  sync.Mutex
+	file io.WriteCloser
+	enc  *json.Encoder
+}
+
+// NewFilePublisher creates the FilePublisher appending the blocks to the given file,
+// creating it if missing.
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	file, err := os.OpenFile(filepath.Clean(path), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &FilePublisher{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Publish implements Publisher.
+func (p *FilePublisher) Publish(block *Block) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.enc.Encode(block)
+}
+
+// Close implements Publisher.
+func (p *FilePublisher) Close() error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.file.Close()
+}
@@ -1,7 +1,10 @@
 package ante
 
 import (
+	"math/big"
+
 	"github.com/cosmos/evm/ante/evm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -58,3 +61,57 @@ func (s *EvmUnitAnteTestSuite) TestGlobalFee() {
 		})
 	}
 }
+
+func (s *EvmUnitAnteTestSuite) TestConvertedGlobalFee() {
+	// one unit of the alternative denomination is worth 10 units of the EVM denomination
+	feeConversion := evmtypes.NewFeeConversion("uusdc", sdkmath.LegacyNewDec(10))
+
+	testCases := []struct {
+		name          string
+		expectedError error
+		txFee         *big.Int
+		minGasPrice   sdkmath.LegacyDec
+		gasLimit      sdkmath.LegacyDec
+	}{
+		{
+			name:          "success: if minGasPrice is 0, skip check",
+			expectedError: nil,
+			txFee:         big.NewInt(1),
+			minGasPrice:   sdkmath.LegacyZeroDec(),
+			gasLimit:      sdkmath.LegacyOneDec(),
+		},
+		{
+			name:          "success: converted fee is equal to min gas price * gas limit",
+			expectedError: nil,
+			txFee:         big.NewInt(1000),
+			minGasPrice:   sdkmath.LegacyNewDec(100),
+			gasLimit:      sdkmath.LegacyOneDec(),
+		},
+		{
+			name:          "fail: converted fee is less than min gas price * gas limit",
+			expectedError: errortypes.ErrInsufficientFee,
+			txFee:         big.NewInt(999),
+			minGasPrice:   sdkmath.LegacyNewDec(101),
+			gasLimit:      sdkmath.LegacyOneDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Function under test
+			err := evm.CheckConvertedGlobalFee(
+				tc.txFee,
+				feeConversion,
+				tc.minGasPrice,
+				tc.gasLimit,
+			)
+
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Contains(err.Error(), tc.expectedError.Error())
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/evm/testutil"
 	"github.com/cosmos/evm/testutil/constants"
 	testutiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 
 	"cosmossdk.io/math"
 
@@ -185,6 +186,60 @@ func (s *AnteTestSuite) TestMinGasPriceDecorator() {
 			fmt.Sprintf("expected only native token %s for fee", denom),
 			true,
 		},
+		{
+			"valid cosmos tx with min gas price override = 5, gasPrice = 5 in override denom",
+			func() sdk.Tx {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.MinGasPrice = math.LegacyNewDec(10)
+				params.MinGasPriceOverrides = []feemarkettypes.DenomMinGasPrice{
+					{Denom: "uusdc", MinGasPrice: math.LegacyNewDec(5)},
+				}
+				err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+				s.Require().NoError(err)
+
+				txBuilder := s.CreateTestCosmosTxBuilder(math.NewInt(5), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			true,
+			"",
+			true,
+		},
+		{
+			"invalid cosmos tx with min gas price override = 5, gasPrice = 1 in override denom",
+			func() sdk.Tx {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.MinGasPrice = math.LegacyZeroDec()
+				params.MinGasPriceOverrides = []feemarkettypes.DenomMinGasPrice{
+					{Denom: "uusdc", MinGasPrice: math.LegacyNewDec(5)},
+				}
+				err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+				s.Require().NoError(err)
+
+				txBuilder := s.CreateTestCosmosTxBuilder(math.NewInt(1), "uusdc", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			"provided fee < minimum global fee",
+			true,
+		},
+		{
+			"invalid cosmos tx with fee denom without min gas price override",
+			func() sdk.Tx {
+				params := nw.App.GetFeeMarketKeeper().GetParams(ctx)
+				params.MinGasPrice = math.LegacyZeroDec()
+				params.MinGasPriceOverrides = []feemarkettypes.DenomMinGasPrice{
+					{Denom: "uusdc", MinGasPrice: math.LegacyNewDec(5)},
+				}
+				err := nw.App.GetFeeMarketKeeper().SetParams(ctx, params)
+				s.Require().NoError(err)
+
+				txBuilder := s.CreateTestCosmosTxBuilder(math.NewInt(5), "uosmo", &testMsg)
+				return txBuilder.GetTx()
+			},
+			false,
+			fmt.Sprintf("expected only native token %s for fee", denom),
+			true,
+		},
 	}
 
 	for _, et := range execTypes {
@@ -242,7 +242,7 @@ func (s *TestSuite) TestEIP712() {
 			expectSuccess: !s.useLegacyEIP712TypedData,
 		},
 		{
-			title: "Fails - Two MsgVotes with Different Signers",
+			title: "Succeeds - Two MsgVotes with Different Signers",
 			msgs: []sdk.Msg{
 				govtypes.NewMsgVote(
 					s.createTestAddress(),
@@ -255,7 +255,7 @@ func (s *TestSuite) TestEIP712() {
 					govtypes.OptionAbstain,
 				),
 			},
-			expectSuccess: false,
+			expectSuccess: !s.useLegacyEIP712TypedData,
 		},
 		{
 			title:         "Fails - Empty Transaction",
@@ -275,7 +275,7 @@ func (s *TestSuite) TestEIP712() {
 			expectSuccess: false,
 		},
 		{
-			title: "Fails - Single Message / Multi-Signer",
+			title: "Succeeds - Single Message / Multi-Signer",
 			msgs: []sdk.Msg{
 				&banktypes.MsgMultiSend{
 					Inputs: []banktypes.Input{
@@ -300,7 +300,7 @@ func (s *TestSuite) TestEIP712() {
 					},
 				},
 			},
-			expectSuccess: false,
+			expectSuccess: !s.useLegacyEIP712TypedData,
 		},
 	}
 
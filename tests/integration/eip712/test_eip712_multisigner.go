@@ -0,0 +1,268 @@
+package eip712
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/evm/ethereum/eip712"
+	basefactory "github.com/cosmos/evm/testutil/integration/base/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const multiSignerGasLimit = uint64(300_000)
+
+// setupMultiSignerNetwork starts a network with two funded accounts used as
+// the signers of multi-signer transactions. The second account sends a
+// transaction first, so that the sequences of the signers differ.
+func (s *TestSuite) setupMultiSignerNetwork() (*network.UnitTestNetwork, grpc.Handler, testkeyring.Keyring) {
+	keyring := testkeyring.New(2)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	handler := grpc.NewIntegrationHandler(nw)
+
+	_, err := factory.New(nw, handler).CommitCosmosTx(keyring.GetPrivKey(1), basefactory.CosmosTxArgs{
+		Msgs: []sdk.Msg{banktypes.NewMsgSend(
+			keyring.GetAccAddr(1),
+			keyring.GetAccAddr(0),
+			s.makeCoins(nw.GetBaseDenom(), math.NewInt(1)),
+		)},
+	})
+	s.Require().NoError(err)
+
+	return nw, handler, keyring
+}
+
+// buildMultiSignerTx builds a transaction sending coins from both keyring
+// accounts to the recipient, with empty signatures of the given sign mode. It
+// returns the signer data of each signer.
+func (s *TestSuite) buildMultiSignerTx(
+	nw *network.UnitTestNetwork,
+	handler grpc.Handler,
+	keyring testkeyring.Keyring,
+	recipient sdk.AccAddress,
+	amount sdk.Coins,
+	signMode signing.SignMode,
+) (client.TxBuilder, []authsigning.SignerData) {
+	txConfig := nw.GetEncodingConfig().TxConfig
+	txBuilder := txConfig.NewTxBuilder()
+
+	baseFee, err := handler.GetBaseFee()
+	s.Require().NoError(err)
+	fee := baseFee.BaseFee.MulInt64(int64(multiSignerGasLimit)).MulInt64(2).Ceil().TruncateInt()
+
+	txBuilder.SetGasLimit(multiSignerGasLimit)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(nw.GetBaseDenom(), fee)))
+
+	err = txBuilder.SetMsgs(
+		banktypes.NewMsgSend(keyring.GetAccAddr(0), recipient, amount),
+		banktypes.NewMsgSend(keyring.GetAccAddr(1), recipient, amount),
+	)
+	s.Require().NoError(err)
+
+	signerData := make([]authsigning.SignerData, 2)
+	sigsV2 := make([]signing.SignatureV2, 2)
+	for i := range sigsV2 {
+		key := keyring.GetKey(i)
+		account, err := handler.GetAccount(key.AccAddr.String())
+		s.Require().NoError(err)
+
+		signerData[i] = authsigning.SignerData{
+			ChainID:       nw.GetChainID(),
+			AccountNumber: account.GetAccountNumber(),
+			Sequence:      account.GetSequence(),
+			Address:       key.AccAddr.String(),
+			PubKey:        key.Priv.PubKey(),
+		}
+		sigsV2[i] = signing.SignatureV2{
+			PubKey: key.Priv.PubKey(),
+			Data: &signing.SingleSignatureData{
+				SignMode:  signMode,
+				Signature: nil,
+			},
+			Sequence: account.GetSequence(),
+		}
+	}
+
+	s.Require().NoError(txBuilder.SetSignatures(sigsV2...))
+
+	return txBuilder, signerData
+}
+
+// TestEIP712MultiSigner verifies that a transaction with messages of two
+// different signers, each signing its own EIP-712 payload, is delivered.
+func (s *TestSuite) TestEIP712MultiSigner() {
+	if s.useLegacyEIP712TypedData {
+		s.T().Skip("the legacy EIP-712 typed data does not support multiple signers")
+	}
+
+	signModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	}
+
+	for _, signMode := range signModes {
+		s.Run(signMode.String(), func() {
+			nw, handler, keyring := s.setupMultiSignerNetwork()
+			txConfig := nw.GetEncodingConfig().TxConfig
+
+			recipient := s.createTestAddress()
+			amount := s.makeCoins(nw.GetBaseDenom(), math.NewInt(1000))
+			txBuilder, signerData := s.buildMultiSignerTx(nw, handler, keyring, recipient, amount, signMode)
+
+			sigsV2 := make([]signing.SignatureV2, len(signerData))
+			for i, data := range signerData {
+				signBytes, err := authsigning.GetSignBytesAdapter(
+					nw.GetContext(),
+					txConfig.SignModeHandler(),
+					signMode,
+					data,
+					txBuilder.GetTx(),
+				)
+				s.Require().NoError(err)
+
+				eip712Bytes, err := eip712.GetEIP712BytesForSigner(signBytes, data.PubKey)
+				s.Require().NoError(err)
+
+				sig, err := keyring.GetPrivKey(i).Sign(eip712Bytes)
+				s.Require().NoError(err)
+
+				sigsV2[i] = signing.SignatureV2{
+					PubKey: data.PubKey,
+					Data: &signing.SingleSignatureData{
+						SignMode:  signMode,
+						Signature: sig,
+					},
+					Sequence: data.Sequence,
+				}
+			}
+			s.Require().NoError(txBuilder.SetSignatures(sigsV2...))
+
+			txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+			s.Require().NoError(err)
+
+			res, err := nw.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResults, 1)
+			s.Require().Equal(uint32(0), res.TxResults[0].Code, res.TxResults[0].Log)
+
+			balance, err := handler.GetBalanceFromBank(recipient, nw.GetBaseDenom())
+			s.Require().NoError(err)
+			s.Require().Equal(amount.AmountOf(nw.GetBaseDenom()).MulRaw(2), balance.Balance.Amount)
+
+			// the sequences of both signers are incremented
+			for i, data := range signerData {
+				account, err := handler.GetAccount(keyring.GetAccAddr(i).String())
+				s.Require().NoError(err)
+				s.Require().Equal(data.Sequence+1, account.GetSequence())
+			}
+		})
+	}
+}
+
+// TestEIP712TypedDataQuery verifies that the EIP712TypedData query returns the
+// typed data signed by each signer of a transaction.
+func (s *TestSuite) TestEIP712TypedDataQuery() {
+	if s.useLegacyEIP712TypedData {
+		s.T().Skip("the query returns the non-legacy EIP-712 typed data")
+	}
+
+	nw, handler, keyring := s.setupMultiSignerNetwork()
+	txConfig := nw.GetEncodingConfig().TxConfig
+
+	recipient := s.createTestAddress()
+	amount := s.makeCoins(nw.GetBaseDenom(), math.NewInt(1000))
+
+	// directSignBytes returns the sign bytes of the signer in direct mode
+	directSignBytes := func(txBuilder client.TxBuilder, data authsigning.SignerData) []byte {
+		signBytes, err := authsigning.GetSignBytesAdapter(
+			nw.GetContext(),
+			txConfig.SignModeHandler(),
+			signing.SignMode_SIGN_MODE_DIRECT,
+			data,
+			txBuilder.GetTx(),
+		)
+		s.Require().NoError(err)
+		return signBytes
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(txBuilder client.TxBuilder)
+		signer   int
+	}{
+		{
+			name:   "first signer of a multi-signer transaction",
+			signer: 0,
+		},
+		{
+			name:   "second signer of a multi-signer transaction",
+			signer: 1,
+		},
+		{
+			name: "transaction without signer infos",
+			malleate: func(txBuilder client.TxBuilder) {
+				s.Require().NoError(txBuilder.SetSignatures())
+			},
+			signer: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			txBuilder, signerData := s.buildMultiSignerTx(
+				nw, handler, keyring, recipient, amount, signing.SignMode_SIGN_MODE_DIRECT,
+			)
+			signBytes := directSignBytes(txBuilder, signerData[tc.signer])
+			expectedTypedData, err := eip712.GetEIP712TypedDataForSigner(signBytes, signerData[tc.signer].PubKey)
+			s.Require().NoError(err)
+			expected, err := json.Marshal(expectedTypedData)
+			s.Require().NoError(err)
+
+			if tc.malleate != nil {
+				tc.malleate(txBuilder)
+			}
+
+			txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+			s.Require().NoError(err)
+
+			res, err := nw.GetEvmClient().EIP712TypedData(
+				nw.GetContext(),
+				&evmtypes.QueryEIP712TypedDataRequest{
+					TxBytes:       txBytes,
+					ChainId:       nw.GetChainID(),
+					AccountNumber: signerData[tc.signer].AccountNumber,
+					Sequence:      signerData[tc.signer].Sequence,
+				},
+			)
+			s.Require().NoError(err)
+			s.Require().JSONEq(string(expected), res.TypedData)
+
+			// a wallet signing the returned typed data produces a valid signature of the transaction
+			var typedData apitypes.TypedData
+			s.Require().NoError(json.Unmarshal([]byte(res.TypedData), &typedData))
+			_, rawData, err := apitypes.TypedDataAndHash(typedData)
+			s.Require().NoError(err)
+
+			privKey := keyring.GetPrivKey(tc.signer)
+			sig, err := privKey.Sign([]byte(rawData))
+			s.Require().NoError(err)
+			s.Require().True(privKey.PubKey().VerifySignature(signBytes, sig))
+		})
+	}
+}
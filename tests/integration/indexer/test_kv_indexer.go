@@ -3,6 +3,7 @@ package indexer
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -163,6 +164,7 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 
 			err = idxer.IndexBlock(tc.block, tc.blockResult)
 			require.NoError(t, err)
+			require.NoError(t, idxer.VerifyBlock(tc.block.Height))
 			if !tc.expSuccess {
 				first, err := idxer.FirstIndexedBlock()
 				require.NoError(t, err)
@@ -189,4 +191,238 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 			}
 		})
 	}
+
+	t.Run("backfill missing and corrupted blocks", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+		block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+		blockResult := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+					}},
+				},
+			},
+		}
+		loadBlock := func(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
+			if height == block.Height {
+				return block, blockResult, nil
+			}
+			return &cmttypes.Block{Header: cmttypes.Header{Height: height}}, nil, nil
+		}
+
+		require.NoError(t, idxer.IndexBlock(block, blockResult))
+		require.ErrorIs(t, idxer.VerifyBlock(2), indexer.ErrBlockNotIndexed)
+
+		res, err := indexer.Backfill(idxer, loadBlock, 1, 3, nil)
+		require.NoError(t, err)
+		require.Equal(t, indexer.BackfillResult{From: 1, To: 3, Skipped: 1, Indexed: 2}, res)
+		require.NoError(t, idxer.VerifyBlock(2))
+		require.NoError(t, idxer.VerifyBlock(3))
+
+		// corrupt the block by dropping its tx result
+		require.NoError(t, db.Delete(indexer.TxHashKey(txHash)))
+		require.ErrorIs(t, idxer.VerifyBlock(1), indexer.ErrCorruptedBlock)
+
+		res, err = indexer.Backfill(idxer, loadBlock, 1, 3, nil)
+		require.NoError(t, err)
+		require.Equal(t, indexer.BackfillResult{From: 1, To: 3, Skipped: 2, Repaired: 1}, res)
+		require.NoError(t, idxer.VerifyBlock(1))
+
+		txResult, err := idxer.GetByTxHash(txHash)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), txResult.Height)
+
+		_, err = indexer.Backfill(idxer, loadBlock, 3, 1, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("prune blocks out of the retention window", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+		blockResult := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+					}},
+				},
+			},
+		}
+		genesisTime := time.Unix(1_700_000_000, 0)
+		require.NoError(t, idxer.IndexBlock(
+			&cmttypes.Block{Header: cmttypes.Header{Height: 1, Time: genesisTime}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+			blockResult,
+		))
+		for height := int64(2); height <= 4; height++ {
+			blockTime := genesisTime.Add(time.Duration(height-1) * time.Hour)
+			require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height, Time: blockTime}}, nil))
+		}
+
+		// nothing to prune below the first block
+		res, err := idxer.Prune(1, time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, indexer.PruneResult{RetainHeight: 1}, res)
+		require.NoError(t, idxer.VerifyBlock(1))
+
+		// blocks 1 and 2 are older than 2h before block 4
+		res, err = idxer.Prune(0, genesisTime.Add(90*time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, indexer.PruneResult{RetainHeight: 3, Txs: 1}, res)
+
+		require.ErrorIs(t, idxer.VerifyBlock(1), indexer.ErrBlockNotIndexed)
+		require.ErrorIs(t, idxer.VerifyBlock(2), indexer.ErrBlockNotIndexed)
+		require.NoError(t, idxer.VerifyBlock(3))
+		_, err = idxer.GetByTxHash(txHash)
+		require.Error(t, err)
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), first)
+
+		res, err = idxer.Prune(4, time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, indexer.PruneResult{RetainHeight: 4}, res)
+		require.ErrorIs(t, idxer.VerifyBlock(3), indexer.ErrBlockNotIndexed)
+		require.NoError(t, idxer.VerifyBlock(4))
+	})
+
+	t.Run("record finality and drop replaced blocks", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+		newBlock := func(height int64, parentHash []byte, appHash byte, txs ...cmttypes.Tx) *cmttypes.Block {
+			return &cmttypes.Block{
+				Header:     cmttypes.Header{Height: height, ValidatorsHash: []byte{1}, AppHash: []byte{appHash}},
+				Data:       cmttypes.Data{Txs: txs},
+				LastCommit: &cmttypes.Commit{Height: height - 1, BlockID: cmttypes.BlockID{Hash: parentHash}},
+			}
+		}
+		requireFinalized := func(expected int64) {
+			finalized, err := idxer.LastFinalizedBlock()
+			require.NoError(t, err)
+			require.Equal(t, expected, finalized)
+		}
+		blockResult := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+					}},
+				},
+			},
+		}
+
+		requireFinalized(-1)
+		block1 := newBlock(1, nil, 0)
+		require.NoError(t, idxer.IndexBlock(block1, nil))
+		requireFinalized(-1)
+		block2 := newBlock(2, block1.Hash(), 0)
+		require.NoError(t, idxer.IndexBlock(block2, nil))
+		requireFinalized(1)
+		block3 := newBlock(3, block2.Hash(), 0, txBz)
+		require.NoError(t, idxer.IndexBlock(block3, blockResult))
+		require.NoError(t, idxer.IndexBlock(newBlock(4, block3.Hash(), 0), nil))
+		requireFinalized(3)
+
+		// re-indexing the same block keeps the blocks above it
+		require.NoError(t, idxer.IndexBlock(block3, blockResult))
+		require.NoError(t, idxer.VerifyBlock(4))
+		requireFinalized(3)
+
+		// the block replacing block 3 drops it along with the blocks above it
+		block3b := newBlock(3, block2.Hash(), 1)
+		require.NoError(t, idxer.IndexBlock(block3b, nil))
+		require.NoError(t, idxer.VerifyBlock(3))
+		require.ErrorIs(t, idxer.VerifyBlock(4), indexer.ErrBlockNotIndexed)
+		_, err := idxer.GetByTxHash(txHash)
+		require.Error(t, err)
+		requireFinalized(2)
+
+		// block 3 is dropped if its hash doesn't match the one committed by block 4
+		require.NoError(t, idxer.IndexBlock(newBlock(4, block3.Hash(), 0), nil))
+		require.ErrorIs(t, idxer.VerifyBlock(3), indexer.ErrBlockNotIndexed)
+		require.NoError(t, idxer.VerifyBlock(4))
+		requireFinalized(2)
+
+		// the committed block 3 is finalized once re-indexed
+		require.NoError(t, idxer.IndexBlock(block3, blockResult))
+		require.NoError(t, idxer.IndexBlock(newBlock(4, block3.Hash(), 0), nil))
+		requireFinalized(3)
+		txResult, err := idxer.GetByTxHash(txHash)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), txResult.Height)
+	})
+
+	t.Run("rollback blocks above height", func(t *testing.T) {
+		db := dbm.NewMemDB()
+		idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+		blockResult := []*abci.ExecTxResult{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "amount", Value: "1000"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "txHash", Value: ""},
+						{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+					}},
+				},
+			},
+		}
+		var parentHash []byte
+		for height := int64(1); height <= 4; height++ {
+			block := &cmttypes.Block{
+				Header:     cmttypes.Header{Height: height, ValidatorsHash: []byte{1}},
+				LastCommit: &cmttypes.Commit{Height: height - 1, BlockID: cmttypes.BlockID{Hash: parentHash}},
+			}
+			var txResults []*abci.ExecTxResult
+			if height == 3 {
+				block.Data.Txs = []cmttypes.Tx{txBz}
+				txResults = blockResult
+			}
+			require.NoError(t, idxer.IndexBlock(block, txResults))
+			parentHash = block.Hash()
+		}
+		finalized, err := idxer.LastFinalizedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), finalized)
+
+		require.NoError(t, idxer.Rollback(2))
+		require.NoError(t, idxer.VerifyBlock(2))
+		require.ErrorIs(t, idxer.VerifyBlock(3), indexer.ErrBlockNotIndexed)
+		require.ErrorIs(t, idxer.VerifyBlock(4), indexer.ErrBlockNotIndexed)
+		_, err = idxer.GetByTxHash(txHash)
+		require.Error(t, err)
+		finalized, err = idxer.LastFinalizedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), finalized)
+
+		// rolling back above the indexed blocks is a no-op
+		require.NoError(t, idxer.Rollback(10))
+		require.NoError(t, idxer.VerifyBlock(2))
+	})
 }
@@ -0,0 +1,188 @@
+package indexer
+
+import (
+	"database/sql"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// PSQLIndexerDSNEnv is the environment variable holding the connection string of
+// the PostgreSQL database used by TestPSQLIndexer, which is skipped if unset.
+// The indexer tables of the database are dropped by the test.
+const PSQLIndexerDSNEnv = "COSMOS_EVM_PSQL_INDEXER_DSN"
+
+func TestPSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	dsn := os.Getenv(PSQLIndexerDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", PSQLIndexerDSNEnv)
+	}
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   big.NewInt(1000),
+		GasLimit: 21000,
+	})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	blockResult := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+
+	// newIndexer returns an indexer on an empty schema of the database
+	newIndexer := func(t *testing.T) *indexer.PSQLIndexer {
+		t.Helper()
+		db, err := sql.Open("postgres", dsn)
+		require.NoError(t, err)
+		_, err = db.Exec(`DROP TABLE IF EXISTS eth_logs, eth_txs, eth_blocks;`)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+
+		idxer, err := indexer.NewPSQLIndexer(dsn, log.NewNopLogger(), clientCtx)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, idxer.Close()) })
+		return idxer
+	}
+	requireIndexedRange := func(t *testing.T, idxer *indexer.PSQLIndexer, expFirst, expLast int64) {
+		t.Helper()
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, expFirst, first)
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.Equal(t, expLast, last)
+	}
+
+	t.Run("index blocks with and without eth txs", func(t *testing.T) {
+		idxer := newIndexer(t)
+		requireIndexedRange(t, idxer, -1, -1)
+
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 1}}, nil))
+		require.NoError(t, idxer.IndexBlock(
+			&cmttypes.Block{Header: cmttypes.Header{Height: 2}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+			blockResult,
+		))
+		require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: 3}}, nil))
+
+		// the indexed range includes the blocks without eth txs
+		requireIndexedRange(t, idxer, 1, 3)
+		for height := int64(1); height <= 3; height++ {
+			require.NoError(t, idxer.VerifyBlock(height))
+		}
+		require.ErrorIs(t, idxer.VerifyBlock(4), indexer.ErrBlockNotIndexed)
+
+		res1, err := idxer.GetByTxHash(txHash)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), res1.Height)
+		require.Equal(t, uint64(21000), res1.GasUsed)
+		res2, err := idxer.GetByBlockAndIndex(2, 0)
+		require.NoError(t, err)
+		require.Equal(t, res1, res2)
+		_, err = idxer.GetByBlockAndIndex(3, 0)
+		require.Error(t, err)
+	})
+
+	t.Run("prune blocks out of the retention window", func(t *testing.T) {
+		idxer := newIndexer(t)
+
+		genesisTime := time.Unix(1_700_000_000, 0)
+		require.NoError(t, idxer.IndexBlock(
+			&cmttypes.Block{Header: cmttypes.Header{Height: 1, Time: genesisTime}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}},
+			blockResult,
+		))
+		for height := int64(2); height <= 4; height++ {
+			blockTime := genesisTime.Add(time.Duration(height-1) * time.Hour)
+			require.NoError(t, idxer.IndexBlock(&cmttypes.Block{Header: cmttypes.Header{Height: height, Time: blockTime}}, nil))
+		}
+
+		// blocks 1 and 2 are older than 2h before block 4
+		res, err := idxer.Prune(0, genesisTime.Add(90*time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, indexer.PruneResult{RetainHeight: 3, Txs: 1}, res)
+		requireIndexedRange(t, idxer, 3, 4)
+		require.ErrorIs(t, idxer.VerifyBlock(2), indexer.ErrBlockNotIndexed)
+		_, err = idxer.GetByTxHash(txHash)
+		require.Error(t, err)
+	})
+
+	t.Run("record finality and rollback blocks above height", func(t *testing.T) {
+		idxer := newIndexer(t)
+
+		var parentHash []byte
+		for height := int64(1); height <= 4; height++ {
+			block := &cmttypes.Block{
+				Header:     cmttypes.Header{Height: height, ValidatorsHash: []byte{1}},
+				LastCommit: &cmttypes.Commit{Height: height - 1, BlockID: cmttypes.BlockID{Hash: parentHash}},
+			}
+			var txResults []*abci.ExecTxResult
+			if height == 3 {
+				block.Data.Txs = []cmttypes.Tx{txBz}
+				txResults = blockResult
+			}
+			require.NoError(t, idxer.IndexBlock(block, txResults))
+			parentHash = block.Hash()
+		}
+		finalized, err := idxer.LastFinalizedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), finalized)
+
+		require.NoError(t, idxer.Rollback(2))
+		requireIndexedRange(t, idxer, 1, 2)
+		require.ErrorIs(t, idxer.VerifyBlock(3), indexer.ErrBlockNotIndexed)
+		_, err = idxer.GetByTxHash(txHash)
+		require.Error(t, err)
+		finalized, err = idxer.LastFinalizedBlock()
+		require.NoError(t, err)
+		require.Equal(t, int64(2), finalized)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/testutil/integration/base/factory"
 	"github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -92,6 +93,32 @@ func (s *IntegrationTestSuite) createEVMValueTransferDynamicFeeTx(key keyring.Ke
 	return tx
 }
 
+// createEVMBatchTx creates a Cosmos transaction batching EVM value transfers
+// with sequential nonces, the same fee cap and the given tip caps
+func (s *IntegrationTestSuite) createEVMBatchTx(key keyring.Key, gasFeeCap *big.Int, gasTipCaps ...*big.Int) sdk.Tx {
+	to := s.keyring.GetKey(1).Addr
+
+	msgs := make([]sdk.Msg, 0, len(gasTipCaps))
+	for i, gasTipCap := range gasTipCaps {
+		ethTxArgs := evmtypes.EvmTxArgs{
+			Nonce:     uint64(i),
+			To:        &to,
+			Amount:    big.NewInt(1000),
+			GasLimit:  TxGas,
+			GasFeeCap: gasFeeCap,
+			GasTipCap: gasTipCap,
+		}
+		msg, err := s.factory.GenerateSignedMsgEthereumTx(key.Priv, ethTxArgs)
+		s.Require().NoError(err)
+		msgs = append(msgs, &msg)
+	}
+
+	tx, err := utiltx.PrepareEthTx(s.network.App.GetTxConfig(), nil, msgs...)
+	s.Require().NoError(err)
+
+	return tx
+}
+
 // createEVMContractDeployTx creates an EVM transaction for contract deployment
 func (s *IntegrationTestSuite) createEVMContractDeployTx(key keyring.Key, gasPrice *big.Int, data []byte) sdk.Tx {
 	ethTxArgs := evmtypes.EvmTxArgs{
@@ -175,6 +202,15 @@ func (s *IntegrationTestSuite) getTxHash(tx sdk.Tx) string {
 	return hex.EncodeToString(tmhash.Sum(txBytes))
 }
 
+// gasPriceWithTip returns the gas price paying the given tip, in gaatom/gas, over the current base fee
+func (s *IntegrationTestSuite) gasPriceWithTip(tip int64) *big.Int {
+	gasPrice := new(big.Int).Mul(big.NewInt(tip), big.NewInt(1000000000))
+	if baseFee := s.network.App.GetEVMKeeper().GetBaseFee(s.network.GetContext()); baseFee != nil {
+		gasPrice.Add(gasPrice, baseFee)
+	}
+	return gasPrice
+}
+
 // calculateCosmosGasPrice calculates the gas price for a Cosmos transaction
 func (s *IntegrationTestSuite) calculateCosmosGasPrice(feeAmount int64, gasLimit uint64) *big.Int {
 	return new(big.Int).Div(big.NewInt(feeAmount), big.NewInt(int64(gasLimit))) //#nosec G115 -- not concern, test
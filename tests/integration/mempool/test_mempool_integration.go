@@ -14,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // TestMempoolInsert tests transaction insertion into the mempool
@@ -801,6 +802,52 @@ func (s *IntegrationTestSuite) TestTransactionOrdering() {
 				s.Require().Nil(iterator)
 			},
 		},
+		{
+			name: "batched EVM transactions ordered by the lowest effective tip of their messages",
+			setupTxs: func() {
+				// The batch has the highest fee cap, but a tip of 1 gaatom/gas for
+				// one of its messages, below the tips of the other transactions
+				batchTx := s.createEVMBatchTx(s.keyring.GetKey(2), s.gasPriceWithTip(10), big.NewInt(3000000000), big.NewInt(1000000000))
+				evmTx := s.createEVMValueTransferTx(s.keyring.GetKey(0), 0, s.gasPriceWithTip(2))
+				cosmosTx := s.createCosmosSendTx(s.keyring.GetKey(3), s.gasPriceWithTip(4))
+
+				mpool := s.network.App.GetMempool()
+
+				err := mpool.Insert(s.network.GetContext(), batchTx)
+				s.Require().NoError(err)
+				err = mpool.Insert(s.network.GetContext(), evmTx)
+				s.Require().NoError(err)
+				err = mpool.Insert(s.network.GetContext(), cosmosTx)
+				s.Require().NoError(err)
+			},
+			verifyFunc: func(iterator mempool.Iterator) {
+				// First: Cosmos with a 4 gaatom/gas tip
+				tx1 := iterator.Tx()
+				s.Require().NotNil(tx1)
+				_, ok := tx1.GetMsgs()[0].(*banktypes.MsgSend)
+				s.Require().True(ok, "First transaction should be Cosmos")
+
+				// Second: EVM with a 2 gaatom/gas tip
+				iterator = iterator.Next()
+				s.Require().NotNil(iterator)
+				tx2 := iterator.Tx()
+				s.Require().NotNil(tx2)
+				s.Require().Len(tx2.GetMsgs(), 1)
+				ethMsg2, ok := tx2.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+				s.Require().True(ok, "Second transaction should be EVM")
+				s.Require().Equal(s.gasPriceWithTip(2), ethMsg2.AsTransaction().GasPrice())
+
+				// Third: the batch with a 1 gaatom/gas tip
+				iterator = iterator.Next()
+				s.Require().NotNil(iterator)
+				tx3 := iterator.Tx()
+				s.Require().NotNil(tx3)
+				s.Require().Len(tx3.GetMsgs(), 2, "Third transaction should be the batch")
+
+				iterator = iterator.Next()
+				s.Require().Nil(iterator)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
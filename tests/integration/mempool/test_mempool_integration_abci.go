@@ -3,13 +3,23 @@ package mempool
 import (
 	"encoding/hex"
 	"math/big"
+	"strconv"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
+	"github.com/cosmos/evm/ante"
+	evmmempool "github.com/cosmos/evm/mempool"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
@@ -504,3 +514,107 @@ func (s *IntegrationTestSuite) TestCheckTxHandlerForCommittedAndLowerNonceTxs()
 		})
 	}
 }
+
+// TestCheckTxPriorityEvent tests that CheckTx exposes the priority assigned to the
+// transactions, i.e. the effective tip for EVM transactions and the fee priority for
+// Cosmos transactions
+func (s *IntegrationTestSuite) TestCheckTxPriorityEvent() {
+	s.SetupTest()
+	baseFee := s.network.App.GetEVMKeeper().GetBaseFee(s.network.GetContext())
+
+	checkTxPriority := func(tx sdk.Tx) int64 {
+		res, err := s.checkTx(tx)
+		s.Require().NoError(err)
+		s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+
+		for _, event := range res.Events {
+			if event.Type != sdk.EventTypeTx {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key != ante.AttributeKeyTxPriority {
+					continue
+				}
+				priority, err := strconv.ParseInt(attr.Value, 10, 64)
+				s.Require().NoError(err)
+				return priority
+			}
+		}
+		s.FailNow("no priority emitted by CheckTx")
+		return 0
+	}
+
+	// EVM transactions are prioritized by their effective tip over the base fee
+	lowTipTx := s.createEVMValueTransferDynamicFeeTx(s.keyring.GetKey(0), 0, s.gasPriceWithTip(10), big.NewInt(1000000000))
+	highTipTx := s.createEVMValueTransferDynamicFeeTx(s.keyring.GetKey(2), 0, s.gasPriceWithTip(10), big.NewInt(3000000000))
+
+	lowTipPriority := checkTxPriority(lowTipTx)
+	highTipPriority := checkTxPriority(highTipTx)
+
+	lowTipEthTx := lowTipTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction()
+	s.Require().Equal(evmtypes.GetTxPriority(lowTipEthTx, baseFee), lowTipPriority)
+	highTipEthTx := highTipTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction()
+	s.Require().Equal(evmtypes.GetTxPriority(highTipEthTx, baseFee), highTipPriority)
+	s.Require().Greater(highTipPriority, lowTipPriority)
+
+	// Cosmos transactions fall back to the priority of their fees
+	lowFeePriority := checkTxPriority(s.createCosmosSendTx(s.keyring.GetKey(3), s.gasPriceWithTip(1)))
+	highFeePriority := checkTxPriority(s.createCosmosSendTx(s.keyring.GetKey(4), s.gasPriceWithTip(5)))
+	s.Require().Greater(highFeePriority, lowFeePriority)
+}
+
+// TestCheckTxQueuedNonceGappedTx tests that CheckTx flags a nonce gapped EVM
+// transaction as queued, that the txpool content reports it as queued and that
+// it is promoted to pending once the nonce gap is filled
+func (s *IntegrationTestSuite) TestCheckTxQueuedNonceGappedTx() {
+	s.SetupTest()
+	key := s.keyring.GetKey(0)
+
+	evmMempool, ok := s.network.App.GetMempool().(*evmmempool.ExperimentalEVMMempool)
+	s.Require().True(ok)
+
+	// txPoolHashes returns the hashes of the sender transactions with the given
+	// status, as returned by the txpool_content endpoint
+	txPoolHashes := func(queued bool) []common.Hash {
+		pending, queuedTxs := evmMempool.GetTxPool().Content()
+		txs := pending[key.Addr]
+		if queued {
+			txs = queuedTxs[key.Addr]
+		}
+		hashes := make([]common.Hash, 0, len(txs))
+		for _, tx := range txs {
+			hashes = append(hashes, tx.Hash())
+		}
+		return hashes
+	}
+
+	gappedTx := s.createEVMValueTransferTx(key, 1, big.NewInt(2000000000))
+	gappedHash := gappedTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Hash()
+
+	res, err := s.checkTx(gappedTx)
+	s.Require().NoError(err)
+	s.Require().Equal(evmmempool.Codespace, res.Codespace)
+	s.Require().Equal(evmmempool.ErrTxQueued.ABCICode(), res.Code, res.Log)
+
+	// clients decoding the CheckTx response tell the queued transaction apart from a rejection
+	s.Require().True(evmmempool.IsTxQueuedError(errorsmod.ABCIError(res.Codespace, res.Code, res.Log)))
+	s.Require().False(evmmempool.IsTxQueuedError(errorsmod.ABCIError(sdkerrors.RootCodespace, sdkerrors.ErrInsufficientFee.ABCICode(), res.Log)))
+
+	s.Require().Equal([]common.Hash{gappedHash}, txPoolHashes(true))
+	s.Require().Empty(txPoolHashes(false))
+	s.Require().Equal(0, evmMempool.CountTx())
+
+	// filling the nonce gap promotes the queued transaction
+	fillTx := s.createEVMValueTransferTx(key, 0, big.NewInt(2000000000))
+	fillHash := fillTx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Hash()
+
+	res, err = s.checkTx(fillTx)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+
+	s.Require().Eventually(func() bool {
+		return len(txPoolHashes(true)) == 0 && len(txPoolHashes(false)) == 2
+	}, time.Second, 10*time.Millisecond, "the queued transaction should be promoted")
+	s.Require().Equal([]common.Hash{fillHash, gappedHash}, txPoolHashes(false))
+	s.Require().Equal(2, evmMempool.CountTx())
+}
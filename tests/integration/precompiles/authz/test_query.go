@@ -0,0 +1,285 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	genericAuthorizationTypeURL = "/cosmos.authz.v1beta1.GenericAuthorization"
+	msgDelegateTypeURL          = sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})
+)
+
+// saveGrants grants the MsgSend and MsgDelegate messages from the first to the second
+// account, and the MsgSend message from the third to the second account.
+func (s *PrecompileTestSuite) saveGrants() time.Time {
+	ctx := s.network.GetContext()
+	expiration := ctx.BlockTime().Add(time.Hour).UTC().Truncate(time.Second)
+	authzKeeper := s.network.App.GetAuthzKeeper()
+
+	err := authzKeeper.SaveGrant(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(msgSendTypeURL), nil)
+	s.Require().NoError(err)
+	err = authzKeeper.SaveGrant(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), sdkauthz.NewGenericAuthorization(msgDelegateTypeURL), &expiration)
+	s.Require().NoError(err)
+	err = authzKeeper.SaveGrant(ctx, s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(2), sdkauthz.NewGenericAuthorization(msgSendTypeURL), nil)
+	s.Require().NoError(err)
+
+	return expiration
+}
+
+func (s *PrecompileTestSuite) TestGrants() {
+	method := s.precompile.Methods[authz.GrantsMethod]
+	var expiration time.Time
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*authz.GrantsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - grant of msg type url not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgSendTypeURL, query.PageRequest{}}
+			},
+			func(*authz.GrantsOutput) {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - grant of msg type url",
+			func() []interface{} {
+				expiration = s.saveGrants()
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgDelegateTypeURL, query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Equal([]authz.GrantData{{
+					Granter:           s.keyring.GetAddr(0),
+					Grantee:           s.keyring.GetAddr(1),
+					AuthorizationType: genericAuthorizationTypeURL,
+					MsgTypeUrl:        msgDelegateTypeURL,
+					Expiration:        expiration.Unix(),
+				}}, out.Grants)
+			},
+			false,
+			"",
+		},
+		{
+			"success - all grants of the granter to the grantee",
+			func() []interface{} {
+				s.saveGrants()
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+					s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(0), "", query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Empty(out.Grants)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.Grants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GrantsMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranterGrants() {
+	method := s.precompile.Methods[authz.GranterGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*authz.GrantsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - grants of the granter",
+			func() []interface{} {
+				s.saveGrants()
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				msgTypeURLs := make([]string, len(out.Grants))
+				for i, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(0), grant.Granter)
+					s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+					msgTypeURLs[i] = grant.MsgTypeUrl
+				}
+				s.Require().ElementsMatch([]string{msgSendTypeURL, msgDelegateTypeURL}, msgTypeURLs)
+			},
+			false,
+			"",
+		},
+		{
+			"success - paginated grants of the granter",
+			func() []interface{} {
+				s.saveGrants()
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{Limit: 1}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 1)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.GranterGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GranterGrantsMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGranteeGrants() {
+	method := s.precompile.Methods[authz.GranteeGrantsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(out *authz.GrantsOutput)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*authz.GrantsOutput) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"success - grants of the grantee",
+			func() []interface{} {
+				s.saveGrants()
+				return []interface{}{s.keyring.GetAddr(1), query.PageRequest{Limit: 10, CountTotal: true}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Len(out.Grants, 3)
+				s.Require().Equal(uint64(3), out.PageResponse.Total)
+				granters := make([]common.Address, len(out.Grants))
+				for i, grant := range out.Grants {
+					s.Require().Equal(s.keyring.GetAddr(1), grant.Grantee)
+					granters[i] = grant.Granter
+				}
+				s.Require().ElementsMatch([]common.Address{s.keyring.GetAddr(0), s.keyring.GetAddr(0), s.keyring.GetAddr(2)}, granters)
+			},
+			false,
+			"",
+		},
+		{
+			"success - no grants",
+			func() []interface{} {
+				s.saveGrants()
+				return []interface{}{s.keyring.GetAddr(0), query.PageRequest{}}
+			},
+			func(out *authz.GrantsOutput) {
+				s.Require().Empty(out.Grants)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.GranteeGrants(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out authz.GrantsOutput
+				err = s.precompile.UnpackIntoInterface(&out, authz.GranteeGrantsMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package authz
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/precompiles/authz"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkauthz "github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestGrant() {
+	method := s.precompile.Methods[authz.GrantMethod]
+	expiration := time.Now().Add(time.Hour).Unix()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(stateDB *statedb.StateDB)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - invalid grantee address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), common.Address{}, msgSendTypeURL, int64(0)}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"fail - empty msg type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "", int64(0)}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"invalid msg type url",
+		},
+		{
+			"fail - negative expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgSendTypeURL, int64(-1)}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"invalid expiration",
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), msgSendTypeURL, int64(0)}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - unknown msg type url",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), "/cosmos.bank.v1beta1.MsgUnknown", int64(0)}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"doesn't exist",
+		},
+		{
+			"success - grant without expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgSendTypeURL, int64(0)}
+			},
+			func(stateDB *statedb.StateDB) {
+				authorization, exp := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgSendTypeURL,
+				)
+				s.Require().Equal(sdkauthz.NewGenericAuthorization(msgSendTypeURL), authorization)
+				s.Require().Nil(exp)
+
+				log := stateDB.Logs()[0]
+				s.Require().Equal(s.precompile.Address(), log.Address)
+				event := s.precompile.Events[authz.EventTypeGrant]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), log.Topics[0])
+				s.Require().Equal(common.BytesToHash(s.keyring.GetAddr(0).Bytes()), log.Topics[1])
+				s.Require().Equal(common.BytesToHash(s.keyring.GetAddr(1).Bytes()), log.Topics[2])
+
+				var grantEvent authz.EventGrant
+				err := cmn.UnpackLog(s.precompile.ABI, &grantEvent, authz.EventTypeGrant, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(msgSendTypeURL, grantEvent.MsgTypeUrl)
+			},
+			false,
+			"",
+		},
+		{
+			"success - grant with expiration",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgSendTypeURL, expiration}
+			},
+			func(*statedb.StateDB) {
+				_, exp := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgSendTypeURL,
+				)
+				s.Require().NotNil(exp)
+				s.Require().Equal(expiration, exp.Unix())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			res, err := s.precompile.Grant(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(stateDB)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authz.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(stateDB *statedb.StateDB)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - msg.sender address does not match the granter address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), s.keyring.GetAddr(2), msgSendTypeURL}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - grant not found",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgSendTypeURL}
+			},
+			func(*statedb.StateDB) {},
+			true,
+			"authorization not found",
+		},
+		{
+			"success - grant revoked",
+			func() []interface{} {
+				err := s.network.App.GetAuthzKeeper().SaveGrant(
+					s.network.GetContext(),
+					s.keyring.GetAccAddr(1),
+					s.keyring.GetAccAddr(0),
+					sdkauthz.NewGenericAuthorization(msgSendTypeURL),
+					nil,
+				)
+				s.Require().NoError(err)
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), msgSendTypeURL}
+			},
+			func(stateDB *statedb.StateDB) {
+				authorization, _ := s.network.App.GetAuthzKeeper().GetAuthorization(
+					s.network.GetContext(), s.keyring.GetAccAddr(1), s.keyring.GetAccAddr(0), msgSendTypeURL,
+				)
+				s.Require().Nil(authorization)
+
+				log := stateDB.Logs()[0]
+				event := s.precompile.Events[authz.EventTypeRevoke]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), log.Topics[0])
+
+				var revokeEvent authz.EventRevoke
+				err := cmn.UnpackLog(s.precompile.ABI, &revokeEvent, authz.EventTypeRevoke, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), revokeEvent.Granter)
+				s.Require().Equal(s.keyring.GetAddr(1), revokeEvent.Grantee)
+				s.Require().Equal(msgSendTypeURL, revokeEvent.MsgTypeUrl)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			res, err := s.precompile.Revoke(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck(stateDB)
+			}
+		})
+	}
+}
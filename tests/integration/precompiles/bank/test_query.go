@@ -12,6 +12,8 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func (s *PrecompileTestSuite) TestBalances() {
@@ -278,3 +280,270 @@ func (s *PrecompileTestSuite) TestSupplyOf() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestBalanceOf() {
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.BalanceOfMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expErr      bool
+		errContains string
+		expBalance  *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+				}
+			},
+			true,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"fail - invalid denom",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), "1",
+				}
+			},
+			true,
+			"invalid denom",
+			nil,
+		},
+		{
+			"pass - zero balance for new account",
+			func() []interface{} {
+				return []interface{}{
+					cosmosevmutiltx.GenerateAddress(), s.tokenDenom,
+				}
+			},
+			false,
+			"",
+			big.NewInt(0),
+		},
+		{
+			"pass - balance of a denom without token pair",
+			func() []interface{} {
+				coins := sdk.NewCoins(sdk.NewCoin("unregistered", math.NewInt(100)))
+				err := s.network.App.GetBankKeeper().MintCoins(s.network.GetContext(), minttypes.ModuleName, coins)
+				s.Require().NoError(err)
+				err = s.network.App.GetBankKeeper().SendCoinsFromModuleToAccount(s.network.GetContext(), minttypes.ModuleName, s.keyring.GetAccAddr(0), coins)
+				s.Require().NoError(err)
+
+				return []interface{}{
+					s.keyring.GetAddr(0), "unregistered",
+				}
+			},
+			false,
+			"",
+			big.NewInt(100),
+		},
+		{
+			"pass - XMPL balance",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0), s.tokenDenom,
+				}
+			},
+			false,
+			"",
+			network.PrefundedAccountInitialBalance.BigInt(),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			bz, err := s.precompile.BalanceOf(
+				s.network.GetContext(),
+				&method,
+				args,
+			)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err, "expected no error unpacking")
+				balance, ok := out[0].(*big.Int)
+				s.Require().True(ok, "expected output to be a big.Int")
+				s.Require().Zero(tc.expBalance.Cmp(balance), "expected balance %s, got %s", tc.expBalance, balance)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSupplyOfDenom() {
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.SupplyOfDenomMethod]
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expErr      bool
+		errContains string
+		expSupply   func() *big.Int
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			"invalid number of arguments",
+			nil,
+		},
+		{
+			"pass - zero supply for unknown denom",
+			func() []interface{} {
+				return []interface{}{"unknown"}
+			},
+			false,
+			"",
+			func() *big.Int { return big.NewInt(0) },
+		},
+		{
+			"pass - supply of a denom without token pair",
+			func() []interface{} {
+				coins := sdk.NewCoins(sdk.NewCoin("unregistered", math.NewInt(100)))
+				err := s.network.App.GetBankKeeper().MintCoins(s.network.GetContext(), minttypes.ModuleName, coins)
+				s.Require().NoError(err)
+
+				return []interface{}{"unregistered"}
+			},
+			false,
+			"",
+			func() *big.Int { return big.NewInt(100) },
+		},
+		{
+			"pass - XMPL total supply",
+			func() []interface{} {
+				return []interface{}{s.tokenDenom}
+			},
+			false,
+			"",
+			func() *big.Int {
+				return s.network.App.GetBankKeeper().GetSupply(s.network.GetContext(), s.tokenDenom).Amount.BigInt()
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			bz, err := s.precompile.SupplyOfDenom(
+				s.network.GetContext(),
+				&method,
+				args,
+			)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err, "expected no error unpacking")
+				supply, ok := out[0].(*big.Int)
+				s.Require().True(ok, "expected output to be a big.Int")
+				s.Require().Zero(tc.expSupply().Cmp(supply), "expected supply %s, got %s", tc.expSupply(), supply)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDenomMetadata() {
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.DenomMetadataMethod]
+
+	metadata := banktypes.Metadata{
+		Description: "An unregistered token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "unregistered", Exponent: 0},
+			{Denom: "UNREG", Exponent: 6, Aliases: []string{"unreg"}},
+		},
+		Base:    "unregistered",
+		Display: "UNREG",
+		Name:    "Unregistered",
+		Symbol:  "UNREG",
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expErr      bool
+		errContains string
+		expFound    bool
+		expMetadata bank.DenomMetadata
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			"invalid number of arguments",
+			false,
+			bank.DenomMetadata{},
+		},
+		{
+			"pass - denom without metadata",
+			func() []interface{} {
+				return []interface{}{"unknown"}
+			},
+			false,
+			"",
+			false,
+			bank.DenomMetadata{DenomUnits: []bank.DenomUnit{}},
+		},
+		{
+			"pass - denom with metadata",
+			func() []interface{} {
+				s.network.App.GetBankKeeper().SetDenomMetaData(s.network.GetContext(), metadata)
+				return []interface{}{"unregistered"}
+			},
+			false,
+			"",
+			true,
+			bank.NewDenomMetadata(metadata),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			args := tc.malleate()
+			bz, err := s.precompile.DenomMetadata(
+				s.network.GetContext(),
+				&method,
+				args,
+			)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				var out struct {
+					Metadata bank.DenomMetadata
+					Found    bool
+				}
+				err = s.precompile.UnpackIntoInterface(&out, method.Name, bz)
+				s.Require().NoError(err, "expected no error unpacking")
+				s.Require().Equal(tc.expFound, out.Found)
+				s.Require().Equal(tc.expMetadata, out.Metadata)
+			}
+		})
+	}
+}
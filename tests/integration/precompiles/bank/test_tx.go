@@ -0,0 +1,141 @@
+package bank
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/precompiles/bank"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	cosmosevmutiltx "github.com/cosmos/evm/testutil/tx"
+
+	"cosmossdk.io/math"
+)
+
+func (s *PrecompileTestSuite) TestMultiSend() {
+	// setup test in order to have s.precompile defined
+	s.SetupTest()
+	method := s.precompile.Methods[bank.MultiSendMethod]
+	recipients := []common.Address{
+		cosmosevmutiltx.GenerateAddress(),
+		cosmosevmutiltx.GenerateAddress(),
+	}
+
+	testcases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+				}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 1),
+		},
+		{
+			"fail - sender is not the caller",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					[]bank.Output{{To: recipients[0], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(1)}}}},
+				}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - no outputs",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]bank.Output{},
+				}
+			},
+			func() {},
+			true,
+			"no outputs to send coins to",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				amount := new(big.Int).Add(s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), s.keyring.GetAccAddr(0), s.tokenDenom).Amount.BigInt(), big.NewInt(1))
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]bank.Output{{To: recipients[0], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: amount}}}},
+				}
+			},
+			func() {},
+			true,
+			"transfer amount exceeds balance",
+		},
+		{
+			"pass - send to multiple recipients",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					[]bank.Output{
+						{To: recipients[0], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(100)}}},
+						{To: recipients[1], Amount: []cmn.Coin{{Denom: s.tokenDenom, Amount: big.NewInt(200)}}},
+					},
+				}
+			},
+			func() {
+				bankKeeper := s.network.App.GetBankKeeper()
+				ctx := s.network.GetContext()
+				s.Require().Equal(math.NewInt(100), bankKeeper.GetBalance(ctx, recipients[0].Bytes(), s.tokenDenom).Amount)
+				s.Require().Equal(math.NewInt(200), bankKeeper.GetBalance(ctx, recipients[1].Bytes(), s.tokenDenom).Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			args := tc.malleate()
+			bz, err := s.precompile.MultiSend(ctx, &method, stateDB, contract, args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			outputs := args[1].([]bank.Output)
+			logs := stateDB.Logs()
+			s.Require().Len(logs, len(outputs))
+			for i, log := range logs {
+				s.Require().Equal(s.precompile.Events[bank.EventTypeSend].ID, log.Topics[0])
+
+				var sendEvent bank.EventSend
+				err := cmn.UnpackLog(s.precompile.ABI, &sendEvent, bank.EventTypeSend, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAddr(0), sendEvent.Sender)
+				s.Require().Equal(outputs[i].To, sendEvent.Recipient)
+				s.Require().Equal(outputs[i].Amount, sendEvent.Amount)
+			}
+
+			tc.postCheck()
+		})
+	}
+}
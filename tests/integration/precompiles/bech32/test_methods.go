@@ -164,6 +164,37 @@ func (s *PrecompileTestSuite) TestBech32ToHex() {
 			true,
 			"address max length is 255",
 		},
+		{
+			"fail - address longer than 20 bytes",
+			func() []interface{} {
+				return []interface{}{
+					sdk.AccAddress(make([]byte, 32)).String(),
+				}
+			},
+			func([]byte) {},
+			true,
+			"invalid address length 32, expected 20 bytes",
+		},
+		{
+			"success - valid bech32 address with a HRP containing the separator",
+			func() []interface{} {
+				address, err := sdk.Bech32ifyAddressBytes("cosmos1test", s.keyring.GetAddr(0).Bytes())
+				s.Require().NoError(err)
+				return []interface{}{
+					address,
+				}
+			},
+			func(data []byte) {
+				args, err := s.precompile.Unpack(bech32.Bech32ToHexMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(args, 1)
+				addr, ok := args[0].(common.Address)
+				s.Require().True(ok)
+				s.Require().Equal(s.keyring.GetAddr(0), addr)
+			},
+			false,
+			"",
+		},
 		{
 			"success - valid bech32 address",
 			func() []interface{} {
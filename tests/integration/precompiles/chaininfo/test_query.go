@@ -0,0 +1,105 @@
+package chaininfo
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/cosmos/evm/precompiles/chaininfo"
+	"github.com/cosmos/evm/precompiles/testutil"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *PrecompileTestSuite) TestChainID() {
+	s.SetupTest()
+	method := s.precompile.Methods[chaininfo.ChainIDMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.ChainID(ctx, &method, contract, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	chainID, ok := out[0].(*big.Int)
+	s.Require().True(ok)
+	s.Require().Zero(chainID.Cmp(evmtypes.GetEthChainConfig().ChainID))
+	s.Require().Positive(chainID.Sign())
+}
+
+func (s *PrecompileTestSuite) TestActiveForks() {
+	s.SetupTest()
+	method := s.precompile.Methods[chaininfo.ActiveForksMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.ActiveForks(ctx, &method, contract, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	forks, ok := out[0].([]string)
+	s.Require().True(ok)
+	for _, fork := range []string{"homestead", "london", "merge", "shanghai", "cancun", "prague"} {
+		s.Require().True(slices.Contains(forks, fork), "expected fork %s to be active", fork)
+	}
+}
+
+func (s *PrecompileTestSuite) TestExtraEIPs() {
+	s.SetupTest()
+	method := s.precompile.Methods[chaininfo.ExtraEIPsMethod]
+
+	params := s.network.App.GetEVMKeeper().GetParams(s.network.GetContext())
+	params.ExtraEIPs = []int64{3855}
+	err := s.network.App.GetEVMKeeper().SetParams(s.network.GetContext(), params)
+	s.Require().NoError(err)
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.ExtraEIPs(ctx, &method, contract, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{3855}, out[0])
+}
+
+func (s *PrecompileTestSuite) TestCoinInfo() {
+	s.SetupTest()
+	method := s.precompile.Methods[chaininfo.CoinInfoMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.CoinInfo(ctx, &method, contract, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	var res struct{ CoinInfo chaininfo.CoinInfo }
+	s.Require().NoError(method.Outputs.Copy(&res, out))
+	coinInfo := res.CoinInfo
+	s.Require().Equal(evmtypes.GetEVMCoinDenom(), coinInfo.Denom)
+	s.Require().Equal(evmtypes.GetEVMCoinExtendedDenom(), coinInfo.ExtendedDenom)
+	s.Require().Equal(evmtypes.GetEVMCoinDisplayDenom(), coinInfo.DisplayDenom)
+	s.Require().EqualValues(evmtypes.GetEVMCoinDecimals(), coinInfo.Decimals)
+}
+
+func (s *PrecompileTestSuite) TestModuleVersions() {
+	s.SetupTest()
+	method := s.precompile.Methods[chaininfo.ModuleVersionsMethod]
+
+	contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+	bz, err := s.precompile.ModuleVersions(ctx, &method, contract, nil)
+	s.Require().NoError(err)
+	out, err := method.Outputs.Unpack(bz)
+	s.Require().NoError(err)
+
+	var versions []chaininfo.ModuleVersion
+	s.Require().NoError(method.Outputs.Copy(&versions, out))
+	s.Require().NotEmpty(versions)
+
+	idx := slices.IndexFunc(versions, func(v chaininfo.ModuleVersion) bool {
+		return v.Name == evmtypes.ModuleName
+	})
+	s.Require().NotEqual(-1, idx, "expected the evm module version")
+	s.Require().Positive(versions[idx].Version)
+}
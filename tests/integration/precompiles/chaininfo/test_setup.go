@@ -0,0 +1,56 @@
+package chaininfo
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/chaininfo"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *chaininfo.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(1)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+
+	s.network = nw
+	s.keyring = keyring
+
+	// NOTE: the precompile is taken from the EVM keeper, since the upgrade keeper is not
+	// exposed by the app.
+	precompileAddr := common.HexToAddress(evmtypes.ChainInfoPrecompileAddress)
+	err := s.network.App.GetEVMKeeper().EnableStaticPrecompiles(s.network.GetContext(), precompileAddr)
+	s.Require().NoError(err)
+
+	params := s.network.App.GetEVMKeeper().GetParams(s.network.GetContext())
+	precompile, found, err := s.network.App.GetEVMKeeper().GetStaticPrecompileInstance(&params, precompileAddr)
+	s.Require().NoError(err)
+	s.Require().True(found, "expected chain info precompile to be active")
+
+	var ok bool
+	s.precompile, ok = precompile.(*chaininfo.Precompile)
+	s.Require().True(ok, "expected chain info precompile type")
+}
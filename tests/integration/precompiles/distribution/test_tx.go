@@ -232,6 +232,165 @@ func (s *PrecompileTestSuite) TestWithdrawDelegatorReward() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestWithdrawDelegatorRewardTo() {
+	var ctx sdk.Context
+	method := s.precompile.Methods[distribution.WithdrawDelegatorRewardToMethod]
+	receiver := utiltx.GenerateAddress()
+	prevWithdrawer := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func(val stakingtypes.Validator) []interface{}
+		postCheck   func(data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func(stakingtypes.Validator) []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid receiver address",
+			func(val stakingtypes.Validator) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					val.OperatorAddress,
+					common.Address{},
+				}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(distribution.ErrInvalidReceiver, common.Address{}),
+		},
+		{
+			"fail - delegator is not the caller",
+			func(val stakingtypes.Validator) []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					val.OperatorAddress,
+					receiver,
+				}
+			},
+			func([]byte) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - withdraw address changes are disabled",
+			func(val stakingtypes.Validator) []interface{} {
+				params, err := s.network.App.GetDistrKeeper().Params.Get(ctx)
+				s.Require().NoError(err)
+				params.WithdrawAddrEnabled = false
+				s.Require().NoError(s.network.App.GetDistrKeeper().Params.Set(ctx, params))
+
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					val.OperatorAddress,
+					receiver,
+				}
+			},
+			func([]byte) {},
+			true,
+			"set withdraw address disabled",
+		},
+		{
+			"success - withdraw rewards to the receiver",
+			func(val stakingtypes.Validator) []interface{} {
+				var err error
+				ctx, err = s.prepareStakingRewards(
+					ctx,
+					stakingRewards{
+						Validator: val,
+						Delegator: s.keyring.GetAccAddr(0),
+						RewardAmt: testRewardsAmt,
+					},
+				)
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					val.OperatorAddress,
+					receiver,
+				}
+			},
+			func(data []byte) {
+				var coins []cmn.Coin
+				err := s.precompile.UnpackIntoInterface(&coins, distribution.WithdrawDelegatorRewardToMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Equal(testconstants.ExampleAttoDenom, coins[0].Denom)
+				s.Require().Equal(expRewardsAmt.Int64(), coins[0].Amount.Int64())
+
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(expRewardsAmt, balance.Amount)
+
+				// the withdraw address of the delegator is not changed
+				withdrawAddr, err := s.network.App.GetDistrKeeper().GetDelegatorWithdrawAddr(ctx, s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+				s.Require().Equal(s.keyring.GetAccAddr(0), withdrawAddr)
+			},
+			false,
+			"",
+		},
+		{
+			"success - withdraw rewards to the receiver keeps the previous withdraw address",
+			func(val stakingtypes.Validator) []interface{} {
+				err := s.network.App.GetDistrKeeper().SetWithdrawAddr(ctx, s.keyring.GetAccAddr(0), prevWithdrawer.Bytes())
+				s.Require().NoError(err)
+				ctx, err = s.prepareStakingRewards(
+					ctx,
+					stakingRewards{
+						Validator: val,
+						Delegator: s.keyring.GetAccAddr(0),
+						RewardAmt: testRewardsAmt,
+					},
+				)
+				s.Require().NoError(err)
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					val.OperatorAddress,
+					receiver,
+				}
+			},
+			func([]byte) {
+				balance := s.network.App.GetBankKeeper().GetBalance(ctx, receiver.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().Equal(expRewardsAmt, balance.Amount)
+				balance = s.network.App.GetBankKeeper().GetBalance(ctx, prevWithdrawer.Bytes(), testconstants.ExampleAttoDenom)
+				s.Require().True(balance.Amount.IsZero())
+
+				withdrawAddr, err := s.network.App.GetDistrKeeper().GetDelegatorWithdrawAddr(ctx, s.keyring.GetAccAddr(0))
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.AccAddress(prevWithdrawer.Bytes()), withdrawAddr)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var contract *vm.Contract
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			args := tc.malleate(s.network.GetValidators()[0])
+			bz, err := s.precompile.WithdrawDelegatorRewardTo(ctx, contract, s.network.GetStateDB(), &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestWithdrawValidatorCommission() {
 	var (
 		ctx         sdk.Context
@@ -494,6 +653,136 @@ func (s *PrecompileTestSuite) TestClaimRewards() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestClaimAllRewards() {
+	var (
+		ctx         sdk.Context
+		prevBalance sdk.Coin
+	)
+	method := s.precompile.Methods[distribution.ClaimAllRewardsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expClaimed  int64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid type for maxValidators: expected uint32",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					big.NewInt(1),
+				}
+			},
+			0,
+			true,
+			"invalid type for maxValidators: expected uint32",
+		},
+		{
+			"fail - delegator is not the caller",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(1),
+					uint32(0),
+				}
+			},
+			0,
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - claim from all delegations",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(0),
+				}
+			},
+			3,
+			false,
+			"",
+		},
+		{
+			"success - claim from only 2 delegations",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					uint32(2),
+				}
+			},
+			2,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			var (
+				contract *vm.Contract
+				err      error
+			)
+			addr := s.keyring.GetAddr(0)
+			contract, ctx = testutil.NewPrecompileContract(s.T(), ctx, addr, s.precompile.Address(), 200000)
+
+			validators := s.network.GetValidators()
+			srs := make([]stakingRewards, len(validators))
+			for i, val := range validators {
+				srs[i] = stakingRewards{
+					Delegator: addr.Bytes(),
+					Validator: val,
+					RewardAmt: testRewardsAmt,
+				}
+			}
+
+			ctx, err = s.prepareStakingRewards(ctx, srs...)
+			s.Require().NoError(err)
+
+			// get previous balance to compare final balance after the claim
+			prevBalance = s.network.App.GetBankKeeper().GetBalance(ctx, addr.Bytes(), testconstants.ExampleAttoDenom)
+
+			stateDB := s.network.GetStateDB()
+			bz, err := s.precompile.ClaimAllRewards(ctx, contract, stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			expRewards := expRewardsAmt.Mul(math.NewInt(tc.expClaimed))
+
+			var coins []cmn.Coin
+			err = s.precompile.UnpackIntoInterface(&coins, distribution.ClaimAllRewardsMethod, bz)
+			s.Require().NoError(err, "failed to unpack output")
+			s.Require().Len(coins, 1)
+			s.Require().Equal(testconstants.ExampleAttoDenom, coins[0].Denom)
+			s.Require().Equal(expRewards.BigInt(), coins[0].Amount)
+
+			balance := s.network.App.GetBankKeeper().GetBalance(ctx, addr.Bytes(), testconstants.ExampleAttoDenom)
+			s.Require().Equal(prevBalance.Amount.Add(expRewards), balance.Amount)
+
+			// one WithdrawDelegatorReward event per delegation and the ClaimRewards event
+			logs := stateDB.Logs()
+			s.Require().Len(logs, int(tc.expClaimed)+1)
+			s.Require().Equal(s.precompile.Events[distribution.EventTypeClaimRewards].ID, logs[len(logs)-1].Topics[0])
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestFundCommunityPool() {
 	var ctx sdk.Context
 	method := s.precompile.Methods[distribution.FundCommunityPoolMethod]
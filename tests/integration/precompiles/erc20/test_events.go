@@ -6,9 +6,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	cmn "github.com/cosmos/evm/precompiles/common"
 	"github.com/cosmos/evm/precompiles/erc20"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 //nolint:dupl // this is not a duplicate of the approval events test
@@ -57,6 +62,29 @@ func (s *PrecompileTestSuite) TestEmitTransferEvent() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestEmitPrecompileTransferEvent() {
+	s.SetupTest()
+	from := utiltx.GenerateAddress()
+	to := utiltx.GenerateAddress()
+	ctx := s.network.GetContext().WithEventManager(sdk.NewEventManager())
+
+	err := s.precompile.EmitPrecompileTransferEvent(ctx, from, to, big.NewInt(100))
+	s.Require().NoError(err, "expected precompile transfer event to be emitted successfully")
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	s.Require().NoError(err, "unable to parse the typed event")
+	s.Require().Equal(&erc20types.EventPrecompileTransfer{
+		Sender:       sdk.AccAddress(from.Bytes()).String(),
+		Recipient:    sdk.AccAddress(to.Bytes()).String(),
+		Amount:       "100",
+		Denom:        s.tokenDenom,
+		Erc20Address: s.precompile.Address().Hex(),
+	}, event)
+}
+
 //nolint:dupl // this is not a duplicate of the transfer events test
 func (s *PrecompileTestSuite) TestEmitApprovalEvent() {
 	testcases := []struct {
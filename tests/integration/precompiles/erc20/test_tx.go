@@ -1,8 +1,10 @@
 package erc20
 
 import (
+	"errors"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
 
@@ -118,6 +120,38 @@ func (s *PrecompileTestSuite) TestTransfer() {
 			true,
 			erc20.ErrTransferAmountExceedsBalance.Error(),
 		},
+		{
+			"fail - transfer denied by the transfer restriction",
+			func() []interface{} {
+				s.network.App.GetErc20Keeper().AppendTransferRestriction(
+					func(_ sdk.Context, pair erc20types.TokenPair, spender, _, to common.Address, _ *big.Int) error {
+						s.Require().Equal(tokenDenom, pair.Denom)
+						s.Require().Equal(fromAddr, spender)
+						if to == toAddr {
+							return errors.New("receiver is sanctioned")
+						}
+						return nil
+					},
+				)
+				return []interface{}{toAddr, big.NewInt(100)}
+			},
+			func() {},
+			true,
+			"receiver is sanctioned",
+		},
+		{
+			"pass - transfer allowed by the transfer restriction",
+			func() []interface{} {
+				s.network.App.GetErc20Keeper().AppendTransferRestriction(erc20types.NoOpTransferRestrictionFn)
+				return []interface{}{toAddr, big.NewInt(100)}
+			},
+			func() {
+				toAddrBalance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), toAddr.Bytes(), tokenDenom)
+				s.Require().Equal(big.NewInt(100), toAddrBalance.Amount.BigInt(), "expected toAddr to have 100 XMPL")
+			},
+			false,
+			"",
+		},
 		{
 			"pass",
 			func() []interface{} {
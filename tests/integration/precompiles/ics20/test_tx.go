@@ -5,8 +5,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/evm"
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/ics20"
 	evmibctesting "github.com/cosmos/evm/testutil/ibc"
 	"github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -24,6 +27,7 @@ type testCase struct {
 	channelID          string
 	useDynamicChannel  bool
 	overrideSender     bool
+	noTimeout          bool
 	receiver           string
 	expectErrSubstring string
 }
@@ -53,6 +57,21 @@ func (s *PrecompileTestSuite) TestTransferErrors() {
 			receiver:           defaultReceiver,
 			expectErrSubstring: "channel not found",
 		},
+		{
+			name:               "invalid source port",
+			port:               "other",
+			useDynamicChannel:  true,
+			receiver:           defaultReceiver,
+			expectErrSubstring: "invalid source port (other)",
+		},
+		{
+			name:               "no timeout",
+			port:               transfertypes.PortID,
+			useDynamicChannel:  true,
+			noTimeout:          true,
+			receiver:           defaultReceiver,
+			expectErrSubstring: ics20.ErrNoTimeout,
+		},
 		{
 			name:               "invalid receiver",
 			port:               transfertypes.PortID,
@@ -87,6 +106,11 @@ func (s *PrecompileTestSuite) TestTransferErrors() {
 				sender = tx.GenerateAddress()
 			}
 
+			timeout := timeoutHeight
+			if tc.noTimeout {
+				timeout = clienttypes.ZeroHeight()
+			}
+
 			data, err := s.chainAPrecompile.ABI.Pack(
 				"transfer",
 				tc.port,
@@ -95,7 +119,7 @@ func (s *PrecompileTestSuite) TestTransferErrors() {
 				amount.BigInt(),
 				sender,
 				tc.receiver,
-				timeoutHeight,
+				timeout,
 				uint64(0),
 				"",
 			)
@@ -128,6 +152,7 @@ func (s *PrecompileTestSuite) TestTransfer() {
 	sourceAddr := common.BytesToAddress(s.chainA.SenderAccount.GetAddress().Bytes())
 	receiver := s.chainB.SenderAccount.GetAddress().String()
 	timeoutHeight := clienttypes.NewHeight(1, 110)
+	memo := "transfer memo"
 
 	sourcePort := path.EndpointA.ChannelConfig.PortID
 	sourceChannel := path.EndpointA.ChannelID
@@ -141,11 +166,11 @@ func (s *PrecompileTestSuite) TestTransfer() {
 		receiver,
 		timeoutHeight,
 		uint64(0),
-		"",
+		memo,
 	)
 	s.Require().NoError(err)
 
-	res, _, _, err := s.chainA.SendEvmTx(
+	res, _, ethRes, err := s.chainA.SendEvmTx(
 		s.chainA.SenderAccounts[0],
 		0,
 		s.chainAPrecompile.Address(),
@@ -158,6 +183,24 @@ func (s *PrecompileTestSuite) TestTransfer() {
 	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
 	s.Require().NoError(err)
 
+	// the transfer event carries the sequence of the packet sent
+	s.Require().Len(ethRes.Logs, 1)
+	log := ethRes.Logs[0].ToEthereum()
+	s.Require().Equal(s.chainAPrecompile.Events[ics20.EventTypeIBCTransfer].ID, log.Topics[0])
+	var transferEvent ics20.EventIBCTransfer
+	err = cmn.UnpackLog(s.chainAPrecompile.ABI, &transferEvent, ics20.EventTypeIBCTransfer, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(sourceAddr, transferEvent.Sender)
+	s.Require().Equal(crypto.Keccak256Hash([]byte(receiver)), transferEvent.Receiver)
+	s.Require().Equal(packet.Sequence, transferEvent.Sequence)
+	s.Require().Equal(sourcePort, transferEvent.SourcePort)
+	s.Require().Equal(sourceChannel, transferEvent.SourceChannel)
+	s.Require().Equal(denom, transferEvent.Denom)
+	s.Require().Equal(amount.BigInt(), transferEvent.Amount)
+	s.Require().Equal(timeoutHeight, transferEvent.TimeoutHeight)
+	s.Require().Equal(uint64(0), transferEvent.TimeoutTimestamp)
+	s.Require().Equal(memo, transferEvent.Memo)
+
 	err = path.RelayPacket(packet)
 	s.Require().NoError(err)
 
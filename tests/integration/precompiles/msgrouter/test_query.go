@@ -0,0 +1,69 @@
+package msgrouter
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/msgrouter"
+	"github.com/cosmos/evm/precompiles/testutil"
+)
+
+func (s *PrecompileTestSuite) TestIsAllowed() {
+	method := s.precompile.Methods[msgrouter.IsAllowedMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expAllowed  bool
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			false,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - msg type not allowed by default",
+			func() []interface{} {
+				return []interface{}{msgSendTypeURL}
+			},
+			false,
+			false,
+			"",
+		},
+		{
+			"success - msg type allowed",
+			func() []interface{} {
+				s.allowMsgTypeURLs(msgSendTypeURL)
+				return []interface{}{msgSendTypeURL}
+			},
+			true,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.IsAllowed(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAllowed, out[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package msgrouter
+
+import (
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/msgrouter"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	precompile *msgrouter.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	s.precompile = msgrouter.NewPrecompile(
+		s.network.App.GetEVMKeeper(),
+		s.network.App.MsgServiceRouter(),
+		s.network.App.GetBankKeeper(),
+		s.network.App.AppCodec(),
+	)
+}
+
+// allowMsgTypeURLs sets the message type URLs allowed in the message router precompile.
+func (s *PrecompileTestSuite) allowMsgTypeURLs(typeURLs ...string) {
+	params := s.network.App.GetEVMKeeper().GetParams(s.network.GetContext())
+	params.AllowedMsgTypeURLs = typeURLs
+	err := s.network.App.GetEVMKeeper().SetParams(s.network.GetContext(), params)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,139 @@
+package msgrouter
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/msgrouter"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var msgSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+func (s *PrecompileTestSuite) TestExecute() {
+	method := s.precompile.Methods[msgrouter.ExecuteMethod]
+	amount := sdk.NewCoins(sdk.NewCoin(evmtypes.GetEVMCoinDenom(), math.NewInt(1000)))
+
+	// msgSendJSON returns the proto-JSON encoded MsgSend of the given amount
+	// from the sender to the second account.
+	msgSendJSON := func(from sdk.AccAddress) string {
+		bz, err := s.network.App.AppCodec().MarshalInterfaceJSON(banktypes.NewMsgSend(from, s.keyring.GetAccAddr(1), amount))
+		s.Require().NoError(err)
+		return string(bz)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(stateDB *statedb.StateDB, responseJSON string)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(*statedb.StateDB, string) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - msg.sender address does not match the signer address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1), msgSendJSON(s.keyring.GetAccAddr(1))}
+			},
+			func(*statedb.StateDB, string) {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - invalid msg json",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), `{"@type":"/cosmos.bank.v1beta1.MsgUnknown"}`}
+			},
+			func(*statedb.StateDB, string) {},
+			true,
+			"invalid msg",
+		},
+		{
+			"fail - msg type not allowed",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), msgSendJSON(s.keyring.GetAccAddr(0))}
+			},
+			func(*statedb.StateDB, string) {},
+			true,
+			fmt.Sprintf(msgrouter.ErrMsgNotAllowed, msgSendTypeURL),
+		},
+		{
+			"fail - msg signed by another account",
+			func() []interface{} {
+				s.allowMsgTypeURLs(msgSendTypeURL)
+				return []interface{}{s.keyring.GetAddr(0), msgSendJSON(s.keyring.GetAccAddr(1))}
+			},
+			func(*statedb.StateDB, string) {},
+			true,
+			fmt.Sprintf(msgrouter.ErrInvalidSigners, msgSendTypeURL),
+		},
+		{
+			"success - msg executed",
+			func() []interface{} {
+				s.allowMsgTypeURLs(msgSendTypeURL)
+				return []interface{}{s.keyring.GetAddr(0), msgSendJSON(s.keyring.GetAccAddr(0))}
+			},
+			func(stateDB *statedb.StateDB, responseJSON string) {
+				s.Require().JSONEq(`{"@type":"/cosmos.bank.v1beta1.MsgSendResponse"}`, responseJSON)
+
+				log := stateDB.Logs()[0]
+				s.Require().Equal(s.precompile.Address(), log.Address)
+				event := s.precompile.Events[msgrouter.EventTypeExecute]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), log.Topics[0])
+				s.Require().Equal(common.BytesToHash(s.keyring.GetAddr(0).Bytes()), log.Topics[1])
+
+				var executeEvent msgrouter.EventExecute
+				err := cmn.UnpackLog(s.precompile.ABI, &executeEvent, msgrouter.EventTypeExecute, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(msgSendTypeURL, executeEvent.MsgTypeUrl)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			balanceBefore := s.network.App.GetBankKeeper().GetBalance(ctx, s.keyring.GetAccAddr(1), evmtypes.GetEVMCoinDenom())
+
+			bz, err := s.precompile.ExecuteMsg(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				balanceAfter := s.network.App.GetBankKeeper().GetBalance(ctx, s.keyring.GetAccAddr(1), evmtypes.GetEVMCoinDenom())
+				s.Require().Equal(big.NewInt(1000), balanceAfter.Amount.Sub(balanceBefore.Amount).BigInt())
+
+				out, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				tc.postCheck(stateDB, out[0].(string))
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"math"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 
 	//nolint:revive // dot imports are fine for Ginkgo
 	. "github.com/onsi/ginkgo/v2"
@@ -22,6 +24,8 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 type IntegrationTestSuite struct {
@@ -85,6 +89,11 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 				Expect(err).To(BeNil())
 				Expect(res.VmError).To(Equal(expErr), "expected different vm error")
 				Expect(res.Ret).To(Equal(expOutput))
+
+				// the verification gas is charged whether the signature is valid or not
+				intrinsicGas, err := core.IntrinsicGas(input, nil, nil, false, true, true, true)
+				Expect(err).To(BeNil())
+				Expect(res.MaxUsedGas).To(Equal(intrinsicGas+p256.VerifyGas), "expected different gas used")
 			},
 				Entry(
 					"valid signature",
@@ -120,6 +129,45 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 			)
 		})
 
+		When("the precompile is not activated in the chain config", func() {
+			BeforeAll(func() {
+				s = setupIntegrationTestSuite(nil, create, options...)
+
+				chainConfig := evmtypes.DefaultChainConfig(s.network.GetEIP155ChainID().Uint64())
+				maxInt := sdkmath.NewInt(math.MaxInt64)
+				chainConfig.P256Block = &maxInt
+				configureChainConfig(chainConfig)
+			})
+
+			AfterAll(func() {
+				configureChainConfig(evmtypes.DefaultChainConfig(s.network.GetEIP155ChainID().Uint64()))
+			})
+
+			It("should not verify the signature", func() {
+				senderKey := s.keyring.GetKey(0)
+
+				input, err := signMsg([]byte("hello world"), s.p256Priv)
+				Expect(err).To(BeNil())
+				args := evmtypes.EvmTxArgs{
+					To:    &s.precompileAddress,
+					Input: input,
+				}
+
+				txResult, err := s.factory.ExecuteEthTx(senderKey.Priv, args)
+				Expect(err).To(BeNil())
+				Expect(txResult.IsOK()).To(Equal(true), "transaction should have succeeded", txResult.GetLog())
+
+				res, err := utils.DecodeExecTxResult(txResult)
+				Expect(err).To(BeNil())
+				Expect(res.Ret).To(BeEmpty())
+
+				// no verification gas is charged
+				intrinsicGas, err := core.IntrinsicGas(input, nil, nil, false, true, true, true)
+				Expect(err).To(BeNil())
+				Expect(res.MaxUsedGas).To(Equal(intrinsicGas), "expected different gas used")
+			})
+		})
+
 		When("the precompile is not enabled in the EVM params", func() {
 			BeforeAll(func() {
 				customGenesis := evmtypes.DefaultGenesisState()
@@ -185,6 +233,24 @@ func TestPrecompileIntegrationTestSuite(t *testing.T, create network.CreateEvmAp
 	RunSpecs(t, "P256 Precompile Integration Test Suite")
 }
 
+// configureChainConfig is a helper function to replace the chain config of the EVM
+func configureChainConfig(chainConfig *evmtypes.ChainConfig) {
+	coinInfo := evmtypes.EvmCoinInfo{
+		Denom:         evmtypes.GetEVMCoinDenom(),
+		ExtendedDenom: evmtypes.GetEVMCoinExtendedDenom(),
+		DisplayDenom:  evmtypes.GetEVMCoinDisplayDenom(),
+		Decimals:      evmtypes.GetEVMCoinDecimals(),
+	}
+
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	err := configurator.
+		WithChainConfig(chainConfig).
+		WithEVMCoinInfo(coinInfo).
+		Configure()
+	Expect(err).To(BeNil())
+}
+
 // setupIntegrationTestSuite is a helper function to setup a integration test suite
 // with a network with a specified custom genesis state for the EVM module
 func setupIntegrationTestSuite(customEVMGenesis *evmtypes.GenesisState, create network.CreateEvmApp, options ...network.ConfigOption) *IntegrationTestSuite {
@@ -0,0 +1,93 @@
+package revenue
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/precompiles/revenue"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	revenuetypes "github.com/cosmos/evm/x/revenue/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestDistributeRevenue registers a contract through the precompile and checks
+// that the developer shares of the fees of the transactions calling it are sent
+// to the withdrawer.
+func (s *PrecompileTestSuite) TestDistributeRevenue() {
+	revenueGenesis := revenuetypes.DefaultGenesisState()
+	revenueGenesis.Params.EnableRevenue = true
+	evmGenesis := evmtypes.DefaultGenesisState()
+	evmGenesis.Params.ActiveStaticPrecompiles = append(slices.Clone(evmtypes.AvailableStaticPrecompiles), evmtypes.RevenuePrecompileAddress)
+	options := s.options
+	s.options = append(slices.Clone(options), network.WithCustomGenesis(network.CustomGenesisState{
+		evmtypes.ModuleName:     evmGenesis,
+		revenuetypes.ModuleName: revenueGenesis,
+	}))
+	defer func() { s.options = options }()
+	s.SetupTest()
+
+	deployer := s.keyring.GetKey(0)
+	withdrawer := s.keyring.GetAccAddr(2)
+	nonce := s.network.App.GetEVMKeeper().GetNonce(s.network.GetContext(), deployer.Addr)
+	contractAddr, err := s.factory.DeployContract(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	precompileAddr := s.precompile.Address()
+	_, err = s.factory.ExecuteContractCall(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{To: &precompileAddr},
+		testutiltypes.CallArgs{
+			ContractABI: s.precompile.ABI,
+			MethodName:  revenue.RegisterRevenueMethod,
+			Args:        []interface{}{contractAddr, common.BytesToAddress(withdrawer), []uint64{nonce}},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+	s.Require().True(s.revenueKeeper.IsRevenueRegistered(s.network.GetContext(), contractAddr))
+
+	balanceBefore := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), withdrawer, evmtypes.GetEVMCoinDenom())
+	res, err := s.factory.ExecuteContractCall(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{To: &contractAddr},
+		testutiltypes.CallArgs{
+			ContractABI: contracts.ERC20MinterBurnerDecimalsContract.ABI,
+			MethodName:  "mint",
+			Args:        []interface{}{deployer.Addr, big.NewInt(1000)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	var distributed sdk.Coins
+	for _, event := range res.Events {
+		if event.Type != revenuetypes.EventTypeDistributeRevenue {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == sdk.AttributeKeyAmount {
+				distributed, err = sdk.ParseCoinsNormalized(attr.Value)
+				s.Require().NoError(err)
+			}
+		}
+	}
+	s.Require().True(distributed.IsAllPositive(), "revenue not distributed")
+
+	balanceAfter := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), withdrawer, evmtypes.GetEVMCoinDenom())
+	s.Require().Equal(distributed.AmountOf(evmtypes.GetEVMCoinExtendedDenom()), balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+}
@@ -0,0 +1,82 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/revenue"
+	"github.com/cosmos/evm/precompiles/testutil"
+	revenuetypes "github.com/cosmos/evm/x/revenue/types"
+)
+
+func (s *PrecompileTestSuite) TestRevenue() {
+	method := s.precompile.Methods[revenue.RevenueMethod]
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expRevenue  func() revenue.RevenueOutput
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - contract not registered",
+			func() []interface{} {
+				return []interface{}{contractAddr}
+			},
+			func() revenue.RevenueOutput { return revenue.RevenueOutput{} },
+			false,
+			"",
+		},
+		{
+			"success - contract registered",
+			func() []interface{} {
+				s.revenueKeeper.SetRevenue(s.network.GetContext(), revenuetypes.NewRevenue(contractAddr, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)))
+				return []interface{}{contractAddr}
+			},
+			func() revenue.RevenueOutput {
+				return revenue.RevenueOutput{
+					ContractAddress:   contractAddr,
+					DeployerAddress:   s.keyring.GetAddr(0),
+					WithdrawerAddress: s.keyring.GetAddr(1),
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			contractAddr = s.deployContract(s.keyring.GetAddr(0), 0)
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.Revenue(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			var out struct{ Revenue revenue.RevenueOutput }
+			err = s.precompile.UnpackIntoInterface(&out, revenue.RevenueMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRevenue(), out.Revenue)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package revenue
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/precompiles/revenue"
+	"github.com/cosmos/evm/testutil/integration/evm/factory"
+	"github.com/cosmos/evm/testutil/integration/evm/grpc"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	revenuekeeper "github.com/cosmos/evm/x/revenue/keeper"
+	"github.com/cosmos/evm/x/vm/statedb"
+)
+
+// RevenueApp defines the app running the x/revenue module.
+type RevenueApp interface {
+	GetRevenueKeeper() *revenuekeeper.Keeper
+}
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create      network.CreateEvmApp
+	options     []network.ConfigOption
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	revenueKeeper *revenuekeeper.Keeper
+	precompile    *revenue.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(3)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+	grpcHandler := grpc.NewIntegrationHandler(nw)
+	txFactory := factory.New(nw, grpcHandler)
+
+	s.network = nw
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+
+	app, ok := s.network.App.(RevenueApp)
+	s.Require().True(ok, "app does not run the revenue module")
+	s.revenueKeeper = app.GetRevenueKeeper()
+
+	s.precompile = revenue.NewPrecompile(*s.revenueKeeper, s.network.App.GetBankKeeper())
+}
+
+// enableRevenue sets whether the contracts can register to receive a share of
+// the transaction fees.
+func (s *PrecompileTestSuite) enableRevenue(enable bool) {
+	params := s.revenueKeeper.GetParams(s.network.GetContext())
+	params.EnableRevenue = enable
+	err := s.revenueKeeper.SetParams(s.network.GetContext(), params)
+	s.Require().NoError(err)
+}
+
+// deployContract stores some code at the address of the contract deployed by
+// the given account with the given nonce, and returns this address.
+func (s *PrecompileTestSuite) deployContract(deployer common.Address, nonce uint64) common.Address {
+	ctx := s.network.GetContext()
+	contractAddr := crypto.CreateAddress(deployer, nonce)
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+
+	s.network.App.GetEVMKeeper().SetCode(ctx, codeHash, code)
+	err := s.network.App.GetEVMKeeper().SetAccount(ctx, contractAddr, statedb.Account{Nonce: 1, CodeHash: codeHash})
+	s.Require().NoError(err)
+
+	return contractAddr
+}
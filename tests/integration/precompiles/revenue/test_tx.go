@@ -0,0 +1,339 @@
+package revenue
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/revenue"
+	"github.com/cosmos/evm/precompiles/testutil"
+	revenuetypes "github.com/cosmos/evm/x/revenue/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *PrecompileTestSuite) TestRegisterRevenue() {
+	method := s.precompile.Methods[revenue.RegisterRevenueMethod]
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name          string
+		malleate      func() []interface{}
+		expWithdrawer func() common.Address
+		expError      bool
+		errContains   string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			nil,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - zero contract address",
+			func() []interface{} {
+				return []interface{}{common.Address{}, common.Address{}, []uint64{0}}
+			},
+			nil,
+			true,
+			fmt.Sprintf(revenue.ErrInvalidContract, common.Address{}),
+		},
+		{
+			"fail - no nonces",
+			func() []interface{} {
+				return []interface{}{contractAddr, common.Address{}, []uint64{}}
+			},
+			nil,
+			true,
+			fmt.Sprintf(revenue.ErrInvalidNonces, []uint64{}),
+		},
+		{
+			"fail - revenue disabled",
+			func() []interface{} {
+				s.enableRevenue(false)
+				return []interface{}{contractAddr, common.Address{}, []uint64{0}}
+			},
+			nil,
+			true,
+			revenuetypes.ErrRevenueDisabled.Error(),
+		},
+		{
+			"fail - no contract deployed",
+			func() []interface{} {
+				return []interface{}{crypto.CreateAddress(s.keyring.GetAddr(0), 1), common.Address{}, []uint64{1}}
+			},
+			nil,
+			true,
+			revenuetypes.ErrRevenueNoContractDeployed.Error(),
+		},
+		{
+			"fail - contract deployed by another account",
+			func() []interface{} {
+				otherContract := s.deployContract(s.keyring.GetAddr(1), 0)
+				return []interface{}{otherContract, common.Address{}, []uint64{0}}
+			},
+			nil,
+			true,
+			"not contract deployer or wrong nonce",
+		},
+		{
+			"fail - wrong nonce",
+			func() []interface{} {
+				return []interface{}{contractAddr, common.Address{}, []uint64{1}}
+			},
+			nil,
+			true,
+			"not contract deployer or wrong nonce",
+		},
+		{
+			"fail - contract already registered",
+			func() []interface{} {
+				s.revenueKeeper.SetRevenue(s.network.GetContext(), revenuetypes.NewRevenue(contractAddr, s.keyring.GetAccAddr(0), nil))
+				return []interface{}{contractAddr, common.Address{}, []uint64{0}}
+			},
+			nil,
+			true,
+			revenuetypes.ErrRevenueAlreadyRegistered.Error(),
+		},
+		{
+			"success - fees withdrawn by the deployer",
+			func() []interface{} {
+				return []interface{}{contractAddr, common.Address{}, []uint64{0}}
+			},
+			func() common.Address { return s.keyring.GetAddr(0) },
+			false,
+			"",
+		},
+		{
+			"success - fees withdrawn by another account",
+			func() []interface{} {
+				return []interface{}{contractAddr, s.keyring.GetAddr(1), []uint64{0}}
+			},
+			func() common.Address { return s.keyring.GetAddr(1) },
+			false,
+			"",
+		},
+		{
+			"success - contract deployed by a factory contract",
+			func() []interface{} {
+				factoryAddr := s.deployContract(s.keyring.GetAddr(0), 1)
+				contractAddr = s.deployContract(factoryAddr, 1)
+				return []interface{}{contractAddr, common.Address{}, []uint64{1, 1}}
+			},
+			func() common.Address { return s.keyring.GetAddr(0) },
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.enableRevenue(true)
+			contractAddr = s.deployContract(s.keyring.GetAddr(0), 0)
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200000)
+
+			bz, err := s.precompile.RegisterRevenue(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			s.Require().Equal(true, out[0])
+
+			rev, found := s.revenueKeeper.GetRevenue(ctx, contractAddr)
+			s.Require().True(found)
+			s.Require().Equal(sdk.AccAddress(s.keyring.GetAddr(0).Bytes()), rev.GetDeployerAddr())
+			s.Require().Equal(sdk.AccAddress(tc.expWithdrawer().Bytes()), rev.GetWithdrawerAddr())
+
+			var event revenue.EventRegisterRevenue
+			err = cmn.UnpackLog(s.precompile.ABI, &event, revenue.EventTypeRegisterRevenue, *stateDB.Logs()[0])
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expWithdrawer(), event.WithdrawerAddress)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestUpdateRevenue() {
+	method := s.precompile.Methods[revenue.UpdateRevenueMethod]
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name        string
+		caller      int
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			0,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - zero withdrawer address",
+			0,
+			func() []interface{} {
+				return []interface{}{contractAddr, common.Address{}}
+			},
+			true,
+			fmt.Sprintf(revenue.ErrInvalidWithdrawer, common.Address{}),
+		},
+		{
+			"fail - contract not registered",
+			0,
+			func() []interface{} {
+				return []interface{}{s.deployContract(s.keyring.GetAddr(0), 1), s.keyring.GetAddr(2)}
+			},
+			true,
+			revenuetypes.ErrRevenueContractNotRegistered.Error(),
+		},
+		{
+			"fail - caller is not the deployer",
+			1,
+			func() []interface{} {
+				return []interface{}{contractAddr, s.keyring.GetAddr(2)}
+			},
+			true,
+			revenuetypes.ErrRevenueNotDeployer.Error(),
+		},
+		{
+			"success - withdrawer updated",
+			0,
+			func() []interface{} {
+				return []interface{}{contractAddr, s.keyring.GetAddr(2)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.enableRevenue(true)
+			contractAddr = s.deployContract(s.keyring.GetAddr(0), 0)
+			s.revenueKeeper.SetRevenue(s.network.GetContext(), revenuetypes.NewRevenue(contractAddr, s.keyring.GetAccAddr(0), s.keyring.GetAccAddr(1)))
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.caller), s.precompile.Address(), 200000)
+
+			_, err := s.precompile.UpdateRevenue(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+
+			rev, found := s.revenueKeeper.GetRevenue(ctx, contractAddr)
+			s.Require().True(found)
+			s.Require().Equal(s.keyring.GetAccAddr(2), rev.GetWithdrawerAddr())
+
+			res, err := s.revenueKeeper.WithdrawerRevenues(ctx, &revenuetypes.QueryWithdrawerRevenuesRequest{WithdrawerAddress: s.keyring.GetAccAddr(2).String()})
+			s.Require().NoError(err)
+			s.Require().Equal([]string{contractAddr.Hex()}, res.ContractAddresses)
+			res, err = s.revenueKeeper.WithdrawerRevenues(ctx, &revenuetypes.QueryWithdrawerRevenuesRequest{WithdrawerAddress: s.keyring.GetAccAddr(1).String()})
+			s.Require().NoError(err)
+			s.Require().Empty(res.ContractAddresses)
+
+			var event revenue.EventUpdateRevenue
+			err = cmn.UnpackLog(s.precompile.ABI, &event, revenue.EventTypeUpdateRevenue, *stateDB.Logs()[0])
+			s.Require().NoError(err)
+			s.Require().Equal(s.keyring.GetAddr(2), event.WithdrawerAddress)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCancelRevenue() {
+	method := s.precompile.Methods[revenue.CancelRevenueMethod]
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name        string
+		caller      int
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			0,
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - caller is not the deployer",
+			1,
+			func() []interface{} {
+				return []interface{}{contractAddr}
+			},
+			true,
+			revenuetypes.ErrRevenueNotDeployer.Error(),
+		},
+		{
+			"success - registration canceled",
+			0,
+			func() []interface{} {
+				return []interface{}{contractAddr}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.enableRevenue(true)
+			contractAddr = s.deployContract(s.keyring.GetAddr(0), 0)
+			s.revenueKeeper.SetRevenue(s.network.GetContext(), revenuetypes.NewRevenue(contractAddr, s.keyring.GetAccAddr(0), nil))
+			stateDB := s.network.GetStateDB()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(tc.caller), s.precompile.Address(), 200000)
+
+			_, err := s.precompile.CancelRevenue(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().False(s.revenueKeeper.IsRevenueRegistered(ctx, contractAddr))
+
+			res, err := s.revenueKeeper.DeployerRevenues(ctx, &revenuetypes.QueryDeployerRevenuesRequest{DeployerAddress: s.keyring.GetAccAddr(0).String()})
+			s.Require().NoError(err)
+			s.Require().Empty(res.ContractAddresses)
+			s.checkCancelEvent(stateDB, contractAddr)
+		})
+	}
+}
+
+// checkCancelEvent checks the CancelRevenue event emitted for the given contract.
+func (s *PrecompileTestSuite) checkCancelEvent(stateDB *statedb.StateDB, contractAddr common.Address) {
+	log := stateDB.Logs()[0]
+	s.Require().Equal(s.precompile.Address(), log.Address)
+	event := s.precompile.Events[revenue.EventTypeCancelRevenue]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), log.Topics[0])
+	s.Require().Equal(common.BytesToHash(s.keyring.GetAddr(0).Bytes()), log.Topics[1])
+	s.Require().Equal(common.BytesToHash(contractAddr.Bytes()), log.Topics[2])
+}
@@ -2,6 +2,7 @@ package slashing
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -9,6 +10,8 @@ import (
 	"github.com/cosmos/evm/precompiles/slashing"
 	"github.com/cosmos/evm/precompiles/testutil"
 
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -274,3 +277,199 @@ func (s *PrecompileTestSuite) TestGetParams() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestGetEvidence() {
+	method := s.precompile.Methods[slashing.GetEvidenceMethod]
+
+	valSigners := s.network.GetValidators()
+	val0ConsAddr, _ := valSigners[0].GetConsAddr()
+	consAddr := types.ConsAddress(val0ConsAddr)
+
+	evidence := &evidencetypes.Equivocation{
+		Height:           10,
+		Time:             time.Unix(1000, 0).UTC(),
+		Power:            100,
+		ConsensusAddress: consAddr.String(),
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(evidence *slashing.Equivocation)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ *slashing.Equivocation) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - empty evidence hash",
+			func() []interface{} {
+				return []interface{}{
+					[32]byte{},
+				}
+			},
+			func(_ *slashing.Equivocation) {},
+			200000,
+			true,
+			"invalid evidence hash",
+		},
+		{
+			"fail - evidence not found",
+			func() []interface{} {
+				return []interface{}{
+					[32]byte(common.BytesToHash(evidence.Hash())),
+				}
+			},
+			func(_ *slashing.Equivocation) {},
+			200000,
+			true,
+			"not found",
+		},
+		{
+			"success - get evidence",
+			func() []interface{} {
+				err := s.network.App.GetEvidenceKeeper().Evidences.Set(s.network.GetContext(), evidence.Hash(), evidence)
+				s.Require().NoError(err)
+				return []interface{}{
+					[32]byte(common.BytesToHash(evidence.Hash())),
+				}
+			},
+			func(out *slashing.Equivocation) {
+				s.Require().Equal(common.BytesToHash(evidence.Hash()), out.Hash)
+				s.Require().Equal(int64(10), out.Height)
+				s.Require().Equal(int64(1000), out.Time)
+				s.Require().Equal(int64(100), out.Power)
+				s.Require().Equal(consAddr.Bytes(), out.ConsensusAddress.Bytes())
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.GetEvidence(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out slashing.EvidenceOutput
+				err = s.precompile.UnpackIntoInterface(&out, slashing.GetEvidenceMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(&out.Evidence)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestGetAllEvidence() {
+	method := s.precompile.Methods[slashing.GetAllEvidenceMethod]
+
+	valSigners := s.network.GetValidators()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(evidence []slashing.Equivocation, pageResponse *query.PageResponse)
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func(_ []slashing.Equivocation, _ *query.PageResponse) {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"success - no evidence",
+			func() []interface{} {
+				return []interface{}{
+					query.PageRequest{
+						Limit:      10,
+						CountTotal: true,
+					},
+				}
+			},
+			func(evidence []slashing.Equivocation, pageResponse *query.PageResponse) {
+				s.Require().Empty(evidence)
+				s.Require().Equal(uint64(0), pageResponse.Total)
+			},
+			200000,
+			false,
+			"",
+		},
+		{
+			"success - get all evidence with pagination",
+			func() []interface{} {
+				for i, val := range valSigners {
+					consAddr, err := val.GetConsAddr()
+					s.Require().NoError(err)
+					evidence := &evidencetypes.Equivocation{
+						Height:           int64(10 + i),
+						Time:             time.Unix(1000, 0).UTC(),
+						Power:            100,
+						ConsensusAddress: types.ConsAddress(consAddr).String(),
+					}
+					err = s.network.App.GetEvidenceKeeper().Evidences.Set(s.network.GetContext(), evidence.Hash(), evidence)
+					s.Require().NoError(err)
+				}
+				return []interface{}{
+					query.PageRequest{
+						Limit:      2,
+						CountTotal: true,
+					},
+				}
+			},
+			func(evidence []slashing.Equivocation, pageResponse *query.PageResponse) {
+				s.Require().Len(evidence, 2)
+				s.Require().Equal(uint64(len(valSigners)), pageResponse.Total)
+				s.Require().NotEmpty(pageResponse.NextKey)
+			},
+			200000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), tc.gas)
+
+			bz, err := s.precompile.GetAllEvidence(ctx, &method, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				var out slashing.AllEvidenceOutput
+				err = s.precompile.UnpackIntoInterface(&out, slashing.GetAllEvidenceMethod, bz)
+				s.Require().NoError(err)
+				tc.postCheck(out.Evidence, &out.PageResponse)
+			}
+		})
+	}
+}
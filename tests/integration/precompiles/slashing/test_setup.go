@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	testkeyring "github.com/cosmos/evm/testutil/keyring"
 
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
@@ -57,6 +59,7 @@ func (s *PrecompileTestSuite) SetupTest() {
 	s.precompile = slashing.NewPrecompile(
 		s.network.App.GetSlashingKeeper(),
 		slashingkeeper.NewMsgServerImpl(s.network.App.GetSlashingKeeper()),
+		evidencekeeper.NewQuerier(s.network.App.GetEvidenceKeeper()),
 		s.network.App.GetBankKeeper(),
 		address.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		address.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
@@ -701,3 +701,223 @@ func (s *PrecompileTestSuite) TestRedelegations() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestDelegatorUnbondingDelegations() {
+	method := s.precompile.Methods[staking.DelegatorUnbondingDelegationsMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid delegator address",
+			func() []interface{} {
+				return []interface{}{
+					common.Address{},
+					query.PageRequest{},
+				}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, common.Address{}),
+		},
+		{
+			"success - no unbonding delegations found",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{
+					addr,
+					query.PageRequest{},
+				}
+			},
+			func(data []byte) {
+				var out staking.DelegatorUnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.UnbondingDelegations, 0)
+			},
+			false,
+			"",
+		},
+		{
+			"success - unbonding delegations from two validators",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{CountTotal: true},
+				}
+			},
+			func(data []byte) {
+				var out staking.DelegatorUnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.UnbondingDelegations, 2)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				for _, ubd := range out.UnbondingDelegations {
+					s.Require().Equal(s.keyring.GetAccAddr(0).String(), ubd.DelegatorAddress)
+					s.Require().Len(ubd.Entries, 1)
+					s.Require().Equal(big.NewInt(1e18), ubd.Entries[0].Balance)
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - unbonding delegations with pagination",
+			func() []interface{} {
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					query.PageRequest{Limit: 1, CountTotal: true},
+				}
+			},
+			func(data []byte) {
+				var out staking.DelegatorUnbondingDelegationsOutput
+				err := s.precompile.UnpackIntoInterface(&out, staking.DelegatorUnbondingDelegationsMethod, data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Len(out.UnbondingDelegations, 1)
+				s.Require().Equal(uint64(2), out.PageResponse.Total)
+				s.Require().NotEmpty(out.PageResponse.NextKey)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+			for _, val := range s.network.GetValidators()[:2] {
+				valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+				s.Require().NoError(err)
+				_, _, err = s.network.App.GetStakingKeeper().Undelegate(s.network.GetContext(), s.keyring.GetAddr(0).Bytes(), valAddr, math.LegacyNewDec(1))
+				s.Require().NoError(err)
+			}
+
+			bz, err := s.precompile.DelegatorUnbondingDelegations(s.network.GetContext(), &method, contract, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCompletionTimes() {
+	method := s.precompile.Methods[staking.CompletionTimesMethod]
+
+	var expUnbondingTime, expRedelegationTime int64
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func(bz []byte)
+		expErr      bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - invalid delegator address",
+			func() []interface{} {
+				return []interface{}{"invalid"}
+			},
+			func([]byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, "invalid"),
+		},
+		{
+			"success - no unbonding delegations nor redelegations",
+			func() []interface{} {
+				addr, _ := testutiltx.NewAddrKey()
+				return []interface{}{addr}
+			},
+			func(data []byte) {
+				out, err := method.Outputs.Unpack(data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().Equal(int64(0), out[0])
+				s.Require().Equal(int64(0), out[1])
+			},
+			false,
+			"",
+		},
+		{
+			"success - unbonding delegation and redelegation completion times",
+			func() []interface{} {
+				ctx := s.network.GetContext()
+				sk := s.network.App.GetStakingKeeper()
+				validators := s.network.GetValidators()
+
+				valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+				s.Require().NoError(err)
+				completionTime, _, err := sk.Undelegate(ctx, s.keyring.GetAddr(0).Bytes(), valAddr, math.LegacyNewDec(1))
+				s.Require().NoError(err)
+				expUnbondingTime = completionTime.UTC().Unix()
+
+				valSrcAddr, err := sdk.ValAddressFromBech32(validators[1].GetOperator())
+				s.Require().NoError(err)
+				valDstAddr, err := sdk.ValAddressFromBech32(validators[2].GetOperator())
+				s.Require().NoError(err)
+				completionTime, err = sk.BeginRedelegation(ctx, s.keyring.GetAddr(0).Bytes(), valSrcAddr, valDstAddr, math.LegacyNewDec(1))
+				s.Require().NoError(err)
+				expRedelegationTime = completionTime.UTC().Unix()
+
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			func(data []byte) {
+				out, err := method.Outputs.Unpack(data)
+				s.Require().NoError(err, "failed to unpack output")
+				s.Require().NotZero(expUnbondingTime)
+				s.Require().Equal(expUnbondingTime, out[0])
+				s.Require().NotZero(expRedelegationTime)
+				s.Require().Equal(expRedelegationTime, out[1])
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(s.keyring.GetAddr(0), s.precompile.Address(), uint256.NewInt(0), 100000, nil)
+
+			args := tc.malleate()
+			bz, err := s.precompile.CompletionTimes(s.network.GetContext(), &method, contract, args)
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotNil(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
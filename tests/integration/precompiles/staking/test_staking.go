@@ -6,6 +6,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/holiman/uint256"
@@ -381,7 +382,7 @@ func (s *PrecompileTestSuite) TestRun() {
 				s.Require().NoError(err, "failed to pack input")
 				return input
 			},
-			19103, // use enough gas to avoid out of gas error
+			20423, // use enough gas to avoid out of gas error
 			true,
 			false,
 			"write protection",
@@ -391,7 +392,7 @@ func (s *PrecompileTestSuite) TestRun() {
 			func(_ keyring.Key) []byte {
 				return []byte("invalid")
 			},
-			19103, // use enough gas to avoid out of gas error
+			20423, // use enough gas to avoid out of gas error
 			false,
 			false,
 			"no method with id",
@@ -470,6 +471,91 @@ func (s *PrecompileTestSuite) TestRun() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestRunQueryCache() {
+	s.SetupTest()
+	// the query results are memoized for the block being finalized
+	ctx := s.network.GetContext().WithBlockTime(time.Now()).WithExecMode(sdk.ExecModeFinalize)
+
+	delegator := s.keyring.GetKey(0)
+	valAddr := s.network.GetValidators()[0].GetOperator()
+
+	cfg, err := s.network.App.GetEVMKeeper().EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+
+	delegationInput, err := s.precompile.Pack(staking.DelegationMethod, delegator.Addr, valAddr)
+	s.Require().NoError(err, "failed to pack input")
+
+	// run executes the input in its own transaction, committed to the context
+	run := func(input []byte) ([]byte, uint64) {
+		stDB := statedb.New(ctx, s.network.App.GetEVMKeeper(), statedb.NewEmptyTxConfig())
+		evm := s.network.App.GetEVMKeeper().NewEVM(ctx, core.Message{From: delegator.Addr, GasPrice: big.NewInt(0)}, cfg, nil, stDB)
+
+		contract := vm.NewPrecompile(delegator.Addr, s.precompile.Address(), uint256.NewInt(0), 1000000)
+		contract.Input = input
+		bz, err := s.precompile.Run(evm, contract, false)
+		s.Require().NoError(err, "expected no error when running the precompile")
+		s.Require().NoError(stDB.Commit(), "failed to commit the state")
+		return bz, 1000000 - contract.Gas
+	}
+
+	// the same query of another transaction returns the same result and consumes
+	// the same gas
+	bz, gas := run(delegationInput)
+	cachedBz, cachedGas := run(delegationInput)
+	s.Require().Equal(bz, cachedBz)
+	s.Require().Equal(gas, cachedGas)
+
+	// a transaction writing to the values read invalidates the memoized result
+	delegateInput, err := s.precompile.Pack(staking.DelegateMethod, delegator.Addr, valAddr, big.NewInt(1000))
+	s.Require().NoError(err, "failed to pack input")
+	run(delegateInput)
+
+	updatedBz, _ := run(delegationInput)
+	s.Require().NotEqual(bz, updatedBz)
+
+	out, err := s.precompile.Unpack(staking.DelegationMethod, updatedBz)
+	s.Require().NoError(err, "failed to unpack output")
+	prevOut, err := s.precompile.Unpack(staking.DelegationMethod, bz)
+	s.Require().NoError(err, "failed to unpack output")
+	s.Require().True(out[0].(*big.Int).Cmp(prevOut[0].(*big.Int)) > 0, "expected shares to increase")
+}
+
+func (s *PrecompileTestSuite) TestRunQueryCacheCallsLimit() {
+	s.SetupTest()
+	ctx := s.network.GetContext().WithBlockTime(time.Now()).WithExecMode(sdk.ExecModeFinalize)
+
+	delegator := s.keyring.GetKey(0)
+	valAddr := s.network.GetValidators()[0].GetOperator()
+
+	cfg, err := s.network.App.GetEVMKeeper().EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err, "failed to instantiate EVM config")
+
+	stDB := statedb.New(ctx, s.network.App.GetEVMKeeper(), statedb.NewEmptyTxConfig())
+	evm := s.network.App.GetEVMKeeper().NewEVM(ctx, core.Message{From: delegator.Addr, GasPrice: big.NewInt(0)}, cfg, nil, stDB)
+
+	delegationInput, err := s.precompile.Pack(staking.DelegationMethod, delegator.Addr, valAddr)
+	s.Require().NoError(err, "failed to pack input")
+
+	run := func() ([]byte, error) {
+		contract := vm.NewPrecompile(delegator.Addr, s.precompile.Address(), uint256.NewInt(0), 1000000)
+		contract.Input = delegationInput
+		return s.precompile.Run(evm, contract, false)
+	}
+
+	// the first call is executed and the next ones are served from the memoized result
+	for i := 0; i < int(evmtypes.MaxPrecompileCalls); i++ {
+		_, err := run()
+		s.Require().NoError(err, "expected no error when running the precompile")
+	}
+
+	// the memoized calls count against the precompile calls limit
+	bz, err := run()
+	s.Require().ErrorIs(err, vm.ErrExecutionReverted)
+	reason, err := abi.UnpackRevert(bz)
+	s.Require().NoError(err, "failed to unpack revert reason")
+	s.Require().Contains(reason, "max calls to precompiles")
+}
+
 // TestCMS tests the cache multistore writes.
 func (s *PrecompileTestSuite) TestCMS() {
 	s.customGenesis = true
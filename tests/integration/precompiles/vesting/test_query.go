@@ -0,0 +1,157 @@
+package vesting
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/vesting"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *PrecompileTestSuite) TestBalances() {
+	s.SetupTest()
+	method := s.precompile.Methods[vesting.BalancesMethod]
+	vestingAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		elapsed     time.Duration
+		expLocked   int64
+		expVested   int64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			0,
+			0,
+			0,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 0),
+		},
+		{
+			"fail - account does not exist",
+			func() []interface{} {
+				return []interface{}{vestingAddr}
+			},
+			0,
+			0,
+			0,
+			true,
+			"does not exist",
+		},
+		{
+			"fail - not a vesting account",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			0,
+			0,
+			0,
+			true,
+			"is not a vesting account",
+		},
+		{
+			"success - nothing vested at start time",
+			func() []interface{} {
+				s.createContinuousVestingAccount(vestingAddr, 1_000, 100*time.Second)
+				return []interface{}{vestingAddr}
+			},
+			0,
+			1_000,
+			0,
+			false,
+			"",
+		},
+		{
+			"success - half vested",
+			func() []interface{} {
+				s.createContinuousVestingAccount(vestingAddr, 1_000, 100*time.Second)
+				return []interface{}{vestingAddr}
+			},
+			50 * time.Second,
+			500,
+			500,
+			false,
+			"",
+		},
+		{
+			"success - fully vested",
+			func() []interface{} {
+				s.createContinuousVestingAccount(vestingAddr, 1_000, 100*time.Second)
+				return []interface{}{vestingAddr}
+			},
+			200 * time.Second,
+			0,
+			1_000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			args := tc.malleate()
+
+			queryCtx := s.network.GetContext()
+			queryCtx = queryCtx.WithBlockTime(queryCtx.BlockTime().Add(tc.elapsed))
+			contract, ctx := testutil.NewPrecompileContract(s.T(), queryCtx, s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			bz, err := s.precompile.Balances(ctx, &method, contract, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				var out struct {
+					Locked   []cmn.Coin
+					Unvested []cmn.Coin
+					Vested   []cmn.Coin
+				}
+				s.Require().NoError(s.precompile.UnpackIntoInterface(&out, vesting.BalancesMethod, bz))
+				s.Require().Equal(tc.expLocked, coinsAmount(out.Locked))
+				s.Require().Equal(tc.expLocked, coinsAmount(out.Unvested))
+				s.Require().Equal(tc.expVested, coinsAmount(out.Vested))
+			}
+		})
+	}
+}
+
+// createContinuousVestingAccount creates a continuous vesting account funded by the first
+// keyring account, vesting the given amount of the base denom over the given duration.
+func (s *PrecompileTestSuite) createContinuousVestingAccount(addr common.Address, amount int64, duration time.Duration) {
+	ctx := s.network.GetContext()
+	msgServer := authvesting.NewMsgServerImpl(s.network.App.GetAccountKeeper(), s.network.App.GetBankKeeper())
+	_, err := msgServer.CreateVestingAccount(ctx, &vestingtypes.MsgCreateVestingAccount{
+		FromAddress: s.keyring.GetAccAddr(0).String(),
+		ToAddress:   sdk.AccAddress(addr.Bytes()).String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewInt(amount))),
+		EndTime:     ctx.BlockTime().Add(duration).Unix(),
+	})
+	s.Require().NoError(err)
+}
+
+// coinsAmount returns the total amount of the given coins.
+func coinsAmount(coins []cmn.Coin) int64 {
+	var total int64
+	for _, coin := range coins {
+		total += coin.Amount.Int64()
+	}
+	return total
+}
@@ -0,0 +1,55 @@
+package vesting
+
+import (
+	"math/big"
+
+	"github.com/stretchr/testify/suite"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/vesting"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	create  network.CreateEvmApp
+	options []network.ConfigOption
+	network *network.UnitTestNetwork
+	keyring testkeyring.Keyring
+
+	precompile *vesting.Precompile
+}
+
+func NewPrecompileTestSuite(create network.CreateEvmApp, options ...network.ConfigOption) *PrecompileTestSuite {
+	return &PrecompileTestSuite{
+		create:  create,
+		options: options,
+	}
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	options := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	}
+	options = append(options, s.options...)
+	nw := network.NewUnitTestNetwork(s.create, options...)
+
+	s.network = nw
+	s.keyring = keyring
+
+	s.precompile = vesting.NewPrecompile(
+		s.network.App.GetAccountKeeper(),
+		authvesting.NewMsgServerImpl(s.network.App.GetAccountKeeper(), s.network.App.GetBankKeeper()),
+		s.network.App.GetBankKeeper(),
+	)
+}
+
+// coins returns the given amount of the bond denom as a precompile Coin slice.
+func (s *PrecompileTestSuite) coins(amount int64) []cmn.Coin {
+	return []cmn.Coin{{Denom: s.network.GetBaseDenom(), Amount: big.NewInt(amount)}}
+}
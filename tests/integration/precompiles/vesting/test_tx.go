@@ -0,0 +1,262 @@
+package vesting
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+	"github.com/cosmos/evm/precompiles/vesting"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *PrecompileTestSuite) TestCreateVestingAccount() {
+	s.SetupTest()
+	method := s.precompile.Methods[vesting.CreateVestingAccountMethod]
+	vestingAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - funder is not the caller",
+			func() []interface{} {
+				endTime := s.network.GetContext().BlockTime().Unix() + 100
+				return []interface{}{s.keyring.GetAddr(1), vestingAddr, s.coins(1_000), endTime, false}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"fail - vesting account already exists",
+			func() []interface{} {
+				endTime := s.network.GetContext().BlockTime().Unix() + 100
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(1), s.coins(1_000), endTime, false}
+			},
+			func() {},
+			true,
+			"already exists",
+		},
+		{
+			"success - continuous vesting account",
+			func() []interface{} {
+				endTime := s.network.GetContext().BlockTime().Unix() + 100
+				return []interface{}{s.keyring.GetAddr(0), vestingAddr, s.coins(1_000), endTime, false}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+				vestingAcc, ok := acc.(*vestingtypes.ContinuousVestingAccount)
+				s.Require().True(ok, "expected continuous vesting account, got %T", acc)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewInt(1_000))), vestingAcc.OriginalVesting)
+			},
+			false,
+			"",
+		},
+		{
+			"success - delayed vesting account",
+			func() []interface{} {
+				endTime := s.network.GetContext().BlockTime().Unix() + 100
+				return []interface{}{s.keyring.GetAddr(0), vestingAddr, s.coins(1_000), endTime, true}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+				_, ok := acc.(*vestingtypes.DelayedVestingAccount)
+				s.Require().True(ok, "expected delayed vesting account, got %T", acc)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			stateDB := s.network.GetStateDB()
+			res, err := s.precompile.CreateVestingAccount(ctx, &method, stateDB, contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				s.Require().Len(stateDB.Logs(), 1)
+				s.Require().Equal(s.precompile.Events[vesting.EventTypeCreateVestingAccount].ID, stateDB.Logs()[0].Topics[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreatePeriodicVestingAccount() {
+	s.SetupTest()
+	method := s.precompile.Methods[vesting.CreatePeriodicVestingAccountMethod]
+	vestingAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - no vesting periods",
+			func() []interface{} {
+				startTime := s.network.GetContext().BlockTime().Unix()
+				return []interface{}{s.keyring.GetAddr(0), vestingAddr, startTime, []vesting.Period{}}
+			},
+			func() {},
+			true,
+			vesting.ErrNoVestingPeriods,
+		},
+		{
+			"fail - funder is not the caller",
+			func() []interface{} {
+				startTime := s.network.GetContext().BlockTime().Unix()
+				return []interface{}{s.keyring.GetAddr(1), vestingAddr, startTime, []vesting.Period{{Length: 100, Amount: s.coins(1_000)}}}
+			},
+			func() {},
+			true,
+			"does not match the requester address",
+		},
+		{
+			"success - periodic vesting account",
+			func() []interface{} {
+				startTime := s.network.GetContext().BlockTime().Unix()
+				return []interface{}{
+					s.keyring.GetAddr(0),
+					vestingAddr,
+					startTime,
+					[]vesting.Period{
+						{Length: 100, Amount: s.coins(1_000)},
+						{Length: 100, Amount: s.coins(2_000)},
+					},
+				}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+				vestingAcc, ok := acc.(*vestingtypes.PeriodicVestingAccount)
+				s.Require().True(ok, "expected periodic vesting account, got %T", acc)
+				s.Require().Len(vestingAcc.VestingPeriods, 2)
+				s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.network.GetBaseDenom(), sdkmath.NewInt(3_000))), vestingAcc.OriginalVesting)
+
+				balance := s.network.App.GetBankKeeper().GetBalance(s.network.GetContext(), vestingAddr.Bytes(), s.network.GetBaseDenom())
+				s.Require().Equal(sdkmath.NewInt(3_000), balance.Amount)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreatePeriodicVestingAccount(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestCreatePermanentLockedAccount() {
+	s.SetupTest()
+	method := s.precompile.Methods[vesting.CreatePermanentLockedAccountMethod]
+	vestingAddr := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid vesting account address",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), "", s.coins(1_000)}
+			},
+			func() {},
+			true,
+			"invalid vesting account address",
+		},
+		{
+			"success - permanently locked account",
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), vestingAddr, s.coins(1_000)}
+			},
+			func() {
+				acc := s.network.App.GetAccountKeeper().GetAccount(s.network.GetContext(), vestingAddr.Bytes())
+				_, ok := acc.(*vestingtypes.PermanentLockedAccount)
+				s.Require().True(ok, "expected permanently locked account, got %T", acc)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), s.keyring.GetAddr(0), s.precompile.Address(), 200_000)
+
+			res, err := s.precompile.CreatePermanentLockedAccount(ctx, &method, s.network.GetStateDB(), contract, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, res)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -209,3 +209,32 @@ func (s *PrecompileUnitTestSuite) TestEmitWithdrawalEvent() {
 		})
 	}
 }
+
+func (s *PrecompileUnitTestSuite) TestWETH9EventSignatures() {
+	s.SetupTest(testconstants.ExampleChainID)
+
+	testCases := []struct {
+		name      string
+		eventType string
+		signature string
+	}{
+		{
+			name:      "deposit",
+			eventType: werc20.EventTypeDeposit,
+			signature: "Deposit(address,uint256)",
+		}, {
+			name:      "withdrawal",
+			eventType: werc20.EventTypeWithdrawal,
+			signature: "Withdrawal(address,uint256)",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			event, ok := s.precompile.Events[tc.eventType]
+			s.Require().True(ok, "expected event to be defined in the ABI")
+			s.Require().Equal(tc.signature, event.Sig)
+			s.Require().Equal(crypto.Keccak256Hash([]byte(tc.signature)), event.ID)
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/evm/rpc/backend/mocks"
@@ -353,6 +355,28 @@ func (s *TestSuite) TestSendRawTransaction() {
 			errors.New("only replay-protected (EIP-155) transactions allowed over RPC").Error(),
 			false,
 		},
+		{
+			"fail - blob transaction",
+			func() {},
+			func() []byte {
+				key, err := crypto.GenerateKey()
+				s.Require().NoError(err)
+				blobTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(s.backend.EvmChainID), &ethtypes.BlobTx{
+					ChainID:    uint256.MustFromBig(s.backend.EvmChainID),
+					Gas:        21000,
+					GasFeeCap:  uint256.NewInt(1),
+					BlobFeeCap: uint256.NewInt(1),
+					BlobHashes: []common.Hash{{0x01}},
+				})
+				s.Require().NoError(err)
+				bytes, err := blobTx.MarshalBinary()
+				s.Require().NoError(err)
+				return bytes
+			},
+			common.Hash{},
+			rpctypes.ErrBlobTxNotSupported.Error(),
+			false,
+		},
 		{
 			"fail - failed to broadcast transaction",
 			func() {
@@ -480,6 +504,47 @@ func (s *TestSuite) TestDoCall() {
 	}
 }
 
+func (s *TestSuite) TestDoCallRevert() {
+	_, bz := s.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(s.backend.EvmChainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	s.Require().NoError(err)
+	reason, err := evmtypes.RevertReasonBytes("COUNTER_TOO_LOW")
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		ret     []byte
+		expMsg  string
+		expData string
+	}{
+		{"revert without data", nil, "execution reverted", "0x"},
+		{"revert with reason", reason, "execution reverted: COUNTER_TOO_LOW", hexutil.Encode(reason)},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset test and queries
+			client := s.backend.ClientCtx.Client.(*mocks.Client)
+			QueryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+			height := int64(1)
+			RegisterHeader(client, &height, bz)
+			RegisterEthCallRevert(QueryClient, &evmtypes.EthCallRequest{Args: argsBz, ChainId: s.backend.EvmChainID.Int64()}, tc.ret)
+
+			_, err := s.backend.DoCall(callArgs, rpctypes.BlockNumber(1))
+			var revertErr *evmtypes.RevertError
+			s.Require().ErrorAs(err, &revertErr)
+			s.Require().Equal(tc.expMsg, revertErr.Error())
+			s.Require().Equal(3, revertErr.ErrorCode())
+			s.Require().Equal(tc.expData, revertErr.ErrorData())
+		})
+	}
+}
+
 func (s *TestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 	height := int64(1)
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	mock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -171,6 +172,12 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterEthCallRevert(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, ret []byte) {
+	ctx, _ := context.WithCancel(rpc.ContextWithHeight(1)) //nolint
+	queryClient.On("EthCall", ctx, request).
+		Return(&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: ret}, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
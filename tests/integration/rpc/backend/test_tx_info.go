@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
 
@@ -234,6 +235,117 @@ func (s *TestSuite) TestGetTxByEthHash() {
 	}
 }
 
+func (s *TestSuite) TestGetCometTxHash() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.Hash()
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		txHash       common.Hash
+		expHash      cmtbytes.HexBytes
+	}{
+		{
+			"pass - transaction not found",
+			func() {},
+			common.Hash{},
+			nil,
+		},
+		{
+			"pass - block not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			txHash,
+			nil,
+		},
+		{
+			"pass - CometBFT tx hash returned",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				s.Require().NoError(err)
+			},
+			txHash,
+			types.Tx(txBz).Hash(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			s.backend.Indexer = indexer.NewKVIndexer(db, log.NewNopLogger(), s.backend.ClientCtx)
+			err := s.backend.Indexer.IndexBlock(block, responseDeliver)
+			s.Require().NoError(err)
+
+			hash, err := s.backend.GetCometTxHash(tc.txHash)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expHash, hash)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetEthTxHashes() {
+	msgEthereumTx, _ := s.buildEthereumTx()
+	txBz := s.signAndEncodeEthTx(msgEthereumTx)
+	cometTxHash := types.Tx(txBz).Hash()
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expHashes    []common.Hash
+	}{
+		{
+			"pass - transaction not found",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterTxError(client, cometTxHash)
+			},
+			nil,
+		},
+		{
+			"pass - ethereum tx hashes returned",
+			func() {
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				RegisterTx(client, cometTxHash, txBz)
+			},
+			[]common.Hash{msgEthereumTx.Hash()},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			tc.registerMock()
+
+			hashes, err := s.backend.GetEthTxHashes(cometTxHash)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expHashes, hashes)
+		})
+	}
+}
+
 func (s *TestSuite) TestGetTransactionByBlockHashAndIndex() {
 	_, bz := s.buildEthereumTx()
 
@@ -3,13 +3,17 @@ package erc20
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/testutil/config"
 	testconstants "github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *KeeperTestSuite) TestTokenPairs() {
@@ -181,3 +185,103 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	s.Require().NoError(err)
 	s.Require().Equal(expParams, res.Params)
 }
+
+func (s *KeeperTestSuite) TestQueryParamsAtHeight() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetErc20Keeper()
+	genesisParams := k.GetParams(ctx)
+
+	height := ctx.BlockHeight()
+	params := genesisParams
+	params.PermissionlessRegistration = !params.PermissionlessRegistration
+	s.Require().NoError(k.SetParams(ctx.WithBlockHeight(height+10), params))
+	// the changes superseded before the retention of the history are pruned
+	pruneHeight := height + 15 + cosmosevmtypes.ParamsHistoryRetention
+	s.Require().NoError(k.SetParams(ctx.WithBlockHeight(pruneHeight), genesisParams))
+
+	testCases := []struct {
+		name            string
+		height          int64
+		expParams       types.Params
+		expChangeHeight int64
+		errContains     string
+	}{
+		{"invalid height", 0, types.Params{}, 0, "invalid height"},
+		{"params pruned", height, types.Params{}, 0, "no params change recorded"},
+		{"params changed at height", height + 10, params, height + 10, ""},
+		{"params changed below height", height + 20, params, height + 10, ""},
+		{"params changed after retention", pruneHeight, genesisParams, pruneHeight, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := k.ParamsAtHeight(ctx, &types.QueryParamsAtHeightRequest{Height: tc.height})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expParams, res.Params)
+			s.Require().Equal(tc.expChangeHeight, res.ChangeHeight)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAccount() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetErc20Keeper()
+	baseDenom := s.network.GetBaseDenom()
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), baseDenom, types.OWNER_MODULE)
+	s.Require().NoError(k.SetToken(ctx, pair))
+	disabledPair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+	disabledPair.Enabled = false
+	s.Require().NoError(k.SetToken(ctx, disabledPair))
+
+	accAddr := s.keyring.GetAccAddr(0)
+	addr := s.keyring.GetAddr(0)
+	balance := s.network.App.GetBankKeeper().GetBalance(ctx, accAddr, baseDenom)
+	moduleAddr := s.network.App.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name        string
+		address     string
+		expAddr     sdk.AccAddress
+		expModule   bool
+		errContains string
+	}{
+		{"invalid address", "invalid", nil, false, "invalid format for address"},
+		{"hex address", addr.Hex(), accAddr, false, ""},
+		{"bech32 address", accAddr.String(), accAddr, false, ""},
+		{"module account", moduleAddr.String(), moduleAddr, true, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := k.Account(ctx, &types.QueryAccountRequest{Address: tc.address})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAddr.String(), res.Bech32Address)
+			s.Require().Equal(common.BytesToAddress(tc.expAddr).Hex(), res.HexAddress)
+			s.Require().Equal(tc.expModule, res.IsModuleAccount)
+			s.Require().False(res.IsVestingAccount)
+			s.Require().False(res.IsContract)
+			s.Require().Equal(res.Sequence, res.Nonce)
+
+			if tc.expModule {
+				return
+			}
+			s.Require().Equal(balance.Amount, res.Balances.AmountOf(baseDenom))
+			s.Require().Equal([]types.TokenPairBalance{{
+				Erc20Address: pair.Erc20Address,
+				Denom:        baseDenom,
+				Balance:      balance.Amount.String(),
+			}}, res.Erc20Balances)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package erc20
+
+import (
+	"math/big"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestNativeCoinsInvariant() {
+	// escrow mints the coins of the denom to the module account
+	escrow := func(denom string, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount)))
+		err := s.network.App.GetBankKeeper().MintCoins(s.network.GetContext(), types.ModuleName, coins)
+		s.Require().NoError(err)
+	}
+	// registerContract deploys an ERC20 contract, mints tokens to an account
+	// and registers the contract as the ERC20 tokens of a native coin
+	registerContract := func(minted int64) {
+		contract, err := s.DeployContract("coin", "COIN", 18)
+		s.Require().NoError(err)
+		_, err = s.MintERC20Token(contract, s.keyring.GetAddr(0), big.NewInt(minted))
+		s.Require().NoError(err)
+
+		pair := types.NewTokenPair(contract, "coin", types.OWNER_MODULE)
+		s.network.App.GetErc20Keeper().SetTokenPair(s.network.GetContext(), pair)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"pass - no coins escrowed for the native precompile",
+			func() {},
+			false,
+		},
+		{
+			"fail - coins escrowed for the native precompile",
+			func() {
+				var precompilePair types.TokenPair
+				s.network.App.GetErc20Keeper().IterateTokenPairs(s.network.GetContext(), func(pair types.TokenPair) bool {
+					precompilePair = pair
+					return pair.IsNativeCoin()
+				})
+				s.Require().True(precompilePair.IsNativeCoin())
+				escrow(precompilePair.Denom, 100)
+			},
+			true,
+		},
+		{
+			"pass - token pair without precompile nor contract",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), "coin", types.OWNER_MODULE)
+				s.network.App.GetErc20Keeper().SetTokenPair(s.network.GetContext(), pair)
+			},
+			false,
+		},
+		{
+			"pass - contract tokens backed by escrowed coins",
+			func() {
+				registerContract(100)
+				escrow("coin", 100)
+			},
+			false,
+		},
+		{
+			"fail - contract tokens not backed by escrowed coins",
+			func() {
+				registerContract(100)
+				escrow("coin", 99)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			msg, broken := keeper.NativeCoinsInvariant(*s.network.App.GetErc20Keeper())(s.network.GetContext())
+			s.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
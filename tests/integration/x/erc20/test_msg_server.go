@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/evm/testutil/integration/base/factory"
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20/keeper"
 	"github.com/cosmos/evm/x/erc20/types"
 	erc20mocks "github.com/cosmos/evm/x/erc20/types/mocks"
@@ -694,3 +695,35 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParamsAuthorities() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	k := s.network.App.GetErc20Keeper()
+
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	authority := s.keyring.GetAccAddr(0).String()
+	authorities := []cosmosevmtypes.ParamsAuthority{{Authority: authority, Params: []string{"permissionless_registration"}}}
+
+	// only governance can set the params authorities
+	_, err := k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{Authority: authority, ParamsAuthorities: authorities})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{Authority: govAuthority, ParamsAuthorities: authorities})
+	s.Require().NoError(err)
+
+	res, err := k.ParamsAuthorities(ctx, &types.QueryParamsAuthoritiesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(authorities, res.ParamsAuthorities)
+
+	// the params authority only updates the permissionless registration
+	params := k.GetParams(ctx)
+	updated := types.NewParams(!params.EnableErc20, !params.PermissionlessRegistration)
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: updated})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewParams(params.EnableErc20, !params.PermissionlessRegistration), k.GetParams(ctx))
+
+	// other accounts can't update the params
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.keyring.GetAccAddr(1).String(), Params: updated})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
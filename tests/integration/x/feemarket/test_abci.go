@@ -1,11 +1,15 @@
 package feemarket
 
 import (
+	"github.com/cosmos/evm/testutil"
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (s *KeeperTestSuite) TestEndBlock() {
@@ -59,3 +63,200 @@ func (s *KeeperTestSuite) TestEndBlock() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestEndBlockBaseFeePolicy() {
+	testCases := []struct {
+		name             string
+		policy           types.BaseFeePolicy
+		baseFees         int64
+		expFeeCollector  int64
+		expCommunityPool int64
+		expSupplyChange  int64
+	}{
+		{
+			"no base fees",
+			types.BaseFeePolicyBurn,
+			0,
+			1000,
+			0,
+			0,
+		},
+		{
+			"distribute",
+			types.BaseFeePolicyDistribute,
+			400,
+			1000,
+			0,
+			0,
+		},
+		{
+			"burn",
+			types.BaseFeePolicyBurn,
+			400,
+			600,
+			0,
+			-400,
+		},
+		{
+			"community pool",
+			types.BaseFeePolicyCommunityPool,
+			400,
+			600,
+			400,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw := network.NewUnitTestNetwork(s.create, s.options...)
+			ctx := nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+			bk := nw.App.GetBankKeeper()
+			dk := nw.App.GetDistrKeeper()
+
+			denom := nw.GetBaseDenom()
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+			params := fmk.GetParams(ctx)
+			params.BaseFeePolicy = tc.policy
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			// start from an empty fee collector
+			balance := bk.GetBalance(ctx, feeCollector, denom)
+			if balance.IsPositive() {
+				s.Require().NoError(bk.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, sdk.NewCoins(balance)))
+				s.Require().NoError(bk.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(balance)))
+			}
+			s.Require().NoError(testutil.FundModuleAccount(ctx, bk, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+			feePool, err := dk.FeePool.Get(ctx)
+			s.Require().NoError(err)
+			communityPool := feePool.CommunityPool.AmountOf(denom)
+			supply := bk.GetSupply(ctx, denom).Amount
+
+			fmk.AddTransientBaseFees(ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, tc.baseFees)))
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, tc.baseFees)), fmk.GetTransientBaseFees(ctx))
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(fmk.EndBlock(ctx))
+
+			s.Require().Equal(math.NewInt(tc.expFeeCollector), bk.GetBalance(ctx, feeCollector, denom).Amount)
+			s.Require().Equal(supply.AddRaw(tc.expSupplyChange), bk.GetSupply(ctx, denom).Amount)
+
+			feePool, err = dk.FeePool.Get(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(communityPool.Add(math.LegacyNewDec(tc.expCommunityPool)), feePool.CommunityPool.AmountOf(denom))
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeBaseFees {
+					continue
+				}
+				found = true
+				policy, ok := event.GetAttribute(types.AttributeKeyBaseFeePolicy)
+				s.Require().True(ok)
+				s.Require().Equal(tc.policy.String(), policy.Value)
+				amount, ok := event.GetAttribute(sdk.AttributeKeyAmount)
+				s.Require().True(ok)
+				s.Require().Equal(sdk.NewInt64Coin(denom, tc.baseFees).String(), amount.Value)
+			}
+			s.Require().Equal(tc.baseFees > 0, found)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockMinGasPriceAdjustment() {
+	testCases := []struct {
+		name           string
+		window         uint64
+		gasUsed        uint64
+		expMinGasPrice math.LegacyDec
+	}{
+		{
+			"disabled",
+			0,
+			1000,
+			math.LegacyNewDec(100),
+		},
+		{
+			"increase on full blocks",
+			2,
+			1000,
+			math.LegacyNewDec(110),
+		},
+		{
+			"decrease on empty blocks",
+			2,
+			0,
+			math.LegacyNewDec(90),
+		},
+		{
+			"unchanged on target",
+			2,
+			500,
+			math.LegacyNewDec(100),
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw := network.NewUnitTestNetwork(s.create, s.options...)
+			ctx := nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.MinGasPrice = math.LegacyNewDec(100)
+			if tc.window > 0 {
+				params.MinGasPriceAdjustment = types.MinGasPriceAdjustment{
+					Window:             tc.window,
+					TargetUtilization:  math.LegacyNewDecWithPrec(5, 1),
+					MaxChangeRate:      math.LegacyNewDecWithPrec(1, 1),
+					MinGasPriceFloor:   math.LegacyNewDec(10),
+					MinGasPriceCeiling: math.LegacyNewDec(1000),
+				}
+			}
+			s.Require().NoError(params.Validate())
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			consParams := ctx.ConsensusParams()
+			consParams.Block.MaxGas = 1000
+			ctx = ctx.WithConsensusParams(consParams)
+
+			endBlock := func() sdk.Context {
+				meter := storetypes.NewGasMeter(1000)
+				meter.ConsumeGas(tc.gasUsed, "test")
+				ctx := ctx.WithBlockGasMeter(meter).WithEventManager(sdk.NewEventManager())
+				s.Require().NoError(fmk.EndBlock(ctx))
+				return ctx
+			}
+
+			// the min gas price is only adjusted at the end of the window
+			for i := uint64(1); i < tc.window; i++ {
+				endBlock()
+				s.Require().Equal(math.LegacyNewDec(100), fmk.GetParams(ctx).MinGasPrice)
+				blocks, _ := fmk.GetMinGasPriceWindow(ctx)
+				s.Require().Equal(i, blocks)
+			}
+
+			eventCtx := endBlock()
+			s.Require().Equal(tc.expMinGasPrice, fmk.GetParams(ctx).MinGasPrice)
+
+			blocks, totalUtilization := fmk.GetMinGasPriceWindow(ctx)
+			s.Require().Zero(blocks)
+			s.Require().True(totalUtilization.IsZero())
+
+			var found bool
+			for _, event := range eventCtx.EventManager().Events() {
+				if event.Type != types.EventTypeMinGasPrice {
+					continue
+				}
+				found = true
+				minGasPrice, ok := event.GetAttribute(types.AttributeKeyMinGasPrice)
+				s.Require().True(ok)
+				s.Require().Equal(tc.expMinGasPrice.String(), minGasPrice.Value)
+			}
+			s.Require().Equal(tc.window > 0, found)
+		})
+	}
+}
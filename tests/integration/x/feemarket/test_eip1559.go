@@ -228,6 +228,118 @@ func (s *KeeperTestSuite) TestCalculateBaseFeeEdgeCases() {
 			parentBaseFee:  big.NewInt(1000000000),
 			expectedResult: big.NewInt(1000000000), // Base fee unchanged when gas used = target
 		},
+		{
+			name:        "independent gas target - gas used equals target, base fee unchanged",
+			blockMaxGas: 30000000, // derived target would be 30000000 / 2 = 15000000
+			setupParams: func() feemarkettypes.Params {
+				return feemarkettypes.Params{
+					NoBaseFee:                false,
+					ElasticityMultiplier:     2,
+					BaseFeeChangeDenominator: 8,
+					MinGasPrice:              math.LegacyZeroDec(),
+					EnableHeight:             1,
+					BlockGasTarget:           6000000,
+				}
+			},
+			setupBlockData: func(k *keeper.Keeper, ctx sdk.Context) {
+				k.SetBlockGasWanted(ctx, 6000000) // Gas used equals the independent target
+				k.SetBaseFee(ctx, math.LegacyNewDecFromBigInt(big.NewInt(1000000000)))
+			},
+			currentBlock:   10,
+			parentBaseFee:  big.NewInt(1000000000),
+			expectedResult: big.NewInt(1000000000),
+		},
+		{
+			name:        "independent gas target - gas used above target, base fee increases",
+			blockMaxGas: 20000000, // derived target would be 20000000 / 2 = 10000000
+			setupParams: func() feemarkettypes.Params {
+				return feemarkettypes.Params{
+					NoBaseFee:                false,
+					ElasticityMultiplier:     2,
+					BaseFeeChangeDenominator: 8,
+					MinGasPrice:              math.LegacyZeroDec(),
+					EnableHeight:             1,
+					BlockGasTarget:           5000000,
+				}
+			},
+			setupBlockData: func(k *keeper.Keeper, ctx sdk.Context) {
+				// Gas used is above the independent target but below the derived one
+				k.SetBlockGasWanted(ctx, 8000000)
+				k.SetBaseFee(ctx, math.LegacyNewDecFromBigInt(big.NewInt(1000000000)))
+			},
+			currentBlock:  10,
+			parentBaseFee: big.NewInt(1000000000),
+			checkFunc: func(s *KeeperTestSuite, result math.LegacyDec, parentBaseFee *big.Int) {
+				s.T().Helper()
+				// 1000000000 + 1000000000 * (8000000 - 5000000) / 5000000 / 8 = 1075000000
+				require.Equal(s.T(), math.LegacyNewDec(1075000000), result)
+			},
+		},
+		{
+			name:        "max base fee change rate - base fee increase clamped",
+			blockMaxGas: 20000000, // parentGasTarget = 20000000 / 2 = 10000000 (ElasticityMultiplier=2)
+			setupParams: func() feemarkettypes.Params {
+				return feemarkettypes.Params{
+					NoBaseFee:                false,
+					ElasticityMultiplier:     2,
+					BaseFeeChangeDenominator: 8,
+					MinGasPrice:              math.LegacyZeroDec(),
+					EnableHeight:             1,
+					MaxBaseFeeChangeRate:     math.LegacyNewDecWithPrec(5, 2),
+				}
+			},
+			setupBlockData: func(k *keeper.Keeper, ctx sdk.Context) {
+				// full block would increase the base fee by 12.5%
+				k.SetBlockGasWanted(ctx, 20000000)
+				k.SetBaseFee(ctx, math.LegacyNewDecFromBigInt(big.NewInt(1000000000)))
+			},
+			currentBlock:   10,
+			parentBaseFee:  big.NewInt(1000000000),
+			expectedResult: big.NewInt(1050000000),
+		},
+		{
+			name:        "max base fee - base fee increase clamped",
+			blockMaxGas: 20000000, // parentGasTarget = 20000000 / 2 = 10000000 (ElasticityMultiplier=2)
+			setupParams: func() feemarkettypes.Params {
+				return feemarkettypes.Params{
+					NoBaseFee:                false,
+					ElasticityMultiplier:     2,
+					BaseFeeChangeDenominator: 8,
+					MinGasPrice:              math.LegacyZeroDec(),
+					EnableHeight:             1,
+					MaxBaseFee:               math.LegacyNewDec(1010000000),
+				}
+			},
+			setupBlockData: func(k *keeper.Keeper, ctx sdk.Context) {
+				k.SetBlockGasWanted(ctx, 20000000)
+				k.SetBaseFee(ctx, math.LegacyNewDecFromBigInt(big.NewInt(1000000000)))
+			},
+			currentBlock:   10,
+			parentBaseFee:  big.NewInt(1000000000),
+			expectedResult: big.NewInt(1010000000),
+		},
+		{
+			name:        "min base fee - base fee decrease clamped",
+			blockMaxGas: 20000000, // parentGasTarget = 20000000 / 2 = 10000000 (ElasticityMultiplier=2)
+			setupParams: func() feemarkettypes.Params {
+				return feemarkettypes.Params{
+					NoBaseFee:                false,
+					ElasticityMultiplier:     2,
+					BaseFeeChangeDenominator: 8,
+					MinGasPrice:              math.LegacyZeroDec(),
+					EnableHeight:             1,
+					MinBaseFee:               math.LegacyNewDec(990000000),
+				}
+			},
+			setupBlockData: func(k *keeper.Keeper, ctx sdk.Context) {
+				// empty block would decrease the base fee by 12.5%
+				k.SetBlockGasWanted(ctx, 0)
+				k.SetBaseFee(ctx, math.LegacyNewDecFromBigInt(big.NewInt(1000000000)))
+			},
+			currentBlock:   10,
+			parentBaseFee:  big.NewInt(1000000000),
+			expectedResult: big.NewInt(990000000),
+		},
 		{
 			name:        "gas used > target - base fee increases",
 			blockMaxGas: 20000000, // parentGasTarget = 20000000 / 2 = 10000000 (ElasticityMultiplier=2)
@@ -45,6 +45,46 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryParamsAtHeight() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+
+	height := ctx.BlockHeight()
+	params := k.GetParams(ctx)
+	params.ElasticityMultiplier = 4
+	s.Require().NoError(k.SetParams(ctx.WithBlockHeight(height+10), params))
+	// the base fee updates are not recorded in the history
+	k.SetBaseFee(ctx.WithBlockHeight(height+15), sdkmath.LegacyNewDec(1))
+	expParams := params
+	expParams.BaseFee = sdkmath.LegacyZeroDec()
+
+	testCases := []struct {
+		name            string
+		height          int64
+		expParams       types.Params
+		expChangeHeight int64
+		errContains     string
+	}{
+		{"invalid height", -1, types.Params{}, 0, "invalid height"},
+		{"params changed at height", height + 10, expParams, height + 10, ""},
+		{"base fee updated at height", height + 15, expParams, height + 10, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := k.ParamsAtHeight(ctx, &types.QueryParamsAtHeightRequest{Height: tc.height})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expParams, res.Params)
+			s.Require().Equal(tc.expChangeHeight, res.ChangeHeight)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryBaseFee() {
 	var (
 		expRes         *types.QueryBaseFeeResponse
@@ -2,8 +2,12 @@ package feemarket
 
 import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/feemarket/types"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -50,3 +54,40 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParamsAuthorities() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	k := nw.App.GetFeeMarketKeeper()
+
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	authority := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	authorities := []cosmosevmtypes.ParamsAuthority{{Authority: authority, Params: []string{"min_gas_price"}}}
+
+	// only governance can set the params authorities
+	_, err := k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{Authority: authority, ParamsAuthorities: authorities})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{Authority: govAuthority, ParamsAuthorities: authorities})
+	s.Require().NoError(err)
+
+	res, err := k.ParamsAuthorities(ctx, &types.QueryParamsAuthoritiesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(authorities, res.ParamsAuthorities)
+
+	// the params authority only updates the min gas price
+	params := k.GetParams(ctx)
+	updated := params
+	updated.MinGasPrice = math.LegacyNewDec(7)
+	updated.ElasticityMultiplier = params.ElasticityMultiplier + 1
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: updated})
+	s.Require().NoError(err)
+
+	expected := params
+	expected.MinGasPrice = math.LegacyNewDec(7)
+	s.Require().Equal(expected, k.GetParams(ctx))
+
+	// other accounts can't update the params
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(), Params: updated})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
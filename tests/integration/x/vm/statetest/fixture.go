@@ -0,0 +1,148 @@
+package statetest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Fixtures embeds the state tests maintained with the module, covering the
+// transaction types and the opcodes whose behavior changes across forks.
+//
+//go:embed testdata
+var Fixtures embed.FS
+
+// Fixture is a state test in the format shared by the Ethereum GeneralStateTests
+// and the execution-spec-tests state_tests fixtures. A fixture defines the
+// pre-state, the block environment and a transaction template, and the
+// expected post-state of each fork for every combination of the transaction
+// data, gas limit and value.
+type Fixture struct {
+	Env  Env                    `json:"env"`
+	Pre  ethtypes.GenesisAlloc  `json:"pre"`
+	Tx   Transaction            `json:"transaction"`
+	Post map[string][]PostState `json:"post"`
+}
+
+// Env is the block environment of a state test.
+type Env struct {
+	Coinbase   common.UnprefixedAddress `json:"currentCoinbase"`
+	Difficulty *math.HexOrDecimal256    `json:"currentDifficulty"`
+	Random     *math.HexOrDecimal256    `json:"currentRandom"`
+	GasLimit   math.HexOrDecimal64      `json:"currentGasLimit"`
+	Number     math.HexOrDecimal64      `json:"currentNumber"`
+	Timestamp  math.HexOrDecimal64      `json:"currentTimestamp"`
+	BaseFee    *math.HexOrDecimal256    `json:"currentBaseFee"`
+}
+
+// Transaction is the transaction template of a state test, where the data,
+// gas limit and value are selected by the indexes of each post-state.
+type Transaction struct {
+	GasPrice             *math.HexOrDecimal256  `json:"gasPrice"`
+	MaxFeePerGas         *math.HexOrDecimal256  `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *math.HexOrDecimal256  `json:"maxPriorityFeePerGas"`
+	Nonce                math.HexOrDecimal64    `json:"nonce"`
+	To                   string                 `json:"to"`
+	Data                 []string               `json:"data"`
+	AccessLists          []*ethtypes.AccessList `json:"accessLists,omitempty"`
+	GasLimit             []math.HexOrDecimal64  `json:"gasLimit"`
+	Value                []string               `json:"value"`
+	PrivateKey           hexutil.Bytes          `json:"secretKey"`
+	Sender               *common.Address        `json:"sender"`
+	BlobVersionedHashes  []common.Hash          `json:"blobVersionedHashes,omitempty"`
+	AuthorizationList    []*Authorization       `json:"authorizationList,omitempty"`
+}
+
+// Authorization is an EIP-7702 code delegation of a state test transaction.
+type Authorization struct {
+	ChainID *math.HexOrDecimal256 `json:"chainId"`
+	Address common.Address        `json:"address"`
+	Nonce   math.HexOrDecimal64   `json:"nonce"`
+	V       math.HexOrDecimal64   `json:"v"`
+	R       *math.HexOrDecimal256 `json:"r"`
+	S       *math.HexOrDecimal256 `json:"s"`
+}
+
+// PostState is the expected result of a state test on a fork.
+type PostState struct {
+	Root            common.UnprefixedHash `json:"hash"`
+	Logs            common.UnprefixedHash `json:"logs"`
+	ExpectException string                `json:"expectException"`
+	Indexes         struct {
+		Data  int `json:"data"`
+		Gas   int `json:"gas"`
+		Value int `json:"value"`
+	} `json:"indexes"`
+}
+
+// Subtest identifies a post-state of a fixture.
+type Subtest struct {
+	Name  string
+	Fork  string
+	Index int
+}
+
+// String returns the name of the subtest.
+func (s Subtest) String() string {
+	return fmt.Sprintf("%s/%s/%d", s.Fork, s.Name, s.Index)
+}
+
+// Subtests returns the subtests of the fixture with the given name, sorted by
+// fork.
+func (f Fixture) Subtests(name string) []Subtest {
+	forks := make([]string, 0, len(f.Post))
+	for fork := range f.Post {
+		forks = append(forks, fork)
+	}
+	sort.Strings(forks)
+
+	var subtests []Subtest
+	for _, fork := range forks {
+		for i := range f.Post[fork] {
+			subtests = append(subtests, Subtest{Name: name, Fork: fork, Index: i})
+		}
+	}
+
+	return subtests
+}
+
+// LoadFixtures loads the fixtures of all the JSON files under the given root
+// of the file system, keyed by the file path and the fixture name.
+func LoadFixtures(fsys fs.FS, root string) (map[string]Fixture, error) {
+	fixtures := make(map[string]Fixture)
+	err := fs.WalkDir(fsys, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != ".json" {
+			return nil
+		}
+
+		bz, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+
+		var fileFixtures map[string]Fixture
+		if err := json.Unmarshal(bz, &fileFixtures); err != nil {
+			return fmt.Errorf("failed to decode fixtures of %s: %w", p, err)
+		}
+
+		prefix := strings.TrimSuffix(strings.TrimPrefix(p, root+"/"), ".json")
+		for name, fixture := range fileFixtures {
+			fixtures[prefix+"/"+name] = fixture
+		}
+		return nil
+	})
+
+	return fixtures, err
+}
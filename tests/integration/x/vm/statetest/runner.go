@@ -0,0 +1,511 @@
+package statetest
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/tests"
+	"github.com/holiman/uint256"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrUnsupported is returned for the subtests relying on features that the
+// module doesn't support, which are skipped instead of reported as divergences.
+var ErrUnsupported = errors.New("unsupported state test")
+
+// Runner executes state test fixtures on the EVM keeper of a test network,
+// using the module statedb and interpreter configuration.
+//
+// The transaction validation and the gas purchase, handled by the ante handler
+// on a chain, are emulated as specified by the Ethereum state transition, so
+// that only the execution of the message goes through the module. Each subtest
+// runs on a branch of the network state with the chain config of its fork.
+type Runner struct {
+	network  *network.UnitTestNetwork
+	baseline map[common.Address]struct{}
+
+	chainConfig *evmtypes.ChainConfig
+	coinInfo    evmtypes.EvmCoinInfo
+}
+
+// NewRunner creates a runner on a new test network. The minimum gas multiplier
+// of the fee market is disabled, so that the gas used by the transactions is
+// the one of the Ethereum state transition.
+func NewRunner(create network.CreateEvmApp, options ...network.ConfigOption) *Runner {
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.MinGasMultiplier = sdkmath.LegacyZeroDec()
+
+	opts := []network.ConfigOption{
+		network.WithCustomGenesis(network.CustomGenesisState{
+			feemarkettypes.ModuleName: feemarketGenesis,
+		}),
+	}
+	opts = append(opts, options...)
+	nw := network.NewUnitTestNetwork(create, opts...)
+
+	// the accounts of the network are ignored when computing the post-state
+	// root of the subtests
+	baseline := make(map[common.Address]struct{})
+	nw.App.GetAccountKeeper().IterateAccounts(nw.GetContext(), func(acc sdk.AccountI) bool {
+		baseline[common.BytesToAddress(acc.GetAddress())] = struct{}{}
+		return false
+	})
+
+	return &Runner{
+		network:     nw,
+		baseline:    baseline,
+		chainConfig: evmtypes.GetChainConfig(),
+		coinInfo: evmtypes.EvmCoinInfo{
+			Denom:         evmtypes.GetEVMCoinDenom(),
+			ExtendedDenom: evmtypes.GetEVMCoinExtendedDenom(),
+			DisplayDenom:  evmtypes.GetEVMCoinDisplayDenom(),
+			Decimals:      evmtypes.GetEVMCoinDecimals(),
+		},
+	}
+}
+
+// Close restores the chain config of the network.
+func (r *Runner) Close() error {
+	return r.configure(r.chainConfig)
+}
+
+// Run executes the subtest of the fixture and returns an error describing the
+// divergence from the expected post-state, if any. Subtests that can't run on
+// the module return an error wrapping ErrUnsupported.
+func (r *Runner) Run(fixture Fixture, subtest Subtest) error {
+	post := fixture.Post[subtest.Fork][subtest.Index]
+
+	ethCfg, eips, err := tests.GetChainConfig(subtest.Fork)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnsupported, err)
+	}
+	if len(eips) > 0 {
+		return fmt.Errorf("%w: extra EIPs %v", ErrUnsupported, eips)
+	}
+	if len(fixture.Tx.BlobVersionedHashes) > 0 {
+		return fmt.Errorf("%w: blob transactions", ErrUnsupported)
+	}
+	if err := r.configure(forkChainConfig(ethCfg, r.chainConfig)); err != nil {
+		return fmt.Errorf("%w: %w", ErrUnsupported, err)
+	}
+
+	ctx, _ := r.network.GetContext().CacheContext()
+	ctx = ctx.
+		WithBlockHeight(int64(fixture.Env.Number)).                      //nolint:gosec // G115 // test block numbers are small
+		WithBlockTime(time.Unix(int64(fixture.Env.Timestamp), 0).UTC()). //nolint:gosec // G115 // test timestamps are small
+		WithBlockGasMeter(storetypes.NewGasMeter(uint64(fixture.Env.GasLimit))).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	if err := r.setPreState(ctx, fixture.Pre); err != nil {
+		return err
+	}
+
+	var baseFee *big.Int
+	if ethCfg.IsLondon(new(big.Int)) {
+		baseFee = big.NewInt(0x0a)
+		if fixture.Env.BaseFee != nil {
+			baseFee = (*big.Int)(fixture.Env.BaseFee)
+		}
+	}
+
+	msg, err := fixture.Tx.toMessage(post, baseFee)
+	if err != nil {
+		return err
+	}
+
+	logs, err := r.applyMessage(ctx, fixture.Env, ethCfg, msg, baseFee)
+	switch {
+	case err != nil && post.ExpectException == "":
+		return fmt.Errorf("unexpected error: %w", err)
+	case err == nil && post.ExpectException != "":
+		return fmt.Errorf("expected error %q, got no error", post.ExpectException)
+	case err != nil:
+		// the transaction is invalid as expected, so the state isn't checked
+		return nil
+	}
+
+	root := r.postStateRoot(ctx, fixture.Pre, ethCfg.IsEIP158(new(big.Int)))
+	if root != common.Hash(post.Root) {
+		return fmt.Errorf("post state root mismatch: got %x, want %x", root, post.Root)
+	}
+	if logsHash := rlpHash(logs); logsHash != common.Hash(post.Logs) {
+		return fmt.Errorf("post state logs hash mismatch: got %x, want %x", logsHash, post.Logs)
+	}
+
+	return nil
+}
+
+// configure replaces the chain config of the EVM.
+func (r *Runner) configure(chainConfig *evmtypes.ChainConfig) error {
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	return configurator.
+		WithChainConfig(chainConfig).
+		WithEVMCoinInfo(r.coinInfo).
+		Configure()
+}
+
+// setPreState writes the accounts of the fixture pre-state.
+func (r *Runner) setPreState(ctx sdk.Context, alloc ethtypes.GenesisAlloc) error {
+	stateDB := statedb.New(ctx, r.network.App.GetEVMKeeper(), statedb.NewEmptyTxConfig())
+	for addr, account := range alloc {
+		balance, overflow := uint256.FromBig(account.Balance)
+		if overflow {
+			return fmt.Errorf("%w: balance of %s overflows", ErrUnsupported, addr)
+		}
+
+		stateDB.CreateAccount(addr)
+		stateDB.AddBalance(addr, balance, tracing.BalanceChangeUnspecified)
+		stateDB.SetNonce(addr, account.Nonce, tracing.NonceChangeUnspecified)
+		if len(account.Code) > 0 {
+			stateDB.SetCode(addr, account.Code)
+		}
+		for key, value := range account.Storage {
+			stateDB.SetState(addr, key, value)
+		}
+	}
+
+	return stateDB.Commit()
+}
+
+// applyMessage validates the message, buys its gas and executes it on the EVM
+// keeper, then refunds the leftover gas and pays the tip to the coinbase. It
+// returns the logs of the execution, or an error if the message is invalid.
+func (r *Runner) applyMessage(ctx sdk.Context, env Env, ethCfg *params.ChainConfig, msg *core.Message, baseFee *big.Int) ([]*ethtypes.Log, error) {
+	k := r.network.App.GetEVMKeeper()
+
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+	if err := validateMessage(stateDB, env, ethCfg, msg, baseFee); err != nil {
+		return nil, err
+	}
+
+	// the nonce of contract creations is handled by the keeper
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+	stateDB.SubBalance(msg.From, uint256.MustFromBig(gasCost), tracing.BalanceDecreaseGasBuy)
+	if msg.To != nil {
+		stateDB.SetNonce(msg.From, msg.Nonce+1, tracing.NonceChangeEoACall)
+	}
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress))
+	if err != nil {
+		return nil, err
+	}
+	cfg.CoinBase = common.Address(env.Coinbase)
+	cfg.BaseFee = baseFee
+
+	res, err := k.ApplyMessageWithConfig(ctx, *msg, nil, true, cfg, statedb.NewEmptyTxConfig(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// the base fee is burnt, only the tip is paid to the coinbase
+	tip := new(big.Int).Set(msg.GasPrice)
+	if baseFee != nil {
+		tip.Sub(tip, baseFee)
+	}
+	leftover := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit-res.GasUsed), msg.GasPrice)
+	fee := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), tip)
+
+	stateDB = statedb.New(ctx, k, statedb.NewEmptyTxConfig())
+	stateDB.AddBalance(msg.From, uint256.MustFromBig(leftover), tracing.BalanceIncreaseGasReturn)
+	if fee.Sign() > 0 {
+		stateDB.AddBalance(cfg.CoinBase, uint256.MustFromBig(fee), tracing.BalanceIncreaseRewardTransactionFee)
+	}
+	if err := stateDB.Commit(); err != nil {
+		return nil, err
+	}
+
+	return evmtypes.LogsToEthereum(res.Logs), nil
+}
+
+// validateMessage performs the checks of the Ethereum state transition that
+// the ante handler performs on a chain.
+func validateMessage(stateDB *statedb.StateDB, env Env, ethCfg *params.ChainConfig, msg *core.Message, baseFee *big.Int) error {
+	nonce := stateDB.GetNonce(msg.From)
+	switch {
+	case nonce < msg.Nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, msg.From, msg.Nonce, nonce)
+	case nonce > msg.Nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, msg.From, msg.Nonce, nonce)
+	case nonce+1 < nonce:
+		return fmt.Errorf("%w: address %s, nonce: %d", core.ErrNonceMax, msg.From, nonce)
+	}
+
+	if code := stateDB.GetCode(msg.From); len(code) > 0 {
+		if _, delegated := ethtypes.ParseDelegation(code); !delegated {
+			return fmt.Errorf("%w: address %s", core.ErrSenderNoEOA, msg.From)
+		}
+	}
+
+	if msg.GasLimit > uint64(env.GasLimit) {
+		return fmt.Errorf("%w: have %d, want %d", core.ErrGasLimitReached, env.GasLimit, msg.GasLimit)
+	}
+
+	maxCost := new(big.Int).Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasPrice)
+	if baseFee != nil {
+		if msg.GasFeeCap.Cmp(msg.GasTipCap) < 0 {
+			return fmt.Errorf("%w: address %s", core.ErrTipAboveFeeCap, msg.From)
+		}
+		if msg.GasFeeCap.Cmp(baseFee) < 0 {
+			return fmt.Errorf("%w: address %s", core.ErrFeeCapTooLow, msg.From)
+		}
+		maxCost.Mul(new(big.Int).SetUint64(msg.GasLimit), msg.GasFeeCap)
+	}
+	maxCost.Add(maxCost, msg.Value)
+	if stateDB.GetBalance(msg.From).ToBig().Cmp(maxCost) < 0 {
+		return fmt.Errorf("%w: address %s", core.ErrInsufficientFunds, msg.From)
+	}
+
+	rules := ethCfg.Rules(new(big.Int), true, uint64(env.Timestamp))
+	if msg.To == nil && rules.IsShanghai && len(msg.Data) > params.MaxInitCodeSize {
+		return fmt.Errorf("%w: code size %v limit %v", core.ErrMaxInitCodeSizeExceeded, len(msg.Data), params.MaxInitCodeSize)
+	}
+	if msg.SetCodeAuthorizations != nil {
+		if !rules.IsPrague {
+			return fmt.Errorf("%w: set code transactions", ErrUnsupported)
+		}
+		if msg.To == nil {
+			return fmt.Errorf("%w (sender %v)", core.ErrSetCodeTxCreate, msg.From)
+		}
+		if len(msg.SetCodeAuthorizations) == 0 {
+			return fmt.Errorf("%w (sender %v)", core.ErrEmptyAuthList, msg.From)
+		}
+	}
+
+	return nil
+}
+
+// postStateRoot computes the Ethereum state root of the accounts of the
+// pre-state and of the accounts created by the execution.
+//
+// The module keeps the empty accounts created by the execution, while they are
+// removed after EIP-158, so those accounts are ignored in that case.
+func (r *Runner) postStateRoot(ctx sdk.Context, pre ethtypes.GenesisAlloc, isEIP158 bool) common.Hash {
+	k := r.network.App.GetEVMKeeper()
+
+	addrs := make(map[common.Address]bool)
+	for addr := range pre {
+		addrs[addr] = true
+	}
+	r.network.App.GetAccountKeeper().IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		addr := common.BytesToAddress(acc.GetAddress())
+		if _, ok := r.baseline[addr]; !ok && !addrs[addr] {
+			addrs[addr] = false
+		}
+		return false
+	})
+
+	stateDB, err := state.New(ethtypes.EmptyRootHash, state.NewDatabaseForTesting())
+	if err != nil {
+		panic(err)
+	}
+	for addr, inPre := range addrs {
+		account := k.GetAccount(ctx, addr)
+		if account == nil {
+			continue
+		}
+
+		var storage []common.Hash
+		k.ForEachStorage(ctx, addr, func(key, value common.Hash) bool {
+			storage = append(storage, key, value)
+			return true
+		})
+
+		empty := account.Nonce == 0 && account.Balance.IsZero() && evmtypes.IsEmptyCodeHash(account.CodeHash)
+		if empty && !inPre && isEIP158 && len(storage) == 0 {
+			continue
+		}
+
+		stateDB.CreateAccount(addr)
+		stateDB.SetBalance(addr, account.Balance, tracing.BalanceChangeUnspecified)
+		stateDB.SetNonce(addr, account.Nonce, tracing.NonceChangeUnspecified)
+		if !evmtypes.IsEmptyCodeHash(account.CodeHash) {
+			stateDB.SetCode(addr, k.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+		}
+		for i := 0; i < len(storage); i += 2 {
+			stateDB.SetState(addr, storage[i], storage[i+1])
+		}
+	}
+
+	return stateDB.IntermediateRoot(false)
+}
+
+// toMessage returns the message of the transaction for the given post-state,
+// with the effective gas price for the base fee if any.
+func (tx *Transaction) toMessage(post PostState, baseFee *big.Int) (*core.Message, error) {
+	var from common.Address
+	switch {
+	case tx.Sender != nil:
+		from = *tx.Sender
+	case len(tx.PrivateKey) > 0:
+		key, err := crypto.ToECDSA(tx.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		from = crypto.PubkeyToAddress(key.PublicKey)
+	default:
+		return nil, errors.New("no transaction sender")
+	}
+
+	var to *common.Address
+	if tx.To != "" {
+		to = new(common.Address)
+		if err := to.UnmarshalText([]byte(tx.To)); err != nil {
+			return nil, fmt.Errorf("invalid to address: %w", err)
+		}
+	}
+
+	if post.Indexes.Data >= len(tx.Data) || post.Indexes.Value >= len(tx.Value) || post.Indexes.Gas >= len(tx.GasLimit) {
+		return nil, fmt.Errorf("transaction indexes %+v out of bounds", post.Indexes)
+	}
+
+	value := new(big.Int)
+	if valueHex := tx.Value[post.Indexes.Value]; valueHex != "0x" {
+		v, ok := math.ParseBig256(valueHex)
+		if !ok {
+			return nil, fmt.Errorf("invalid transaction value %q", valueHex)
+		}
+		value = v
+	}
+	dataHex := tx.Data[post.Indexes.Data]
+	data, err := hex.DecodeString(strings.TrimPrefix(dataHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction data %q", dataHex)
+	}
+
+	var accessList ethtypes.AccessList
+	if post.Indexes.Data < len(tx.AccessLists) && tx.AccessLists[post.Indexes.Data] != nil {
+		accessList = *tx.AccessLists[post.Indexes.Data]
+	}
+
+	gasPrice := (*big.Int)(tx.GasPrice)
+	gasFeeCap := (*big.Int)(tx.MaxFeePerGas)
+	gasTipCap := (*big.Int)(tx.MaxPriorityFeePerGas)
+	if baseFee != nil {
+		if gasFeeCap == nil {
+			gasFeeCap = gasPrice
+		}
+		if gasFeeCap == nil {
+			gasFeeCap = new(big.Int)
+		}
+		if gasTipCap == nil {
+			gasTipCap = gasFeeCap
+		}
+		gasPrice = new(big.Int).Add(gasTipCap, baseFee)
+		if gasPrice.Cmp(gasFeeCap) > 0 {
+			gasPrice = gasFeeCap
+		}
+	}
+	if gasPrice == nil {
+		return nil, errors.New("no gas price provided")
+	}
+
+	var authList []ethtypes.SetCodeAuthorization
+	if tx.AuthorizationList != nil {
+		authList = make([]ethtypes.SetCodeAuthorization, len(tx.AuthorizationList))
+		for i, auth := range tx.AuthorizationList {
+			authList[i] = ethtypes.SetCodeAuthorization{
+				ChainID: *uint256.MustFromBig((*big.Int)(auth.ChainID)),
+				Address: auth.Address,
+				Nonce:   uint64(auth.Nonce),
+				V:       uint8(auth.V), //nolint:gosec // G115 // the recovery id fits in a byte
+				R:       *uint256.MustFromBig((*big.Int)(auth.R)),
+				S:       *uint256.MustFromBig((*big.Int)(auth.S)),
+			}
+		}
+	}
+
+	return &core.Message{
+		From:                  from,
+		To:                    to,
+		Nonce:                 uint64(tx.Nonce),
+		Value:                 value,
+		GasLimit:              uint64(tx.GasLimit[post.Indexes.Gas]),
+		GasPrice:              gasPrice,
+		GasFeeCap:             gasFeeCap,
+		GasTipCap:             gasTipCap,
+		Data:                  data,
+		AccessList:            accessList,
+		SetCodeAuthorizations: authList,
+	}, nil
+}
+
+// forkChainConfig returns the chain config of the module for the Ethereum
+// chain config of a fork, keeping the EVM denomination of the network.
+func forkChainConfig(cfg *params.ChainConfig, base *evmtypes.ChainConfig) *evmtypes.ChainConfig {
+	blockValue := func(v *big.Int) *sdkmath.Int {
+		if v == nil {
+			return nil
+		}
+		i := sdkmath.NewIntFromBigInt(v)
+		return &i
+	}
+	timestampValue := func(v *uint64) *sdkmath.Int {
+		if v == nil {
+			return nil
+		}
+		i := sdkmath.NewIntFromUint64(*v)
+		return &i
+	}
+
+	return &evmtypes.ChainConfig{
+		ChainId:             cfg.ChainID.Uint64(),
+		Denom:               base.Denom,
+		Decimals:            base.Decimals,
+		HomesteadBlock:      blockValue(cfg.HomesteadBlock),
+		DAOForkBlock:        blockValue(cfg.DAOForkBlock),
+		DAOForkSupport:      cfg.DAOForkSupport,
+		EIP150Block:         blockValue(cfg.EIP150Block),
+		EIP155Block:         blockValue(cfg.EIP155Block),
+		EIP158Block:         blockValue(cfg.EIP158Block),
+		ByzantiumBlock:      blockValue(cfg.ByzantiumBlock),
+		ConstantinopleBlock: blockValue(cfg.ConstantinopleBlock),
+		PetersburgBlock:     blockValue(cfg.PetersburgBlock),
+		IstanbulBlock:       blockValue(cfg.IstanbulBlock),
+		MuirGlacierBlock:    blockValue(cfg.MuirGlacierBlock),
+		BerlinBlock:         blockValue(cfg.BerlinBlock),
+		LondonBlock:         blockValue(cfg.LondonBlock),
+		ArrowGlacierBlock:   blockValue(cfg.ArrowGlacierBlock),
+		GrayGlacierBlock:    blockValue(cfg.GrayGlacierBlock),
+		MergeNetsplitBlock:  blockValue(cfg.MergeNetsplitBlock),
+		ShanghaiTime:        timestampValue(cfg.ShanghaiTime),
+		CancunTime:          timestampValue(cfg.CancunTime),
+		PragueTime:          timestampValue(cfg.PragueTime),
+		OsakaTime:           timestampValue(cfg.OsakaTime),
+		VerkleTime:          timestampValue(cfg.VerkleTime),
+	}
+}
+
+// rlpHash returns the hash of the RLP encoding of the value.
+func rlpHash(x interface{}) common.Hash {
+	bz, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(bz)
+}
@@ -0,0 +1,161 @@
+package vm
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cosmos/evm/server/config"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+// BLS12-381 curve parameters and generators, as defined in EIP-2537.
+var (
+	bls12381P, _  = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+	bls12381G1X   = common.FromHex("0x17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb")
+	bls12381G1Y   = common.FromHex("0x08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1")
+	bls12381G2XC0 = common.FromHex("0x024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8")
+	bls12381G2XC1 = common.FromHex("0x13e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e")
+	bls12381G2YC0 = common.FromHex("0x0ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801")
+	bls12381G2YC1 = common.FromHex("0x0606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be")
+)
+
+// encodeFp encodes a BLS12-381 base field element in 64 bytes, as defined in EIP-2537.
+func encodeFp(bz []byte) []byte {
+	return common.LeftPadBytes(bz, 64)
+}
+
+// bls12381G1 returns the encoded G1 generator, or its negation.
+func bls12381G1(negate bool) []byte {
+	y := bls12381G1Y
+	if negate {
+		y = new(big.Int).Sub(bls12381P, new(big.Int).SetBytes(y)).Bytes()
+	}
+
+	return append(encodeFp(bls12381G1X), encodeFp(y)...)
+}
+
+// bls12381G2 returns the encoded G2 generator.
+func bls12381G2() []byte {
+	var bz []byte
+	for _, fp := range [][]byte{bls12381G2XC0, bls12381G2XC1, bls12381G2YC0, bls12381G2YC1} {
+		bz = append(bz, encodeFp(fp)...)
+	}
+
+	return bz
+}
+
+func (s *KeeperTestSuite) TestBLS12381Precompiles() {
+	s.SetupTest()
+
+	g1Infinity := make([]byte, 128)
+	two := common.LeftPadBytes(big.NewInt(2).Bytes(), 32)
+
+	ethCall := func(precompile byte, input []byte) *types.MsgEthereumTxResponse {
+		sender := s.Keyring.GetAddr(0)
+		to := common.BytesToAddress([]byte{precompile})
+		args, err := json.Marshal(&types.TransactionArgs{
+			From: &sender,
+			To:   &to,
+			Data: (*hexutil.Bytes)(&input),
+		})
+		s.Require().NoError(err)
+
+		res, err := s.Network.GetEvmClient().EthCall(
+			s.Network.GetContext(),
+			&types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap},
+		)
+		s.Require().NoError(err)
+		return res
+	}
+
+	// G1 + G1
+	doubleG1 := ethCall(0x0b, append(bls12381G1(false), bls12381G1(false)...))
+	s.Require().Empty(doubleG1.VmError)
+	s.Require().Len(doubleG1.Ret, 128)
+
+	testCases := []struct {
+		name       string
+		precompile byte
+		input      []byte
+		expRet     []byte
+		expVMError bool
+	}{
+		{
+			"G1ADD - add the point at infinity",
+			0x0b,
+			append(bls12381G1(false), g1Infinity...),
+			bls12381G1(false),
+			false,
+		},
+		{
+			"G1ADD - add the negation",
+			0x0b,
+			append(bls12381G1(false), bls12381G1(true)...),
+			g1Infinity,
+			false,
+		},
+		{
+			"G1ADD - invalid input length",
+			0x0b,
+			bls12381G1(false),
+			nil,
+			true,
+		},
+		{
+			"G1MSM - scalar multiplication",
+			0x0c,
+			append(bls12381G1(false), two...),
+			doubleG1.Ret,
+			false,
+		},
+		{
+			"PAIRING_CHECK - e(G1, G2) * e(-G1, G2) == 1",
+			0x0f,
+			append(append(append(bls12381G1(false), bls12381G2()...), bls12381G1(true)...), bls12381G2()...),
+			common.LeftPadBytes([]byte{1}, 32),
+			false,
+		},
+		{
+			"PAIRING_CHECK - e(G1, G2) != 1",
+			0x0f,
+			append(bls12381G1(false), bls12381G2()...),
+			make([]byte, 32),
+			false,
+		},
+		{
+			"MAP_FP_TO_G1 - map a field element",
+			0x10,
+			encodeFp([]byte{1}),
+			nil,
+			false,
+		},
+		{
+			"MAP_FP_TO_G1 - field element larger than the modulus",
+			0x10,
+			encodeFp(bls12381P.Bytes()),
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res := ethCall(tc.precompile, tc.input)
+
+			if tc.expVMError {
+				s.Require().NotEmpty(res.VmError)
+				return
+			}
+
+			s.Require().Empty(res.VmError)
+			if tc.expRet != nil {
+				s.Require().Equal(tc.expRet, res.Ret)
+			} else {
+				s.Require().Len(res.Ret, 128)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *KeeperTestSuite) TestCircuitBreaker() {
+	s.SetupTest()
+
+	contractAddr, err := s.Factory.DeployContract(
+		s.Keyring.GetPrivKey(0),
+		types.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.Network.NextBlock())
+
+	eoa := s.Keyring.GetAddr(1)
+	stakingPrecompile := common.HexToAddress(types.StakingPrecompileAddress)
+	transfer := types.EvmTxArgs{To: &eoa, Amount: big.NewInt(100), GasLimit: 100_000}
+	call := types.EvmTxArgs{To: &contractAddr, GasLimit: 100_000}
+	precompileCall := types.EvmTxArgs{To: &stakingPrecompile, GasLimit: 100_000}
+	create := types.EvmTxArgs{Input: contracts.ERC20MinterBurnerDecimalsContract.Bin, GasLimit: 5_000_000}
+
+	testCases := []struct {
+		name           string
+		circuitBreaker func(height int64) types.CircuitBreaker
+		txArgs         types.EvmTxArgs
+		expPaused      bool
+	}{
+		{
+			"pass - transfer with paused calls and creations",
+			func(int64) types.CircuitBreaker {
+				return types.CircuitBreaker{PauseCreate: true, PauseCall: true}
+			},
+			transfer,
+			false,
+		},
+		{
+			"fail - contract call with paused calls",
+			func(int64) types.CircuitBreaker { return types.CircuitBreaker{PauseCall: true} },
+			call,
+			true,
+		},
+		{
+			"fail - precompile call with paused calls",
+			func(int64) types.CircuitBreaker { return types.CircuitBreaker{PauseCall: true} },
+			precompileCall,
+			true,
+		},
+		{
+			"fail - contract creation with paused creations",
+			func(int64) types.CircuitBreaker { return types.CircuitBreaker{PauseCreate: true} },
+			create,
+			true,
+		},
+		{
+			"pass - contract call with paused creations",
+			func(int64) types.CircuitBreaker { return types.CircuitBreaker{PauseCreate: true} },
+			call,
+			false,
+		},
+		{
+			"fail - paused precompile call",
+			func(int64) types.CircuitBreaker {
+				return types.CircuitBreaker{PausedPrecompiles: []string{types.StakingPrecompileAddress}}
+			},
+			precompileCall,
+			true,
+		},
+		{
+			"pass - contract call with a paused precompile",
+			func(int64) types.CircuitBreaker {
+				return types.CircuitBreaker{PausedPrecompiles: []string{types.StakingPrecompileAddress}}
+			},
+			call,
+			false,
+		},
+		{
+			"pass - contract call with expired paused calls",
+			func(height int64) types.CircuitBreaker {
+				return types.CircuitBreaker{PauseCall: true, ExpiryHeight: height}
+			},
+			call,
+			false,
+		},
+		{
+			"fail - contract call with paused calls until a later height",
+			func(height int64) types.CircuitBreaker {
+				return types.CircuitBreaker{PauseCall: true, ExpiryHeight: height + 1}
+			},
+			call,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.Network.GetContext()
+			k := s.Network.App.GetEVMKeeper()
+
+			params := k.GetParams(ctx)
+			params.CircuitBreaker = tc.circuitBreaker(ctx.BlockHeight())
+			s.Require().NoError(k.SetParams(ctx, params))
+
+			coreMsg, err := s.Factory.GenerateGethCoreMsg(s.Keyring.GetPrivKey(0), tc.txArgs)
+			s.Require().NoError(err)
+
+			res, err := k.ApplyMessage(ctx, *coreMsg, nil, true, false)
+			s.Require().NoError(err)
+			if tc.expPaused {
+				s.Require().True(res.Failed())
+				s.Require().Contains(res.VmError, types.ErrCircuitBreakerTripped.Error())
+			} else {
+				s.Require().NotContains(res.VmError, types.ErrCircuitBreakerTripped.Error())
+			}
+		})
+	}
+}
@@ -507,7 +507,7 @@ func (s *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 
 			baseDenom := evmtypes.GetEVMCoinDenom()
 
-			fees, err := keeper.VerifyFee(ethTx, baseDenom, baseFee, false, false, false, s.Network.GetContext().IsCheckTx())
+			fees, err := keeper.VerifyFee(ethTx, baseDenom, baseFee, evmtypes.GasOverrides{}, false, false, false, s.Network.GetContext().IsCheckTx())
 			if tc.expectPassVerify {
 				s.Require().NoError(err, "valid test %d failed - '%s'", i, tc.name)
 				if tc.EnableFeemarket {
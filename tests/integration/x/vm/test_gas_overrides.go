@@ -0,0 +1,62 @@
+package vm
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func (s *KeeperTestSuite) TestGasOverrides() {
+	s.SetupTest()
+
+	recipient := s.Keyring.GetAddr(1)
+	data := []byte{0, 1, 2, 3}
+	// creation code setting the storage slot 0 to 1
+	sstoreInitCode := []byte{byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP)}
+
+	testCases := []struct {
+		name         string
+		txArgs       types.EvmTxArgs
+		gasOverrides types.GasOverrides
+		expGasDiff   uint64
+	}{
+		{
+			"tx data byte costs",
+			types.EvmTxArgs{To: &recipient, Input: data, GasLimit: 100_000},
+			types.GasOverrides{TxDataZeroGas: 10, TxDataNonZeroGas: 40},
+			(10 - params.TxDataZeroGas) + 3*(40-params.TxDataNonZeroGasEIP2028),
+		},
+		{
+			"sstore set cost",
+			types.EvmTxArgs{Input: sstoreInitCode, GasLimit: 100_000},
+			types.GasOverrides{SstoreSetGas: 30_000},
+			30_000 - params.SstoreSetGasEIP2200,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx := s.Network.GetContext()
+			k := s.Network.App.GetEVMKeeper()
+
+			applyMessage := func(gasOverrides types.GasOverrides) uint64 {
+				params := k.GetParams(ctx)
+				params.GasOverrides = gasOverrides
+				s.Require().NoError(k.SetParams(ctx, params))
+
+				coreMsg, err := s.Factory.GenerateGethCoreMsg(s.Keyring.GetPrivKey(0), tc.txArgs)
+				s.Require().NoError(err)
+
+				res, err := k.ApplyMessage(ctx, *coreMsg, nil, false, true)
+				s.Require().NoError(err)
+				s.Require().False(res.Failed(), res.VmError)
+				return res.GasUsed
+			}
+
+			defaultGas := applyMessage(types.GasOverrides{})
+			overriddenGas := applyMessage(tc.gasOverrides)
+			s.Require().Equal(defaultGas+tc.expGasDiff, overriddenGas)
+		})
+	}
+}
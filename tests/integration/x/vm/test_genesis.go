@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -110,6 +111,23 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 			},
 			expPanic: false,
 		},
+		{
+			name: "valid account with 0x prefixed code",
+			malleate: func(network *network.UnitTestNetwork) {
+				acc := network.App.GetAccountKeeper().NewAccountWithAddress(ctx, address.Bytes())
+				network.App.GetAccountKeeper().SetAccount(ctx, acc)
+			},
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Accounts: []types.GenesisAccount{
+					{
+						Address: address.String(),
+						Code:    "0x1234",
+					},
+				},
+			},
+			expPanic: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -148,7 +166,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 					s.Require().NotNil(
 						s.network.App.GetAccountKeeper().GetAccount(ctx, common.HexToAddress(acct.Address).Bytes()),
 					)
-					expHash := crypto.Keccak256Hash(common.Hex2Bytes(acct.Code))
+					expHash := crypto.Keccak256Hash(common.FromHex(acct.Code))
 					if acct.Code == "" {
 						expHash = common.BytesToHash(types.EmptyCodeHash)
 					}
@@ -158,7 +176,7 @@ func (s *GenesisTestSuite) TestInitGenesis() {
 						s.network.App.GetEVMKeeper().GetCodeHash(ctx, common.HexToAddress(acct.Address)).String(),
 					)
 					s.Require().Equal(
-						acct.Code,
+						common.Bytes2Hex(common.FromHex(acct.Code)),
 						common.Bytes2Hex(
 							s.network.App.GetEVMKeeper().GetCode(ctx, expHash),
 						),
@@ -233,4 +251,29 @@ func (s *GenesisTestSuite) TestExportGenesis() {
 
 	// Since preinstalls gets exported as normal contracts, it should be empty on export genesis
 	s.Require().Empty(genState.Preinstalls)
+
+	// the streamed genesis state matches the exported one
+	var buf bytes.Buffer
+	err = vm.ExportGenesisTo(s.network.GetContext(), s.network.App.GetEVMKeeper(), s.network.App.AppCodec(), &buf)
+	s.Require().NoError(err)
+
+	s.Require().JSONEq(string(s.network.App.AppCodec().MustMarshalJSON(genState)), buf.String())
+
+	var streamed types.GenesisState
+	s.Require().NoError(s.network.App.AppCodec().UnmarshalJSON(buf.Bytes(), &streamed))
+
+	// the exported contracts state round-trips
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	for _, acct := range streamed.Accounts {
+		addr := common.HexToAddress(acct.Address)
+		if s.network.App.GetAccountKeeper().GetAccount(ctx, addr.Bytes()) == nil {
+			acc := s.network.App.GetAccountKeeper().NewAccountWithAddress(ctx, addr.Bytes())
+			s.network.App.GetAccountKeeper().SetAccount(ctx, acc)
+		}
+	}
+	vm.InitGenesis(ctx, s.network.App.GetEVMKeeper(), s.network.App.GetAccountKeeper(), streamed)
+
+	reexported := vm.ExportGenesis(ctx, s.network.App.GetEVMKeeper())
+	s.Require().Equal(streamed.Accounts, reexported.Accounts)
 }
@@ -320,6 +320,129 @@ func (s *KeeperTestSuite) TestQueryStorage() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryContractStorage() {
+	testCases := []struct {
+		msg           string
+		getReqAndResp func() (*types.QueryContractStorageRequest, types.Storage)
+		expPass       bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryContractStorageRequest, types.Storage) {
+				req := &types.QueryContractStorageRequest{
+					Address: invalidAddress,
+				}
+				return req, nil
+			},
+			false,
+		},
+		{
+			"success - empty storage",
+			func() (*types.QueryContractStorageRequest, types.Storage) {
+				newIndex := s.Keyring.AddKey()
+				addr := s.Keyring.GetAddr(newIndex)
+
+				req := &types.QueryContractStorageRequest{
+					Address: addr.String(),
+				}
+				return req, types.Storage{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() (*types.QueryContractStorageRequest, types.Storage) {
+				newIndex := s.Keyring.AddKey()
+				addr := s.Keyring.GetAddr(newIndex)
+
+				// slots of other accounts are not returned
+				s.Network.App.GetEVMKeeper().SetState(
+					s.Network.GetContext(),
+					s.Keyring.GetAddr(0),
+					common.BytesToHash([]byte("key")),
+					[]byte("value"),
+				)
+
+				var expStorage types.Storage
+				for i := byte(1); i <= 3; i++ {
+					key := common.BytesToHash([]byte{i})
+					value := common.BytesToHash([]byte{i, i})
+
+					s.Network.App.GetEVMKeeper().SetState(
+						s.Network.GetContext(),
+						addr,
+						key,
+						value.Bytes(),
+					)
+					expStorage = append(expStorage, types.NewState(key, value))
+				}
+
+				req := &types.QueryContractStorageRequest{
+					Address: addr.String(),
+				}
+				return req, expStorage
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req, expStorage := tc.getReqAndResp()
+
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().ContractStorage(ctx, req)
+
+			if tc.expPass {
+				s.Require().NoError(err)
+				s.Require().ElementsMatch(expStorage, res.Storage)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryCodeMetadata() {
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+
+	// the default preinstalls are deployed at genesis
+	preinstall := types.DefaultPreinstalls[0]
+	codeHash := crypto.Keccak256Hash(common.FromHex(preinstall.Code))
+
+	var expMetadata []types.CodeMetadata
+	for i := 0; i < 2; i++ {
+		metadata := types.NewCodeMetadata(codeHash, s.Keyring.GetAccAddr(i), fmt.Sprintf("ipfs://metadata-%d", i))
+		s.Require().NoError(k.SetCodeMetadata(ctx, metadata))
+		expMetadata = append(expMetadata, metadata)
+	}
+
+	testCases := []struct {
+		msg         string
+		address     string
+		expMetadata []types.CodeMetadata
+		errContains string
+	}{
+		{"invalid address", invalidAddress, nil, types.ErrZeroAddress.Error()},
+		{"not a contract", s.Keyring.GetAddr(0).String(), nil, "no contract deployed"},
+		{"contract without metadata", types.DefaultPreinstalls[1].Address, nil, ""},
+		{"success", preinstall.Address, expMetadata, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := k.CodeMetadata(ctx, &types.QueryCodeMetadataRequest{Address: tc.address})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().ElementsMatch(tc.expMetadata, res.Metadata)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryCode() {
 	var (
 		req     *types.QueryCodeRequest
@@ -455,6 +578,46 @@ func (s *KeeperTestSuite) TestQueryParams() {
 	s.Require().Equal(expParams, res.Params)
 }
 
+func (s *KeeperTestSuite) TestQueryParamsAtHeight() {
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+	genesisParams := k.GetParams(ctx)
+
+	height := ctx.BlockHeight()
+	params := genesisParams
+	params.HistoryServeWindow = 1000
+	s.Require().NoError(k.SetParams(ctx.WithBlockHeight(height+10), params))
+	// setting the params in effect doesn't record a change
+	s.Require().NoError(k.SetParams(ctx.WithBlockHeight(height+15), params))
+
+	testCases := []struct {
+		name            string
+		height          int64
+		expParams       types.Params
+		expChangeHeight int64
+		errContains     string
+	}{
+		{"invalid height", 0, types.Params{}, 0, "invalid height"},
+		{"params set at genesis", height, genesisParams, 0, ""},
+		{"params changed at height", height + 10, params, height + 10, ""},
+		{"params unchanged at height", height + 15, params, height + 10, ""},
+		{"params changed below height", height + 20, params, height + 10, ""},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := k.ParamsAtHeight(ctx, &types.QueryParamsAtHeightRequest{Height: tc.height})
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expParams, res.Params)
+			s.Require().Equal(tc.expChangeHeight, res.ChangeHeight)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryValidatorAccount() {
 	testCases := []struct {
 		msg           string
@@ -967,6 +1130,109 @@ func (s *KeeperTestSuite) TestEstimateGas() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEstimateGasRevertWithLimitedFunds() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+
+	reason, err := types.RevertReasonBytes("COUNTER_TOO_LOW")
+	s.Require().NoError(err)
+	// creation code reverting with the reason appended to it
+	initCode := []byte{
+		byte(vm.PUSH1), byte(len(reason)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(reason)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}
+	initCode = append(initCode, reason...)
+
+	// the gas price caps the gas allowance of the sender below the gas cap
+	from := s.Keyring.GetAddr(0)
+	balance := s.Network.App.GetBankKeeper().SpendableCoin(ctx, from.Bytes(), types.GetEVMCoinDenom())
+	gasPrice := (*hexutil.Big)(balance.Amount.QuoRaw(1_000_000).BigInt())
+
+	args, err := json.Marshal(types.TransactionArgs{From: &from, Data: (*hexutil.Bytes)(&initCode), GasPrice: gasPrice})
+	s.Require().NoError(err)
+
+	res, err := s.Network.GetEvmClient().EstimateGas(ctx, &types.EthCallRequest{
+		Args:            args,
+		GasCap:          config.DefaultGasCap,
+		ProposerAddress: ctx.BlockHeader().ProposerAddress,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+	s.Require().Equal(reason, res.Ret)
+}
+
+func (s *KeeperTestSuite) TestEstimateGasStateOverrides() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+
+	from := s.Keyring.GetAddr(0)
+	to := tx.GenerateAddress()
+	args, err := json.Marshal(types.TransactionArgs{From: &from, To: &to})
+	s.Require().NoError(err)
+
+	// code storing a value in the first slot of the account
+	code := hexutil.Bytes{byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP)}
+	slots := map[common.Hash]common.Hash{{}: common.BytesToHash([]byte{1})}
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+		expGas    func(gas uint64) bool
+	}{
+		{
+			"no overrides, plain transfer",
+			nil,
+			true,
+			func(gas uint64) bool { return gas == ethparams.TxGas },
+		},
+		{
+			"code override, the call stores a value",
+			types.StateOverride{to: {Code: &code}},
+			true,
+			func(gas uint64) bool { return gas > ethparams.TxGas+ethparams.SstoreSetGasEIP2200 },
+		},
+		{
+			"code and state diff overrides, the slot is already set",
+			types.StateOverride{to: {Code: &code, StateDiff: &slots}},
+			true,
+			func(gas uint64) bool { return gas < ethparams.TxGas+ethparams.SstoreSetGasEIP2200 },
+		},
+		{
+			"fail - state and state diff overrides of the same account",
+			types.StateOverride{to: {State: &slots, StateDiff: &slots}},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			var overrides []byte
+			if tc.overrides != nil {
+				overrides, err = json.Marshal(tc.overrides)
+				s.Require().NoError(err)
+			}
+
+			res, err := s.Network.GetEvmClient().EstimateGas(ctx, &types.EthCallRequest{
+				Args:            args,
+				GasCap:          config.DefaultGasCap,
+				ProposerAddress: ctx.BlockHeader().ProposerAddress,
+				Overrides:       overrides,
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(tc.expGas(res.Gas), "unexpected gas %d", res.Gas)
+
+			// the overrides never reach the state
+			s.Require().Empty(s.Network.App.GetEVMKeeper().GetCode(ctx, crypto.Keccak256Hash(code)))
+		})
+	}
+}
+
 func getDefaultTraceTxRequest(unitNetwork network.Network) *types.QueryTraceTxRequest {
 	ctx := unitNetwork.GetContext()
 	chainID := unitNetwork.GetEIP155ChainID().Int64()
@@ -1778,6 +2044,175 @@ func (s *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEthCallBatch() {
+	s.SetupTest()
+
+	erc20Contract, err := testdata.LoadERC20Contract()
+	s.Require().NoError(err)
+
+	sender := s.Keyring.GetAddr(0)
+	supply := sdkmath.NewIntWithDecimal(1000, 18).BigInt()
+	ctorArgs, err := erc20Contract.ABI.Pack("", sender, supply)
+	s.Require().NoError(err)
+	data := erc20Contract.Bin
+	data = append(data, ctorArgs...)
+
+	deployArgs, err := json.Marshal(&types.TransactionArgs{
+		From: &sender,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	s.Require().NoError(err)
+
+	recipient := tx.GenerateAddress()
+	transferArgs, err := json.Marshal(&types.TransactionArgs{
+		From:  &sender,
+		To:    &recipient,
+		Value: (*hexutil.Big)(big.NewInt(1)),
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		args     [][]byte
+		expPass  bool
+		expError []bool
+	}{
+		{
+			"empty batch",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"calls executed against the same state",
+			[][]byte{deployArgs, transferArgs, deployArgs},
+			true,
+			[]bool{false, false, false},
+		},
+		{
+			"invalid args reported by the call result",
+			[][]byte{[]byte("invalid args"), deployArgs},
+			true,
+			[]bool{true, false},
+		},
+		{
+			"batch exceeding the size limit",
+			make([][]byte, 101),
+			false,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := &types.EthCallBatchRequest{Args: tc.args, GasCap: config.DefaultGasCap}
+			res, err := s.Network.GetEvmClient().EthCallBatch(s.Network.GetContext(), req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Len(res.Results, len(tc.args))
+			for i, result := range res.Results {
+				if tc.expError[i] {
+					s.Require().NotEmpty(result.Error)
+					s.Require().Nil(result.Response)
+					continue
+				}
+
+				s.Require().Empty(result.Error)
+				s.Require().NotNil(result.Response)
+				s.Require().False(result.Response.Failed(), result.Response.VmError)
+			}
+		})
+	}
+
+	// the calls aren't committed, so each deployment returns the same code
+	res, err := s.Network.GetEvmClient().EthCallBatch(s.Network.GetContext(), &types.EthCallBatchRequest{
+		Args:   [][]byte{deployArgs, deployArgs},
+		GasCap: config.DefaultGasCap,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Results[0].Response.Ret, res.Results[1].Response.Ret)
+	s.Require().Equal(res.Results[0].Response.GasUsed, res.Results[1].Response.GasUsed)
+}
+
+func (s *KeeperTestSuite) TestEthCallPendingTxs() {
+	s.SetupTest()
+
+	sender := s.Keyring.GetKey(0)
+	recipient := tx.GenerateAddress()
+	amount := big.NewInt(1000)
+
+	// the recipient has no funds, so it can only send back the amount it
+	// receives from a pending transaction
+	args, err := json.Marshal(&types.TransactionArgs{
+		From:  &recipient,
+		To:    &sender.Addr,
+		Value: (*hexutil.Big)(amount),
+	})
+	s.Require().NoError(err)
+
+	nonce := s.Network.App.GetEVMKeeper().GetNonce(s.Network.GetContext(), sender.Addr)
+	pendingTransfer := func(nonce uint64) []byte {
+		msg, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, types.EvmTxArgs{
+			To:       &recipient,
+			Amount:   amount,
+			Nonce:    nonce,
+			GasLimit: ethparams.TxGas,
+		})
+		s.Require().NoError(err)
+		bz, err := msg.AsTransaction().MarshalBinary()
+		s.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name       string
+		pendingTxs func() [][]byte
+		expPass    bool
+	}{
+		{
+			"no pending txs, the recipient has no funds",
+			func() [][]byte { return nil },
+			false,
+		},
+		{
+			"pending transfer funding the recipient",
+			func() [][]byte { return [][]byte{pendingTransfer(nonce)} },
+			true,
+		},
+		{
+			"pending transfer with a future nonce is skipped",
+			func() [][]byte { return [][]byte{pendingTransfer(nonce + 1)} },
+			false,
+		},
+		{
+			"fail - invalid pending tx encoding",
+			func() [][]byte { return [][]byte{[]byte("invalid tx")} },
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.Network.GetEvmClient().EthCall(s.Network.GetContext(), &types.EthCallRequest{
+				Args:       args,
+				GasCap:     config.DefaultGasCap,
+				PendingTxs: tc.pendingTxs(),
+			})
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().False(res.Failed(), res.VmError)
+
+			// the pending txs never reach the state
+			s.Require().Equal(uint64(0), s.Network.App.GetEVMKeeper().GetAccountOrEmpty(s.Network.GetContext(), recipient).Balance.Uint64())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestBalance() {
 	testCases := []struct {
 		name        string
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -146,3 +147,127 @@ func (s *KeeperTestSuite) TestPostTxProcessingFailureLogReversion() {
 	s.Require().Equal(originalLogSize, finalLogSize,
 		"LogSizeTransient should not be updated when PostTxProcessing fails")
 }
+
+// OrderRecordHook records its name in the shared order when run
+type OrderRecordHook struct {
+	Name  string
+	Order *[]string
+}
+
+func (dh *OrderRecordHook) PostTxProcessing(_ sdk.Context, _ common.Address, _ core.Message, _ *ethtypes.Receipt) error {
+	*dh.Order = append(*dh.Order, dh.Name)
+	return nil
+}
+
+// MintFailureHook mints coins to the sender, alters the receipt logs and fails
+type MintFailureHook struct {
+	BankKeeper interface {
+		MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	}
+}
+
+func (dh *MintFailureHook) PostTxProcessing(ctx sdk.Context, _ common.Address, _ core.Message, receipt *ethtypes.Receipt) error {
+	coins := sdk.NewCoins(sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewInt(1)))
+	if err := dh.BankKeeper.MintCoins(ctx, "mint", coins); err != nil {
+		return err
+	}
+	receipt.Logs = nil
+	return errors.New("post tx processing failed")
+}
+
+func (s *KeeperTestSuite) TestRegisterHooks() {
+	var order []string
+	recordHook := func(name string) types.EvmHooks {
+		return &OrderRecordHook{Name: name, Order: &order}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func(k *keeper.Keeper)
+		expOrder []string
+		expPass  bool
+	}{
+		{
+			"hooks run by priority then name",
+			func(k *keeper.Keeper) {
+				k.RegisterHooks("b", 1, types.HookFailClosed, recordHook("b"))
+				k.RegisterHooks("c", -1, types.HookFailOpen, recordHook("c"))
+				k.RegisterHooks("a", 1, types.HookFailClosed, recordHook("a"))
+				k.SetHooks(recordHook(keeper.DefaultHooksName))
+			},
+			[]string{"c", keeper.DefaultHooksName, "a", "b"},
+			true,
+		},
+		{
+			"fail-open hook failure is ignored",
+			func(k *keeper.Keeper) {
+				k.RegisterHooks("a", 0, types.HookFailClosed, recordHook("a"))
+				k.RegisterHooks("b", 1, types.HookFailOpen, &FailureHook{})
+				k.RegisterHooks("c", 2, types.HookFailClosed, recordHook("c"))
+			},
+			[]string{"a", "c"},
+			true,
+		},
+		{
+			"fail-closed hook failure stops the processing",
+			func(k *keeper.Keeper) {
+				k.RegisterHooks("a", 0, types.HookFailClosed, recordHook("a"))
+				k.RegisterHooks("b", 1, types.HookFailClosed, &FailureHook{})
+				k.RegisterHooks("c", 2, types.HookFailClosed, recordHook("c"))
+			},
+			[]string{"a"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest()
+			order = nil
+
+			k := s.Network.App.GetEVMKeeper()
+			tc.malleate(k)
+
+			receipt := &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(1))}
+			err := k.PostTxProcessing(s.Network.GetContext(), s.Keyring.GetAddr(0), core.Message{}, receipt)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().ErrorContains(err, "EVM hook b failed")
+			}
+			s.Require().Equal(tc.expOrder, order)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRegisterHooksFailOpenReversion() {
+	s.SetupTest()
+
+	k := s.Network.App.GetEVMKeeper()
+	k.RegisterHooks("mint", 0, types.HookFailOpen, &MintFailureHook{BankKeeper: s.Network.App.GetBankKeeper()})
+
+	ctx := s.Network.GetContext()
+	supply := s.Network.App.GetBankKeeper().GetSupply(ctx, types.GetEVMCoinDenom())
+	logs := []*ethtypes.Log{{Address: s.Keyring.GetAddr(0)}}
+	receipt := &ethtypes.Receipt{TxHash: common.BigToHash(big.NewInt(1)), Logs: logs}
+
+	err := k.PostTxProcessing(ctx, s.Keyring.GetAddr(0), core.Message{}, receipt)
+	s.Require().NoError(err)
+	s.Require().Equal(logs, receipt.Logs, "receipt logs changes should be discarded")
+	s.Require().Equal(supply, s.Network.App.GetBankKeeper().GetSupply(ctx, types.GetEVMCoinDenom()), "state changes should be discarded")
+}
+
+func (s *KeeperTestSuite) TestRegisterHooksPanics() {
+	s.SetupTest()
+
+	k := s.Network.App.GetEVMKeeper()
+	k.SetHooks(&LogRecordHook{})
+	k.RegisterHooks("a", 0, types.HookFailOpen, &LogRecordHook{})
+
+	s.Require().PanicsWithValue("cannot set evm hooks twice", func() { k.SetHooks(&LogRecordHook{}) })
+	s.Require().PanicsWithValue("evm hooks a already registered", func() {
+		k.RegisterHooks("a", 1, types.HookFailClosed, &LogRecordHook{})
+	})
+	s.Require().Panics(func() { k.RegisterHooks("", 0, types.HookFailClosed, &LogRecordHook{}) })
+	s.Require().Panics(func() { k.RegisterHooks("b", 0, types.HookFailurePolicy(2), &LogRecordHook{}) })
+}
@@ -3,9 +3,17 @@ package vm
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/cosmos/evm/testutil/integration/evm/utils"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -73,6 +81,200 @@ func (s *KeeperTestSuite) TestEthereumTx() {
 	s.EnableFeemarket = false
 }
 
+func (s *KeeperTestSuite) TestEthereumTxRevertReason() {
+	s.SetupTest()
+
+	reason, err := types.RevertReasonBytes("COUNTER_TOO_LOW")
+	s.Require().NoError(err)
+	// creation code reverting with the reason appended to it
+	initCode := []byte{
+		byte(vm.PUSH1), byte(len(reason)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(reason)), byte(vm.PUSH1), 0, byte(vm.REVERT),
+	}
+	initCode = append(initCode, reason...)
+
+	tx, err := s.Factory.GenerateSignedEthTx(s.Keyring.GetPrivKey(0), types.EvmTxArgs{Input: initCode, GasLimit: 100_000})
+	s.Require().NoError(err)
+
+	ctx := s.Network.GetContext().WithEventManager(sdktypes.NewEventManager())
+	res, err := s.Network.App.GetEVMKeeper().EthereumTx(ctx, tx.GetMsgs()[0].(*types.MsgEthereumTx))
+	s.Require().NoError(err)
+	s.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+	s.Require().Equal(reason, res.Ret)
+
+	var failure string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEthereumTx {
+			continue
+		}
+		if attr, ok := event.GetAttribute(types.AttributeKeyEthereumTxFailed); ok {
+			failure = attr.Value
+		}
+	}
+	s.Require().Equal("execution reverted: COUNTER_TOO_LOW", failure)
+}
+
+func (s *KeeperTestSuite) TestEthereumTxBatch() {
+	// creation code reverting without any reason
+	revertingInitCode := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT)}
+	transfer := func(nonce uint64) types.EvmTxArgs {
+		recipient := s.Keyring.GetAddr(1)
+		return types.EvmTxArgs{Nonce: nonce, To: &recipient, Amount: big.NewInt(1e18), GasLimit: 21_000, GasPrice: big.NewInt(1e9)}
+	}
+	revertingCreation := func(nonce uint64) types.EvmTxArgs {
+		return types.EvmTxArgs{Nonce: nonce, Input: revertingInitCode, GasLimit: 100_000, GasPrice: big.NewInt(1e9)}
+	}
+
+	testCases := []struct {
+		name       string
+		firstTx    func(nonce uint64) types.EvmTxArgs
+		secondTx   func(nonce uint64) types.EvmTxArgs
+		expPass    bool
+		expBalance *big.Int
+		// expGasUsed is the gas charged for each tx of the batch
+		expGasUsed []bool
+	}{
+		{
+			name:       "success - both transfers of the batch are applied",
+			firstTx:    transfer,
+			secondTx:   transfer,
+			expPass:    true,
+			expBalance: big.NewInt(2e18),
+			expGasUsed: []bool{true, true},
+		},
+		{
+			name:       "fail - a reverted tx reverts the transfer executed before it",
+			firstTx:    transfer,
+			secondTx:   revertingCreation,
+			expPass:    false,
+			expBalance: big.NewInt(0),
+			expGasUsed: []bool{true, true},
+		},
+		{
+			name:       "fail - the tx following a reverted tx is not executed",
+			firstTx:    revertingCreation,
+			secondTx:   transfer,
+			expPass:    false,
+			expBalance: big.NewInt(0),
+			expGasUsed: []bool{true, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.Keyring.GetKey(0)
+			recipient := s.Keyring.GetKey(1)
+			denom := types.GetEVMCoinDenom()
+
+			balanceBefore, err := s.Handler.GetBalanceFromBank(recipient.AccAddr, denom)
+			s.Require().NoError(err)
+			senderBalanceBefore, err := s.Handler.GetBalanceFromBank(sender.AccAddr, denom)
+			s.Require().NoError(err)
+			accountBefore, err := s.Handler.GetEvmAccount(sender.Addr)
+			s.Require().NoError(err)
+			nonce := accountBefore.Nonce
+
+			// the nonces of the batched txs are sequential
+			msg1, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, tc.firstTx(nonce))
+			s.Require().NoError(err)
+			msg2, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, tc.secondTx(nonce+1))
+			s.Require().NoError(err)
+
+			msgs := []*types.MsgEthereumTx{&msg1, &msg2}
+			tx, err := utiltx.PrepareEthTx(s.Network.GetEncodingConfig().TxConfig, nil, &msg1, &msg2)
+			s.Require().NoError(err)
+			txBytes, err := s.Network.GetEncodingConfig().TxConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			// a reverted batch is recorded like failed Ethereum txs, so the
+			// Cosmos tx succeeds
+			res, err := s.Network.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResults, 1)
+			s.Require().True(res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+			// each tx of the batch has a receipt, failed if the batch is reverted
+			responses, err := types.DecodeTxResponses(res.TxResults[0].Data)
+			s.Require().NoError(err)
+			s.Require().Len(responses, 2)
+			gasUsed := uint64(0)
+			for i, response := range responses {
+				s.Require().Equal(msgs[i].Hash().Hex(), response.Hash)
+				s.Require().Equal(!tc.expPass, response.Failed(), response.VmError)
+				s.Require().Equal(tc.expGasUsed[i], response.GasUsed > 0)
+				gasUsed += response.GasUsed
+			}
+			if !tc.expPass {
+				s.Require().Empty(responses[0].Logs)
+				s.Require().Empty(responses[1].Logs)
+			}
+
+			// the events of the messages, not of the ante handler, carry the gas used
+			var txIndexes []string
+			for _, event := range res.TxResults[0].Events {
+				if event.Type != types.EventTypeEthereumTx {
+					continue
+				}
+				var txIndex, failure string
+				hasGasUsed, failed := false, false
+				for _, attr := range event.Attributes {
+					switch attr.Key {
+					case types.AttributeKeyTxIndex:
+						txIndex = attr.Value
+					case types.AttributeKeyTxGasUsed:
+						hasGasUsed = true
+					case types.AttributeKeyEthereumTxFailed:
+						failure, failed = attr.Value, true
+					}
+				}
+				if !hasGasUsed {
+					continue
+				}
+				txIndexes = append(txIndexes, txIndex)
+				s.Require().Equal(!tc.expPass, failed)
+				if failed && failure != vm.ErrExecutionReverted.Error() {
+					s.Require().Contains(failure, types.ErrBatchTxFailed.Error())
+				}
+			}
+			s.Require().Equal([]string{"0", "1"}, txIndexes)
+
+			// the state changes of the batch are applied all together or not at all
+			balanceAfter, err := s.Handler.GetBalanceFromBank(recipient.AccAddr, denom)
+			s.Require().NoError(err)
+			s.Require().Equal(
+				balanceBefore.Balance.Amount.Add(sdkmath.NewIntFromBigInt(tc.expBalance)),
+				balanceAfter.Balance.Amount,
+			)
+
+			// the sender is only charged the gas used by the txs executed,
+			// the gas left being refunded even if the batch is reverted
+			gasCost := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), big.NewInt(1e9))
+			senderBalanceAfter, err := s.Handler.GetBalanceFromBank(sender.AccAddr, denom)
+			s.Require().NoError(err)
+			s.Require().Equal(
+				senderBalanceBefore.Balance.Amount.Sub(sdkmath.NewIntFromBigInt(tc.expBalance)).Sub(sdkmath.NewIntFromBigInt(gasCost)),
+				senderBalanceAfter.Balance.Amount,
+			)
+
+			// the nonces of all the batched txs are consumed by the ante handler,
+			// as for failed Ethereum txs, so a reverted batch cannot be replayed
+			accountAfter, err := s.Handler.GetEvmAccount(sender.Addr)
+			s.Require().NoError(err)
+			s.Require().Equal(nonce+2, accountAfter.Nonce)
+
+			// no contract is left at the address of the reverted creation
+			if !tc.expPass {
+				for _, contractNonce := range []uint64{nonce, nonce + 1} {
+					contract := crypto.CreateAddress(sender.Addr, contractNonce)
+					s.Require().Nil(s.Network.App.GetEVMKeeper().GetAccount(s.Network.GetContext(), contract))
+				}
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	s.SetupTest()
 	testCases := []struct {
@@ -116,6 +318,49 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUpdateParamsAuthorities() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	authority := s.Keyring.GetAccAddr(0).String()
+	authorities := []cosmosevmtypes.ParamsAuthority{{Authority: authority, Params: []string{"history_serve_window"}}}
+
+	// only governance can set the params authorities
+	_, err := k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{Authority: authority, ParamsAuthorities: authorities})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{
+		Authority:         govAuthority,
+		ParamsAuthorities: []cosmosevmtypes.ParamsAuthority{{Authority: authority, Params: []string{"unknown"}}},
+	})
+	s.Require().ErrorContains(err, "unknown param")
+
+	_, err = k.UpdateParamsAuthorities(ctx, &types.MsgUpdateParamsAuthorities{Authority: govAuthority, ParamsAuthorities: authorities})
+	s.Require().NoError(err)
+
+	res, err := k.ParamsAuthorities(ctx, &types.QueryParamsAuthoritiesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(authorities, res.ParamsAuthorities)
+
+	// the params authority only updates the history serve window
+	params := k.GetParams(ctx)
+	updated := params
+	updated.HistoryServeWindow = params.HistoryServeWindow + 1
+	updated.EvmDenom = "other"
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: updated})
+	s.Require().NoError(err)
+
+	expected := params
+	expected.HistoryServeWindow = params.HistoryServeWindow + 1
+	s.Require().Equal(expected, k.GetParams(ctx))
+
+	// other accounts can't update the params
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.Keyring.GetAccAddr(1).String(), Params: updated})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+}
+
 func (s *KeeperTestSuite) TestRegisterPreinstalls() {
 	s.SetupTest()
 	testCases := []struct {
@@ -172,3 +417,42 @@ func (s *KeeperTestSuite) TestRegisterPreinstalls() {
 		s.Require().NoError(err)
 	}
 }
+
+func (s *KeeperTestSuite) TestRegisterCodeMetadata() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+
+	// the default preinstalls are deployed at genesis
+	preinstall := types.DefaultPreinstalls[0]
+	codeHash := crypto.Keccak256Hash(common.FromHex(preinstall.Code))
+	sender := s.Keyring.GetAccAddr(0)
+
+	// metadata can't be published for a code that isn't stored
+	_, err := k.RegisterCodeMetadata(ctx, &types.MsgRegisterCodeMetadata{
+		Sender:      sender.String(),
+		CodeHash:    common.HexToHash("0x01").Hex(),
+		MetadataUri: "ipfs://metadata",
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidCodeMetadata)
+
+	_, err = k.RegisterCodeMetadata(ctx, &types.MsgRegisterCodeMetadata{
+		Sender:      sender.String(),
+		CodeHash:    codeHash.Hex(),
+		MetadataUri: "ipfs://metadata",
+	})
+	s.Require().NoError(err)
+
+	// publishing again replaces the metadata of the sender
+	_, err = k.RegisterCodeMetadata(ctx, &types.MsgRegisterCodeMetadata{
+		Sender:      sender.String(),
+		CodeHash:    codeHash.Hex(),
+		MetadataUri: "ipfs://updated",
+	})
+	s.Require().NoError(err)
+
+	metadata, found := k.GetCodeMetadata(ctx, codeHash, sender)
+	s.Require().True(found)
+	s.Require().Equal(types.NewCodeMetadata(codeHash, sender, "ipfs://updated"), metadata)
+	s.Require().Len(k.GetAllCodeMetadata(ctx), 1)
+}
@@ -0,0 +1,220 @@
+package vm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmante "github.com/cosmos/evm/ante/evm"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testkeyring "github.com/cosmos/evm/testutil/keyring"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	"github.com/cosmos/evm/x/vm/parallel"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// parallelTxResult is the result of the execution of an Ethereum transaction
+// by the parallel executor.
+type parallelTxResult struct {
+	Res *types.MsgEthereumTxResponse
+	Err string
+}
+
+// TestParallelExecution checks that executing Ethereum transactions with the
+// parallel executor produces the same state and results as the serial
+// execution, for blocks with different rates of conflicts.
+func TestParallelExecution(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	const senders = 8
+	keyring := testkeyring.New(2 * senders)
+
+	// the transactions don't pay fees, so that the fee collector balance
+	// doesn't make all of them conflicting
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	feemarketGenesis.Params.MinGasPrice = sdkmath.LegacyZeroDec()
+
+	opts := []network.ConfigOption{
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+		network.WithCustomGenesis(network.CustomGenesisState{
+			feemarkettypes.ModuleName: feemarketGenesis,
+		}),
+	}
+	opts = append(opts, options...)
+	nw := network.NewUnitTestNetwork(create, opts...)
+
+	// the module account is created by the first balance change of a new chain,
+	// which would make the transactions of the first block conflicting
+	nw.App.GetAccountKeeper().GetModuleAccount(nw.GetContext(), types.ModuleName)
+
+	cms, ok := nw.App.GetBaseApp().CommitMultiStore().(*rootmulti.Store)
+	require.True(t, ok)
+	storesByName := cms.StoreKeysByName()
+	keys := make([]storetypes.StoreKey, 0, len(storesByName))
+	for _, key := range storesByName {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	// every transaction reads and increases the index of the next transaction
+	// and log of the block
+	transientKey := storesByName[types.TransientKey]
+	counters := []parallel.Counter{
+		{Key: transientKey, Path: types.KeyPrefixTransientTxIndex},
+		{Key: transientKey, Path: types.KeyPrefixTransientLogSize},
+	}
+
+	signer := ethtypes.LatestSignerForChainID(types.GetEthChainConfig().ChainID)
+	signTx := func(index int, txData ethtypes.TxData) *ethtypes.Transaction {
+		privKey, err := keyring.GetPrivKey(index).(*ethsecp256k1.PrivKey).ToECDSA()
+		require.NoError(t, err)
+		tx, err := ethtypes.SignNewTx(privKey, signer, txData)
+		require.NoError(t, err)
+		return tx
+	}
+	transfer := func(from, to int, nonce uint64) *ethtypes.Transaction {
+		recipient := keyring.GetAddr(to)
+		return signTx(from, &ethtypes.LegacyTx{Nonce: nonce, To: &recipient, Value: big.NewInt(1000), Gas: 21000, GasPrice: big.NewInt(0)})
+	}
+	// the deployed contracts store a value and emit a log from their init code
+	deploy := func(from int, nonce uint64) *ethtypes.Transaction {
+		initCode := common.FromHex("0x602a60005560006000a000")
+		return signTx(from, &ethtypes.LegacyTx{Nonce: nonce, Data: initCode, Gas: 100000, GasPrice: big.NewInt(0)})
+	}
+
+	testCases := []struct {
+		name    string
+		txs     func() []*ethtypes.Transaction
+		expReex func(n int) int
+	}{
+		{
+			"independent transfers",
+			func() []*ethtypes.Transaction {
+				txs := make([]*ethtypes.Transaction, senders)
+				for i := range txs {
+					txs[i] = transfer(i, senders+i, 0)
+				}
+				return txs
+			},
+			func(int) int { return 0 },
+		},
+		{
+			"transfers to the same recipient",
+			func() []*ethtypes.Transaction {
+				txs := make([]*ethtypes.Transaction, senders)
+				for i := range txs {
+					txs[i] = transfer(i, senders, 0)
+				}
+				return txs
+			},
+			func(n int) int { return n - 1 },
+		},
+		{
+			"transfers from the same sender",
+			func() []*ethtypes.Transaction {
+				txs := make([]*ethtypes.Transaction, senders)
+				for i := range txs {
+					txs[i] = transfer(0, senders+i, uint64(i)) //nolint:gosec // G115 // small test indexes
+				}
+				return txs
+			},
+			func(n int) int { return n - 1 },
+		},
+		{
+			"mixed transfers and contract creations",
+			func() []*ethtypes.Transaction {
+				var txs []*ethtypes.Transaction
+				for i := 0; i < senders; i++ {
+					txs = append(txs, transfer(i, senders+i, 0), deploy(i, 1))
+				}
+				return txs
+			},
+			// the contract creations read the account updated by the
+			// transfer of their sender
+			func(n int) int { return n / 2 },
+		},
+	}
+
+	for _, tc := range testCases {
+		txs := tc.txs()
+		run := func(ctx sdk.Context, i int) parallelTxResult {
+			return applyEthereumTx(nw, ctx, txs[i])
+		}
+
+		serialCtx, _ := nw.GetContext().CacheContext()
+		expResults := make([]parallelTxResult, len(txs))
+		for i := range txs {
+			expResults[i] = run(serialCtx.WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter()), i)
+		}
+		expHashes := storeHashes(serialCtx, keys)
+
+		for _, workers := range []int{1, 4, 16} {
+			t.Run(fmt.Sprintf("%s/%d workers", tc.name, workers), func(t *testing.T) {
+				ctx, _ := nw.GetContext().CacheContext()
+				executor := parallel.NewExecutor[parallelTxResult](keys, workers).WithCounters(counters...)
+				results, stats := executor.Execute(ctx, len(txs), run)
+
+				require.Equal(t, expResults, results)
+				require.Equal(t, expHashes, storeHashes(ctx, keys))
+				require.Equal(t, tc.expReex(len(txs)), stats.Reexecuted)
+			})
+		}
+	}
+}
+
+// applyEthereumTx executes the transaction of the block, with the steps of the
+// ante handler updating the state of the block.
+func applyEthereumTx(nw *network.UnitTestNetwork, ctx sdk.Context, tx *ethtypes.Transaction) parallelTxResult {
+	k := nw.App.GetEVMKeeper()
+	accountKeeper := nw.App.GetAccountKeeper()
+
+	k.ResetTransientGasUsed(ctx)
+
+	// the nonce of contract creations is set by the EVM
+	if tx.To() != nil {
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return parallelTxResult{Err: err.Error()}
+		}
+		account := accountKeeper.GetAccount(ctx, from.Bytes())
+		if err := evmante.IncrementNonce(ctx, accountKeeper, account, tx.Nonce()); err != nil {
+			return parallelTxResult{Err: err.Error()}
+		}
+	}
+
+	res, err := k.ApplyTransaction(ctx.WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(tx.Gas())), tx)
+	if err != nil {
+		return parallelTxResult{Err: err.Error()}
+	}
+	return parallelTxResult{Res: res}
+}
+
+// storeHashes returns the hash of the content of each store, standing for the
+// store roots which aren't computed by the branched stores.
+func storeHashes(ctx sdk.Context, keys []storetypes.StoreKey) map[string]string {
+	hashes := make(map[string]string, len(keys))
+	for _, key := range keys {
+		h := sha256.New()
+		it := ctx.MultiStore().GetKVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			h.Write(it.Key())
+			h.Write(it.Value())
+		}
+		it.Close()
+		hashes[key.Name()] = fmt.Sprintf("%X", h.Sum(nil))
+	}
+	return hashes
+}
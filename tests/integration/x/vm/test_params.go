@@ -1,6 +1,8 @@
 package vm
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/testutil/config"
 	"github.com/cosmos/evm/x/vm/types"
 )
@@ -95,3 +97,31 @@ func (s *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestToggleStaticPrecompiles() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+
+	bech32Precompile := common.HexToAddress(types.Bech32PrecompileAddress)
+	s.Require().True(k.IsAvailableStaticPrecompile(bech32Precompile))
+
+	// disabled precompiles remain available and can be enabled again
+	s.Require().NoError(k.DisableStaticPrecompiles(ctx, bech32Precompile))
+	params := k.GetParams(ctx)
+	s.Require().NotContains(params.ActiveStaticPrecompiles, bech32Precompile.Hex())
+	s.Require().False(k.IsActiveStaticPrecompile(&params, bech32Precompile))
+
+	err := k.DisableStaticPrecompiles(ctx, bech32Precompile)
+	s.Require().ErrorIs(err, types.ErrInactivePrecompile)
+
+	s.Require().NoError(k.EnableStaticPrecompiles(ctx, bech32Precompile))
+	params = k.GetParams(ctx)
+	s.Require().True(k.IsActiveStaticPrecompile(&params, bech32Precompile))
+
+	// precompiles not compiled in the binary can't be activated
+	unavailable := common.HexToAddress("0x0000000000000000000000000000000000000fff")
+	s.Require().False(k.IsAvailableStaticPrecompile(unavailable))
+	err = k.EnableStaticPrecompiles(ctx, unavailable)
+	s.Require().ErrorIs(err, types.ErrUnavailablePrecompile)
+}
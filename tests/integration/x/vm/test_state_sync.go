@@ -0,0 +1,86 @@
+package vm
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/vm"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestStateSyncSnapshot verifies that the contracts code and storage are part
+// of the state sync snapshots, as the EVM state is held by the evm module store
+// of the multistore, so that the nodes bootstrapped with state sync serve them.
+func (s *GenesisTestSuite) TestStateSyncSnapshot() {
+	withSnapshots := func(create network.CreateEvmApp) network.CreateEvmApp {
+		return func(chainID string, evmChainID uint64, customBaseAppOptions ...func(*baseapp.BaseApp)) evm.EvmApp {
+			snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), s.T().TempDir())
+			s.Require().NoError(err)
+
+			opts := append(customBaseAppOptions, baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0)))
+			return create(chainID, evmChainID, opts...)
+		}
+	}
+
+	create := s.create
+	s.create = withSnapshots(create)
+	defer func() { s.create = create }()
+	s.SetupTest()
+
+	contractAddr, err := s.factory.DeployContract(
+		s.keyring.GetPrivKey(0),
+		types.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"TestToken", "TTK", uint8(18)},
+		},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	source := s.network.App
+	height := source.LastBlockHeight()
+	snapshot, err := source.GetBaseApp().SnapshotManager().Create(uint64(height)) //#nosec G115 -- height is positive
+	s.Require().NoError(err)
+
+	abciSnapshot, err := snapshot.ToABCI()
+	s.Require().NoError(err)
+
+	// restore the snapshot on a new node, as CometBFT does during state sync
+	target := withSnapshots(create)(s.network.GetChainID(), s.network.GetEIP155ChainID().Uint64())
+	offerRes, err := target.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &abciSnapshot, AppHash: source.LastCommitID().Hash})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseOfferSnapshot_ACCEPT, offerRes.Result)
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunkRes, err := source.LoadSnapshotChunk(&abci.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: i})
+		s.Require().NoError(err)
+
+		applyRes, err := target.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{Index: i, Chunk: chunkRes.Chunk})
+		s.Require().NoError(err)
+		s.Require().Equal(abci.ResponseApplySnapshotChunk_ACCEPT, applyRes.Result)
+	}
+	s.Require().Equal(source.LastCommitID(), target.LastCommitID())
+
+	sourceCtx := s.network.GetContext()
+	targetCtx := sdk.NewContext(target.GetBaseApp().CommitMultiStore().CacheMultiStore(), cmtproto.Header{Height: height}, false, log.NewNopLogger())
+
+	codeHash := target.GetEVMKeeper().GetCodeHash(targetCtx, contractAddr)
+	s.Require().Equal(source.GetEVMKeeper().GetCodeHash(sourceCtx, contractAddr), codeHash)
+	s.Require().NotEmpty(target.GetEVMKeeper().GetCode(targetCtx, codeHash))
+	s.Require().NotEmpty(target.GetEVMKeeper().GetAccountStorage(targetCtx, contractAddr))
+
+	s.Require().Equal(vm.ExportGenesis(sourceCtx, source.GetEVMKeeper()), vm.ExportGenesis(targetCtx, target.GetEVMKeeper()))
+}
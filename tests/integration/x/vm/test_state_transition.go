@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -254,6 +255,17 @@ func (s *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			true,
 			params.TxGas + params.TxAccessListAddressGas + params.TxAccessListStorageKeyGas*1,
 		},
+		{
+			"no data, one accesslist with one storageKey, not contract creation, not berlin",
+			nil,
+			[]gethtypes.AccessTuple{
+				{StorageKeys: make([]common.Hash, 1)},
+			},
+			0,
+			false,
+			true,
+			params.TxGas,
+		},
 		{
 			"no data, no accesslist, is contract creation, is homestead, not istanbul, not shanghai",
 			nil,
@@ -279,6 +291,7 @@ func (s *KeeperTestSuite) TestGetEthIntrinsicGas() {
 			ethCfg := types.GetEthChainConfig()
 			ethCfg.HomesteadBlock = big.NewInt(2)
 			ethCfg.IstanbulBlock = big.NewInt(3)
+			ethCfg.BerlinBlock = big.NewInt(1)
 			signer := gethtypes.LatestSignerForChainID(ethCfg.ChainID)
 
 			// in the future, fork not enabled
@@ -622,6 +635,11 @@ func (s *KeeperTestSuite) TestApplyTransaction() {
 			balanceAfterRefund := s.Network.App.GetBankKeeper().GetBalance(ctx, s.Keyring.GetAccAddr(0), "aatom")
 			expectedRefund := new(big.Int).Mul(new(big.Int).SetUint64(6e6/2), s.Network.App.GetEVMKeeper().GetBaseFee(ctx))
 			s.Require().Equal(balanceAfterRefund.Sub(initialBalance).Amount, sdkmath.NewIntFromBigInt(expectedRefund))
+
+			// the base fee portion of the fees paid for the gas used is recorded for the fee market
+			expectedBaseFees := new(big.Int).Mul(new(big.Int).SetUint64(res.GasUsed), s.Network.App.GetEVMKeeper().GetBaseFee(ctx))
+			baseFees := s.Network.App.GetFeeMarketKeeper().GetTransientBaseFees(ctx)
+			s.Require().Equal(sdkmath.NewIntFromBigInt(expectedBaseFees), baseFees.AmountOf(types.GetEVMCoinExtendedDenom()))
 		})
 	}
 }
@@ -825,6 +843,77 @@ func (s *KeeperTestSuite) TestApplyMessage() {
 	s.Require().Equal(expectedGasUsed, res.GasUsed)
 }
 
+func (s *KeeperTestSuite) TestApplyMessageWithAccessList() {
+	s.SetupTest()
+
+	ctx := s.Network.GetContext()
+	k := s.Network.App.GetEVMKeeper()
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress)
+	s.Require().NoError(err)
+	s.Require().NotEqual(common.Address{}, cfg.CoinBase)
+
+	coldAddr := utiltx.GenerateAddress()
+	slot := common.HexToHash("0x01")
+
+	// balanceInitCode returns a contract creation code reading the balance of
+	// the given address, whose gas cost depends on the address being warm.
+	balanceInitCode := func(addr common.Address) []byte {
+		code := append([]byte{byte(vm.PUSH20)}, addr.Bytes()...)
+		return append(code, byte(vm.BALANCE), byte(vm.POP), byte(vm.STOP))
+	}
+
+	testCases := []struct {
+		name       string
+		addr       common.Address
+		accessList gethtypes.AccessList
+		expGasDiff int64
+	}{
+		{
+			"cold address warmed by the access list",
+			coldAddr,
+			gethtypes.AccessList{{Address: coldAddr}},
+			int64(params.TxAccessListAddressGas) - int64(params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929),
+		},
+		{
+			"cold address and storage key warmed by the access list",
+			coldAddr,
+			gethtypes.AccessList{{Address: coldAddr, StorageKeys: []common.Hash{slot}}},
+			int64(params.TxAccessListAddressGas+params.TxAccessListStorageKeyGas) - int64(params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929),
+		},
+		{
+			"coinbase is already warm",
+			cfg.CoinBase,
+			gethtypes.AccessList{{Address: cfg.CoinBase}},
+			int64(params.TxAccessListAddressGas),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			applyMessage := func(accessList *gethtypes.AccessList) uint64 {
+				coreMsg, err := s.Factory.GenerateGethCoreMsg(
+					s.Keyring.GetPrivKey(0),
+					types.EvmTxArgs{
+						Input:    balanceInitCode(tc.addr),
+						GasLimit: 100_000,
+						Accesses: accessList,
+					},
+				)
+				s.Require().NoError(err)
+
+				res, err := k.ApplyMessage(ctx, *coreMsg, nil, false, true)
+				s.Require().NoError(err)
+				s.Require().False(res.Failed(), res.VmError)
+				return res.GasUsed
+			}
+
+			gasWithoutList := applyMessage(nil)
+			gasWithList := applyMessage(&tc.accessList)
+			s.Require().Equal(tc.expGasDiff, int64(gasWithList)-int64(gasWithoutList)) //#nosec G115 -- int overflow is not a concern here
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestApplyMessageWithConfig() {
 	s.EnableFeemarket = true
 	defer func() { s.EnableFeemarket = false }()
@@ -0,0 +1,83 @@
+package vm
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/tests/integration/x/vm/statetest"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+)
+
+// StateTestsDirEnv is the environment variable pointing to a directory of
+// additional state test fixtures, such as the GeneralStateTests of the
+// Ethereum tests repository or an execution-spec-tests fixtures release.
+const StateTestsDirEnv = "STATE_TESTS_DIR"
+
+// TestStateTests runs the Ethereum state test fixtures embedded in the statetest
+// package, and the ones of StateTestsDirEnv if set, through the EVM keeper.
+// Each divergence from the expected post-state is reported as a failure of the
+// subtest, and the subtests relying on unsupported features are skipped.
+func TestStateTests(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	fixtures, err := statetest.LoadFixtures(statetest.Fixtures, "testdata")
+	require.NoError(t, err)
+
+	if dir := os.Getenv(StateTestsDirEnv); dir != "" {
+		external, err := statetest.LoadFixtures(os.DirFS(dir), ".")
+		require.NoError(t, err)
+		for name, fixture := range external {
+			fixtures["external/"+name] = fixture
+		}
+	}
+
+	runner := statetest.NewRunner(create, options...)
+	defer func() {
+		require.NoError(t, runner.Close())
+	}()
+
+	names := make([]string, 0, len(fixtures))
+	for name := range fixtures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	type forkSummary struct{ passed, failed, skipped int }
+	summary := make(map[string]*forkSummary)
+
+	for _, name := range names {
+		fixture := fixtures[name]
+		for _, subtest := range fixture.Subtests(name) {
+			if summary[subtest.Fork] == nil {
+				summary[subtest.Fork] = &forkSummary{}
+			}
+			forkRes := summary[subtest.Fork]
+
+			t.Run(subtest.String(), func(t *testing.T) {
+				err := runner.Run(fixture, subtest)
+				switch {
+				case errors.Is(err, statetest.ErrUnsupported):
+					forkRes.skipped++
+					t.Skip(err)
+				case err != nil:
+					forkRes.failed++
+					t.Error(err)
+				default:
+					forkRes.passed++
+				}
+			})
+		}
+	}
+
+	forks := make([]string, 0, len(summary))
+	for fork := range summary {
+		forks = append(forks, fork)
+	}
+	sort.Strings(forks)
+	for _, fork := range forks {
+		res := summary[fork]
+		t.Logf("%s: %d passed, %d failed, %d skipped", fork, res.passed, res.failed, res.skipped)
+	}
+}
@@ -7,8 +7,11 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 )
 
-// EVMTxIndexer defines the interface of custom eth tx indexer.
+// EVMTxIndexer defines the interface of custom eth tx indexer, implemented by
+// the different indexer backends.
 type EVMTxIndexer interface {
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
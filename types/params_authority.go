@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParamsAuthorities returns the accounts allowed to update a subset of the
+// module params from the params authorities store.
+func GetParamsAuthorities(store storetypes.KVStore, cdc codec.BinaryCodec) []ParamsAuthority {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var authorities []ParamsAuthority
+	for ; iterator.Valid(); iterator.Next() {
+		var authority ParamsAuthority
+		cdc.MustUnmarshal(iterator.Value(), &authority)
+		authorities = append(authorities, authority)
+	}
+
+	return authorities
+}
+
+// GetParamsAuthority returns the params the given account is allowed to update
+// from the params authorities store, if any.
+func GetParamsAuthority(store storetypes.KVStore, cdc codec.BinaryCodec, authority string) (ParamsAuthority, bool) {
+	bz := store.Get([]byte(authority))
+	if bz == nil {
+		return ParamsAuthority{}, false
+	}
+
+	var paramsAuthority ParamsAuthority
+	cdc.MustUnmarshal(bz, &paramsAuthority)
+	return paramsAuthority, true
+}
+
+// SetParamsAuthorities replaces the accounts allowed to update a subset of the
+// given module params in the params authorities store.
+func SetParamsAuthorities(store storetypes.KVStore, cdc codec.BinaryCodec, authorities []ParamsAuthority, params proto.Message) error {
+	if err := ValidateParamsAuthorities(authorities, params); err != nil {
+		return err
+	}
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, authority := range authorities {
+		store.Set([]byte(authority.Authority), cdc.MustMarshal(&authority))
+	}
+
+	return nil
+}
+
+// ValidateParamsAuthorities returns an error if an authority is not a valid and
+// unique account address, or if the parameters it can update are not unique
+// fields of the given module params.
+func ValidateParamsAuthorities(authorities []ParamsAuthority, params proto.Message) error {
+	fields := paramsFields(params)
+	seenAuthorities := make(map[string]bool, len(authorities))
+	for _, authority := range authorities {
+		if _, err := sdk.AccAddressFromBech32(authority.Authority); err != nil {
+			return errorsmod.Wrapf(err, "invalid params authority address %s", authority.Authority)
+		}
+		if seenAuthorities[authority.Authority] {
+			return fmt.Errorf("duplicated params authority %s", authority.Authority)
+		}
+		seenAuthorities[authority.Authority] = true
+
+		if len(authority.Params) == 0 {
+			return fmt.Errorf("params authority %s has no params to update", authority.Authority)
+		}
+
+		seenParams := make(map[string]bool, len(authority.Params))
+		for _, param := range authority.Params {
+			if _, found := fields[param]; !found {
+				return fmt.Errorf("params authority %s: unknown param %s", authority.Authority, param)
+			}
+			if seenParams[param] {
+				return fmt.Errorf("params authority %s: duplicated param %s", authority.Authority, param)
+			}
+			seenParams[param] = true
+		}
+	}
+
+	return nil
+}
+
+// ApplyParams sets the given fields of the current params to their value in the
+// updated params. The other fields of the current params are left unchanged.
+func ApplyParams(current, updated proto.Message, params []string) {
+	fields := paramsFields(current)
+	currentValue := reflect.ValueOf(current).Elem()
+	updatedValue := reflect.ValueOf(updated).Elem()
+	for _, param := range params {
+		if i, found := fields[param]; found {
+			currentValue.Field(i).Set(updatedValue.Field(i))
+		}
+	}
+}
+
+// paramsFields returns the index of the struct field of each proto field of
+// the given params, by proto field name.
+func paramsFields(params proto.Message) map[string]int {
+	t := reflect.TypeOf(params).Elem()
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		for _, opt := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if name, found := strings.CutPrefix(opt, "name="); found {
+				fields[name] = i
+			}
+		}
+	}
+	return fields
+}
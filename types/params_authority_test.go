@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateParamsAuthorities(t *testing.T) {
+	authority := sdk.AccAddress([]byte("params_authority_addr")).String()
+	params := &ExtensionOptionDynamicFeeTx{}
+
+	testCases := []struct {
+		name        string
+		authorities []ParamsAuthority
+		errContains string
+	}{
+		{"no authorities", nil, ""},
+		{"valid authority", []ParamsAuthority{{Authority: authority, Params: []string{"max_priority_price"}}}, ""},
+		{"invalid address", []ParamsAuthority{{Authority: "invalid", Params: []string{"max_priority_price"}}}, "invalid params authority address"},
+		{
+			"duplicated authority",
+			[]ParamsAuthority{
+				{Authority: authority, Params: []string{"max_priority_price"}},
+				{Authority: authority, Params: []string{"max_priority_price"}},
+			},
+			"duplicated params authority",
+		},
+		{"no params", []ParamsAuthority{{Authority: authority}}, "has no params to update"},
+		{"unknown param", []ParamsAuthority{{Authority: authority, Params: []string{"unknown"}}}, "unknown param unknown"},
+		{"duplicated param", []ParamsAuthority{{Authority: authority, Params: []string{"max_priority_price", "max_priority_price"}}}, "duplicated param"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateParamsAuthorities(tc.authorities, params)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestApplyParams(t *testing.T) {
+	current := &ParamsAuthority{Authority: "current", Params: []string{"current"}}
+	updated := &ParamsAuthority{Authority: "updated", Params: []string{"updated"}}
+
+	ApplyParams(current, updated, []string{"params", "unknown"})
+	require.Equal(t, &ParamsAuthority{Authority: "current", Params: []string{"updated"}}, current)
+}
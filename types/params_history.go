@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamsHistoryRetention is the number of blocks the parameter change history
+// of a module covers. The changes superseded before it are pruned, so the
+// params of older blocks can't be queried anymore.
+const ParamsHistoryRetention int64 = 1_000_000
+
+// paramsMessage is the pointer type of the module params P.
+type paramsMessage[P any] interface {
+	*P
+	proto.Message
+}
+
+// GetParamsAtHeight returns the module params in effect at the end of the given
+// block from the parameter change history store, along with the height of the
+// change that set them. It returns false if no change is recorded at or below
+// the height.
+func GetParamsAtHeight[P any, PP paramsMessage[P]](store storetypes.KVStore, cdc codec.BinaryCodec, height int64) (params P, changeHeight int64, found bool) {
+	if height < 0 {
+		return params, 0, false
+	}
+
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return params, 0, false
+	}
+	cdc.MustUnmarshal(iterator.Value(), PP(&params))
+	return params, int64(sdk.BigEndianToUint64(iterator.Key())), true //#nosec G115 -- encoded from a non-negative int64
+}
+
+// IterateParamsHistory iterates over the changes of the parameter change
+// history store by ascending height, until the callback returns true.
+func IterateParamsHistory[P any, PP paramsMessage[P]](store storetypes.KVStore, cdc codec.BinaryCodec, cb func(height int64, params P) (stop bool)) {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var params P
+		cdc.MustUnmarshal(iterator.Value(), PP(&params))
+		if cb(int64(sdk.BigEndianToUint64(iterator.Key())), params) { //#nosec G115 -- encoded from a non-negative int64
+			break
+		}
+	}
+}
+
+// SetParamsAtHeight records the module params set at the given height in the
+// parameter change history store. The params are only recorded if they differ
+// from the ones in effect, and the changes superseded before the retention of
+// the history are pruned.
+func SetParamsAtHeight(store storetypes.KVStore, cdc codec.BinaryCodec, height int64, params proto.Message) {
+	bz := cdc.MustMarshal(params)
+
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1)) //#nosec G115 -- block height is never negative
+	unchanged := iterator.Valid() && bytes.Equal(iterator.Value(), bz)
+	iterator.Close()
+	if unchanged {
+		return
+	}
+
+	store.Set(sdk.Uint64ToBigEndian(uint64(height)), bz) //#nosec G115 -- block height is never negative
+	pruneParamsHistory(store, height-ParamsHistoryRetention)
+}
+
+// pruneParamsHistory deletes the changes superseded at or before the given
+// height, keeping the change in effect at it.
+func pruneParamsHistory(store storetypes.KVStore, height int64) {
+	if height <= 0 {
+		return
+	}
+
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	var keys [][]byte
+	// the first change is the one in effect at the height
+	if iterator.Valid() {
+		iterator.Next()
+	}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
 	"github.com/cosmos/evm/ethereum/eip712"
 	"github.com/cosmos/evm/wallets/accounts"
 	"github.com/cosmos/evm/wallets/usbwallet"
@@ -111,7 +112,9 @@ func (e CosmosEVMSECP256K1) SignSECP256K1(hdPath []uint32, signDocBytes []byte,
 		return nil, errors.New("unable to derive Ledger address, please open the Ethereum app and retry")
 	}
 
-	typedData, err := eip712.GetEIP712TypedDataForMsg(signDocBytes)
+	// the signer info of the derived account is selected by its public key
+	signer := &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(account.PublicKey)}
+	typedData, err := eip712.GetEIP712TypedDataForSigner(signDocBytes, signer)
 	if err != nil {
 		return nil, err
 	}
@@ -2,13 +2,18 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/erc20/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetQueryCmd returns the parent command for all erc20 CLI query commands
@@ -24,7 +29,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetDenomForCmd(),
+		GetAddressForCmd(),
 		GetParamsCmd(),
+		GetParamsAuthoritiesCmd(),
+		GetParamsAtHeightCmd(),
+		GetAccountCmd(),
 	)
 	return cmd
 }
@@ -99,6 +109,84 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetDenomForCmd queries the Cosmos coin denomination of a registered ERC-20 token
+func GetDenomForCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-for ADDRESS",
+		Short:   "Get the Cosmos coin denomination of a registered ERC-20 token",
+		Long:    "Get the Cosmos coin denomination of a registered ERC-20 token, given its hex or bech32 contract address.",
+		Example: "evmd query erc20 denom-for 0xD4949664cD82660AaE99bEdc034a0deA8A0bd517",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address := common.HexToAddress(args[0])
+			if !common.IsHexAddress(args[0]) {
+				address, err = utils.HexAddressFromBech32String(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid ERC-20 contract address %s: %w", args[0], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairRequest{
+				Token: address.Hex(),
+			}
+
+			res, err := queryClient.TokenPair(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(res.TokenPair.Denom + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAddressForCmd queries the ERC-20 contract address of a registered Cosmos coin
+func GetAddressForCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address-for DENOM",
+		Short:   "Get the ERC-20 contract address of a registered Cosmos coin",
+		Long:    "Get the ERC-20 contract address of a registered Cosmos coin, given its denomination.",
+		Example: "evmd query erc20 address-for ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := sdk.ValidateDenom(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.TokenPair(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(res.TokenPair.Erc20Address + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -128,3 +216,93 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetParamsAuthoritiesCmd queries the accounts allowed to update a subset of
+// the module params
+func GetParamsAuthoritiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-authorities",
+		Short: "Get the erc20 params authorities",
+		Long:  "Get the accounts allowed to update a subset of the erc20 parameters, and the parameters each of them can update.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsAuthorities(cmd.Context(), &types.QueryParamsAuthoritiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsAtHeightCmd queries the module params in effect at a past height
+func GetParamsAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-at-height [height]",
+		Short: "Get the erc20 params in effect at a past height",
+		Long:  "Get the erc20 parameters in effect at the end of the given block, as recorded by the parameter change history, along with the height of the change that set them.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsAtHeight(cmd.Context(), &types.QueryParamsAtHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetAccountCmd queries the unified view of an account
+func GetAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account [address]",
+		Short: "Get the unified view of an account",
+		Long:  "Get the bech32 and hex addresses, Cosmos sequence and EVM nonce, bank and token pair balances, code hash and type of an account, given its bech32 or hex address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Account(cmd.Context(), &types.QueryAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
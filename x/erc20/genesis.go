@@ -19,11 +19,18 @@ func InitGenesis(
 	accountKeeper authkeeper.AccountKeeper,
 	data types.GenesisState,
 ) {
+	// the params history is recorded before the params, which are recorded as
+	// a change at the genesis height if they differ from the last one
+	k.SetParamsHistory(ctx, data.ParamsHistory)
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	if err := k.SetParamsAuthorities(ctx, data.ParamsAuthorities); err != nil {
+		panic(fmt.Errorf("error setting params authorities %s", err))
+	}
+
 	// ensure erc20 module account is set on genesis
 	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
 		// NOTE: shouldn't occur
@@ -68,5 +75,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Allowances:         k.GetAllowances(ctx),
 		NativePrecompiles:  k.GetNativePrecompiles(ctx),
 		DynamicPrecompiles: k.GetDynamicPrecompiles(ctx),
+		ParamsAuthorities:  k.GetParamsAuthorities(ctx),
+		ParamsHistory:      k.GetParamsHistory(ctx),
 	}
 }
@@ -3,16 +3,21 @@ package keeper
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/evm/contracts"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -84,3 +89,104 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// ParamsAuthorities returns the accounts allowed to update a subset of the
+// module parameters.
+func (k Keeper) ParamsAuthorities(c context.Context, _ *types.QueryParamsAuthoritiesRequest) (*types.QueryParamsAuthoritiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsAuthoritiesResponse{ParamsAuthorities: k.GetParamsAuthorities(ctx)}, nil
+}
+
+// ParamsAtHeight returns the module parameters in effect at the end of a past
+// block, as recorded by the parameter change history.
+func (k Keeper) ParamsAtHeight(c context.Context, req *types.QueryParamsAtHeightRequest) (*types.QueryParamsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, changeHeight, found := k.GetParamsAtHeight(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no params change recorded at or below height %d", req.Height)
+	}
+	return &types.QueryParamsAtHeightResponse{Params: params, ChangeHeight: changeHeight}, nil
+}
+
+// Account returns a unified view of an account, gathering its addresses, its
+// Cosmos sequence and EVM nonce, its bank and token pair balances, its code hash
+// and its account type.
+func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var address common.Address
+	if common.IsHexAddress(req.Address) {
+		address = common.HexToAddress(req.Address)
+	} else {
+		accAddr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for address %s, should be either hex ('0x...') or bech32", req.Address,
+			)
+		}
+		address = common.BytesToAddress(accAddr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	accAddr := sdk.AccAddress(address.Bytes())
+	res := &types.QueryAccountResponse{
+		Bech32Address: accAddr.String(),
+		HexAddress:    address.Hex(),
+		CodeHash:      common.BytesToHash(evmtypes.EmptyCodeHash).Hex(),
+		Balances:      sdk.NewCoins(),
+		Erc20Balances: []types.TokenPairBalance{},
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, accAddr); acc != nil {
+		res.AccountNumber = acc.GetAccountNumber()
+		res.Sequence = acc.GetSequence()
+		_, res.IsModuleAccount = acc.(sdk.ModuleAccountI)
+		_, res.IsVestingAccount = acc.(vestingexported.VestingAccount)
+	}
+
+	if evmAcc := k.evmKeeper.GetAccountWithoutBalance(ctx, address); evmAcc != nil {
+		res.Nonce = evmAcc.Nonce
+		res.CodeHash = common.BytesToHash(evmAcc.CodeHash).Hex()
+		res.IsContract = evmAcc.IsContract()
+	}
+
+	k.bankKeeper.IterateAccountBalances(ctx, accAddr, func(coin sdk.Coin) bool {
+		res.Balances = append(res.Balances, coin)
+		return false
+	})
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		if !pair.Enabled {
+			return false
+		}
+
+		balance := sdkmath.ZeroInt()
+		if pair.IsNativeCoin() {
+			balance = k.bankKeeper.GetBalance(ctx, accAddr, pair.Denom).Amount
+		} else if erc20Balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), address); erc20Balance != nil {
+			balance = sdkmath.NewIntFromBigInt(erc20Balance)
+		}
+
+		if balance.IsPositive() {
+			res.Erc20Balances = append(res.Erc20Balances, types.TokenPairBalance{
+				Erc20Address: pair.Erc20Address,
+				Denom:        pair.Denom,
+				Balance:      balance.String(),
+			})
+		}
+		return false
+	})
+
+	return res, nil
+}
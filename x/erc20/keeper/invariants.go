@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "token-pairs", TokenPairsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrowed-tokens", EscrowedTokensInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "native-coins", NativeCoinsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TokenPairsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = EscrowedTokensInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return NativeCoinsInvariant(k)(ctx)
+	}
+}
+
+// TokenPairsInvariant checks that every token pair is indexed by both its
+// Cosmos coin denomination and its ERC20 contract address.
+func TokenPairsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+			id := pair.GetID()
+			if !bytes.Equal(k.GetDenomMap(ctx, pair.Denom), id) {
+				count++
+				msg += fmt.Sprintf("\ttoken pair %s is not indexed by its denom %s\n", pair.Erc20Address, pair.Denom)
+			}
+			if !bytes.Equal(k.GetERC20Map(ctx, pair.GetERC20Contract()), id) {
+				count++
+				msg += fmt.Sprintf("\ttoken pair %s is not indexed by its ERC20 address\n", pair.Erc20Address)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "token-pairs",
+			fmt.Sprintf("found %d token pair indexes missing\n%s", count, msg),
+		), broken
+	}
+}
+
+// EscrowedTokensInvariant checks that the supply of the Cosmos coins of every
+// native ERC20 token pair is backed by the ERC20 tokens escrowed by the module
+// account.
+func EscrowedTokensInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+			if !pair.IsNativeERC20() {
+				return false
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+			escrowed := sdkmath.ZeroInt()
+			if balance := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), types.ModuleAddress); balance != nil {
+				escrowed = sdkmath.NewIntFromBigInt(balance)
+			}
+
+			if supply.GT(escrowed) {
+				count++
+				msg += fmt.Sprintf("\t%s supply %s is greater than the escrowed tokens %s\n", pair.Denom, supply, escrowed)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrowed-tokens",
+			fmt.Sprintf("found %d token pairs with unbacked coins\n%s", count, msg),
+		), broken
+	}
+}
+
+// NativeCoinsInvariant checks that the ERC20 tokens of every native Cosmos coin
+// token pair held outside the module account are backed by the coins escrowed
+// by the module account. The ERC20 tokens served by a precompile are the coins
+// themselves, so no coins must be escrowed for them, while the tokens of an
+// ERC20 contract are minted against escrowed coins.
+func NativeCoinsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+			if !pair.IsNativeCoin() {
+				return false
+			}
+
+			contract := pair.GetERC20Contract()
+			escrowed := k.bankKeeper.GetBalance(ctx, types.ModuleAddress.Bytes(), pair.Denom).Amount
+			if k.IsNativePrecompileAvailable(ctx, contract) || k.IsDynamicPrecompileAvailable(ctx, contract) {
+				if !escrowed.IsZero() {
+					count++
+					msg += fmt.Sprintf("\t%s escrowed coins %s are not zero for its precompile %s\n", pair.Denom, escrowed, pair.Erc20Address)
+				}
+				return false
+			}
+
+			// a token pair without precompile nor contract has no tokens
+			acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+			if acc == nil || !acc.IsContract() {
+				return false
+			}
+
+			totalSupply := k.TotalSupply(ctx, erc20, contract)
+			balance := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+			if totalSupply == nil || balance == nil {
+				count++
+				msg += fmt.Sprintf("\t%s ERC20 tokens can't be queried from %s\n", pair.Denom, pair.Erc20Address)
+				return false
+			}
+
+			outstanding := sdkmath.NewIntFromBigInt(totalSupply).Sub(sdkmath.NewIntFromBigInt(balance))
+			if outstanding.GT(escrowed) {
+				count++
+				msg += fmt.Sprintf("\t%s ERC20 tokens %s held outside the module are greater than the escrowed coins %s\n", pair.Denom, outstanding, escrowed)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "native-coins",
+			fmt.Sprintf("found %d token pairs with unbacked tokens\n%s", count, msg),
+		), broken
+	}
+}
@@ -25,6 +25,9 @@ type Keeper struct {
 	evmKeeper      types.EVMKeeper
 	stakingKeeper  types.StakingKeeper
 	transferKeeper *transferkeeper.Keeper
+
+	// transferRestriction is run before the ERC20 precompile transfers
+	transferRestriction *transferRestriction
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -52,6 +55,8 @@ func NewKeeper(
 		evmKeeper:      evmKeeper,
 		stakingKeeper:  sk,
 		transferKeeper: transferKeeper,
+
+		transferRestriction: &transferRestriction{},
 	}
 }
 
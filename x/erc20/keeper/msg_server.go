@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	"github.com/cosmos/evm/contracts"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/erc20/types"
 
 	sdkerrors "cosmossdk.io/errors"
@@ -307,18 +308,45 @@ func (k Keeper) ConvertCoinNativeERC20(
 
 // UpdateParams implements the gRPC MsgServer interface. After a successful governance vote
 // it updates the parameters in the keeper only if the requested authority
-// is the Cosmos SDK governance module account
+// is the Cosmos SDK governance module account, or a params authority, which
+// only updates the params it's allowed to
 func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := req.Params
+	if k.authority.String() != req.Authority {
+		paramsAuthority, found := k.GetParamsAuthority(ctx, req.Authority)
+		if !found {
+			return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+		}
+
+		// only the params the authority is allowed to update are changed
+		params = k.GetParams(ctx)
+		cosmosevmtypes.ApplyParams(&params, &req.Params, paramsAuthority.Params)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateParamsAuthorities implements the gRPC MsgServer interface. When an
+// UpdateParamsAuthorities proposal passes, it replaces the accounts allowed to
+// update a subset of the module parameters. The update can only be performed
+// if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateParamsAuthorities(goCtx context.Context, req *types.MsgUpdateParamsAuthorities) (*types.MsgUpdateParamsAuthoritiesResponse, error) {
 	if k.authority.String() != req.Authority {
 		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.SetParams(ctx, req.Params); err != nil {
+	if err := k.SetParamsAuthorities(ctx, req.ParamsAuthorities); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	return &types.MsgUpdateParamsAuthoritiesResponse{}, nil
 }
 
 // RegisterERC20 implements the gRPC MsgServer interface. Any account can permissionlessly
@@ -19,6 +19,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
 	k.SetPermissionlessRegistration(ctx, newParams.PermissionlessRegistration)
+	k.setParamsHistory(ctx, newParams)
 	return nil
 }
 
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// transferRestriction holds the restriction run before the ERC20 precompile
+// transfers. It is held through a pointer so that it is shared by the copies of
+// the keeper, e.g. the ones held by the app and the precompiles.
+type transferRestriction struct {
+	fn types.TransferRestrictionFn
+}
+
+// restriction returns the transfer restriction of the keeper, allocating it if
+// the keeper is the zero value.
+func (k *Keeper) restriction() *transferRestriction {
+	if k.transferRestriction == nil {
+		k.transferRestriction = &transferRestriction{}
+	}
+	return k.transferRestriction
+}
+
+// AppendTransferRestriction adds the provided restriction to run after the ones
+// already set.
+func (k *Keeper) AppendTransferRestriction(restriction types.TransferRestrictionFn) {
+	r := k.restriction()
+	r.fn = types.ComposeTransferRestrictions(r.fn, restriction)
+}
+
+// PrependTransferRestriction adds the provided restriction to run before the ones
+// already set.
+func (k *Keeper) PrependTransferRestriction(restriction types.TransferRestrictionFn) {
+	r := k.restriction()
+	r.fn = types.ComposeTransferRestrictions(restriction, r.fn)
+}
+
+// ClearTransferRestriction removes all the transfer restrictions.
+func (k *Keeper) ClearTransferRestriction() {
+	k.restriction().fn = nil
+}
+
+// CheckTransferRestriction runs the transfer restrictions on a transfer executed
+// through the ERC20 precompile of the given token pair. It returns an error if the
+// transfer is denied.
+func (k Keeper) CheckTransferRestriction(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	spender, from, to common.Address,
+	amount *big.Int,
+) error {
+	if k.transferRestriction == nil || k.transferRestriction.fn == nil {
+		return nil
+	}
+
+	if err := k.transferRestriction.fn(ctx, pair, spender, from, to, amount); err != nil {
+		return errorsmod.Wrapf(types.ErrTransferRestricted, "%s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferRestrictionZeroKeeper(t *testing.T) {
+	deny := func(_ sdk.Context, _ types.TokenPair, _, _, _ common.Address, _ *big.Int) error {
+		return errors.New("denied")
+	}
+
+	var k Keeper
+	require.NoError(t, k.CheckTransferRestriction(sdk.Context{}, types.TokenPair{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(1)))
+
+	k.AppendTransferRestriction(deny)
+	err := k.CheckTransferRestriction(sdk.Context{}, types.TokenPair{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(1))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+
+	// the copies of the keeper share its restriction
+	copied := k
+	copied.ClearTransferRestriction()
+	require.NoError(t, k.CheckTransferRestriction(sdk.Context{}, types.TokenPair{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(1)))
+
+	var prepended Keeper
+	prepended.PrependTransferRestriction(deny)
+	err = prepended.CheckTransferRestriction(sdk.Context{}, types.TokenPair{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(1))
+	require.ErrorIs(t, err, types.ErrTransferRestricted)
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding erc20 type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamStoreKeyEnableErc20),
+			bytes.Equal(kvA.Key, types.ParamStoreKeyPermissionlessRegistration):
+			return fmt.Sprintf("%t\n%t", len(kvA.Value) != 0, len(kvB.Value) != 0)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPair):
+			var pairA, pairB types.TokenPair
+			cdc.MustUnmarshal(kvA.Value, &pairA)
+			cdc.MustUnmarshal(kvB.Value, &pairB)
+			return fmt.Sprintf("%v\n%v", pairA, pairB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPairByERC20),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixTokenPairByDenom):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixAllowance):
+			var allowanceA, allowanceB types.Allowance
+			cdc.MustUnmarshal(kvA.Value, &allowanceA)
+			cdc.MustUnmarshal(kvB.Value, &allowanceB)
+			return fmt.Sprintf("%v\n%v", allowanceA, allowanceB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixSTRv2Addresses),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixNativePrecompiles),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixDynamicPrecompiles):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid erc20 key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package simulation_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/simulation"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	dec := simulation.NewDecodeStore(types.ModuleCdc)
+
+	pair := types.NewTokenPair(types.ModuleAddress, "simcoin", types.OWNER_MODULE)
+	allowance := types.NewAllowance(types.ModuleAddress, types.ModuleAddress, types.ModuleAddress, big.NewInt(100))
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamStoreKeyEnableErc20, Value: []byte{0x01}},
+			{Key: types.KeyPrefixTokenPair, Value: types.ModuleCdc.MustMarshal(&pair)},
+			{Key: types.KeyPrefixTokenPairByDenom, Value: pair.GetID()},
+			{Key: types.KeyPrefixAllowance, Value: types.ModuleCdc.MustMarshal(&allowance)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"EnableErc20", "true\ntrue"},
+		{"TokenPair", fmt.Sprintf("%v\n%v", pair, pair)},
+		{"TokenPairByDenom", fmt.Sprintf("%X\n%X", pair.GetID(), pair.GetID())},
+		{"Allowance", fmt.Sprintf("%v\n%v", allowance, allowance)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+		})
+	}
+}
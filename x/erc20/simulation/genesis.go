@@ -0,0 +1,48 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	EnableErc20                = "enable_erc20"
+	PermissionlessRegistration = "permissionless_registration"
+)
+
+// GenEnableErc20 randomized EnableErc20, mostly enabled
+func GenEnableErc20(r *rand.Rand) bool {
+	return r.Intn(10) != 0
+}
+
+// GenPermissionlessRegistration randomized PermissionlessRegistration
+func GenPermissionlessRegistration(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for the erc20 module. It
+// starts from the genesis state of the application, which holds the token
+// pairs of the chain native precompiles.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesisState()
+	if bz, ok := simState.GenState[types.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, genesis)
+	}
+
+	var enableErc20, permissionlessRegistration bool
+	simState.AppParams.GetOrGenerate(
+		EnableErc20, &enableErc20, simState.Rand,
+		func(r *rand.Rand) { enableErc20 = GenEnableErc20(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		PermissionlessRegistration, &permissionlessRegistration, simState.Rand,
+		func(r *rand.Rand) { permissionlessRegistration = GenPermissionlessRegistration(r) },
+	)
+	genesis.Params = types.NewParams(enableErc20, permissionlessRegistration)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,204 @@
+package simulation
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+	vmsimulation "github.com/cosmos/evm/x/vm/simulation"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgRegisterERC20 = "op_weight_msg_register_erc20"
+	OpWeightMsgConvertERC20  = "op_weight_msg_convert_erc20"
+
+	DefaultWeightMsgRegisterERC20 = 20
+	DefaultWeightMsgConvertERC20  = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, txConfig client.TxConfig, k keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgRegisterERC20, weightMsgConvertERC20 int
+	appParams.GetOrGenerate(OpWeightMsgRegisterERC20, &weightMsgRegisterERC20, nil, func(_ *rand.Rand) {
+		weightMsgRegisterERC20 = DefaultWeightMsgRegisterERC20
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgConvertERC20, &weightMsgConvertERC20, nil, func(_ *rand.Rand) {
+		weightMsgConvertERC20 = DefaultWeightMsgConvertERC20
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgRegisterERC20,
+			SimulateMsgRegisterERC20(txConfig, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgConvertERC20,
+			SimulateMsgConvertERC20(txConfig, k),
+		),
+	}
+}
+
+// SimulateMsgRegisterERC20 simulates the permissionless registration of an
+// ERC20 contract deployed by a random account, which mints itself a random
+// amount of tokens to be converted by the other operations.
+func SimulateMsgRegisterERC20(txConfig client.TxConfig, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterERC20{})
+
+		params := k.GetParams(ctx)
+		if !params.EnableErc20 || !params.PermissionlessRegistration {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "permissionless registration is disabled"), nil, nil
+		}
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		sender := common.BytesToAddress(from.Address)
+
+		contract := contracts.ERC20MinterBurnerDecimalsContract
+		symbol := simtypes.RandStringOfLength(r, 4)
+		ctorArgs, err := contract.ABI.Pack("", fmt.Sprintf("Simulation %s", symbol), symbol, uint8(r.Intn(19))) // #nosec G115 -- lower than 19
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack constructor arguments"), nil, err
+		}
+
+		ethMsg, reason, err := vmsimulation.DeliverEthTx(app, ctx, txConfig, from, nil, nil, append(append([]byte{}, contract.Bin...), ctorArgs...))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deploy contract"), nil, err
+		}
+		if ethMsg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		contractAddr := crypto.CreateAddress(sender, ethMsg.AsTransaction().Nonce())
+		input, err := contract.ABI.Pack("mint", sender, big.NewInt(r.Int63n(1e18)+1))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack mint arguments"), nil, err
+		}
+
+		ethMsg, reason, err = vmsimulation.DeliverEthTx(app, ctx, txConfig, from, &contractAddr, nil, input)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to mint tokens"), nil, err
+		}
+		if ethMsg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		msg := &types.MsgRegisterERC20{
+			Signer:         from.Address.String(),
+			Erc20Addresses: []string{contractAddr.Hex()},
+		}
+
+		reason, err = vmsimulation.DeliverCosmosTx(r, app, ctx, txConfig, from, msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+		if reason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgConvertERC20 simulates the conversion of a random amount of the
+// tokens of a random native ERC20 token pair held by a random account, to a
+// random receiver. The receiver then converts the coins back to ERC20 tokens
+// with MsgConvertCoin, so that the simulated accounts only hold coins that the
+// operations of the other modules can use to pay fees.
+func SimulateMsgConvertERC20(txConfig client.TxConfig, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgConvertERC20{})
+
+		pair, ok := randomConvertiblePair(r, ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no convertible native ERC20 token pairs"), nil, nil
+		}
+
+		// the tokens are held by few accounts, so pick a random one among them
+		var (
+			from    simtypes.Account
+			balance *big.Int
+		)
+		for _, i := range r.Perm(len(accs)) {
+			balance = k.BalanceOf(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(accs[i].Address))
+			if balance != nil && balance.Sign() > 0 {
+				from = accs[i]
+				break
+			}
+		}
+		if from.Address.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no account holds the tokens"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, sdkmath.NewIntFromBigInt(balance))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+
+		to, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgConvertERC20(amount, to.Address, pair.GetERC20Contract(), common.BytesToAddress(from.Address))
+
+		reason, err := vmsimulation.DeliverCosmosTx(r, app, ctx, txConfig, from, msg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+		if reason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		convertCoinMsg := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, amount), common.BytesToAddress(receiver.Address), to.Address)
+
+		reason, err = vmsimulation.DeliverCosmosTx(r, app, ctx, txConfig, to, convertCoinMsg)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to convert coins back"), nil, err
+		}
+		if reason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to convert coins back"), nil, fmt.Errorf("unable to convert coins back: %s", reason)
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomConvertiblePair returns a random enabled native ERC20 token pair, if
+// conversions are enabled.
+func randomConvertiblePair(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (types.TokenPair, bool) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.TokenPair{}, false
+	}
+
+	var pairs []types.TokenPair
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		if pair.Enabled && pair.IsNativeERC20() {
+			pairs = append(pairs, pair)
+		}
+		return false
+	})
+	if len(pairs) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	return pairs[r.Intn(len(pairs))], true
+}
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/x/erc20/keeper"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams     int = 50
+	DefaultWeightMsgToggleConversion int = 50
+
+	OpWeightMsgUpdateParams     = "op_weight_msg_update_params"
+	OpWeightMsgToggleConversion = "op_weight_msg_toggle_conversion"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgToggleConversion,
+			DefaultWeightMsgToggleConversion,
+			SimulateMsgToggleConversion(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	return &types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    types.NewParams(GenEnableErc20(r), GenPermissionlessRegistration(r)),
+	}
+}
+
+// SimulateMsgToggleConversion returns a MsgToggleConversion of a random token
+// pair, or nil if there are no token pairs registered.
+func SimulateMsgToggleConversion(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		pairs := k.GetTokenPairs(ctx)
+		if len(pairs) == 0 {
+			return nil
+		}
+
+		return &types.MsgToggleConversion{
+			Authority: authority.String(),
+			Token:     pairs[r.Intn(len(pairs))].Erc20Address,
+		}
+	}
+}
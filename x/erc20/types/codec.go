@@ -24,11 +24,12 @@ var (
 
 const (
 	// Amino names
-	convertERC20Name = "cosmos/evm/MsgConvertERC20"
-	convertCoinName  = "cosmos/evm/MsgConvertCoin" // keep it for backwards compatibility when querying txs
-	updateParams     = "cosmos/evm/erc20/MsgUpdateParams"
-	registerERC20    = "cosmos/evm/erc20/MsgRegisterERC20"
-	toggleConversion = "cosmos/evm/erc20/MsgToggleConversion"
+	convertERC20Name        = "cosmos/evm/MsgConvertERC20"
+	convertCoinName         = "cosmos/evm/MsgConvertCoin" // keep it for backwards compatibility when querying txs
+	updateParams            = "cosmos/evm/erc20/MsgUpdateParams"
+	registerERC20           = "cosmos/evm/erc20/MsgRegisterERC20"
+	toggleConversion        = "cosmos/evm/erc20/MsgToggleConversion"
+	updateParamsAuthorities = "cosmos/evm/erc20/MsgUpdateParamsAuthorities"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -46,6 +47,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgRegisterERC20{},
 		&MsgToggleConversion{},
+		&MsgUpdateParamsAuthorities{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -66,4 +68,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
 	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20, nil)
 	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversion, nil)
+	cdc.RegisterConcrete(&MsgUpdateParamsAuthorities{}, updateParamsAuthorities, nil)
 }
@@ -298,37 +298,123 @@ func (m *EventConvertERC20) GetContractAddress() string {
 	return ""
 }
 
+// EventPrecompileTransfer is an event emitted when coins are transferred through an ERC20
+// precompile, mirroring the attributes of the bank transfer event.
+type EventPrecompileTransfer struct {
+	// sender is the sender's address.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the recipient's address.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount of coins transferred.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// denom is the coin's denomination.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// erc20_address is the ERC20 contract address.
+	Erc20Address string `protobuf:"bytes,5,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *EventPrecompileTransfer) Reset()         { *m = EventPrecompileTransfer{} }
+func (m *EventPrecompileTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventPrecompileTransfer) ProtoMessage()    {}
+func (*EventPrecompileTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb108440d0a34e58, []int{4}
+}
+func (m *EventPrecompileTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPrecompileTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPrecompileTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPrecompileTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPrecompileTransfer.Merge(m, src)
+}
+func (m *EventPrecompileTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPrecompileTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPrecompileTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPrecompileTransfer proto.InternalMessageInfo
+
+func (m *EventPrecompileTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventPrecompileTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventPrecompileTransfer) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventPrecompileTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventPrecompileTransfer) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventRegisterPair)(nil), "cosmos.evm.erc20.v1.EventRegisterPair")
 	proto.RegisterType((*EventToggleTokenConversion)(nil), "cosmos.evm.erc20.v1.EventToggleTokenConversion")
 	proto.RegisterType((*EventConvertCoin)(nil), "cosmos.evm.erc20.v1.EventConvertCoin")
 	proto.RegisterType((*EventConvertERC20)(nil), "cosmos.evm.erc20.v1.EventConvertERC20")
+	proto.RegisterType((*EventPrecompileTransfer)(nil), "cosmos.evm.erc20.v1.EventPrecompileTransfer")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/events.proto", fileDescriptor_fb108440d0a34e58) }
 
 var fileDescriptor_fb108440d0a34e58 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x1b, 0xb5, 0x45, 0x83, 0x62, 0x3d, 0x45, 0x4a, 0x87, 0xa3, 0xb4, 0x8b, 0x2e, 0x77,
-	0x6d, 0x9d, 0x1d, 0xb4, 0x74, 0x15, 0x29, 0x05, 0xc1, 0x45, 0xae, 0xb9, 0xc7, 0x19, 0x34, 0x79,
-	0x25, 0x49, 0x83, 0xfe, 0x17, 0xae, 0x6e, 0xfe, 0x39, 0x8e, 0x1d, 0x1d, 0xa5, 0xf7, 0x8f, 0x48,
-	0x93, 0xf8, 0x03, 0x11, 0x17, 0xc1, 0xf1, 0xfb, 0xf2, 0xde, 0x87, 0x0f, 0xe1, 0x4b, 0x5b, 0x0c,
-	0xb5, 0x40, 0x9d, 0x82, 0x15, 0x29, 0x28, 0xd6, 0xef, 0xa6, 0xb6, 0x97, 0x82, 0x05, 0x69, 0x74,
-	0x32, 0x55, 0x68, 0x30, 0xda, 0xf5, 0x1b, 0x09, 0x58, 0x91, 0xb8, 0x8d, 0xc4, 0xf6, 0xda, 0x67,
-	0x74, 0x67, 0xb8, 0x5c, 0x1a, 0x41, 0xc1, 0xb5, 0x01, 0x75, 0x9e, 0x71, 0x15, 0xed, 0xd1, 0x6a,
-	0x0e, 0x12, 0x45, 0x83, 0xb4, 0xc8, 0xc1, 0xc6, 0xc8, 0x87, 0xa8, 0x43, 0xb7, 0xdc, 0xd9, 0x55,
-	0x96, 0xe7, 0x0a, 0xb4, 0x6e, 0xac, 0xb8, 0xd7, 0x4d, 0x37, 0x3c, 0xf1, 0xb3, 0xf6, 0x05, 0x6d,
-	0x3a, 0xde, 0x18, 0x8b, 0xe2, 0x16, 0xc6, 0x78, 0x03, 0x72, 0x80, 0xd2, 0x82, 0xd2, 0x1c, 0xe5,
-	0x5f, 0xc0, 0x8f, 0x84, 0xd6, 0x1d, 0xd9, 0xe3, 0xcc, 0x00, 0xb9, 0x8c, 0xf6, 0x69, 0x4d, 0x83,
-	0xcc, 0x41, 0x05, 0x60, 0x48, 0x51, 0x93, 0xae, 0x2b, 0x60, 0xc0, 0x2d, 0xa8, 0x00, 0xfb, 0xc8,
-	0xcb, 0x9b, 0x4c, 0xe0, 0x4c, 0x9a, 0xc6, 0xaa, 0xbf, 0xf1, 0xe9, 0xd3, 0x6d, 0xed, 0x57, 0xb7,
-	0xea, 0x0f, 0x6e, 0x4f, 0x24, 0xfc, 0x62, 0x70, 0x1b, 0x8e, 0x06, 0xfd, 0xee, 0x3f, 0xc8, 0x1d,
-	0xd2, 0x3a, 0x43, 0x69, 0x54, 0xc6, 0xcc, 0x37, 0xbf, 0xed, 0xf7, 0x79, 0x50, 0x3c, 0x3d, 0x7e,
-	0x5e, 0xc4, 0x64, 0xbe, 0x88, 0xc9, 0xeb, 0x22, 0x26, 0x0f, 0x65, 0x5c, 0x99, 0x97, 0x71, 0xe5,
-	0xa5, 0x8c, 0x2b, 0x97, 0x9d, 0x82, 0x9b, 0xeb, 0xd9, 0x24, 0x61, 0x28, 0xd2, 0x2f, 0x1d, 0xba,
-	0x0b, 0x2d, 0x32, 0xf7, 0x53, 0xd0, 0x93, 0x9a, 0xab, 0xd0, 0xd1, 0x5b, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x71, 0x9c, 0x37, 0x77, 0x66, 0x02, 0x00, 0x00,
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x8d, 0xda, 0x62, 0x17, 0xc5, 0x1a, 0x45, 0x4b, 0x91, 0x52, 0xda, 0x8b, 0x5e, 0x92,
+	0xb6, 0x9e, 0x3d, 0x68, 0xe9, 0x55, 0x4a, 0x28, 0x08, 0x5e, 0x24, 0x4d, 0xc6, 0xb8, 0x68, 0x76,
+	0xc3, 0xee, 0x36, 0xd4, 0xb7, 0xf0, 0xea, 0x4d, 0x7c, 0x1a, 0x8f, 0x3d, 0x7a, 0x14, 0x7d, 0x11,
+	0xa7, 0xbb, 0x5b, 0x2b, 0x52, 0xbc, 0x88, 0x1e, 0x86, 0xf0, 0xcf, 0xfe, 0xf3, 0xef, 0xc7, 0x66,
+	0x48, 0x3d, 0xe2, 0x32, 0xe5, 0xd2, 0x87, 0x3c, 0xf5, 0x41, 0x44, 0x9d, 0x96, 0x9f, 0xb7, 0x51,
+	0x00, 0x53, 0xd2, 0xcb, 0x04, 0x57, 0xdc, 0xdd, 0x36, 0x0e, 0x0f, 0x1d, 0x9e, 0x76, 0x78, 0x79,
+	0xbb, 0x71, 0x46, 0xb6, 0x7a, 0x53, 0x53, 0x00, 0x09, 0x95, 0x0a, 0x44, 0x3f, 0xa4, 0xc2, 0xdd,
+	0x21, 0x85, 0x18, 0x18, 0x4f, 0x2b, 0x4e, 0xdd, 0x39, 0x28, 0x05, 0x46, 0xb8, 0x4d, 0xb2, 0xa1,
+	0xc7, 0x2e, 0xc3, 0x38, 0x16, 0x20, 0x65, 0x65, 0x59, 0x9f, 0xae, 0xeb, 0xe6, 0x89, 0xe9, 0x35,
+	0xce, 0x49, 0x55, 0xe7, 0x0d, 0x78, 0x92, 0xdc, 0xc2, 0x80, 0xdf, 0x00, 0xeb, 0x72, 0x96, 0x83,
+	0x90, 0x94, 0xb3, 0xdf, 0x04, 0x3f, 0x38, 0xa4, 0xac, 0x93, 0x4d, 0x1c, 0x7e, 0x28, 0x73, 0x77,
+	0x49, 0x51, 0x02, 0x8b, 0x41, 0xd8, 0x40, 0xab, 0xdc, 0x2a, 0x59, 0x13, 0x10, 0x01, 0x45, 0xa3,
+	0x0d, 0xfb, 0xd4, 0xd3, 0x99, 0x30, 0xe5, 0x23, 0xa6, 0x2a, 0x2b, 0x66, 0xc6, 0xa8, 0x39, 0xdb,
+	0xea, 0x8f, 0x6c, 0x85, 0x05, 0x6c, 0x8f, 0x8e, 0x7d, 0x45, 0xcb, 0xd6, 0x0b, 0xba, 0x9d, 0xd6,
+	0x3f, 0xc0, 0x1d, 0x92, 0x72, 0xc4, 0x99, 0x12, 0x61, 0xa4, 0xbe, 0xf1, 0x6d, 0xce, 0xfa, 0x33,
+	0xc4, 0x27, 0x87, 0xec, 0x69, 0xc4, 0x3e, 0xde, 0xc5, 0xd3, 0x8c, 0xe2, 0xcf, 0x11, 0x21, 0x93,
+	0x57, 0xe6, 0xd2, 0x85, 0xa0, 0xfb, 0xa4, 0x84, 0x66, 0x9a, 0x51, 0x1c, 0xb3, 0xa4, 0xf3, 0xc6,
+	0x1f, 0xbc, 0xe3, 0xe9, 0xf1, 0xf3, 0x5b, 0xcd, 0x99, 0x60, 0xbd, 0x62, 0xdd, 0xbf, 0xd7, 0x96,
+	0x26, 0x58, 0x2f, 0x58, 0x17, 0xcd, 0x84, 0xaa, 0xeb, 0xd1, 0xd0, 0x43, 0x74, 0xff, 0xcb, 0xa2,
+	0x8f, 0xed, 0xaa, 0xab, 0xbb, 0x0c, 0xe4, 0xb0, 0xa8, 0xf7, 0xfc, 0xe8, 0x03, 0x03, 0x5e, 0x86,
+	0xda, 0x0b, 0x03, 0x00, 0x00,
 }
 
 func (m *EventRegisterPair) Marshal() (dAtA []byte, err error) {
@@ -521,6 +607,64 @@ func (m *EventConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPrecompileTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPrecompileTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPrecompileTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -624,6 +768,35 @@ func (m *EventConvertERC20) Size() (n int) {
 	return n
 }
 
+func (m *EventPrecompileTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1278,6 +1451,216 @@ func (m *EventConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPrecompileTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPrecompileTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPrecompileTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
 )
 
 // NewGenesisState creates a new genesis state.
@@ -73,6 +75,27 @@ func (gs GenesisState) Validate() error {
 		seenAllowance[a.Erc20Address+a.Owner+a.Spender] = true
 	}
 
+	if err := cosmosevmtypes.ValidateParamsAuthorities(gs.ParamsAuthorities, &Params{}); err != nil {
+		return fmt.Errorf("invalid params authorities on genesis: %w", err)
+	}
+
+	if err := validateParamsHistory(gs.ParamsHistory); err != nil {
+		return fmt.Errorf("invalid params history on genesis: %w", err)
+	}
+
+	return nil
+}
+
+// validateParamsHistory checks that the parameter changes are recorded by strictly ascending, non-negative heights.
+func validateParamsHistory(history []ParamsChange) error {
+	for i, change := range history {
+		if change.Height < 0 {
+			return fmt.Errorf("negative change height %d", change.Height)
+		}
+		if i > 0 && change.Height <= history[i-1].Height {
+			return fmt.Errorf("change at height %d is not after the one at height %d", change.Height, history[i-1].Height)
+		}
+	}
 	return nil
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -36,6 +37,12 @@ type GenesisState struct {
 	NativePrecompiles []string `protobuf:"bytes,4,rep,name=native_precompiles,json=nativePrecompiles,proto3" json:"native_precompiles,omitempty"`
 	// dynamic_precompiles is a slice of registered dynamic precompiles at genesis
 	DynamicPrecompiles []string `protobuf:"bytes,5,rep,name=dynamic_precompiles,json=dynamicPrecompiles,proto3" json:"dynamic_precompiles,omitempty"`
+	// params_authorities are the accounts allowed to update a subset of the
+	// module parameters, in addition to the governance account.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,6,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+	// params_history are the recorded changes of the module parameters, by
+	// ascending height.
+	ParamsHistory []ParamsChange `protobuf:"bytes,7,rep,name=params_history,json=paramsHistory,proto3" json:"params_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +113,20 @@ func (m *GenesisState) GetDynamicPrecompiles() []string {
 	return nil
 }
 
+func (m *GenesisState) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamsHistory() []ParamsChange {
+	if m != nil {
+		return m.ParamsHistory
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <-->
@@ -163,41 +184,103 @@ func (m *Params) GetPermissionlessRegistration() bool {
 	return false
 }
 
+// ParamsChange is a change of the module parameters recorded in the parameter
+// change history.
+type ParamsChange struct {
+	// params are the parameters set by the change.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// height is the block height of the change.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ParamsChange) Reset()         { *m = ParamsChange{} }
+func (m *ParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ParamsChange) ProtoMessage()    {}
+func (*ParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e964b7a0cc2cbbd5, []int{2}
+}
+func (m *ParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsChange.Merge(m, src)
+}
+func (m *ParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsChange proto.InternalMessageInfo
+
+func (m *ParamsChange) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ParamsChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "cosmos.evm.erc20.v1.Params")
+	proto.RegisterType((*ParamsChange)(nil), "cosmos.evm.erc20.v1.ParamsChange")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0xe6, 0xde, 0xd2, 0x4e, 0xba, 0xb0, 0x53, 0x17, 0xa1, 0x85, 0xf4, 0x8f, 0x9b,
-	0xe2, 0x22, 0xb1, 0x75, 0x23, 0x82, 0x8a, 0x05, 0x11, 0xbb, 0x2a, 0xd5, 0x95, 0x9b, 0x30, 0x8d,
-	0x87, 0x38, 0x98, 0x99, 0x09, 0x33, 0x63, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec,
-	0x52, 0x10, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x69, 0x69, 0x2a, 0xe5, 0x6e, 0xc2, 0xf0, 0xf1,
-	0xfb, 0x7d, 0x39, 0x1c, 0x0e, 0x1a, 0xa6, 0x42, 0x31, 0xa1, 0x62, 0x28, 0x59, 0x0c, 0x32, 0x9d,
-	0x3e, 0x8a, 0xcb, 0x49, 0x9c, 0x01, 0x07, 0x45, 0x55, 0x54, 0x48, 0xa1, 0x05, 0xee, 0x58, 0x24,
-	0x82, 0x92, 0x45, 0x06, 0x89, 0xca, 0x49, 0xb7, 0x4d, 0x18, 0xe5, 0x22, 0x36, 0x5f, 0xcb, 0x75,
-	0xfb, 0xd7, 0xaa, 0xac, 0x60, 0x81, 0xfb, 0x99, 0xc8, 0x84, 0x79, 0xc6, 0x87, 0x97, 0x4d, 0x47,
-	0xbf, 0x6a, 0xa8, 0xf5, 0xda, 0xfe, 0xf0, 0xad, 0x26, 0x1a, 0xf0, 0x73, 0x54, 0x2f, 0x88, 0x24,
-	0x4c, 0x05, 0xee, 0xc0, 0x1d, 0xfb, 0xd3, 0x5e, 0x74, 0x65, 0x80, 0x68, 0x61, 0x90, 0x59, 0x73,
-	0xf3, 0xbb, 0xef, 0x7c, 0xff, 0xfb, 0xe3, 0xa1, 0xbb, 0x3c, 0x5a, 0x78, 0x8e, 0x7c, 0x2d, 0x3e,
-	0x01, 0x4f, 0x0a, 0x42, 0xa5, 0x0a, 0x6a, 0x03, 0x6f, 0xec, 0x4f, 0xc3, 0xab, 0x25, 0xef, 0x0e,
-	0xdc, 0x82, 0x50, 0x59, 0xed, 0x41, 0xfa, 0x94, 0x2a, 0xfc, 0x06, 0x21, 0x92, 0xe7, 0xe2, 0x0b,
-	0xe1, 0x29, 0xa8, 0xc0, 0xbb, 0xa3, 0xea, 0xe5, 0x09, 0xbb, 0xa8, 0x3a, 0xcb, 0xf8, 0x09, 0xc2,
-	0x9c, 0x68, 0x5a, 0x42, 0x52, 0x48, 0x48, 0x05, 0x2b, 0x68, 0x0e, 0x2a, 0xb8, 0x19, 0x78, 0xe3,
-	0xa6, 0x51, 0x5c, 0xab, 0xb4, 0x2d, 0xb4, 0x38, 0x33, 0xf8, 0x29, 0xea, 0x7c, 0x58, 0x73, 0xc2,
-	0x68, 0x7a, 0xa1, 0xde, 0xfe, 0xaf, 0xe2, 0x23, 0x55, 0x71, 0x47, 0x12, 0xd5, 0xed, 0xa6, 0xf0,
-	0x10, 0xb5, 0x80, 0x93, 0x55, 0x0e, 0x89, 0x99, 0xd9, 0x2c, 0xb7, 0xb1, 0xf4, 0x6d, 0xf6, 0xea,
-	0x10, 0xe1, 0x17, 0xa8, 0x57, 0x80, 0x64, 0x54, 0x29, 0x2a, 0x78, 0x0e, 0x4a, 0x25, 0x12, 0x32,
-	0xaa, 0xb4, 0x24, 0x9a, 0x0a, 0x1e, 0xdc, 0x1a, 0xa3, 0x7b, 0x89, 0x2c, 0x2b, 0xc4, 0xfc, 0xa6,
-	0x51, 0xbb, 0xe7, 0xcd, 0x9e, 0x6d, 0x76, 0xa1, 0xbb, 0xdd, 0x85, 0xee, 0x9f, 0x5d, 0xe8, 0x7e,
-	0xdb, 0x87, 0xce, 0x76, 0x1f, 0x3a, 0x3f, 0xf7, 0xa1, 0xf3, 0xfe, 0x41, 0x46, 0xf5, 0xc7, 0xcf,
-	0xab, 0x28, 0x15, 0x2c, 0xae, 0x5c, 0xcb, 0xd7, 0xe3, 0xbd, 0xe8, 0x75, 0x01, 0x6a, 0x55, 0x37,
-	0x77, 0xf1, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x48, 0x19, 0xb7, 0x9b, 0x02, 0x00,
-	0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x93, 0xcf, 0x4f, 0xdb, 0x30,
+	0x14, 0xc7, 0x09, 0x2d, 0x01, 0xdc, 0x6e, 0xa2, 0x06, 0xa1, 0xaa, 0x48, 0x85, 0x76, 0x3b, 0x20,
+	0x0e, 0x09, 0x3f, 0x2e, 0x13, 0xd2, 0x36, 0xc1, 0x34, 0x0d, 0x38, 0x55, 0x85, 0x13, 0x87, 0x45,
+	0x6e, 0x66, 0x12, 0x8b, 0xc6, 0x8e, 0x6c, 0x53, 0xd6, 0xff, 0x82, 0x3f, 0x63, 0xc7, 0xfd, 0x19,
+	0x1c, 0x39, 0x72, 0x9a, 0xa6, 0xed, 0xb0, 0x7f, 0x63, 0x2f, 0x76, 0xaa, 0x26, 0xa8, 0xe2, 0xc2,
+	0xe1, 0x45, 0xce, 0xcb, 0xe7, 0xfb, 0x8d, 0xdf, 0x7b, 0x36, 0xea, 0x84, 0x42, 0x25, 0x42, 0xf9,
+	0x74, 0x94, 0xf8, 0x54, 0x86, 0xfb, 0xbb, 0xfe, 0x68, 0xcf, 0x8f, 0x28, 0xa7, 0x8a, 0x29, 0x2f,
+	0x95, 0x42, 0x0b, 0xbc, 0x6a, 0x11, 0x0f, 0x10, 0xcf, 0x20, 0xde, 0x68, 0xaf, 0xd5, 0x20, 0x09,
+	0xe3, 0xc2, 0x37, 0x4f, 0xcb, 0xb5, 0x36, 0x67, 0x59, 0x59, 0x81, 0x05, 0x76, 0x0a, 0x80, 0x1e,
+	0xa7, 0x54, 0x65, 0x40, 0x4a, 0x24, 0x49, 0x54, 0x40, 0x6e, 0x74, 0x2c, 0x24, 0xd3, 0xe3, 0x9c,
+	0x5d, 0x8b, 0x44, 0x24, 0xcc, 0xd2, 0xcf, 0x56, 0x36, 0xdb, 0xbd, 0xab, 0xa2, 0xfa, 0x17, 0xbb,
+	0xb9, 0x73, 0x4d, 0x34, 0xc5, 0x1f, 0x90, 0x6b, 0x0d, 0x9a, 0xce, 0x96, 0xb3, 0x5d, 0xdb, 0xdf,
+	0xf0, 0x66, 0x6c, 0xd6, 0xeb, 0x19, 0xe4, 0x78, 0xf9, 0xfe, 0xd7, 0xe6, 0xdc, 0x8f, 0x7f, 0x3f,
+	0x77, 0x9c, 0x7e, 0xae, 0xc2, 0x67, 0xa8, 0xa6, 0xc5, 0x35, 0xe5, 0x41, 0x4a, 0x98, 0x54, 0xcd,
+	0xf9, 0xad, 0x0a, 0x98, 0xb4, 0x67, 0x9a, 0x5c, 0x64, 0x5c, 0x0f, 0xb0, 0xa2, 0x0f, 0xd2, 0x93,
+	0xac, 0xc2, 0xa7, 0x08, 0x91, 0xe1, 0x50, 0xdc, 0x12, 0x1e, 0x52, 0xd5, 0xac, 0x3c, 0x63, 0x75,
+	0x34, 0xc1, 0x4a, 0x56, 0x53, 0x31, 0x7e, 0x87, 0x30, 0x27, 0x9a, 0x8d, 0x68, 0x90, 0x4a, 0x1a,
+	0x8a, 0x24, 0x65, 0x43, 0xb0, 0xac, 0x82, 0xe5, 0xb2, 0x91, 0x38, 0x56, 0xd2, 0xb0, 0x50, 0x6f,
+	0xca, 0xe0, 0x43, 0xb4, 0xfa, 0x6d, 0xcc, 0x61, 0x2c, 0x61, 0x49, 0xba, 0xf0, 0x54, 0x8a, 0x73,
+	0xaa, 0xa8, 0xfd, 0x8a, 0xf0, 0x93, 0x69, 0x30, 0x90, 0xba, 0xa6, 0x90, 0xb7, 0xc5, 0x42, 0xcc,
+	0xf0, 0xa6, 0x8d, 0x3d, 0x9a, 0xcc, 0xae, 0x58, 0x4e, 0x23, 0x2d, 0x7d, 0x03, 0x27, 0x7c, 0x8e,
+	0x5e, 0xe7, 0xfe, 0x31, 0x53, 0x5a, 0xc8, 0x71, 0x73, 0xd1, 0x78, 0x77, 0x9e, 0x19, 0xda, 0xa7,
+	0x98, 0xf0, 0xa8, 0xd4, 0xa7, 0x57, 0xd6, 0xe3, 0xc4, 0x5a, 0x74, 0x25, 0x72, 0x2d, 0x89, 0x3b,
+	0xa8, 0x4e, 0x39, 0x19, 0x0c, 0x69, 0x60, 0x3c, 0xcc, 0x89, 0x58, 0xea, 0xd7, 0x6c, 0xee, 0x73,
+	0x96, 0xc2, 0x1f, 0xd1, 0x46, 0x4a, 0x65, 0xc2, 0x94, 0x62, 0x82, 0x43, 0xc9, 0x2a, 0x90, 0x34,
+	0x02, 0x23, 0x09, 0x7d, 0x14, 0x1c, 0xba, 0x94, 0x29, 0x5a, 0x65, 0xa4, 0x5f, 0x20, 0xce, 0xaa,
+	0x4b, 0xf3, 0x2b, 0x95, 0xee, 0x15, 0xaa, 0x17, 0x77, 0xf7, 0xe2, 0x53, 0xb8, 0x8e, 0xdc, 0x98,
+	0xb2, 0x28, 0xd6, 0x70, 0x00, 0x9d, 0xed, 0x4a, 0x3f, 0x7f, 0x3b, 0x7e, 0x7f, 0xff, 0xa7, 0xed,
+	0x3c, 0x40, 0xfc, 0x86, 0xb8, 0xfb, 0xdb, 0x9e, 0x7b, 0x80, 0x78, 0x84, 0xb8, 0x7c, 0x13, 0x31,
+	0x1d, 0xdf, 0x0c, 0xe0, 0x3f, 0x89, 0x5f, 0xb8, 0x55, 0xdf, 0xf3, 0x8b, 0x67, 0x06, 0x34, 0x70,
+	0xcd, 0xa5, 0x39, 0xf8, 0x0f, 0x6c, 0x92, 0x0a, 0xb9, 0xe4, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -220,6 +303,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParamsHistory) > 0 {
+		for iNdEx := len(m.ParamsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DynamicPrecompiles) > 0 {
 		for iNdEx := len(m.DynamicPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DynamicPrecompiles[iNdEx])
@@ -322,6 +433,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -365,6 +514,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParamsHistory) > 0 {
+		for _, e := range m.ParamsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -383,6 +544,20 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *ParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -583,6 +758,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.DynamicPrecompiles = append(m.DynamicPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsHistory = append(m.ParamsHistory, ParamsChange{})
+			if err := m.ParamsHistory[len(m.ParamsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -694,6 +937,108 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,6 +34,8 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixParamsAuthority
+	prefixParamsHistory
 )
 
 // KVStore key prefixes
@@ -45,6 +47,8 @@ var (
 	KeyPrefixAllowance          = []byte{prefixAllowance}
 	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
 	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixParamsAuthority    = []byte{prefixParamsAuthority}
+	KeyPrefixParamsHistory      = []byte{prefixParamsHistory}
 )
 
 func AllowanceKey(
@@ -248,12 +248,12 @@ func (_m *EVMKeeper) GetParams(ctx types.Context) vmtypes.Params {
 	return r0
 }
 
-// IsAvailableStaticPrecompile provides a mock function with given fields: params, address
-func (_m *EVMKeeper) IsAvailableStaticPrecompile(params *vmtypes.Params, address common.Address) bool {
+// IsActiveStaticPrecompile provides a mock function with given fields: params, address
+func (_m *EVMKeeper) IsActiveStaticPrecompile(params *vmtypes.Params, address common.Address) bool {
 	ret := _m.Called(params, address)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsAvailableStaticPrecompile")
+		panic("no return value specified for IsActiveStaticPrecompile")
 	}
 
 	var r0 bool
@@ -5,6 +5,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	erc20api "github.com/cosmos/evm/api/cosmos/evm/erc20/v1"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -19,11 +20,13 @@ var (
 	_ sdk.Msg              = &MsgUpdateParams{}
 	_ sdk.Msg              = &MsgRegisterERC20{}
 	_ sdk.Msg              = &MsgToggleConversion{}
+	_ sdk.Msg              = &MsgUpdateParamsAuthorities{}
 	_ sdk.HasValidateBasic = &MsgConvertERC20{}
 	_ sdk.HasValidateBasic = &MsgConvertCoin{}
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgRegisterERC20{}
 	_ sdk.HasValidateBasic = &MsgToggleConversion{}
+	_ sdk.HasValidateBasic = &MsgUpdateParamsAuthorities{}
 )
 
 const (
@@ -97,6 +100,20 @@ func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
 
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParamsAuthorities) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+
+	return cosmosevmtypes.ValidateParamsAuthorities(m.ParamsAuthorities, &Params{})
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParamsAuthorities) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgRegisterERC20) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Signer)
@@ -6,8 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -313,6 +316,445 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryParamsAuthoritiesRequest defines the request type for querying the
+// accounts allowed to update a subset of the x/erc20 parameters.
+type QueryParamsAuthoritiesRequest struct {
+}
+
+func (m *QueryParamsAuthoritiesRequest) Reset()         { *m = QueryParamsAuthoritiesRequest{} }
+func (m *QueryParamsAuthoritiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAuthoritiesRequest) ProtoMessage()    {}
+func (*QueryParamsAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{6}
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAuthoritiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAuthoritiesRequest.Merge(m, src)
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAuthoritiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAuthoritiesRequest proto.InternalMessageInfo
+
+// QueryParamsAuthoritiesResponse defines the response type for querying the
+// accounts allowed to update a subset of the x/erc20 parameters.
+type QueryParamsAuthoritiesResponse struct {
+	// params_authorities are the accounts and the parameters they can update.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,1,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+}
+
+func (m *QueryParamsAuthoritiesResponse) Reset()         { *m = QueryParamsAuthoritiesResponse{} }
+func (m *QueryParamsAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAuthoritiesResponse) ProtoMessage()    {}
+func (*QueryParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{7}
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAuthoritiesResponse.Merge(m, src)
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAuthoritiesResponse proto.InternalMessageInfo
+
+func (m *QueryParamsAuthoritiesResponse) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+// QueryParamsAtHeightRequest defines the request type for querying the x/erc20
+// parameters in effect at a past height.
+type QueryParamsAtHeightRequest struct {
+	// height is the block height to query the parameters at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryParamsAtHeightRequest) Reset()         { *m = QueryParamsAtHeightRequest{} }
+func (m *QueryParamsAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAtHeightRequest) ProtoMessage()    {}
+func (*QueryParamsAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{8}
+}
+func (m *QueryParamsAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAtHeightRequest.Merge(m, src)
+}
+func (m *QueryParamsAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryParamsAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryParamsAtHeightResponse defines the response type for querying the
+// x/erc20 parameters in effect at a past height.
+type QueryParamsAtHeightResponse struct {
+	// params are the parameters in effect at the end of the queried block.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// change_height is the height of the parameter change in effect at the
+	// queried block.
+	ChangeHeight int64 `protobuf:"varint,2,opt,name=change_height,json=changeHeight,proto3" json:"change_height,omitempty"`
+}
+
+func (m *QueryParamsAtHeightResponse) Reset()         { *m = QueryParamsAtHeightResponse{} }
+func (m *QueryParamsAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAtHeightResponse) ProtoMessage()    {}
+func (*QueryParamsAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{9}
+}
+func (m *QueryParamsAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAtHeightResponse.Merge(m, src)
+}
+func (m *QueryParamsAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryParamsAtHeightResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryParamsAtHeightResponse) GetChangeHeight() int64 {
+	if m != nil {
+		return m.ChangeHeight
+	}
+	return 0
+}
+
+// QueryAccountRequest defines the request type for querying the unified view
+// of an account.
+type QueryAccountRequest struct {
+	// address is the bech32 or hex address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAccountRequest) Reset()         { *m = QueryAccountRequest{} }
+func (m *QueryAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountRequest) ProtoMessage()    {}
+func (*QueryAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{10}
+}
+func (m *QueryAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountRequest.Merge(m, src)
+}
+func (m *QueryAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountRequest proto.InternalMessageInfo
+
+func (m *QueryAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAccountResponse defines the response type for querying the unified
+// view of an account.
+type QueryAccountResponse struct {
+	// bech32_address is the bech32 address of the account.
+	Bech32Address string `protobuf:"bytes,1,opt,name=bech32_address,json=bech32Address,proto3" json:"bech32_address,omitempty"`
+	// hex_address is the EIP-55 checksummed hex address of the account.
+	HexAddress string `protobuf:"bytes,2,opt,name=hex_address,json=hexAddress,proto3" json:"hex_address,omitempty"`
+	// account_number is the number of the account, zero if it doesn't exist in
+	// the auth module.
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the Cosmos sequence of the account.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// nonce is the EVM nonce of the account.
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// balances are the bank balances of the account.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// erc20_balances are the non-zero balances of the account for the enabled
+	// token pairs.
+	Erc20Balances []TokenPairBalance `protobuf:"bytes,7,rep,name=erc20_balances,json=erc20Balances,proto3" json:"erc20_balances"`
+	// code_hash is the hex encoded hash of the account EVM code.
+	CodeHash string `protobuf:"bytes,8,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// is_contract is true if the account has EVM code.
+	IsContract bool `protobuf:"varint,9,opt,name=is_contract,json=isContract,proto3" json:"is_contract,omitempty"`
+	// is_module_account is true if the account is a module account.
+	IsModuleAccount bool `protobuf:"varint,10,opt,name=is_module_account,json=isModuleAccount,proto3" json:"is_module_account,omitempty"`
+	// is_vesting_account is true if the account is a vesting account.
+	IsVestingAccount bool `protobuf:"varint,11,opt,name=is_vesting_account,json=isVestingAccount,proto3" json:"is_vesting_account,omitempty"`
+}
+
+func (m *QueryAccountResponse) Reset()         { *m = QueryAccountResponse{} }
+func (m *QueryAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountResponse) ProtoMessage()    {}
+func (*QueryAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{11}
+}
+func (m *QueryAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountResponse.Merge(m, src)
+}
+func (m *QueryAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAccountResponse) GetBech32Address() string {
+	if m != nil {
+		return m.Bech32Address
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetHexAddress() string {
+	if m != nil {
+		return m.HexAddress
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *QueryAccountResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryAccountResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *QueryAccountResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *QueryAccountResponse) GetErc20Balances() []TokenPairBalance {
+	if m != nil {
+		return m.Erc20Balances
+	}
+	return nil
+}
+
+func (m *QueryAccountResponse) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *QueryAccountResponse) GetIsContract() bool {
+	if m != nil {
+		return m.IsContract
+	}
+	return false
+}
+
+func (m *QueryAccountResponse) GetIsModuleAccount() bool {
+	if m != nil {
+		return m.IsModuleAccount
+	}
+	return false
+}
+
+func (m *QueryAccountResponse) GetIsVestingAccount() bool {
+	if m != nil {
+		return m.IsVestingAccount
+	}
+	return false
+}
+
+// TokenPairBalance defines the balance of an account for a token pair.
+type TokenPairBalance struct {
+	// erc20_address is the hex address of the ERC20 contract of the token pair.
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// denom is the Cosmos coin denomination of the token pair.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// balance is the balance of the account for the token pair.
+	Balance string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *TokenPairBalance) Reset()         { *m = TokenPairBalance{} }
+func (m *TokenPairBalance) String() string { return proto.CompactTextString(m) }
+func (*TokenPairBalance) ProtoMessage()    {}
+func (*TokenPairBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1630a6677a16bf4, []int{12}
+}
+func (m *TokenPairBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairBalance.Merge(m, src)
+}
+func (m *TokenPairBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairBalance proto.InternalMessageInfo
+
+func (m *TokenPairBalance) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPairBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenPairBalance) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryTokenPairsRequest)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsRequest")
 	proto.RegisterType((*QueryTokenPairsResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairsResponse")
@@ -320,45 +762,85 @@ func init() {
 	proto.RegisterType((*QueryTokenPairResponse)(nil), "cosmos.evm.erc20.v1.QueryTokenPairResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.erc20.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.erc20.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsAuthoritiesRequest)(nil), "cosmos.evm.erc20.v1.QueryParamsAuthoritiesRequest")
+	proto.RegisterType((*QueryParamsAuthoritiesResponse)(nil), "cosmos.evm.erc20.v1.QueryParamsAuthoritiesResponse")
+	proto.RegisterType((*QueryParamsAtHeightRequest)(nil), "cosmos.evm.erc20.v1.QueryParamsAtHeightRequest")
+	proto.RegisterType((*QueryParamsAtHeightResponse)(nil), "cosmos.evm.erc20.v1.QueryParamsAtHeightResponse")
+	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.erc20.v1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.erc20.v1.QueryAccountResponse")
+	proto.RegisterType((*TokenPairBalance)(nil), "cosmos.evm.erc20.v1.TokenPairBalance")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/query.proto", fileDescriptor_f1630a6677a16bf4) }
 
 var fileDescriptor_f1630a6677a16bf4 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x2d, 0x2d, 0xe4, 0xe5, 0xe4, 0x34, 0x6a, 0x49, 0x75, 0x1b, 0xb7, 0x60, 0xc3,
-	0xc6, 0xce, 0x98, 0xf4, 0x5c, 0x0f, 0x3d, 0xa8, 0x78, 0x8a, 0x41, 0x2f, 0x5e, 0x74, 0x36, 0x0c,
-	0xeb, 0xa2, 0xbb, 0xb3, 0xdd, 0x99, 0x2c, 0x16, 0x11, 0xc4, 0x4f, 0xa0, 0x78, 0xf2, 0x1b, 0x78,
-	0x12, 0xcf, 0x7e, 0x82, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x5f, 0x43, 0x76, 0x66, 0xb2,
-	0xc9, 0xea, 0x4a, 0xe2, 0x25, 0xec, 0x3c, 0xfe, 0xef, 0xff, 0xff, 0xbd, 0x37, 0x43, 0x60, 0x77,
-	0x24, 0x64, 0x24, 0x24, 0xe5, 0x59, 0x44, 0x79, 0x3a, 0xea, 0xdf, 0xa4, 0x59, 0x8f, 0x9e, 0x8c,
-	0x79, 0x7a, 0x4a, 0x92, 0x54, 0x28, 0x81, 0xb7, 0x8c, 0x80, 0xf0, 0x2c, 0x22, 0x5a, 0x40, 0xb2,
-	0x5e, 0xeb, 0x02, 0x8b, 0xc2, 0x58, 0x50, 0xfd, 0x6b, 0x74, 0x2d, 0xcf, 0x1a, 0xf9, 0x4c, 0x72,
-	0x63, 0x40, 0xb3, 0x9e, 0xcf, 0x15, 0xeb, 0xd1, 0x84, 0x05, 0x61, 0xcc, 0x54, 0x28, 0x62, 0xab,
-	0xad, 0x0c, 0x35, 0xe6, 0x46, 0x70, 0xad, 0x4a, 0x10, 0xf0, 0x98, 0xcb, 0x50, 0x5a, 0x49, 0x33,
-	0x10, 0x81, 0xd0, 0x9f, 0x34, 0xff, 0xb2, 0xd5, 0x2b, 0x81, 0x10, 0xc1, 0x73, 0x4e, 0x59, 0x12,
-	0x52, 0x16, 0xc7, 0x42, 0xe9, 0x58, 0xdb, 0xe3, 0x3e, 0x81, 0x4b, 0xf7, 0x73, 0xb2, 0x07, 0xe2,
-	0x19, 0x8f, 0x07, 0x2c, 0x4c, 0xe5, 0x90, 0x9f, 0x8c, 0xb9, 0x54, 0xf8, 0x36, 0xc0, 0x9c, 0x72,
-	0x1b, 0xb5, 0x51, 0xa7, 0xd1, 0xbf, 0x4e, 0xec, 0xe8, 0xf9, 0x48, 0xc4, 0xec, 0xc4, 0x8e, 0x44,
-	0x06, 0x2c, 0xe0, 0xb6, 0x77, 0xb8, 0xd0, 0xe9, 0x7e, 0x42, 0x70, 0xf9, 0xaf, 0x08, 0x99, 0x88,
-	0x58, 0x72, 0x7c, 0x0f, 0x1a, 0x2a, 0xaf, 0x3e, 0x4e, 0xf2, 0xf2, 0x36, 0x6a, 0xaf, 0x77, 0x1a,
-	0x7d, 0x87, 0x54, 0xec, 0x97, 0x14, 0xdd, 0xc7, 0xf5, 0xb3, 0xef, 0xbb, 0xb5, 0x8f, 0xbf, 0x3e,
-	0x7b, 0x68, 0x08, 0xaa, 0xf0, 0xc4, 0x77, 0x4a, 0xbc, 0x6b, 0x9a, 0x77, 0x7f, 0x29, 0xaf, 0x01,
-	0x29, 0x01, 0x1f, 0xc0, 0xc5, 0x32, 0xef, 0x6c, 0x23, 0x4d, 0xd8, 0xd0, 0x79, 0x7a, 0x19, 0xf5,
-	0xa1, 0x39, 0xb8, 0xfe, 0x9f, 0x1b, 0x2c, 0xa6, 0xbb, 0x0b, 0x30, 0x9f, 0xce, 0x6e, 0xf0, 0x3f,
-	0x86, 0xab, 0x17, 0xc3, 0xb9, 0x4d, 0xc0, 0x3a, 0x63, 0xc0, 0x52, 0x16, 0xcd, 0x6e, 0xc8, 0x7d,
-	0x08, 0x5b, 0xa5, 0xaa, 0x8d, 0xbd, 0x05, 0x9b, 0x89, 0xae, 0xd8, 0xc8, 0x9d, 0xca, 0x48, 0xd3,
-	0xb4, 0x98, 0x67, 0xbb, 0xfa, 0x5f, 0xd6, 0x61, 0x43, 0xfb, 0xe2, 0x77, 0x08, 0x60, 0x7e, 0x6b,
-	0xb8, 0x5b, 0x69, 0x54, 0xfd, 0x7c, 0x5a, 0x37, 0x56, 0x13, 0x1b, 0x66, 0xb7, 0xf3, 0xe6, 0xeb,
-	0xcf, 0xf7, 0x6b, 0x2e, 0x6e, 0xd3, 0xaa, 0x67, 0xbe, 0xf0, 0x46, 0xf0, 0x07, 0x04, 0xf5, 0xc2,
-	0x00, 0x7b, 0x2b, 0xa4, 0xcc, 0x88, 0xba, 0x2b, 0x69, 0x2d, 0xd0, 0xa1, 0x06, 0x3a, 0xc0, 0xdd,
-	0x65, 0x40, 0xf4, 0xa5, 0x3e, 0x1c, 0x79, 0xde, 0x2b, 0xfc, 0x1a, 0xc1, 0xa6, 0xd9, 0x2b, 0xde,
-	0xff, 0x77, 0x58, 0xe9, 0x12, 0x5b, 0x9d, 0xe5, 0x42, 0x8b, 0xb4, 0xa7, 0x91, 0xae, 0xe2, 0x9d,
-	0x4a, 0x24, 0x73, 0x79, 0xc7, 0x47, 0x67, 0x13, 0x07, 0x9d, 0x4f, 0x1c, 0xf4, 0x63, 0xe2, 0xa0,
-	0xb7, 0x53, 0xa7, 0x76, 0x3e, 0x75, 0x6a, 0xdf, 0xa6, 0x4e, 0xed, 0xd1, 0x5e, 0x10, 0xaa, 0xa7,
-	0x63, 0x9f, 0x8c, 0x44, 0xb4, 0x68, 0xf0, 0xc2, 0x5a, 0xa8, 0xd3, 0x84, 0x4b, 0x7f, 0x53, 0xff,
-	0x2b, 0x1c, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xe7, 0x87, 0x8b, 0x04, 0x05, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xee, 0xe6, 0xc7, 0xb1, 0x4f, 0x9a, 0xd0, 0x4c, 0xd3, 0x62, 0x36, 0xd4, 0x2e, 0x9b, 0xb6,
+	0x49, 0x9d, 0x66, 0x37, 0x71, 0x80, 0xbb, 0x22, 0x25, 0x95, 0x20, 0xaa, 0x04, 0x0a, 0x16, 0x3f,
+	0x12, 0x17, 0xb8, 0xe3, 0xf5, 0x68, 0xbd, 0x6a, 0xbc, 0x63, 0x76, 0xd6, 0x51, 0xa3, 0x2a, 0x12,
+	0xea, 0x0b, 0x00, 0x42, 0x42, 0xe2, 0x0d, 0x50, 0x11, 0x88, 0xc7, 0xe8, 0x65, 0x25, 0x6e, 0xb8,
+	0x02, 0x04, 0x48, 0xbc, 0x06, 0xb3, 0x33, 0x67, 0x37, 0xbb, 0xce, 0x36, 0x76, 0x25, 0x2e, 0x36,
+	0xde, 0x39, 0xf3, 0xcd, 0xf9, 0xbe, 0xf3, 0x33, 0x67, 0x03, 0x75, 0x97, 0x8b, 0x3e, 0x17, 0x0e,
+	0x3b, 0xea, 0x3b, 0x2c, 0x74, 0x9b, 0x5b, 0xce, 0xd1, 0xb6, 0xf3, 0xc5, 0x90, 0x85, 0xc7, 0xf6,
+	0x20, 0xe4, 0x11, 0x27, 0x97, 0x35, 0xc0, 0x96, 0x00, 0x5b, 0x01, 0xec, 0xa3, 0x6d, 0x73, 0x89,
+	0xf6, 0xfd, 0x80, 0x3b, 0xea, 0xaf, 0xc6, 0x99, 0x0d, 0x74, 0xd4, 0xa1, 0x82, 0x69, 0x07, 0xd2,
+	0x53, 0x87, 0x45, 0x74, 0xdb, 0x19, 0x50, 0xcf, 0x0f, 0x68, 0xe4, 0xf3, 0x00, 0xb1, 0xb5, 0x2c,
+	0x36, 0x41, 0xb9, 0xdc, 0x4f, 0xf6, 0x0b, 0x45, 0x69, 0x72, 0x0d, 0x78, 0xa3, 0x08, 0xe0, 0xb1,
+	0x80, 0x09, 0x5f, 0x8c, 0xe8, 0x89, 0x21, 0xd1, 0xf1, 0x80, 0x89, 0x18, 0x32, 0xa0, 0x21, 0xed,
+	0x8b, 0x36, 0x1d, 0x46, 0x3d, 0x1e, 0xfa, 0x11, 0xc6, 0x68, 0x2e, 0x7b, 0xdc, 0xe3, 0xea, 0xd5,
+	0x89, 0xdf, 0xd0, 0xfa, 0xba, 0xc7, 0xb9, 0x77, 0xc8, 0x1c, 0x3a, 0xf0, 0x1d, 0x1a, 0x04, 0x3c,
+	0x52, 0x21, 0xa0, 0x7f, 0xeb, 0x01, 0x5c, 0xfd, 0x30, 0x8e, 0xf2, 0x23, 0xfe, 0x90, 0x05, 0x07,
+	0xd4, 0x0f, 0x45, 0x8b, 0xc9, 0xb0, 0x45, 0x44, 0xde, 0x05, 0x38, 0x8d, 0xb8, 0x6a, 0x5c, 0x37,
+	0xd6, 0xe7, 0x9b, 0xb7, 0x6c, 0x4c, 0x63, 0x1c, 0xb2, 0xad, 0xf3, 0x8b, 0x81, 0xdb, 0x07, 0xd4,
+	0x63, 0x78, 0xb6, 0x95, 0x39, 0x69, 0xfd, 0x6c, 0xc0, 0xab, 0x67, 0x28, 0xc4, 0x40, 0x4a, 0x60,
+	0xe4, 0x3e, 0xcc, 0x47, 0xb1, 0xb5, 0x3d, 0x88, 0xcd, 0x92, 0x64, 0x5a, 0x92, 0xd4, 0xec, 0x82,
+	0x5a, 0xd9, 0xe9, 0xe9, 0xbd, 0xca, 0xb3, 0xdf, 0xeb, 0x17, 0x7e, 0xf8, 0xf7, 0x97, 0x86, 0xd1,
+	0x82, 0x28, 0xf5, 0x49, 0xde, 0xcb, 0xe9, 0x9d, 0x52, 0x7a, 0xd7, 0xc6, 0xea, 0xd5, 0x42, 0x72,
+	0x82, 0x37, 0xe1, 0x4a, 0x5e, 0x6f, 0x92, 0x91, 0x65, 0x98, 0x55, 0x7c, 0x2a, 0x19, 0x95, 0x96,
+	0x5e, 0x58, 0x9d, 0xd1, 0x0c, 0xa6, 0xd1, 0xed, 0x03, 0x9c, 0x46, 0x87, 0x19, 0x7c, 0x89, 0xe0,
+	0x2a, 0x69, 0x70, 0xd6, 0x32, 0x10, 0xc5, 0x71, 0xa0, 0x0a, 0x8f, 0x7a, 0xac, 0x8f, 0xe1, 0x72,
+	0xce, 0x8a, 0xb4, 0xef, 0x40, 0x49, 0x37, 0x08, 0x52, 0xae, 0x14, 0x52, 0xea, 0x43, 0x59, 0x3e,
+	0x3c, 0x65, 0xd5, 0xe1, 0x5a, 0xc6, 0xed, 0x2e, 0x36, 0x99, 0xcf, 0x52, 0xde, 0x2f, 0x0d, 0xa8,
+	0xbd, 0x08, 0x81, 0x1a, 0x3e, 0x07, 0x32, 0xd2, 0xa4, 0x72, 0x17, 0xeb, 0x7b, 0x23, 0xab, 0x47,
+	0xf5, 0xf4, 0xa9, 0x9e, 0xc4, 0xd7, 0x71, 0x56, 0xd8, 0xd2, 0x60, 0x94, 0xc7, 0x7a, 0x13, 0xcc,
+	0xac, 0x82, 0x68, 0x9f, 0xf9, 0x5e, 0x2f, 0x4a, 0x0a, 0x75, 0x15, 0x4a, 0x3d, 0x65, 0x50, 0x19,
+	0x98, 0x6e, 0xe1, 0xca, 0x7a, 0x62, 0xc0, 0x4a, 0xe1, 0xb1, 0xff, 0x27, 0x73, 0x64, 0x15, 0x16,
+	0xdc, 0x1e, 0x0d, 0x3c, 0xd6, 0x46, 0xfa, 0x29, 0x45, 0x7f, 0x51, 0x1b, 0x35, 0x99, 0xe5, 0x60,
+	0xd5, 0x76, 0x5d, 0x97, 0x0f, 0x83, 0x54, 0x73, 0x15, 0xe6, 0x68, 0xb7, 0x1b, 0x32, 0x21, 0xb0,
+	0xbd, 0x92, 0xa5, 0xf5, 0xdd, 0x0c, 0x2c, 0xe7, 0x4f, 0xa0, 0xdc, 0x9b, 0xb0, 0xd8, 0x61, 0x6e,
+	0x6f, 0xa7, 0xd9, 0xce, 0x9f, 0x5c, 0xd0, 0xd6, 0x5d, 0x6d, 0x24, 0x75, 0x98, 0xef, 0xb1, 0x47,
+	0x29, 0x66, 0x4a, 0x61, 0x40, 0x9a, 0x12, 0x80, 0xf4, 0x43, 0xb5, 0xeb, 0x76, 0x30, 0xec, 0x77,
+	0x58, 0x58, 0x9d, 0x96, 0x98, 0x99, 0xd6, 0x02, 0x5a, 0x3f, 0x50, 0x46, 0x62, 0x42, 0x59, 0xc4,
+	0x62, 0x03, 0x97, 0x55, 0x67, 0x14, 0x20, 0x5d, 0xc7, 0x57, 0x23, 0xe0, 0xf1, 0xc6, 0xac, 0xda,
+	0xd0, 0x0b, 0x72, 0x08, 0xe5, 0x0e, 0x3d, 0xa4, 0xf2, 0x55, 0x54, 0x4b, 0xaa, 0xf6, 0xaf, 0xe5,
+	0x2e, 0x64, 0x72, 0x15, 0xef, 0xc9, 0x99, 0xb9, 0xf7, 0x56, 0x9c, 0xcf, 0xa7, 0x7f, 0xd4, 0xd7,
+	0x3d, 0x3f, 0xea, 0x0d, 0x3b, 0x12, 0xd8, 0x77, 0x70, 0xf8, 0xe9, 0x9f, 0x4d, 0xd1, 0x7d, 0xa8,
+	0x67, 0xa0, 0x3a, 0x20, 0x74, 0xee, 0x53, 0x06, 0xf2, 0x29, 0x2c, 0xaa, 0x1a, 0xb5, 0x53, 0xce,
+	0x39, 0xc5, 0x79, 0x73, 0xcc, 0x95, 0xd3, 0xe8, 0x6c, 0x3d, 0x17, 0x14, 0x6a, 0x2f, 0x71, 0xbc,
+	0x02, 0x15, 0x97, 0x77, 0x65, 0x51, 0xa9, 0xe8, 0x55, 0xcb, 0x2a, 0x7d, 0xe5, 0xd8, 0xb0, 0x2f,
+	0xd7, 0x71, 0x76, 0x7d, 0xd1, 0x76, 0x79, 0x10, 0x85, 0xd4, 0x8d, 0xaa, 0x15, 0xb9, 0x5d, 0x6e,
+	0x81, 0x2f, 0xee, 0xa1, 0x85, 0x34, 0x60, 0x49, 0x02, 0xfa, 0xbc, 0x3b, 0x3c, 0x64, 0x6d, 0xcc,
+	0x68, 0x15, 0x14, 0xec, 0x15, 0x5f, 0xbc, 0xaf, 0xec, 0x58, 0x59, 0x72, 0x07, 0x88, 0xc4, 0x1e,
+	0xc9, 0x7e, 0xf0, 0x03, 0x2f, 0x05, 0xcf, 0x2b, 0xf0, 0x25, 0x5f, 0x7c, 0xa2, 0x37, 0x10, 0x6d,
+	0x79, 0x70, 0x69, 0x34, 0x8a, 0xb8, 0x05, 0x75, 0x12, 0xf2, 0x2d, 0x71, 0x51, 0x19, 0x93, 0x82,
+	0xcb, 0x6a, 0x75, 0x59, 0xc0, 0xfb, 0xd8, 0x0b, 0x7a, 0x11, 0x77, 0x20, 0x66, 0x4e, 0xd5, 0x5f,
+	0x76, 0x20, 0x2e, 0x9b, 0x4f, 0xe7, 0x60, 0x56, 0x75, 0x20, 0xf9, 0xc6, 0x00, 0x38, 0x9d, 0xe3,
+	0x64, 0xa3, 0x30, 0xb5, 0xc5, 0x1f, 0x14, 0xf3, 0xce, 0x64, 0x60, 0xdd, 0xdc, 0xd6, 0xfa, 0x93,
+	0x5f, 0xff, 0xf9, 0x76, 0xca, 0x22, 0xd7, 0x9d, 0xa2, 0x8f, 0x64, 0xe6, 0xab, 0x41, 0xbe, 0x37,
+	0xa0, 0x92, 0x3a, 0x20, 0x8d, 0x09, 0x58, 0x12, 0x45, 0x1b, 0x13, 0x61, 0x51, 0xd0, 0x8e, 0x12,
+	0xb4, 0x49, 0x36, 0xc6, 0x09, 0x72, 0x1e, 0xab, 0xc5, 0xdd, 0x46, 0xe3, 0x84, 0xc8, 0x51, 0x59,
+	0xd2, 0xf3, 0x82, 0xac, 0xbd, 0x98, 0x2c, 0x37, 0xd6, 0xcd, 0xf5, 0xf1, 0x40, 0x94, 0xb4, 0xaa,
+	0x24, 0x5d, 0x23, 0x2b, 0x85, 0x92, 0x70, 0x28, 0xfd, 0x64, 0xc0, 0xd2, 0x99, 0x41, 0x4d, 0x9a,
+	0xe3, 0x48, 0xce, 0xce, 0x7d, 0x73, 0xe7, 0xa5, 0xce, 0xa0, 0x46, 0x47, 0x69, 0xbc, 0x4d, 0xd6,
+	0xce, 0xd1, 0x98, 0xfd, 0x48, 0x90, 0x1f, 0x0d, 0x58, 0xcc, 0xcf, 0x67, 0xe2, 0x8c, 0x25, 0xce,
+	0x7f, 0x00, 0xcc, 0xad, 0xc9, 0x0f, 0xa0, 0xcc, 0xb7, 0x95, 0xcc, 0x2d, 0x62, 0x9f, 0x2b, 0x33,
+	0xc2, 0xc1, 0xee, 0x3c, 0xd6, 0xbf, 0x27, 0xe4, 0x2b, 0x03, 0xe6, 0x92, 0xdb, 0x7b, 0x4e, 0xe1,
+	0xf2, 0xc3, 0xde, 0xbc, 0x3d, 0x01, 0x72, 0xa2, 0xfc, 0xe1, 0x88, 0x90, 0x3d, 0x87, 0xf7, 0xfd,
+	0x64, 0xef, 0xee, 0xb3, 0xbf, 0x6a, 0xc6, 0x73, 0xf9, 0xfc, 0x29, 0x9f, 0xaf, 0xff, 0xae, 0x5d,
+	0x78, 0x2e, 0x9f, 0xdf, 0xe4, 0xf3, 0xd9, 0xea, 0xd9, 0xc9, 0x1a, 0x3b, 0x7b, 0x84, 0xee, 0xd4,
+	0x68, 0xed, 0x94, 0xd4, 0xff, 0x85, 0x3b, 0xff, 0x01, 0xe1, 0x17, 0x6e, 0xfa, 0x52, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -379,6 +861,16 @@ type QueryClient interface {
 	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/erc20 module parameters.
+	ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/erc20 module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
+	// Account queries a unified view of an account: its bech32 and hex
+	// addresses, Cosmos sequence and EVM nonce, bank and ERC20 token pair
+	// balances, code hash and account type.
+	Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error)
 }
 
 type queryClient struct {
@@ -416,6 +908,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error) {
+	out := new(QueryParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/ParamsAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/ParamsAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Account(ctx context.Context, in *QueryAccountRequest, opts ...grpc.CallOption) (*QueryAccountResponse, error) {
+	out := new(QueryAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Query/Account", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// TokenPairs retrieves registered token pairs (mappings)x
@@ -424,6 +943,16 @@ type QueryServer interface {
 	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
 	// Params retrieves the erc20 module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/erc20 module parameters.
+	ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/erc20 module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
+	// Account queries a unified view of an account: its bech32 and hex
+	// addresses, Cosmos sequence and EVM nonce, bank and ERC20 token pair
+	// balances, code hash and account type.
+	Account(context.Context, *QueryAccountRequest) (*QueryAccountResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -439,6 +968,16 @@ func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenP
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ParamsAuthorities(ctx context.Context, req *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAuthorities not implemented")
+}
+func (*UnimplementedQueryServer) ParamsAtHeight(ctx context.Context, req *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
+
+func (*UnimplementedQueryServer) Account(ctx context.Context, req *QueryAccountRequest) (*QueryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -498,7 +1037,61 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
+func _Query_ParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/ParamsAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAuthorities(ctx, req.(*QueryParamsAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/ParamsAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Account_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Account(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Query/Account",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Account(ctx, req.(*QueryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.erc20.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -514,6 +1107,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ParamsAuthorities",
+			Handler:    _Query_ParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
+		{
+			MethodName: "Account",
+			Handler:    _Query_Account_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/query.proto",
@@ -722,133 +1327,1292 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsAuthoritiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryParamsAuthoritiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAuthoritiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TokenPairs) > 0 {
-		for _, e := range m.TokenPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.ChangeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChangeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *QueryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsVestingAccount {
+		i--
+		if m.IsVestingAccount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.IsModuleAccount {
+		i--
+		if m.IsModuleAccount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IsContract {
+		i--
+		if m.IsContract {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Erc20Balances) > 0 {
+		for iNdEx := len(m.Erc20Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HexAddress) > 0 {
+		i -= len(m.HexAddress)
+		copy(dAtA[i:], m.HexAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HexAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bech32Address) > 0 {
+		i -= len(m.Bech32Address)
+		copy(dAtA[i:], m.Bech32Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bech32Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsAuthoritiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryParamsAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ChangeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ChangeHeight))
+	}
+	return n
+}
+
+func (m *QueryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bech32Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HexAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Erc20Balances) > 0 {
+		for _, e := range m.Erc20Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsContract {
+		n += 2
+	}
+	if m.IsModuleAccount {
+		n += 2
+	}
+	if m.IsVestingAccount {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenPairBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAuthoritiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -873,12 +2637,110 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeHeight", wireType)
+			}
+			m.ChangeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -901,7 +2763,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,15 +2786,136 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HexAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HexAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -959,14 +2942,14 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, types1.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -993,66 +2976,14 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20Balances = append(m.Erc20Balances, TokenPairBalance{})
+			if err := m.Erc20Balances[len(m.Erc20Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1080,8 +3011,68 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsContract", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsContract = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsModuleAccount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsModuleAccount = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVestingAccount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVestingAccount = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1103,7 +3094,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenPairBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,17 +3117,17 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPairBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPairBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1146,130 +3137,61 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1279,24 +3201,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferRestrictionFn is run before the transfers executed through the ERC20
+// precompiles of the token pairs. Applications can use it to enforce compliance
+// rules, e.g. sanctions lists or per-denom transfer restrictions. It returns an
+// error to deny the transfer.
+//
+// The spender is the caller of the precompile, i.e. the sender of the transfer
+// for the transfer method or the approved spender for the transferFrom method.
+type TransferRestrictionFn func(ctx sdk.Context, pair TokenPair, spender, from, to common.Address, amount *big.Int) error
+
+// NoOpTransferRestrictionFn is a TransferRestrictionFn allowing all the transfers.
+func NoOpTransferRestrictionFn(_ sdk.Context, _ TokenPair, _, _, _ common.Address, _ *big.Int) error {
+	return nil
+}
+
+// Then creates a composite restriction that runs this one then the provided one.
+// The first restriction denying the transfer stops the chain.
+func (r TransferRestrictionFn) Then(second TransferRestrictionFn) TransferRestrictionFn {
+	return ComposeTransferRestrictions(r, second)
+}
+
+// ComposeTransferRestrictions combines multiple restrictions into one that runs
+// them in order, skipping the nil ones. It returns nil if there are none.
+func ComposeTransferRestrictions(restrictions ...TransferRestrictionFn) TransferRestrictionFn {
+	toRun := make([]TransferRestrictionFn, 0, len(restrictions))
+	for _, r := range restrictions {
+		if r != nil {
+			toRun = append(toRun, r)
+		}
+	}
+
+	switch len(toRun) {
+	case 0:
+		return nil
+	case 1:
+		return toRun[0]
+	}
+
+	return func(ctx sdk.Context, pair TokenPair, spender, from, to common.Address, amount *big.Int) error {
+		for _, r := range toRun {
+			if err := r(ctx, pair, spender, from, to, amount); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
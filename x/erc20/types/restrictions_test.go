@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestComposeTransferRestrictions(t *testing.T) {
+	var calls []string
+	restriction := func(name string, err error) types.TransferRestrictionFn {
+		return func(_ sdk.Context, _ types.TokenPair, _, _, _ common.Address, _ *big.Int) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	errDenied := errors.New("denied")
+
+	testCases := []struct {
+		name         string
+		restrictions []types.TransferRestrictionFn
+		expNil       bool
+		expCalls     []string
+		expErr       error
+	}{
+		{
+			name:   "no restrictions",
+			expNil: true,
+		},
+		{
+			name:         "only nil restrictions",
+			restrictions: []types.TransferRestrictionFn{nil, nil},
+			expNil:       true,
+		},
+		{
+			name:         "all restrictions allow the transfer",
+			restrictions: []types.TransferRestrictionFn{restriction("a", nil), nil, restriction("b", nil)},
+			expCalls:     []string{"a", "b"},
+		},
+		{
+			name:         "the first restriction denying the transfer stops the chain",
+			restrictions: []types.TransferRestrictionFn{restriction("a", nil), restriction("b", errDenied), restriction("c", nil)},
+			expCalls:     []string{"a", "b"},
+			expErr:       errDenied,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			fn := types.ComposeTransferRestrictions(tc.restrictions...)
+			if tc.expNil {
+				require.Nil(t, fn)
+				return
+			}
+
+			err := fn(sdk.Context{}, types.TokenPair{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(1))
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
+
+func TestTransferRestrictionFnThen(t *testing.T) {
+	var calls []string
+	first := types.TransferRestrictionFn(func(_ sdk.Context, _ types.TokenPair, _, _, _ common.Address, _ *big.Int) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	second := func(_ sdk.Context, _ types.TokenPair, _, _, _ common.Address, _ *big.Int) error {
+		calls = append(calls, "second")
+		return nil
+	}
+
+	err := first.Then(second)(sdk.Context{}, types.TokenPair{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second"}, calls)
+}
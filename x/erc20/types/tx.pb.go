@@ -11,6 +11,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -527,6 +528,100 @@ func (m *MsgToggleConversionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgToggleConversionResponse proto.InternalMessageInfo
 
+// MsgUpdateParamsAuthorities defines a Msg for updating the accounts allowed to
+// update a subset of the x/erc20 module parameters.
+type MsgUpdateParamsAuthorities struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params_authorities defines the accounts replacing the current ones.
+	ParamsAuthorities []types1.ParamsAuthority `protobuf:"bytes,2,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+}
+
+func (m *MsgUpdateParamsAuthorities) Reset()         { *m = MsgUpdateParamsAuthorities{} }
+func (m *MsgUpdateParamsAuthorities) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsAuthorities) ProtoMessage()    {}
+func (*MsgUpdateParamsAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{10}
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsAuthorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsAuthorities.Merge(m, src)
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsAuthorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsAuthorities proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsAuthorities) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParamsAuthorities) GetParamsAuthorities() []types1.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+// MsgUpdateParamsAuthoritiesResponse defines the response structure for
+// executing a MsgUpdateParamsAuthorities message.
+type MsgUpdateParamsAuthoritiesResponse struct {
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Reset()         { *m = MsgUpdateParamsAuthoritiesResponse{} }
+func (m *MsgUpdateParamsAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsAuthoritiesResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e06c8e6992ada536, []int{11}
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertERC20)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20")
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "cosmos.evm.erc20.v1.MsgConvertERC20Response")
@@ -538,62 +633,70 @@ func init() {
 	proto.RegisterType((*MsgRegisterERC20Response)(nil), "cosmos.evm.erc20.v1.MsgRegisterERC20Response")
 	proto.RegisterType((*MsgToggleConversion)(nil), "cosmos.evm.erc20.v1.MsgToggleConversion")
 	proto.RegisterType((*MsgToggleConversionResponse)(nil), "cosmos.evm.erc20.v1.MsgToggleConversionResponse")
+	proto.RegisterType((*MsgUpdateParamsAuthorities)(nil), "cosmos.evm.erc20.v1.MsgUpdateParamsAuthorities")
+	proto.RegisterType((*MsgUpdateParamsAuthoritiesResponse)(nil), "cosmos.evm.erc20.v1.MsgUpdateParamsAuthoritiesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/tx.proto", fileDescriptor_e06c8e6992ada536) }
 
 var fileDescriptor_e06c8e6992ada536 = []byte{
-	// 796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xee, 0x42, 0x69, 0xbe, 0x0e, 0x7c, 0xc0, 0xb7, 0xf0, 0x41, 0x59, 0xf8, 0xca, 0xe7, 0xf2,
-	0x43, 0xac, 0xb0, 0xdb, 0x16, 0x35, 0xb1, 0x89, 0x26, 0x96, 0x78, 0xf0, 0xd0, 0xc4, 0xac, 0x7a,
-	0xf1, 0x42, 0xb6, 0xdb, 0xc9, 0xb0, 0x81, 0x9d, 0x69, 0x76, 0x86, 0x06, 0x6e, 0x86, 0xa3, 0x89,
-	0x89, 0xc6, 0xbb, 0x89, 0x37, 0x8f, 0x1c, 0xfc, 0x03, 0x3c, 0x19, 0x8e, 0x44, 0x2f, 0xc6, 0x03,
-	0x31, 0x60, 0xc2, 0xc1, 0x9b, 0x7f, 0x81, 0xd9, 0x99, 0xe9, 0xb2, 0x5d, 0xb6, 0xb6, 0xf1, 0xd2,
-	0x74, 0xde, 0xf7, 0x79, 0xe7, 0x7d, 0x9e, 0xf7, 0xc7, 0x2c, 0x98, 0x73, 0x08, 0xf5, 0x08, 0x35,
-	0x61, 0xcb, 0x33, 0xa1, 0xef, 0x94, 0x8b, 0x66, 0xab, 0x64, 0xb2, 0x3d, 0xa3, 0xe9, 0x13, 0x46,
-	0xd4, 0x09, 0xe1, 0x35, 0x60, 0xcb, 0x33, 0xb8, 0xd7, 0x68, 0x95, 0xb4, 0x7f, 0x6c, 0xcf, 0xc5,
-	0xc4, 0xe4, 0xbf, 0x02, 0xa7, 0xe5, 0xe5, 0x2d, 0x75, 0x9b, 0x42, 0xb3, 0x55, 0xaa, 0x43, 0x66,
-	0x97, 0x4c, 0x87, 0xb8, 0x58, 0xfa, 0xaf, 0x24, 0x65, 0x41, 0x10, 0x43, 0xea, 0x52, 0x09, 0x99,
-	0x96, 0x10, 0x8f, 0xa2, 0xc0, 0xe9, 0x51, 0x24, 0x1d, 0x33, 0xc2, 0xb1, 0xc9, 0x4f, 0xa6, 0x24,
-	0x24, 0x5c, 0x93, 0x88, 0x20, 0x22, 0xec, 0xc1, 0x3f, 0x69, 0x9d, 0x43, 0x84, 0xa0, 0x1d, 0x68,
-	0xda, 0x4d, 0xd7, 0xb4, 0x31, 0x26, 0xcc, 0x66, 0x2e, 0xc1, 0x32, 0x46, 0xff, 0xa9, 0x80, 0xb1,
-	0x1a, 0x45, 0x1b, 0x04, 0xb7, 0xa0, 0xcf, 0xee, 0x5b, 0x1b, 0xe5, 0xa2, 0x7a, 0x0d, 0x8c, 0x3b,
-	0x04, 0x33, 0xdf, 0x76, 0xd8, 0xa6, 0xdd, 0x68, 0xf8, 0x90, 0xd2, 0x9c, 0xf2, 0xbf, 0xb2, 0x92,
-	0xb5, 0xc6, 0xda, 0xf6, 0x7b, 0xc2, 0xac, 0x56, 0x40, 0xc6, 0xf6, 0xc8, 0x2e, 0x66, 0xb9, 0x81,
-	0x00, 0x50, 0xd5, 0x8f, 0x4e, 0xe6, 0x53, 0x5f, 0x4f, 0xe6, 0xff, 0x15, 0xc4, 0x68, 0x63, 0xdb,
-	0x70, 0x89, 0xe9, 0xd9, 0x6c, 0xcb, 0x78, 0x80, 0xd9, 0xbb, 0xf3, 0xc3, 0x82, 0x62, 0xc9, 0x08,
-	0xf5, 0x06, 0xf8, 0xcb, 0x87, 0x0e, 0x74, 0x5b, 0xd0, 0xcf, 0x0d, 0xf2, 0xe8, 0xdc, 0xa7, 0xf7,
-	0x6b, 0x93, 0x52, 0x92, 0xcc, 0xf0, 0x88, 0xf9, 0x2e, 0x46, 0x56, 0x88, 0x54, 0xa7, 0x40, 0x86,
-	0x42, 0xdc, 0x80, 0x7e, 0x2e, 0xcd, 0x29, 0xc9, 0x53, 0xa5, 0x70, 0x70, 0x7e, 0x58, 0x90, 0x87,
-	0xe7, 0xe7, 0x87, 0x05, 0x2d, 0x52, 0xe3, 0x98, 0x40, 0x7d, 0x06, 0x4c, 0xc7, 0x4c, 0x16, 0xa4,
-	0x4d, 0x82, 0x29, 0xd4, 0x3f, 0x2a, 0x60, 0xf4, 0xc2, 0xb7, 0x41, 0x5c, 0xac, 0xae, 0x83, 0x74,
-	0xd0, 0x3b, 0x5e, 0x82, 0xe1, 0xf2, 0x8c, 0x21, 0x09, 0x06, 0xcd, 0x35, 0x64, 0x73, 0x8d, 0x00,
-	0x58, 0x4d, 0x07, 0xe2, 0x2d, 0x0e, 0x56, 0xb5, 0x88, 0x38, 0x5e, 0x9a, 0x88, 0x84, 0x62, 0x28,
-	0xa1, 0x97, 0xec, 0xb6, 0xb8, 0x52, 0x4c, 0x5c, 0x74, 0x80, 0xf6, 0xe4, 0x08, 0x75, 0xb2, 0xd6,
-	0x73, 0x60, 0xaa, 0xd3, 0x12, 0x4a, 0xfc, 0x20, 0x5a, 0xfe, 0xa4, 0xd9, 0xb0, 0x19, 0x7c, 0x68,
-	0xfb, 0xb6, 0x47, 0xd5, 0x5b, 0x20, 0x6b, 0xef, 0xb2, 0x2d, 0xe2, 0xbb, 0x6c, 0x5f, 0xf4, 0xfa,
-	0x37, 0xac, 0x2e, 0xa0, 0xea, 0x5d, 0x90, 0x69, 0xf2, 0x1b, 0xb8, 0xc8, 0xe1, 0xf2, 0xac, 0x91,
-	0xb0, 0x22, 0x86, 0x48, 0x52, 0xcd, 0x06, 0xf5, 0x91, 0x33, 0x20, 0xa2, 0x2a, 0x37, 0x03, 0x61,
-	0x17, 0xf7, 0x05, 0xda, 0xf4, 0x64, 0x6d, 0x51, 0xba, 0xb2, 0x81, 0x51, 0x53, 0xa8, 0xee, 0xad,
-	0x02, 0xc6, 0x6b, 0x14, 0x59, 0x10, 0xb9, 0x94, 0x41, 0x5f, 0x4c, 0x74, 0x50, 0x71, 0x17, 0x61,
-	0xe8, 0xf7, 0xd4, 0x26, 0x71, 0xea, 0x32, 0x18, 0xe5, 0xa9, 0xe5, 0xfc, 0xc3, 0x40, 0xe0, 0xe0,
-	0x4a, 0xd6, 0x8a, 0x59, 0x2b, 0xeb, 0xa2, 0x33, 0x3c, 0x28, 0x60, 0xbf, 0x90, 0xcc, 0xbe, 0x83,
-	0x8e, 0xae, 0x81, 0x5c, 0xdc, 0x16, 0xf2, 0x7f, 0xa3, 0x80, 0x89, 0x1a, 0x45, 0x8f, 0x09, 0x42,
-	0x3b, 0x50, 0xb4, 0x8f, 0xba, 0x04, 0xff, 0x71, 0x87, 0x26, 0xc1, 0x10, 0x23, 0xdb, 0x10, 0xcb,
-	0x29, 0x14, 0x87, 0xca, 0xed, 0xcb, 0x75, 0x5f, 0x4e, 0x66, 0x1e, 0x27, 0xa2, 0xff, 0x07, 0x66,
-	0x13, 0xcc, 0x6d, 0xfe, 0xe5, 0x1f, 0x69, 0x30, 0x58, 0xa3, 0x48, 0x7d, 0xa5, 0x80, 0x91, 0x8e,
-	0x57, 0x65, 0x31, 0x71, 0x34, 0x62, 0x7b, 0xa8, 0xad, 0xf6, 0x83, 0x0a, 0x8b, 0xb5, 0x76, 0xf0,
-	0xf9, 0xfb, 0xeb, 0x81, 0xab, 0xea, 0x92, 0x99, 0xfc, 0x6e, 0x9b, 0x8e, 0x88, 0xda, 0xe4, 0x36,
-	0xf5, 0x85, 0x02, 0x86, 0xa3, 0x9b, 0xbd, 0xd0, 0x23, 0x59, 0x00, 0xd2, 0xae, 0xf7, 0x01, 0x0a,
-	0x09, 0xad, 0x72, 0x42, 0xcb, 0xea, 0x62, 0x2f, 0x42, 0xfc, 0x91, 0xa8, 0x83, 0x91, 0x8e, 0x2d,
-	0xec, 0x5a, 0xa2, 0x28, 0xaa, 0x7b, 0x89, 0x92, 0xf6, 0x41, 0x85, 0xe0, 0xef, 0xce, 0x5d, 0x58,
-	0xea, 0x16, 0xde, 0x01, 0xd3, 0xd6, 0xfa, 0x82, 0x85, 0x69, 0x30, 0x18, 0xbf, 0x34, 0xb2, 0x2b,
-	0xdd, 0xae, 0x88, 0x23, 0xb5, 0x62, 0xbf, 0xc8, 0x76, 0x3e, 0x6d, 0xe8, 0x59, 0xf0, 0x8e, 0x54,
-	0xef, 0x1c, 0x9d, 0xe6, 0x95, 0xe3, 0xd3, 0xbc, 0xf2, 0xed, 0x34, 0xaf, 0xbc, 0x3c, 0xcb, 0xa7,
-	0x8e, 0xcf, 0xf2, 0xa9, 0x2f, 0x67, 0xf9, 0xd4, 0xd3, 0x05, 0xe4, 0xb2, 0xad, 0xdd, 0xba, 0xe1,
-	0x10, 0xcf, 0x4c, 0x98, 0x6c, 0xb6, 0xdf, 0x84, 0xb4, 0x9e, 0xe1, 0x1f, 0xc1, 0xf5, 0x5f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x3b, 0x2d, 0x64, 0x53, 0xf7, 0x07, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x36, 0x89, 0x85, 0xc7, 0xa5, 0x4d, 0xa7, 0xa1, 0x71, 0xb6, 0xc5, 0x85, 0x8d, 0x1b,
+	0x82, 0xa9, 0x77, 0x6d, 0x87, 0x1f, 0xc2, 0x12, 0x88, 0x3a, 0xe2, 0xc0, 0xc1, 0x12, 0x5a, 0xe0,
+	0xc2, 0x01, 0x6b, 0xbd, 0x1e, 0x4d, 0x46, 0xed, 0xce, 0x58, 0x3b, 0x13, 0xab, 0xb9, 0xa1, 0x72,
+	0x43, 0x42, 0x02, 0x71, 0x47, 0xe2, 0xc6, 0x31, 0x07, 0xfe, 0x00, 0x4e, 0xa8, 0xc7, 0x0a, 0x2e,
+	0x88, 0x43, 0x84, 0x12, 0xa4, 0x9c, 0xe1, 0xc0, 0x19, 0xcd, 0x0f, 0xaf, 0xd7, 0x9b, 0x35, 0x36,
+	0xb9, 0x44, 0xd9, 0x37, 0xdf, 0x9b, 0xf7, 0x7d, 0xef, 0x7d, 0xf3, 0x12, 0x70, 0x27, 0x64, 0x3c,
+	0x62, 0xdc, 0x43, 0xe3, 0xc8, 0x43, 0x71, 0xd8, 0x6e, 0x7a, 0xe3, 0x96, 0x27, 0x1e, 0xbb, 0xa3,
+	0x98, 0x09, 0x06, 0x6f, 0xea, 0x53, 0x17, 0x8d, 0x23, 0x57, 0x9d, 0xba, 0xe3, 0x96, 0x7d, 0x23,
+	0x88, 0x08, 0x65, 0x9e, 0xfa, 0xa9, 0x71, 0x76, 0xd5, 0xdc, 0x32, 0x08, 0x38, 0xf2, 0xc6, 0xad,
+	0x01, 0x12, 0x41, 0xcb, 0x0b, 0x19, 0xa1, 0xe6, 0xfc, 0xe5, 0xbc, 0x2a, 0x18, 0x51, 0xc4, 0x09,
+	0x37, 0x90, 0x7a, 0x0a, 0x22, 0x8e, 0x46, 0x88, 0x4b, 0xc8, 0x28, 0x88, 0x83, 0x88, 0xf7, 0x83,
+	0x43, 0x71, 0xc0, 0x62, 0x22, 0x8e, 0x0c, 0x76, 0xd3, 0x60, 0x23, 0x8e, 0x25, 0x2a, 0xe2, 0xd8,
+	0x1c, 0x6c, 0xe9, 0x83, 0xbe, 0xfa, 0xf2, 0x0c, 0x79, 0x7d, 0xb4, 0x81, 0x19, 0x66, 0x3a, 0x2e,
+	0x7f, 0x33, 0xd1, 0x3b, 0x98, 0x31, 0xfc, 0x08, 0x79, 0xc1, 0x88, 0x78, 0x01, 0xa5, 0x4c, 0x04,
+	0x82, 0x30, 0x6a, 0x72, 0x9c, 0xbf, 0x2d, 0x70, 0xbd, 0xc7, 0xf1, 0x3e, 0xa3, 0x63, 0x14, 0x8b,
+	0xf7, 0xfd, 0xfd, 0x76, 0x13, 0xbe, 0x0a, 0xd6, 0x43, 0x46, 0x45, 0x1c, 0x84, 0xa2, 0x1f, 0x0c,
+	0x87, 0x31, 0xe2, 0xbc, 0x62, 0xbd, 0x64, 0xed, 0x96, 0xfc, 0xeb, 0x93, 0xf8, 0x03, 0x1d, 0x86,
+	0x1d, 0x50, 0x0c, 0x22, 0x76, 0x48, 0x45, 0xe5, 0x8a, 0x04, 0x74, 0x9d, 0xa7, 0x27, 0x77, 0x0b,
+	0xbf, 0x9f, 0xdc, 0x7d, 0x41, 0x13, 0xe3, 0xc3, 0x87, 0x2e, 0x61, 0x5e, 0x14, 0x88, 0x03, 0xf7,
+	0x03, 0x2a, 0x7e, 0x38, 0x3f, 0xae, 0x5b, 0xbe, 0xc9, 0x80, 0xaf, 0x83, 0xe7, 0x62, 0x14, 0x22,
+	0x32, 0x46, 0x71, 0x65, 0x45, 0x65, 0x57, 0x7e, 0xf9, 0xb1, 0xb1, 0x61, 0x24, 0x99, 0x0a, 0x1f,
+	0x89, 0x98, 0x50, 0xec, 0x27, 0x48, 0x78, 0x0b, 0x14, 0x39, 0xa2, 0x43, 0x14, 0x57, 0x56, 0x15,
+	0x25, 0xf3, 0xd5, 0xa9, 0x3f, 0x39, 0x3f, 0xae, 0x9b, 0x8f, 0x2f, 0xcf, 0x8f, 0xeb, 0x76, 0xaa,
+	0xd9, 0x19, 0x81, 0xce, 0x16, 0xd8, 0xcc, 0x84, 0x7c, 0xc4, 0x47, 0x8c, 0x72, 0xe4, 0xfc, 0x6c,
+	0x81, 0x6b, 0xd3, 0xb3, 0x7d, 0x46, 0x28, 0xdc, 0x03, 0xab, 0x72, 0xce, 0xaa, 0x05, 0xe5, 0xf6,
+	0x96, 0x6b, 0x08, 0x4a, 0x23, 0xb8, 0xc6, 0x08, 0xae, 0x04, 0x76, 0x57, 0xa5, 0x78, 0x5f, 0x81,
+	0xa1, 0x9d, 0x12, 0xa7, 0x5a, 0x93, 0x92, 0xd0, 0x4c, 0x24, 0x2c, 0x92, 0x3d, 0x11, 0xd7, 0xca,
+	0x88, 0x4b, 0x9b, 0xed, 0xb1, 0xb1, 0xdb, 0x2c, 0x6b, 0xa7, 0x02, 0x6e, 0xcd, 0x46, 0x12, 0x89,
+	0x3f, 0xe9, 0x91, 0x7f, 0x32, 0x1a, 0x06, 0x02, 0x7d, 0xa8, 0xec, 0x07, 0xdf, 0x04, 0xa5, 0xc4,
+	0x81, 0x7a, 0xd6, 0xff, 0xc1, 0x6a, 0x0a, 0x85, 0xef, 0x82, 0xa2, 0x36, 0xb0, 0x12, 0x59, 0x6e,
+	0xdf, 0x76, 0x73, 0x9e, 0x93, 0xab, 0x8b, 0x74, 0x4b, 0xb2, 0x3f, 0xc6, 0x03, 0x3a, 0xab, 0xf3,
+	0x86, 0x14, 0x36, 0xbd, 0x4f, 0x6a, 0x73, 0xf2, 0xb5, 0xa5, 0xe9, 0x9a, 0x01, 0xa6, 0x43, 0x89,
+	0xba, 0xef, 0x2d, 0xb0, 0xde, 0xe3, 0xd8, 0x47, 0x98, 0x70, 0x81, 0x62, 0xed, 0x68, 0xd9, 0x71,
+	0x82, 0x29, 0x8a, 0x17, 0x6a, 0x33, 0x38, 0xb8, 0x03, 0xae, 0xa9, 0xd2, 0xc6, 0xff, 0x48, 0x0a,
+	0x5c, 0xd9, 0x2d, 0xf9, 0x99, 0x68, 0x67, 0x4f, 0x4f, 0x46, 0x25, 0x49, 0xf6, 0xdb, 0xf9, 0xec,
+	0x67, 0xe8, 0x38, 0x36, 0xa8, 0x64, 0x63, 0x09, 0xff, 0xef, 0x2c, 0x70, 0xb3, 0xc7, 0xf1, 0xc7,
+	0x0c, 0xe3, 0x47, 0x48, 0x8f, 0x8f, 0x13, 0x46, 0x2f, 0x3d, 0xa1, 0x0d, 0xb0, 0x26, 0xd8, 0x43,
+	0x44, 0x8d, 0x0b, 0xf5, 0x47, 0xe7, 0xed, 0x8b, 0x7d, 0xdf, 0xc9, 0x67, 0x9e, 0x25, 0xe2, 0xbc,
+	0x08, 0x6e, 0xe7, 0x84, 0x13, 0xfe, 0xff, 0x58, 0xc0, 0xce, 0xcc, 0xe6, 0x81, 0x29, 0x43, 0xd0,
+	0xe5, 0x8d, 0xf6, 0x19, 0x80, 0x99, 0x4d, 0x49, 0xcc, 0x4c, 0xca, 0xed, 0x5a, 0xda, 0x74, 0x6a,
+	0xb1, 0x4e, 0x4d, 0x37, 0xa9, 0x7d, 0x94, 0x76, 0xdf, 0x8d, 0x51, 0x96, 0x57, 0xe7, 0xbd, 0x8b,
+	0x0d, 0x69, 0x2c, 0x36, 0x62, 0xea, 0x06, 0xa7, 0x06, 0x9c, 0xf9, 0xa7, 0x93, 0xf6, 0xb4, 0xff,
+	0x5a, 0x03, 0x2b, 0x3d, 0x8e, 0xe1, 0x37, 0x16, 0xb8, 0x3a, 0xb3, 0x74, 0x6b, 0xb9, 0x2f, 0x27,
+	0xb3, 0xa6, 0xec, 0xfb, 0xcb, 0xa0, 0x92, 0x59, 0x34, 0x9e, 0xfc, 0xfa, 0xe7, 0xb7, 0x57, 0x5e,
+	0x81, 0xf7, 0xbc, 0xfc, 0x3f, 0x81, 0x5e, 0xa8, 0xb3, 0xfa, 0x2a, 0x06, 0xbf, 0xb2, 0x40, 0x39,
+	0xbd, 0xf8, 0xb6, 0x17, 0x14, 0x93, 0x20, 0xfb, 0xb5, 0x25, 0x40, 0x09, 0xa1, 0xfb, 0x8a, 0xd0,
+	0x0e, 0xac, 0x2d, 0x22, 0xa4, 0x76, 0xe8, 0x00, 0x5c, 0x9d, 0x59, 0x52, 0x73, 0x5b, 0x94, 0x46,
+	0xcd, 0x6f, 0x51, 0xde, 0xba, 0x80, 0x08, 0x3c, 0x3f, 0xbb, 0x2a, 0xee, 0xcd, 0x4b, 0x9f, 0x81,
+	0xd9, 0x8d, 0xa5, 0x60, 0x49, 0x19, 0x0a, 0xd6, 0x2f, 0xbc, 0xe8, 0xdd, 0x79, 0x57, 0x64, 0x91,
+	0x76, 0x73, 0x59, 0x64, 0x52, 0xef, 0x0b, 0x0b, 0x6c, 0xce, 0x7b, 0x82, 0xde, 0x32, 0x0d, 0x4a,
+	0x25, 0xd8, 0x6f, 0xfd, 0xcf, 0x84, 0x09, 0x0b, 0x7b, 0xed, 0x73, 0xf9, 0xdc, 0xba, 0xef, 0x3c,
+	0x3d, 0xad, 0x5a, 0xcf, 0x4e, 0xab, 0xd6, 0x1f, 0xa7, 0x55, 0xeb, 0xeb, 0xb3, 0x6a, 0xe1, 0xd9,
+	0x59, 0xb5, 0xf0, 0xdb, 0x59, 0xb5, 0xf0, 0xe9, 0x36, 0x26, 0xe2, 0xe0, 0x70, 0xe0, 0x86, 0x2c,
+	0xf2, 0x72, 0x5e, 0x9b, 0x7a, 0xcb, 0x83, 0xa2, 0xfa, 0x4f, 0x65, 0xef, 0xdf, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x24, 0xc4, 0xfe, 0x4c, 0xc8, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -626,6 +729,10 @@ type MsgClient interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(ctx context.Context, in *MsgToggleConversion, opts ...grpc.CallOption) (*MsgToggleConversionResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/erc20 module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error)
 }
 
 type msgClient struct {
@@ -681,6 +788,15 @@ func (c *msgClient) ToggleConversion(ctx context.Context, in *MsgToggleConversio
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	out := new(MsgUpdateParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.erc20.v1.Msg/UpdateParamsAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
@@ -701,6 +817,10 @@ type MsgServer interface {
 	// token pair conversion. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	ToggleConversion(context.Context, *MsgToggleConversion) (*MsgToggleConversionResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/erc20 module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -722,6 +842,9 @@ func (*UnimplementedMsgServer) RegisterERC20(ctx context.Context, req *MsgRegist
 func (*UnimplementedMsgServer) ToggleConversion(ctx context.Context, req *MsgToggleConversion) (*MsgToggleConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleConversion not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParamsAuthorities(ctx context.Context, req *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsAuthorities not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -817,6 +940,24 @@ func _Msg_ToggleConversion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.erc20.v1.Msg/UpdateParamsAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, req.(*MsgUpdateParamsAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -841,6 +982,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleConversion",
 			Handler:    _Msg_ToggleConversion_Handler,
 		},
+		{
+			MethodName: "UpdateParamsAuthorities",
+			Handler:    _Msg_UpdateParamsAuthorities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/erc20/v1/tx.proto",
@@ -1178,6 +1323,73 @@ func (m *MsgToggleConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParamsAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1327,6 +1539,34 @@ func (m *MsgToggleConversionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParamsAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2253,6 +2493,172 @@ func (m *MsgToggleConversionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types1.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/evm/x/feemarket/types"
@@ -23,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetParamsAuthoritiesCmd(),
+		GetParamsAtHeightCmd(),
 	)
 	return cmd
 }
@@ -114,3 +118,65 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetParamsAuthoritiesCmd queries the accounts allowed to update a subset of
+// the module params
+func GetParamsAuthoritiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-authorities",
+		Short: "Get the fee market params authorities",
+		Long:  "Get the accounts allowed to update a subset of the fee market parameters, and the parameters each of them can update.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsAuthorities(cmd.Context(), &types.QueryParamsAuthoritiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsAtHeightCmd queries the module params in effect at a past height
+func GetParamsAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-at-height [height]",
+		Short: "Get the fee market params in effect at a past height",
+		Long:  "Get the fee market parameters in effect at the end of the given block, as recorded by the parameter change history, along with the height of the change that set them.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsAtHeight(cmd.Context(), &types.QueryParamsAtHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -17,11 +17,18 @@ func InitGenesis(
 	k keeper.Keeper,
 	data types.GenesisState,
 ) []abci.ValidatorUpdate {
+	// the params history is recorded before the params, which are recorded as
+	// a change at the genesis height if they differ from the last one
+	k.SetParamsHistory(ctx, data.ParamsHistory)
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
 	}
 
+	if err := k.SetParamsAuthorities(ctx, data.ParamsAuthorities); err != nil {
+		panic(errorsmod.Wrap(err, "could not set params authorities at genesis"))
+	}
+
 	k.SetBlockGasWanted(ctx, data.BlockGas)
 
 	return []abci.ValidatorUpdate{}
@@ -30,7 +37,9 @@ func InitGenesis(
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:            k.GetParams(ctx),
+		BlockGas:          k.GetBlockGasWanted(ctx),
+		ParamsAuthorities: k.GetParamsAuthorities(ctx),
+		ParamsHistory:     k.GetParamsHistory(ctx),
 	}
 }
@@ -44,10 +44,13 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) error {
 	return nil
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted, applies the base fee policy to the base
+// fees collected in the block and adjusts the min gas price if enabled.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
+	k.ApplyBaseFeePolicy(ctx)
+
 	if ctx.BlockGasMeter() == nil {
 		err := errors.New("block gas meter is nil when setting block gas wanted")
 		k.Logger(ctx).Error(err.Error())
@@ -78,6 +81,15 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
 
+	// a failed adjustment leaves the min gas price and its window unchanged
+	// instead of halting the chain
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.AdjustMinGasPrice(cacheCtx, gasUsed.Uint64()); err != nil {
+		k.Logger(ctx).Error("failed to adjust min gas price", "error", err.Error())
+	} else {
+		writeCache()
+	}
+
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas")
 	}()
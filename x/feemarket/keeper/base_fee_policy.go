@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AddTransientBaseFees adds the base fee portion of the fees paid by an Ethereum
+// transaction to the base fees collected in the current block. The fees must be
+// expressed in the denominations held by the fee collector, i.e. the EVM extended
+// denomination or the alternative fee denomination used to pay them.
+func (k Keeper) AddTransientBaseFees(ctx sdk.Context, fees sdk.Coins) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBaseFees)
+	for _, fee := range fees {
+		if !fee.IsPositive() {
+			continue
+		}
+
+		amount := fee.Amount
+		if bz := store.Get([]byte(fee.Denom)); bz != nil {
+			var prev math.Int
+			if err := prev.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			amount = amount.Add(prev)
+		}
+
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set([]byte(fee.Denom), bz)
+	}
+}
+
+// GetTransientBaseFees returns the base fees collected in the current block.
+func (k Keeper) GetTransientBaseFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBaseFees)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return fees
+}
+
+// ApplyBaseFeePolicy burns the base fees collected in the current block or sends
+// them to the community pool, according to the BaseFeePolicy parameter. With the
+// default policy, the base fees are left in the fee collector to be distributed
+// together with the rest of the fees. An event records the amounts handled.
+//
+// NOTE: A failure is logged and the base fees are distributed instead, so that
+// it doesn't halt the chain.
+func (k Keeper) ApplyBaseFeePolicy(ctx sdk.Context) {
+	baseFees := k.GetTransientBaseFees(ctx)
+	if baseFees.IsZero() {
+		return
+	}
+
+	policy := k.GetParams(ctx).BaseFeePolicy
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	amount, err := k.applyBaseFeePolicy(cacheCtx, policy, baseFees)
+	if err != nil {
+		k.Logger(ctx).Error("failed to apply base fee policy", "policy", policy.String(), "amount", baseFees.String(), "error", err.Error())
+		policy = types.BaseFeePolicyDistribute
+		amount = baseFees
+	} else {
+		writeCache()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBaseFees,
+		sdk.NewAttribute(types.AttributeKeyBaseFeePolicy, policy.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+}
+
+// applyBaseFeePolicy moves the given base fees out of the fee collector according
+// to the policy and returns the amount handled.
+func (k Keeper) applyBaseFeePolicy(ctx sdk.Context, policy types.BaseFeePolicy, baseFees sdk.Coins) (sdk.Coins, error) {
+	switch policy {
+	case types.BaseFeePolicyBurn:
+		if k.bankKeeper == nil {
+			return nil, fmt.Errorf("bank keeper is not set")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, baseFees); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseFees); err != nil {
+			return nil, err
+		}
+		return baseFees, nil
+
+	case types.BaseFeePolicyCommunityPool:
+		if k.distrKeeper == nil {
+			return nil, fmt.Errorf("distribution keeper is not set")
+		}
+		amount := communityPoolCoins(baseFees)
+		if amount.IsZero() {
+			return amount, nil
+		}
+		feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, amount, feeCollector); err != nil {
+			return nil, err
+		}
+		return amount, nil
+
+	default:
+		return baseFees, nil
+	}
+}
+
+// communityPoolCoins converts the base fees in the EVM extended denomination into
+// the EVM denomination held by the bank module, as the community pool doesn't
+// support fractional amounts. The fractional remainder is distributed.
+func communityPoolCoins(baseFees sdk.Coins) sdk.Coins {
+	extendedDenom := evmtypes.GetEVMCoinExtendedDenom()
+	evmDenom := evmtypes.GetEVMCoinDenom()
+	if extendedDenom == evmDenom {
+		return baseFees
+	}
+
+	coins := sdk.NewCoins()
+	for _, fee := range baseFees {
+		if fee.Denom == extendedDenom {
+			fee = sdk.NewCoin(evmDenom, fee.Amount.Quo(evmtypes.GetEVMCoinDecimals().ConversionFactor()))
+		}
+		coins = coins.Add(fee)
+	}
+	return coins
+}
@@ -43,27 +43,27 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) sdkmath.LegacyDec {
 
 	parentGasUsed := k.GetBlockGasWanted(ctx)
 
-	gasLimit := sdkmath.NewIntFromUint64(math.MaxUint64)
+	gasLimit := uint64(math.MaxUint64)
 
 	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
 	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = sdkmath.NewInt(consParams.Block.MaxGas)
+		gasLimit = uint64(consParams.Block.MaxGas) // #nosec G115 -- MaxGas is checked to be non-negative
 	}
 
-	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
-	// validation
-	parentGasTargetInt := gasLimit.Quo(sdkmath.NewIntFromUint64(uint64(params.ElasticityMultiplier)))
-	if !parentGasTargetInt.IsUint64() {
-		return sdkmath.LegacyDec{}
-	}
+	// the gas target is either set independently of the gas limit or derived
+	// from it through the elasticity multiplier
+	parentGasTarget := params.GasTarget(gasLimit)
 
 	factor := evmtypes.GetEVMCoinDecimals().ConversionFactor()
-	return utils.CalcGasBaseFee(
+	baseFee := utils.CalcGasBaseFee(
 		parentGasUsed,
-		parentGasTargetInt.Uint64(),
+		parentGasTarget,
 		uint64(params.BaseFeeChangeDenominator),
 		parentBaseFee,
 		sdkmath.LegacyOneDec().QuoInt(factor),
 		params.MinGasPrice,
 	)
+
+	// bound the fee volatility, e.g. during spam events
+	return params.ClampBaseFee(parentBaseFee, baseFee)
 }
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithFeeRateOracle sets the oracle used to derive the conversion rates of
+// the alternative fee denominations. Rates provided by the oracle take
+// precedence over the ones set through governance in the module parameters.
+func (k Keeper) WithFeeRateOracle(oracle types.FeeRateOracle) Keeper {
+	k.feeRateOracle = oracle
+	return k
+}
+
+// GetFeeDenomRate returns the conversion rate between the given alternative fee
+// denomination and the EVM denomination. Only denominations listed in the
+// FeeDenomRates parameter are accepted, even if the oracle can price others.
+func (k Keeper) GetFeeDenomRate(ctx sdk.Context, denom string) (math.LegacyDec, bool) {
+	rate, found := k.GetParams(ctx).GetFeeDenomRate(denom)
+	if !found {
+		return math.LegacyDec{}, false
+	}
+
+	if k.feeRateOracle != nil {
+		oracleRate, found := k.feeRateOracle.GetFeeDenomRate(ctx, denom)
+		if found && !oracleRate.IsNil() && oracleRate.IsPositive() {
+			return oracleRate, true
+		}
+	}
+
+	return rate, true
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -23,6 +26,13 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
+// ParamsAuthorities returns the accounts allowed to update a subset of the
+// module parameters.
+func (k Keeper) ParamsAuthorities(c context.Context, _ *types.QueryParamsAuthoritiesRequest) (*types.QueryParamsAuthoritiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsAuthoritiesResponse{ParamsAuthorities: k.GetParamsAuthorities(ctx)}, nil
+}
+
 // BaseFee implements the Query/BaseFee gRPC method
 func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -47,3 +57,21 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// ParamsAtHeight returns the module parameters in effect at the end of a past
+// block, as recorded by the parameter change history.
+func (k Keeper) ParamsAtHeight(c context.Context, req *types.QueryParamsAtHeightRequest) (*types.QueryParamsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, changeHeight, found := k.GetParamsAtHeight(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no params change recorded at or below height %d", req.Height)
+	}
+	return &types.QueryParamsAtHeightResponse{Params: params, ChangeHeight: changeHeight}, nil
+}
@@ -19,11 +19,17 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// optional source of the conversion rates for alternative fee denominations
+	feeRateOracle types.FeeRateOracle
+	// keepers used to burn the base fees or send them to the community pool
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
 	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -35,6 +41,8 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
 	}
 }
 
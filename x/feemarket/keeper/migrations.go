@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It sets the
+// BaseFeePolicy parameter to its default value, which keeps distributing the
+// base fees to validators as before.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.BaseFeePolicy = types.DefaultBaseFeePolicy
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3. It sets the
+// base fee floor, ceiling and maximum change rate parameters to their default
+// values, which don't bound the base fee adjustments.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MinBaseFee = types.DefaultMinBaseFee
+	params.MaxBaseFee = types.DefaultMaxBaseFee
+	params.MaxBaseFeeChangeRate = types.DefaultMaxBaseFeeChangeRate
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4. It sets the
+// min gas price adjustment parameters to their default values, which keep the
+// min gas price unchanged until governance enables the adjustments.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.MinGasPriceAdjustment = types.DefaultMinGasPriceAdjustment
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5. It records the
+// current parameters in the parameter change history, so that they can be
+// queried at the heights following the upgrade.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.setParamsHistory(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AdjustMinGasPrice records the utilization of the block gas limit by the gas
+// used in the current block and, at the end of each window of blocks, adjusts
+// the MinGasPrice parameter based on the average utilization over the window.
+// It's a no-op if the adjustments are disabled or the block gas is unlimited.
+//
+// The windows are fixed rather than rolling: only the sum of the utilizations
+// of the current window is stored, and it's reset once the min gas price is
+// adjusted. The min gas price thus changes at most once per window, which the
+// max change rate bounds, and so does the params history.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) AdjustMinGasPrice(ctx sdk.Context, gasUsed uint64) error {
+	params := k.GetParams(ctx)
+	if !params.MinGasPriceAdjustment.IsEnabled() {
+		return nil
+	}
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	consParams := ctx.ConsensusParams()
+	if consParams.Block == nil || consParams.Block.MaxGas <= 0 {
+		return nil
+	}
+
+	utilization := math.LegacyMinDec(
+		math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).QuoInt64(consParams.Block.MaxGas),
+		math.LegacyOneDec(),
+	)
+
+	blocks, totalUtilization := k.GetMinGasPriceWindow(ctx)
+	blocks++
+	totalUtilization = totalUtilization.Add(utilization)
+
+	// the window can be shortened by governance while in progress
+	if blocks < params.MinGasPriceAdjustment.Window {
+		k.SetMinGasPriceWindow(ctx, blocks, totalUtilization)
+		return nil
+	}
+
+	k.SetMinGasPriceWindow(ctx, 0, math.LegacyZeroDec())
+
+	averageUtilization := totalUtilization.QuoInt64(int64(blocks)) // #nosec G115 -- blocks is bounded by the window
+	params.MinGasPrice = params.AdjustMinGasPrice(averageUtilization)
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMinGasPrice,
+		sdk.NewAttribute(types.AttributeKeyMinGasPrice, params.MinGasPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyUtilization, averageUtilization.String()),
+	))
+
+	return nil
+}
+
+// GetMinGasPriceWindow returns the number of blocks recorded in the current
+// min gas price adjustment window, together with the sum of their block gas
+// limit utilizations.
+func (k Keeper) GetMinGasPriceWindow(ctx sdk.Context) (uint64, math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	blocks := sdk.BigEndianToUint64(store.Get(types.KeyPrefixMinGasPriceWindowBlocks))
+
+	totalUtilization := math.LegacyZeroDec()
+	bz := store.Get(types.KeyPrefixMinGasPriceWindowUtilization)
+	if bz == nil {
+		return blocks, totalUtilization
+	}
+	if err := totalUtilization.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return blocks, totalUtilization
+}
+
+// SetMinGasPriceWindow sets the number of blocks recorded in the current min
+// gas price adjustment window and the sum of their block gas limit
+// utilizations to the store.
+func (k Keeper) SetMinGasPriceWindow(ctx sdk.Context, blocks uint64, totalUtilization math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := totalUtilization.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.KeyPrefixMinGasPriceWindowBlocks, sdk.Uint64ToBigEndian(blocks))
+	store.Set(types.KeyPrefixMinGasPriceWindowUtilization, bz)
+}
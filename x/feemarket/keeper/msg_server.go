@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -14,16 +15,43 @@ import (
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
 // proposal passes, it updates the module parameters. The update can only be
 // performed if the requested authority is the Cosmos SDK governance module
-// account.
+// account, or a params authority, which only updates the params it's allowed
+// to.
 func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := req.Params
+	if k.authority.String() != req.Authority {
+		paramsAuthority, found := k.GetParamsAuthority(ctx, req.Authority)
+		if !found {
+			return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+		}
+
+		// only the params the authority is allowed to update are changed
+		params = k.GetParams(ctx)
+		cosmosevmtypes.ApplyParams(&params, &req.Params, paramsAuthority.Params)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateParamsAuthorities implements the gRPC MsgServer interface. When an
+// UpdateParamsAuthorities proposal passes, it replaces the accounts allowed to
+// update a subset of the module parameters. The update can only be performed
+// if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateParamsAuthorities(goCtx context.Context, req *types.MsgUpdateParamsAuthorities) (*types.MsgUpdateParamsAuthoritiesResponse, error) {
 	if k.authority.String() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.SetParams(ctx, req.Params); err != nil {
+	if err := k.SetParamsAuthorities(ctx, req.ParamsAuthorities); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	return &types.MsgUpdateParamsAuthoritiesResponse{}, nil
 }
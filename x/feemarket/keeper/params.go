@@ -19,8 +19,18 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
-// SetParams sets the fee market params in a single key
+// SetParams sets the fee market params in a single key, recording them in the
+// parameter change history.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := k.setParams(ctx, params); err != nil {
+		return err
+	}
+	k.setParamsHistory(ctx, params)
+	return nil
+}
+
+// setParams sets the fee market params in a single key.
+func (k Keeper) setParams(ctx sdk.Context, params types.Params) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -52,11 +62,12 @@ func (k Keeper) GetBaseFee(ctx sdk.Context) math.LegacyDec {
 	return params.BaseFee
 }
 
-// SetBaseFee set's the base fee in the store
+// SetBaseFee set's the base fee in the store. The base fee changing at every
+// block, it's not recorded in the parameter change history.
 func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
 	params := k.GetParams(ctx)
 	params.BaseFee = baseFee
-	err := k.SetParams(ctx, params)
+	err := k.setParams(ctx, params)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParamsAuthorities returns the accounts allowed to update a subset of the
+// x/feemarket module parameters.
+func (k Keeper) GetParamsAuthorities(ctx sdk.Context) []cosmosevmtypes.ParamsAuthority {
+	return cosmosevmtypes.GetParamsAuthorities(k.paramsAuthorityStore(ctx), k.cdc)
+}
+
+// GetParamsAuthority returns the parameters the given account is allowed to
+// update, if any.
+func (k Keeper) GetParamsAuthority(ctx sdk.Context, authority string) (cosmosevmtypes.ParamsAuthority, bool) {
+	return cosmosevmtypes.GetParamsAuthority(k.paramsAuthorityStore(ctx), k.cdc, authority)
+}
+
+// SetParamsAuthorities replaces the accounts allowed to update a subset of the
+// x/feemarket module parameters.
+func (k Keeper) SetParamsAuthorities(ctx sdk.Context, authorities []cosmosevmtypes.ParamsAuthority) error {
+	return cosmosevmtypes.SetParamsAuthorities(k.paramsAuthorityStore(ctx), k.cdc, authorities, &types.Params{})
+}
+
+// paramsAuthorityStore returns the store of the params authorities.
+func (k Keeper) paramsAuthorityStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParamsAuthority)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParamsAtHeight returns the x/feemarket module parameters in effect at the
+// end of the given block, along with the height of the change that set them. It
+// returns false if no change is recorded at or below the height, which is the
+// case for the blocks before the retention of the history.
+func (k Keeper) GetParamsAtHeight(ctx sdk.Context, height int64) (types.Params, int64, bool) {
+	return cosmosevmtypes.GetParamsAtHeight[types.Params](k.paramsHistoryStore(ctx), k.cdc, height)
+}
+
+// GetParamsHistory returns the recorded changes of the x/feemarket module
+// parameters by ascending height.
+func (k Keeper) GetParamsHistory(ctx sdk.Context) []types.ParamsChange {
+	var history []types.ParamsChange
+	cosmosevmtypes.IterateParamsHistory[types.Params](k.paramsHistoryStore(ctx), k.cdc, func(height int64, params types.Params) bool {
+		history = append(history, types.ParamsChange{Params: params, Height: height})
+		return false
+	})
+	return history
+}
+
+// SetParamsHistory records the given changes of the x/feemarket module
+// parameters, by ascending height, in the parameter change history. The changes
+// at or after the current block height are skipped, as they are not part of the
+// history of the chain the context is on.
+func (k Keeper) SetParamsHistory(ctx sdk.Context, history []types.ParamsChange) {
+	for _, change := range history {
+		if change.Height >= ctx.BlockHeight() {
+			break
+		}
+		k.setParamsHistory(ctx.WithBlockHeight(change.Height), change.Params)
+	}
+}
+
+// setParamsHistory records the parameters set at the current block height, if
+// they differ from the ones in effect. The base fee is updated every block
+// outside of the params history, so it is not recorded.
+func (k Keeper) setParamsHistory(ctx sdk.Context, params types.Params) {
+	params.BaseFee = math.LegacyZeroDec()
+	cosmosevmtypes.SetParamsAtHeight(k.paramsHistoryStore(ctx), k.cdc, ctx.BlockHeight(), &params)
+}
+
+// paramsHistoryStore returns the store of the parameter change history.
+func (k Keeper) paramsHistoryStore(ctx sdk.Context) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParamsHistory)
+}
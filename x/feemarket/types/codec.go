@@ -21,7 +21,8 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "os/feemarket/MsgUpdateParams"
+	updateParamsName            = "os/feemarket/MsgUpdateParams"
+	updateParamsAuthoritiesName = "os/feemarket/MsgUpdateParamsAuthorities"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -35,6 +36,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateParamsAuthorities{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -42,4 +44,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParamsAuthorities{}, updateParamsAuthoritiesName, nil)
 }
@@ -2,7 +2,12 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket   = "fee_market"
+	EventTypeBaseFees    = "base_fees"
+	EventTypeMinGasPrice = "min_gas_price"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee       = "base_fee"
+	AttributeKeyBaseFeePolicy = "policy"
+	AttributeKeyMinGasPrice   = "min_gas_price"
+	AttributeKeyUtilization   = "utilization"
 )
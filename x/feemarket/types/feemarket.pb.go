@@ -25,6 +25,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeePolicy defines what happens to the base fee portion of the fees paid
+// by Ethereum transactions, i.e. the gas used times the base fee. The priority
+// tips are always distributed to the validators.
+type BaseFeePolicy int32
+
+const (
+	// BASE_FEE_POLICY_DISTRIBUTE distributes the base fee to the validators and
+	// delegators together with the rest of the transaction fees.
+	BaseFeePolicyDistribute BaseFeePolicy = 0
+	// BASE_FEE_POLICY_BURN burns the base fee, as specified by EIP-1559.
+	BaseFeePolicyBurn BaseFeePolicy = 1
+	// BASE_FEE_POLICY_COMMUNITY_POOL sends the base fee to the community pool.
+	BaseFeePolicyCommunityPool BaseFeePolicy = 2
+)
+
+var BaseFeePolicy_name = map[int32]string{
+	0: "BASE_FEE_POLICY_DISTRIBUTE",
+	1: "BASE_FEE_POLICY_BURN",
+	2: "BASE_FEE_POLICY_COMMUNITY_POOL",
+}
+
+var BaseFeePolicy_value = map[string]int32{
+	"BASE_FEE_POLICY_DISTRIBUTE":     0,
+	"BASE_FEE_POLICY_BURN":           1,
+	"BASE_FEE_POLICY_COMMUNITY_POOL": 2,
+}
+
+func (x BaseFeePolicy) String() string {
+	return proto.EnumName(BaseFeePolicy_name, int32(x))
+}
+
+func (BaseFeePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -33,7 +68,7 @@ type Params struct {
 	// between blocks.
 	BaseFeeChangeDenominator uint32 `protobuf:"varint,2,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
 	// elasticity_multiplier bounds the maximum gas limit an EIP-1559 block may
-	// have.
+	// have. It's ignored if block_gas_target is set.
 	ElasticityMultiplier uint32 `protobuf:"varint,3,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
 	// enable_height defines at which block height the base fee calculation is
 	// enabled.
@@ -46,6 +81,35 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_denom_rates defines the alternative denominations accepted to pay
+	// the fees of Ethereum transactions, together with their conversion rate
+	// into the EVM denomination.
+	FeeDenomRates []FeeDenomRate `protobuf:"bytes,9,rep,name=fee_denom_rates,json=feeDenomRates,proto3" json:"fee_denom_rates"`
+	// base_fee_policy defines what happens to the base fee portion of the fees
+	// paid by Ethereum transactions.
+	BaseFeePolicy BaseFeePolicy `protobuf:"varint,10,opt,name=base_fee_policy,json=baseFeePolicy,proto3,enum=cosmos.evm.feemarket.v1.BaseFeePolicy" json:"base_fee_policy,omitempty"`
+	// block_gas_target defines the gas used per block targeted by the base fee
+	// adjustments, independently of the consensus block gas limit. If zero, the
+	// target is the block gas limit divided by the elasticity multiplier.
+	BlockGasTarget uint64 `protobuf:"varint,11,opt,name=block_gas_target,json=blockGasTarget,proto3" json:"block_gas_target,omitempty"`
+	// min_base_fee defines the lowest base fee the EIP-1559 adjustments can set.
+	// Unlike min_gas_price, it doesn't affect the fees accepted by the nodes. If
+	// zero, the base fee has no floor besides min_gas_price.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// max_base_fee defines the highest base fee the EIP-1559 adjustments can set.
+	// If zero, the base fee has no ceiling.
+	MaxBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee"`
+	// max_base_fee_change_rate bounds the relative change of the base fee between
+	// two blocks, e.g. 0.125 for 12.5%. If zero, the change is only bounded by
+	// the base_fee_change_denominator.
+	MaxBaseFeeChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=max_base_fee_change_rate,json=maxBaseFeeChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_fee_change_rate"`
+	// min_gas_price_overrides defines the minimum gas prices of the alternative
+	// fee denominations, which replace min_gas_price for the transactions paying
+	// their fees in these denominations.
+	MinGasPriceOverrides []DenomMinGasPrice `protobuf:"bytes,15,rep,name=min_gas_price_overrides,json=minGasPriceOverrides,proto3" json:"min_gas_price_overrides"`
+	// min_gas_price_adjustment defines the automatic adjustments of min_gas_price
+	// based on the block utilization. It's disabled if its window is zero.
+	MinGasPriceAdjustment MinGasPriceAdjustment `protobuf:"bytes,16,opt,name=min_gas_price_adjustment,json=minGasPriceAdjustment,proto3" json:"min_gas_price_adjustment"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,8 +173,211 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeDenomRates() []FeeDenomRate {
+	if m != nil {
+		return m.FeeDenomRates
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeePolicy() BaseFeePolicy {
+	if m != nil {
+		return m.BaseFeePolicy
+	}
+	return BaseFeePolicyDistribute
+}
+
+func (m *Params) GetBlockGasTarget() uint64 {
+	if m != nil {
+		return m.BlockGasTarget
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPriceOverrides() []DenomMinGasPrice {
+	if m != nil {
+		return m.MinGasPriceOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetMinGasPriceAdjustment() MinGasPriceAdjustment {
+	if m != nil {
+		return m.MinGasPriceAdjustment
+	}
+	return MinGasPriceAdjustment{}
+}
+
+// FeeDenomRate defines the rate used to convert the fees of an Ethereum
+// transaction into an alternative fee denomination.
+type FeeDenomRate struct {
+	// denom is the bank denomination of the alternative fee token. It must
+	// belong to a registered ERC20 token pair.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of the EVM denomination, in its 18 decimals
+	// representation, that one unit of the alternative denomination is worth.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeDenomRate) Reset()         { *m = FeeDenomRate{} }
+func (m *FeeDenomRate) String() string { return proto.CompactTextString(m) }
+func (*FeeDenomRate) ProtoMessage()    {}
+func (*FeeDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *FeeDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenomRate.Merge(m, src)
+}
+func (m *FeeDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenomRate proto.InternalMessageInfo
+
+func (m *FeeDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DenomMinGasPrice defines the minimum gas price of a fee denomination.
+type DenomMinGasPrice struct {
+	// denom is the bank denomination the fees are paid in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_gas_price is the minimum amount of the denomination, in its bank
+	// representation, to pay per unit of gas.
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *DenomMinGasPrice) Reset()         { *m = DenomMinGasPrice{} }
+func (m *DenomMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*DenomMinGasPrice) ProtoMessage()    {}
+func (*DenomMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{2}
+}
+func (m *DenomMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMinGasPrice.Merge(m, src)
+}
+func (m *DenomMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMinGasPrice proto.InternalMessageInfo
+
+func (m *DenomMinGasPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MinGasPriceAdjustment defines how the min_gas_price parameter is adjusted at
+// the end of each window of blocks, depending on the average utilization of the
+// block gas limit over that window.
+type MinGasPriceAdjustment struct {
+	// window is the number of consecutive blocks the utilization is averaged
+	// over before adjusting the min gas price. The windows are fixed and don't
+	// overlap: the min gas price is adjusted once per window, at its last block,
+	// and the next window starts empty. If zero, the adjustments are disabled.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// target_utilization is the average ratio of the block gas limit used over
+	// the window above which the min gas price increases, and below which it
+	// decreases, e.g. 0.5 for 50%.
+	TargetUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=target_utilization,json=targetUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_utilization"`
+	// max_change_rate bounds the relative change of the min gas price at the end
+	// of a window, e.g. 0.1 for 10%. The change is proportional to the deviation
+	// of the utilization from its target.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// min_gas_price_floor is the lowest min gas price the adjustments can set.
+	MinGasPriceFloor cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_gas_price_floor,json=minGasPriceFloor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_floor"`
+	// min_gas_price_ceiling is the highest min gas price the adjustments can
+	// set.
+	MinGasPriceCeiling cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_gas_price_ceiling,json=minGasPriceCeiling,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price_ceiling"`
+}
+
+func (m *MinGasPriceAdjustment) Reset()         { *m = MinGasPriceAdjustment{} }
+func (m *MinGasPriceAdjustment) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceAdjustment) ProtoMessage()    {}
+func (*MinGasPriceAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{3}
+}
+func (m *MinGasPriceAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceAdjustment.Merge(m, src)
+}
+func (m *MinGasPriceAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceAdjustment proto.InternalMessageInfo
+
+func (m *MinGasPriceAdjustment) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evm.feemarket.v1.BaseFeePolicy", BaseFeePolicy_name, BaseFeePolicy_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*FeeDenomRate)(nil), "cosmos.evm.feemarket.v1.FeeDenomRate")
+	proto.RegisterType((*DenomMinGasPrice)(nil), "cosmos.evm.feemarket.v1.DenomMinGasPrice")
+	proto.RegisterType((*MinGasPriceAdjustment)(nil), "cosmos.evm.feemarket.v1.MinGasPriceAdjustment")
 }
 
 func init() {
@@ -118,34 +385,64 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4d, 0x53, 0xdb, 0x46,
+	0x18, 0xc7, 0x2d, 0x6c, 0x08, 0x5e, 0x63, 0x10, 0x5b, 0xbb, 0x68, 0xcc, 0x54, 0xd1, 0xb8, 0xd3,
+	0x46, 0xe5, 0x20, 0x4f, 0xc8, 0xad, 0x2f, 0x07, 0x64, 0x20, 0x25, 0x03, 0xd8, 0x23, 0xa0, 0x99,
+	0xb4, 0x07, 0x75, 0x25, 0xaf, 0xe5, 0x2d, 0x5a, 0xad, 0x47, 0x5a, 0x3b, 0xb8, 0x9f, 0x20, 0xc3,
+	0x29, 0x5f, 0x80, 0x53, 0x2f, 0x3d, 0xe6, 0x5b, 0x34, 0xc7, 0x1c, 0x3b, 0x3d, 0x64, 0x3a, 0x70,
+	0xc8, 0xd7, 0xe8, 0x68, 0xe5, 0x17, 0x99, 0xc6, 0x07, 0xb5, 0x17, 0x46, 0xbb, 0xcf, 0x3e, 0xbf,
+	0x47, 0xfa, 0x3f, 0xff, 0x7d, 0x30, 0x78, 0xe4, 0xb2, 0x88, 0xb2, 0xa8, 0x81, 0x87, 0xb4, 0xd1,
+	0xc5, 0x98, 0xa2, 0xf0, 0x12, 0xf3, 0xc6, 0xf0, 0xf1, 0x6c, 0x61, 0xf4, 0x43, 0xc6, 0x19, 0xdc,
+	0x4a, 0x0e, 0x1a, 0x78, 0x48, 0x8d, 0x59, 0x6c, 0xf8, 0xb8, 0xb6, 0x89, 0x28, 0x09, 0x58, 0x43,
+	0xfc, 0x4d, 0xce, 0xd6, 0x2a, 0x1e, 0xf3, 0x98, 0x78, 0x6c, 0xc4, 0x4f, 0xc9, 0x6e, 0xfd, 0x55,
+	0x11, 0xac, 0xb4, 0x51, 0x88, 0x68, 0x04, 0x55, 0x50, 0x0a, 0x98, 0xed, 0xa0, 0x08, 0xdb, 0x5d,
+	0x8c, 0x15, 0x49, 0x93, 0xf4, 0x55, 0xab, 0x18, 0x30, 0x13, 0x45, 0xf8, 0x10, 0x63, 0xf8, 0x1d,
+	0xd8, 0x9e, 0x04, 0x6d, 0xb7, 0x87, 0x02, 0x0f, 0xdb, 0x1d, 0x1c, 0x30, 0x4a, 0x02, 0xc4, 0x59,
+	0xa8, 0x2c, 0x69, 0x92, 0x5e, 0xb6, 0x14, 0x27, 0x39, 0xdd, 0x14, 0x07, 0xf6, 0x67, 0x71, 0xf8,
+	0x04, 0x54, 0xb1, 0x8f, 0x22, 0x4e, 0x5c, 0xc2, 0x47, 0x36, 0x1d, 0xf8, 0x9c, 0xf4, 0x7d, 0x82,
+	0x43, 0x25, 0x2f, 0x12, 0x2b, 0xb3, 0xe0, 0xc9, 0x34, 0x06, 0x3f, 0x07, 0x65, 0x1c, 0x20, 0xc7,
+	0xc7, 0x76, 0x0f, 0x13, 0xaf, 0xc7, 0x95, 0x65, 0x4d, 0xd2, 0xf3, 0xd6, 0x5a, 0xb2, 0xf9, 0xbd,
+	0xd8, 0x83, 0x4d, 0xb0, 0x3a, 0x7d, 0xeb, 0x15, 0x4d, 0xd2, 0x8b, 0xa6, 0xfe, 0xf6, 0xfd, 0xc3,
+	0xdc, 0x5f, 0xef, 0x1f, 0x6e, 0x27, 0xfa, 0x44, 0x9d, 0x4b, 0x83, 0xb0, 0x06, 0x45, 0xbc, 0x67,
+	0x1c, 0x63, 0x0f, 0xb9, 0xa3, 0x7d, 0xec, 0xfe, 0xfe, 0xe1, 0xcd, 0x8e, 0x64, 0x3d, 0x18, 0xbf,
+	0x2f, 0x3c, 0x06, 0x65, 0x4a, 0x02, 0xdb, 0x43, 0x91, 0xdd, 0x0f, 0x89, 0x8b, 0x95, 0x07, 0x19,
+	0x49, 0x25, 0x4a, 0x82, 0xa7, 0x28, 0x6a, 0xc7, 0xc9, 0xf0, 0x07, 0x00, 0x27, 0xb4, 0xd4, 0x97,
+	0xae, 0x66, 0x44, 0xca, 0x09, 0x32, 0xa5, 0xc7, 0x19, 0xd8, 0x88, 0xe5, 0x17, 0xba, 0xdb, 0x21,
+	0xe2, 0x38, 0x52, 0x8a, 0x5a, 0x5e, 0x2f, 0xed, 0x7e, 0x61, 0x2c, 0xb0, 0x82, 0x71, 0x88, 0x93,
+	0x36, 0x58, 0x88, 0x63, 0xb3, 0x10, 0xd7, 0xb6, 0xca, 0xdd, 0xd4, 0x5e, 0x04, 0x4f, 0xc1, 0xc6,
+	0xb4, 0xb1, 0x7d, 0xe6, 0x13, 0x77, 0xa4, 0x00, 0x4d, 0xd2, 0xd7, 0x77, 0xbf, 0x5c, 0x08, 0x1d,
+	0x7b, 0xa2, 0x2d, 0x4e, 0x5b, 0x65, 0x27, 0xbd, 0x84, 0x3a, 0x90, 0x1d, 0x9f, 0xb9, 0x97, 0xe2,
+	0xf3, 0x39, 0x0a, 0x3d, 0xcc, 0x95, 0x92, 0x26, 0xe9, 0x05, 0x6b, 0x5d, 0xec, 0x3f, 0x45, 0xd1,
+	0xb9, 0xd8, 0x85, 0xcf, 0xc0, 0x5a, 0x2c, 0xd3, 0xb4, 0x7b, 0x6b, 0x19, 0x05, 0x02, 0x94, 0x04,
+	0x13, 0x7b, 0xc6, 0x2c, 0x74, 0x35, 0x63, 0x95, 0x33, 0xb3, 0xd0, 0xd5, 0x84, 0xf5, 0x33, 0x50,
+	0xd2, 0xac, 0x89, 0xdd, 0x63, 0xc1, 0x95, 0xf5, 0x8c, 0xdc, 0xca, 0x8c, 0x9b, 0x5c, 0x8a, 0x58,
+	0x74, 0xd8, 0x05, 0x5b, 0x73, 0x76, 0xb3, 0xd9, 0x10, 0x87, 0x21, 0xe9, 0xe0, 0x48, 0xd9, 0x10,
+	0x0d, 0xfd, 0x6a, 0xa1, 0xf6, 0xa2, 0x73, 0x27, 0x33, 0xb3, 0x8d, 0x9b, 0x5a, 0x49, 0xf9, 0xaf,
+	0x35, 0x81, 0x41, 0x0a, 0x94, 0xf9, 0x3a, 0xa8, 0xf3, 0xcb, 0x20, 0xe2, 0x14, 0x07, 0x5c, 0x91,
+	0x35, 0x49, 0x2f, 0xed, 0x1a, 0x0b, 0x0b, 0xa5, 0x6a, 0xec, 0x4d, 0xb3, 0xc6, 0xd5, 0xaa, 0xf4,
+	0x63, 0xc1, 0xaf, 0xeb, 0xd7, 0x1f, 0xde, 0xec, 0x7c, 0x96, 0x1a, 0x5f, 0x57, 0xa9, 0x01, 0x96,
+	0xcc, 0x99, 0x67, 0x85, 0xd5, 0x82, 0xbc, 0x6c, 0xc9, 0x24, 0x20, 0x9c, 0x20, 0x7f, 0x2a, 0x72,
+	0xdd, 0x01, 0x6b, 0x69, 0xaf, 0xc2, 0x0a, 0x58, 0x16, 0x3e, 0x17, 0x93, 0xa8, 0x68, 0x25, 0x0b,
+	0xf8, 0x2d, 0x28, 0x88, 0x36, 0x2c, 0x65, 0x6c, 0x83, 0xc8, 0xaa, 0x0f, 0x81, 0x7c, 0x5f, 0xbe,
+	0x05, 0x75, 0xfe, 0x35, 0x0f, 0x96, 0xfe, 0xc7, 0x3c, 0xa8, 0xbf, 0xce, 0x83, 0xea, 0x47, 0xe5,
+	0x84, 0x9f, 0x82, 0x95, 0x97, 0x24, 0xe8, 0xb0, 0x97, 0xa2, 0x7c, 0xc1, 0x1a, 0xaf, 0xe0, 0x73,
+	0x00, 0x93, 0xab, 0x63, 0x0f, 0x38, 0xf1, 0xc9, 0xaf, 0x88, 0x13, 0x16, 0x64, 0x7e, 0x89, 0xcd,
+	0x84, 0x71, 0x31, 0x43, 0xc0, 0x36, 0xd8, 0x88, 0xbd, 0x9d, 0xb6, 0x74, 0x3e, 0x23, 0xb5, 0x4c,
+	0xd1, 0x55, 0xca, 0xcb, 0xcf, 0xc1, 0x27, 0xf3, 0x1e, 0xeb, 0xfa, 0x8c, 0x85, 0x4a, 0xe1, 0xbf,
+	0x4d, 0x3b, 0x21, 0xd0, 0x61, 0x4c, 0x80, 0x3f, 0x81, 0xea, 0x3c, 0xd8, 0xc5, 0xc4, 0x27, 0x81,
+	0x27, 0xfe, 0x0b, 0x64, 0x41, 0xc3, 0x14, 0xba, 0x99, 0x30, 0x76, 0xfe, 0x90, 0x40, 0x79, 0x6e,
+	0x8c, 0xc1, 0x6f, 0x40, 0xcd, 0xdc, 0x3b, 0x3b, 0xb0, 0x0f, 0x0f, 0x0e, 0xec, 0x76, 0xeb, 0xf8,
+	0xa8, 0xf9, 0xc2, 0xde, 0x3f, 0x3a, 0x3b, 0xb7, 0x8e, 0xcc, 0x8b, 0xf3, 0x03, 0x39, 0x57, 0xdb,
+	0xbe, 0xbe, 0xd1, 0xb6, 0xe6, 0x52, 0xf6, 0x49, 0xc4, 0x43, 0xe2, 0x0c, 0x38, 0x86, 0x0d, 0x50,
+	0xb9, 0x9f, 0x6c, 0x5e, 0x58, 0xa7, 0xb2, 0x54, 0xab, 0x5e, 0xdf, 0x68, 0x9b, 0x73, 0x69, 0xe6,
+	0x20, 0x0c, 0xa0, 0x09, 0xd4, 0xfb, 0x09, 0xcd, 0xd6, 0xc9, 0xc9, 0xc5, 0xe9, 0xd1, 0xf9, 0x0b,
+	0xbb, 0xdd, 0x6a, 0x1d, 0xcb, 0x4b, 0x35, 0xf5, 0xfa, 0x46, 0xab, 0xcd, 0xa5, 0x36, 0x19, 0xa5,
+	0x83, 0x80, 0xf0, 0x51, 0x9b, 0x31, 0xbf, 0x56, 0x78, 0xf5, 0x9b, 0x9a, 0x33, 0xf7, 0xde, 0xde,
+	0xaa, 0xd2, 0xbb, 0x5b, 0x55, 0xfa, 0xfb, 0x56, 0x95, 0x5e, 0xdf, 0xa9, 0xb9, 0x77, 0x77, 0x6a,
+	0xee, 0xcf, 0x3b, 0x35, 0xf7, 0xe3, 0x23, 0x8f, 0xf0, 0xde, 0xc0, 0x31, 0x5c, 0x46, 0x1b, 0x0b,
+	0x2e, 0x25, 0x1f, 0xf5, 0x71, 0xe4, 0xac, 0x88, 0x5f, 0x03, 0x4f, 0xfe, 0x09, 0x00, 0x00, 0xff,
+	0xff, 0x35, 0xdf, 0x83, 0x1d, 0x7a, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +465,86 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.MinGasPriceAdjustment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.MinGasPriceOverrides) > 0 {
+		for iNdEx := len(m.MinGasPriceOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPriceOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	{
+		size := m.MaxBaseFeeChangeRate.Size()
+		i -= size
+		if _, err := m.MaxBaseFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BlockGasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BlockGasTarget))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.BaseFeePolicy != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeePolicy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeDenomRates) > 0 {
+		for iNdEx := len(m.FeeDenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -226,61 +603,285 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FeeDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NoBaseFee {
-		n += 2
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	return dAtA[:n], nil
+}
+
+func (m *DenomMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MinGasPriceAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MinGasPriceAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeemarket
-			}
-			if iNdEx >= l {
+
+func (m *MinGasPriceAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPriceCeiling.Size()
+		i -= size
+		if _, err := m.MinGasPriceCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinGasPriceFloor.Size()
+		i -= size
+		if _, err := m.MinGasPriceFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetUtilization.Size()
+		i -= size
+		if _, err := m.TargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Window != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.FeeDenomRates) > 0 {
+		for _, e := range m.FeeDenomRates {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.BaseFeePolicy != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeePolicy))
+	}
+	if m.BlockGasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.BlockGasTarget))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFeeChangeRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.MinGasPriceOverrides) > 0 {
+		for _, e := range m.MinGasPriceOverrides {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = m.MinGasPriceAdjustment.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeeDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *DenomMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *MinGasPriceAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovFeemarket(uint64(m.Window))
+	}
+	l = m.TargetUtilization.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPriceFloor.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPriceCeiling.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -478,6 +1079,684 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenomRates = append(m.FeeDenomRates, FeeDenomRate{})
+			if err := m.FeeDenomRates[len(m.FeeDenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeePolicy", wireType)
+			}
+			m.BaseFeePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeePolicy |= BaseFeePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasTarget", wireType)
+			}
+			m.BlockGasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPriceOverrides = append(m.MinGasPriceOverrides, DenomMinGasPrice{})
+			if err := m.MinGasPriceOverrides[len(m.MinGasPriceOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceAdjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+)
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +25,30 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := cosmosevmtypes.ValidateParamsAuthorities(gs.ParamsAuthorities, &Params{}); err != nil {
+		return fmt.Errorf("invalid params authorities: %w", err)
+	}
+
+	if err := validateParamsHistory(gs.ParamsHistory); err != nil {
+		return fmt.Errorf("invalid params history: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
+
+// validateParamsHistory checks that the parameter changes are valid and
+// recorded by strictly ascending, non-negative heights.
+func validateParamsHistory(history []ParamsChange) error {
+	for i, change := range history {
+		if change.Height < 0 {
+			return fmt.Errorf("negative change height %d", change.Height)
+		}
+		if i > 0 && change.Height <= history[i-1].Height {
+			return fmt.Errorf("change at height %d is not after the one at height %d", change.Height, history[i-1].Height)
+		}
+		if err := change.Params.Validate(); err != nil {
+			return fmt.Errorf("change at height %d: %w", change.Height, err)
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -31,6 +32,12 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// params_authorities are the accounts allowed to update a subset of the
+	// module parameters, in addition to the governance account.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,4,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+	// params_history are the recorded changes of the module parameters, by
+	// ascending height.
+	ParamsHistory []ParamsChange `protobuf:"bytes,5,rep,name=params_history,json=paramsHistory,proto3" json:"params_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,8 +87,80 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamsHistory() []ParamsChange {
+	if m != nil {
+		return m.ParamsHistory
+	}
+	return nil
+}
+
+// ParamsChange is a change of the module parameters recorded in the parameter
+// change history.
+type ParamsChange struct {
+	// params are the parameters set by the change. The base fee is not
+	// recorded, so base_fee is always zero.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// height is the block height of the change.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ParamsChange) Reset()         { *m = ParamsChange{} }
+func (m *ParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ParamsChange) ProtoMessage()    {}
+func (*ParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c64d3a2a89a388, []int{1}
+}
+func (m *ParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsChange.Merge(m, src)
+}
+func (m *ParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsChange proto.InternalMessageInfo
+
+func (m *ParamsChange) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ParamsChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.feemarket.v1.GenesisState")
+	proto.RegisterType((*ParamsChange)(nil), "cosmos.evm.feemarket.v1.ParamsChange")
 }
 
 func init() {
@@ -89,24 +168,30 @@ func init() {
 }
 
 var fileDescriptor_07c64d3a2a89a388 = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe3, 0x52, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
 	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94,
-	0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44,
-	0x68, 0x84, 0x28, 0x14, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x3d, 0x17, 0x8f, 0x3b, 0xc4, 0xee, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6, 0x82,
-	0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x79, 0x3d, 0x1c, 0x6e,
-	0xd1, 0x0b, 0x00, 0x2b, 0x73, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c,
-	0x41, 0x50, 0x9d, 0x42, 0xd2, 0x5c, 0x9c, 0x49, 0x39, 0xf9, 0xc9, 0xd9, 0xf1, 0xe9, 0x89, 0xc5,
-	0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x1c, 0x60, 0x01, 0xf7, 0xc4, 0x62, 0x2f, 0x16, 0x0e,
-	0x26, 0x01, 0xe6, 0x20, 0x8e, 0xa4, 0xc4, 0xe2, 0xd4, 0xf8, 0xb4, 0xd4, 0x54, 0x27, 0xc7, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
-	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf2, 0x64, 0x05, 0x92, 0x37, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
-	0x93, 0xd8, 0xc0, 0x5e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8e, 0xbd, 0xe4, 0x86, 0x5e,
-	0x01, 0x00, 0x00,
+	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x03, 0x2a, 0xd3, 0x83, 0x2b, 0xd3, 0x2b, 0x33, 0x94, 0x12,
+	0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x8c, 0x44, 0x68,
+	0x84, 0x28, 0xd4, 0x42, 0x52, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x0c, 0x52, 0x54, 0x90, 0x58, 0x94,
+	0x98, 0x5b, 0x1c, 0x9f, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x09, 0x55, 0x2b, 0x92,
+	0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x10, 0x51, 0xa5, 0x1d, 0x4c, 0x5c, 0x3c, 0xee,
+	0x10, 0x87, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x39, 0x71, 0xb1, 0x41, 0x0c, 0x90, 0x60, 0x54,
+	0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x70, 0xbd, 0x00, 0xb0, 0x32, 0x27, 0xce, 0x13,
+	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x29, 0x24, 0xcd, 0xc5, 0x99,
+	0x94, 0x93, 0x9f, 0x9c, 0x1d, 0x9f, 0x9e, 0x58, 0x2c, 0xc1, 0x0c, 0x34, 0x86, 0x25, 0x88, 0x03,
+	0x2c, 0xe0, 0x9e, 0x58, 0x2c, 0x14, 0xc7, 0x25, 0x84, 0xe6, 0xc2, 0xcc, 0xd4, 0x62, 0x09, 0x16,
+	0x05, 0x66, 0xa0, 0x65, 0x2a, 0xc8, 0x96, 0x81, 0x3d, 0x84, 0xb0, 0xc8, 0x11, 0xe6, 0x1f, 0x64,
+	0x1b, 0x05, 0x0b, 0x50, 0xe4, 0x80, 0x26, 0x09, 0x85, 0x73, 0xf1, 0x41, 0xcd, 0xcf, 0xc8, 0x2c,
+	0x2e, 0xc9, 0x2f, 0xaa, 0x94, 0x60, 0x05, 0x9b, 0xad, 0x4a, 0xc0, 0x23, 0xce, 0x19, 0x89, 0x79,
+	0xe9, 0xa9, 0xc8, 0x86, 0xf3, 0x42, 0xcc, 0xf1, 0x80, 0x18, 0xe3, 0xc5, 0xc2, 0xc1, 0x24, 0xc0,
+	0x0c, 0xf4, 0x48, 0x62, 0x71, 0x6a, 0x3c, 0x50, 0xbf, 0x52, 0x16, 0x17, 0x0f, 0xb2, 0x4e, 0xaa,
+	0x84, 0x9c, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04, 0x13, 0xd0, 0x0c, 0xe6,
+	0x20, 0x28, 0xcf, 0xc9, 0xf1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x40, 0xfc, 0x00, 0x88, 0x27, 0x3c,
+	0x96, 0x63, 0xb8, 0x00, 0xc4, 0x37, 0x80, 0x38, 0x4a, 0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x09,
+	0x68, 0x57, 0xae, 0x3e, 0x52, 0x6a, 0xa8, 0x40, 0x4a, 0x38, 0xe0, 0x80, 0x4c, 0x62, 0x03, 0x47,
+	0xb8, 0x31, 0x00, 0x4e, 0x2e, 0x1f, 0x9f, 0xb0, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -129,6 +214,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParamsHistory) > 0 {
+		for iNdEx := len(m.ParamsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -147,6 +260,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -169,6 +320,32 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParamsHistory) > 0 {
+		for _, e := range m.ParamsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -259,6 +436,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsHistory = append(m.ParamsHistory, ParamsChange{})
+			if err := m.ParamsHistory[len(m.ParamsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type GenesisTestSuite struct {
@@ -28,8 +32,29 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 		{
 			"valid genesis",
 			&GenesisState{
-				DefaultParams(),
-				uint64(1),
+				Params:   DefaultParams(),
+				BlockGas: uint64(1),
+			},
+			true,
+		},
+		{
+			"valid genesis with params authorities",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParamsAuthorities: []cosmosevmtypes.ParamsAuthority{
+					{Authority: sdk.AccAddress([]byte("params_authority")).String(), Params: []string{"min_gas_price"}},
+				},
+			},
+			true,
+		},
+		{
+			"valid genesis with params history",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParamsHistory: []ParamsChange{
+					{Params: DefaultParams(), Height: 0},
+					{Params: DefaultParams(), Height: 10},
+				},
 			},
 			true,
 		},
@@ -49,6 +74,37 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"invalid params authority param",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParamsAuthorities: []cosmosevmtypes.ParamsAuthority{
+					{Authority: sdk.AccAddress([]byte("params_authority")).String(), Params: []string{"base_fee_denom"}},
+				},
+			},
+			false,
+		},
+		{
+			"params history not by ascending height",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParamsHistory: []ParamsChange{
+					{Params: DefaultParams(), Height: 10},
+					{Params: DefaultParams(), Height: 10},
+				},
+			},
+			false,
+		},
+		{
+			"invalid params history params",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParamsHistory: []ParamsChange{
+					{Params: Params{}, Height: 10},
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
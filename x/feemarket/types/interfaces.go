@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper used to burn the base fees. It
+// must support the EVM extended denomination, e.g. the x/precisebank keeper.
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected distribution keeper used to send the
+// base fees to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeRateOracle defines an optional source of conversion rates for the
+// alternative fee denominations, e.g. an x/oracle keeper or a price feed
+// sidecar. Rates are expressed as the amount of the EVM denomination, in its
+// 18 decimals representation, that one unit of the given denomination is worth.
+type FeeRateOracle interface {
+	GetFeeDenomRate(ctx sdk.Context, denom string) (rate math.LegacyDec, found bool)
+}
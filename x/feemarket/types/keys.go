@@ -20,18 +20,28 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixMinGasPriceWindowBlocks
+	prefixMinGasPriceWindowUtilization
+	prefixParamsAuthority
+	prefixParamsHistory
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBaseFees
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted               = []byte{prefixBlockGasWanted}
+	KeyPrefixMinGasPriceWindowBlocks      = []byte{prefixMinGasPriceWindowBlocks}
+	KeyPrefixMinGasPriceWindowUtilization = []byte{prefixMinGasPriceWindowUtilization}
+	KeyPrefixParamsAuthority              = []byte{prefixParamsAuthority}
+	KeyPrefixParamsHistory                = []byte{prefixParamsHistory}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBaseFees       = []byte{prefixTransientBaseFees}
 )
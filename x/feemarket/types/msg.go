@@ -1,12 +1,17 @@
 package types
 
 import (
+	cosmosevmtypes "github.com/cosmos/evm/types"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdateParamsAuthorities{}
+)
 
 // ValidateBasic does a sanity check of the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
@@ -21,3 +26,17 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParamsAuthorities) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return cosmosevmtypes.ValidateParamsAuthorities(m.ParamsAuthorities, &Params{})
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParamsAuthorities) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -19,6 +21,22 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeePolicy distributes the base fee to the validators
+	DefaultBaseFeePolicy = BaseFeePolicyDistribute
+	// DefaultMinBaseFee is 0 (i.e disabled)
+	DefaultMinBaseFee = math.LegacyZeroDec()
+	// DefaultMaxBaseFee is 0 (i.e disabled)
+	DefaultMaxBaseFee = math.LegacyZeroDec()
+	// DefaultMaxBaseFeeChangeRate is 0 (i.e disabled)
+	DefaultMaxBaseFeeChangeRate = math.LegacyZeroDec()
+	// DefaultMinGasPriceAdjustment doesn't adjust the min gas price (i.e disabled)
+	DefaultMinGasPriceAdjustment = MinGasPriceAdjustment{
+		Window:             0,
+		TargetUtilization:  math.LegacyZeroDec(),
+		MaxChangeRate:      math.LegacyZeroDec(),
+		MinGasPriceFloor:   math.LegacyZeroDec(),
+		MinGasPriceCeiling: math.LegacyZeroDec(),
+	}
 
 	ParamsKey = []byte("Params")
 )
@@ -41,6 +59,10 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		MaxBaseFeeChangeRate:     DefaultMaxBaseFeeChangeRate,
+		MinGasPriceAdjustment:    DefaultMinGasPriceAdjustment,
 	}
 }
 
@@ -54,6 +76,11 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeePolicy:            DefaultBaseFeePolicy,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		MaxBaseFeeChangeRate:     DefaultMaxBaseFeeChangeRate,
+		MinGasPriceAdjustment:    DefaultMinGasPriceAdjustment,
 	}
 }
 
@@ -79,9 +106,129 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateFeeDenomRates(p.FeeDenomRates); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeePolicy(p.BaseFeePolicy); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBounds(p.MinBaseFee, p.MaxBaseFee, p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateMaxBaseFeeChangeRate(p.MaxBaseFeeChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPriceOverrides(p.MinGasPriceOverrides); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPriceAdjustment(p.MinGasPriceAdjustment, p.MaxBaseFee); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
+// GetFeeDenomRate returns the conversion rate configured for the given
+// alternative fee denomination.
+func (p Params) GetFeeDenomRate(denom string) (math.LegacyDec, bool) {
+	for _, feeDenomRate := range p.FeeDenomRates {
+		if feeDenomRate.Denom == denom {
+			return feeDenomRate.Rate, true
+		}
+	}
+	return math.LegacyDec{}, false
+}
+
+// GetMinGasPriceOverride returns the minimum gas price set for the given fee
+// denomination, which replaces the MinGasPrice parameter for the transactions
+// paying their fees in that denomination.
+func (p Params) GetMinGasPriceOverride(denom string) (math.LegacyDec, bool) {
+	for _, override := range p.MinGasPriceOverrides {
+		if override.Denom == denom {
+			return override.MinGasPrice, true
+		}
+	}
+	return math.LegacyDec{}, false
+}
+
+// GasTarget returns the block gas targeted by the base fee adjustments for the
+// given block gas limit: the BlockGasTarget parameter capped to the gas limit if
+// it's set, or the gas limit divided by the elasticity multiplier otherwise.
+//
+// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+// validation.
+func (p Params) GasTarget(gasLimit uint64) uint64 {
+	if p.BlockGasTarget > 0 {
+		return min(p.BlockGasTarget, gasLimit)
+	}
+	return gasLimit / uint64(p.ElasticityMultiplier)
+}
+
+// ClampBaseFee bounds the base fee computed from the parent block's base fee by
+// the maximum change rate, and then by the base fee floor and ceiling. Each bound
+// is ignored if its parameter is zero.
+func (p Params) ClampBaseFee(parentBaseFee, baseFee math.LegacyDec) math.LegacyDec {
+	if isSet(p.MaxBaseFeeChangeRate) {
+		maxDelta := parentBaseFee.Mul(p.MaxBaseFeeChangeRate)
+		baseFee = math.LegacyMinDec(baseFee, parentBaseFee.Add(maxDelta))
+		baseFee = math.LegacyMaxDec(baseFee, parentBaseFee.Sub(maxDelta))
+	}
+
+	if isSet(p.MinBaseFee) {
+		baseFee = math.LegacyMaxDec(baseFee, p.MinBaseFee)
+	}
+
+	if isSet(p.MaxBaseFee) {
+		baseFee = math.LegacyMinDec(baseFee, p.MaxBaseFee)
+	}
+
+	return baseFee
+}
+
+// AdjustMinGasPrice returns the min gas price set by the adjustments for the
+// given average utilization of the block gas limit over a window. The min gas
+// price changes proportionally to the deviation of the utilization from its
+// target, by at most the maximum change rate, and is kept within the floor and
+// ceiling.
+//
+// CONTRACT: the adjustments must be enabled, which guarantees a positive target
+// utilization through the params validation.
+func (p Params) AdjustMinGasPrice(utilization math.LegacyDec) math.LegacyDec {
+	adjustment := p.MinGasPriceAdjustment
+
+	// relative deviation of the utilization from the target, within [-1, 1]
+	deviation := utilization.Sub(adjustment.TargetUtilization).Quo(adjustment.TargetUtilization)
+	deviation = math.LegacyMinDec(deviation, math.LegacyOneDec())
+	deviation = math.LegacyMaxDec(deviation, math.LegacyOneDec().Neg())
+
+	minGasPrice := adjustment.clamp(p.MinGasPrice)
+	minGasPrice = minGasPrice.Add(minGasPrice.Mul(adjustment.MaxChangeRate).Mul(deviation))
+
+	return adjustment.clamp(minGasPrice)
+}
+
+// IsEnabled returns true if the min gas price is adjusted based on the block
+// utilization.
+func (a MinGasPriceAdjustment) IsEnabled() bool {
+	return a.Window > 0
+}
+
+// clamp bounds the min gas price by the floor and ceiling of the adjustments.
+func (a MinGasPriceAdjustment) clamp(minGasPrice math.LegacyDec) math.LegacyDec {
+	minGasPrice = math.LegacyMaxDec(minGasPrice, a.MinGasPriceFloor)
+	return math.LegacyMinDec(minGasPrice, a.MinGasPriceCeiling)
+}
+
+// isSet returns true if the optional decimal parameter is enabled.
+func isSet(value math.LegacyDec) bool {
+	return !value.IsNil() && value.IsPositive()
+}
+
 func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
@@ -98,6 +245,134 @@ func validateMinGasPrice(gasPrice math.LegacyDec) error {
 	return nil
 }
 
+func validateFeeDenomRates(feeDenomRates []FeeDenomRate) error {
+	seenDenoms := make(map[string]struct{}, len(feeDenomRates))
+	for _, feeDenomRate := range feeDenomRates {
+		if err := sdk.ValidateDenom(feeDenomRate.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+
+		if _, found := seenDenoms[feeDenomRate.Denom]; found {
+			return fmt.Errorf("duplicate fee denom: %s", feeDenomRate.Denom)
+		}
+		seenDenoms[feeDenomRate.Denom] = struct{}{}
+
+		if feeDenomRate.Rate.IsNil() || !feeDenomRate.Rate.IsPositive() {
+			return fmt.Errorf("fee denom %s rate must be positive: %s", feeDenomRate.Denom, feeDenomRate.Rate)
+		}
+	}
+
+	return nil
+}
+
+func validateMinGasPriceOverrides(overrides []DenomMinGasPrice) error {
+	seenDenoms := make(map[string]struct{}, len(overrides))
+	for _, override := range overrides {
+		if err := sdk.ValidateDenom(override.Denom); err != nil {
+			return fmt.Errorf("invalid min gas price override denom: %w", err)
+		}
+
+		if _, found := seenDenoms[override.Denom]; found {
+			return fmt.Errorf("duplicate min gas price override denom: %s", override.Denom)
+		}
+		seenDenoms[override.Denom] = struct{}{}
+
+		if err := validateMinGasPrice(override.MinGasPrice); err != nil {
+			return fmt.Errorf("invalid min gas price override for %s: %w", override.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+func validateBaseFeePolicy(policy BaseFeePolicy) error {
+	if _, found := BaseFeePolicy_name[int32(policy)]; !found {
+		return fmt.Errorf("invalid base fee policy: %d", policy)
+	}
+
+	return nil
+}
+
+func validateBaseFeeBounds(minBaseFee, maxBaseFee, minGasPrice math.LegacyDec) error {
+	if minBaseFee.IsNil() || maxBaseFee.IsNil() {
+		return fmt.Errorf("invalid base fee bounds: nil")
+	}
+
+	if minBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", minBaseFee)
+	}
+
+	if maxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", maxBaseFee)
+	}
+
+	if !maxBaseFee.IsPositive() {
+		return nil
+	}
+
+	if maxBaseFee.LT(minBaseFee) {
+		return fmt.Errorf("max base fee %s cannot be lower than min base fee %s", maxBaseFee, minBaseFee)
+	}
+
+	// the base fee can't decrease below the min gas price
+	if !minGasPrice.IsNil() && maxBaseFee.LT(minGasPrice) {
+		return fmt.Errorf("max base fee %s cannot be lower than min gas price %s", maxBaseFee, minGasPrice)
+	}
+
+	return nil
+}
+
+func validateMaxBaseFeeChangeRate(rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if rate.IsNegative() {
+		return fmt.Errorf("max base fee change rate cannot be negative: %s", rate)
+	}
+
+	if rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max base fee change rate cannot be greater than 1: %s", rate)
+	}
+
+	return nil
+}
+
+func validateMinGasPriceAdjustment(adjustment MinGasPriceAdjustment, maxBaseFee math.LegacyDec) error {
+	if adjustment.TargetUtilization.IsNil() || adjustment.MaxChangeRate.IsNil() ||
+		adjustment.MinGasPriceFloor.IsNil() || adjustment.MinGasPriceCeiling.IsNil() {
+		return fmt.Errorf("invalid min gas price adjustment: nil")
+	}
+
+	if !adjustment.IsEnabled() {
+		return nil
+	}
+
+	if !adjustment.TargetUtilization.IsPositive() || adjustment.TargetUtilization.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min gas price target utilization must be within (0, 1]: %s", adjustment.TargetUtilization)
+	}
+
+	if !adjustment.MaxChangeRate.IsPositive() || adjustment.MaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("min gas price max change rate must be within (0, 1]: %s", adjustment.MaxChangeRate)
+	}
+
+	// a zero min gas price can't be increased by a relative change
+	if !adjustment.MinGasPriceFloor.IsPositive() {
+		return fmt.Errorf("min gas price floor must be positive: %s", adjustment.MinGasPriceFloor)
+	}
+
+	if adjustment.MinGasPriceCeiling.LT(adjustment.MinGasPriceFloor) {
+		return fmt.Errorf("min gas price ceiling %s cannot be lower than min gas price floor %s", adjustment.MinGasPriceCeiling, adjustment.MinGasPriceFloor)
+	}
+
+	// the adjusted min gas price can't exceed the max base fee
+	if isSet(maxBaseFee) && adjustment.MinGasPriceCeiling.GT(maxBaseFee) {
+		return fmt.Errorf("min gas price ceiling %s cannot be greater than max base fee %s", adjustment.MinGasPriceCeiling, maxBaseFee)
+	}
+
+	return nil
+}
+
 func validateMinGasMultiplier(multiplier math.LegacyDec) error {
 	if multiplier.IsNil() {
 		return fmt.Errorf("invalid parameter: nil")
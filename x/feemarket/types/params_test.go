@@ -113,3 +113,298 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateFeeDenomRates() {
+	testCases := []struct {
+		name     string
+		value    []FeeDenomRate
+		expError bool
+	}{
+		{"empty", nil, false},
+		{"valid", []FeeDenomRate{{Denom: "uatom", Rate: math.LegacyNewDec(10)}}, false},
+		{"invalid - bad denom", []FeeDenomRate{{Denom: "1", Rate: math.LegacyNewDec(10)}}, true},
+		{"invalid - duplicate denom", []FeeDenomRate{{Denom: "uatom", Rate: math.LegacyNewDec(10)}, {Denom: "uatom", Rate: math.LegacyNewDec(2)}}, true},
+		{"invalid - zero rate", []FeeDenomRate{{Denom: "uatom", Rate: math.LegacyZeroDec()}}, true},
+		{"invalid - nil rate", []FeeDenomRate{{Denom: "uatom"}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := validateFeeDenomRates(tc.value)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeePolicy() {
+	testCases := []struct {
+		name     string
+		value    BaseFeePolicy
+		expError bool
+	}{
+		{"default", DefaultParams().BaseFeePolicy, false},
+		{"burn", BaseFeePolicyBurn, false},
+		{"community pool", BaseFeePolicyCommunityPool, false},
+		{"invalid - unknown policy", BaseFeePolicy(3), true},
+	}
+
+	for _, tc := range testCases {
+		err := validateBaseFeePolicy(tc.value)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsGasTarget() {
+	testCases := []struct {
+		name           string
+		blockGasTarget uint64
+		gasLimit       uint64
+		expGasTarget   uint64
+	}{
+		{"derived from gas limit", 0, 30_000_000, 15_000_000},
+		{"independent target", 10_000_000, 30_000_000, 10_000_000},
+		{"independent target capped to gas limit", 40_000_000, 30_000_000, 30_000_000},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.BlockGasTarget = tc.blockGasTarget
+
+		suite.Require().Equal(tc.expGasTarget, params.GasTarget(tc.gasLimit), tc.name)
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateBaseFeeBounds() {
+	testCases := []struct {
+		name        string
+		minBaseFee  math.LegacyDec
+		maxBaseFee  math.LegacyDec
+		minGasPrice math.LegacyDec
+		expError    bool
+	}{
+		{"default", DefaultMinBaseFee, DefaultMaxBaseFee, DefaultMinGasPrice, false},
+		{"floor without ceiling", math.LegacyNewDec(10), math.LegacyZeroDec(), math.LegacyZeroDec(), false},
+		{"floor and ceiling", math.LegacyNewDec(10), math.LegacyNewDec(100), math.LegacyNewDec(5), false},
+		{"invalid - nil", math.LegacyDec{}, math.LegacyZeroDec(), math.LegacyZeroDec(), true},
+		{"invalid - negative floor", math.LegacyNewDec(-1), math.LegacyZeroDec(), math.LegacyZeroDec(), true},
+		{"invalid - negative ceiling", math.LegacyZeroDec(), math.LegacyNewDec(-1), math.LegacyZeroDec(), true},
+		{"invalid - ceiling below floor", math.LegacyNewDec(100), math.LegacyNewDec(10), math.LegacyZeroDec(), true},
+		{"invalid - ceiling below min gas price", math.LegacyZeroDec(), math.LegacyNewDec(10), math.LegacyNewDec(100), true},
+	}
+
+	for _, tc := range testCases {
+		err := validateBaseFeeBounds(tc.minBaseFee, tc.maxBaseFee, tc.minGasPrice)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateMaxBaseFeeChangeRate() {
+	testCases := []struct {
+		name     string
+		value    math.LegacyDec
+		expError bool
+	}{
+		{"default", DefaultMaxBaseFeeChangeRate, false},
+		{"valid", math.LegacyNewDecWithPrec(125, 3), false},
+		{"one", math.LegacyOneDec(), false},
+		{"invalid - nil", math.LegacyDec{}, true},
+		{"invalid - negative", math.LegacyNewDec(-1), true},
+		{"invalid - greater than one", math.LegacyNewDecWithPrec(11, 1), true},
+	}
+
+	for _, tc := range testCases {
+		err := validateMaxBaseFeeChangeRate(tc.value)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsClampBaseFee() {
+	parentBaseFee := math.LegacyNewDec(1000)
+
+	testCases := []struct {
+		name       string
+		malleate   func(params *Params)
+		baseFee    math.LegacyDec
+		expBaseFee math.LegacyDec
+	}{
+		{"no bounds", func(*Params) {}, math.LegacyNewDec(2000), math.LegacyNewDec(2000)},
+		{"unset bounds", func(params *Params) { *params = Params{} }, math.LegacyNewDec(2000), math.LegacyNewDec(2000)},
+		{
+			"increase clamped by change rate",
+			func(params *Params) { params.MaxBaseFeeChangeRate = math.LegacyNewDecWithPrec(1, 1) },
+			math.LegacyNewDec(2000),
+			math.LegacyNewDec(1100),
+		},
+		{
+			"decrease clamped by change rate",
+			func(params *Params) { params.MaxBaseFeeChangeRate = math.LegacyNewDecWithPrec(1, 1) },
+			math.LegacyNewDec(500),
+			math.LegacyNewDec(900),
+		},
+		{
+			"change within rate",
+			func(params *Params) { params.MaxBaseFeeChangeRate = math.LegacyNewDecWithPrec(1, 1) },
+			math.LegacyNewDec(1050),
+			math.LegacyNewDec(1050),
+		},
+		{
+			"clamped by floor",
+			func(params *Params) { params.MinBaseFee = math.LegacyNewDec(800) },
+			math.LegacyNewDec(500),
+			math.LegacyNewDec(800),
+		},
+		{
+			"clamped by ceiling",
+			func(params *Params) { params.MaxBaseFee = math.LegacyNewDec(1500) },
+			math.LegacyNewDec(2000),
+			math.LegacyNewDec(1500),
+		},
+		{
+			"ceiling applied after change rate",
+			func(params *Params) {
+				params.MaxBaseFeeChangeRate = math.LegacyNewDecWithPrec(5, 1)
+				params.MaxBaseFee = math.LegacyNewDec(1200)
+			},
+			math.LegacyNewDec(2000),
+			math.LegacyNewDec(1200),
+		},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		tc.malleate(&params)
+
+		suite.Require().Equal(tc.expBaseFee, params.ClampBaseFee(parentBaseFee, tc.baseFee), tc.name)
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateMinGasPriceOverrides() {
+	testCases := []struct {
+		name     string
+		value    []DenomMinGasPrice
+		expError bool
+	}{
+		{"empty", nil, false},
+		{"valid", []DenomMinGasPrice{{Denom: "uusdc", MinGasPrice: math.LegacyNewDecWithPrec(5, 2)}, {Denom: "uosmo", MinGasPrice: math.LegacyZeroDec()}}, false},
+		{"invalid - denom", []DenomMinGasPrice{{Denom: "", MinGasPrice: math.LegacyOneDec()}}, true},
+		{"invalid - duplicate denom", []DenomMinGasPrice{{Denom: "uusdc", MinGasPrice: math.LegacyOneDec()}, {Denom: "uusdc", MinGasPrice: math.LegacyOneDec()}}, true},
+		{"invalid - nil min gas price", []DenomMinGasPrice{{Denom: "uusdc"}}, true},
+		{"invalid - negative min gas price", []DenomMinGasPrice{{Denom: "uusdc", MinGasPrice: math.LegacyNewDec(-1)}}, true},
+	}
+
+	for _, tc := range testCases {
+		err := validateMinGasPriceOverrides(tc.value)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsGetMinGasPriceOverride() {
+	params := DefaultParams()
+	params.MinGasPriceOverrides = []DenomMinGasPrice{{Denom: "uusdc", MinGasPrice: math.LegacyNewDec(5)}}
+
+	minGasPrice, found := params.GetMinGasPriceOverride("uusdc")
+	suite.Require().True(found)
+	suite.Require().Equal(math.LegacyNewDec(5), minGasPrice)
+
+	_, found = params.GetMinGasPriceOverride("uosmo")
+	suite.Require().False(found)
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateMinGasPriceAdjustment() {
+	enabled := MinGasPriceAdjustment{
+		Window:             10,
+		TargetUtilization:  math.LegacyNewDecWithPrec(5, 1),
+		MaxChangeRate:      math.LegacyNewDecWithPrec(1, 1),
+		MinGasPriceFloor:   math.LegacyNewDec(10),
+		MinGasPriceCeiling: math.LegacyNewDec(1000),
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func(adjustment *MinGasPriceAdjustment)
+		maxBaseFee math.LegacyDec
+		expError   bool
+	}{
+		{"disabled", func(adjustment *MinGasPriceAdjustment) { *adjustment = DefaultMinGasPriceAdjustment }, math.LegacyZeroDec(), false},
+		{"enabled", func(*MinGasPriceAdjustment) {}, math.LegacyZeroDec(), false},
+		{"ceiling equal to max base fee", func(*MinGasPriceAdjustment) {}, math.LegacyNewDec(1000), false},
+		{"invalid - nil", func(adjustment *MinGasPriceAdjustment) { *adjustment = MinGasPriceAdjustment{} }, math.LegacyZeroDec(), true},
+		{"invalid - zero target utilization", func(adjustment *MinGasPriceAdjustment) { adjustment.TargetUtilization = math.LegacyZeroDec() }, math.LegacyZeroDec(), true},
+		{"invalid - target utilization greater than one", func(adjustment *MinGasPriceAdjustment) {
+			adjustment.TargetUtilization = math.LegacyNewDecWithPrec(11, 1)
+		}, math.LegacyZeroDec(), true},
+		{"invalid - zero change rate", func(adjustment *MinGasPriceAdjustment) { adjustment.MaxChangeRate = math.LegacyZeroDec() }, math.LegacyZeroDec(), true},
+		{"invalid - change rate greater than one", func(adjustment *MinGasPriceAdjustment) { adjustment.MaxChangeRate = math.LegacyNewDecWithPrec(11, 1) }, math.LegacyZeroDec(), true},
+		{"invalid - zero floor", func(adjustment *MinGasPriceAdjustment) { adjustment.MinGasPriceFloor = math.LegacyZeroDec() }, math.LegacyZeroDec(), true},
+		{"invalid - ceiling below floor", func(adjustment *MinGasPriceAdjustment) { adjustment.MinGasPriceCeiling = math.LegacyNewDec(5) }, math.LegacyZeroDec(), true},
+		{"invalid - ceiling above max base fee", func(*MinGasPriceAdjustment) {}, math.LegacyNewDec(500), true},
+	}
+
+	for _, tc := range testCases {
+		adjustment := enabled
+		tc.malleate(&adjustment)
+
+		err := validateMinGasPriceAdjustment(adjustment, tc.maxBaseFee)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsAdjustMinGasPrice() {
+	testCases := []struct {
+		name           string
+		minGasPrice    math.LegacyDec
+		utilization    math.LegacyDec
+		expMinGasPrice math.LegacyDec
+	}{
+		{"on target", math.LegacyNewDec(100), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(100)},
+		{"above target", math.LegacyNewDec(100), math.LegacyNewDecWithPrec(75, 2), math.LegacyNewDec(105)},
+		{"full blocks", math.LegacyNewDec(100), math.LegacyOneDec(), math.LegacyNewDec(110)},
+		{"below target", math.LegacyNewDec(100), math.LegacyNewDecWithPrec(25, 2), math.LegacyNewDec(95)},
+		{"empty blocks", math.LegacyNewDec(100), math.LegacyZeroDec(), math.LegacyNewDec(90)},
+		{"clamped by floor", math.LegacyNewDec(10), math.LegacyZeroDec(), math.LegacyNewDec(10)},
+		{"clamped by ceiling", math.LegacyNewDec(1000), math.LegacyOneDec(), math.LegacyNewDec(1000)},
+		{"raised from below floor", math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyNewDec(11)},
+	}
+
+	for _, tc := range testCases {
+		params := DefaultParams()
+		params.MinGasPrice = tc.minGasPrice
+		params.MinGasPriceAdjustment = MinGasPriceAdjustment{
+			Window:             10,
+			TargetUtilization:  math.LegacyNewDecWithPrec(5, 1),
+			MaxChangeRate:      math.LegacyNewDecWithPrec(1, 1),
+			MinGasPriceFloor:   math.LegacyNewDec(10),
+			MinGasPriceCeiling: math.LegacyNewDec(1000),
+		}
+
+		suite.Require().Equal(tc.expMinGasPrice, params.AdjustMinGasPrice(tc.utilization), tc.name)
+	}
+}
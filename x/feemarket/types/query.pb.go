@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -275,6 +276,197 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryParamsAuthoritiesRequest defines the request type for querying the
+// accounts allowed to update a subset of the x/feemarket parameters.
+type QueryParamsAuthoritiesRequest struct {
+}
+
+func (m *QueryParamsAuthoritiesRequest) Reset()         { *m = QueryParamsAuthoritiesRequest{} }
+func (m *QueryParamsAuthoritiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAuthoritiesRequest) ProtoMessage()    {}
+func (*QueryParamsAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAuthoritiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAuthoritiesRequest.Merge(m, src)
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAuthoritiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAuthoritiesRequest proto.InternalMessageInfo
+
+// QueryParamsAuthoritiesResponse defines the response type for querying the
+// accounts allowed to update a subset of the x/feemarket parameters.
+type QueryParamsAuthoritiesResponse struct {
+	// params_authorities are the accounts and the parameters they can update.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,1,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+}
+
+func (m *QueryParamsAuthoritiesResponse) Reset()         { *m = QueryParamsAuthoritiesResponse{} }
+func (m *QueryParamsAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAuthoritiesResponse) ProtoMessage()    {}
+func (*QueryParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAuthoritiesResponse.Merge(m, src)
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAuthoritiesResponse proto.InternalMessageInfo
+
+func (m *QueryParamsAuthoritiesResponse) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+// QueryParamsAtHeightRequest defines the request type for querying the x/feemarket
+// parameters in effect at a past height.
+type QueryParamsAtHeightRequest struct {
+	// height is the block height to query the parameters at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryParamsAtHeightRequest) Reset()         { *m = QueryParamsAtHeightRequest{} }
+func (m *QueryParamsAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAtHeightRequest) ProtoMessage()    {}
+func (*QueryParamsAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{8}
+}
+func (m *QueryParamsAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAtHeightRequest.Merge(m, src)
+}
+func (m *QueryParamsAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryParamsAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryParamsAtHeightResponse defines the response type for querying the
+// x/feemarket parameters in effect at a past height.
+type QueryParamsAtHeightResponse struct {
+	// params are the parameters in effect at the end of the queried block. The
+	// base fee is updated every block outside of the parameter change history,
+	// so base_fee is always zero.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// change_height is the height of the parameter change in effect at the
+	// queried block.
+	ChangeHeight int64 `protobuf:"varint,2,opt,name=change_height,json=changeHeight,proto3" json:"change_height,omitempty"`
+}
+
+func (m *QueryParamsAtHeightResponse) Reset()         { *m = QueryParamsAtHeightResponse{} }
+func (m *QueryParamsAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAtHeightResponse) ProtoMessage()    {}
+func (*QueryParamsAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{9}
+}
+func (m *QueryParamsAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAtHeightResponse.Merge(m, src)
+}
+func (m *QueryParamsAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryParamsAtHeightResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryParamsAtHeightResponse) GetChangeHeight() int64 {
+	if m != nil {
+		return m.ChangeHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
@@ -282,6 +474,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryParamsAuthoritiesRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsAuthoritiesRequest")
+	proto.RegisterType((*QueryParamsAuthoritiesResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsAuthoritiesResponse")
+	proto.RegisterType((*QueryParamsAtHeightRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsAtHeightRequest")
+	proto.RegisterType((*QueryParamsAtHeightResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsAtHeightResponse")
 }
 
 func init() {
@@ -289,36 +485,48 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 646 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6b, 0x13, 0x41,
+	0x18, 0xce, 0xb4, 0x98, 0xb6, 0x53, 0x15, 0x3b, 0xb6, 0x55, 0xb6, 0xba, 0x6b, 0xb7, 0x85, 0x7e,
+	0xd9, 0x5d, 0x9a, 0x04, 0x85, 0xde, 0x1a, 0x44, 0x3d, 0x78, 0xd0, 0xdc, 0xf4, 0x60, 0x98, 0xac,
+	0xd3, 0xdd, 0x25, 0xdd, 0x9d, 0x6d, 0x66, 0x12, 0x0c, 0x22, 0x88, 0x07, 0x4f, 0x1e, 0x14, 0xf1,
+	0x3f, 0x78, 0xf4, 0xa6, 0xfe, 0x83, 0x1e, 0x0b, 0x5e, 0xc4, 0x43, 0x91, 0x44, 0xf0, 0x6f, 0xc8,
+	0xce, 0x4c, 0xd2, 0xdd, 0xc4, 0x25, 0x29, 0x78, 0x49, 0x36, 0x6f, 0x9e, 0xf7, 0x79, 0x9e, 0xf7,
+	0x2b, 0x81, 0x2b, 0x0e, 0x65, 0x01, 0x65, 0x36, 0x69, 0x05, 0xf6, 0x3e, 0x21, 0x01, 0x6e, 0xd4,
+	0x09, 0xb7, 0x5b, 0x3b, 0xf6, 0x61, 0x93, 0x34, 0xda, 0x56, 0xd4, 0xa0, 0x9c, 0xa2, 0x2b, 0x12,
+	0x64, 0x91, 0x56, 0x60, 0xf5, 0x41, 0x56, 0x6b, 0x47, 0x9b, 0xc3, 0x81, 0x1f, 0x52, 0x5b, 0xbc,
+	0x4a, 0xac, 0xb6, 0x96, 0x45, 0x78, 0x9a, 0x28, 0x81, 0x9b, 0x09, 0x20, 0x6f, 0x47, 0x84, 0xc5,
+	0xa0, 0x08, 0x37, 0x70, 0xc0, 0xaa, 0xb8, 0xc9, 0x3d, 0xda, 0xf0, 0xb9, 0x32, 0xa0, 0xcd, 0xbb,
+	0xd4, 0xa5, 0xe2, 0xd1, 0x8e, 0x9f, 0x54, 0xf4, 0x9a, 0x4b, 0xa9, 0x7b, 0x40, 0x6c, 0x1c, 0xf9,
+	0x36, 0x0e, 0x43, 0xca, 0x31, 0xf7, 0x69, 0xc8, 0xe4, 0xb7, 0xe6, 0x3c, 0x44, 0x8f, 0xe2, 0x1a,
+	0x1e, 0x0a, 0xca, 0x0a, 0x39, 0x6c, 0x12, 0xc6, 0xcd, 0xc7, 0xf0, 0x72, 0x2a, 0xca, 0x22, 0x1a,
+	0x32, 0x82, 0xca, 0x30, 0x2f, 0xa5, 0xaf, 0x82, 0x1b, 0x60, 0x7d, 0xb6, 0x60, 0x58, 0x19, 0x25,
+	0x5b, 0x32, 0xb1, 0x3c, 0x73, 0x74, 0x62, 0xe4, 0x3e, 0xfd, 0xf9, 0xbc, 0x09, 0x2a, 0x2a, 0xd3,
+	0x5c, 0x50, 0xd4, 0x65, 0xcc, 0xc8, 0x5d, 0x42, 0x7a, 0x8a, 0x15, 0x38, 0x9f, 0x0e, 0x2b, 0xc9,
+	0x5d, 0x38, 0x5d, 0xc3, 0x8c, 0x54, 0xf7, 0x09, 0x11, 0xa2, 0x33, 0x65, 0xe3, 0xe7, 0x89, 0xb1,
+	0x24, 0x75, 0xd9, 0xb3, 0xba, 0xe5, 0x53, 0x3b, 0xc0, 0xdc, 0xb3, 0x1e, 0x10, 0x17, 0x3b, 0xed,
+	0x3b, 0xc4, 0xa9, 0x4c, 0xd5, 0x24, 0x87, 0xb9, 0xd8, 0xe3, 0x3c, 0xa0, 0x4e, 0xfd, 0x1e, 0xee,
+	0x57, 0xb7, 0x01, 0x17, 0x06, 0xe2, 0x4a, 0xec, 0x12, 0x9c, 0x74, 0xb1, 0x2c, 0x6e, 0xb2, 0x12,
+	0x3f, 0x9a, 0x06, 0xbc, 0x9e, 0x68, 0xc4, 0x9e, 0x6a, 0xb8, 0x4f, 0xfa, 0x5c, 0xaf, 0x00, 0xd4,
+	0xb3, 0x10, 0x8a, 0xf5, 0x29, 0x44, 0x03, 0x03, 0xf3, 0x49, 0x2c, 0x32, 0xb9, 0x3e, 0x5b, 0x58,
+	0x4d, 0x76, 0x50, 0xcc, 0xf7, 0xb4, 0x7b, 0x3d, 0xae, 0x76, 0xb2, 0x8d, 0x73, 0xd1, 0xa0, 0x8e,
+	0x59, 0x82, 0x5a, 0xd2, 0x01, 0xbf, 0x4f, 0x7c, 0xd7, 0xe3, 0xca, 0x20, 0x5a, 0x84, 0x79, 0x4f,
+	0x04, 0x54, 0x59, 0xea, 0x93, 0xf9, 0x06, 0xc0, 0xa5, 0x7f, 0xa6, 0xfd, 0xbf, 0x59, 0xa3, 0x15,
+	0x78, 0xc1, 0xf1, 0x70, 0xe8, 0x92, 0xaa, 0xb2, 0x30, 0x21, 0x2c, 0x9c, 0x97, 0x41, 0x29, 0x58,
+	0xf8, 0x9a, 0x87, 0xe7, 0x84, 0x11, 0xf4, 0x16, 0xc0, 0xbc, 0x24, 0x43, 0x5b, 0x99, 0x6a, 0xc3,
+	0xdb, 0xaa, 0xdd, 0x1c, 0x0f, 0x2c, 0x0b, 0x33, 0xd7, 0x5e, 0x7f, 0xff, 0xfd, 0x61, 0x62, 0x19,
+	0x19, 0x76, 0xd6, 0x0d, 0x2a, 0xf7, 0xef, 0x01, 0x9c, 0x52, 0xeb, 0x88, 0x46, 0x48, 0xa4, 0x97,
+	0x59, 0xdb, 0x1e, 0x13, 0xad, 0x1c, 0x6d, 0x08, 0x47, 0x2b, 0x68, 0x39, 0xd3, 0x51, 0xef, 0x04,
+	0xd0, 0x47, 0x00, 0xa7, 0x7b, 0x6b, 0x8b, 0x46, 0xc9, 0xa4, 0xd7, 0x5e, 0xb3, 0xc6, 0x85, 0x2b,
+	0x5b, 0x9b, 0xc2, 0xd6, 0x2a, 0x32, 0xb3, 0x6d, 0xc5, 0x29, 0x55, 0x17, 0x33, 0xf4, 0x0d, 0xc0,
+	0xb9, 0xa1, 0x0b, 0x40, 0xb7, 0xc6, 0x19, 0xcc, 0xf0, 0x51, 0x69, 0xb7, 0xcf, 0x9c, 0xa7, 0x2c,
+	0x17, 0x85, 0xe5, 0x6d, 0xb4, 0x35, 0x62, 0xb6, 0xc9, 0x4b, 0x44, 0x5f, 0x00, 0xbc, 0x98, 0x3e,
+	0x02, 0x54, 0x1c, 0xcb, 0x40, 0xfa, 0xd2, 0xb4, 0xd2, 0xd9, 0x92, 0x94, 0xe5, 0x5d, 0x61, 0xb9,
+	0x84, 0x0a, 0x23, 0x2d, 0x73, 0x75, 0x45, 0xf6, 0x0b, 0xf9, 0xfe, 0xb2, 0xbc, 0x77, 0xd4, 0xd1,
+	0xc1, 0x71, 0x47, 0x07, 0xbf, 0x3a, 0x3a, 0x78, 0xd7, 0xd5, 0x73, 0xc7, 0x5d, 0x3d, 0xf7, 0xa3,
+	0xab, 0xe7, 0x9e, 0xac, 0xb9, 0x3e, 0xf7, 0x9a, 0x35, 0xcb, 0xa1, 0x41, 0x92, 0xf7, 0x79, 0x82,
+	0x59, 0xfc, 0xda, 0xd4, 0xf2, 0xe2, 0x6f, 0xa0, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x88, 0xda,
+	0xbb, 0x50, 0xe2, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +547,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/feemarket module parameters.
+	ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/feemarket module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +590,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error) {
+	out := new(QueryParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/ParamsAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/ParamsAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +616,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/feemarket module parameters.
+	ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/feemarket module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +637,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) ParamsAuthorities(ctx context.Context, req *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAuthorities not implemented")
+}
+func (*UnimplementedQueryServer) ParamsAtHeight(ctx context.Context, req *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +702,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/ParamsAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAuthorities(ctx, req.(*QueryParamsAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/ParamsAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +754,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "ParamsAuthorities",
+			Handler:    _Query_ParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -644,6 +932,132 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsAuthoritiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAuthoritiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAuthoritiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChangeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -718,13 +1132,63 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsAuthoritiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryParamsAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ChangeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ChangeHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1112,6 +1576,311 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsAuthoritiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeHeight", wireType)
+			}
+			m.ChangeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -125,37 +126,140 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateParamsAuthorities defines a Msg for updating the accounts allowed to
+// update a subset of the x/feemarket module parameters.
+type MsgUpdateParamsAuthorities struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params_authorities defines the accounts replacing the current ones.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,2,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+}
+
+func (m *MsgUpdateParamsAuthorities) Reset()         { *m = MsgUpdateParamsAuthorities{} }
+func (m *MsgUpdateParamsAuthorities) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsAuthorities) ProtoMessage()    {}
+func (*MsgUpdateParamsAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{2}
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsAuthorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsAuthorities.Merge(m, src)
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsAuthorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsAuthorities proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsAuthorities) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParamsAuthorities) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+// MsgUpdateParamsAuthoritiesResponse defines the response structure for
+// executing a MsgUpdateParamsAuthorities message.
+type MsgUpdateParamsAuthoritiesResponse struct {
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Reset()         { *m = MsgUpdateParamsAuthoritiesResponse{} }
+func (m *MsgUpdateParamsAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsAuthoritiesResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e58e525ba547f0, []int{3}
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateParamsAuthorities)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParamsAuthorities")
+	proto.RegisterType((*MsgUpdateParamsAuthoritiesResponse)(nil), "cosmos.evm.feemarket.v1.MsgUpdateParamsAuthoritiesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/feemarket/v1/tx.proto", fileDescriptor_e1e58e525ba547f0) }
 
 var fileDescriptor_e1e58e525ba547f0 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
+	// 449 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d,
 	0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
 	0xd0, 0x4b, 0x2d, 0xcb, 0xd5, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x4c, 0x43, 0x68, 0x84, 0x28, 0x84, 0x1a,
-	0xaa, 0x9f, 0x5b, 0x9c, 0x0e, 0x92, 0xce, 0x2d, 0x4e, 0x87, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1,
-	0x3c, 0x7d, 0xa8, 0xd5, 0x10, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11,
-	0x55, 0x3a, 0xc1, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x90,
-	0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59,
-	0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x38,
-	0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52,
-	0x21, 0x27, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xf2, 0x7a,
-	0x38, 0xfc, 0xae, 0x07, 0xb1, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68,
-	0x31, 0x06, 0x41, 0x75, 0x5a, 0x59, 0x36, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xb3, 0xeb, 0xf9, 0x06,
-	0x2d, 0x35, 0xa4, 0x50, 0xa9, 0x40, 0x0a, 0x17, 0x34, 0x67, 0x2b, 0x49, 0x72, 0x89, 0xa3, 0x09,
-	0x05, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x1a, 0x55, 0x70, 0x31, 0xfb, 0x16, 0xa7, 0x0b,
-	0x65, 0x71, 0xf1, 0xa0, 0x78, 0x54, 0x03, 0xa7, 0x03, 0xd1, 0x0c, 0x92, 0x32, 0x20, 0x56, 0x25,
-	0xcc, 0x4a, 0x29, 0xd6, 0x06, 0x90, 0xbf, 0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x3d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f,
-	0x87, 0x0f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x31, 0x65, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x35, 0xe5, 0xf9, 0x17, 0x6c, 0x02, 0x00, 0x00,
+	0xcb, 0xd7, 0x07, 0x93, 0x10, 0xb5, 0x52, 0xea, 0xb8, 0x4c, 0x43, 0x68, 0x84, 0x28, 0xd4, 0x42,
+	0x52, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x0c, 0x52, 0x54, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x1c, 0x9f,
+	0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x09, 0x55, 0x0b, 0x75, 0x80, 0x7e, 0x6e, 0x71,
+	0x3a, 0x48, 0x55, 0x6e, 0x71, 0x3a, 0x54, 0x42, 0x12, 0x22, 0x11, 0x0f, 0xe6, 0xe9, 0x43, 0x9d,
+	0x09, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x88, 0x83, 0x58, 0x10, 0x51, 0xa5, 0x13, 0x8c,
+	0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x01, 0x60, 0xdb, 0x84, 0xcc,
+	0xb8, 0x38, 0xe1, 0x16, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b,
+	0x02, 0x35, 0xce, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d,
+	0x08, 0xa1, 0x54, 0xc8, 0x89, 0x8b, 0x0d, 0xe2, 0x5e, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
+	0x79, 0x3d, 0x1c, 0xe1, 0xa4, 0x07, 0xb1, 0xc8, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf,
+	0x37, 0x68, 0x31, 0x06, 0x41, 0x75, 0x5a, 0x59, 0x36, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xb3, 0xeb,
+	0xf9, 0x06, 0x2d, 0x35, 0xa4, 0x80, 0xa9, 0x40, 0x0a, 0x43, 0x34, 0x67, 0x2b, 0x49, 0x72, 0x89,
+	0xa3, 0x09, 0x05, 0xa5, 0x16, 0x17, 0xe4, 0xe7, 0x15, 0xa7, 0x2a, 0xfd, 0x62, 0xe4, 0x92, 0x42,
+	0x93, 0x73, 0x84, 0x5a, 0x91, 0x99, 0x4a, 0xbe, 0x87, 0xe3, 0xb8, 0x84, 0xd0, 0x22, 0x28, 0x33,
+	0x15, 0xe4, 0x79, 0x66, 0x0d, 0x6e, 0x23, 0x15, 0x64, 0xcf, 0x83, 0xe3, 0x13, 0xe1, 0x71, 0x98,
+	0xdd, 0x95, 0xc8, 0x21, 0x20, 0x58, 0x80, 0xee, 0x2e, 0x2b, 0x17, 0xcc, 0xc0, 0x30, 0x24, 0x2e,
+	0x30, 0x90, 0x4c, 0x51, 0x52, 0xe1, 0x52, 0xc2, 0x2d, 0x0b, 0x0b, 0x22, 0xa3, 0x36, 0x26, 0x2e,
+	0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x2c, 0x2e, 0x1e, 0x94, 0xc4, 0xa0, 0x81, 0x33, 0x12, 0xd1, 0x0c,
+	0x95, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x4e, 0xa1, 0x6e, 0x46, 0x2e, 0x71, 0x5c, 0x71, 0x62, 0x4c,
+	0xac, 0x69, 0x48, 0x9a, 0xa4, 0xac, 0xc9, 0xd0, 0x04, 0x73, 0x8d, 0x14, 0x6b, 0x03, 0x28, 0x1e,
+	0x9c, 0x1c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
+	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3d, 0x3d, 0xb3,
+	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x47, 0x34, 0x80, 0x23, 0x3a, 0x89, 0x0d,
+	0x9c, 0xb7, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xc8, 0x2c, 0xe8, 0x4a, 0x04, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +278,10 @@ type MsgClient interface {
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/feemarket module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error)
 }
 
 type msgClient struct {
@@ -193,12 +301,25 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	out := new(MsgUpdateParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Msg/UpdateParamsAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/feemarket
 	// module parameters. The authority is hard-coded to the Cosmos SDK x/gov
 	// module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/feemarket module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +329,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParamsAuthorities(ctx context.Context, req *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsAuthorities not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +355,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Msg/UpdateParamsAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, req.(*MsgUpdateParamsAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +381,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateParamsAuthorities",
+			Handler:    _Msg_UpdateParamsAuthorities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/tx.proto",
@@ -307,6 +453,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParamsAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -342,6 +555,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParamsAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -513,6 +754,172 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,119 @@
+package evmhooks
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/ibc/evmhooks/keeper"
+	"github.com/cosmos/evm/x/ibc/evmhooks/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ porttypes.IBCModule             = &IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = &IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 callbacks for the EVM hooks middleware, executing
+// the EVM call set in the memo of the received transfers atomically with the transfer.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+	maxGas uint64
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper, the underlying application
+// and the maximum gas limit of the EVM hooks.
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule, maxGas uint64) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+	if maxGas == 0 {
+		panic(errors.New("max gas cannot be zero"))
+	}
+
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+		maxGas: maxGas,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the memo of the transfer contains an EVM hook, i.e.
+// {"evm": {"contract": "0x...", "calldata": "0x...", "gas": 500000}}, the tokens are
+// received by an intermediate sender derived from the channel and the counterparty sender,
+// which then calls the contract with the calldata, after approving it to spend the tokens.
+// The receiver of the transfer must be the contract address.
+//
+// An error acknowledgement is returned if the hook fails, so that the transfer is reverted
+// and the tokens are refunded on the counterparty chain. Otherwise, the acknowledgement
+// contains the data returned by the contract along with the transfer acknowledgement.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS20 v1 packet, pass it through to the underlying application
+		return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	hook, isHook, err := types.ParseMemo(data.Memo)
+	if !isHook {
+		return im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if !isContractReceiver(data.Receiver, hook.ContractAddress()) {
+		return channeltypes.NewErrorAcknowledgement(
+			errorsmod.Wrapf(types.ErrInvalidReceiverAddress, "receiver %s must be the hook contract %s", data.Receiver, hook.Contract),
+		)
+	}
+
+	// credit the tokens to the intermediate sender instead of the contract
+	data.Receiver = types.GenerateIntermediateSender(packet.GetDestChannel(), data.Sender).String()
+	packet.Data = data.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	ret, err := im.keeper.ExecuteHook(ctx, packet, data, hook, min(hook.Gas, im.maxGas))
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	hookAck, err := types.NewHookAcknowledgement(ret, ack.Acknowledgement())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return hookAck
+}
+
+// isContractReceiver returns true if the hex or bech32 receiver of the transfer is the contract.
+func isContractReceiver(receiver string, contract common.Address) bool {
+	if common.IsHexAddress(receiver) {
+		return common.HexToAddress(receiver) == contract
+	}
+	addr, err := utils.GetAccAddressFromBech32(receiver)
+	if err != nil {
+		return false
+	}
+	return common.BytesToAddress(addr) == contract
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/ibc"
+	cosmosevmtypes "github.com/cosmos/evm/types"
+	"github.com/cosmos/evm/x/ibc/evmhooks/types"
+	evmante "github.com/cosmos/evm/x/vm/ante"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper executes the EVM hooks of the received ICS20 transfers.
+type Keeper struct {
+	authKeeper  types.AccountKeeper
+	evmKeeper   types.EVMKeeper
+	erc20Keeper types.ERC20Keeper
+}
+
+// NewKeeper creates a new EVM hooks Keeper instance.
+func NewKeeper(authKeeper types.AccountKeeper, evmKeeper types.EVMKeeper, erc20Keeper types.ERC20Keeper) Keeper {
+	return Keeper{
+		authKeeper:  authKeeper,
+		evmKeeper:   evmKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// ExecuteHook calls the hook contract from the intermediate sender, which received the
+// transferred tokens, and returns the data returned by the contract.
+//
+// The contract is approved to spend the transferred amount of the ERC20 representation of
+// the received tokens before the call, and must transfer them from the intermediate sender,
+// e.g. with IERC20(token).transferFrom(msg.sender, address(this), amount). The execution
+// fails if any token is left on the intermediate sender, since they would be irretrievable.
+//
+// The calls are executed on a cached context with the given gas limit, and the EVM gas used
+// is consumed on the ctx once they succeed.
+func (k Keeper) ExecuteHook(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	hook types.EVMHook,
+	gasLimit uint64,
+) ([]byte, error) {
+	sender := types.GenerateIntermediateSender(packet.GetDestChannel(), data.Sender)
+	senderHex := common.BytesToAddress(sender)
+	if !k.authKeeper.HasAccount(ctx, sender) {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, sender))
+	}
+
+	contractAddr := hook.ContractAddress()
+	// Check if the contract address contains code, otherwise the call would succeed
+	// ignoring the calldata, and the funds would get stuck.
+	if !k.evmKeeper.GetAccountOrEmpty(ctx, contractAddr).IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrContractHasNoCode, "provided contract address is not a contract: %s", contractAddr)
+	}
+
+	token := transfertypes.Token{
+		Denom:  transfertypes.ExtractDenomFromPath(data.Denom),
+		Amount: data.Amount,
+	}
+	coin := ibc.GetReceivedCoin(packet, token)
+	tokenPair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, coin.Denom))
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token pair for denom %s not found", coin.Denom)
+	}
+	erc20Addr := tokenPair.GetERC20Contract()
+
+	// Use an infinite gas meter limited to the hook gas, since the EVM gas estimation would
+	// otherwise deplete the gas of the ctx. The gas used is consumed on the ctx afterwards.
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = evmante.BuildEvmExecutionCtx(cachedCtx).
+		WithGasMeter(cosmosevmtypes.NewInfiniteGasMeterWithLimit(gasLimit))
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+	remainingGas := new(big.Int).SetUint64(gasLimit)
+
+	res, err := k.evmKeeper.CallEVM(cachedCtx, erc20.ABI, senderHex, erc20Addr, true, remainingGas, "approve", contractAddr, coin.Amount.BigInt())
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to set allowance: %v", err)
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm hook allowance")
+	if res.GasUsed > gasLimit || ctx.GasMeter().IsOutOfGas() {
+		return nil, errorsmod.Wrap(types.ErrOutOfGas, "out of gas")
+	}
+	remainingGas.Sub(remainingGas, new(big.Int).SetUint64(res.GasUsed))
+
+	var approved bool
+	if err := erc20.ABI.UnpackIntoInterface(&approved, "approve", res.Ret); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to unpack approve return: %v", err)
+	}
+	if !approved {
+		return nil, errorsmod.Wrap(types.ErrAllowanceFailed, "failed to set allowance")
+	}
+
+	res, err = k.evmKeeper.CallEVMWithData(cachedCtx, senderHex, &contractAddr, hook.Calldata, true, remainingGas)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrEVMCallFailed, "EVM returned error: %s", err.Error())
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm hook")
+	if ctx.GasMeter().IsOutOfGas() {
+		return nil, errorsmod.Wrap(types.ErrOutOfGas, "out of gas")
+	}
+
+	if balance := k.erc20Keeper.BalanceOf(cachedCtx, erc20.ABI, erc20Addr, senderHex); balance == nil || balance.Sign() != 0 {
+		return nil, errorsmod.Wrapf(types.ErrUnrecoverableFunds, "%s tokens left after the call", balance)
+	}
+
+	writeFn()
+	return res.Ret, nil
+}
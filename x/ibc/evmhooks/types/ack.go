@@ -0,0 +1,29 @@
+package types
+
+import (
+	"encoding/json"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// HookAcknowledgement is the result of the successful acknowledgement of a transfer
+// executing an EVM hook.
+type HookAcknowledgement struct {
+	// ContractResult is the data returned by the hook contract
+	ContractResult []byte `json:"contract_result"`
+	// IBCAck is the acknowledgement of the transfer
+	IBCAck []byte `json:"ibc_ack"`
+}
+
+// NewHookAcknowledgement wraps the acknowledgement of the transfer along with the data
+// returned by the hook contract into a successful acknowledgement.
+func NewHookAcknowledgement(contractResult, ibcAck []byte) (channeltypes.Acknowledgement, error) {
+	bz, err := json.Marshal(HookAcknowledgement{
+		ContractResult: contractResult,
+		IBCAck:         ibcAck,
+	})
+	if err != nil {
+		return channeltypes.Acknowledgement{}, err
+	}
+	return channeltypes.NewResultAcknowledgement(bz), nil
+}
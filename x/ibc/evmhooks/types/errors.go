@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// EVM hooks sentinel errors
+var (
+	ErrInvalidMemo            = errorsmod.Register(ModuleName, 1, "invalid evm hook memo")
+	ErrInvalidReceiverAddress = errorsmod.Register(ModuleName, 2, "invalid receiver address")
+	ErrContractHasNoCode      = errorsmod.Register(ModuleName, 3, "contract has no code")
+	ErrTokenPairNotFound      = errorsmod.Register(ModuleName, 4, "token not registered")
+	ErrAllowanceFailed        = errorsmod.Register(ModuleName, 5, "allowance failed")
+	ErrEVMCallFailed          = errorsmod.Register(ModuleName, 6, "evm call failed")
+	ErrOutOfGas               = errorsmod.Register(ModuleName, 7, "out of gas")
+	ErrUnrecoverableFunds     = errorsmod.Register(ModuleName, 8, "funds left on the intermediate sender")
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "evm-hooks"
+
+	// MemoKey is the key of the EVM hook in the ICS20 transfer memo
+	MemoKey = "evm"
+)
+
+// GenerateIntermediateSender generates the intermediate sender address for the given channel ID
+// and counterparty sender address. The received funds are credited to this address, which then
+// calls the hook contract, so that the contract can't be called on behalf of a local account.
+func GenerateIntermediateSender(channelID string, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(ModuleName, []byte(channelID), []byte(sender))[:20])
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// EVMHook is the EVM call requested in the memo of an ICS20 transfer, e.g.:
+//
+//	{"evm": {"contract": "0x...", "calldata": "0x...", "gas": 500000}}
+type EVMHook struct {
+	// Contract is the hex address of the called contract
+	Contract string `json:"contract"`
+	// Calldata is the hex encoded input of the call
+	Calldata hexutil.Bytes `json:"calldata"`
+	// Gas is the gas limit of the call
+	Gas uint64 `json:"gas"`
+}
+
+// ContractAddress returns the address of the called contract.
+func (h EVMHook) ContractAddress() common.Address {
+	return common.HexToAddress(h.Contract)
+}
+
+// Validate performs a stateless validation of the hook.
+func (h EVMHook) Validate() error {
+	if !common.IsHexAddress(h.Contract) {
+		return errorsmod.Wrapf(ErrInvalidMemo, "invalid contract address: %s", h.Contract)
+	}
+	if (h.ContractAddress() == common.Address{}) {
+		return errorsmod.Wrap(ErrInvalidMemo, "contract address cannot be the zero address")
+	}
+	if h.Gas == 0 {
+		return errorsmod.Wrap(ErrInvalidMemo, "gas cannot be zero")
+	}
+	return nil
+}
+
+// ParseMemo parses the EVM hook from the memo of an ICS20 transfer. It returns false if the
+// memo is not a JSON object with the MemoKey, and an error if the hook is malformed.
+func ParseMemo(memo string) (EVMHook, bool, error) {
+	if len(memo) == 0 {
+		return EVMHook{}, false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		// not a JSON object, e.g. a plain text memo
+		return EVMHook{}, false, nil
+	}
+	raw, ok := fields[MemoKey]
+	if !ok {
+		return EVMHook{}, false, nil
+	}
+
+	var hook EVMHook
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&hook); err != nil {
+		return EVMHook{}, true, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+	if err := hook.Validate(); err != nil {
+		return EVMHook{}, true, err
+	}
+	return hook, true, nil
+}
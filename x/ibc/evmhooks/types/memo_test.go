@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/ibc/evmhooks/types"
+)
+
+func TestParseMemo(t *testing.T) {
+	contract := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	testCases := []struct {
+		name     string
+		memo     string
+		expHook  bool
+		expError string
+		expGas   uint64
+		expData  []byte
+	}{
+		{name: "empty memo", memo: ""},
+		{name: "plain text memo", memo: "hello"},
+		{name: "json without hook", memo: `{"dest_callback": {"address": "0x1234567890123456789012345678901234567890"}}`},
+		{name: "json array", memo: `["evm"]`},
+		{
+			name:    "valid hook",
+			memo:    `{"evm": {"contract": "0x1234567890123456789012345678901234567890", "calldata": "0xdeadbeef", "gas": 200000}}`,
+			expHook: true,
+			expGas:  200000,
+			expData: []byte{0xde, 0xad, 0xbe, 0xef},
+		},
+		{
+			name:    "valid hook without calldata",
+			memo:    `{"evm": {"contract": "0x1234567890123456789012345678901234567890", "gas": 1}, "other": "field"}`,
+			expHook: true,
+			expGas:  1,
+		},
+		{
+			name:     "invalid contract",
+			memo:     `{"evm": {"contract": "not_hex_address", "gas": 200000}}`,
+			expHook:  true,
+			expError: "invalid contract address",
+		},
+		{
+			name:     "zero contract",
+			memo:     `{"evm": {"contract": "0x0000000000000000000000000000000000000000", "gas": 200000}}`,
+			expHook:  true,
+			expError: "zero address",
+		},
+		{
+			name:     "zero gas",
+			memo:     `{"evm": {"contract": "0x1234567890123456789012345678901234567890", "calldata": "0x"}}`,
+			expHook:  true,
+			expError: "gas cannot be zero",
+		},
+		{
+			name:     "calldata without prefix",
+			memo:     `{"evm": {"contract": "0x1234567890123456789012345678901234567890", "calldata": "deadbeef", "gas": 1}}`,
+			expHook:  true,
+			expError: types.ErrInvalidMemo.Error(),
+		},
+		{
+			name:     "unknown field",
+			memo:     `{"evm": {"contract": "0x1234567890123456789012345678901234567890", "gas": 1, "value": 1}}`,
+			expHook:  true,
+			expError: "unknown field",
+		},
+		{
+			name:     "null hook",
+			memo:     `{"evm": null}`,
+			expHook:  true,
+			expError: "invalid contract address",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hook, isHook, err := types.ParseMemo(tc.memo)
+			require.Equal(t, tc.expHook, isHook)
+			if tc.expError != "" {
+				require.ErrorContains(t, err, tc.expError)
+				return
+			}
+			require.NoError(t, err)
+			if !tc.expHook {
+				return
+			}
+			require.Equal(t, contract, hook.ContractAddress())
+			require.Equal(t, tc.expGas, hook.Gas)
+			require.Equal(t, tc.expData, []byte(hook.Calldata))
+		})
+	}
+}
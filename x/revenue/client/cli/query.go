@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/revenue/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetQueryCmd returns the parent command for all revenue CLI query commands
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the revenue module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetRevenuesCmd(),
+		GetRevenueCmd(),
+		GetParamsCmd(),
+		GetDeployerRevenuesCmd(),
+		GetWithdrawerRevenuesCmd(),
+	)
+	return cmd
+}
+
+// GetRevenuesCmd queries all registered revenues
+func GetRevenuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts",
+		Short: "Query all revenues",
+		Long:  "Query all revenues",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Revenues(context.Background(), &types.QueryRevenuesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
+	return cmd
+}
+
+// GetRevenueCmd queries the revenue of a given registered contract
+func GetRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract CONTRACT_ADDRESS",
+		Short: "Query the revenue of a registered contract",
+		Long:  "Query the revenue of a registered contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract hex address %s", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Revenue(context.Background(), &types.QueryRevenueRequest{
+				ContractAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the revenue module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets revenue params",
+		Long:  "Gets revenue params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetDeployerRevenuesCmd queries all the contracts registered by a deployer
+func GetDeployerRevenuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-contracts DEPLOYER_ADDRESS",
+		Short: "Query all contracts that a given deployer has registered for fee distribution",
+		Long:  "Query all contracts that a given deployer has registered for fee distribution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("invalid deployer bech32 address %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DeployerRevenues(context.Background(), &types.QueryDeployerRevenuesRequest{
+				DeployerAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployer-contracts")
+	return cmd
+}
+
+// GetWithdrawerRevenuesCmd queries all the contracts distributing their fees
+// to a withdrawer
+func GetWithdrawerRevenuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawer-contracts WITHDRAWER_ADDRESS",
+		Short: "Query all contracts that have been registered for fee distribution with a given withdrawer address",
+		Long:  "Query all contracts that have been registered for fee distribution with a given withdrawer address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("invalid withdrawer bech32 address %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WithdrawerRevenues(context.Background(), &types.QueryWithdrawerRevenuesRequest{
+				WithdrawerAddress: args[0],
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawer-contracts")
+	return cmd
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/evm/x/revenue/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTxCmd returns a root CLI command handler for revenue transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "revenue subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterRevenueCmd(),
+		NewUpdateRevenueCmd(),
+		NewCancelRevenueCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterRevenueCmd returns a CLI command handler for registering a
+// contract for fee distribution
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_HEX NONCES [WITHDRAWER_BECH32]",
+		Short: "Register a contract for fee distribution. Only the contract deployer can register a contract. The nonces are the comma-separated nonces of the deployment path, from the deployer to the contract. When the withdrawer [optional] is omitted, the fees are sent to the deployer.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			var nonces []uint64
+			for _, nonce := range strings.Split(args[1], ",") {
+				n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", nonce, err)
+				}
+				nonces = append(nonces, n)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				withdrawer, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(common.HexToAddress(contract), cliCtx.GetFromAddress(), withdrawer, nonces)
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateRevenueCmd returns a CLI command handler for updating the
+// withdrawer address of a registered contract
+func NewUpdateRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_HEX WITHDRAWER_BECH32",
+		Short: "Update the withdrawer address of a contract registered for fee distribution. Only the contract deployer can update the withdrawer address.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRevenue(common.HexToAddress(contract), cliCtx.GetFromAddress(), withdrawer)
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelRevenueCmd returns a CLI command handler for canceling the fee
+// distribution of a registered contract
+func NewCancelRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_HEX",
+		Short: "Cancel a contract from fee distribution. Only the contract deployer can cancel a contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if !common.IsHexAddress(contract) {
+				return fmt.Errorf("invalid contract hex address %s", contract)
+			}
+
+			msg := types.NewMsgCancelRevenue(common.HexToAddress(contract), cliCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,37 @@
+package revenue
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/evm/x/revenue/keeper"
+	"github.com/cosmos/evm/x/revenue/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes genesis state based on exported genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) []abci.ValidatorUpdate {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
+	}
+
+	for _, revenue := range data.Revenues {
+		k.SetRevenue(ctx, revenue)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis exports genesis state of the revenue module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:   k.GetParams(ctx),
+		Revenues: k.GetRevenues(ctx),
+	}
+}
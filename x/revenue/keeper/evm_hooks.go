@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/x/revenue/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for the revenue keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks return the wrapper hooks struct for the Keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
+// the module keeper
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ common.Address, msg core.Message, receipt *ethtypes.Receipt) error {
+	return h.k.PostTxProcessing(ctx, msg, receipt)
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
+// interaction with a registered contract, the developer shares of the
+// transaction fees are sent from the fee collector to the withdrawer address.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	contract := msg.To
+	if contract == nil || receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil
+	}
+
+	revenue, found := k.GetRevenue(ctx, *contract)
+	if !found {
+		return nil
+	}
+
+	txFee := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), msg.GasPrice)
+	developerFee := sdkmath.LegacyNewDecFromBigInt(txFee).Mul(params.DeveloperShares).TruncateInt()
+	fees := sdk.Coins{sdk.NewCoin(evmtypes.GetEVMCoinExtendedDenom(), developerFee)}
+
+	// fees paid in an alternative denomination are distributed in that same
+	// denomination, at the rate used to pay them.
+	if feeConversion, ok := evmtypes.GetFeeConversion(ctx); ok {
+		fees = sdk.Coins{sdk.NewCoin(feeConversion.Denom, feeConversion.ConvertRefund(developerFee.BigInt()))}
+	}
+
+	if !fees.IsAllPositive() {
+		return nil
+	}
+
+	withdrawer := revenue.GetWithdrawerAddr()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, withdrawer, fees); err != nil {
+		return errorsmod.Wrapf(err, "fee collector account failed to distribute developer fees (%s) to withdraw address %s", fees, withdrawer)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeRevenue,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.Hex()),
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/x/revenue/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterRevenue registers a contract to receive transaction fees
+func (k Keeper) RegisterRevenue(
+	goCtx context.Context,
+	msg *types.MsgRegisterRevenue,
+) (*types.MsgRegisterRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if k.IsRevenueRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAlreadyRegistered, "contract is already registered %s", contract)
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	var withdrawer sdk.AccAddress
+	if msg.WithdrawerAddress != "" {
+		withdrawer, err = sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid withdrawer address %s", msg.WithdrawerAddress)
+		}
+	}
+
+	// contract must already be deployed, to avoid spam registrations
+	contractAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if contractAccount == nil || !contractAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueNoContractDeployed, "no contract code found at address %s", contract)
+	}
+
+	// the contract address must be derived from the deployer address through
+	// the nonces of the deployment path, where every intermediate address is a
+	// factory contract
+	derivedContract := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		ctx.GasMeter().ConsumeGas(params.AddrDerivationCostCreate, "revenue registration: address derivation CREATE opcode")
+		derivedContract = crypto.CreateAddress(derivedContract, nonce)
+	}
+
+	if contract != derivedContract {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"not contract deployer or wrong nonce: expected %s instead of %s",
+			derivedContract, contract,
+		)
+	}
+
+	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	k.SetRevenue(ctx, revenue)
+
+	k.Logger(ctx).Debug(
+		"registering contract for transaction fees",
+		"contract", msg.ContractAddress,
+		"deployer", msg.DeployerAddress,
+		"withdraw", revenue.WithdrawerAddress,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterRevenue,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, revenue.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// UpdateRevenue updates the withdrawer address of a registered contract
+func (k Keeper) UpdateRevenue(
+	goCtx context.Context,
+	msg *types.MsgUpdateRevenue,
+) (*types.MsgUpdateRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid withdrawer address %s", msg.WithdrawerAddress)
+	}
+
+	if revenue.WithdrawerAddress == withdrawer.String() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"withdrawer address is already set to %s", revenue.WithdrawerAddress,
+		)
+	}
+
+	k.DeleteRevenue(ctx, revenue)
+	revenue.WithdrawerAddress = withdrawer.String()
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue deletes the revenue of a registered contract
+func (k Keeper) CancelRevenue(
+	goCtx context.Context,
+	msg *types.MsgCancelRevenue,
+) (*types.MsgCancelRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRevenue,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+		),
+	)
+
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// UpdateParams updates the revenue module parameters
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getDeployerRevenue returns the revenue of the given contract, checking that
+// it was registered by the given deployer.
+func (k Keeper) getDeployerRevenue(ctx sdk.Context, contractAddress, deployerAddress string) (types.Revenue, error) {
+	contract := common.HexToAddress(contractAddress)
+	revenue, found := k.GetRevenue(ctx, contract)
+	if !found {
+		return types.Revenue{}, errorsmod.Wrapf(types.ErrRevenueContractNotRegistered, "contract %s is not registered", contractAddress)
+	}
+
+	if revenue.DeployerAddress != deployerAddress {
+		return types.Revenue{}, errorsmod.Wrapf(
+			types.ErrRevenueNotDeployer,
+			"%s is not the contract deployer", deployerAddress,
+		)
+	}
+
+	return revenue, nil
+}
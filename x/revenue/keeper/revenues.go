@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/revenue/types"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRevenues returns all registered revenues.
+func (k Keeper) GetRevenues(ctx sdk.Context) []types.Revenue {
+	revenues := []types.Revenue{}
+
+	k.IterateRevenues(ctx, func(revenue types.Revenue) (stop bool) {
+		revenues = append(revenues, revenue)
+		return false
+	})
+
+	return revenues
+}
+
+// IterateRevenues iterates over all registered contracts and performs a
+// callback with the corresponding revenue.
+func (k Keeper) IterateRevenues(ctx sdk.Context, handlerFn func(revenue types.Revenue) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.Revenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+
+		if handlerFn(revenue) {
+			break
+		}
+	}
+}
+
+// GetRevenue returns the revenue for a registered contract.
+func (k Keeper) GetRevenue(ctx sdk.Context, contract common.Address) (types.Revenue, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Revenue{}, false
+	}
+
+	var revenue types.Revenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue, true
+}
+
+// IsRevenueRegistered checks if a contract was registered for receiving
+// transaction fees.
+func (k Keeper) IsRevenueRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	return store.Has(contract.Bytes())
+}
+
+// SetRevenue stores the revenue of a registered contract along with its
+// deployer and withdrawer indexes.
+func (k Keeper) SetRevenue(ctx sdk.Context, revenue types.Revenue) {
+	contract := revenue.GetContractAddr()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	store.Set(contract.Bytes(), k.cdc.MustMarshal(&revenue))
+
+	ctx.KVStore(k.storeKey).Set(types.GetKeyDeployerRevenue(revenue.GetDeployerAddr(), contract), []byte{1})
+	ctx.KVStore(k.storeKey).Set(types.GetKeyWithdrawerRevenue(revenue.GetWithdrawerAddr(), contract), []byte{1})
+}
+
+// DeleteRevenue deletes the revenue of a registered contract along with its
+// deployer and withdrawer indexes.
+func (k Keeper) DeleteRevenue(ctx sdk.Context, revenue types.Revenue) {
+	contract := revenue.GetContractAddr()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	store.Delete(contract.Bytes())
+
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyDeployerRevenue(revenue.GetDeployerAddr(), contract))
+	ctx.KVStore(k.storeKey).Delete(types.GetKeyWithdrawerRevenue(revenue.GetWithdrawerAddr(), contract))
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global revenue module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to
+	// modules/revenue and defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewLegacyAmino()
+)
+
+const (
+	// Amino names
+	registerRevenueName = "cosmos/evm/x/revenue/MsgRegisterRevenue"
+	updateRevenueName   = "cosmos/evm/x/revenue/MsgUpdateRevenue"
+	cancelRevenueName   = "cosmos/evm/x/revenue/MsgCancelRevenue"
+	updateParamsName    = "cosmos/evm/x/revenue/MsgUpdateParams"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterRevenue{},
+		&MsgUpdateRevenue{},
+		&MsgCancelRevenue{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/revenue interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterRevenue{}, registerRevenueName, nil)
+	cdc.RegisterConcrete(&MsgUpdateRevenue{}, updateRevenueName, nil)
+	cdc.RegisterConcrete(&MsgCancelRevenue{}, cancelRevenueName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRevenueDisabled              = errorsmod.Register(ModuleName, 2, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered     = errorsmod.Register(ModuleName, 3, "revenue already exists for given contract")
+	ErrRevenueNoContractDeployed    = errorsmod.Register(ModuleName, 4, "no contract deployed")
+	ErrRevenueContractNotRegistered = errorsmod.Register(ModuleName, 5, "no revenue registered for contract")
+	ErrRevenueDeployerIsNotEOA      = errorsmod.Register(ModuleName, 6, "deployer is not an externally owned account")
+	ErrRevenueNotDeployer           = errorsmod.Register(ModuleName, 7, "sender is not the contract deployer")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, revenues []Revenue) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Revenues: revenues,
+	}
+}
+
+// DefaultGenesisState sets default revenue genesis state with no registered
+// revenues and default params.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:   DefaultParams(),
+		Revenues: []Revenue{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenContract := make(map[string]bool)
+	for _, r := range gs.Revenues {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		// revenues are indexed by contract address, so compare them case insensitively
+		contract := r.GetContractAddr().Hex()
+		if seenContract[contract] {
+			return fmt.Errorf("contract duplicated on genesis '%s'", r.ContractAddress)
+		}
+
+		seenContract[contract] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	contract   common.Address
+	deployer   sdk.AccAddress
+	withdrawer sdk.AccAddress
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.contract = common.HexToAddress("0x1D54EcB8583Ca25895c512A8308389fFD581F9c9")
+	suite.deployer = sdk.AccAddress(common.HexToAddress("0x00000Be6819f41400225702D32d3dd23663Dd690").Bytes())
+	suite.withdrawer = sdk.AccAddress(common.HexToAddress("0x000000000000000000000000000000000000dEaD").Bytes())
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			&GenesisState{
+				Params:   DefaultParams(),
+				Revenues: []Revenue{NewRevenue(suite.contract, suite.deployer, suite.withdrawer)},
+			},
+			true,
+		},
+		{
+			"valid New genesis - withdrawer defaults to deployer",
+			func() *GenesisState {
+				gs := NewGenesisState(DefaultParams(), []Revenue{NewRevenue(suite.contract, suite.deployer, nil)})
+				return &gs
+			}(),
+			true,
+		},
+		{
+			"invalid genesis - duplicated contract",
+			&GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{
+					NewRevenue(suite.contract, suite.deployer, suite.withdrawer),
+					{
+						ContractAddress:   "0x1d54ecb8583ca25895c512a8308389ffd581f9c9",
+						DeployerAddress:   suite.deployer.String(),
+						WithdrawerAddress: suite.deployer.String(),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid contract address",
+			&GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{{
+					ContractAddress:   "0x",
+					DeployerAddress:   suite.deployer.String(),
+					WithdrawerAddress: suite.deployer.String(),
+				}},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid withdrawer address",
+			&GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{{
+					ContractAddress:   suite.contract.Hex(),
+					DeployerAddress:   suite.deployer.String(),
+					WithdrawerAddress: "",
+				}},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid params",
+			&GenesisState{
+				Params:   NewParams(true, math.LegacyNewDec(2), DefaultAddrDerivationCostCreate),
+				Revenues: []Revenue{},
+			},
+			false,
+		},
+		{
+			"empty genesis",
+			&GenesisState{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected interface needed to distribute the
+// transaction fees.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used on revenue
+type EVMKeeper interface {
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "revenue"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the revenue persistent store
+const (
+	prefixRevenue = iota + 1
+	prefixDeployer
+	prefixWithdrawer
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRevenue    = []byte{prefixRevenue}
+	KeyPrefixDeployer   = []byte{prefixDeployer}
+	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	ParamsKey           = []byte{prefixParams}
+)
+
+// GetKeyPrefixDeployer returns the KVStore key prefix for the reversed index
+// of a deployer's revenues
+func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixDeployer, deployerAddress.Bytes()...)
+}
+
+// GetKeyPrefixWithdrawer returns the KVStore key prefix for the reversed index
+// of a withdrawer's revenues
+func GetKeyPrefixWithdrawer(withdrawerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixWithdrawer, withdrawerAddress.Bytes()...)
+}
+
+// GetKeyDeployerRevenue returns the KVStore key of the deployer index entry
+// of a revenue
+func GetKeyDeployerRevenue(deployerAddress sdk.AccAddress, contract common.Address) []byte {
+	return append(GetKeyPrefixDeployer(deployerAddress), contract.Bytes()...)
+}
+
+// GetKeyWithdrawerRevenue returns the KVStore key of the withdrawer index
+// entry of a revenue
+func GetKeyWithdrawerRevenue(withdrawerAddress sdk.AccAddress, contract common.Address) []byte {
+	return append(GetKeyPrefixWithdrawer(withdrawerAddress), contract.Bytes()...)
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg              = &MsgRegisterRevenue{}
+	_ sdk.Msg              = &MsgUpdateRevenue{}
+	_ sdk.Msg              = &MsgCancelRevenue{}
+	_ sdk.Msg              = &MsgUpdateParams{}
+	_ sdk.HasValidateBasic = &MsgRegisterRevenue{}
+	_ sdk.HasValidateBasic = &MsgUpdateRevenue{}
+	_ sdk.HasValidateBasic = &MsgCancelRevenue{}
+	_ sdk.HasValidateBasic = &MsgUpdateParams{}
+)
+
+// MaxNonces is the maximum number of nonces of the address derivation path
+// from the deployer to the registered contract.
+const MaxNonces = 20
+
+// NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
+func NewMsgRegisterRevenue(
+	contract common.Address,
+	deployer,
+	withdrawer sdk.AccAddress,
+	nonces []uint64,
+) *MsgRegisterRevenue {
+	withdrawerAddress := ""
+	if len(withdrawer) > 0 {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		Nonces:            nonces,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+
+	if msg.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+		}
+	}
+
+	if len(msg.Nonces) < 1 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - empty array")
+	}
+
+	if len(msg.Nonces) > MaxNonces {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - array length must be less than or equal to %d", MaxNonces)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateRevenue creates new instance of MsgUpdateRevenue
+func NewMsgUpdateRevenue(
+	contract common.Address,
+	deployer,
+	withdrawer sdk.AccAddress,
+) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// NewMsgCancelRevenue creates new instance of MsgCancelRevenue.
+func NewMsgCancelRevenue(
+	contract common.Address,
+	deployer sdk.AccAddress,
+) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract.Hex(),
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
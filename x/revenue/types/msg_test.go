@@ -0,0 +1,185 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	contract common.Address
+	deployer sdk.AccAddress
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.contract = common.HexToAddress("0x1D54EcB8583Ca25895c512A8308389fFD581F9c9")
+	suite.deployer = sdk.AccAddress(common.HexToAddress("0x00000Be6819f41400225702D32d3dd23663Dd690").Bytes())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterRevenueValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterRevenue
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.deployer, []uint64{1}),
+			true,
+		},
+		{
+			"pass - empty withdrawer",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{1}),
+			true,
+		},
+		{
+			"fail - invalid deployer",
+			&MsgRegisterRevenue{ContractAddress: suite.contract.Hex(), DeployerAddress: "invalid", Nonces: []uint64{1}},
+			false,
+		},
+		{
+			"fail - invalid contract",
+			&MsgRegisterRevenue{ContractAddress: "0x", DeployerAddress: suite.deployer.String(), Nonces: []uint64{1}},
+			false,
+		},
+		{
+			"fail - invalid withdrawer",
+			&MsgRegisterRevenue{ContractAddress: suite.contract.Hex(), DeployerAddress: suite.deployer.String(), WithdrawerAddress: "invalid", Nonces: []uint64{1}},
+			false,
+		},
+		{
+			"fail - empty nonces",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, nil),
+			false,
+		},
+		{
+			"fail - too many nonces",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, make([]uint64, MaxNonces+1)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateRevenueValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateRevenue
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgUpdateRevenue(suite.contract, suite.deployer, suite.deployer),
+			true,
+		},
+		{
+			"fail - invalid deployer",
+			&MsgUpdateRevenue{ContractAddress: suite.contract.Hex(), DeployerAddress: "invalid", WithdrawerAddress: suite.deployer.String()},
+			false,
+		},
+		{
+			"fail - invalid contract",
+			&MsgUpdateRevenue{ContractAddress: "0x", DeployerAddress: suite.deployer.String(), WithdrawerAddress: suite.deployer.String()},
+			false,
+		},
+		{
+			"fail - empty withdrawer",
+			NewMsgUpdateRevenue(suite.contract, suite.deployer, nil),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelRevenueValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgCancelRevenue
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgCancelRevenue(suite.contract, suite.deployer),
+			true,
+		},
+		{
+			"fail - invalid deployer",
+			&MsgCancelRevenue{ContractAddress: suite.contract.Hex(), DeployerAddress: "invalid"},
+			false,
+		},
+		{
+			"fail - invalid contract",
+			&MsgCancelRevenue{ContractAddress: "0x", DeployerAddress: suite.deployer.String()},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateParams{Authority: "invalid", Params: DefaultParams()},
+			false,
+		},
+		{
+			"fail - invalid params",
+			&MsgUpdateParams{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Params: Params{}},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateParams{Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(), Params: DefaultParams()},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// DefaultEnableRevenue disables the fee distribution
+	DefaultEnableRevenue = false
+	// DefaultDeveloperShares is 0.5 or 50%
+	DefaultDeveloperShares = math.LegacyNewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the gas cost of deriving an address
+	// with a nonce at the fee registration
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	enableRevenue bool,
+	developerShares math.LegacyDec,
+	addrDerivationCostCreate uint64,
+) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default revenue module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableRevenue:            DefaultEnableRevenue,
+		DeveloperShares:          DefaultDeveloperShares,
+		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+	}
+}
+
+// Validate performs a stateless validation of the revenue module parameters
+func (p Params) Validate() error {
+	if p.DeveloperShares.IsNil() {
+		return fmt.Errorf("developer shares cannot be nil")
+	}
+	if p.DeveloperShares.IsNegative() {
+		return fmt.Errorf("developer shares cannot be negative: %s", p.DeveloperShares)
+	}
+	if p.DeveloperShares.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("developer shares cannot be greater than 1: %s", p.DeveloperShares)
+	}
+
+	return nil
+}
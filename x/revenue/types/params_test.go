@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default", DefaultParams(), true},
+		{"valid", NewParams(true, math.LegacyNewDecWithPrec(25, 2), 100), true},
+		{"valid - zero shares", NewParams(true, math.LegacyZeroDec(), 100), true},
+		{"valid - all the fees", NewParams(true, math.LegacyOneDec(), 100), true},
+		{"invalid - nil shares", Params{EnableRevenue: true}, false},
+		{"invalid - negative shares", NewParams(true, math.LegacyNewDec(-1), 100), false},
+		{"invalid - shares greater than 1", NewParams(true, math.LegacyNewDecWithPrec(101, 2), 100), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewRevenue returns an instance of Revenue. If the provided withdrawer
+// address is empty, it sets the value to the deployer address.
+func NewRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) Revenue {
+	if len(withdrawer) == 0 {
+		withdrawer = deployer
+	}
+
+	return Revenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// GetContractAddr returns the contract address
+func (r Revenue) GetContractAddr() common.Address {
+	return common.HexToAddress(r.ContractAddress)
+}
+
+// GetDeployerAddr returns the contract deployer address
+func (r Revenue) GetDeployerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.DeployerAddress)
+}
+
+// GetWithdrawerAddr returns the account address to where the funds proceeding
+// from the fees will be received.
+func (r Revenue) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of a Revenue
+func (r Revenue) Validate() error {
+	if !common.IsHexAddress(r.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", r.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.WithdrawerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdrawer address")
+	}
+
+	return nil
+}
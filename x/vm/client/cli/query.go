@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"os"
+	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -19,6 +21,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// flagLayout defines the flag of the storage layout file of a contract
+const flagLayout = "layout"
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,9 +36,13 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetStorageCmd(),
+		GetStorageDiffCmd(),
 		GetCodeCmd(),
+		GetCodeMetadataCmd(),
 		GetAccountCmd(),
 		GetParamsCmd(),
+		GetParamsAuthoritiesCmd(),
+		GetParamsAtHeightCmd(),
 		GetConfigCmd(),
 		HexToBech32Cmd(),
 		Bech32ToHexCmd(),
@@ -83,6 +92,76 @@ func GetStorageCmd() *cobra.Command {
 	return cmd
 }
 
+// GetStorageDiffCmd queries the storage slots of a contract changed between two heights
+func GetStorageDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "storage-diff ADDRESS FROM_HEIGHT TO_HEIGHT",
+		Short:   "Gets the storage slots of a contract changed between two heights",
+		Long:    "Gets the storage slots of a contract changed between two heights. If the solc storage layout of the contract is provided, the state variables stored in the changed slots are decoded.", //nolint:lll
+		Example: "evmd query evm storage-diff 0x7cB61D4117AE31a12E393a1Cfa3BaC666481D02E 100 200 --layout layout.json",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+			toHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+
+			var layout *rpctypes.StorageLayout
+			if layoutFile, _ := cmd.Flags().GetString(flagLayout); layoutFile != "" {
+				bz, err := os.ReadFile(layoutFile)
+				if err != nil {
+					return err
+				}
+				layout = new(rpctypes.StorageLayout)
+				if err := json.Unmarshal(bz, layout); err != nil {
+					return fmt.Errorf("invalid storage layout: %w", err)
+				}
+			}
+
+			from, err := rpctypes.QueryContractStorage(rpctypes.ContextWithHeight(fromHeight), queryClient, common.HexToAddress(address))
+			if err != nil {
+				return err
+			}
+			to, err := rpctypes.QueryContractStorage(rpctypes.ContextWithHeight(toHeight), queryClient, common.HexToAddress(address))
+			if err != nil {
+				return err
+			}
+
+			diffs := rpctypes.DiffStorage(from, to)
+			if err := rpctypes.DecodeStorageDiffs(diffs, layout); err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(diffs)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().String(flagLayout, "", "Path to the solc storage layout JSON of the contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCodeCmd queries the code field of a given address
 func GetCodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -120,6 +199,51 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCodeMetadataCmd queries the metadata published for the code of a given
+// contract
+func GetCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-metadata ADDRESS",
+		Short: "Gets the metadata published for the code of a contract",
+		Long:  "Gets the metadata, such as the compiler metadata IPFS hash, published by the deployers for the code hash of a contract.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCodeMetadataRequest{
+				Address:    address,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CodeMetadata(rpctypes.ContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "code-metadata")
+	return cmd
+}
+
 // GetAccountCmd queries the account of a given address
 func GetAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -342,3 +466,65 @@ func GetERC20BalanceCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetParamsAuthoritiesCmd queries the accounts allowed to update a subset of
+// the module params
+func GetParamsAuthoritiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-authorities",
+		Short: "Get the evm params authorities",
+		Long:  "Get the accounts allowed to update a subset of the evm parameters, and the parameters each of them can update.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsAuthorities(cmd.Context(), &types.QueryParamsAuthoritiesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsAtHeightCmd queries the module params in effect at a past height
+func GetParamsAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params-at-height [height]",
+		Short: "Get the evm params in effect at a past height",
+		Long:  "Get the evm parameters in effect at the end of the given block, as recorded by the parameter change history, along with the height of the change that set them.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParamsAtHeight(cmd.Context(), &types.QueryParamsAtHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
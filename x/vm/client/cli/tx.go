@@ -2,16 +2,19 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	rpctypes "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
@@ -38,7 +41,9 @@ func NewTxCmd(ac address.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		NewRawTxCmd(),
+		NewDecodeTxCmd(),
 		NewSendTxCmd(ac),
+		NewRegisterCodeMetadataCmd(),
 	)
 	return txCmd
 }
@@ -126,6 +131,50 @@ func NewRawTxCmd() *cobra.Command {
 	return cmd
 }
 
+// NewDecodeTxCmd command decodes a raw ethereum transaction
+func NewDecodeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode TX_HEX",
+		Short: "Decode a raw ethereum transaction",
+		Long:  "Decode a signed RLP encoded ethereum transaction and print its fields, including the recovered sender and the transaction hash.", //nolint:lll
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			data, err := hexutil.Decode(args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to decode ethereum tx hex bytes")
+			}
+
+			ethTx := &ethtypes.Transaction{}
+			if err := ethTx.UnmarshalBinary(data); err != nil {
+				return errors.Wrap(err, "failed to decode ethereum tx")
+			}
+
+			msg := &types.MsgEthereumTx{}
+			msg.FromEthereumTx(ethTx)
+
+			rpcTx, err := rpctypes.NewRPCTransaction(msg, common.Hash{}, 0, 0, nil, ethTx.ChainId())
+			if err != nil {
+				return errors.Wrap(err, "failed to recover ethereum tx sender")
+			}
+
+			bz, err := json.Marshal(rpcTx)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatJSON, "Output format (text|json)")
+	return cmd
+}
+
 // NewSendTxCmd returns a CLI command handler for creating a MsgSend transaction.
 func NewSendTxCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -177,3 +226,40 @@ When using '--dry-run' a key name cannot be used, only an 0x or bech32 address.
 
 	return cmd
 }
+
+// NewRegisterCodeMetadataCmd returns a CLI command handler for creating a
+// MsgRegisterCodeMetadata transaction.
+func NewRegisterCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-code-metadata [code_hash] [metadata_uri]",
+		Short: "Publish the metadata of a contract code hash.",
+		Long: `Publish the metadata of a contract code hash stored on-chain, such as the
+location of its compiler metadata, to be used by explorers as a source verification
+anchor. Publishing again replaces the metadata previously published by the sender.
+`,
+		Example: "evmd tx evm register-code-metadata 0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8 ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi --from mykey",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterCodeMetadata{
+				Sender:      clientCtx.GetFromAddress().String(),
+				CodeHash:    args[0],
+				MetadataUri: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+)
+
+func TestDecodeTxCmd(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0xA2A8B87390F8F2D188242656BFb6852914073D06")
+	chainID := big.NewInt(9001)
+
+	ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	require.NoError(t, err)
+	bz, err := ethTx.MarshalBinary()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		args    []string
+		expPass bool
+	}{
+		{"invalid hex", []string{"0xzz"}, false},
+		{"invalid tx", []string{"0x01"}, false},
+		{"success", []string{hexutil.Encode(bz), "--" + flags.FlagOutput, flags.OutputFormatJSON}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(client.Context{}, NewDecodeTxCmd(), tc.args)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var rpcTx rpctypes.RPCTransaction
+			require.NoError(t, json.Unmarshal(out.Bytes(), &rpcTx))
+			require.Equal(t, ethTx.Hash(), rpcTx.Hash)
+			require.Equal(t, from, rpcTx.From)
+			require.Equal(t, &to, rpcTx.To)
+			require.Equal(t, uint64(1), uint64(rpcTx.Nonce))
+			require.Equal(t, chainID, rpcTx.ChainID.ToInt())
+			require.Equal(t, big.NewInt(100), rpcTx.Value.ToInt())
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package vm
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -11,6 +14,7 @@ import (
 	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,11 +25,18 @@ func InitGenesis(
 	accountKeeper types.AccountKeeper,
 	data types.GenesisState,
 ) []abci.ValidatorUpdate {
+	// the params history is recorded before the params, which are recorded as
+	// a change at the genesis height if they differ from the last one
+	k.SetParamsHistory(ctx, data.ParamsHistory)
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(fmt.Errorf("error setting params %s", err))
 	}
 
+	if err := k.SetParamsAuthorities(ctx, data.ParamsAuthorities); err != nil {
+		panic(fmt.Errorf("error setting params authorities %s", err))
+	}
+
 	// ensure evm module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the EVM module account has not been set")
@@ -41,7 +52,9 @@ func InitGenesis(
 			panic(fmt.Errorf("account not found for address %s", account.Address))
 		}
 
-		code := common.Hex2Bytes(account.Code)
+		// the code is exported without the 0x prefix, which the migrated and
+		// hand written genesis files may have
+		code := common.FromHex(account.Code)
 		codeHash := crypto.Keccak256Hash(code).Bytes()
 
 		if !types.IsEmptyCodeHash(codeHash) {
@@ -53,7 +66,13 @@ func InitGenesis(
 		}
 
 		for _, storage := range account.Storage {
-			k.SetState(ctx, address, common.HexToHash(storage.Key), common.HexToHash(storage.Value).Bytes())
+			value := common.HexToHash(storage.Value)
+			// zero values are deleted from the contract storage when committed,
+			// so they are not stored either
+			if value == (common.Hash{}) {
+				continue
+			}
+			k.SetState(ctx, address, common.HexToHash(storage.Key), value.Bytes())
 		}
 	}
 
@@ -61,6 +80,15 @@ func InitGenesis(
 		panic(fmt.Errorf("error adding preinstalls: %s", err))
 	}
 
+	for _, metadata := range data.CodeMetadata {
+		if len(k.GetCode(ctx, common.HexToHash(metadata.CodeHash))) == 0 {
+			panic(fmt.Errorf("code metadata published for code hash %s without code", metadata.CodeHash))
+		}
+		if err := k.SetCodeMetadata(ctx, metadata); err != nil {
+			panic(fmt.Errorf("error setting code metadata: %s", err))
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -81,7 +109,128 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:          ethGenAccounts,
+		Params:            k.GetParams(ctx),
+		ParamsAuthorities: k.GetParamsAuthorities(ctx),
+		CodeMetadata:      k.GetAllCodeMetadata(ctx),
+		ParamsHistory:     k.GetParamsHistory(ctx),
+	}
+}
+
+// ExportGenesisTo writes the genesis state of the EVM module to the given
+// writer as JSON, one storage slot at a time, so that the state of the
+// contracts is never loaded in memory at once. The written state is the
+// ExportGenesis one.
+func ExportGenesisTo(ctx sdk.Context, k *keeper.Keeper, cdc codec.JSONCodec, w io.Writer) error {
+	params := k.GetParams(ctx)
+	paramsBz, err := cdc.MarshalJSON(&params)
+	if err != nil {
+		return err
+	}
+
+	authorities := k.GetParamsAuthorities(ctx)
+	authoritiesBz := make([]string, len(authorities))
+	for i := range authorities {
+		bz, err := cdc.MarshalJSON(&authorities[i])
+		if err != nil {
+			return err
+		}
+		authoritiesBz[i] = string(bz)
+	}
+
+	codeMetadata := k.GetAllCodeMetadata(ctx)
+	codeMetadataBz := make([]string, len(codeMetadata))
+	for i := range codeMetadata {
+		bz, err := cdc.MarshalJSON(&codeMetadata[i])
+		if err != nil {
+			return err
+		}
+		codeMetadataBz[i] = string(bz)
+	}
+
+	history := k.GetParamsHistory(ctx)
+	historyBz := make([]string, len(history))
+	for i := range history {
+		bz, err := cdc.MarshalJSON(&history[i])
+		if err != nil {
+			return err
+		}
+		historyBz[i] = string(bz)
+	}
+
+	if _, err := io.WriteString(w, `{"accounts":[`); err != nil {
+		return err
+	}
+
+	first := true
+	k.IterateContracts(ctx, func(address common.Address, codeHash common.Hash) (stop bool) {
+		if !first {
+			if _, err = io.WriteString(w, ","); err != nil {
+				return true
+			}
+		}
+		first = false
+
+		err = writeGenesisAccount(ctx, k, cdc, w, address, codeHash)
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(
+		w, `],"params":%s,"preinstalls":[],"params_authorities":[%s],"code_metadata":[%s],"params_history":[%s]}`,
+		paramsBz, strings.Join(authoritiesBz, ","), strings.Join(codeMetadataBz, ","), strings.Join(historyBz, ","),
+	)
+	return err
+}
+
+// writeGenesisAccount writes the genesis account of the given contract as JSON.
+func writeGenesisAccount(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	cdc codec.JSONCodec,
+	w io.Writer,
+	address common.Address,
+	codeHash common.Hash,
+) error {
+	addressBz, err := json.Marshal(address.String())
+	if err != nil {
+		return err
+	}
+
+	codeBz, err := json.Marshal(common.Bytes2Hex(k.GetCode(ctx, codeHash)))
+	if err != nil {
+		return err
 	}
+
+	if _, err := fmt.Fprintf(w, `{"address":%s,"code":%s,"storage":[`, addressBz, codeBz); err != nil {
+		return err
+	}
+
+	first := true
+	k.ForEachStorage(ctx, address, func(key, value common.Hash) bool {
+		state := types.NewState(key, value)
+
+		var stateBz []byte
+		if stateBz, err = cdc.MarshalJSON(&state); err != nil {
+			return false
+		}
+
+		if !first {
+			if _, err = io.WriteString(w, ","); err != nil {
+				return false
+			}
+		}
+		first = false
+
+		_, err = w.Write(stateBz)
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
 }
@@ -7,6 +7,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -47,6 +48,8 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 		k.evmMempool.GetBlockchain().NotifyNewBlock()
 	}
 
+	defer telemetry.MeasureSince(telemetry.Now(), "evm", "bloom", "block")
+
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ApplyAtomicBatch applies the Ethereum transactions of an atomic batch in
+// order, on a branch of the state only written if all of them succeed.
+//
+// If one of them fails, the state changes of the whole batch are reverted and
+// all the transactions are recorded as failed in the block, outside of the
+// reverted branch: the transactions executed are charged the gas they used and
+// the transactions following the failed one, which are not executed, are
+// refunded all their gas. The fees and nonces of all the transactions are
+// consumed by the ante handler, as for single failed transactions.
+func (k *Keeper) ApplyAtomicBatch(ctx sdk.Context, msgs []*types.MsgEthereumTx) ([]*types.AtomicBatchResult, error) {
+	batchCtx, commit := ctx.CacheContext()
+
+	results := make([]*types.AtomicBatchResult, 0, len(msgs))
+	var failure *types.MsgEthereumTxResponse
+	for _, msg := range msgs {
+		txCtx := batchCtx.WithEventManager(sdk.NewEventManager())
+		txIndex := k.GetTxIndexTransient(txCtx)
+
+		res, err := k.ApplyTransaction(txCtx, msg.AsTransaction())
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &types.AtomicBatchResult{
+			Response: res,
+			TxIndex:  txIndex,
+			Events:   txCtx.EventManager().Events(),
+		})
+		if res.Failed() {
+			failure = res
+			break
+		}
+	}
+
+	if failure == nil {
+		commit()
+		return results, nil
+	}
+
+	// record the reverted transactions on the state before the batch
+	baseFee := k.GetBaseFee(ctx)
+	reverted := make([]*types.AtomicBatchResult, len(msgs))
+	for i, msg := range msgs {
+		hash := msg.Hash()
+		res := &types.MsgEthereumTxResponse{
+			Hash:           hash.Hex(),
+			BlockHash:      ctx.HeaderHash(),
+			BlockTimestamp: uint64(ctx.BlockTime().Unix()), //#nosec G115 -- int overflow is not a concern here
+		}
+
+		// the failed transaction is the last one executed
+		switch {
+		case i == len(results)-1:
+			res.Ret = failure.Ret
+			res.VmError = failure.VmError
+			res.GasUsed = failure.GasUsed
+			res.MaxUsedGas = failure.MaxUsedGas
+		case i < len(results)-1:
+			res.VmError = errorsmod.Wrapf(types.ErrBatchTxFailed, "reverted by transaction %s: %s", failure.Hash, failure.FailureReason()).Error()
+			res.GasUsed = results[i].Response.GasUsed
+			res.MaxUsedGas = results[i].Response.MaxUsedGas
+		default:
+			res.VmError = errorsmod.Wrapf(types.ErrBatchTxFailed, "not executed after transaction %s: %s", failure.Hash, failure.FailureReason()).Error()
+		}
+
+		txIndex := k.GetTxIndexTransient(ctx)
+		if err := k.settleTransaction(ctx, *msg.AsMessage(baseFee), res, k.TxConfig(ctx, hash), baseFee); err != nil {
+			return nil, err
+		}
+
+		reverted[i] = &types.AtomicBatchResult{
+			Response: res,
+			TxIndex:  txIndex,
+		}
+	}
+
+	return reverted, nil
+}
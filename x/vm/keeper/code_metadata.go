@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCodeMetadata returns the metadata published by the given account for a
+// code hash, if any.
+func (k Keeper) GetCodeMetadata(ctx sdk.Context, codeHash common.Hash, publisher sdk.AccAddress) (types.CodeMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CodeMetadataKey(codeHash, publisher))
+	if bz == nil {
+		return types.CodeMetadata{}, false
+	}
+
+	var metadata types.CodeMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetCodeMetadata stores the metadata published by an account for a code
+// hash, replacing the one it previously published, if any.
+func (k Keeper) SetCodeMetadata(ctx sdk.Context, metadata types.CodeMetadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	codeHash := common.HexToHash(metadata.CodeHash)
+	metadata.CodeHash = codeHash.Hex()
+
+	publisher := sdk.MustAccAddressFromBech32(metadata.Publisher)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CodeMetadataKey(codeHash, publisher), k.cdc.MustMarshal(&metadata))
+	return nil
+}
+
+// GetAllCodeMetadata returns the metadata published for all the code hashes,
+// ordered by code hash and publisher.
+func (k Keeper) GetAllCodeMetadata(ctx sdk.Context) []types.CodeMetadata {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCodeMetadata)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var metadata []types.CodeMetadata
+	for ; iterator.Valid(); iterator.Next() {
+		var m types.CodeMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &m)
+		metadata = append(metadata, m)
+	}
+
+	return metadata
+}
@@ -4,9 +4,10 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/cosmos/evm/x/vm/types"
+
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -39,6 +40,31 @@ func CheckSenderBalance(
 	return nil
 }
 
+// CheckSenderValueBalance validates that the sender has enough funds to cover
+// the value transferred by the transaction. It is used instead of
+// CheckSenderBalance when the fees are paid in an alternative denomination.
+func CheckSenderValueBalance(
+	balance sdkmath.Int,
+	ethTx *ethtypes.Transaction,
+) error {
+	value := ethTx.Value()
+
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if balance.IsNegative() || balance.BigInt().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", balance, value,
+		)
+	}
+	return nil
+}
+
 // DeductTxCostsFromUserBalance deducts the fees from the user balance.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
@@ -68,6 +94,7 @@ func VerifyFee(
 	ethTx *ethtypes.Transaction,
 	denom string,
 	baseFee *big.Int,
+	gasOverrides types.GasOverrides,
 	homestead, istanbul, shanghai, isCheckTx bool,
 ) (sdk.Coins, error) {
 	isContractCreation := ethTx.To() == nil
@@ -80,7 +107,7 @@ func VerifyFee(
 	}
 
 	authList := ethTx.SetCodeAuthorizations()
-	intrinsicGas, err := core.IntrinsicGas(ethTx.Data(), accessList, authList, isContractCreation, homestead,
+	intrinsicGas, err := gasOverrides.IntrinsicGas(ethTx.Data(), accessList, authList, isContractCreation, homestead,
 		istanbul, shanghai)
 	if err != nil {
 		return nil, errorsmod.Wrapf(
@@ -24,12 +24,17 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	homestead := cfg.IsHomestead(height)
 	istanbul := cfg.IsIstanbul(height)
 	shanghai := cfg.IsShanghai(height, uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
-	return core.IntrinsicGas(msg.Data, msg.AccessList, msg.SetCodeAuthorizations, isContractCreation,
+	// the access list entries are only charged (and warmed) after the Berlin fork
+	accessList := msg.AccessList
+	if !cfg.IsBerlin(height) {
+		accessList = nil
+	}
+	return k.GetParams(ctx).GasOverrides.IntrinsicGas(msg.Data, accessList, msg.SetCodeAuthorizations, isContractCreation,
 		homestead, istanbul, shanghai)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, capped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
+// RefundGas transfers the leftover gas to the sender of the message (or to the fee granter, if the
+// fees were sponsored), capped to half of the total gas consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
@@ -44,8 +49,23 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
+		// fees paid in an alternative denomination are refunded in that same
+		// denomination, at the rate used to pay them.
+		if feeConversion, ok := types.GetFeeConversion(ctx); ok {
+			refundedCoins = sdk.NewCoins(sdk.NewCoin(feeConversion.Denom, feeConversion.ConvertRefund(remaining)))
+			if refundedCoins.IsZero() {
+				return nil
+			}
+		}
+
+		// fees sponsored through a fee grant are refunded to the granter
+		refundAddr := sdk.AccAddress(msg.From.Bytes())
+		if granter, ok := types.GetFeeGranter(ctx); ok {
+			refundAddr = granter
+		}
+
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundAddr, refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
@@ -57,6 +77,34 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// AddTransientBaseFees records the base fee portion of the fees paid for the gas
+// used by the transaction, in the denomination held by the fee collector. The
+// base fee is capped to the gas price of the message.
+func (k *Keeper) AddTransientBaseFees(ctx sdk.Context, msg core.Message, gasUsed uint64, baseFee *big.Int) {
+	if baseFee == nil || msg.GasPrice == nil {
+		return
+	}
+
+	price := baseFee
+	if msg.GasPrice.Cmp(price) < 0 {
+		price = msg.GasPrice
+	}
+
+	amount := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), price)
+	if amount.Sign() <= 0 {
+		return
+	}
+
+	// fees paid in an alternative denomination are held in that same denomination
+	if feeConversion, ok := types.GetFeeConversion(ctx); ok {
+		k.feeMarketWrapper.AddTransientBaseFees(ctx, sdk.NewCoins(sdk.NewCoin(feeConversion.Denom, feeConversion.ConvertRefund(amount))))
+		return
+	}
+
+	baseFees := sdk.Coins{sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewIntFromBigInt(amount))}
+	k.feeMarketWrapper.AddTransientBaseFees(ctx, types.ConvertCoinsDenomToExtendedDenom(baseFees))
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
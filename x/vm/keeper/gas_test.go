@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/cosmos/evm/testutil/config"
+	vmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestRefundGas() {
+	suite.Require().NoError(config.EvmAppOptions(uint64(config.EighteenDecimalsChainID)))
+
+	sender := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	// 1000 leftover gas at a gas price of 10
+	msg := core.Message{From: sender, GasPrice: big.NewInt(10)}
+	granter := sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000002").Bytes())
+
+	testCases := []struct {
+		name         string
+		malleate     func(ctx sdk.Context) sdk.Context
+		leftoverGas  uint64
+		expRefund    sdk.Coins
+		expRecipient sdk.AccAddress
+	}{
+		{
+			name:        "refund in the EVM denom",
+			malleate:    func(ctx sdk.Context) sdk.Context { return ctx },
+			leftoverGas: 1000,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin(vmtypes.GetEVMCoinExtendedDenom(), 10000)),
+		},
+		{
+			name: "refund in the alternative fee denom, at the conversion rate",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				return vmtypes.WithFeeConversion(ctx, vmtypes.NewFeeConversion("ufoo", sdkmath.LegacyNewDec(4)))
+			},
+			leftoverGas: 1000,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("ufoo", 2500)),
+		},
+		{
+			name: "refund in the alternative fee denom, rounded down",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				return vmtypes.WithFeeConversion(ctx, vmtypes.NewFeeConversion("ufoo", sdkmath.LegacyNewDec(3)))
+			},
+			leftoverGas: 1000,
+			expRefund:   sdk.NewCoins(sdk.NewInt64Coin("ufoo", 3333)),
+		},
+		{
+			name: "no refund when the converted refund is zero",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				return vmtypes.WithFeeConversion(ctx, vmtypes.NewFeeConversion("ufoo", sdkmath.LegacyNewDec(100)))
+			},
+			leftoverGas: 1,
+		},
+		{
+			name: "refund to the fee granter",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				return vmtypes.WithFeeGranter(ctx, granter)
+			},
+			leftoverGas:  1000,
+			expRefund:    sdk.NewCoins(sdk.NewInt64Coin(vmtypes.GetEVMCoinExtendedDenom(), 10000)),
+			expRecipient: granter,
+		},
+		{
+			name: "refund to the fee granter in the alternative fee denom",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				ctx = vmtypes.WithFeeConversion(ctx, vmtypes.NewFeeConversion("ufoo", sdkmath.LegacyNewDec(4)))
+				return vmtypes.WithFeeGranter(ctx, granter)
+			},
+			leftoverGas:  1000,
+			expRefund:    sdk.NewCoins(sdk.NewInt64Coin("ufoo", 2500)),
+			expRecipient: granter,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := tc.malleate(suite.ctx)
+
+			if !tc.expRefund.Empty() {
+				recipient := tc.expRecipient
+				if recipient == nil {
+					recipient = sender.Bytes()
+				}
+				suite.bankKeeper.On(
+					"SendCoinsFromModuleToAccount",
+					mock.Anything,
+					authtypes.FeeCollectorName,
+					recipient,
+					tc.expRefund,
+				).Return(nil).Once()
+			}
+
+			err := suite.vmKeeper.RefundGas(ctx, msg, tc.leftoverGas, vmtypes.GetEVMCoinDenom())
+			suite.Require().NoError(err)
+			suite.bankKeeper.AssertExpectations(suite.T())
+		})
+	}
+}
@@ -21,15 +21,20 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/evm/ethereum/eip712"
 	cosmosevmtypes "github.com/cosmos/evm/types"
 	evmante "github.com/cosmos/evm/x/vm/ante"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -41,6 +46,9 @@ const (
 	maxTracePredecessors = 10_000
 
 	maxPredecessorGas = uint64(50_000_000)
+
+	// maxEthCallBatchSize is the maximum amount of calls included in an EthCallBatch request.
+	maxEthCallBatchSize = 100
 )
 
 // Account implements the Query/Account gRPC method. The method returns the
@@ -185,6 +193,40 @@ func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*typ
 	}, nil
 }
 
+// ContractStorage implements the Query/ContractStorage gRPC method. The method
+// returns the set storage slots of the given contract, ordered by key.
+func (k Keeper) ContractStorage(c context.Context, req *types.QueryContractStorageRequest) (*types.QueryContractStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(address))
+
+	var storage types.Storage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		storage = append(storage, types.NewState(common.BytesToHash(key), common.BytesToHash(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractStorageResponse{
+		Storage:    storage,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Code implements the Query/Code gRPC method
 func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 	if req == nil {
@@ -213,6 +255,50 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.Que
 	}, nil
 }
 
+// CodeMetadata implements the Query/CodeMetadata gRPC method. The method
+// returns the metadata published for the code hash of the given contract,
+// ordered by publisher.
+func (k Keeper) CodeMetadata(c context.Context, req *types.QueryCodeMetadataRequest) (*types.QueryCodeMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := cosmosevmtypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address := common.HexToAddress(req.Address)
+	acct := k.GetAccountWithoutBalance(ctx, address)
+	if acct == nil || !acct.IsContract() {
+		return nil, status.Errorf(codes.NotFound, "no contract deployed at address %s", req.Address)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeMetadataPrefix(common.BytesToHash(acct.CodeHash)))
+
+	var metadata []types.CodeMetadata
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var m types.CodeMetadata
+		if err := k.cdc.Unmarshal(value, &m); err != nil {
+			return err
+		}
+		metadata = append(metadata, m)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCodeMetadataResponse{
+		Metadata:   metadata,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -223,6 +309,31 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	}, nil
 }
 
+// ParamsAuthorities returns the accounts allowed to update a subset of the
+// module parameters.
+func (k Keeper) ParamsAuthorities(c context.Context, _ *types.QueryParamsAuthoritiesRequest) (*types.QueryParamsAuthoritiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsAuthoritiesResponse{ParamsAuthorities: k.GetParamsAuthorities(ctx)}, nil
+}
+
+// ParamsAtHeight returns the module parameters in effect at the end of a past
+// block, as recorded by the parameter change history.
+func (k Keeper) ParamsAtHeight(c context.Context, req *types.QueryParamsAtHeightRequest) (*types.QueryParamsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, changeHeight, found := k.GetParamsAtHeight(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no params change recorded at or below height %d", req.Height)
+	}
+	return &types.QueryParamsAtHeightResponse{Params: params, ChangeHeight: changeHeight}, nil
+}
+
 // EthCall implements eth_call rpc api.
 func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.MsgEthereumTxResponse, error) {
 	if req == nil {
@@ -231,22 +342,71 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	var args types.TransactionArgs
-	err := json.Unmarshal(req.Args, &args)
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the call is executed on top of the pending block, if any, and the
+	// overrides are applied to the resulting state
+	ctx, err = k.applyPendingTxs(ctx, cfg, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	return k.ethCall(ctx, req.Args, req.GasCap, cfg)
+}
+
+// EthCallBatch implements the Query/EthCallBatch gRPC method. The calls are
+// executed in order against the same state, none of them being committed, so
+// each call behaves as a separate `eth_call` at the requested height. A call
+// failing to execute is reported by its result and doesn't fail the batch.
+func (k Keeper) EthCallBatch(c context.Context, req *types.EthCallBatchRequest) (*types.EthCallBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Args) > maxEthCallBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch of %d calls exceeds the limit of %d", len(req.Args), maxEthCallBatchSize)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	results := make([]types.EthCallBatchResult, len(req.Args))
+	for i, args := range req.Args {
+		res, err := k.ethCall(ctx, args, req.GasCap, cfg)
+		if err != nil {
+			results[i].Error = status.Convert(err).Message()
+			continue
+		}
+		results[i].Response = res
+	}
+
+	return &types.EthCallBatchResponse{Results: results}, nil
+}
+
+// ethCall executes the call of the given json encoded args against the state of
+// the context, without committing it.
+func (k Keeper) ethCall(ctx sdk.Context, rawArgs []byte, gasCap uint64, cfg *statedb.EVMConfig) (*types.MsgEthereumTxResponse, error) {
+	var args types.TransactionArgs
+	err := json.Unmarshal(rawArgs, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	if err := args.CallDefaults(req.GasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
+	if err := args.CallDefaults(gasCap, cfg.BaseFee, types.GetEthChainConfig().ChainID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -291,9 +451,8 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 
 	// Binary search the gas requirement, as it may be higher than the amount used
 	var (
-		lo     = ethparams.TxGas - 1
-		hi     uint64
-		gasCap uint64
+		lo = ethparams.TxGas - 1
+		hi uint64
 	)
 
 	// Determine the highest gas limit can be used during the estimation.
@@ -314,12 +473,22 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		hi = req.GasCap
 	}
 
-	gasCap = hi
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress))
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	// the pending block and the overrides are applied once, each execution
+	// below runs against its own copy of the resulting state
+	ctx, err = k.applyPendingTxs(ctx, cfg, req.PendingTxs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx, err = k.applyStateOverrides(ctx, req.Overrides)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -372,10 +541,10 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		// update the message with the new gas value
 		msg.GasLimit = gas
 
-		tmpCtx := ctx
+		// every execution runs on an isolated snapshot of the state, so the
+		// state changes of a probe never leak into the next one
+		tmpCtx, _ := ctx.CacheContext()
 		if fromType == types.RPC {
-			tmpCtx, _ = ctx.CacheContext()
-
 			acct := k.GetAccount(tmpCtx, msg.From)
 
 			from := msg.From
@@ -428,20 +597,17 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 		return nil, err
 	}
 	if failed {
-		// Preserve Cosmos error semantics when the cap is reached
-		if hi == gasCap {
-			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
-				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return &types.EstimateGasResponse{
-						Ret:     result.Ret,
-						VmError: result.VmError,
-					}, nil
-				}
-				return nil, errors.New(result.VmError)
+		// a failure other than running out of gas can't be fixed by a larger
+		// allowance, the revert data is returned to the caller
+		if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
+			if result.VmError == vm.ErrExecutionReverted.Error() {
+				return &types.EstimateGasResponse{
+					Ret:     result.Ret,
+					VmError: result.VmError,
+				}, nil
 			}
-			return nil, fmt.Errorf("gas required exceeds allowance (%d)", gasCap)
+			return nil, errors.New(result.VmError)
 		}
-		// If no larger allowance is available, fail fast
 		return nil, fmt.Errorf("gas required exceeds allowance (%d)", hi)
 	}
 
@@ -779,6 +945,90 @@ func (k Keeper) Config(_ context.Context, _ *types.QueryConfigRequest) (*types.Q
 	return &types.QueryConfigResponse{Config: config}, nil
 }
 
+// EIP712TypedData implements the Query/EIP712TypedData gRPC method. It returns
+// the EIP-712 typed data that must be signed to authorize the given unsigned
+// transaction by the signer of the given account number.
+func (k Keeper) EIP712TypedData(c context.Context, req *types.QueryEIP712TypedDataRequest) (*types.QueryEIP712TypedDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var txRaw txtypes.TxRaw
+	if err := txRaw.Unmarshal(req.TxBytes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(txRaw.AuthInfoBytes); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// unsigned transactions might not contain the signer info yet
+	authInfoBytes := txRaw.AuthInfoBytes
+	if len(authInfo.SignerInfos) == 0 {
+		authInfo.SignerInfos = []*txtypes.SignerInfo{{Sequence: req.Sequence}}
+		bz, err := authInfo.Marshal()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		authInfoBytes = bz
+	}
+
+	signDoc := txtypes.SignDoc{
+		BodyBytes:     txRaw.BodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       req.ChainId,
+		AccountNumber: req.AccountNumber,
+	}
+	signDocBytes, err := signDoc.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the signer info of each signer has its own sequence
+	var signer cryptotypes.PubKey
+	if len(authInfo.SignerInfos) > 1 {
+		signer, err = k.signerOfAccountNumber(ctx, authInfo.SignerInfos, req.AccountNumber)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	typedData, err := eip712.GetEIP712TypedDataForSigner(signDocBytes, signer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bz, err := json.Marshal(typedData)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEIP712TypedDataResponse{TypedData: string(bz)}, nil
+}
+
+// signerOfAccountNumber returns the public key of the signer info of the account
+// with the given number.
+func (k Keeper) signerOfAccountNumber(ctx sdk.Context, signerInfos []*txtypes.SignerInfo, accountNumber uint64) (cryptotypes.PubKey, error) {
+	for _, info := range signerInfos {
+		if info.PublicKey == nil {
+			continue
+		}
+
+		var pubKey cryptotypes.PubKey
+		if err := k.cdc.UnpackAny(info.PublicKey, &pubKey); err != nil {
+			return nil, err
+		}
+		account := k.accountKeeper.GetAccount(ctx, sdk.AccAddress(pubKey.Address()))
+		if account != nil && account.GetAccountNumber() == accountNumber {
+			return pubKey, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no signer info found for account number %d", accountNumber)
+}
+
 // buildTraceCtx builds a context for simulating or tracing transactions by:
 // 1. assigning a new infinite gas meter with the provided gasLimit
 // 2. calling BuildEvmExecutionCtx to set up gas configs consistent with Ethereum transaction execution.
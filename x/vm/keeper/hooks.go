@@ -34,3 +34,48 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, sender common.Address,
 	}
 	return nil
 }
+
+// DefaultHooksName is the name under which the hooks set with SetHooks are
+// registered.
+const DefaultHooksName = "default"
+
+// evmHook is an EVM hook registered on the keeper.
+type evmHook struct {
+	name     string
+	priority int64
+	policy   types.HookFailurePolicy
+	hooks    types.EvmHooks
+}
+
+// hasHook returns true if hooks are registered under the given name.
+func (k *Keeper) hasHook(name string) bool {
+	for _, h := range k.hooks {
+		if h.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// runHook runs the PostTxProcessing of the given hook. The error of a
+// fail-open hook is logged and its state changes and receipt logs changes are
+// discarded, while the error of a fail-closed hook is returned.
+func (k *Keeper) runHook(ctx sdk.Context, h evmHook, sender common.Address, msg core.Message, receipt *ethtypes.Receipt) error {
+	if h.policy == types.HookFailClosed {
+		if err := h.hooks.PostTxProcessing(ctx, sender, msg, receipt); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %s failed", h.name)
+		}
+		return nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	logs := receipt.Logs
+	if err := h.hooks.PostTxProcessing(cacheCtx, sender, msg, receipt); err != nil {
+		receipt.Logs = logs
+		k.Logger(ctx).Error("fail-open EVM hook failed", "hook", h.name, "error", err)
+		return nil
+	}
+
+	writeCache()
+	return nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the evm module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-code", ContractCodeInvariant(k))
+}
+
+// AllInvariants runs all invariants of the evm module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ContractCodeInvariant(k)(ctx)
+	}
+}
+
+// ContractCodeInvariant checks that the code of every contract account is
+// stored under its code hash.
+func ContractCodeInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateContracts(ctx, func(addr common.Address, codeHash common.Hash) bool {
+			if len(k.GetCode(ctx, codeHash)) == 0 {
+				count++
+				msg += fmt.Sprintf("\tcontract %s has no code stored for code hash %s\n", addr, codeHash)
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "contract-code",
+			fmt.Sprintf("found %d contracts without code\n%s", count, msg),
+		), broken
+	}
+}
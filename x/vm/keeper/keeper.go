@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -14,6 +16,7 @@ import (
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/vm/parallel"
 	"github.com/cosmos/evm/x/vm/statedb"
 	"github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/evm/x/vm/wrappers"
@@ -70,8 +73,8 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
-	hooks types.EvmHooks
-	// EVM Hooks for tx post-processing
+	// EVM Hooks for tx post-processing, sorted by priority and name
+	hooks []evmHook
 
 	// precompiles defines the map of all available precompiled smart contracts.
 	// Some of these precompiled contracts might not be active depending on the EVM
@@ -81,6 +84,14 @@ type Keeper struct {
 	// evmMempool is the custom EVM appside mempool
 	// if it is nil, the default comet mempool will be used
 	evmMempool *evmmempool.ExperimentalEVMMempool
+
+	// prefetcher executes the transactions of the finalized blocks
+	// concurrently, if it is nil the transactions are only executed serially
+	prefetcher *parallel.Prefetcher
+
+	// precompileQueries memoizes the results of the read-only precompile calls
+	// of the block being finalized
+	precompileQueries *statedb.PrecompileQueries
 }
 
 // NewKeeper generates new evm module keeper
@@ -112,18 +123,19 @@ func NewKeeper(
 
 	// NOTE: we pass in the parameter space to the CommitStateDB in order to use custom denominations for the EVM operations
 	return &Keeper{
-		cdc:              cdc,
-		authority:        authority,
-		accountKeeper:    ak,
-		bankWrapper:      bankWrapper,
-		stakingKeeper:    sk,
-		feeMarketWrapper: feeMarketWrapper,
-		storeKey:         storeKey,
-		transientKey:     transientKey,
-		tracer:           tracer,
-		consensusKeeper:  consensusKeeper,
-		erc20Keeper:      erc20Keeper,
-		storeKeys:        keys,
+		cdc:               cdc,
+		authority:         authority,
+		accountKeeper:     ak,
+		bankWrapper:       bankWrapper,
+		stakingKeeper:     sk,
+		feeMarketWrapper:  feeMarketWrapper,
+		storeKey:          storeKey,
+		transientKey:      transientKey,
+		tracer:            tracer,
+		consensusKeeper:   consensusKeeper,
+		erc20Keeper:       erc20Keeper,
+		storeKeys:         keys,
+		precompileQueries: statedb.NewPrecompileQueries(),
 	}
 }
 
@@ -152,24 +164,27 @@ func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
 }
 
-// GetBlockBloomTransient returns bloom bytes for the current block height
+// GetBlockBloomTransient returns the bloom of the current block, combining the
+// blooms of its transactions.
 func (k Keeper) GetBlockBloomTransient(ctx sdk.Context) *big.Int {
 	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBloom)
-	heightBz := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())) //nolint:gosec // G115 // won't exceed uint64
-	bz := store.Get(heightBz)
-	if len(bz) == 0 {
-		return big.NewInt(0)
-	}
+	it := store.Iterator(nil, nil)
+	defer it.Close()
 
-	return new(big.Int).SetBytes(bz)
+	bloom := big.NewInt(0)
+	for ; it.Valid(); it.Next() {
+		bloom.Or(bloom, new(big.Int).SetBytes(it.Value()))
+	}
+	return bloom
 }
 
-// SetBlockBloomTransient sets the given bloom bytes to the transient store. This value is reset on
-// every block.
-func (k Keeper) SetBlockBloomTransient(ctx sdk.Context, bloom *big.Int) {
+// SetTxBloomTransient sets the bloom of the logs of the transaction at the
+// given index of the block to the transient store. Each transaction has its own
+// entry, so that the transactions of the block don't read the bloom of the
+// preceding ones. This value is reset on every block.
+func (k Keeper) SetTxBloomTransient(ctx sdk.Context, txIndex uint64, bloom ethtypes.Bloom) {
 	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBloom)
-	heightBz := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())) //nolint:gosec // G115 // won't exceed uint64
-	store.Set(heightBz, bloom.Bytes())
+	store.Set(sdk.Uint64ToBigEndian(txIndex), bloom.Bytes())
 }
 
 // ----------------------------------------------------------------------------
@@ -194,12 +209,41 @@ func (k Keeper) GetTxIndexTransient(ctx sdk.Context) uint64 {
 
 // SetHooks sets the hooks for the EVM module
 // Called only once during initialization, panics if called more than once.
+// The hooks are registered under DefaultHooksName with the fail-closed policy.
 func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
-	if k.hooks != nil {
+	if k.hasHook(DefaultHooksName) {
 		panic("cannot set evm hooks twice")
 	}
 
-	k.hooks = eh
+	return k.RegisterHooks(DefaultHooksName, 0, types.HookFailClosed, eh)
+}
+
+// RegisterHooks registers the hooks for the EVM module under the given name.
+// The hooks are run in ascending priority order, then in name order for the
+// same priority. The failure policy defines whether a hook error reverts the
+// EVM transaction or is only logged.
+// Called only during initialization, panics if the name is already registered.
+func (k *Keeper) RegisterHooks(name string, priority int64, policy types.HookFailurePolicy, eh types.EvmHooks) *Keeper {
+	if name == "" {
+		panic("evm hooks name cannot be empty")
+	}
+	if eh == nil {
+		panic(fmt.Sprintf("evm hooks %s cannot be nil", name))
+	}
+	if policy != types.HookFailClosed && policy != types.HookFailOpen {
+		panic(fmt.Sprintf("invalid failure policy %d for evm hooks %s", policy, name))
+	}
+	if k.hasHook(name) {
+		panic(fmt.Sprintf("evm hooks %s already registered", name))
+	}
+
+	k.hooks = append(k.hooks, evmHook{name: name, priority: priority, policy: policy, hooks: eh})
+	sort.SliceStable(k.hooks, func(i, j int) bool {
+		if k.hooks[i].priority != k.hooks[j].priority {
+			return k.hooks[i].priority < k.hooks[j].priority
+		}
+		return k.hooks[i].name < k.hooks[j].name
+	})
 	return k
 }
 
@@ -211,15 +255,17 @@ func (k *Keeper) PostTxProcessing(
 	msg core.Message,
 	receipt *ethtypes.Receipt,
 ) error {
-	if k.hooks == nil {
-		return nil
+	for _, h := range k.hooks {
+		if err := k.runHook(ctx, h, sender, msg, receipt); err != nil {
+			return err
+		}
 	}
-	return k.hooks.PostTxProcessing(ctx, sender, msg, receipt)
+	return nil
 }
 
 // HasHooks returns true if hooks are set
 func (k *Keeper) HasHooks() bool {
-	return k.hooks != nil
+	return len(k.hooks) > 0
 }
 
 // ----------------------------------------------------------------------------
@@ -408,6 +454,22 @@ func (k Keeper) GetEvmMempool() *evmmempool.ExperimentalEVMMempool {
 	return k.evmMempool
 }
 
+// SetPrefetcher sets the prefetcher of the Ethereum transactions
+func (k *Keeper) SetPrefetcher(prefetcher *parallel.Prefetcher) {
+	k.prefetcher = prefetcher
+}
+
+// GetPrefetcher returns the prefetcher of the Ethereum transactions
+func (k Keeper) GetPrefetcher() *parallel.Prefetcher {
+	return k.prefetcher
+}
+
+// PrecompileQueries returns the memoized results of the read-only precompile
+// calls of the block being finalized
+func (k Keeper) PrecompileQueries() *statedb.PrecompileQueries {
+	return k.precompileQueries
+}
+
 // SetHeaderHash sets current block hash into EIP-2935 compatible storage contract.
 func (k Keeper) SetHeaderHash(ctx sdk.Context) {
 	window := uint64(types.DefaultHistoryServeWindow)
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It records the
+// current parameters in the parameter change history, so that they can be
+// queried at the heights following the upgrade.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.setParamsHistory(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
@@ -2,14 +2,13 @@ package keeper
 
 import (
 	"context"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/hashicorp/go-metrics"
 
-	cmttypes "github.com/cometbft/cometbft/types"
-
+	cosmosevmtypes "github.com/cosmos/evm/types"
 	"github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -29,6 +28,16 @@ var _ types.MsgServer = &Keeper{}
 func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	batch, isBatch := types.GetAtomicBatch(ctx)
+
+	// reuse the result of the execution prefetched at the beginning of the
+	// block if the state it read is unchanged
+	if !isBatch {
+		if response, ok := k.prefetcher.Apply(ctx, msg); ok {
+			return response, nil
+		}
+	}
+
 	tx := msg.AsTransaction()
 	txIndex := k.GetTxIndexTransient(ctx)
 
@@ -41,9 +50,29 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplyTransaction(ctx, msg.AsTransaction())
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to apply transaction")
+	var (
+		response *types.MsgEthereumTxResponse
+		err      error
+	)
+	if isBatch {
+		// the batch is applied with its first transaction, the result of each
+		// transaction is then returned by its own message
+		if batch.Results == nil {
+			if batch.Results, err = k.ApplyAtomicBatch(ctx, batch.Msgs); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to apply transaction batch")
+			}
+		}
+		result, found := batch.Result(tx.Hash().Hex())
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrBatchTxFailed, "transaction %s not found in the batch", tx.Hash())
+		}
+		response, txIndex = result.Response, result.TxIndex
+		ctx.EventManager().EmitEvents(result.Events)
+	} else {
+		response, err = k.ApplyTransaction(ctx, tx)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply transaction")
+		}
 	}
 
 	defer func() {
@@ -84,10 +113,9 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(response.GasUsed, 10)),
 	}
 
-	if len(ctx.TxBytes()) > 0 {
+	if hash := types.CometTxHash(ctx); hash != "" {
 		// add event for CometBFT transaction hash format
-		hash := cmttypes.Tx(ctx.TxBytes()).Hash()
-		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxHash, hex.EncodeToString(hash)))
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyTxHash, hash))
 	}
 
 	if to := tx.To(); to != nil {
@@ -95,7 +123,7 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 	}
 
 	if response.Failed() {
-		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.FailureReason()))
 	}
 
 	// emit events
@@ -118,18 +146,45 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 // UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
 // proposal passes, it updates the module parameters. The update can only be
 // performed if the requested authority is the Cosmos SDK governance module
-// account.
+// account, or a params authority, which only updates the params it's allowed
+// to.
 func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := req.Params
+	if k.authority.String() != req.Authority {
+		paramsAuthority, found := k.GetParamsAuthority(ctx, req.Authority)
+		if !found {
+			return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+		}
+
+		// only the params the authority is allowed to update are changed
+		params = k.GetParams(ctx)
+		cosmosevmtypes.ApplyParams(&params, &req.Params, paramsAuthority.Params)
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateParamsAuthorities implements the gRPC MsgServer interface. When an
+// UpdateParamsAuthorities proposal passes, it replaces the accounts allowed to
+// update a subset of the module parameters. The update can only be performed
+// if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateParamsAuthorities(goCtx context.Context, req *types.MsgUpdateParamsAuthorities) (*types.MsgUpdateParamsAuthoritiesResponse, error) {
 	if k.authority.String() != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.SetParams(ctx, req.Params); err != nil {
+	if err := k.SetParamsAuthorities(ctx, req.ParamsAuthorities); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	return &types.MsgUpdateParamsAuthoritiesResponse{}, nil
 }
 
 // RegisterPreinstalls implements the gRPC MsgServer interface. When a RegisterPreinstalls
@@ -150,3 +205,37 @@ func (k *Keeper) RegisterPreinstalls(goCtx context.Context, req *types.MsgRegist
 
 	return &types.MsgRegisterPreinstallsResponse{}, nil
 }
+
+// RegisterCodeMetadata implements the gRPC MsgServer interface. It stores the
+// metadata published by the sender for a code hash, which must be the hash of a
+// code stored on-chain. Any account can publish metadata for a code hash, and
+// publishing again replaces the metadata the sender previously published.
+func (k *Keeper) RegisterCodeMetadata(goCtx context.Context, req *types.MsgRegisterCodeMetadata) (*types.MsgRegisterCodeMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	publisher, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	codeHash := common.HexToHash(req.CodeHash)
+	if len(k.GetCode(ctx, codeHash)) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCodeMetadata, "no code stored for code hash %s", req.CodeHash)
+	}
+
+	metadata := types.NewCodeMetadata(codeHash, publisher, req.MetadataUri)
+	if err := k.SetCodeMetadata(ctx, metadata); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidCodeMetadata, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCodeMetadata,
+			sdk.NewAttribute(types.AttributeKeyCodeHash, metadata.CodeHash),
+			sdk.NewAttribute(types.AttributeKeyPublisher, metadata.Publisher),
+			sdk.NewAttribute(types.AttributeKeyMetadataURI, metadata.MetadataUri),
+		),
+	)
+
+	return &types.MsgRegisterCodeMetadataResponse{}, nil
+}
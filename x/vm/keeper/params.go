@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/evm/utils"
 	"github.com/cosmos/evm/x/vm/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -34,6 +36,13 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	// only the precompiles compiled in the binary can be activated
+	for _, precompile := range params.ActiveStaticPrecompiles {
+		if k.precompiles != nil && !k.IsAvailableStaticPrecompile(common.HexToAddress(precompile)) {
+			return errorsmod.Wrapf(types.ErrUnavailablePrecompile, "cannot activate precompile %s", precompile)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -41,6 +50,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	}
 
 	store.Set(types.KeyPrefixParams, bz)
+	k.setParamsHistory(ctx, params)
 	return nil
 }
 
@@ -60,6 +70,22 @@ func (k Keeper) EnableStaticPrecompiles(ctx sdk.Context, addresses ...common.Add
 	return k.SetParams(ctx, params)
 }
 
+// DisableStaticPrecompiles removes the addresses of the given Precompiles from the
+// list of active static precompiles. They remain available and can be enabled
+// again without a binary upgrade.
+func (k Keeper) DisableStaticPrecompiles(ctx sdk.Context, addresses ...common.Address) error {
+	params := k.GetParams(ctx)
+	for _, address := range addresses {
+		i := slices.Index(params.ActiveStaticPrecompiles, address.Hex())
+		if i == -1 {
+			return errorsmod.Wrapf(types.ErrInactivePrecompile, "precompile %s", address.Hex())
+		}
+		params.ActiveStaticPrecompiles = slices.Delete(params.ActiveStaticPrecompiles, i, i+1)
+	}
+
+	return k.SetParams(ctx, params)
+}
+
 func appendPrecompiles(existingPrecompiles []string, addresses ...common.Address) ([]string, error) {
 	// check for duplicates
 	hexAddresses := make([]string, len(addresses))
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxPendingTxs is the maximum amount of pending transactions executed before a call.
+const maxPendingTxs = 1000
+
+// applyPendingTxs executes the binary encoded transactions of the pending block
+// in order on a cached copy of the context state, so that a call observes the
+// pending state. As in a block, a transaction with an unexpected nonce or
+// failing to execute is skipped. The fees of the transactions are not charged,
+// as their deduction is part of the ante handler.
+// The context is returned unchanged if there are no pending transactions.
+func (k *Keeper) applyPendingTxs(ctx sdk.Context, cfg *statedb.EVMConfig, rawTxs [][]byte) (sdk.Context, error) {
+	if len(rawTxs) == 0 {
+		return ctx, nil
+	}
+	if len(rawTxs) > maxPendingTxs {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "too many pending transactions, got %d: limit %d", len(rawTxs), maxPendingTxs)
+	}
+
+	signer := ethtypes.MakeSigner(types.GetEthChainConfig(), big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())) //#nosec G115 -- int overflow is not a concern here
+	txConfig := statedb.NewEmptyTxConfig()
+
+	ctx, _ = ctx.CacheContext()
+	for i, rawTx := range rawTxs {
+		tx := new(ethtypes.Transaction)
+		if err := tx.UnmarshalBinary(rawTx); err != nil {
+			return ctx, errorsmod.Wrapf(err, "invalid pending transaction at index %d", i)
+		}
+
+		msg, err := core.TransactionToMessage(tx, signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+
+		// each transaction runs on its own cache, discarded if the transaction
+		// fails to execute, as it would then not be included in the block
+		txCtx, write := ctx.CacheContext()
+
+		// the nonce is checked and increased by the ante handler on delivery
+		account := k.GetAccountOrEmpty(txCtx, msg.From)
+		if account.Nonce != msg.Nonce {
+			continue
+		}
+		account.Nonce++
+		if err := k.SetAccount(txCtx, msg.From, account); err != nil {
+			return ctx, err
+		}
+
+		txConfig.TxHash = tx.Hash()
+		txConfig.TxIndex = uint(i) //nolint:gosec // G115 // won't exceed uint64
+
+		rsp, err := k.ApplyMessageWithConfig(buildTraceCtx(txCtx, msg.GasLimit), *msg, nil, true, cfg, txConfig, false)
+		if err != nil {
+			continue
+		}
+		write()
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+
+	return ctx, nil
+}
@@ -9,6 +9,8 @@ import (
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 )
 
+var p256Address = common.HexToAddress(types.P256PrecompileAddress)
+
 type Precompiles struct {
 	Map       map[common.Address]vm.PrecompiledContract
 	Addresses []common.Address
@@ -21,6 +23,12 @@ func (k *Keeper) GetPrecompileInstance(
 	address common.Address,
 ) (*Precompiles, bool, error) {
 	params := k.GetParams(ctx)
+
+	// The p256 precompile is only available from its activation block
+	if address == p256Address && !types.GetChainConfig().IsP256Active(ctx.BlockHeight()) {
+		return nil, false, nil
+	}
+
 	// Get the precompile from the static precompiles
 	if precompile, found, err := k.GetStaticPrecompileInstance(&params, address); err != nil {
 		return nil, false, err
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/utils"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// applyStateOverrides applies the json encoded state overrides of a call to a
+// cached copy of the context state, so they never reach the underlying store.
+// The context is returned unchanged if there are no overrides.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, rawOverrides []byte) (sdk.Context, error) {
+	if len(rawOverrides) == 0 {
+		return ctx, nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(rawOverrides, &overrides); err != nil {
+		return ctx, errorsmod.Wrap(err, "invalid state overrides")
+	}
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	ctx, _ = ctx.CacheContext()
+	for addr, override := range overrides {
+		account := k.GetAccountOrEmpty(ctx, addr)
+		if override.Nonce != nil {
+			account.Nonce = uint64(*override.Nonce)
+		}
+		if override.Code != nil {
+			codeHash := crypto.Keccak256Hash(*override.Code)
+			if len(*override.Code) > 0 {
+				k.SetCode(ctx, codeHash.Bytes(), *override.Code)
+			}
+			account.CodeHash = codeHash.Bytes()
+		}
+		if override.Balance != nil {
+			balance, err := utils.Uint256FromBigInt((*big.Int)(*override.Balance))
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "invalid balance override for account %s", addr.Hex())
+			}
+			account.Balance = balance
+		}
+		if err := k.SetAccount(ctx, addr, account); err != nil {
+			return ctx, err
+		}
+
+		// the state replaces the whole account storage, while the state diff
+		// only replaces the given slots
+		if override.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.DeleteState(ctx, addr, key)
+			}
+			k.setOverriddenStorage(ctx, addr, *override.State)
+		}
+		if override.StateDiff != nil {
+			k.setOverriddenStorage(ctx, addr, *override.StateDiff)
+		}
+	}
+
+	return ctx, nil
+}
+
+// setOverriddenStorage sets the given storage slots of an account, deleting the
+// slots overridden with an empty value.
+func (k *Keeper) setOverriddenStorage(ctx sdk.Context, addr common.Address, storage map[common.Hash]common.Hash) {
+	for key, value := range storage {
+		if value == (common.Hash{}) {
+			k.DeleteState(ctx, addr, key)
+			continue
+		}
+		k.SetState(ctx, addr, key, value.Bytes())
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 )
@@ -75,6 +76,17 @@ func (k *Keeper) NewEVM(
 		accessControl.GetCallHook(signer),
 		k.GetPrecompilesCallHook(ctx),
 	)
+	if circuitBreaker := cfg.Params.CircuitBreaker; circuitBreaker.IsTripped(ctx.BlockHeight()) {
+		evmHooks.AddCreateHooks(circuitBreaker.GetCreateHook())
+		evmHooks.AddCallHooks(circuitBreaker.GetCallHook())
+	}
+	// the overridden SSTORE gas function implements the EIP-2929 costs
+	if gasOverrides := cfg.Params.GasOverrides; gasOverrides.SstoreSetGas != 0 && ethCfg.IsBerlin(blockCtx.BlockNumber) {
+		if db, ok := stateDB.(types.GasOverridesStateDB); ok {
+			db.SetGasOverrides(gasOverrides)
+			vmConfig.ExtraEips = append(vmConfig.ExtraEips, types.GasOverridesEIP)
+		}
+	}
 	return vm.NewEVMWithHooks(evmHooks, blockCtx, txCtx, stateDB, ethCfg, vmConfig)
 }
 
@@ -122,15 +134,14 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	}
 }
 
-func (k *Keeper) initializeBloomFromLogs(ctx sdk.Context, ethLogs []*ethtypes.Log) (bloom *big.Int, bloomReceipt ethtypes.Bloom) {
-	// Compute block bloom filter
-	if len(ethLogs) > 0 {
-		bloom = k.GetBlockBloomTransient(ctx)
-		bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.CreateBloom(&ethtypes.Receipt{Logs: ethLogs}).Bytes()))
-		bloomReceipt = ethtypes.BytesToBloom(bloom.Bytes())
+// createBloom returns the bloom of the given logs, empty without logs.
+func createBloom(ethLogs []*ethtypes.Log) ethtypes.Bloom {
+	if len(ethLogs) == 0 {
+		return ethtypes.Bloom{}
 	}
 
-	return
+	defer telemetry.MeasureSince(telemetry.Now(), "evm", "bloom", "create")
+	return ethtypes.CreateBloom(&ethtypes.Receipt{Logs: ethLogs})
 }
 
 func calculateCumulativeGasFromEthResponse(meter storetypes.GasMeter, res *types.MsgEthereumTxResponse) uint64 {
@@ -181,8 +192,22 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	// thus restricted to be used only inside `ApplyMessage`.
 	tmpCtx, commitFn := ctx.CacheContext()
 
+	// collect the gas used by opcode family for the telemetry, unless a tracer
+	// is configured to debug the execution
+	var (
+		tracer       *tracing.Hooks
+		opcodeTracer *types.OpcodeGasTracer
+	)
+	if telemetry.IsTelemetryEnabled() && k.tracer == "" {
+		opcodeTracer = types.NewOpcodeGasTracer()
+		tracer = opcodeTracer.Hooks()
+	}
+
 	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, nil, true, cfg, txConfig, false)
+	res, err := k.ApplyMessageWithConfig(tmpCtx, *msg, tracer, true, cfg, txConfig, false)
+	if opcodeTracer != nil {
+		opcodeTracer.EmitTelemetry()
+	}
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -191,7 +216,6 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	ethLogs := types.LogsToEthereum(res.Logs)
-	_, bloomReceipt := k.initializeBloomFromLogs(ctx, ethLogs)
 
 	var contractAddr common.Address
 	if msg.To == nil {
@@ -202,7 +226,7 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		Type:              tx.Type(),
 		PostState:         nil,
 		CumulativeGasUsed: calculateCumulativeGasFromEthResponse(ctx.GasMeter(), res),
-		Bloom:             bloomReceipt,
+		Bloom:             createBloom(ethLogs),
 		Logs:              ethLogs,
 		TxHash:            txConfig.TxHash,
 		ContractAddress:   contractAddr,
@@ -270,22 +294,34 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		}
 	}
 
-	// update logs and bloom for full view if post processing updated them
-	ethLogs = types.LogsToEthereum(res.Logs)
-	bloom, _ := k.initializeBloomFromLogs(ctx, ethLogs)
+	if err := k.settleTransaction(ctx, *msg, res, txConfig, cfg.BaseFee); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// settleTransaction refunds the gas left by the transaction to its sender, and
+// records in the block the gas it used, its base fees, its logs and its index.
+func (k *Keeper) settleTransaction(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse, txConfig statedb.TxConfig, baseFee *big.Int) error {
+	// update logs for full view if post processing updated them
+	ethLogs := types.LogsToEthereum(res.Logs)
 
 	// refund gas to match the Ethereum gas consumption instead of the default SDK one.
 	remainingGas := uint64(0)
 	if msg.GasLimit > res.GasUsed {
 		remainingGas = msg.GasLimit - res.GasUsed
 	}
-	if err = k.RefundGas(ctx, *msg, remainingGas, types.GetEVMCoinDenom()); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
+	if err := k.RefundGas(ctx, msg, remainingGas, types.GetEVMCoinDenom()); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
 	}
 
+	// record the base fee portion of the fees, which is handled by the fee market
+	// according to its base fee policy
+	k.AddTransientBaseFees(ctx, msg, res.GasUsed, baseFee)
+
 	if len(ethLogs) > 0 {
 		// Update transient block bloom filter
-		k.SetBlockBloomTransient(ctx, bloom)
+		k.SetTxBloomTransient(ctx, uint64(txConfig.TxIndex), createBloom(ethLogs))
 		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(ethLogs)))
 	}
 
@@ -293,12 +329,12 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
+		return errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
-	return res, nil
+	return nil
 }
 
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
@@ -408,7 +444,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	stateDB.Prepare(rules, msg.From, common.Address{}, msg.To, evm.ActivePrecompiles(), msg.AccessList)
+	stateDB.Prepare(rules, msg.From, evm.Context.Coinbase, msg.To, evm.ActivePrecompiles(), msg.AccessList)
 
 	convertedValue, err := utils.Uint256FromBigInt(msg.Value)
 	if err != nil {
@@ -475,9 +511,11 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
+		start := telemetry.Now()
 		if err := stateDB.Commit(); err != nil {
 			return nil, errorsmod.Wrap(err, "failed to commit stateDB")
 		}
+		telemetry.MeasureSince(start, "evm", "statedb", "commit")
 	}
 
 	// calculate a minimum amount of gas to be charged to sender if GasLimit
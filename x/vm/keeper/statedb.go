@@ -17,7 +17,10 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-var _ statedb.Keeper = &Keeper{}
+var (
+	_ statedb.Keeper                  = &Keeper{}
+	_ statedb.PrecompileQueriesKeeper = &Keeper{}
+)
 
 // ----------------------------------------------------------------------------
 // StateDB Keeper implementation
@@ -26,7 +26,7 @@ func (k *Keeper) WithStaticPrecompiles(precompiles map[common.Address]vm.Precomp
 
 // GetStaticPrecompileInstance returns the instance of the given static precompile address.
 func (k *Keeper) GetStaticPrecompileInstance(params *types.Params, address common.Address) (vm.PrecompiledContract, bool, error) {
-	if k.IsAvailableStaticPrecompile(params, address) {
+	if k.IsActiveStaticPrecompile(params, address) {
 		precompile, found := k.precompiles[address]
 		// If the precompile is within params but not found in the precompiles map it means we have memory
 		// corruption.
@@ -38,10 +38,18 @@ func (k *Keeper) GetStaticPrecompileInstance(params *types.Params, address commo
 	return nil, false, nil
 }
 
-// IsAvailablePrecompile returns true if the given static precompile address is contained in the
-// EVM keeper's available precompiles map.
+// IsAvailableStaticPrecompile returns true if the given static precompile
+// address is compiled in the binary, i.e. if it can be activated by the
+// parameters.
+func (k Keeper) IsAvailableStaticPrecompile(address common.Address) bool {
+	_, found := k.precompiles[address]
+	return found
+}
+
+// IsActiveStaticPrecompile returns true if the given static precompile address is contained in the
+// active static precompiles of the given parameters.
 // This function assumes that the Berlin precompiles cannot be disabled.
-func (k Keeper) IsAvailableStaticPrecompile(params *types.Params, address common.Address) bool {
+func (k Keeper) IsActiveStaticPrecompile(params *types.Params, address common.Address) bool {
 	return slices.Contains(params.ActiveStaticPrecompiles, address.String()) ||
 		slices.Contains(vm.PrecompiledAddressesPrague, address)
 }
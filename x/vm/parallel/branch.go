@@ -0,0 +1,85 @@
+package parallel
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// seeds maps the stores and paths of the counters to the value read by a
+// transaction in place of the one of the state.
+type seeds map[storetypes.StoreKey]map[string][]byte
+
+// set sets the value read for the counter.
+func (s seeds) set(counter Counter, value []byte) {
+	if s[counter.Key] == nil {
+		s[counter.Key] = make(map[string][]byte)
+	}
+	s[counter.Key][string(counter.Path)] = value
+}
+
+// branch is a cache multistore on top of tracked views of the stores of a
+// multistore, holding the reads and writes of a transaction.
+type branch struct {
+	keys  []storetypes.StoreKey
+	views map[storetypes.StoreKey]*trackedStore
+	cms   storetypes.CacheMultiStore
+}
+
+// newBranch creates a branch of the stores of the given keys of the multistore,
+// reading the values of the seeds in place of the ones of the multistore.
+func newBranch(ms storetypes.MultiStore, keys []storetypes.StoreKey, s seeds) *branch {
+	views := make(map[storetypes.StoreKey]*trackedStore, len(keys))
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	keysByName := make(map[string]storetypes.StoreKey, len(keys))
+	for _, key := range keys {
+		view := newTrackedStore(ms.GetKVStore(key), s[key])
+		views[key] = view
+		stores[key] = view
+		keysByName[key.Name()] = key
+	}
+
+	return &branch{
+		keys:  keys,
+		views: views,
+		cms:   cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, keysByName, nil, nil),
+	}
+}
+
+// write flushes the cache multistore to the tracked views, which buffer the
+// writes.
+func (b *branch) write() {
+	b.cms.Write()
+}
+
+// conflicts returns true if the state read through the branch was modified by
+// the preceding transactions, which wrote the given keys to the multistore.
+func (b *branch) conflicts(ms storetypes.MultiStore, written map[storetypes.StoreKey]map[string]struct{}) bool {
+	for _, key := range b.keys {
+		if b.views[key].conflicts(ms.GetKVStore(key), written[key]) {
+			return true
+		}
+	}
+	return false
+}
+
+// valid returns true if all the values read through the branch are the ones of
+// the multistore, and no store was iterated through.
+func (b *branch) valid(ms storetypes.MultiStore) bool {
+	for _, key := range b.keys {
+		if !b.views[key].valid(ms.GetKVStore(key)) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply writes the buffered writes of the branch to the multistore, recording
+// the written keys if written isn't nil.
+func (b *branch) apply(ms storetypes.MultiStore, written map[storetypes.StoreKey]map[string]struct{}) {
+	for _, key := range b.keys {
+		b.views[key].apply(ms.GetKVStore(key), written[key])
+	}
+}
@@ -0,0 +1,219 @@
+package parallel
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxRunner executes the transaction at the given index of the block on the
+// context and returns its result. The state changes must only be made through
+// the stores of the context, since a transaction can be executed more than
+// once and only its last execution is kept.
+type TxRunner[R any] func(ctx sdk.Context, index int) R
+
+// Counter is a counter of the block, stored as a big endian uint64 at the given
+// path of a store, like the index of the next Ethereum transaction. The
+// transactions read it and increase it by an amount which doesn't depend on its
+// value.
+type Counter struct {
+	Key  storetypes.StoreKey
+	Path []byte
+}
+
+// Stats are the statistics of the execution of a block.
+type Stats struct {
+	// Executed is the number of transactions of the block.
+	Executed int
+	// Reseeded is the number of transactions executed again concurrently
+	// with the values of the counters resulting from the preceding
+	// transactions.
+	Reseeded int
+	// Reexecuted is the number of transactions executed again after a
+	// conflict with a preceding transaction.
+	Reexecuted int
+}
+
+// Executor executes the transactions of a block concurrently, with an
+// optimistic concurrency control producing the same state and results as the
+// serial execution of the transactions.
+//
+// All the transactions are first executed concurrently on the state at the
+// beginning of the block, recording the values they read and buffering the
+// ones they write. Their writes are then applied in the order of the block,
+// after validating that none of the values read by a transaction has been
+// changed by a preceding one, and that none of the ranges it iterated through
+// has been written. A transaction failing the validation is executed again on the
+// state resulting from the preceding transactions, so that its result is the
+// one of the serial execution.
+//
+// Each transaction reading a counter would fail the validation, since the
+// preceding transactions increase it. The increments of the first executions
+// are therefore added up to compute the value of the counters read by each
+// transaction, and the transactions which read another value are executed
+// again concurrently with these values before the validation.
+type Executor[R any] struct {
+	keys     []storetypes.StoreKey
+	counters []Counter
+	workers  int
+}
+
+// NewExecutor creates a new executor for the transactions accessing the stores
+// of the given keys, which must include all the stores used by the
+// transactions, transient stores included. The transactions are executed by
+// the given number of workers, or by one worker per CPU if not positive.
+func NewExecutor[R any](keys []storetypes.StoreKey, workers int) *Executor[R] {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	return &Executor[R]{
+		keys:    keys,
+		workers: workers,
+	}
+}
+
+// WithCounters sets the counters of the block read and increased by the
+// transactions. Their stores must be part of the keys of the executor.
+func (e *Executor[R]) WithCounters(counters ...Counter) *Executor[R] {
+	e.counters = counters
+	return e
+}
+
+// Execute executes n transactions on the multistore of the context and returns
+// their results in order. The state changes of the transactions are written to
+// the multistore of the context.
+//
+// Each transaction is executed with its own event manager and an infinite gas
+// meter, which the runner replaces to enforce the gas limit of the transaction.
+// The other fields of the context, such as the block gas meter, are shared by
+// the concurrent executions and must not be modified by the runner.
+func (e *Executor[R]) Execute(ctx sdk.Context, n int, run TxRunner[R]) ([]R, Stats) {
+	results := make([]R, n)
+	branches := make([]*branch, n)
+	stats := Stats{Executed: n}
+
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	e.executeConcurrently(ctx, all, nil, run, results, branches)
+
+	if len(e.counters) > 0 {
+		txSeeds := e.seedCounters(ctx.MultiStore(), branches)
+		var reseeded []int
+		for i, b := range branches {
+			if b != nil && !e.readSeeds(b, txSeeds[i]) {
+				reseeded = append(reseeded, i)
+			}
+		}
+		e.executeConcurrently(ctx, reseeded, txSeeds, run, results, branches)
+		stats.Reseeded = len(reseeded)
+	}
+
+	written := make(map[storetypes.StoreKey]map[string]struct{}, len(e.keys))
+	for _, key := range e.keys {
+		written[key] = make(map[string]struct{})
+	}
+
+	for i := 0; i < n; i++ {
+		if branches[i] == nil || branches[i].conflicts(ctx.MultiStore(), written) {
+			results[i], branches[i] = e.execute(ctx, i, nil, run)
+			stats.Reexecuted++
+		}
+
+		branches[i].apply(ctx.MultiStore(), written)
+		// release the buffered writes of the applied transaction
+		branches[i] = nil
+	}
+
+	return results, stats
+}
+
+// executeConcurrently executes the transactions of the given indexes
+// concurrently on the state of the context, reading the values of their seeds
+// if any. The executions only read the state, and a panic is handled like a
+// conflict.
+func (e *Executor[R]) executeConcurrently(ctx sdk.Context, indexes []int, txSeeds []seeds, run TxRunner[R], results []R, branches []*branch) {
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(e.workers, len(indexes)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				var s seeds
+				if txSeeds != nil {
+					s = txSeeds[i]
+				}
+				results[i], branches[i] = e.tryExecute(ctx, i, s, run)
+			}
+		}()
+	}
+	for _, i := range indexes {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// tryExecute executes the transaction and returns a nil branch if it panics.
+func (e *Executor[R]) tryExecute(ctx sdk.Context, index int, s seeds, run TxRunner[R]) (result R, b *branch) {
+	defer func() {
+		if r := recover(); r != nil {
+			b = nil
+		}
+	}()
+	return e.execute(ctx, index, s, run)
+}
+
+// execute executes the transaction on a branch of the multistore of the
+// context and returns the branch holding its reads and writes.
+func (e *Executor[R]) execute(ctx sdk.Context, index int, s seeds, run TxRunner[R]) (R, *branch) {
+	b := newBranch(ctx.MultiStore(), e.keys, s)
+	txCtx := ctx.
+		WithMultiStore(b.cms).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	result := run(txCtx, index)
+	b.write()
+
+	return result, b
+}
+
+// seedCounters returns the values of the counters read by each transaction in
+// the serial execution, assuming that the transactions increase them as much
+// as in the given executions.
+func (e *Executor[R]) seedCounters(ms storetypes.MultiStore, branches []*branch) []seeds {
+	values := make([][]byte, len(e.counters))
+	for j, counter := range e.counters {
+		values[j] = ms.GetKVStore(counter.Key).Get(counter.Path)
+	}
+
+	txSeeds := make([]seeds, len(branches))
+	for i, b := range branches {
+		txSeeds[i] = make(seeds)
+		for j, counter := range e.counters {
+			txSeeds[i].set(counter, values[j])
+			if b != nil {
+				values[j] = b.views[counter.Key].increase(counter.Path, values[j])
+			}
+		}
+	}
+	return txSeeds
+}
+
+// readSeeds returns true if the values of the counters read by the
+// transaction are the ones of the seeds.
+func (e *Executor[R]) readSeeds(b *branch, s seeds) bool {
+	for _, counter := range e.counters {
+		value, ok := b.views[counter.Key].reads[string(counter.Path)]
+		if ok && !bytes.Equal(value, s[counter.Key][string(counter.Path)]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,300 @@
+package parallel_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/vm/parallel"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	keyA = storetypes.NewKVStoreKey("a")
+	keyB = storetypes.NewKVStoreKey("b")
+	keyT = storetypes.NewTransientStoreKey("transient_t")
+
+	storeKeys = []storetypes.StoreKey{keyA, keyB, keyT}
+)
+
+// newContext returns a context on a new multistore with the test stores.
+func newContext() sdk.Context {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper)
+	keys := make(map[string]storetypes.StoreKey)
+	for _, key := range storeKeys {
+		stores[key] = dbadapter.Store{DB: dbm.NewMemDB()}
+		keys[key.Name()] = key
+	}
+	ms := cachemulti.NewStore(dbm.NewMemDB(), stores, keys, nil, nil)
+	return sdk.NewContext(ms, cmtproto.Header{}, false, log.NewNopLogger())
+}
+
+// dump returns the content of the test stores.
+func dump(ctx sdk.Context) map[string]string {
+	content := make(map[string]string)
+	for _, key := range storeKeys {
+		it := ctx.MultiStore().GetKVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			content[key.Name()+"/"+string(it.Key())] = string(it.Value())
+		}
+		it.Close()
+	}
+	return content
+}
+
+// tx is a test transaction moving a value between two counters and summing the
+// counters of a range.
+type tx struct {
+	key             storetypes.StoreKey
+	from, to        string
+	rangeStart, end string
+	remove          string
+}
+
+func (tx tx) run(ctx sdk.Context, index int) string {
+	store := ctx.KVStore(tx.key)
+	counter := func(key string) int {
+		var v int
+		if bz := store.Get([]byte(key)); bz != nil {
+			_, _ = fmt.Sscan(string(bz), &v)
+		}
+		return v
+	}
+
+	from := counter(tx.from)
+	if from == 0 {
+		from = 100
+	}
+	store.Set([]byte(tx.from), []byte(fmt.Sprint(from-1)))
+	store.Set([]byte(tx.to), []byte(fmt.Sprint(counter(tx.to)+1)))
+
+	sum := 0
+	it := store.Iterator([]byte(tx.rangeStart), []byte(tx.end))
+	for ; it.Valid(); it.Next() {
+		sum += counter(string(it.Key()))
+	}
+	it.Close()
+
+	if tx.remove != "" && store.Has([]byte(tx.remove)) {
+		store.Delete([]byte(tx.remove))
+	}
+
+	ctx.TransientStore(keyT).Set([]byte(fmt.Sprintf("tx/%d", index)), []byte(fmt.Sprint(sum)))
+	ctx.EventManager().EmitEvent(sdk.NewEvent("tx", sdk.NewAttribute("sum", fmt.Sprint(sum))))
+	return fmt.Sprintf("%d:%d:%d", index, sum, len(ctx.EventManager().Events()))
+}
+
+// randomTxs returns transactions on a key space of the given size, which
+// drives the rate of conflicts.
+func randomTxs(r *rand.Rand, n, keySpace int) []tx {
+	txs := make([]tx, n)
+	for i := range txs {
+		key := keyA
+		if r.Intn(2) == 0 {
+			key = keyB
+		}
+		k := func() string { return fmt.Sprintf("k%03d", r.Intn(keySpace)) }
+		txs[i] = tx{key: key, from: k(), to: k(), rangeStart: k(), end: k()}
+		if r.Intn(4) == 0 {
+			txs[i].remove = k()
+		}
+	}
+	return txs
+}
+
+// executeSerially executes the transactions one after the other.
+func executeSerially(ctx sdk.Context, txs []tx) []string {
+	results := make([]string, len(txs))
+	for i, tx := range txs {
+		results[i] = tx.run(ctx.WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter()), i)
+	}
+	return results
+}
+
+func TestExecuteMatchesSerialExecution(t *testing.T) {
+	testCases := []struct {
+		name     string
+		keySpace int
+	}{
+		{"high contention", 4},
+		{"medium contention", 64},
+		{"low contention", 10000},
+	}
+
+	for _, tc := range testCases {
+		for _, workers := range []int{1, 4, 16} {
+			t.Run(fmt.Sprintf("%s/%d workers", tc.name, workers), func(t *testing.T) {
+				r := rand.New(rand.NewSource(int64(tc.keySpace)))
+				txs := randomTxs(r, 200, tc.keySpace)
+
+				serialCtx := newContext()
+				expResults := executeSerially(serialCtx, txs)
+
+				ctx := newContext()
+				executor := parallel.NewExecutor[string](storeKeys, workers)
+				results, stats := executor.Execute(ctx, len(txs), func(ctx sdk.Context, i int) string {
+					return txs[i].run(ctx, i)
+				})
+
+				require.Equal(t, expResults, results)
+				require.Equal(t, dump(serialCtx), dump(ctx))
+				require.Equal(t, len(txs), stats.Executed)
+				require.LessOrEqual(t, stats.Reexecuted, len(txs))
+			})
+		}
+	}
+}
+
+func TestExecuteReexecutesConflicts(t *testing.T) {
+	testCases := []struct {
+		name          string
+		txs           []tx
+		expReexecuted int
+	}{
+		{
+			"independent transactions",
+			[]tx{
+				{key: keyA, from: "a", to: "b", rangeStart: "a", end: "c"},
+				{key: keyA, from: "c", to: "d", rangeStart: "c", end: "e"},
+				{key: keyB, from: "a", to: "b", rangeStart: "a", end: "c"},
+			},
+			0,
+		},
+		{
+			"read after write",
+			[]tx{
+				{key: keyA, from: "a", to: "b", rangeStart: "x", end: "y"},
+				{key: keyA, from: "b", to: "c", rangeStart: "x", end: "y"},
+			},
+			1,
+		},
+		{
+			"iterated range written",
+			[]tx{
+				{key: keyA, from: "a", to: "b", rangeStart: "x", end: "y"},
+				{key: keyA, from: "c", to: "d", rangeStart: "b", end: "c"},
+			},
+			1,
+		},
+		{
+			"write outside of the iterated range",
+			[]tx{
+				{key: keyA, from: "a", to: "c", rangeStart: "x", end: "y"},
+				{key: keyA, from: "e", to: "f", rangeStart: "d", end: "e"},
+			},
+			0,
+		},
+		{
+			"same key in another store",
+			[]tx{
+				{key: keyA, from: "a", to: "b", rangeStart: "x", end: "y"},
+				{key: keyB, from: "a", to: "b", rangeStart: "x", end: "y"},
+			},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serialCtx := newContext()
+			expResults := executeSerially(serialCtx, tc.txs)
+
+			ctx := newContext()
+			executor := parallel.NewExecutor[string](storeKeys, 4)
+			results, stats := executor.Execute(ctx, len(tc.txs), func(ctx sdk.Context, i int) string {
+				return tc.txs[i].run(ctx, i)
+			})
+
+			require.Equal(t, expResults, results)
+			require.Equal(t, dump(serialCtx), dump(ctx))
+			require.Equal(t, tc.expReexecuted, stats.Reexecuted)
+		})
+	}
+}
+
+func TestExecuteReexecutesPanics(t *testing.T) {
+	ctx := newContext()
+	executor := parallel.NewExecutor[string](storeKeys, 4)
+
+	// the second transaction panics when executed before the first one
+	results, stats := executor.Execute(ctx, 2, func(ctx sdk.Context, i int) string {
+		store := ctx.KVStore(keyA)
+		if i == 0 {
+			store.Set([]byte("key"), []byte("value"))
+			return ""
+		}
+		value := store.Get([]byte("key"))
+		if value == nil {
+			panic("key not found")
+		}
+		return string(value)
+	})
+
+	require.Equal(t, []string{"", "value"}, results)
+	require.Equal(t, 1, stats.Reexecuted)
+}
+
+func TestExecuteIgnoresUnchangedValues(t *testing.T) {
+	ctx := newContext()
+	ctx.KVStore(keyA).Set([]byte("key"), []byte("value"))
+	executor := parallel.NewExecutor[string](storeKeys, 4)
+
+	// the first transaction writes the key back to its initial value, like a
+	// balance going through a module account during a transfer
+	results, stats := executor.Execute(ctx, 2, func(ctx sdk.Context, i int) string {
+		store := ctx.KVStore(keyA)
+		if i == 0 {
+			store.Set([]byte("key"), []byte("other"))
+			store.Set([]byte("key"), []byte("value"))
+			return ""
+		}
+		return string(store.Get([]byte("key")))
+	})
+
+	require.Equal(t, []string{"", "value"}, results)
+	require.Zero(t, stats.Reexecuted)
+}
+
+func TestExecuteSeedsCounters(t *testing.T) {
+	counter := parallel.Counter{Key: keyT, Path: []byte("counter")}
+
+	// each transaction reads the counter to index its record and increases it,
+	// by two for the odd transactions
+	run := func(ctx sdk.Context, i int) string {
+		store := ctx.TransientStore(keyT)
+		var value uint64
+		if bz := store.Get(counter.Path); bz != nil {
+			value = binary.BigEndian.Uint64(bz)
+		}
+		store.Set(counter.Path, binary.BigEndian.AppendUint64(nil, value+1+uint64(i%2))) //nolint:gosec // G115 // small test indexes
+		ctx.KVStore(keyA).Set([]byte(fmt.Sprintf("record/%d", value)), []byte(fmt.Sprint(i)))
+		return fmt.Sprintf("%d:%d", i, value)
+	}
+
+	const n = 50
+	serialCtx := newContext()
+	expResults := make([]string, n)
+	for i := range expResults {
+		expResults[i] = run(serialCtx, i)
+	}
+
+	ctx := newContext()
+	executor := parallel.NewExecutor[string](storeKeys, 4).WithCounters(counter)
+	results, stats := executor.Execute(ctx, n, run)
+
+	require.Equal(t, expResults, results)
+	require.Equal(t, dump(serialCtx), dump(ctx))
+	require.Equal(t, n-1, stats.Reseeded)
+	require.Zero(t, stats.Reexecuted)
+}
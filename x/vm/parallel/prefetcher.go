@@ -0,0 +1,267 @@
+package parallel
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRouter returns the handlers of the messages, like the message service
+// router of the application.
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
+
+// PrefetcherOptions are the handlers of the application, used to execute the
+// transactions the same way as the serial execution of the block.
+type PrefetcherOptions struct {
+	TxDecoder   sdk.TxDecoder
+	AnteHandler sdk.AnteHandler
+	PostHandler sdk.PostHandler
+	Router      MsgRouter
+}
+
+// PrefetchStats are the statistics of the prefetching of a block.
+type PrefetchStats struct {
+	Stats
+	// Prefetched is the number of Ethereum messages prefetched.
+	Prefetched int
+	// Reused is the number of prefetched Ethereum messages whose result was
+	// reused by the serial execution of the block.
+	Reused int
+}
+
+// prefetchedMsg is the result of the execution of an Ethereum message, along
+// with the state it read and wrote and the inputs it depends on.
+type prefetchedMsg struct {
+	hash      common.Hash
+	txBytes   []byte
+	branch    *branch
+	response  *evmtypes.MsgEthereumTxResponse
+	events    sdk.Events
+	gasLimit  uint64
+	gasBefore uint64
+	gasAfter  uint64
+}
+
+// Prefetcher executes the transactions of a block concurrently at the
+// beginning of the block with an executor, so that the serial execution of the
+// block reuses the results of the Ethereum messages instead of executing them
+// again.
+//
+// The result of an Ethereum message is only reused if the message is executed
+// by the same transaction, with the same gas limit and gas consumed, and if the
+// values it read are unchanged, so that it has the same state changes, events
+// and response as its serial execution. A message iterating through a store, or
+// part of an atomic batch, is always executed again.
+//
+// The transactions paying fees all update the balance of the fee collector and
+// the base fees of the block, so they conflict and are executed again in order
+// by the executor. Their results are then still reused, but the prefetching
+// doesn't save their execution time.
+type Prefetcher struct {
+	executor *Executor[[]*prefetchedMsg]
+	keys     []storetypes.StoreKey
+	options  PrefetcherOptions
+
+	mtx        sync.Mutex
+	txs        [][]byte
+	prefetched map[common.Hash]*prefetchedMsg
+	stats      PrefetchStats
+}
+
+// NewPrefetcher creates a new prefetcher executing the transactions with the
+// given number of workers, on the stores of the given keys and with the given
+// counters of the block.
+func NewPrefetcher(keys []storetypes.StoreKey, counters []Counter, workers int, options PrefetcherOptions) *Prefetcher {
+	return &Prefetcher{
+		executor: NewExecutor[[]*prefetchedMsg](keys, workers).WithCounters(counters...),
+		keys:     keys,
+		options:  options,
+	}
+}
+
+// SetBlock sets the transactions of the block to prefetch, dropping the
+// results of the previous block.
+func (p *Prefetcher) SetBlock(txs [][]byte) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.txs = txs
+	p.prefetched = nil
+	p.stats = PrefetchStats{}
+}
+
+// Prefetch executes the transactions of the block concurrently on a branch of
+// the multistore of the context, which is discarded.
+func (p *Prefetcher) Prefetch(ctx sdk.Context) {
+	// no result is set while the messages are prefetched, so that their
+	// executions don't reuse any
+	p.mtx.Lock()
+	txs := p.txs
+	p.prefetched = nil
+	p.mtx.Unlock()
+
+	branchCtx := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+	results, stats := p.executor.Execute(branchCtx, len(txs), func(ctx sdk.Context, index int) []*prefetchedMsg {
+		return p.execute(ctx, txs[index])
+	})
+
+	prefetched := make(map[common.Hash]*prefetchedMsg)
+	for _, msgs := range results {
+		for _, msg := range msgs {
+			if _, ok := prefetched[msg.hash]; !ok {
+				prefetched[msg.hash] = msg
+			}
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.prefetched = prefetched
+	p.stats = PrefetchStats{Stats: stats, Prefetched: len(prefetched)}
+}
+
+// Apply applies the prefetched result of the Ethereum message to the context
+// and returns its response, or false if there is no result valid on the state
+// of the context. A result is only applied once, when finalizing the block.
+func (p *Prefetcher) Apply(ctx sdk.Context, msg *evmtypes.MsgEthereumTx) (*evmtypes.MsgEthereumTxResponse, bool) {
+	if p == nil || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil, false
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	hash := msg.Hash()
+	prefetched, ok := p.prefetched[hash]
+	if !ok {
+		return nil, false
+	}
+	delete(p.prefetched, hash)
+
+	gasMeter := ctx.GasMeter()
+	if !bytes.Equal(ctx.TxBytes(), prefetched.txBytes) ||
+		gasMeter.Limit() != prefetched.gasLimit ||
+		gasMeter.GasConsumed() != prefetched.gasBefore ||
+		!prefetched.branch.valid(ctx.MultiStore()) {
+		return nil, false
+	}
+
+	prefetched.branch.apply(ctx.MultiStore(), nil)
+	// the gas meter is reset by the execution of the message, so it may end
+	// below the gas consumed before it
+	if prefetched.gasAfter >= prefetched.gasBefore {
+		gasMeter.ConsumeGas(prefetched.gasAfter-prefetched.gasBefore, "prefetched ethereum tx")
+	} else {
+		gasMeter.RefundGas(prefetched.gasBefore-prefetched.gasAfter, "prefetched ethereum tx")
+	}
+	ctx.EventManager().EmitEvents(prefetched.events)
+	p.stats.Reused++
+
+	return prefetched.response, true
+}
+
+// Stats returns the statistics of the prefetching of the current block.
+func (p *Prefetcher) Stats() PrefetchStats {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return p.stats
+}
+
+// execute executes the transaction like the serial execution of the block:
+// the ante handler, then the messages and the post handler on a branch written
+// if all of them succeed. It returns the results of the Ethereum messages, or
+// nil if the transaction fails.
+func (p *Prefetcher) execute(ctx sdk.Context, txBytes []byte) []*prefetchedMsg {
+	tx, err := p.options.TxDecoder(txBytes)
+	if err != nil {
+		return nil
+	}
+	ctx = ctx.WithTxBytes(txBytes)
+
+	if p.options.AnteHandler != nil {
+		anteCtx, anteCache := cacheContext(ctx)
+		newCtx, err := p.options.AnteHandler(anteCtx.WithEventManager(sdk.NewEventManager()), tx, false)
+		if err != nil {
+			return nil
+		}
+		ctx = newCtx.WithMultiStore(ctx.MultiStore())
+		anteCache.Write()
+	}
+
+	msgCtx, msgCache := cacheContext(ctx)
+	var msgs []*prefetchedMsg
+	for _, msg := range tx.GetMsgs() {
+		handler := p.options.Router.Handler(msg)
+		if handler == nil {
+			return nil
+		}
+
+		if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			prefetched := p.executeEthereumMsg(msgCtx, txBytes, handler, ethMsg)
+			if prefetched == nil {
+				return nil
+			}
+			msgs = append(msgs, prefetched)
+		} else if _, err := handler(msgCtx, msg); err != nil {
+			return nil
+		}
+	}
+
+	if p.options.PostHandler != nil {
+		if _, err := p.options.PostHandler(msgCtx.WithEventManager(sdk.NewEventManager()), tx, false, true); err != nil {
+			return nil
+		}
+	}
+	msgCache.Write()
+
+	return msgs
+}
+
+// executeEthereumMsg executes the Ethereum message on a tracked branch of the
+// context, recording the state it read and wrote, then writes the branch to the
+// context. It returns nil if the message fails.
+func (p *Prefetcher) executeEthereumMsg(ctx sdk.Context, txBytes []byte, handler baseapp.MsgServiceHandler, msg *evmtypes.MsgEthereumTx) *prefetchedMsg {
+	b := newBranch(ctx.MultiStore(), p.keys, nil)
+	prefetched := &prefetchedMsg{
+		hash:      msg.Hash(),
+		txBytes:   txBytes,
+		branch:    b,
+		gasLimit:  ctx.GasMeter().Limit(),
+		gasBefore: ctx.GasMeter().GasConsumed(),
+	}
+
+	res, err := handler(ctx.WithMultiStore(b.cms), msg)
+	if err != nil || len(res.MsgResponses) != 1 {
+		return nil
+	}
+	prefetched.gasAfter = ctx.GasMeter().GasConsumed()
+
+	prefetched.response = new(evmtypes.MsgEthereumTxResponse)
+	if err := prefetched.response.Unmarshal(res.MsgResponses[0].Value); err != nil {
+		return nil
+	}
+	for _, event := range res.Events {
+		prefetched.events = append(prefetched.events, sdk.Event(event))
+	}
+
+	b.write()
+	b.apply(ctx.MultiStore(), nil)
+
+	return prefetched
+}
+
+// cacheContext returns a context on a cache of the multistore of the context.
+func cacheContext(ctx sdk.Context) (sdk.Context, storetypes.CacheMultiStore) {
+	ms := ctx.MultiStore().CacheMultiStore()
+	return ctx.WithMultiStore(ms), ms
+}
@@ -0,0 +1,210 @@
+package parallel
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// keyRange is a range of keys read through an iterator, with a nil end
+// meaning no upper bound.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains returns true if the key is part of the range.
+func (r keyRange) contains(key []byte) bool {
+	if r.start != nil && bytes.Compare(key, r.start) < 0 {
+		return false
+	}
+	return r.end == nil || bytes.Compare(key, r.end) < 0
+}
+
+// trackedStore is a KVStore recording the values and ranges read from its
+// parent, and buffering the writes instead of applying them to the parent.
+//
+// It is wrapped by a cache store during the execution of a transaction, so it
+// only sees the reads missing the cache and the writes flushed at the end of
+// the execution. It isn't safe for concurrent use.
+type trackedStore struct {
+	parent storetypes.KVStore
+
+	// seeds maps the keys of the counters to the value read in place of the
+	// one of the parent
+	seeds map[string][]byte
+	// reads maps the read keys to the value read, nil if not found
+	reads  map[string][]byte
+	ranges []keyRange
+	// writes maps the written keys to their value, nil for a deletion
+	writes map[string][]byte
+}
+
+var _ storetypes.KVStore = (*trackedStore)(nil)
+
+func newTrackedStore(parent storetypes.KVStore, seeds map[string][]byte) *trackedStore {
+	return &trackedStore{
+		parent: parent,
+		seeds:  seeds,
+		reads:  make(map[string][]byte),
+		writes: make(map[string][]byte),
+	}
+}
+
+// GetStoreType implements the KVStore interface.
+func (s *trackedStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface.
+func (s *trackedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *trackedStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// Get implements the KVStore interface.
+func (s *trackedStore) Get(key []byte) []byte {
+	if value, ok := s.writes[string(key)]; ok {
+		return value
+	}
+	value, ok := s.seeds[string(key)]
+	if !ok {
+		value = s.parent.Get(key)
+	}
+	s.reads[string(key)] = value
+	return value
+}
+
+// Has implements the KVStore interface. The value is read to be validated like
+// the ones read by Get.
+func (s *trackedStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements the KVStore interface.
+func (s *trackedStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	s.writes[string(key)] = value
+}
+
+// Delete implements the KVStore interface.
+func (s *trackedStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	s.writes[string(key)] = nil
+}
+
+// Iterator implements the KVStore interface. The writes are flushed once at the
+// end of the execution, so there are no pending writes to merge while the
+// transaction iterates through the cache store.
+func (s *trackedStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *trackedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.ReverseIterator(start, end)
+}
+
+// conflicts returns true if a value read by this transaction was changed by
+// the preceding transactions, which wrote the given keys to the store, or if
+// one of these keys is in a range it iterated through.
+func (s *trackedStore) conflicts(store storetypes.KVStore, written map[string]struct{}) bool {
+	for key, value := range s.reads {
+		if _, ok := written[key]; ok && !bytes.Equal(store.Get([]byte(key)), value) {
+			return true
+		}
+	}
+
+	if len(s.ranges) == 0 {
+		return false
+	}
+	for key := range written {
+		for _, r := range s.ranges {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// valid returns true if the values read by this transaction are the ones of the
+// store and it didn't iterate through it.
+func (s *trackedStore) valid(store storetypes.KVStore) bool {
+	if len(s.ranges) > 0 {
+		return false
+	}
+	for key, value := range s.reads {
+		if !bytes.Equal(store.Get([]byte(key)), value) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply writes the buffered writes to the store, in the order of the keys,
+// and records the written keys if written isn't nil.
+func (s *trackedStore) apply(store storetypes.KVStore, written map[string]struct{}) {
+	keys := make([]string, 0, len(s.writes))
+	for key := range s.writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := s.writes[key]; value != nil {
+			store.Set([]byte(key), value)
+		} else {
+			store.Delete([]byte(key))
+		}
+		if written != nil {
+			written[key] = struct{}{}
+		}
+	}
+}
+
+// increase returns the value of the counter at the given path once increased
+// from the given value as much as this transaction increased it, or the value
+// it wrote if it didn't read the counter.
+func (s *trackedStore) increase(path, value []byte) []byte {
+	written, ok := s.writes[string(path)]
+	if !ok {
+		return value
+	}
+	read, ok := s.reads[string(path)]
+	if !ok {
+		return written
+	}
+
+	from, okFrom := decodeCounter(read)
+	to, okTo := decodeCounter(written)
+	current, okCurrent := decodeCounter(value)
+	if !okFrom || !okTo || !okCurrent {
+		return written
+	}
+	return binary.BigEndian.AppendUint64(nil, current+to-from)
+}
+
+// decodeCounter decodes a big endian uint64 counter, a missing value being
+// zero.
+func decodeCounter(value []byte) (uint64, bool) {
+	switch len(value) {
+	case 0:
+		return 0, true
+	case 8:
+		return binary.BigEndian.Uint64(value), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// RandomAccounts generates n random accounts with eth_secp256k1 keys, so that
+// their addresses match the senders of the Ethereum transactions they sign. It
+// replaces simtypes.RandomAccounts in the simulations of Cosmos EVM chains.
+func RandomAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accs := make([]simtypes.Account, n)
+	idx := make(map[string]struct{}, n)
+	var i int
+	for i < n {
+		privKeyBz := make([]byte, ethsecp256k1.PrivKeySize)
+		if _, err := r.Read(privKeyBz); err != nil {
+			panic(err)
+		}
+		// skip the rare keys outside of the secp256k1 curve order
+		if _, err := crypto.ToECDSA(privKeyBz); err != nil {
+			continue
+		}
+
+		privKey := &ethsecp256k1.PrivKey{Key: privKeyBz}
+		pubKey := privKey.PubKey()
+		addr := sdk.AccAddress(pubKey.Address())
+		if _, exists := idx[string(addr.Bytes())]; exists {
+			continue
+		}
+		idx[string(addr.Bytes())] = struct{}{}
+		accs[i] = simtypes.Account{
+			Address:       addr,
+			PrivKey:       privKey,
+			PubKey:        pubKey,
+			ConsKey:       ed25519.GenPrivKeyFromSecret(privKeyBz),
+			AddressBech32: addr.String(),
+		}
+		i++
+	}
+
+	return accs
+}
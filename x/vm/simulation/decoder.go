@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding evm type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixCode):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixStorage),
+			bytes.Equal(kvA.Key[:1], types.KeyPrefixCodeHash):
+			return fmt.Sprintf("%s\n%s", common.BytesToHash(kvA.Value), common.BytesToHash(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixParams):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		default:
+			panic(fmt.Sprintf("invalid evm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
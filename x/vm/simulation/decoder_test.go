@@ -0,0 +1,53 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/simulation"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestDecodeStore(t *testing.T) {
+	dec := simulation.NewDecodeStore(types.ModuleCdc)
+
+	code := []byte{0x60, 0x80}
+	hash := common.HexToHash("0x01")
+	params := types.DefaultParams()
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KeyPrefixCode, Value: code},
+			{Key: types.KeyPrefixStorage, Value: hash.Bytes()},
+			{Key: types.KeyPrefixCodeHash, Value: hash.Bytes()},
+			{Key: types.KeyPrefixParams, Value: types.ModuleCdc.MustMarshal(&params)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Code", fmt.Sprintf("%X\n%X", code, code)},
+		{"Storage", fmt.Sprintf("%s\n%s", hash, hash)},
+		{"CodeHash", fmt.Sprintf("%s\n%s", hash, hash)},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Simulation parameter constants
+const (
+	HistoryServeWindow = "history_serve_window"
+)
+
+// GenHistoryServeWindow randomized HistoryServeWindow
+func GenHistoryServeWindow(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(types.DefaultHistoryServeWindow) + 1) // #nosec G115 -- positive value
+}
+
+// RandomizedGenState generates a random GenesisState for the evm module. It
+// starts from the genesis state of the application, which holds the chain
+// specific configuration such as the EVM denomination and the preinstalled
+// contracts, and only randomizes the parameters that don't depend on it.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesisState()
+	if bz, ok := simState.GenState[types.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, genesis)
+	}
+
+	var historyServeWindow uint64
+	simState.AppParams.GetOrGenerate(
+		HistoryServeWindow, &historyServeWindow, simState.Rand,
+		func(r *rand.Rand) { historyServeWindow = GenHistoryServeWindow(r) },
+	)
+	genesis.Params.HistoryServeWindow = historyServeWindow
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
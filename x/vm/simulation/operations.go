@@ -0,0 +1,164 @@
+package simulation
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgEthSimpleTransfer = "op_weight_msg_eth_simple_transfer"
+	OpWeightMsgEthCreateContract = "op_weight_msg_eth_create_contract"
+
+	DefaultWeightMsgEthSimpleTransfer = 100
+	DefaultWeightMsgEthCreateContract = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, txConfig client.TxConfig) simulation.WeightedOperations {
+	var weightMsgEthSimpleTransfer, weightMsgEthCreateContract int
+	appParams.GetOrGenerate(OpWeightMsgEthSimpleTransfer, &weightMsgEthSimpleTransfer, nil, func(_ *rand.Rand) {
+		weightMsgEthSimpleTransfer = DefaultWeightMsgEthSimpleTransfer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgEthCreateContract, &weightMsgEthCreateContract, nil, func(_ *rand.Rand) {
+		weightMsgEthCreateContract = DefaultWeightMsgEthCreateContract
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEthSimpleTransfer,
+			SimulateEthSimpleTransfer(txConfig),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgEthCreateContract,
+			SimulateEthCreateContract(txConfig),
+		),
+	}
+}
+
+// SimulateEthSimpleTransfer simulates an Ethereum transaction transferring a
+// random amount of the EVM denomination between two random accounts.
+func SimulateEthSimpleTransfer(txConfig client.TxConfig) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgEthereumTx{})
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		recipient := common.BytesToAddress(to.Address)
+
+		balance, _, err := queryAccount(ctx, newQueryClient(app, ctx), from)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query account"), nil, err
+		}
+
+		// transfer at most half of the balance, leaving enough for the fees
+		maxAmount := sdkmath.NewIntFromBigInt(balance).QuoRaw(2)
+		if !maxAmount.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "insufficient funds"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, maxAmount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+
+		msg, reason, err := DeliverEthTx(app, ctx, txConfig, from, &recipient, amount.BigInt(), nil)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+		if msg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateEthCreateContract simulates an Ethereum transaction deploying an
+// ERC20 contract from a random account. The contract is then called by
+// SimulateEthCallContract in a future operation.
+func SimulateEthCreateContract(txConfig client.TxConfig) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgEthereumTx{})
+		from, _ := simtypes.RandomAcc(r, accs)
+
+		contract := contracts.ERC20MinterBurnerDecimalsContract
+		symbol := simtypes.RandStringOfLength(r, 4)
+		ctorArgs, err := contract.ABI.Pack("", fmt.Sprintf("Simulation %s", symbol), symbol, uint8(r.Intn(19))) // #nosec G115 -- lower than 19
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack constructor arguments"), nil, err
+		}
+
+		_, nonce, err := queryAccount(ctx, newQueryClient(app, ctx), from)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to query account"), nil, err
+		}
+
+		msg, reason, err := DeliverEthTx(app, ctx, txConfig, from, nil, nil, append(append([]byte{}, contract.Bin...), ctorArgs...))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+		if msg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		contractAddr := crypto.CreateAddress(common.BytesToAddress(from.Address), nonce)
+		futureOps := []simtypes.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + 1,
+				Op:          SimulateEthCallContract(txConfig, from, contractAddr),
+			},
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), futureOps, nil
+	}
+}
+
+// SimulateEthCallContract simulates an Ethereum transaction calling an ERC20
+// contract previously deployed by the given account, to mint a random amount of
+// tokens to a random account.
+func SimulateEthCallContract(txConfig client.TxConfig, deployer simtypes.Account, contractAddr common.Address) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgEthereumTx{})
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		amount := big.NewInt(r.Int63n(1e18) + 1)
+		input, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("mint", common.BytesToAddress(to.Address), amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to pack mint arguments"), nil, err
+		}
+
+		msg, reason, err := DeliverEthTx(app, ctx, txConfig, deployer, &contractAddr, nil, input)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+		if msg == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, reason), nil, nil
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"math/rand"
+	"slices"
+
+	"github.com/cosmos/evm/x/vm/keeper"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams int = 100
+
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k *keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams. It only randomizes
+// the parameters that don't depend on the chain configuration, and randomly
+// enables or disables the available static precompiles.
+func SimulateMsgUpdateParams(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		params := k.GetParams(ctx)
+		params.HistoryServeWindow = GenHistoryServeWindow(r)
+
+		params.ActiveStaticPrecompiles = nil
+		for _, precompile := range types.AvailableStaticPrecompiles {
+			if r.Intn(2) == 0 {
+				params.ActiveStaticPrecompiles = append(params.ActiveStaticPrecompiles, precompile)
+			}
+		}
+		slices.Sort(params.ActiveStaticPrecompiles)
+
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package simulation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// gasCap bounds the gas estimated for the simulated Ethereum transactions.
+	gasCap = 25_000_000
+	// cosmosTxGas is the gas limit of the simulated Cosmos transactions.
+	cosmosTxGas = 1_000_000
+)
+
+// DeliverEthTx signs an Ethereum transaction sent by the given account with the
+// provided recipient, value and data, and delivers it in the block being
+// simulated. The gas limit is estimated and the gas price set to the current
+// base fee. If the transaction can't be sent, e.g. because the account can't
+// afford it, it returns the reason and no error.
+//
+// CONTRACT: the account must have been generated by RandomAccounts.
+func DeliverEthTx(
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txConfig client.TxConfig,
+	from simtypes.Account,
+	to *common.Address,
+	value *big.Int,
+	data []byte,
+) (*types.MsgEthereumTx, string, error) {
+	privKey, ok := from.PrivKey.(*ethsecp256k1.PrivKey)
+	if !ok {
+		return nil, "", fmt.Errorf("invalid private key type %T, expected %T", from.PrivKey, &ethsecp256k1.PrivKey{})
+	}
+
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	queryClient := newQueryClient(app, ctx)
+	balance, nonce, err := queryAccount(ctx, queryClient, from)
+	if err != nil {
+		return nil, "", err
+	}
+
+	baseFee := big.NewInt(0)
+	baseFeeRes, err := queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	if err != nil {
+		return nil, "", err
+	}
+	if baseFeeRes.BaseFee != nil {
+		baseFee = baseFeeRes.BaseFee.BigInt()
+	}
+
+	sender := common.BytesToAddress(from.Address)
+	chainID := types.GetEthChainConfig().ChainID
+	args, err := json.Marshal(&types.TransactionArgs{
+		From:  &sender,
+		To:    to,
+		Value: (*hexutil.Big)(value),
+		Data:  (*hexutil.Bytes)(&data),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the estimation fails if the transaction reverts or the value exceeds the
+	// balance of the account
+	estimate, err := queryClient.EstimateGas(ctx, &types.EthCallRequest{
+		Args:            args,
+		GasCap:          gasCap,
+		ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		ChainId:         chainID.Int64(),
+	})
+	if err != nil {
+		return nil, fmt.Sprintf("unable to estimate gas: %s", err), nil
+	}
+
+	cost := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(estimate.Gas))
+	if cost.Add(cost, value).Cmp(balance) > 0 {
+		return nil, "insufficient funds", nil
+	}
+
+	key, err := privKey.ToECDSA()
+	if err != nil {
+		return nil, "", err
+	}
+
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	ethTx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: baseFee,
+		Gas:       estimate.Gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	msg := &types.MsgEthereumTx{}
+	if err := msg.FromSignedEthereumTx(ethTx, signer); err != nil {
+		return nil, "", err
+	}
+
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), types.GetEVMCoinDenom())
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, res, err := app.SimDeliver(txConfig.TxEncoder(), tx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txRes, err := types.DecodeTxResponse(res.Data)
+	if err != nil {
+		return nil, "", err
+	}
+	if txRes.Failed() {
+		return nil, "", errors.New(txRes.VmError)
+	}
+
+	return msg, "", nil
+}
+
+// DeliverCosmosTx signs a Cosmos transaction with the given messages sent by the
+// given account, and delivers it in the block being simulated. The fees are set
+// to the current base fee, paid in the EVM denomination. If the account can't afford
+// the fees, it returns the reason and no error.
+//
+// CONTRACT: the account must have been generated by RandomAccounts.
+func DeliverCosmosTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txConfig client.TxConfig,
+	from simtypes.Account,
+	msgs ...sdk.Msg,
+) (string, error) {
+	queryClient := newQueryClient(app, ctx)
+	balance, _, err := queryAccount(ctx, queryClient, from)
+	if err != nil {
+		return "", err
+	}
+
+	baseFeeRes, err := queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	if err != nil {
+		return "", err
+	}
+	// the base fee and the balance are both expressed with 18 decimals
+	fee := sdkmath.ZeroInt()
+	if baseFeeRes.BaseFee != nil {
+		fee = baseFeeRes.BaseFee.MulRaw(cosmosTxGas)
+	}
+	if fee.BigInt().Cmp(balance) > 0 {
+		return "insufficient funds", nil
+	}
+	feeAmount := types.ConvertBigIntFrom18DecimalsToLegacyDec(fee.BigInt()).Ceil().TruncateInt()
+
+	accRes, err := authtypes.NewQueryClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: app.GRPCQueryRouter(),
+		Ctx:             ctx,
+	}).AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: from.Address.String()})
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txConfig,
+		msgs,
+		sdk.NewCoins(sdk.NewCoin(types.GetEVMCoinDenom(), feeAmount)),
+		cosmosTxGas,
+		ctx.ChainID(),
+		[]uint64{accRes.Info.AccountNumber},
+		[]uint64{accRes.Info.Sequence},
+		from.PrivKey,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if _, _, err := app.SimDeliver(txConfig.TxEncoder(), tx); err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
+
+// newQueryClient returns a client of the EVM queries on the state of the block
+// being simulated.
+func newQueryClient(app *baseapp.BaseApp, ctx sdk.Context) types.QueryClient {
+	return types.NewQueryClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: app.GRPCQueryRouter(),
+		Ctx:             ctx,
+	})
+}
+
+// queryAccount returns the balance of the EVM denomination and the nonce of the
+// given account.
+func queryAccount(ctx sdk.Context, queryClient types.QueryClient, acc simtypes.Account) (*big.Int, uint64, error) {
+	res, err := queryClient.Account(ctx, &types.QueryAccountRequest{
+		Address: common.BytesToAddress(acc.Address).Hex(),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	balance, ok := new(big.Int).SetString(res.Balance, 10)
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid balance %s", res.Balance)
+	}
+
+	return balance, res.Nonce, nil
+}
@@ -0,0 +1,258 @@
+package statedb
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPrecompileQueries is the maximum number of read-only precompile call
+// results memoized in a block.
+const MaxPrecompileQueries = 4096
+
+// PrecompileQueriesKeeper is implemented by the keepers memoizing the results of
+// the read-only precompile calls of the block being finalized.
+type PrecompileQueriesKeeper interface {
+	PrecompileQueries() *PrecompileQueries
+}
+
+// precompileQuery is the memoized result of a read-only precompile call.
+type precompileQuery struct {
+	// reads maps the stores and keys read by the call to the value read, nil
+	// if not found. The result is stale once any of them is written.
+	reads  map[storetypes.StoreKey]map[string][]byte
+	result []byte
+	gas    uint64
+}
+
+// valid returns true if the values read by the call are the ones of the
+// multistore.
+func (q precompileQuery) valid(ms storetypes.MultiStore) bool {
+	for key, reads := range q.reads {
+		store := ms.GetKVStore(key)
+		for k, value := range reads {
+			if !bytes.Equal(store.Get([]byte(k)), value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// PrecompileQueries memoizes the results of the read-only precompile calls of a
+// block, along with the values they read from the stores. A result is reused
+// by the following transactions of the block as long as these values are
+// unchanged, so any write to the keys it read invalidates it.
+//
+// It is safe for concurrent use.
+type PrecompileQueries struct {
+	mtx     sync.Mutex
+	height  int64
+	queries map[string]precompileQuery
+}
+
+// NewPrecompileQueries creates an empty cache of precompile query results.
+func NewPrecompileQueries() *PrecompileQueries {
+	return &PrecompileQueries{}
+}
+
+// Get returns the result and the gas cost of the read-only precompile call
+// memoized with the given key in the block at the given height, if the values
+// it read are the ones of the multistore.
+func (q *PrecompileQueries) Get(height int64, ms storetypes.MultiStore, key string) ([]byte, uint64, bool) {
+	q.mtx.Lock()
+	query, found := q.queries[key]
+	found = found && q.height == height
+	q.mtx.Unlock()
+
+	if !found || !query.valid(ms) {
+		return nil, 0, false
+	}
+	return common.CopyBytes(query.result), query.gas, true
+}
+
+// Track returns a view of the multistore recording the values read through it,
+// and the function memoizing with the given key the result of the read-only
+// precompile call executed on the view, in the block at the given height.
+//
+// The result is not memoized if the call iterates through, writes to or
+// branches the view, since the values it depends on can't be validated.
+func (q *PrecompileQueries) Track(height int64, ms storetypes.MultiStore, key string) (storetypes.MultiStore, func(result []byte, gas uint64)) {
+	view := &queryMultiStore{
+		MultiStore: ms,
+		reads:      make(map[storetypes.StoreKey]map[string][]byte),
+	}
+
+	return view, func(result []byte, gas uint64) {
+		if view.untracked {
+			return
+		}
+		q.set(height, key, precompileQuery{
+			reads:  view.reads,
+			result: common.CopyBytes(result),
+			gas:    gas,
+		})
+	}
+}
+
+// set memoizes the precompile call result, dropping the results of the
+// previous blocks.
+func (q *PrecompileQueries) set(height int64, key string, query precompileQuery) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if q.queries == nil || q.height != height {
+		q.height = height
+		q.queries = make(map[string]precompileQuery)
+	}
+	if _, found := q.queries[key]; !found && len(q.queries) >= MaxPrecompileQueries {
+		return
+	}
+	q.queries[key] = query
+}
+
+// precompileQueries returns the memoized precompile query results of the block,
+// or nil if they aren't kept. They are only kept while finalizing a block, on
+// the state and header shared by all its transactions.
+func (s *StateDB) precompileQueries() *PrecompileQueries {
+	if s.ctx.ExecMode() != sdk.ExecModeFinalize {
+		return nil
+	}
+	keeper, ok := s.keeper.(PrecompileQueriesKeeper)
+	if !ok {
+		return nil
+	}
+	return keeper.PrecompileQueries()
+}
+
+// GetPrecompileQuery returns the result and the gas cost of the read-only precompile
+// call memoized with the given key in the block, if the values it read are the ones
+// of the cache context.
+func (s *StateDB) GetPrecompileQuery(key string) ([]byte, uint64, bool) {
+	queries := s.precompileQueries()
+	if queries == nil || s.writeCache == nil {
+		return nil, 0, false
+	}
+	return queries.Get(s.ctx.BlockHeight(), s.cacheCtx.MultiStore(), key)
+}
+
+// TrackPrecompileQuery returns the context on which to execute the read-only
+// precompile call of the given key, recording the values it reads, and the function
+// memoizing its result and gas cost for the rest of the block.
+func (s *StateDB) TrackPrecompileQuery(ctx sdk.Context, key string) (sdk.Context, func(result []byte, gas uint64)) {
+	queries := s.precompileQueries()
+	if queries == nil {
+		return ctx, func([]byte, uint64) {}
+	}
+	ms, memoize := queries.Track(s.ctx.BlockHeight(), ctx.MultiStore(), key)
+	return ctx.WithMultiStore(ms), memoize
+}
+
+// queryMultiStore is a view of a multistore recording the values read from its
+// KV stores. Any access it can't record marks it as untracked.
+type queryMultiStore struct {
+	storetypes.MultiStore
+
+	reads     map[storetypes.StoreKey]map[string][]byte
+	untracked bool
+}
+
+// GetKVStore implements the MultiStore interface.
+func (ms *queryMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if ms.reads[key] == nil {
+		ms.reads[key] = make(map[string][]byte)
+	}
+	return &queryKVStore{KVStore: ms.MultiStore.GetKVStore(key), view: ms, reads: ms.reads[key]}
+}
+
+// GetStore implements the MultiStore interface.
+func (ms *queryMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// CacheWrap implements the MultiStore interface.
+func (ms *queryMultiStore) CacheWrap() storetypes.CacheWrap {
+	ms.untracked = true
+	return ms.MultiStore.CacheWrap()
+}
+
+// CacheWrapWithTrace implements the MultiStore interface.
+func (ms *queryMultiStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	ms.untracked = true
+	return ms.MultiStore.CacheWrapWithTrace(w, tc)
+}
+
+// CacheMultiStore implements the MultiStore interface.
+func (ms *queryMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	ms.untracked = true
+	return ms.MultiStore.CacheMultiStore()
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface.
+func (ms *queryMultiStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	ms.untracked = true
+	return ms.MultiStore.CacheMultiStoreWithVersion(version)
+}
+
+// queryKVStore is a view of a KV store recording the values read from it.
+type queryKVStore struct {
+	storetypes.KVStore
+
+	view  *queryMultiStore
+	reads map[string][]byte
+}
+
+// Get implements the KVStore interface.
+func (s *queryKVStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.reads[string(key)] = common.CopyBytes(value)
+	return value
+}
+
+// Has implements the KVStore interface. The value is read to be validated like
+// the ones read by Get.
+func (s *queryKVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements the KVStore interface.
+func (s *queryKVStore) Set(key, value []byte) {
+	s.view.untracked = true
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements the KVStore interface.
+func (s *queryKVStore) Delete(key []byte) {
+	s.view.untracked = true
+	s.KVStore.Delete(key)
+}
+
+// Iterator implements the KVStore interface.
+func (s *queryKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.view.untracked = true
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *queryKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.view.untracked = true
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// CacheWrap implements the KVStore interface.
+func (s *queryKVStore) CacheWrap() storetypes.CacheWrap {
+	s.view.untracked = true
+	return s.KVStore.CacheWrap()
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *queryKVStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	s.view.untracked = true
+	return s.KVStore.CacheWrapWithTrace(w, tc)
+}
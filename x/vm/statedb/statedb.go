@@ -79,6 +79,9 @@ type StateDB struct {
 
 	// The count of calls to precompiles
 	precompileCallsCounter uint8
+
+	// The gas overrides of the params, used by the overridden gas functions
+	gasOverrides types.GasOverrides
 }
 
 func (s *StateDB) CreateContract(address common.Address) {
@@ -166,6 +169,16 @@ func (s *StateDB) GetContext() sdk.Context {
 	return s.ctx
 }
 
+// GetGasOverrides returns the gas overrides applied by the EVM.
+func (s *StateDB) GetGasOverrides() types.GasOverrides {
+	return s.gasOverrides
+}
+
+// SetGasOverrides sets the gas overrides applied by the EVM.
+func (s *StateDB) SetGasOverrides(gasOverrides types.GasOverrides) {
+	s.gasOverrides = gasOverrides
+}
+
 // GetCacheContext returns the stateDB CacheContext.
 func (s *StateDB) GetCacheContext() (sdk.Context, error) {
 	if s.writeCache == nil {
@@ -437,10 +450,17 @@ func (s *StateDB) AddPrecompileFn(snapshot int, events sdk.Events) error {
 		snapshot: snapshot,
 		events:   events,
 	})
-	s.precompileCallsCounter++
-	if s.precompileCallsCounter > types.MaxPrecompileCalls {
+	return s.AddPrecompileCall()
+}
+
+// AddPrecompileCall counts a call to a stateful precompile against the
+// MaxPrecompileCalls limit of the transaction. It's called for every call,
+// including the ones served from the memoized query results.
+func (s *StateDB) AddPrecompileCall() error {
+	if s.precompileCallsCounter >= types.MaxPrecompileCalls {
 		return fmt.Errorf("max calls to precompiles (%d) reached", types.MaxPrecompileCalls)
 	}
+	s.precompileCallsCounter++
 	return nil
 }
 
@@ -15,8 +15,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/evm/x/vm/statedb"
+	"github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/evm/x/vm/types/mocks"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -651,6 +655,122 @@ func (suite *StateDBTestSuite) TestRefund() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestPrecompileQueries() {
+	storeKey := storetypes.NewKVStoreKey("test")
+	readKey, otherKey, missingKey := []byte("read"), []byte("other"), []byte("missing")
+	queryKey := "query"
+	result := []byte("result")
+
+	testCases := []struct {
+		name     string
+		query    func(store storetypes.KVStore)
+		malleate func(ctx sdk.Context)
+		height   int64
+		expFound bool
+	}{
+		{"unchanged values", func(store storetypes.KVStore) {
+			store.Get(readKey)
+			store.Has(missingKey)
+		}, func(_ sdk.Context) {}, 1, true},
+		{"unrelated key written", func(store storetypes.KVStore) {
+			store.Get(readKey)
+		}, func(ctx sdk.Context) {
+			ctx.KVStore(storeKey).Set(otherKey, []byte("value"))
+		}, 1, true},
+		{"same value written", func(store storetypes.KVStore) {
+			store.Get(readKey)
+		}, func(ctx sdk.Context) {
+			ctx.KVStore(storeKey).Set(readKey, []byte("value"))
+		}, 1, true},
+		{"read key written", func(store storetypes.KVStore) {
+			store.Get(readKey)
+		}, func(ctx sdk.Context) {
+			ctx.KVStore(storeKey).Set(readKey, []byte("updated"))
+		}, 1, false},
+		{"read key deleted", func(store storetypes.KVStore) {
+			store.Has(readKey)
+		}, func(ctx sdk.Context) {
+			ctx.KVStore(storeKey).Delete(readKey)
+		}, 1, false},
+		{"missing key written", func(store storetypes.KVStore) {
+			store.Get(missingKey)
+		}, func(ctx sdk.Context) {
+			ctx.KVStore(storeKey).Set(missingKey, []byte("value"))
+		}, 1, false},
+		{"next block", func(store storetypes.KVStore) {
+			store.Get(readKey)
+		}, func(_ sdk.Context) {}, 2, false},
+		{"iterated store", func(store storetypes.KVStore) {
+			it := store.Iterator(nil, nil)
+			it.Close()
+		}, func(_ sdk.Context) {}, 1, false},
+		{"written store", func(store storetypes.KVStore) {
+			store.Set(otherKey, []byte("value"))
+		}, func(_ sdk.Context) {}, 1, false},
+		{"branched store", func(store storetypes.KVStore) {
+			store.CacheWrap()
+		}, func(_ sdk.Context) {}, 1, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := testutil.DefaultContextWithDB(suite.T(), storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+			ctx.KVStore(storeKey).Set(readKey, []byte("value"))
+
+			queries := statedb.NewPrecompileQueries()
+			_, _, found := queries.Get(1, ctx.MultiStore(), queryKey)
+			suite.Require().False(found)
+
+			ms, memoize := queries.Track(1, ctx.MultiStore(), queryKey)
+			tc.query(ms.GetKVStore(storeKey))
+			memoize(result, 100)
+
+			tc.malleate(ctx)
+
+			bz, gas, found := queries.Get(tc.height, ctx.MultiStore(), queryKey)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(result, bz)
+				suite.Require().Equal(uint64(100), gas)
+			}
+		})
+	}
+}
+
+func (suite *StateDBTestSuite) TestPrecompileQueriesLimit() {
+	storeKey := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(suite.T(), storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	queries := statedb.NewPrecompileQueries()
+	for i := 0; i <= statedb.MaxPrecompileQueries; i++ {
+		_, memoize := queries.Track(1, ctx.MultiStore(), fmt.Sprint(i))
+		memoize([]byte("result"), 100)
+	}
+
+	_, _, found := queries.Get(1, ctx.MultiStore(), fmt.Sprint(statedb.MaxPrecompileQueries-1))
+	suite.Require().True(found)
+	_, _, found = queries.Get(1, ctx.MultiStore(), fmt.Sprint(statedb.MaxPrecompileQueries))
+	suite.Require().False(found)
+
+	// the results of the previous block are dropped
+	_, memoize := queries.Track(2, ctx.MultiStore(), "next")
+	memoize([]byte("result"), 100)
+	_, _, found = queries.Get(1, ctx.MultiStore(), "0")
+	suite.Require().False(found)
+	_, _, found = queries.Get(2, ctx.MultiStore(), "next")
+	suite.Require().True(found)
+}
+
+func (suite *StateDBTestSuite) TestAddPrecompileCall() {
+	db := statedb.New(sdk.Context{}, mocks.NewEVMKeeper(), emptyTxConfig)
+	for i := 0; i < int(types.MaxPrecompileCalls); i++ {
+		suite.Require().NoError(db.AddPrecompileCall())
+	}
+	// the limit is enforced for all the following calls
+	for i := 0; i < 256; i++ {
+		suite.Require().ErrorContains(db.AddPrecompileCall(), "max calls to precompiles")
+	}
+}
+
 func (suite *StateDBTestSuite) TestIterateStorage() {
 	ctx := sdk.Context{}
 
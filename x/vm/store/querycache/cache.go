@@ -0,0 +1,98 @@
+package querycache
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Kind identifies one of the caches of the query multi store.
+type Kind string
+
+const (
+	// KindCode caches the contract code by hash. The code is content addressed,
+	// so the cached entries are shared by all the heights.
+	KindCode Kind = "code"
+	// KindAccount caches the records making up the accounts: the auth accounts,
+	// the balances and the code hashes.
+	KindAccount Kind = "account"
+	// KindStorage caches the contract storage slots.
+	KindStorage Kind = "storage"
+)
+
+// Route sends the reads of the keys of a store starting with a prefix to one of
+// the caches.
+type Route struct {
+	StoreName string
+	Prefix    []byte
+	Kind      Kind
+}
+
+// cache is a thread-safe LRU cache of the store values, nil for missing keys,
+// counting its hits and misses.
+type cache struct {
+	kind    Kind
+	entries *lru.Cache[string, []byte]
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+}
+
+func newCache(kind Kind, size int) *cache {
+	return &cache{
+		kind:    kind,
+		entries: lru.NewCache[string, []byte](size),
+	}
+}
+
+// get returns the cached value of the key, or reads it with the given function
+// and caches it.
+func (c *cache) get(key string, read func() []byte) []byte {
+	if value, ok := c.entries.Get(key); ok {
+		c.hits.Add(1)
+		telemetry.IncrCounterWithLabels([]string{"evm", "query_cache", "hit"}, 1, []metrics.Label{telemetry.NewLabel("cache", string(c.kind))})
+		return value
+	}
+
+	c.misses.Add(1)
+	telemetry.IncrCounterWithLabels([]string{"evm", "query_cache", "miss"}, 1, []metrics.Label{telemetry.NewLabel("cache", string(c.kind))})
+	value := read()
+	c.entries.Add(key, value)
+	return value
+}
+
+// route is a Route resolved to its cache.
+type route struct {
+	prefix []byte
+	cache  *cache
+}
+
+// find returns the cache of the first route matching the key, nil if none.
+func find(routes []route, key []byte) *cache {
+	for _, r := range routes {
+		if bytes.HasPrefix(key, r.prefix) {
+			return r.cache
+		}
+	}
+	return nil
+}
+
+// Stats are the hit and miss counts of a cache since the node started.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Size   int
+}
+
+// HitRate returns the share of the reads served by the cache, 0 if it wasn't
+// read.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
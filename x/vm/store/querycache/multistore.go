@@ -0,0 +1,134 @@
+package querycache
+
+import (
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Config defines the number of entries of each cache, 0 disabling it.
+type Config struct {
+	CodeSize    int
+	AccountSize int
+	StorageSize int
+}
+
+// Enabled returns true if at least one of the caches is enabled.
+func (c Config) Enabled() bool {
+	return c.CodeSize > 0 || c.AccountSize > 0 || c.StorageSize > 0
+}
+
+// DefaultRoutes returns the routes of the EVM state read by the queries: the
+// code and storage of the contracts, and the records of the accounts.
+func DefaultRoutes() []Route {
+	return []Route{
+		{StoreName: evmtypes.StoreKey, Prefix: evmtypes.KeyPrefixCode, Kind: KindCode},
+		{StoreName: evmtypes.StoreKey, Prefix: evmtypes.KeyPrefixStorage, Kind: KindStorage},
+		{StoreName: evmtypes.StoreKey, Prefix: evmtypes.KeyPrefixCodeHash, Kind: KindAccount},
+		{StoreName: authtypes.StoreKey, Prefix: authtypes.AddressStoreKeyPrefix, Kind: KindAccount},
+		{StoreName: banktypes.StoreKey, Prefix: banktypes.BalancesPrefix, Kind: KindAccount},
+	}
+}
+
+// QueryableMultiStore is the multi store the queries are branched from, usually
+// the root multi store of the app.
+type QueryableMultiStore interface {
+	storetypes.MultiStore
+	StoreKeysByName() map[string]storetypes.StoreKey
+}
+
+// MultiStore is a query multi store adding a read-through LRU cache of the EVM
+// state to the multi stores branched at a committed height, so the repeated
+// queries against the same accounts and contracts don't read the IAVL trees.
+//
+// The state of a committed height doesn't change, so the account and storage
+// entries are cached by height, while the code is content addressed and cached
+// once for all the heights.
+type MultiStore struct {
+	QueryableMultiStore
+
+	caches map[Kind]*cache
+	// routes by store name
+	routes map[string][]route
+}
+
+var _ storetypes.MultiStore = (*MultiStore)(nil)
+
+// NewMultiStore returns a query multi store caching the reads of the given
+// routes, skipping the ones of the disabled caches.
+func NewMultiStore(parent QueryableMultiStore, cfg Config, routes []Route) *MultiStore {
+	sizes := map[Kind]int{
+		KindCode:    cfg.CodeSize,
+		KindAccount: cfg.AccountSize,
+		KindStorage: cfg.StorageSize,
+	}
+
+	ms := &MultiStore{
+		QueryableMultiStore: parent,
+		caches:              make(map[Kind]*cache),
+		routes:              make(map[string][]route),
+	}
+	for _, r := range routes {
+		size, ok := sizes[r.Kind]
+		if !ok {
+			panic(fmt.Errorf("unknown query cache kind %q", r.Kind))
+		}
+		if size <= 0 {
+			continue
+		}
+
+		c, ok := ms.caches[r.Kind]
+		if !ok {
+			c = newCache(r.Kind, size)
+			ms.caches[r.Kind] = c
+		}
+		ms.routes[r.StoreName] = append(ms.routes[r.StoreName], route{prefix: r.Prefix, cache: c})
+	}
+
+	return ms
+}
+
+// CacheMultiStoreWithVersion implements the MultiStore interface, branching the
+// multi store at the given height with the routed stores reading through the
+// caches.
+func (ms *MultiStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	cms, err := ms.QueryableMultiStore.CacheMultiStoreWithVersion(version)
+	if err != nil || version <= 0 || len(ms.routes) == 0 {
+		return cms, err
+	}
+
+	keys := ms.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for name, key := range keys {
+		parent := cms.GetKVStore(key)
+		if routes, ok := ms.routes[name]; ok {
+			stores[key] = newStore(parent, name, version, routes)
+		} else {
+			stores[key] = parent
+		}
+	}
+
+	return cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, keys, nil, nil), nil
+}
+
+// Stats returns the statistics of the enabled caches.
+func (ms *MultiStore) Stats() map[Kind]Stats {
+	stats := make(map[Kind]Stats, len(ms.caches))
+	for kind, c := range ms.caches {
+		stats[kind] = Stats{
+			Hits:   c.hits.Load(),
+			Misses: c.misses.Load(),
+			Size:   c.entries.Len(),
+		}
+	}
+	return stats
+}
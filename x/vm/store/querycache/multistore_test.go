@@ -0,0 +1,139 @@
+package querycache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/evm/x/vm/store/querycache"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	codeKey    = []byte("\x01code")
+	slotKey    = []byte("\x02slot")
+	otherKey   = []byte("\x03other")
+	missingKey = []byte("\x02missing")
+)
+
+func setupMultiStore(t *testing.T, cfg querycache.Config) (*rootmulti.Store, *storetypes.KVStoreKey, *storetypes.TransientStoreKey, *querycache.MultiStore) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("evm")
+	tkey := storetypes.NewTransientStoreKey("transient_evm")
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(tkey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	routes := []querycache.Route{
+		{StoreName: "evm", Prefix: []byte{1}, Kind: querycache.KindCode},
+		{StoreName: "evm", Prefix: []byte{2}, Kind: querycache.KindStorage},
+	}
+	return rs, key, tkey, querycache.NewMultiStore(rs, cfg, routes)
+}
+
+// commit sets the given values in the store and commits a new height.
+func commit(rs *rootmulti.Store, key storetypes.StoreKey, values map[string]string) {
+	kv := rs.GetKVStore(key)
+	for k, v := range values {
+		kv.Set([]byte(k), []byte(v))
+	}
+	rs.Commit()
+}
+
+func TestQueryCacheReads(t *testing.T) {
+	rs, key, _, ms := setupMultiStore(t, querycache.Config{CodeSize: 10, StorageSize: 10})
+	commit(rs, key, map[string]string{string(codeKey): "code", string(slotKey): "1", string(otherKey): "a"})
+	commit(rs, key, map[string]string{string(slotKey): "2", string(otherKey): "b"})
+
+	for i := 0; i < 2; i++ {
+		cms, err := ms.CacheMultiStoreWithVersion(1)
+		require.NoError(t, err)
+		kv := cms.GetKVStore(key)
+		require.Equal(t, []byte("code"), kv.Get(codeKey))
+		require.Equal(t, []byte("1"), kv.Get(slotKey))
+		require.Equal(t, []byte("a"), kv.Get(otherKey))
+		require.Nil(t, kv.Get(missingKey))
+		require.False(t, kv.Has(missingKey))
+
+		cms, err = ms.CacheMultiStoreWithVersion(2)
+		require.NoError(t, err)
+		kv = cms.GetKVStore(key)
+		require.Equal(t, []byte("code"), kv.Get(codeKey))
+		require.Equal(t, []byte("2"), kv.Get(slotKey))
+		require.Equal(t, []byte("b"), kv.Get(otherKey))
+	}
+
+	stats := ms.Stats()
+	require.Len(t, stats, 2)
+	// the code is shared by the heights, the slots are cached by height
+	require.Equal(t, querycache.Stats{Hits: 3, Misses: 1, Size: 1}, stats[querycache.KindCode])
+	require.Equal(t, querycache.Stats{Hits: 3, Misses: 3, Size: 3}, stats[querycache.KindStorage])
+	require.Equal(t, 0.5, stats[querycache.KindStorage].HitRate())
+}
+
+func TestQueryCacheWrites(t *testing.T) {
+	rs, key, tkey, ms := setupMultiStore(t, querycache.Config{StorageSize: 10})
+	commit(rs, key, map[string]string{string(slotKey): "1"})
+
+	cms, err := ms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	kv := cms.GetKVStore(key)
+	require.Equal(t, []byte("1"), kv.Get(slotKey))
+
+	// the writes of a query aren't visible to the other queries
+	kv.Set(slotKey, []byte("2"))
+	kv.Delete(missingKey)
+	cms.GetKVStore(tkey).Set(otherKey, []byte("a"))
+	require.Equal(t, []byte("2"), kv.Get(slotKey))
+	cms.Write()
+	require.Equal(t, []byte("2"), kv.Get(slotKey))
+
+	cms, err = ms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(key).Get(slotKey))
+	require.Nil(t, cms.GetKVStore(tkey).Get(otherKey))
+	require.Equal(t, []byte("1"), rs.GetKVStore(key).Get(slotKey))
+}
+
+func TestQueryCacheDisabled(t *testing.T) {
+	rs, key, _, ms := setupMultiStore(t, querycache.Config{CodeSize: 10})
+	commit(rs, key, map[string]string{string(codeKey): "code", string(slotKey): "1"})
+
+	for i := 0; i < 2; i++ {
+		cms, err := ms.CacheMultiStoreWithVersion(1)
+		require.NoError(t, err)
+		require.Equal(t, []byte("code"), cms.GetKVStore(key).Get(codeKey))
+		require.Equal(t, []byte("1"), cms.GetKVStore(key).Get(slotKey))
+	}
+
+	stats := ms.Stats()
+	require.Len(t, stats, 1)
+	require.Equal(t, querycache.Stats{Hits: 1, Misses: 1, Size: 1}, stats[querycache.KindCode])
+
+	_, err := ms.CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+}
+
+func TestQueryCacheEviction(t *testing.T) {
+	rs, key, _, ms := setupMultiStore(t, querycache.Config{StorageSize: 1})
+	commit(rs, key, map[string]string{string(slotKey): "1"})
+
+	cms, err := ms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	cms.GetKVStore(key).Get(slotKey)
+	cms.GetKVStore(key).Get(missingKey)
+
+	cms, err = ms.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(key).Get(slotKey))
+
+	stats := ms.Stats()[querycache.KindStorage]
+	require.Equal(t, querycache.Stats{Hits: 0, Misses: 3, Size: 1}, stats)
+}
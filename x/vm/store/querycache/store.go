@@ -0,0 +1,83 @@
+package querycache
+
+import (
+	"encoding/binary"
+	"io"
+
+	"cosmossdk.io/store/cachekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// store reads the routed keys of a store branched at a committed height through
+// the caches, and the other keys from its parent.
+//
+// It is wrapped by the cache store of the query, so the writes of the query
+// aren't visible to it unless the query flushes them, in which case the caches
+// are bypassed for the rest of the query.
+type store struct {
+	storetypes.KVStore
+
+	routes []route
+	// prefix of the cached entries of the height, for the kinds of entries
+	// depending on it
+	prefix string
+	dirty  bool
+}
+
+var _ storetypes.KVStore = (*store)(nil)
+
+func newStore(parent storetypes.KVStore, name string, version int64, routes []route) *store {
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, uint64(version)) //nolint:gosec // G115 // committed heights are positive
+	return &store{
+		KVStore: parent,
+		routes:  routes,
+		prefix:  string(height) + name + "/",
+	}
+}
+
+// CacheWrap implements the KVStore interface.
+func (s *store) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *store) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// Get implements the KVStore interface.
+func (s *store) Get(key []byte) []byte {
+	c := find(s.routes, key)
+	if c == nil || s.dirty {
+		return s.KVStore.Get(key)
+	}
+
+	cacheKey := s.prefix + string(key)
+	if c.kind == KindCode {
+		cacheKey = string(key)
+	}
+	return c.get(cacheKey, func() []byte {
+		return s.KVStore.Get(key)
+	})
+}
+
+// Has implements the KVStore interface.
+func (s *store) Has(key []byte) bool {
+	if find(s.routes, key) == nil {
+		return s.KVStore.Has(key)
+	}
+	return s.Get(key) != nil
+}
+
+// Set implements the KVStore interface.
+func (s *store) Set(key, value []byte) {
+	s.dirty = true
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements the KVStore interface.
+func (s *store) Delete(key []byte) {
+	s.dirty = true
+	s.KVStore.Delete(key)
+}
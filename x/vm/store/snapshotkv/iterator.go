@@ -0,0 +1,139 @@
+package snapshotkv
+
+import (
+	"bytes"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// kvPair is a buffered write, with a nil value for a deletion.
+type kvPair struct {
+	key   []byte
+	value *value
+}
+
+// inDomain returns true if the key is in the domain [start, end) of an
+// iterator, a nil bound being unbounded.
+func inDomain(key, start, end []byte) bool {
+	if start != nil && bytes.Compare(key, start) < 0 {
+		return false
+	}
+	return end == nil || bytes.Compare(key, end) < 0
+}
+
+// mergeIterator iterates through the buffered writes merged with the parent
+// iterator, the writes overriding the parent values of the same keys.
+type mergeIterator struct {
+	parent    storetypes.Iterator
+	writes    []kvPair
+	ascending bool
+}
+
+var _ storetypes.Iterator = (*mergeIterator)(nil)
+
+func newMergeIterator(parent storetypes.Iterator, writes []kvPair, ascending bool) *mergeIterator {
+	it := &mergeIterator{
+		parent:    parent,
+		writes:    writes,
+		ascending: ascending,
+	}
+	it.skipDeleted()
+	return it
+}
+
+// Domain implements the Iterator interface.
+func (it *mergeIterator) Domain() (start, end []byte) {
+	return it.parent.Domain()
+}
+
+// Valid implements the Iterator interface.
+func (it *mergeIterator) Valid() bool {
+	return it.parent.Valid() || len(it.writes) > 0
+}
+
+// Next implements the Iterator interface.
+func (it *mergeIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+
+	switch it.compare() {
+	case -1:
+		it.parent.Next()
+	case 1:
+		it.writes = it.writes[1:]
+	default:
+		it.parent.Next()
+		it.writes = it.writes[1:]
+	}
+	it.skipDeleted()
+}
+
+// Key implements the Iterator interface.
+func (it *mergeIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	if it.compare() < 0 {
+		return it.parent.Key()
+	}
+	return it.writes[0].key
+}
+
+// Value implements the Iterator interface.
+func (it *mergeIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	if it.compare() < 0 {
+		return it.parent.Value()
+	}
+	return it.writes[0].value.bz
+}
+
+// Error implements the Iterator interface.
+func (it *mergeIterator) Error() error {
+	if err := it.parent.Error(); err != nil {
+		return err
+	}
+	if !it.Valid() {
+		return errors.New("invalid merge iterator")
+	}
+	return nil
+}
+
+// Close implements the Iterator interface.
+func (it *mergeIterator) Close() error {
+	return it.parent.Close()
+}
+
+// compare returns -1 if the next item is from the parent, 1 if it's from the
+// writes, and 0 if both have the same key, in the order of the iteration.
+func (it *mergeIterator) compare() int {
+	switch {
+	case !it.parent.Valid():
+		return 1
+	case len(it.writes) == 0:
+		return -1
+	}
+
+	cmp := bytes.Compare(it.parent.Key(), it.writes[0].key)
+	if !it.ascending {
+		cmp = -cmp
+	}
+	return cmp
+}
+
+// skipDeleted skips the deleted keys, which shadow the parent values.
+func (it *mergeIterator) skipDeleted() {
+	for len(it.writes) > 0 && it.writes[0].value == nil {
+		switch it.compare() {
+		case -1:
+			return
+		case 0:
+			it.parent.Next()
+		}
+		it.writes = it.writes[1:]
+	}
+}
@@ -2,6 +2,8 @@ package snapshotkv
 
 import (
 	"fmt"
+	"io"
+	"sort"
 
 	"github.com/cosmos/evm/x/vm/store/types"
 
@@ -9,61 +11,199 @@ import (
 	storetypes "cosmossdk.io/store/types"
 )
 
-// Store manages a stack of nested cache store to
+// value is a write buffered by the store, nil for a deletion.
+type value struct {
+	bz []byte
+}
+
+// journalEntry records the buffered write of a key before a modification, to
+// restore it on revert.
+type journalEntry struct {
+	key  string
+	prev *value
+	// dirty is false if the key had no buffered write
+	dirty bool
+}
+
+// Store buffers the writes on top of a cache store, and journals them to
 // support the evm `StateDB`'s `Snapshot` and `RevertToSnapshot` methods.
+//
+// The journal is linear, with a snapshot being the position in the journal
+// where it was taken, so that taking a snapshot is constant, reverting is
+// linear in the number of reverted writes, and reads don't depend on the
+// number of snapshots.
 type Store struct {
 	// Store of the initial state before transaction execution
 	initialStore storetypes.CacheKVStore
 
-	// Stack of cached store
-	cacheStores []storetypes.CacheKVStore
+	// writes buffered since the last commit
+	dirty map[string]*value
+	// journal of the buffered writes, and positions of the snapshots in it
+	journal   []journalEntry
+	snapshots []int
 }
 
-var _ types.SnapshotKVStore = (*Store)(nil)
+var (
+	_ types.SnapshotKVStore   = (*Store)(nil)
+	_ storetypes.CacheKVStore = (*Store)(nil)
+)
 
 // NewStore creates a new Store object
 func NewStore(store storetypes.CacheKVStore) *Store {
 	return &Store{
 		initialStore: store,
-		cacheStores:  nil,
+		dirty:        make(map[string]*value),
 	}
 }
 
-// CurrentStore returns the top of cached store stack.
-// If the stack is empty, returns the initial store.
+// CurrentStore returns the store applying the writes on top of the current
+// snapshot, which is the store itself.
 func (cs *Store) CurrentStore() storetypes.CacheKVStore {
-	l := len(cs.cacheStores)
-	if l == 0 {
-		return cs.initialStore
-	}
-	return cs.cacheStores[l-1]
+	return cs
 }
 
-// Commit commits all the cached stores from top to bottom in order
-// and clears the cache stack by setting an empty slice of cache store.
+// Commit writes the buffered writes to the initial store in order, flushes it
+// and clears the journal and the snapshots.
 func (cs *Store) Commit() {
-	// commit in order from top to bottom
-	for i := len(cs.cacheStores) - 1; i >= 0; i-- {
-		cs.cacheStores[i].Write()
+	keys := make([]string, 0, len(cs.dirty))
+	for key := range cs.dirty {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if v := cs.dirty[key]; v != nil {
+			cs.initialStore.Set([]byte(key), v.bz)
+		} else {
+			cs.initialStore.Delete([]byte(key))
+		}
 	}
 	cs.initialStore.Write()
-	cs.cacheStores = nil
+
+	cs.dirty = make(map[string]*value)
+	cs.journal = nil
+	cs.snapshots = nil
 }
 
-// Snapshot pushes a new cached store to the stack,
-// and returns the index of it.
+// Snapshot records the current position of the journal,
+// and returns the index of the snapshot.
 func (cs *Store) Snapshot() int {
-	cs.cacheStores = append(cs.cacheStores, cachekv.NewStore(cs.CurrentStore()))
-	return len(cs.cacheStores) - 1
+	cs.snapshots = append(cs.snapshots, len(cs.journal))
+	return len(cs.snapshots) - 1
 }
 
-// RevertToSnapshot pops all the cached stores
-// whose index is greator than or equal to target.
+// RevertToSnapshot undoes the writes made since the target snapshot, and
+// discards the snapshots whose index is greater than or equal to target.
 // The target should be snapshot index returned by `Snapshot`.
 // This function panics if the index is out of bounds.
 func (cs *Store) RevertToSnapshot(target int) {
-	if target < 0 || target >= len(cs.cacheStores) {
-		panic(fmt.Errorf("snapshot index %d out of bound [%d..%d)", target, 0, len(cs.cacheStores)))
+	if target < 0 || target >= len(cs.snapshots) {
+		panic(fmt.Errorf("snapshot index %d out of bound [%d..%d)", target, 0, len(cs.snapshots)))
+	}
+
+	position := cs.snapshots[target]
+	for i := len(cs.journal) - 1; i >= position; i-- {
+		entry := cs.journal[i]
+		if entry.dirty {
+			cs.dirty[entry.key] = entry.prev
+		} else {
+			delete(cs.dirty, entry.key)
+		}
+	}
+	cs.journal = cs.journal[:position]
+	cs.snapshots = cs.snapshots[:target]
+}
+
+// GetStoreType implements the KVStore interface.
+func (cs *Store) GetStoreType() storetypes.StoreType {
+	return cs.initialStore.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface.
+func (cs *Store) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(cs)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (cs *Store) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(cs)
+}
+
+// Write implements the CacheKVStore interface, committing the store.
+func (cs *Store) Write() {
+	cs.Commit()
+}
+
+// Get implements the KVStore interface.
+func (cs *Store) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+	if v, ok := cs.dirty[string(key)]; ok {
+		if v == nil {
+			return nil
+		}
+		return v.bz
+	}
+	return cs.initialStore.Get(key)
+}
+
+// Has implements the KVStore interface.
+func (cs *Store) Has(key []byte) bool {
+	return cs.Get(key) != nil
+}
+
+// Set implements the KVStore interface.
+func (cs *Store) Set(key, bz []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(bz)
+	cs.write(string(key), &value{bz: bz})
+}
+
+// Delete implements the KVStore interface.
+func (cs *Store) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	cs.write(string(key), nil)
+}
+
+// write buffers the write of the key and journals the previous one.
+func (cs *Store) write(key string, v *value) {
+	prev, dirty := cs.dirty[key]
+	cs.journal = append(cs.journal, journalEntry{key: key, prev: prev, dirty: dirty})
+	cs.dirty[key] = v
+}
+
+// Iterator implements the KVStore interface.
+func (cs *Store) Iterator(start, end []byte) storetypes.Iterator {
+	return cs.iterator(start, end, true)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (cs *Store) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return cs.iterator(start, end, false)
+}
+
+// iterator merges the buffered writes of the domain with an iterator of the
+// initial store. The writes made during the iteration aren't visible.
+func (cs *Store) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	var parent storetypes.Iterator
+	if ascending {
+		parent = cs.initialStore.Iterator(start, end)
+	} else {
+		parent = cs.initialStore.ReverseIterator(start, end)
 	}
-	cs.cacheStores = cs.cacheStores[:target]
+
+	writes := make([]kvPair, 0)
+	for key, v := range cs.dirty {
+		if !inDomain([]byte(key), start, end) {
+			continue
+		}
+		writes = append(writes, kvPair{key: []byte(key), value: v})
+	}
+	sort.Slice(writes, func(i, j int) bool {
+		if ascending {
+			return string(writes[i].key) < string(writes[j].key)
+		}
+		return string(writes[i].key) > string(writes[j].key)
+	})
+
+	return newMergeIterator(parent, writes, ascending)
 }
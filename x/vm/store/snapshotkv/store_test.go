@@ -2,6 +2,9 @@ package snapshotkv_test
 
 import (
 	"fmt"
+	"maps"
+	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,6 +14,7 @@ import (
 
 	"cosmossdk.io/store/cachekv"
 	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
 )
 
 func newSnapshotKV() *snapshotkv.Store {
@@ -120,3 +124,122 @@ func TestSnapshotInvalidIndex(t *testing.T) {
 		store.RevertToSnapshot(-1)
 	})
 }
+
+// iterate returns the key/value pairs of the domain, in the order of the
+// iteration.
+func iterate(store storetypes.KVStore, start, end []byte, ascending bool) [][2]string {
+	var it storetypes.Iterator
+	if ascending {
+		it = store.Iterator(start, end)
+	} else {
+		it = store.ReverseIterator(start, end)
+	}
+	defer it.Close()
+
+	var pairs [][2]string
+	for ; it.Valid(); it.Next() {
+		pairs = append(pairs, [2]string{string(it.Key()), string(it.Value())})
+	}
+	return pairs
+}
+
+// expectedPairs returns the key/value pairs of the domain in the model.
+func expectedPairs(model map[string]string, start, end string, ascending bool) [][2]string {
+	var pairs [][2]string
+	for k, v := range model {
+		if k >= start && (end == "" || k < end) {
+			pairs = append(pairs, [2]string{k, v})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if ascending {
+			return pairs[i][0] < pairs[j][0]
+		}
+		return pairs[i][0] > pairs[j][0]
+	})
+	return pairs
+}
+
+func TestSnapshotRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	parent := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+	store := snapshotkv.NewStore(cachekv.NewStore(parent))
+
+	// the model keeps a copy of the state at each snapshot
+	model := make(map[string]string)
+	var snapshots []map[string]string
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("k%02d", i)
+		parent.Set([]byte(key), []byte("parent"))
+		model[key] = "parent"
+	}
+
+	key := func() string { return fmt.Sprintf("k%02d", r.Intn(150)) }
+	for i := 0; i < 5000; i++ {
+		switch op := r.Intn(100); {
+		case op < 40:
+			k, v := key(), fmt.Sprint(i)
+			store.CurrentStore().Set([]byte(k), []byte(v))
+			model[k] = v
+		case op < 55:
+			k := key()
+			store.CurrentStore().Delete([]byte(k))
+			delete(model, k)
+		case op < 70:
+			require.Equal(t, len(snapshots), store.Snapshot())
+			snapshots = append(snapshots, maps.Clone(model))
+		case op < 80:
+			if len(snapshots) == 0 {
+				continue
+			}
+			target := r.Intn(len(snapshots))
+			store.RevertToSnapshot(target)
+			model = snapshots[target]
+			snapshots = snapshots[:target]
+		case op < 81:
+			store.Commit()
+			snapshots = nil
+		default:
+			start, end := key(), key()
+			if start > end {
+				start, end = end, start
+			}
+			if r.Intn(4) == 0 {
+				end = ""
+			}
+			var endBz []byte
+			if end != "" {
+				endBz = []byte(end)
+			}
+			ascending := r.Intn(2) == 0
+			require.Equal(t, expectedPairs(model, start, end, ascending), iterate(store.CurrentStore(), []byte(start), endBz, ascending), "iteration %d", i)
+
+			k := key()
+			v, found := model[k]
+			require.Equal(t, found, store.CurrentStore().Has([]byte(k)))
+			if found {
+				require.Equal(t, []byte(v), store.CurrentStore().Get([]byte(k)))
+			}
+		}
+	}
+
+	store.Commit()
+	require.Equal(t, expectedPairs(model, "", "", true), iterate(parent, nil, nil, true))
+}
+
+func TestSnapshotDeepNesting(t *testing.T) {
+	store := newSnapshotKV()
+	const depth = 1000
+
+	for i := 0; i < depth; i++ {
+		require.Equal(t, i, store.Snapshot())
+		store.CurrentStore().Set([]byte(fmt.Sprintf("key-%04d", i)), []byte("value"))
+	}
+
+	// reverting the innermost half of the snapshots keeps the outer writes
+	store.RevertToSnapshot(depth / 2)
+	require.Len(t, iterate(store.CurrentStore(), nil, nil, true), depth/2)
+	require.Equal(t, []byte("value"), store.CurrentStore().Get([]byte(fmt.Sprintf("key-%04d", depth/2-1))))
+	require.Nil(t, store.CurrentStore().Get([]byte(fmt.Sprintf("key-%04d", depth/2))))
+	require.Equal(t, depth/2, store.Snapshot())
+}
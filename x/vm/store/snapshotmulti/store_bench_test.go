@@ -68,3 +68,36 @@ func benchmarkSequential(b *testing.B) {
 func BenchmarkSequentialCacheMultiStore(b *testing.B) {
 	benchmarkSequential(b)
 }
+
+// benchmarkNestedSnapshots simulates a flash-loan style call stack, where each
+// nested precompile call takes a snapshot before writing to the stores, and the
+// innermost half of the calls is reverted before the transaction is committed.
+func benchmarkNestedSnapshots(b *testing.B, depth int) {
+	b.Helper()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		cms, keys := setupCacheMultiStoreWithKeys(5, 1000)
+		b.StartTimer()
+
+		for d := 0; d < depth; d++ {
+			snapshot := cms.Snapshot()
+			for _, key := range keys {
+				kv := cms.GetKVStore(key)
+				kv.Get([]byte(fmt.Sprintf("%s-key-%d", key.Name(), d%1000)))
+				kv.Set([]byte(fmt.Sprintf("%s-call-%d", key.Name(), d)), genBytes(32))
+			}
+			benchSink = snapshot
+		}
+		cms.RevertToSnapshot(depth / 2)
+		cms.Write()
+	}
+}
+
+func BenchmarkNestedSnapshots(b *testing.B) {
+	for _, depth := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("depth-%d", depth), func(b *testing.B) {
+			benchmarkNestedSnapshots(b, depth)
+		})
+	}
+}
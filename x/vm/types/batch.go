@@ -0,0 +1,66 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// atomicBatchKey is the context key of the Ethereum transactions batched in a
+// single Cosmos transaction.
+type atomicBatchKey struct{}
+
+// AtomicBatch holds the Ethereum transactions batched in a single Cosmos
+// transaction, which are executed atomically, i.e. the state changes of the
+// whole batch are reverted if any of them fails.
+//
+// The batch is executed with its first message, and the result of each
+// transaction is then returned by its own message.
+type AtomicBatch struct {
+	// Msgs are the batched Ethereum transactions, in order.
+	Msgs []*MsgEthereumTx
+	// Results are the results of the batched transactions, set once the batch
+	// is executed.
+	Results []*AtomicBatchResult
+}
+
+// AtomicBatchResult is the result of an Ethereum transaction of an atomic batch.
+type AtomicBatchResult struct {
+	// Response is the response of the transaction, failed if the batch is
+	// reverted.
+	Response *MsgEthereumTxResponse
+	// TxIndex is the index of the transaction in the block.
+	TxIndex uint64
+	// Events are the events emitted by the execution of the transaction, none
+	// if the batch is reverted.
+	Events sdk.Events
+}
+
+// Result returns the result of the batched transaction of the given hash, or
+// false if the batch is not executed yet or doesn't include the transaction.
+func (b *AtomicBatch) Result(hash string) (*AtomicBatchResult, bool) {
+	for _, result := range b.Results {
+		if result.Response.Hash == hash {
+			return result, true
+		}
+	}
+	return nil, false
+}
+
+// WithAtomicBatch returns a copy of the context carrying the Ethereum
+// transactions executed as an atomic batch.
+func WithAtomicBatch(ctx sdk.Context, msgs []*MsgEthereumTx) sdk.Context {
+	return ctx.WithValue(atomicBatchKey{}, &AtomicBatch{Msgs: msgs})
+}
+
+// GetAtomicBatch returns the atomic batch of Ethereum transactions carried by
+// the context, if any.
+func GetAtomicBatch(ctx sdk.Context) (*AtomicBatch, bool) {
+	batch, ok := ctx.Value(atomicBatchKey{}).(*AtomicBatch)
+	return batch, ok && batch != nil
+}
+
+// IsAtomicBatch returns true if the Ethereum transactions carried by the
+// context are executed as an atomic batch.
+func IsAtomicBatch(ctx sdk.Context) bool {
+	_, ok := GetAtomicBatch(ctx)
+	return ok
+}
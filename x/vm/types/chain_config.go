@@ -81,6 +81,7 @@ func DefaultChainConfig(evmChainID uint64) *ChainConfig {
 	shanghaiTime := sdkmath.ZeroInt()
 	cancunTime := sdkmath.ZeroInt()
 	pragueTime := sdkmath.ZeroInt()
+	p256Block := sdkmath.ZeroInt()
 
 	cfg := &ChainConfig{
 		ChainId:             evmChainID,
@@ -107,10 +108,21 @@ func DefaultChainConfig(evmChainID uint64) *ChainConfig {
 		PragueTime:          &pragueTime,
 		OsakaTime:           nil,
 		VerkleTime:          nil,
+		P256Block:           &p256Block,
 	}
 	return cfg
 }
 
+// IsP256Active returns true if the RIP-7212 secp256r1 verification precompile
+// is active at the given block height.
+func (cc ChainConfig) IsP256Active(height int64) bool {
+	if cc.P256Block == nil || cc.P256Block.IsNegative() {
+		return false
+	}
+
+	return cc.P256Block.LTE(sdkmath.NewInt(height))
+}
+
 // setChainConfig allows to set the `chainConfig` variable modifying the
 // default values. The method is private because it should only be called once
 // in the EVMConfigurator.
@@ -209,6 +221,9 @@ func (cc ChainConfig) Validate() error {
 	if err := validateBlockOrTimestamp(cc.VerkleTime); err != nil {
 		return errorsmod.Wrap(err, "VerkleTime")
 	}
+	if err := validateBlockOrTimestamp(cc.P256Block); err != nil {
+		return errorsmod.Wrap(err, "P256Block")
+	}
 	// NOTE: chain ID is not needed to check config order
 	if err := cc.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
 		return errorsmod.Wrap(err, "invalid config fork order")
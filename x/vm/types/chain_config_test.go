@@ -341,6 +341,13 @@ func TestChainConfigValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid P256Block",
+			types.ChainConfig{
+				P256Block: newIntPtr(-1),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -353,3 +360,23 @@ func TestChainConfigValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestChainConfigIsP256Active(t *testing.T) {
+	testCases := []struct {
+		name      string
+		p256Block *sdkmath.Int
+		height    int64
+		expActive bool
+	}{
+		{"nil block", nil, 10, false},
+		{"active from genesis", newIntPtr(0), 1, true},
+		{"before activation block", newIntPtr(10), 9, false},
+		{"at activation block", newIntPtr(10), 10, true},
+		{"after activation block", newIntPtr(10), 11, true},
+	}
+
+	for _, tc := range testCases {
+		config := types.ChainConfig{P256Block: tc.p256Block}
+		require.Equal(t, tc.expActive, config.IsP256Active(tc.height), tc.name)
+	}
+}
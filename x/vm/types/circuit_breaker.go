@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/cosmos/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a basic validation of the circuit breaker fields.
+func (cb CircuitBreaker) Validate() error {
+	seenPrecompiles := make(map[string]bool, len(cb.PausedPrecompiles))
+	for _, precompile := range cb.PausedPrecompiles {
+		if err := types.ValidateAddress(precompile); err != nil {
+			return fmt.Errorf("invalid paused precompile %s", precompile)
+		}
+		if seenPrecompiles[precompile] {
+			return fmt.Errorf("duplicate paused precompile %s", precompile)
+		}
+		seenPrecompiles[precompile] = true
+	}
+
+	if cb.ExpiryHeight < 0 {
+		return fmt.Errorf("circuit breaker expiry height cannot be negative: %d", cb.ExpiryHeight)
+	}
+
+	return nil
+}
+
+// IsTripped returns true if the circuit breaker pauses an operation at the
+// given block height.
+func (cb CircuitBreaker) IsTripped(height int64) bool {
+	if cb.ExpiryHeight != 0 && height >= cb.ExpiryHeight {
+		return false
+	}
+	return cb.PauseCreate || cb.PauseCall || len(cb.PausedPrecompiles) > 0
+}
+
+// IsPrecompilePaused returns true if the given precompile address is paused.
+func (cb CircuitBreaker) IsPrecompilePaused(address common.Address) bool {
+	return slices.ContainsFunc(cb.PausedPrecompiles, func(precompile string) bool {
+		return common.HexToAddress(precompile) == address
+	})
+}
+
+// GetCreateHook returns a CreateHook that fails the contract creations if
+// they are paused.
+func (cb CircuitBreaker) GetCreateHook() CreateHook {
+	return func(_ *vm.EVM, _ common.Address) error {
+		if cb.PauseCreate {
+			return errorsmod.Wrap(ErrCircuitBreakerTripped, "contract creation is paused")
+		}
+		return nil
+	}
+}
+
+// GetCallHook returns a CallHook that fails the calls to the paused
+// precompiles, and to any contract or precompile if the calls are paused.
+// The value transfers to accounts without code are never paused.
+//
+// NOTE: the hook must run after the precompiles one, which loads the called
+// precompile in the EVM.
+func (cb CircuitBreaker) GetCallHook() CallHook {
+	return func(evm *vm.EVM, _ common.Address, recipient common.Address) error {
+		_, isPrecompile := evm.Precompile(recipient)
+		if isPrecompile && cb.IsPrecompilePaused(recipient) {
+			return errorsmod.Wrapf(ErrCircuitBreakerTripped, "precompile %s is paused", recipient)
+		}
+
+		if cb.PauseCall && (isPrecompile || evm.StateDB.GetCodeSize(recipient) > 0) {
+			return errorsmod.Wrapf(ErrCircuitBreakerTripped, "call to %s is paused", recipient)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		circuitBreaker CircuitBreaker
+		errContains    string
+	}{
+		{"empty", CircuitBreaker{}, ""},
+		{"valid", CircuitBreaker{PauseCall: true, PausedPrecompiles: []string{StakingPrecompileAddress}, ExpiryHeight: 100}, ""},
+		{"invalid precompile", CircuitBreaker{PausedPrecompiles: []string{"0x"}}, "invalid paused precompile"},
+		{"duplicate precompile", CircuitBreaker{PausedPrecompiles: []string{StakingPrecompileAddress, StakingPrecompileAddress}}, "duplicate paused precompile"},
+		{"negative expiry height", CircuitBreaker{PauseCreate: true, ExpiryHeight: -1}, "cannot be negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.circuitBreaker.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestCircuitBreakerIsTripped(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, CircuitBreaker{}.IsTripped(1))
+	require.False(t, CircuitBreaker{ExpiryHeight: 10}.IsTripped(1))
+
+	require.True(t, CircuitBreaker{PauseCall: true}.IsTripped(1))
+	require.True(t, CircuitBreaker{PauseCreate: true, ExpiryHeight: 10}.IsTripped(9))
+	require.False(t, CircuitBreaker{PauseCreate: true, ExpiryHeight: 10}.IsTripped(10))
+	require.True(t, CircuitBreaker{PausedPrecompiles: []string{StakingPrecompileAddress}}.IsTripped(1))
+}
+
+func TestCircuitBreakerIsPrecompilePaused(t *testing.T) {
+	t.Parallel()
+
+	circuitBreaker := CircuitBreaker{PausedPrecompiles: []string{"0x0000000000000000000000000000000000000800"}}
+	require.True(t, circuitBreaker.IsPrecompilePaused(common.HexToAddress(StakingPrecompileAddress)))
+	require.False(t, circuitBreaker.IsPrecompilePaused(common.HexToAddress(DistributionPrecompileAddress)))
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxCodeMetadataURILength is the maximum length of the metadata URI published
+// for a code hash.
+const MaxCodeMetadataURILength = 256
+
+// NewCodeMetadata returns the metadata published by the given account for a
+// code hash.
+func NewCodeMetadata(codeHash common.Hash, publisher sdk.AccAddress, metadataURI string) CodeMetadata {
+	return CodeMetadata{
+		CodeHash:    codeHash.Hex(),
+		Publisher:   publisher.String(),
+		MetadataUri: metadataURI,
+	}
+}
+
+// Validate performs a basic validation of the CodeMetadata fields.
+func (m CodeMetadata) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Publisher); err != nil {
+		return fmt.Errorf("invalid publisher address %q: %w", m.Publisher, err)
+	}
+
+	return ValidateCodeMetadata(m.CodeHash, m.MetadataUri)
+}
+
+// ValidateCodeMetadata validates the code hash and the metadata URI published
+// for it.
+func ValidateCodeMetadata(codeHash, metadataURI string) error {
+	bz, err := hexutil.Decode(codeHash)
+	if err != nil || len(bz) != common.HashLength {
+		return fmt.Errorf("code hash %q is not a valid hex encoded hash", codeHash)
+	}
+
+	if IsEmptyCodeHash(bz) {
+		return fmt.Errorf("code hash %s is the empty code hash", codeHash)
+	}
+
+	if metadataURI == "" {
+		return fmt.Errorf("metadata uri cannot be empty")
+	}
+
+	if len(metadataURI) > MaxCodeMetadataURILength {
+		return fmt.Errorf("metadata uri length %d exceeds the maximum of %d", len(metadataURI), MaxCodeMetadataURILength)
+	}
+
+	return nil
+}
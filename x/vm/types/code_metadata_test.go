@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCodeMetadata_Validate(t *testing.T) {
+	codeHash := crypto.Keccak256Hash([]byte{0x60, 0x80})
+	publisher := sdk.AccAddress(common.HexToAddress("0x1234567890123456789012345678901234567890").Bytes())
+
+	tests := []struct {
+		name     string
+		metadata CodeMetadata
+		errorMsg string
+	}{
+		{
+			name:     "valid metadata",
+			metadata: NewCodeMetadata(codeHash, publisher, "ipfs://metadata"),
+		},
+		{
+			name:     "invalid publisher",
+			metadata: CodeMetadata{CodeHash: codeHash.Hex(), Publisher: "invalid", MetadataUri: "ipfs://metadata"},
+			errorMsg: "invalid publisher address",
+		},
+		{
+			name:     "short code hash",
+			metadata: CodeMetadata{CodeHash: "0x1234", Publisher: publisher.String(), MetadataUri: "ipfs://metadata"},
+			errorMsg: "not a valid hex encoded hash",
+		},
+		{
+			name:     "empty code hash",
+			metadata: NewCodeMetadata(common.BytesToHash(EmptyCodeHash), publisher, "ipfs://metadata"),
+			errorMsg: "empty code hash",
+		},
+		{
+			name:     "empty metadata uri",
+			metadata: NewCodeMetadata(codeHash, publisher, ""),
+			errorMsg: "metadata uri cannot be empty",
+		},
+		{
+			name:     "metadata uri too long",
+			metadata: NewCodeMetadata(codeHash, publisher, strings.Repeat("a", MaxCodeMetadataURILength+1)),
+			errorMsg: "exceeds the maximum",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.metadata.Validate()
+			if tt.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errorMsg)
+			}
+		})
+	}
+}
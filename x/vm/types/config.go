@@ -38,6 +38,10 @@ func (ec *EVMConfigurator) Configure() error {
 		return err
 	}
 
+	if err := vm.ExtendActivators(map[int]func(*vm.JumpTable){GasOverridesEIP: EnableGasOverrides}); err != nil {
+		return err
+	}
+
 	// After applying modifiers the configurator is sealed. This way, it is not possible
 	// to call the configure method twice.
 	ec.sealed = true
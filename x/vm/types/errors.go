@@ -32,6 +32,10 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrBatchTxFailed
+	codeErrUnavailablePrecompile
+	codeErrCircuitBreakerTripped
+	codeErrInvalidCodeMetadata
 )
 
 var (
@@ -92,6 +96,18 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrBatchTxFailed returns an error if a transaction of an atomic batch fails
+	ErrBatchTxFailed = errorsmod.Register(ModuleName, codeErrBatchTxFailed, "batched ethereum transaction failed")
+
+	// ErrUnavailablePrecompile returns an error if a precompile is not compiled in the binary
+	ErrUnavailablePrecompile = errorsmod.Register(ModuleName, codeErrUnavailablePrecompile, "precompile not available")
+
+	// ErrCircuitBreakerTripped returns an error if an EVM operation is paused by the circuit breaker
+	ErrCircuitBreakerTripped = errorsmod.Register(ModuleName, codeErrCircuitBreakerTripped, "EVM operation paused by the circuit breaker")
+
+	// ErrInvalidCodeMetadata returns an error if a code metadata is invalid
+	ErrInvalidCodeMetadata = errorsmod.Register(ModuleName, codeErrInvalidCodeMetadata, "invalid code metadata")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/evm/x/vm/types"
@@ -49,3 +50,25 @@ func TestNewExecErrorWithReason(t *testing.T) {
 		require.Equal(t, 3, errWithReason.ErrorCode())
 	}
 }
+
+func TestFailureReason(t *testing.T) {
+	reason, err := types.RevertReasonBytes("COUNTER_TOO_LOW")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		response types.MsgEthereumTxResponse
+		expected string
+	}{
+		{"success", types.MsgEthereumTxResponse{Ret: []byte{1}}, ""},
+		{"vm error", types.MsgEthereumTxResponse{VmError: vm.ErrOutOfGas.Error()}, "out of gas"},
+		{"revert without data", types.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error()}, "execution reverted"},
+		{"revert with reason", types.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: reason}, "execution reverted: COUNTER_TOO_LOW"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.response.FailureReason())
+		})
+	}
+}
@@ -1,11 +1,23 @@
 package types
 
+import (
+	"encoding/hex"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Evm module events
 const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeFeeMarket  = "evm_fee_market"
 
+	EventTypeFeeConversion = "fee_conversion"
+
+	EventTypeRegisterCodeMetadata = "register_code_metadata"
+
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -15,6 +27,12 @@ const (
 	AttributeKeyTxGasUsed       = "txGasUsed"
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
+	AttributeKeyFeeDenom        = "fee_denom"
+	AttributeKeyFeeRate         = "rate"
+	AttributeKeyConvertedFee    = "converted_fee"
+	AttributeKeyCodeHash        = "code_hash"
+	AttributeKeyPublisher       = "publisher"
+	AttributeKeyMetadataURI     = "metadata_uri"
 
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
@@ -24,3 +42,13 @@ const (
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
 )
+
+// CometTxHash returns the hex encoded CometBFT hash of the transaction carried
+// by the context, or an empty string if the context doesn't carry its bytes
+// (e.g. in queries and simulations).
+func CometTxHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(cmttypes.Tx(ctx.TxBytes()).Hash())
+}
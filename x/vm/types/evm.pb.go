@@ -72,6 +72,15 @@ type Params struct {
 	// precompiled contracts that are active
 	ActiveStaticPrecompiles []string `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64   `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
+	// allowed_msg_type_urls defines the type URLs of the Cosmos SDK messages that
+	// can be executed through the message router precompile
+	AllowedMsgTypeURLs []string `protobuf:"bytes,11,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// circuit_breaker defines the EVM operations paused chain-wide for incident
+	// response
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,12,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+	// gas_overrides defines the chain specific overrides of the go-ethereum gas
+	// costs
+	GasOverrides GasOverrides `protobuf:"bytes,13,opt,name=gas_overrides,json=gasOverrides,proto3" json:"gas_overrides"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -149,6 +158,170 @@ func (m *Params) GetHistoryServeWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetAllowedMsgTypeURLs() []string {
+	if m != nil {
+		return m.AllowedMsgTypeURLs
+	}
+	return nil
+}
+
+func (m *Params) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return CircuitBreaker{}
+}
+
+func (m *Params) GetGasOverrides() GasOverrides {
+	if m != nil {
+		return m.GasOverrides
+	}
+	return GasOverrides{}
+}
+
+// CircuitBreaker defines the EVM operations paused chain-wide. The value
+// transfers to accounts without code are never paused.
+type CircuitBreaker struct {
+	// pause_create pauses the contract creations
+	PauseCreate bool `protobuf:"varint,1,opt,name=pause_create,json=pauseCreate,proto3" json:"pause_create,omitempty"`
+	// pause_call pauses the calls to contracts and precompiles
+	PauseCall bool `protobuf:"varint,2,opt,name=pause_call,json=pauseCall,proto3" json:"pause_call,omitempty"`
+	// paused_precompiles defines the hex addresses of the precompiled contracts
+	// that are paused
+	PausedPrecompiles []string `protobuf:"bytes,3,rep,name=paused_precompiles,json=pausedPrecompiles,proto3" json:"paused_precompiles,omitempty"`
+	// expiry_height defines the block height from which the circuit breaker is
+	// lifted. The circuit breaker has no expiry if it's zero.
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetPauseCreate() bool {
+	if m != nil {
+		return m.PauseCreate
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetPauseCall() bool {
+	if m != nil {
+		return m.PauseCall
+	}
+	return false
+}
+
+func (m *CircuitBreaker) GetPausedPrecompiles() []string {
+	if m != nil {
+		return m.PausedPrecompiles
+	}
+	return nil
+}
+
+func (m *CircuitBreaker) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// GasOverrides defines overrides of the go-ethereum gas costs. A zero value
+// keeps the go-ethereum default cost.
+type GasOverrides struct {
+	// tx_data_zero_gas is the intrinsic gas cost of a zero transaction data byte
+	TxDataZeroGas uint64 `protobuf:"varint,1,opt,name=tx_data_zero_gas,json=txDataZeroGas,proto3" json:"tx_data_zero_gas,omitempty"`
+	// tx_data_non_zero_gas is the intrinsic gas cost of a non-zero transaction
+	// data byte
+	TxDataNonZeroGas uint64 `protobuf:"varint,2,opt,name=tx_data_non_zero_gas,json=txDataNonZeroGas,proto3" json:"tx_data_non_zero_gas,omitempty"`
+	// sstore_set_gas is the gas cost of an SSTORE setting a storage slot from
+	// zero to a non-zero value
+	SstoreSetGas uint64 `protobuf:"varint,3,opt,name=sstore_set_gas,json=sstoreSetGas,proto3" json:"sstore_set_gas,omitempty"`
+}
+
+func (m *GasOverrides) Reset()         { *m = GasOverrides{} }
+func (m *GasOverrides) String() string { return proto.CompactTextString(m) }
+func (*GasOverrides) ProtoMessage()    {}
+func (*GasOverrides) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
+}
+func (m *GasOverrides) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasOverrides) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasOverrides.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasOverrides) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasOverrides.Merge(m, src)
+}
+func (m *GasOverrides) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasOverrides) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasOverrides.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasOverrides proto.InternalMessageInfo
+
+func (m *GasOverrides) GetTxDataZeroGas() uint64 {
+	if m != nil {
+		return m.TxDataZeroGas
+	}
+	return 0
+}
+
+func (m *GasOverrides) GetTxDataNonZeroGas() uint64 {
+	if m != nil {
+		return m.TxDataNonZeroGas
+	}
+	return 0
+}
+
+func (m *GasOverrides) GetSstoreSetGas() uint64 {
+	if m != nil {
+		return m.SstoreSetGas
+	}
+	return 0
+}
+
 // AccessControl defines the permission policy of the EVM
 // for creating and calling contracts
 type AccessControl struct {
@@ -162,7 +335,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,7 +396,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,13 +500,16 @@ type ChainConfig struct {
 	VerkleTime *cosmossdk_io_math.Int `protobuf:"bytes,30,opt,name=verkle_time,json=verkleTime,proto3,customtype=cosmossdk.io/math.Int" json:"verkle_time,omitempty" yaml:"verkle_time"`
 	// osaka_time: Osaka switch time (nil = no fork, 0 = already on osaka)
 	OsakaTime *cosmossdk_io_math.Int `protobuf:"bytes,31,opt,name=osaka_time,json=osakaTime,proto3,customtype=cosmossdk.io/math.Int" json:"osaka_time,omitempty" yaml:"osaka_time"`
+	// p256_block: RIP-7212 secp256r1 verification precompile activation block
+	// (nil = not active, 0 = already active)
+	P256Block *cosmossdk_io_math.Int `protobuf:"bytes,32,opt,name=p256_block,json=p256Block,proto3,customtype=cosmossdk.io/math.Int" json:"p256_block,omitempty" yaml:"p256_block"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +578,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +635,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +713,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +835,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +876,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,7 +936,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,7 +1057,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -931,9 +1107,77 @@ func (m *Preinstall) GetCode() string {
 	return ""
 }
 
+// CodeMetadata defines the metadata published by an account for a contract code
+// hash, used as an anchor to verify the source of the deployed contracts.
+type CodeMetadata struct {
+	// code_hash is the hex encoded hash of the contract code
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// publisher is the bech32 address of the account that published the metadata
+	Publisher string `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// metadata_uri is the location of the compiler metadata of the contract, e.g.
+	// ipfs://<cid>
+	MetadataUri string `protobuf:"bytes,3,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+}
+
+func (m *CodeMetadata) Reset()         { *m = CodeMetadata{} }
+func (m *CodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadata) ProtoMessage()    {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
+}
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
+func (m *CodeMetadata) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetPublisher() string {
+	if m != nil {
+		return m.Publisher
+	}
+	return ""
+}
+
+func (m *CodeMetadata) GetMetadataUri() string {
+	if m != nil {
+		return m.MetadataUri
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*CircuitBreaker)(nil), "cosmos.evm.vm.v1.CircuitBreaker")
+	proto.RegisterType((*GasOverrides)(nil), "cosmos.evm.vm.v1.GasOverrides")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
 	proto.RegisterType((*ChainConfig)(nil), "cosmos.evm.vm.v1.ChainConfig")
@@ -944,138 +1188,156 @@ func init() {
 	proto.RegisterType((*AccessTuple)(nil), "cosmos.evm.vm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "cosmos.evm.vm.v1.TraceConfig")
 	proto.RegisterType((*Preinstall)(nil), "cosmos.evm.vm.v1.Preinstall")
+	proto.RegisterType((*CodeMetadata)(nil), "cosmos.evm.vm.v1.CodeMetadata")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd2, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x6d,
-	0x0f, 0xaa, 0x91, 0x4a, 0x96, 0x1c, 0xb5, 0x86, 0xd3, 0x0f, 0x88, 0x32, 0xd3, 0x4a, 0xb5, 0x1d,
-	0x61, 0xa8, 0xc6, 0x48, 0xd1, 0x62, 0x31, 0xdc, 0x1d, 0x2f, 0x37, 0xda, 0xdd, 0x21, 0x66, 0x96,
-	0xb4, 0xd8, 0x3f, 0xd0, 0xc0, 0xa7, 0xf4, 0x07, 0x18, 0x08, 0xd0, 0x4b, 0x8e, 0x39, 0xf4, 0x07,
-	0xf4, 0x98, 0x63, 0x8e, 0x45, 0x81, 0x2e, 0x0a, 0xfa, 0x10, 0x40, 0x47, 0xfd, 0x82, 0x62, 0x3e,
-	0xf8, 0x29, 0x85, 0x55, 0x00, 0xc1, 0x9e, 0xe7, 0xfd, 0x78, 0x9e, 0xf9, 0x78, 0x77, 0xe7, 0x5d,
-	0x82, 0xaa, 0x47, 0x79, 0x4c, 0xf9, 0x0e, 0xe9, 0xc6, 0x3b, 0xe2, 0x6f, 0x57, 0x8c, 0xb6, 0xdb,
-	0x8c, 0xa6, 0x14, 0x5a, 0xca, 0xb7, 0x2d, 0x2c, 0xe2, 0x6f, 0xb7, 0x7a, 0x0b, 0xc7, 0x61, 0x42,
-	0x77, 0xe4, 0xbf, 0x2a, 0xa8, 0x5a, 0x0e, 0x68, 0x40, 0xe5, 0x70, 0x47, 0x8c, 0x94, 0xd5, 0xf9,
-	0x47, 0x1e, 0x2c, 0x9e, 0x60, 0x86, 0x63, 0x0e, 0x77, 0x41, 0x81, 0x74, 0x63, 0xd7, 0x27, 0x09,
-	0x8d, 0x2b, 0xb9, 0xcd, 0xdc, 0x56, 0xa1, 0x56, 0xbe, 0xcc, 0x6c, 0xab, 0x87, 0xe3, 0xe8, 0x89,
-	0x33, 0x74, 0x39, 0xc8, 0x24, 0xdd, 0xf8, 0xa9, 0x18, 0xc2, 0x03, 0x00, 0xc8, 0x79, 0xca, 0xb0,
-	0x4b, 0xc2, 0x36, 0xaf, 0x18, 0x9b, 0xf9, 0xad, 0x7c, 0xcd, 0xe9, 0x67, 0x76, 0xa1, 0x2e, 0xac,
-	0xf5, 0xa3, 0x13, 0x7e, 0x99, 0xd9, 0xb7, 0x34, 0xc1, 0x30, 0xd0, 0x41, 0x05, 0x09, 0xea, 0x61,
-	0x9b, 0xc3, 0x3d, 0x50, 0x12, 0xd4, 0x5e, 0x0b, 0x27, 0x09, 0x89, 0x78, 0x65, 0x69, 0x33, 0xbf,
-	0x55, 0xa8, 0xad, 0xf6, 0x33, 0xbb, 0x58, 0xff, 0xe4, 0xf9, 0xa1, 0x36, 0xa3, 0x22, 0xe9, 0xc6,
-	0x03, 0x00, 0xff, 0x0c, 0x56, 0xb0, 0xe7, 0x11, 0xce, 0x5d, 0x8f, 0x26, 0x29, 0xa3, 0x51, 0xc5,
-	0xdc, 0xcc, 0x6d, 0x15, 0xf7, 0xec, 0xed, 0xe9, 0x8d, 0xd8, 0x3e, 0x90, 0x71, 0x87, 0x2a, 0xac,
-	0x76, 0xfb, 0x9b, 0xcc, 0x9e, 0xeb, 0x67, 0xf6, 0xf2, 0x84, 0x19, 0x2d, 0xe3, 0x71, 0x08, 0x9f,
-	0x80, 0xbb, 0xd8, 0x4b, 0xc3, 0x2e, 0x71, 0x79, 0x8a, 0xd3, 0xd0, 0x73, 0xdb, 0x8c, 0x78, 0x34,
-	0x6e, 0x87, 0x11, 0xe1, 0x95, 0x82, 0x98, 0x1f, 0xba, 0xa3, 0x02, 0x1a, 0xd2, 0x7f, 0x32, 0x72,
-	0xc3, 0x87, 0xa0, 0xdc, 0x0a, 0x79, 0x4a, 0x59, 0xcf, 0xe5, 0x84, 0x75, 0x89, 0xfb, 0x3a, 0x4c,
-	0x7c, 0xfa, 0xba, 0x02, 0x36, 0x73, 0x5b, 0x06, 0x82, 0xda, 0xd7, 0x10, 0xae, 0x97, 0xd2, 0xf3,
-	0xe4, 0xde, 0x9b, 0xef, 0xbe, 0x7e, 0xb0, 0x3e, 0x76, 0xba, 0xe7, 0xe2, 0x7c, 0xd5, 0x99, 0x1c,
-	0x1b, 0xe6, 0xbc, 0x95, 0x3f, 0x36, 0xcc, 0xbc, 0x65, 0x1c, 0x1b, 0xe6, 0x82, 0xb5, 0x78, 0x6c,
-	0x98, 0x8b, 0xd6, 0x92, 0xf3, 0xb7, 0x1c, 0x98, 0x5c, 0x03, 0x3c, 0x00, 0x8b, 0x1e, 0x23, 0x38,
-	0x25, 0xf2, 0xe8, 0x8a, 0x7b, 0x3f, 0xfe, 0x3f, 0x7b, 0x71, 0xda, 0x6b, 0x93, 0x9a, 0x21, 0xf6,
-	0x03, 0xe9, 0x44, 0xf8, 0x2b, 0x60, 0x78, 0x38, 0x8a, 0x2a, 0xf3, 0x3f, 0x94, 0x40, 0xa6, 0x39,
-	0xff, 0xc9, 0x81, 0x5b, 0x57, 0x22, 0xa0, 0x07, 0x8a, 0xfa, 0xac, 0xd2, 0x5e, 0x5b, 0x4d, 0x6e,
-	0x65, 0xef, 0xfe, 0xf7, 0x71, 0x4b, 0xd2, 0x9f, 0xf4, 0x33, 0x1b, 0x8c, 0xf0, 0x65, 0x66, 0x43,
-	0x55, 0x42, 0x63, 0x44, 0x0e, 0x02, 0x78, 0x18, 0x01, 0x3d, 0xb0, 0x36, 0x59, 0x10, 0x6e, 0x14,
-	0xf2, 0xb4, 0x32, 0x2f, 0x6b, 0xe9, 0x51, 0x3f, 0xb3, 0x27, 0x27, 0xf6, 0x2c, 0xe4, 0xe9, 0x65,
-	0x66, 0x57, 0x27, 0x58, 0xc7, 0x33, 0x1d, 0x74, 0x0b, 0x4f, 0x27, 0x38, 0x5f, 0x59, 0xa0, 0x78,
-	0xd8, 0xc2, 0x61, 0x72, 0x48, 0x93, 0x57, 0x61, 0x00, 0xff, 0x04, 0x56, 0x5b, 0x34, 0x26, 0x3c,
-	0x25, 0xd8, 0x77, 0x9b, 0x11, 0xf5, 0xce, 0xf4, 0x53, 0xf3, 0xe8, 0xdf, 0x99, 0x7d, 0x5b, 0x2d,
-	0x90, 0xfb, 0x67, 0xdb, 0x21, 0xdd, 0x89, 0x71, 0xda, 0xda, 0x3e, 0x4a, 0x84, 0xe8, 0xba, 0x12,
-	0x9d, 0xca, 0x74, 0xd0, 0xca, 0xd0, 0x52, 0x13, 0x06, 0xd8, 0x02, 0x2b, 0x3e, 0xa6, 0xee, 0x2b,
-	0xca, 0xce, 0x34, 0xf9, 0xbc, 0x24, 0xaf, 0x7d, 0x2f, 0x79, 0x3f, 0xb3, 0x4b, 0x4f, 0x0f, 0x3e,
-	0xfe, 0x88, 0xb2, 0x33, 0x49, 0x71, 0x99, 0xd9, 0xb7, 0x95, 0xd8, 0x24, 0x91, 0x83, 0x4a, 0x3e,
-	0xa6, 0xc3, 0x30, 0xf8, 0x12, 0x58, 0xc3, 0x00, 0xde, 0x69, 0xb7, 0x29, 0x4b, 0x2b, 0xf9, 0xcd,
-	0xdc, 0x96, 0x59, 0xfb, 0x59, 0x3f, 0xb3, 0x57, 0x34, 0x65, 0x43, 0x79, 0x2e, 0x33, 0xfb, 0xce,
-	0x14, 0xa9, 0xce, 0x71, 0xd0, 0x8a, 0xa6, 0xd5, 0xa1, 0xb0, 0x09, 0x4a, 0x24, 0x6c, 0xef, 0xee,
-	0x3f, 0xd4, 0x0b, 0x30, 0xe4, 0x02, 0x7e, 0x33, 0x6b, 0x01, 0xc5, 0xfa, 0xd1, 0xc9, 0xee, 0xfe,
-	0xc3, 0xc1, 0xfc, 0xd7, 0xf4, 0xab, 0x63, 0x8c, 0xc5, 0x41, 0x45, 0x05, 0xd5, 0xe4, 0x07, 0x1a,
-	0xfb, 0x5a, 0x63, 0xf1, 0xa6, 0x1a, 0xfb, 0xd7, 0x69, 0xec, 0x4f, 0x6a, 0xec, 0x4f, 0x6a, 0x3c,
-	0xd6, 0x1a, 0x4b, 0x37, 0xd5, 0x78, 0x7c, 0x9d, 0xc6, 0xe3, 0x49, 0x0d, 0x15, 0x23, 0x8a, 0xa9,
-	0xd9, 0xfb, 0x0b, 0x4e, 0xd2, 0xb0, 0x13, 0x6b, 0x19, 0xf3, 0xc6, 0xc5, 0x34, 0x95, 0xe9, 0xa0,
-	0x95, 0xa1, 0x45, 0xb1, 0x9f, 0x81, 0xb2, 0x47, 0x13, 0x9e, 0x0a, 0x5b, 0x42, 0xdb, 0x11, 0xd1,
-	0x12, 0x05, 0x29, 0xf1, 0x78, 0x96, 0xc4, 0x3d, 0x25, 0x71, 0x5d, 0xba, 0x83, 0xd6, 0x26, 0xcd,
-	0x4a, 0xcc, 0x05, 0x56, 0x9b, 0xa4, 0x84, 0xf1, 0x66, 0x87, 0x05, 0x5a, 0x08, 0x48, 0xa1, 0x0f,
-	0x66, 0x09, 0xe9, 0xb2, 0x9a, 0x4e, 0x75, 0xd0, 0xea, 0xc8, 0xa4, 0x04, 0x3e, 0x05, 0x2b, 0xa1,
-	0x50, 0x6d, 0x76, 0x22, 0x4d, 0x5f, 0x94, 0xf4, 0x7b, 0xb3, 0xe8, 0xf5, 0xa3, 0x30, 0x99, 0xe8,
-	0xa0, 0xe5, 0x81, 0x41, 0x51, 0xfb, 0x00, 0xc6, 0x9d, 0x90, 0xb9, 0x41, 0x84, 0xbd, 0x90, 0x30,
-	0x4d, 0x5f, 0x92, 0xf4, 0x3f, 0x9f, 0x45, 0x7f, 0x57, 0xd1, 0x5f, 0x4d, 0x76, 0x90, 0x25, 0x8c,
-	0xbf, 0x55, 0x36, 0xa5, 0xd2, 0x00, 0xa5, 0x26, 0x61, 0x51, 0x98, 0x68, 0xfe, 0x65, 0xc9, 0xff,
-	0x70, 0x16, 0xbf, 0xae, 0xa0, 0xf1, 0x34, 0x07, 0x15, 0x15, 0x1c, 0x92, 0x46, 0x34, 0xf1, 0xe9,
-	0x80, 0xf4, 0xd6, 0x8d, 0x49, 0xc7, 0xd3, 0x1c, 0x54, 0x54, 0x50, 0x91, 0x06, 0x60, 0x0d, 0x33,
-	0x46, 0x5f, 0x4f, 0x6d, 0x08, 0x94, 0xdc, 0xbf, 0x98, 0xc5, 0x3d, 0x78, 0xb9, 0x5e, 0xcd, 0x16,
-	0x2f, 0x57, 0x61, 0x9d, 0xd8, 0x12, 0x1f, 0xc0, 0x80, 0xe1, 0xde, 0x94, 0x4e, 0xf9, 0xc6, 0x1b,
-	0x7f, 0x35, 0xd9, 0x41, 0x96, 0x30, 0x4e, 0xa8, 0x7c, 0x06, 0xca, 0x31, 0x61, 0x01, 0x71, 0x13,
-	0x92, 0xf2, 0x76, 0x14, 0xa6, 0x5a, 0xe7, 0xf6, 0x8d, 0x9f, 0x83, 0xeb, 0xd2, 0x1d, 0x04, 0xa5,
-	0xf9, 0x85, 0xb6, 0x2a, 0xad, 0xbb, 0xc0, 0xf4, 0xc4, 0x6d, 0xe1, 0x86, 0x7e, 0xa5, 0x22, 0x6f,
-	0xff, 0x25, 0x89, 0x8f, 0x7c, 0x58, 0x06, 0x0b, 0xaa, 0xcb, 0xba, 0x2b, 0x74, 0x91, 0x02, 0xb0,
-	0x0a, 0x4c, 0x9f, 0x78, 0x61, 0x8c, 0x23, 0x5e, 0xa9, 0xca, 0x84, 0x21, 0x86, 0x9f, 0x80, 0x65,
-	0xde, 0xc2, 0x49, 0xd0, 0xc2, 0xa1, 0x9b, 0x86, 0x31, 0xa9, 0xdc, 0x93, 0x33, 0xde, 0x9d, 0x35,
-	0xe3, 0xb2, 0x9a, 0xf1, 0x44, 0x9e, 0x83, 0x4a, 0x03, 0x7c, 0x1a, 0xc6, 0x04, 0x9e, 0x80, 0xa2,
-	0x87, 0x13, 0xaf, 0x93, 0x28, 0xd6, 0xfb, 0x92, 0x75, 0x67, 0x16, 0xab, 0xbe, 0x8a, 0xc7, 0xb2,
-	0x1c, 0x04, 0x14, 0x1a, 0x30, 0xb6, 0x19, 0x0e, 0x3a, 0x44, 0x31, 0xbe, 0x77, 0x63, 0xc6, 0xb1,
-	0x2c, 0x07, 0x01, 0x85, 0x06, 0x8c, 0x5d, 0xc2, 0xce, 0x22, 0xcd, 0xb8, 0x71, 0x63, 0xc6, 0xb1,
-	0x2c, 0x07, 0x01, 0x85, 0x24, 0xe3, 0x73, 0x00, 0x28, 0xc7, 0x67, 0x58, 0x11, 0xda, 0x92, 0x70,
-	0x7b, 0x16, 0xa1, 0x6e, 0x61, 0x47, 0x49, 0x0e, 0x2a, 0x48, 0x20, 0xe8, 0x86, 0x8d, 0xd9, 0xba,
-	0x75, 0xe7, 0xd8, 0x30, 0xef, 0x58, 0x15, 0x67, 0x07, 0x2c, 0x88, 0xd6, 0x90, 0x40, 0x0b, 0xe4,
-	0xcf, 0x48, 0x4f, 0xf5, 0x05, 0x48, 0x0c, 0xc5, 0xd9, 0x77, 0x71, 0xd4, 0x21, 0xea, 0x3a, 0x47,
-	0x0a, 0x38, 0x27, 0x60, 0xf5, 0x94, 0xe1, 0x84, 0x8b, 0xb6, 0x92, 0x26, 0xcf, 0x68, 0xc0, 0x21,
-	0x04, 0x46, 0x0b, 0xf3, 0x96, 0xce, 0x95, 0x63, 0xf8, 0x53, 0x60, 0x44, 0x34, 0xe0, 0xb2, 0xb1,
-	0x29, 0xee, 0xdd, 0xbe, 0xda, 0x45, 0x3d, 0xa3, 0x01, 0x92, 0x21, 0xce, 0x5f, 0xf3, 0x20, 0xff,
-	0x8c, 0x06, 0xb0, 0x02, 0x96, 0xb0, 0xef, 0x33, 0xc2, 0xb9, 0x66, 0x1a, 0x40, 0xb8, 0x0e, 0x16,
-	0x53, 0xda, 0x0e, 0x3d, 0x45, 0x57, 0x40, 0x1a, 0x09, 0x61, 0x1f, 0xa7, 0x58, 0xf6, 0x00, 0x25,
-	0x24, 0xc7, 0xa2, 0x4b, 0x97, 0xa5, 0xee, 0x26, 0x9d, 0xb8, 0x49, 0x98, 0xbc, 0xca, 0x8d, 0xda,
-	0xea, 0x45, 0x66, 0x17, 0xa5, 0xfd, 0x85, 0x34, 0xa3, 0x71, 0x00, 0xdf, 0x07, 0x4b, 0xe9, 0xb9,
-	0x2b, 0xd7, 0xb0, 0x20, 0xb7, 0x78, 0xed, 0x22, 0xb3, 0x57, 0xd3, 0xd1, 0x32, 0x7f, 0x87, 0x79,
-	0x0b, 0x2d, 0xa6, 0xe7, 0xe2, 0x7f, 0xb8, 0x03, 0xcc, 0xf4, 0xdc, 0x0d, 0x13, 0x9f, 0x9c, 0xcb,
-	0x4b, 0xdc, 0xa8, 0x95, 0x2f, 0x32, 0xdb, 0x1a, 0x0b, 0x3f, 0x12, 0x3e, 0xb4, 0x94, 0x9e, 0xcb,
-	0x01, 0x7c, 0x1f, 0x00, 0x35, 0x25, 0xa9, 0xa0, 0xee, 0xe4, 0xe5, 0x8b, 0xcc, 0x2e, 0x48, 0xab,
-	0xe4, 0x1e, 0x0d, 0xa1, 0x03, 0x16, 0x14, 0xb7, 0x29, 0xb9, 0x4b, 0x17, 0x99, 0x6d, 0x46, 0x34,
-	0x50, 0x9c, 0xca, 0x25, 0xb6, 0x8a, 0x91, 0x98, 0x76, 0x89, 0x2f, 0x2f, 0x46, 0x13, 0x0d, 0x20,
-	0xfc, 0x10, 0xac, 0x2a, 0x2d, 0x71, 0xf6, 0x3c, 0xc5, 0x71, 0x5b, 0x35, 0xf4, 0x35, 0x78, 0x91,
-	0xd9, 0x2b, 0xd2, 0x75, 0x3a, 0xf0, 0xa0, 0x29, 0xec, 0x7c, 0x31, 0x0f, 0xcc, 0xd3, 0x73, 0x44,
-	0x78, 0x27, 0x4a, 0xe1, 0x47, 0xc0, 0x92, 0x8d, 0x26, 0xf6, 0x52, 0x77, 0xe2, 0x5c, 0x6a, 0xf7,
-	0x46, 0x77, 0xe0, 0x74, 0x84, 0x83, 0x56, 0x07, 0xa6, 0x03, 0x7d, 0x78, 0x65, 0xb0, 0xd0, 0x8c,
-	0x28, 0x8d, 0x65, 0x19, 0x95, 0x90, 0x02, 0xf0, 0xa5, 0xdc, 0x72, 0x59, 0x22, 0x79, 0xd9, 0xc4,
-	0xff, 0xe8, 0x6a, 0x89, 0x4c, 0xd5, 0x59, 0xed, 0x9e, 0x68, 0xe1, 0x2f, 0x33, 0x7b, 0x45, 0x69,
-	0xeb, 0x7c, 0xe7, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0x13, 0xa7, 0x23, 0x8b, 0xd1, 0x02, 0x79, 0x46,
-	0x52, 0x79, 0xec, 0x25, 0x24, 0x86, 0xe2, 0x6d, 0xc5, 0x48, 0x97, 0xb0, 0x94, 0xf8, 0xf2, 0x78,
-	0x4d, 0x34, 0xc4, 0xe2, 0xd5, 0x17, 0x60, 0xee, 0x76, 0x38, 0xf1, 0xd5, 0x59, 0xa2, 0xa5, 0x00,
-	0xf3, 0x3f, 0x70, 0xe2, 0x3f, 0x31, 0x3e, 0xff, 0xd2, 0x9e, 0x73, 0x30, 0x28, 0xea, 0xfe, 0xbe,
-	0xd3, 0x8e, 0xc8, 0x8c, 0x1a, 0xdd, 0x03, 0x25, 0xf1, 0xc1, 0x84, 0x03, 0xe2, 0x9e, 0x91, 0x9e,
-	0xae, 0x54, 0x55, 0x77, 0xda, 0xfe, 0x7b, 0xd2, 0xe3, 0x68, 0x1c, 0x68, 0x89, 0x2f, 0x0d, 0x50,
-	0x3c, 0x65, 0xd8, 0x23, 0xba, 0x5b, 0x17, 0xd5, 0x2e, 0x20, 0xd3, 0x12, 0x1a, 0x09, 0x6d, 0x71,
-	0xa8, 0xb4, 0x93, 0xea, 0x27, 0x72, 0x00, 0x45, 0x06, 0x23, 0xe4, 0x9c, 0x78, 0x72, 0x2f, 0x0d,
-	0xa4, 0x11, 0xdc, 0x07, 0xcb, 0x7e, 0xc8, 0x71, 0x33, 0x92, 0xdf, 0x87, 0xde, 0x99, 0x5a, 0x7e,
-	0xcd, 0xba, 0xc8, 0xec, 0x92, 0x76, 0x34, 0x84, 0x1d, 0x4d, 0x20, 0x51, 0x43, 0xa3, 0x34, 0x39,
-	0x5b, 0xb9, 0x37, 0xa6, 0xaa, 0xa1, 0x61, 0xa8, 0xf4, 0xa0, 0x29, 0xac, 0x6e, 0x8c, 0x66, 0x27,
-	0x90, 0xe5, 0x6b, 0x22, 0x05, 0x84, 0x35, 0x0a, 0xe3, 0x30, 0x95, 0xe5, 0xba, 0x80, 0x14, 0x80,
-	0x1f, 0x82, 0x02, 0xed, 0x12, 0xc6, 0x42, 0x9f, 0x70, 0x59, 0xa6, 0xc5, 0xbd, 0xf7, 0xae, 0x96,
-	0xc1, 0xd8, 0x97, 0x0c, 0x1a, 0xc5, 0x8b, 0xc5, 0x91, 0x44, 0x4e, 0x32, 0x26, 0x31, 0x65, 0x3d,
-	0xd9, 0x5a, 0xe9, 0xc5, 0x29, 0xc7, 0x73, 0x69, 0x47, 0x13, 0x08, 0xd6, 0x00, 0xd4, 0x69, 0x8c,
-	0xa4, 0x1d, 0x96, 0xb8, 0xf2, 0x0d, 0x52, 0x92, 0xb9, 0xf2, 0x39, 0x56, 0x5e, 0x24, 0x9d, 0x4f,
-	0x71, 0x8a, 0xd1, 0x15, 0x0b, 0xfc, 0x35, 0x80, 0xea, 0x4c, 0xdc, 0xcf, 0x38, 0x4d, 0xc4, 0xf7,
-	0xd8, 0xab, 0x30, 0xd0, 0xbd, 0x91, 0xd4, 0x57, 0x5e, 0x3d, 0x67, 0x4b, 0xa1, 0x63, 0x4e, 0xf5,
-	0x2a, 0x8e, 0x0d, 0xd3, 0xb0, 0x16, 0x8e, 0x0d, 0x73, 0xc9, 0x32, 0x87, 0xfb, 0xa7, 0x57, 0x81,
-	0xd6, 0x06, 0x78, 0x6c, 0x7a, 0xce, 0x0b, 0x00, 0x4e, 0x18, 0x09, 0x45, 0x07, 0x1b, 0x45, 0xe2,
-	0xb5, 0x97, 0xe0, 0x98, 0x0c, 0xde, 0xb7, 0x62, 0x3c, 0x5e, 0x98, 0xf3, 0x93, 0x85, 0x09, 0x81,
-	0xe1, 0x51, 0x9f, 0xc8, 0xd2, 0x28, 0x20, 0x39, 0x7e, 0xf0, 0xcf, 0x1c, 0x18, 0xfb, 0x6c, 0x85,
-	0xbf, 0x04, 0xd5, 0x83, 0xc3, 0xc3, 0x7a, 0xa3, 0xe1, 0x9e, 0x7e, 0x7a, 0x52, 0x77, 0x4f, 0xea,
-	0xe8, 0xf9, 0x51, 0xa3, 0x71, 0xf4, 0xf1, 0x8b, 0x67, 0xf5, 0x46, 0xc3, 0x9a, 0xab, 0xde, 0x7f,
-	0xf3, 0x76, 0xb3, 0x32, 0x8a, 0x3f, 0x21, 0x2c, 0x0e, 0x39, 0x0f, 0x69, 0x12, 0x09, 0x81, 0x0f,
-	0xc0, 0xfa, 0x78, 0x36, 0xaa, 0x37, 0x4e, 0xd1, 0xd1, 0xe1, 0x69, 0xfd, 0xa9, 0x95, 0xab, 0x56,
-	0xde, 0xbc, 0xdd, 0x2c, 0x8f, 0x32, 0x11, 0xe1, 0x29, 0x0b, 0x3d, 0xf1, 0xe4, 0x3d, 0x06, 0x95,
-	0xeb, 0x35, 0xeb, 0x4f, 0xad, 0xf9, 0x6a, 0xf5, 0xcd, 0xdb, 0xcd, 0xf5, 0xeb, 0x14, 0x89, 0x5f,
-	0x35, 0x3e, 0xff, 0xfb, 0xc6, 0x5c, 0xed, 0xc9, 0x37, 0xfd, 0x8d, 0xdc, 0xb7, 0xfd, 0x8d, 0xdc,
-	0x7f, 0xfb, 0x1b, 0xb9, 0x2f, 0xde, 0x6d, 0xcc, 0x7d, 0xfb, 0x6e, 0x63, 0xee, 0x5f, 0xef, 0x36,
-	0xe6, 0xfe, 0xb8, 0x19, 0x84, 0x69, 0xab, 0xd3, 0xdc, 0xf6, 0x68, 0xbc, 0x33, 0xfd, 0x63, 0x85,
-	0xf8, 0x20, 0xe7, 0xcd, 0x45, 0xf9, 0x8b, 0xd2, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc6,
-	0x17, 0xe6, 0x54, 0xaa, 0x12, 0x00, 0x00,
+	// 2273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x58, 0x4b, 0x6f, 0x23, 0xc7,
+	0x11, 0x5e, 0x8a, 0x94, 0x44, 0x36, 0x1f, 0x1a, 0xb5, 0x28, 0x2d, 0x97, 0xda, 0x95, 0xe4, 0xb1,
+	0x81, 0x6c, 0x8c, 0x58, 0x5a, 0x69, 0x2d, 0x47, 0x90, 0xf3, 0x80, 0xa8, 0x95, 0x6d, 0x39, 0x2b,
+	0x2d, 0xd1, 0xd4, 0xda, 0xf0, 0x22, 0xc1, 0x60, 0x38, 0x6c, 0x93, 0x63, 0xcd, 0x83, 0x98, 0xc7,
+	0xae, 0xe4, 0x73, 0x80, 0x18, 0x06, 0x02, 0x38, 0xe7, 0x20, 0x80, 0x81, 0x00, 0x41, 0x8e, 0xf9,
+	0x09, 0x39, 0xfa, 0xe8, 0xa3, 0x11, 0x20, 0x8b, 0xc0, 0x39, 0x18, 0xf0, 0x31, 0xbf, 0x20, 0xd5,
+	0xd5, 0x3d, 0xe4, 0x0c, 0x25, 0x33, 0x0a, 0x20, 0x8e, 0xba, 0x5e, 0x5f, 0x75, 0x57, 0xd7, 0x54,
+	0x57, 0x0f, 0x69, 0x5a, 0x7e, 0xe8, 0xfa, 0xe1, 0x16, 0x7f, 0xee, 0x6e, 0x89, 0xbf, 0x6d, 0x31,
+	0xda, 0x1c, 0x06, 0x7e, 0xe4, 0x53, 0x4d, 0xca, 0x36, 0x05, 0x47, 0xfc, 0x6d, 0x37, 0x17, 0x4d,
+	0xd7, 0xf6, 0xfc, 0x2d, 0x7c, 0x4a, 0xa5, 0x66, 0xbd, 0xef, 0xf7, 0x7d, 0x1c, 0x6e, 0x89, 0x91,
+	0xe4, 0xea, 0x7f, 0x9c, 0x25, 0x73, 0x6d, 0x33, 0x30, 0xdd, 0x90, 0x6e, 0x93, 0x12, 0x00, 0x18,
+	0x3d, 0xee, 0xf9, 0x6e, 0x23, 0xb7, 0x91, 0xbb, 0x5f, 0x6a, 0xd5, 0xff, 0xf3, 0x72, 0x5d, 0xbb,
+	0x34, 0x5d, 0x67, 0x5f, 0x1f, 0x89, 0x74, 0x56, 0x84, 0xf1, 0x23, 0x31, 0xa4, 0x07, 0x84, 0xf0,
+	0x8b, 0x28, 0x30, 0x0d, 0x6e, 0x0f, 0xc3, 0x46, 0x61, 0x23, 0x7f, 0x3f, 0xdf, 0xd2, 0xbf, 0x7d,
+	0xb9, 0x5e, 0x3a, 0x12, 0xdc, 0xa3, 0xe3, 0x76, 0x08, 0x00, 0x8b, 0x0a, 0x60, 0xa4, 0xa8, 0xb3,
+	0x12, 0x12, 0x47, 0x30, 0xa6, 0x3b, 0xa4, 0x22, 0xa0, 0xad, 0x81, 0xe9, 0x79, 0xdc, 0x09, 0x1b,
+	0xf3, 0x00, 0x52, 0x6a, 0x2d, 0x00, 0x48, 0xf9, 0xe8, 0x83, 0x93, 0x43, 0xc5, 0x66, 0x65, 0x50,
+	0x4a, 0x08, 0xfa, 0x1b, 0x52, 0x33, 0x2d, 0x8b, 0x87, 0xa1, 0x61, 0xf9, 0x5e, 0x14, 0xf8, 0x4e,
+	0xa3, 0x08, 0xd3, 0x2d, 0xef, 0xac, 0x6f, 0x4e, 0x06, 0x62, 0xf3, 0x00, 0xf5, 0x0e, 0xa5, 0x5a,
+	0x6b, 0xf9, 0xab, 0x97, 0xeb, 0xb7, 0x00, 0xba, 0x9a, 0x61, 0xb3, 0xaa, 0x99, 0x26, 0xe9, 0x3e,
+	0xb9, 0x63, 0x5a, 0x91, 0xfd, 0x9c, 0x1b, 0x61, 0x64, 0x46, 0xb6, 0x65, 0x0c, 0x03, 0x6e, 0xf9,
+	0xee, 0xd0, 0x76, 0x78, 0xd8, 0x28, 0x89, 0xf9, 0xb1, 0xdb, 0x52, 0xa1, 0x83, 0xf2, 0xf6, 0x58,
+	0x4c, 0x1f, 0x90, 0xfa, 0xc0, 0x0e, 0x23, 0x3f, 0xb8, 0x34, 0x42, 0x1e, 0x00, 0xc4, 0x0b, 0xdb,
+	0xeb, 0xf9, 0x2f, 0x1a, 0x04, 0x26, 0x58, 0x60, 0x54, 0xc9, 0x3a, 0x42, 0xf4, 0x21, 0x4a, 0xe8,
+	0x31, 0x59, 0x36, 0x1d, 0xc7, 0x7f, 0xc1, 0x7b, 0x86, 0x1b, 0xf6, 0x8d, 0xe8, 0x72, 0xc8, 0x8d,
+	0x38, 0x80, 0x48, 0x94, 0x31, 0x12, 0x2b, 0x30, 0x5d, 0x7a, 0x20, 0x15, 0x4e, 0xc2, 0xfe, 0x19,
+	0x88, 0x9f, 0xb2, 0xc7, 0x21, 0xa3, 0x66, 0x96, 0x07, 0x16, 0xf4, 0x09, 0x59, 0xb0, 0xec, 0xc0,
+	0x8a, 0xed, 0xc8, 0xe8, 0x06, 0xdc, 0x3c, 0xe7, 0x41, 0xa3, 0x82, 0x81, 0xd9, 0xb8, 0x1a, 0x98,
+	0x43, 0xa9, 0xd8, 0x92, 0x7a, 0xad, 0x82, 0x88, 0x0c, 0xab, 0x59, 0x19, 0x2e, 0xcc, 0xad, 0xda,
+	0x37, 0x43, 0xc3, 0x7f, 0xce, 0x83, 0xc0, 0xee, 0xc1, 0xea, 0xab, 0x08, 0xb7, 0x76, 0x15, 0xee,
+	0x5d, 0x33, 0x7c, 0x92, 0x68, 0x29, 0xb0, 0x4a, 0x3f, 0xc5, 0xdb, 0x5f, 0xfd, 0xfc, 0xbb, 0xbf,
+	0xbd, 0xbe, 0x92, 0x4a, 0xe2, 0x0b, 0x91, 0xc6, 0x32, 0xf5, 0xde, 0x2f, 0x14, 0x67, 0xb4, 0x3c,
+	0x3c, 0xf3, 0x5a, 0x01, 0x9e, 0xb3, 0xda, 0x1c, 0x3c, 0xe7, 0xb4, 0x79, 0xfd, 0x2f, 0x39, 0x52,
+	0xcb, 0x4e, 0x94, 0xbe, 0x42, 0x2a, 0x43, 0x33, 0x0e, 0xb9, 0x61, 0x01, 0x23, 0xe2, 0x98, 0xa8,
+	0x45, 0x56, 0x46, 0xde, 0x21, 0xb2, 0xe8, 0x3d, 0x42, 0x94, 0x0a, 0xc4, 0xa8, 0x31, 0x83, 0x0a,
+	0x25, 0xa9, 0x00, 0x0c, 0xfa, 0x06, 0xa1, 0x48, 0xf4, 0x32, 0xfb, 0x9a, 0xc7, 0x7d, 0x5d, 0x94,
+	0x92, 0xf4, 0x8e, 0xbe, 0x4a, 0xaa, 0xfc, 0x62, 0x68, 0xc3, 0x86, 0x0e, 0xb8, 0xdd, 0x1f, 0x44,
+	0x90, 0xe6, 0xb9, 0xfb, 0x79, 0x56, 0x91, 0xcc, 0xf7, 0x90, 0xa7, 0xff, 0x3e, 0x47, 0x2a, 0xe9,
+	0x10, 0xd0, 0x1f, 0x11, 0x2d, 0xba, 0x30, 0x7a, 0x66, 0x64, 0x1a, 0x9f, 0xf2, 0xc0, 0x37, 0x20,
+	0x16, 0x38, 0xd5, 0x02, 0xab, 0x46, 0x17, 0x8f, 0x80, 0xfd, 0x0c, 0xb8, 0x60, 0x41, 0x37, 0x49,
+	0x3d, 0x51, 0xf4, 0x7c, 0x6f, 0xac, 0x3c, 0x83, 0xca, 0x9a, 0x54, 0x3e, 0xf5, 0xbd, 0x44, 0xff,
+	0x35, 0x52, 0x0b, 0x45, 0x0e, 0x41, 0x72, 0xf2, 0x08, 0x35, 0xf3, 0xa8, 0x59, 0x91, 0xdc, 0x0e,
+	0x8f, 0x40, 0x4b, 0xff, 0x43, 0x8e, 0x64, 0x73, 0x1c, 0x5e, 0xd5, 0xb9, 0x54, 0xc4, 0xca, 0x3b,
+	0xaf, 0xfe, 0x8f, 0x77, 0x45, 0x24, 0x95, 0xda, 0x48, 0x65, 0x48, 0x7f, 0x4e, 0x0a, 0xa3, 0x88,
+	0xfe, 0x5f, 0x00, 0x68, 0xa6, 0xff, 0x33, 0x47, 0x16, 0xaf, 0x68, 0x50, 0x8b, 0x94, 0xd5, 0xbb,
+	0x2c, 0x32, 0x1f, 0x27, 0x57, 0xdb, 0xb9, 0xfb, 0x43, 0xd8, 0x08, 0xfa, 0x1a, 0xbc, 0x12, 0x64,
+	0x4c, 0x43, 0x89, 0xa1, 0xb2, 0xc4, 0xa4, 0x80, 0x74, 0x46, 0xcc, 0x91, 0x06, 0x38, 0x59, 0xca,
+	0x16, 0x0c, 0xc3, 0x81, 0x17, 0x11, 0x16, 0x22, 0xde, 0xb0, 0x87, 0x00, 0x97, 0x9d, 0xd8, 0x63,
+	0x10, 0x02, 0x6a, 0x33, 0x83, 0x9a, 0xb6, 0xd4, 0xd9, 0xa2, 0x39, 0x69, 0xa0, 0xff, 0x76, 0x91,
+	0x94, 0xa1, 0x44, 0xd9, 0x1e, 0x30, 0x3f, 0xb6, 0xfb, 0xf4, 0xd7, 0x64, 0x61, 0xe0, 0xbb, 0x3c,
+	0x8c, 0xb8, 0xd9, 0x33, 0xba, 0x8e, 0x6f, 0x9d, 0xab, 0xaa, 0xfa, 0xf0, 0x1f, 0x2f, 0xd7, 0x97,
+	0xe5, 0x02, 0xc3, 0xde, 0xf9, 0xa6, 0xed, 0x6f, 0xb9, 0x66, 0x34, 0xd8, 0x3c, 0xf6, 0x84, 0xd3,
+	0x15, 0xe9, 0x74, 0xc2, 0x52, 0x67, 0xb5, 0x11, 0xa7, 0x25, 0x18, 0x74, 0x40, 0x6a, 0x3d, 0xd3,
+	0x37, 0x3e, 0xf6, 0x83, 0x73, 0x05, 0x3e, 0x83, 0xe0, 0xad, 0x1f, 0x04, 0x87, 0x65, 0x56, 0x1e,
+	0x1d, 0x3c, 0x79, 0x07, 0x2c, 0x10, 0x02, 0x9c, 0x2d, 0x4b, 0x67, 0x59, 0x20, 0x9d, 0x55, 0x80,
+	0x31, 0x52, 0xa3, 0x1f, 0x12, 0x6d, 0xa4, 0x10, 0xc6, 0xc3, 0xa1, 0x1f, 0x44, 0x98, 0x73, 0xc5,
+	0xd6, 0x1b, 0x00, 0x59, 0x53, 0x90, 0x1d, 0x29, 0x01, 0xd0, 0xdb, 0x13, 0xa0, 0xca, 0x06, 0x96,
+	0xa0, 0x60, 0x95, 0x2a, 0xed, 0x42, 0xe9, 0xb7, 0x87, 0xdb, 0xbb, 0x0f, 0xd4, 0x02, 0x0a, 0xb8,
+	0x80, 0x5f, 0x4e, 0x5b, 0x40, 0x19, 0xce, 0x14, 0x30, 0x48, 0xe6, 0xbf, 0xa4, 0x8e, 0x96, 0x14,
+	0x8a, 0x0e, 0x47, 0x05, 0x92, 0x72, 0xf2, 0x89, 0x8f, 0x5d, 0xe5, 0x63, 0xee, 0xa6, 0x3e, 0x76,
+	0xaf, 0xf3, 0xb1, 0x9b, 0xf5, 0xb1, 0x9b, 0xf5, 0xb1, 0xa7, 0x7c, 0xcc, 0xdf, 0xd4, 0xc7, 0xde,
+	0x75, 0x3e, 0xf6, 0xb2, 0x3e, 0xa4, 0x8e, 0x48, 0xa6, 0xee, 0xe5, 0xa7, 0xa6, 0x17, 0xd9, 0xb1,
+	0xab, 0xdc, 0x14, 0x6f, 0x9c, 0x4c, 0x13, 0x96, 0xb0, 0x13, 0x23, 0x8e, 0x44, 0x3f, 0x27, 0x75,
+	0x48, 0x6f, 0x38, 0xed, 0x80, 0xe7, 0xf9, 0x43, 0x87, 0x2b, 0x17, 0x25, 0x74, 0xb1, 0x37, 0xcd,
+	0xc5, 0xaa, 0x74, 0x71, 0x9d, 0xb9, 0xce, 0x96, 0xb2, 0x6c, 0xe9, 0xcc, 0x20, 0xda, 0x90, 0x47,
+	0x3c, 0x08, 0xbb, 0x71, 0xd0, 0x57, 0x8e, 0x08, 0x3a, 0x7a, 0x73, 0x9a, 0x23, 0x95, 0x56, 0x93,
+	0xa6, 0x3a, 0x5b, 0x18, 0xb3, 0xa4, 0x83, 0x8f, 0x48, 0xcd, 0x16, 0x5e, 0xbb, 0xb1, 0xa3, 0xe0,
+	0xcb, 0x08, 0xbf, 0x33, 0x0d, 0x5e, 0xbd, 0x0a, 0x59, 0x43, 0x9d, 0x55, 0x13, 0x86, 0x84, 0xee,
+	0x11, 0xea, 0xc6, 0x76, 0x60, 0xf4, 0x1d, 0xd3, 0xb2, 0x79, 0xa0, 0xe0, 0x2b, 0x08, 0xff, 0xd6,
+	0x34, 0xf8, 0x3b, 0x12, 0xfe, 0xaa, 0xb1, 0xce, 0x34, 0xc1, 0x7c, 0x57, 0xf2, 0xa4, 0x97, 0x0e,
+	0xa9, 0x74, 0x79, 0xe0, 0xd8, 0x9e, 0xc2, 0xaf, 0x22, 0xfe, 0x83, 0x69, 0xf8, 0x2a, 0x83, 0xd2,
+	0x66, 0x90, 0x41, 0x92, 0x1c, 0x81, 0x3a, 0x3e, 0x74, 0x1c, 0x09, 0xe8, 0xe2, 0x8d, 0x41, 0xd3,
+	0x66, 0x00, 0x2a, 0x49, 0x09, 0xda, 0x87, 0xc2, 0x1a, 0x04, 0xfe, 0x8b, 0x89, 0x80, 0x50, 0xc4,
+	0xfe, 0xe9, 0x34, 0xec, 0xa4, 0xb8, 0x5e, 0xb5, 0x16, 0xc5, 0x55, 0x70, 0x33, 0x21, 0x81, 0xc0,
+	0xf7, 0x03, 0xf3, 0x72, 0xc2, 0x4f, 0xfd, 0xc6, 0x81, 0xbf, 0x6a, 0x0c, 0x81, 0x17, 0xcc, 0x8c,
+	0x97, 0x4f, 0x48, 0xdd, 0xe5, 0x41, 0x9f, 0x1b, 0x1e, 0x8f, 0xc2, 0xa1, 0x23, 0xfa, 0x28, 0xf4,
+	0xb3, 0x7c, 0xe3, 0xf7, 0xe0, 0x3a, 0x73, 0x9d, 0x51, 0x64, 0x9f, 0x2a, 0xae, 0xf4, 0x75, 0x87,
+	0x14, 0x2d, 0x71, 0x5a, 0x18, 0x76, 0xaf, 0xd1, 0xc0, 0x23, 0x7c, 0x1e, 0xe9, 0xe3, 0x1e, 0xad,
+	0x93, 0x59, 0xd9, 0x85, 0xdf, 0x11, 0x7e, 0x99, 0x24, 0x68, 0x93, 0x14, 0x7b, 0xdc, 0xb2, 0x5d,
+	0x13, 0x7a, 0xc3, 0x26, 0x1a, 0x8c, 0x68, 0xfa, 0x01, 0xa9, 0x86, 0xd0, 0x1d, 0xf7, 0x01, 0xc0,
+	0x88, 0x6c, 0x97, 0x37, 0x56, 0x71, 0xc6, 0xdb, 0xd3, 0x66, 0x5c, 0x97, 0x33, 0xce, 0xd8, 0x41,
+	0xed, 0x4f, 0xe8, 0x33, 0x20, 0x69, 0x9b, 0x94, 0x2d, 0xd3, 0xb3, 0x62, 0x4f, 0xa2, 0xde, 0x45,
+	0xd4, 0xad, 0x69, 0xa8, 0xea, 0x28, 0x4e, 0x59, 0xc1, 0x51, 0x2c, 0xa9, 0x04, 0x71, 0x18, 0x98,
+	0xfd, 0x98, 0x4b, 0xc4, 0x7b, 0x37, 0x46, 0x4c, 0x59, 0x01, 0xa2, 0xa4, 0x12, 0x44, 0x68, 0xbb,
+	0xce, 0x1d, 0x85, 0xb8, 0x76, 0x63, 0xc4, 0x94, 0x15, 0x20, 0x4a, 0x0a, 0x11, 0x4f, 0x08, 0xf1,
+	0x43, 0xf3, 0xdc, 0x94, 0x80, 0xeb, 0x08, 0xb8, 0x39, 0x0d, 0x50, 0x5d, 0x71, 0xc6, 0x46, 0x70,
+	0xc5, 0x41, 0x02, 0xe1, 0x9e, 0x41, 0x3f, 0xba, 0xb3, 0xfb, 0x96, 0xca, 0xa5, 0x0d, 0x84, 0x7b,
+	0x7b, 0xda, 0xe9, 0x50, 0x6a, 0x83, 0x7a, 0x72, 0x36, 0x28, 0xec, 0x31, 0x02, 0x60, 0x0f, 0x13,
+	0xf9, 0xa8, 0x5b, 0x5e, 0xd1, 0x6e, 0xc3, 0xf3, 0xb6, 0xd6, 0xd0, 0xb7, 0xc8, 0xac, 0xb8, 0x96,
+	0x70, 0xaa, 0x91, 0xfc, 0x39, 0xbf, 0x94, 0x3d, 0x07, 0x13, 0x43, 0x91, 0x57, 0xcf, 0x4d, 0x27,
+	0xe6, 0xb2, 0x55, 0x60, 0x92, 0xd0, 0xdb, 0x64, 0xe1, 0x2c, 0x30, 0xbd, 0x50, 0x5c, 0x69, 0x7c,
+	0xef, 0xb1, 0xdf, 0x0f, 0x29, 0x25, 0x85, 0x81, 0x19, 0x0e, 0x94, 0x2d, 0x8e, 0xe9, 0x8f, 0x49,
+	0xc1, 0x01, 0x19, 0x36, 0x4d, 0xe5, 0x9d, 0xe5, 0xab, 0x1d, 0x1a, 0x58, 0x32, 0x54, 0xd1, 0x7f,
+	0x97, 0x27, 0x79, 0xa0, 0x68, 0x83, 0xcc, 0x9b, 0xbd, 0x5e, 0x00, 0x7d, 0x92, 0x42, 0x4a, 0x48,
+	0xba, 0x42, 0xe6, 0x22, 0x7f, 0x68, 0x5b, 0x12, 0xae, 0xc4, 0x14, 0x25, 0x1c, 0x8b, 0x56, 0x18,
+	0xfb, 0x8b, 0x0a, 0xc3, 0xb1, 0xb8, 0x21, 0xe2, 0xba, 0x0d, 0x2f, 0x76, 0xa1, 0xa0, 0x61, 0x9b,
+	0x50, 0x68, 0x2d, 0x7c, 0x0f, 0xa7, 0x28, 0xf2, 0x4f, 0x91, 0xcd, 0xd2, 0x04, 0xfd, 0x09, 0x99,
+	0x87, 0xae, 0x1a, 0xd7, 0x30, 0x8b, 0xf1, 0x5e, 0x02, 0xf5, 0x85, 0x68, 0xbc, 0xcc, 0xf7, 0x40,
+	0x04, 0x5e, 0x2f, 0xc4, 0x7f, 0xba, 0x45, 0x8a, 0xa0, 0x0d, 0xd7, 0x31, 0x7e, 0x81, 0x0d, 0x42,
+	0xa1, 0x55, 0x07, 0x75, 0x2d, 0xa5, 0x7e, 0x2c, 0x64, 0x0c, 0x30, 0x71, 0x00, 0xf0, 0x44, 0x4e,
+	0x09, 0x3d, 0xc8, 0xf3, 0xbe, 0x0a, 0x26, 0x25, 0xe4, 0x22, 0xf6, 0x78, 0x48, 0x75, 0x32, 0x2b,
+	0xb1, 0x8b, 0x88, 0x5d, 0x01, 0xc5, 0x22, 0xc4, 0x49, 0x62, 0x4a, 0x91, 0x08, 0x55, 0xc0, 0x5d,
+	0xb8, 0x68, 0xf5, 0xf0, 0xd0, 0x2d, 0xb2, 0x84, 0xa4, 0x6f, 0xc3, 0xc9, 0x8f, 0xbe, 0x44, 0x5e,
+	0xc1, 0x61, 0xe4, 0x0e, 0xe5, 0x65, 0xb2, 0x45, 0x01, 0xa7, 0x86, 0xa2, 0xb3, 0x44, 0xc2, 0x26,
+	0x68, 0xfd, 0x8b, 0x19, 0x52, 0x3c, 0xbb, 0x60, 0x3c, 0x8c, 0x9d, 0x88, 0xbe, 0x43, 0x34, 0x6c,
+	0x62, 0x61, 0x49, 0x46, 0x66, 0x5f, 0x5a, 0xab, 0xe3, 0xf3, 0x75, 0x52, 0x03, 0xce, 0xd7, 0x84,
+	0x75, 0xa0, 0x36, 0x0f, 0xd2, 0x08, 0xdc, 0x40, 0x79, 0x9a, 0xc1, 0x5d, 0x92, 0x04, 0xb4, 0x89,
+	0x22, 0xe4, 0x98, 0x22, 0x79, 0xbc, 0x20, 0xbc, 0x72, 0x35, 0x45, 0x26, 0xf2, 0xac, 0xb5, 0x2a,
+	0xae, 0x07, 0xe0, 0xbb, 0x26, 0x7d, 0x2b, 0x7b, 0xfd, 0xaf, 0x70, 0x55, 0xcc, 0x89, 0xdd, 0xc1,
+	0x64, 0x84, 0x3c, 0x0e, 0xb8, 0xbc, 0x76, 0x55, 0x98, 0x18, 0x8a, 0x4a, 0x18, 0x70, 0x78, 0x5f,
+	0x23, 0x88, 0xd6, 0x2c, 0x46, 0x6b, 0x44, 0x8b, 0xb2, 0x2a, 0xae, 0xac, 0xe2, 0x16, 0x27, 0xf7,
+	0x92, 0xcd, 0x03, 0xfd, 0x14, 0xc8, 0xfd, 0xc2, 0x67, 0x5f, 0xae, 0xdf, 0xd2, 0x4d, 0x52, 0x56,
+	0x77, 0x87, 0x18, 0x5a, 0x92, 0x29, 0x39, 0x0a, 0x79, 0x27, 0xae, 0x54, 0x26, 0xd4, 0x73, 0x78,
+	0x79, 0x54, 0xa6, 0xca, 0xbc, 0x53, 0xfc, 0x5f, 0x01, 0x9b, 0xa5, 0x09, 0xe5, 0xe2, 0xcb, 0x02,
+	0x29, 0xc3, 0x52, 0x2d, 0xae, 0x6e, 0x02, 0x22, 0xdb, 0x05, 0x19, 0x28, 0x17, 0x8a, 0x12, 0xbe,
+	0xc5, 0xa6, 0xfa, 0x71, 0xa4, 0xde, 0xc8, 0x84, 0x14, 0x16, 0x01, 0xe7, 0x17, 0xdc, 0x52, 0xb7,
+	0x3b, 0x45, 0xd1, 0x5d, 0x52, 0xed, 0xd9, 0xa1, 0xd9, 0x75, 0xf0, 0xdb, 0x04, 0x54, 0x13, 0x5c,
+	0x7e, 0x4b, 0x83, 0x49, 0x55, 0x94, 0xa0, 0x23, 0xf8, 0x2c, 0x43, 0x89, 0x1c, 0x1a, 0x9b, 0xe1,
+	0x6c, 0x31, 0x36, 0x45, 0x99, 0x43, 0x23, 0x55, 0x94, 0xb0, 0x09, 0x5a, 0x9e, 0x46, 0xdd, 0xb8,
+	0x8f, 0xe9, 0x5b, 0x64, 0x92, 0x10, 0x5c, 0xc7, 0x76, 0xed, 0x08, 0xd3, 0x75, 0x96, 0x49, 0x02,
+	0x1c, 0x95, 0xc6, 0x1f, 0x0b, 0x08, 0xa6, 0xc1, 0xbd, 0x6b, 0xbe, 0x3d, 0x8c, 0x6f, 0x49, 0x6c,
+	0xac, 0x2f, 0x16, 0xc7, 0x3d, 0x9c, 0xa4, 0x0b, 0xb9, 0x1f, 0x5c, 0x62, 0xdb, 0xa6, 0x16, 0x27,
+	0x05, 0x27, 0xc8, 0x67, 0x19, 0x8a, 0xb6, 0x08, 0x55, 0x66, 0x90, 0x1b, 0x71, 0xe0, 0xe1, 0x65,
+	0x1a, 0x7b, 0xb2, 0xa2, 0x7c, 0x8f, 0xa5, 0x94, 0xa1, 0x50, 0xdc, 0xa6, 0xd9, 0x15, 0x0e, 0xfd,
+	0x05, 0xa1, 0x72, 0x4f, 0x8c, 0x4f, 0x42, 0x68, 0x75, 0x2c, 0x9c, 0x9b, 0xea, 0xbb, 0xd0, 0xbf,
+	0x94, 0xaa, 0x39, 0x6b, 0x92, 0x7a, 0x1f, 0x54, 0x25, 0x07, 0x4a, 0x6f, 0x41, 0x9b, 0x85, 0xe7,
+	0xbc, 0x56, 0x1c, 0xc5, 0x4f, 0xad, 0x82, 0x2d, 0x25, 0x74, 0x6a, 0x7a, 0xfa, 0x29, 0x21, 0xed,
+	0x80, 0xdb, 0xa2, 0x3b, 0x76, 0x1c, 0x51, 0xf6, 0x3c, 0xd3, 0xe5, 0x49, 0xbd, 0x15, 0xe3, 0x74,
+	0x62, 0xce, 0x64, 0x13, 0x13, 0xb4, 0x2d, 0xbf, 0xc7, 0x31, 0x35, 0x40, 0x5b, 0x8c, 0x75, 0x87,
+	0x54, 0x0e, 0xe1, 0xff, 0x09, 0x8f, 0x4c, 0x2c, 0x9a, 0xab, 0xa4, 0x24, 0xf8, 0x46, 0xaa, 0x8c,
+	0x17, 0x05, 0x03, 0x0b, 0xd2, 0x5d, 0x52, 0x1a, 0xc6, 0x5d, 0xb8, 0xc8, 0x0e, 0x20, 0x25, 0x25,
+	0xf8, 0x98, 0x21, 0xbe, 0xb0, 0xb8, 0x0a, 0xc6, 0x88, 0x03, 0x5b, 0xb9, 0x29, 0x27, 0xbc, 0xa7,
+	0x81, 0xfd, 0xfa, 0xdf, 0x73, 0x24, 0x75, 0x01, 0xa7, 0x3f, 0x23, 0xcd, 0x83, 0xc3, 0xc3, 0xa3,
+	0x4e, 0xc7, 0x38, 0xfb, 0xa8, 0x7d, 0x64, 0xb4, 0x8f, 0xd8, 0xc9, 0x71, 0xa7, 0x73, 0xfc, 0xe4,
+	0xf4, 0x31, 0xf0, 0xb4, 0x5b, 0xcd, 0xbb, 0x9f, 0xff, 0x69, 0xa3, 0x31, 0xd6, 0x6f, 0xf3, 0xc0,
+	0xb5, 0xc3, 0x10, 0x8a, 0x80, 0x23, 0x96, 0xf3, 0x26, 0x59, 0x49, 0x5b, 0xb3, 0xa3, 0xce, 0x19,
+	0x3b, 0x3e, 0x3c, 0x3b, 0x7a, 0xa4, 0xe5, 0x9a, 0x0d, 0xb0, 0xac, 0x8f, 0x2d, 0xa1, 0x90, 0x45,
+	0x81, 0x6d, 0x89, 0xf7, 0x7c, 0x8f, 0x34, 0xae, 0xf7, 0x09, 0x76, 0x33, 0xcd, 0x26, 0xd8, 0xad,
+	0x5c, 0xe7, 0x91, 0xf7, 0x9a, 0x85, 0xcf, 0xfe, 0xbc, 0x76, 0xab, 0xb5, 0xff, 0xd5, 0xb7, 0x6b,
+	0xb9, 0xaf, 0xe1, 0xf7, 0x2f, 0xf8, 0x7d, 0xf1, 0xef, 0xb5, 0x5b, 0x5f, 0xc3, 0xef, 0x1b, 0xf8,
+	0x3d, 0xdb, 0xe8, 0xdb, 0xd1, 0x20, 0xee, 0x42, 0xda, 0xba, 0x5b, 0x93, 0xdf, 0xab, 0xc4, 0xa7,
+	0x85, 0xb0, 0x3b, 0x87, 0xdf, 0x4e, 0x1f, 0xfe, 0x17, 0xfd, 0x33, 0x8c, 0x5b, 0x94, 0x15, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1098,6 +1360,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.GasOverrides.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.AllowedMsgTypeURLs) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeURLs[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedMsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.HistoryServeWindow != 0 {
 		i = encodeVarintEvm(dAtA, i, uint64(m.HistoryServeWindow))
 		i--
@@ -1132,21 +1423,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA5 := make([]byte, len(m.ExtraEIPs)*10)
+		var j4 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j4++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvm(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1160,6 +1451,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PausedPrecompiles) > 0 {
+		for iNdEx := len(m.PausedPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedPrecompiles[iNdEx])
+			copy(dAtA[i:], m.PausedPrecompiles[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.PausedPrecompiles[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PauseCall {
+		i--
+		if m.PauseCall {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PauseCreate {
+		i--
+		if m.PauseCreate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasOverrides) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasOverrides) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasOverrides) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SstoreSetGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.SstoreSetGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxDataNonZeroGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxDataNonZeroGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxDataZeroGas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.TxDataZeroGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1260,6 +1646,20 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.P256Block != nil {
+		{
+			size := m.P256Block.Size()
+			i -= size
+			if _, err := m.P256Block.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.OsakaTime != nil {
 		{
 			size := m.OsakaTime.Size()
@@ -1993,20 +2393,64 @@ func (m *Preinstall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Publisher) > 0 {
+		i -= len(m.Publisher)
+		copy(dAtA[i:], m.Publisher)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Publisher)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -2038,6 +2482,58 @@ func (m *Params) Size() (n int) {
 	if m.HistoryServeWindow != 0 {
 		n += 1 + sovEvm(uint64(m.HistoryServeWindow))
 	}
+	if len(m.AllowedMsgTypeURLs) > 0 {
+		for _, s := range m.AllowedMsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.GasOverrides.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PauseCreate {
+		n += 2
+	}
+	if m.PauseCall {
+		n += 2
+	}
+	if len(m.PausedPrecompiles) > 0 {
+		for _, s := range m.PausedPrecompiles {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvm(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *GasOverrides) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxDataZeroGas != 0 {
+		n += 1 + sovEvm(uint64(m.TxDataZeroGas))
+	}
+	if m.TxDataNonZeroGas != 0 {
+		n += 1 + sovEvm(uint64(m.TxDataNonZeroGas))
+	}
+	if m.SstoreSetGas != 0 {
+		n += 1 + sovEvm(uint64(m.SstoreSetGas))
+	}
 	return n
 }
 
@@ -2171,6 +2667,10 @@ func (m *ChainConfig) Size() (n int) {
 		l = m.OsakaTime.Size()
 		n += 2 + l + sovEvm(uint64(l))
 	}
+	if m.P256Block != nil {
+		l = m.P256Block.Size()
+		n += 2 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2371,6 +2871,27 @@ func (m *Preinstall) Size() (n int) {
 	return n
 }
 
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Publisher)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2560,30 +3081,370 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveStaticPrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryServeWindow", wireType)
+			}
+			m.HistoryServeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryServeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypeURLs = append(m.AllowedMsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseCreate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseCreate = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseCall", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PauseCall = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPrecompiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedPrecompiles = append(m.PausedPrecompiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvm
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvm
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasOverrides) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveStaticPrecompiles", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasOverrides: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasOverrides: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxDataZeroGas", wireType)
+			}
+			m.TxDataZeroGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2593,29 +3454,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TxDataZeroGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvm
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvm
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxDataNonZeroGas", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TxDataNonZeroGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxDataNonZeroGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ActiveStaticPrecompiles = append(m.ActiveStaticPrecompiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryServeWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SstoreSetGas", wireType)
 			}
-			m.HistoryServeWindow = 0
+			m.SstoreSetGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvm
@@ -2625,7 +3492,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoryServeWindow |= uint64(b&0x7F) << shift
+				m.SstoreSetGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3707,6 +4574,42 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P256Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.P256Block = &v
+			if err := m.P256Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -5068,6 +5971,152 @@ func (m *Preinstall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publisher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publisher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
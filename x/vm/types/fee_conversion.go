@@ -0,0 +1,70 @@
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeConversionKey is the context key under which the fee conversion of the
+// Ethereum transaction being processed is stored.
+type feeConversionKey struct{}
+
+// FeeConversion defines the alternative denomination used to pay the fees of
+// an Ethereum transaction and the rate used to convert them from the EVM
+// denomination.
+type FeeConversion struct {
+	// Denom is the bank denomination used to pay the fees.
+	Denom string
+	// Rate is the amount of the EVM denomination, in its 18 decimals
+	// representation, that one unit of Denom is worth.
+	Rate sdkmath.LegacyDec
+}
+
+// NewFeeConversion returns a new FeeConversion instance.
+func NewFeeConversion(denom string, rate sdkmath.LegacyDec) *FeeConversion {
+	return &FeeConversion{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// ConvertFee converts the given fee amount, expressed in the EVM denomination
+// with 18 decimals, into the alternative denomination. The result is rounded up
+// so that the sender never pays less than the equivalent of the EVM fee.
+func (fc FeeConversion) ConvertFee(amt *big.Int) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromBigInt(amt).Quo(fc.Rate).Ceil().TruncateInt()
+}
+
+// ConvertRefund converts the given refund amount, expressed in the EVM
+// denomination with 18 decimals, into the alternative denomination. The result
+// is rounded down so that refunds never exceed the fees paid.
+func (fc FeeConversion) ConvertRefund(amt *big.Int) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromBigInt(amt).Quo(fc.Rate).TruncateInt()
+}
+
+// ConvertCoins converts the given fee coins, expressed in the EVM denomination,
+// into coins of the alternative denomination.
+func (fc FeeConversion) ConvertCoins(fees sdk.Coins) sdk.Coins {
+	amt := fees.AmountOf(GetEVMCoinDenom())
+	if !amt.IsPositive() {
+		return sdk.Coins{}
+	}
+
+	return sdk.Coins{sdk.NewCoin(fc.Denom, fc.ConvertFee(amt.BigInt()))}
+}
+
+// WithFeeConversion returns a copy of the context that carries the given fee
+// conversion, so that fee refunds after the EVM execution are paid back in the
+// same denomination used to pay the fees.
+func WithFeeConversion(ctx sdk.Context, fc *FeeConversion) sdk.Context {
+	return ctx.WithValue(feeConversionKey{}, fc)
+}
+
+// GetFeeConversion returns the fee conversion carried by the context, if any.
+func GetFeeConversion(ctx sdk.Context) (*FeeConversion, bool) {
+	fc, ok := ctx.Value(feeConversionKey{}).(*FeeConversion)
+	return fc, ok && fc != nil
+}
@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestFeeConversionRounding(t *testing.T) {
+	fc := evmtypes.NewFeeConversion("uatom", math.LegacyNewDec(3))
+
+	require.Equal(t, math.NewInt(4), fc.ConvertFee(big.NewInt(10)))
+	require.Equal(t, math.NewInt(3), fc.ConvertRefund(big.NewInt(10)))
+	require.Equal(t, math.NewInt(3), fc.ConvertFee(big.NewInt(9)))
+	require.Equal(t, math.NewInt(3), fc.ConvertRefund(big.NewInt(9)))
+}
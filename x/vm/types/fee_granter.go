@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeGranterKey is the context key under which the granter sponsoring the fees
+// of the Ethereum transaction being processed is stored.
+type feeGranterKey struct{}
+
+// WithFeeGranter returns a copy of the context that carries the given fee
+// granter, so that fee refunds after the EVM execution are paid back to the
+// account that paid the fees.
+func WithFeeGranter(ctx sdk.Context, granter sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(feeGranterKey{}, granter)
+}
+
+// GetFeeGranter returns the fee granter carried by the context, if any.
+func GetFeeGranter(ctx sdk.Context) (sdk.AccAddress, bool) {
+	granter, ok := ctx.Value(feeGranterKey{}).(sdk.AccAddress)
+	return granter, ok && !granter.Empty()
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeePaymentMessage returns the message signed by the sender of an Ethereum
+// transaction, following EIP-191, to authorize its fees to be paid in the given
+// denomination and, if not empty, with the fee allowance of the given granter.
+// The fee denomination and granter are set on the Cosmos tx wrapping the
+// Ethereum transaction and are not covered by its signature.
+func FeePaymentMessage(txHash common.Hash, denom string, granter sdk.AccAddress) []byte {
+	message := fmt.Sprintf("Pay the fees of transaction %s in %s", txHash.Hex(), denom)
+	if !granter.Empty() {
+		message += fmt.Sprintf(" with the fee allowance of %s", granter)
+	}
+	return []byte(message)
+}
+
+// VerifyFeePaymentSignature verifies that the given EIP-191 signature of the
+// fee payment message was produced by the sender. The recovery id of the
+// signature can either be 0/1 or 27/28 as returned by personal_sign.
+func VerifyFeePaymentSignature(sig, message []byte, sender common.Address) error {
+	if len(sig) != crypto.SignatureLength {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"fee payment signature must be %d bytes long, got %d", crypto.SignatureLength, len(sig),
+		)
+	}
+
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(message), sig)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "invalid fee payment signature: %s", err)
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != sender {
+		return errorsmod.Wrapf(
+			errortypes.ErrorInvalidSigner,
+			"fee payment signed by %s instead of the sender %s", signer, sender,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// GasOverridesEIP is the number of the jump table activator replacing the
+// SSTORE gas function with the one charging the overridden set cost.
+const GasOverridesEIP = 1_000_000_000
+
+// GasOverridesStateDB defines the StateDB carrying the gas overrides of the
+// params to the gas functions of the jump table.
+type GasOverridesStateDB interface {
+	vm.StateDB
+	GetGasOverrides() GasOverrides
+	SetGasOverrides(gasOverrides GasOverrides)
+}
+
+// Validate checks that the overridden gas costs are not below their safe
+// floors. The transaction data byte costs can't be lower than the go-ethereum
+// ones, which are used by the stateless transaction checks, and a storage
+// slot can't be set for less than it's reset.
+func (o GasOverrides) Validate() error {
+	if o.TxDataZeroGas != 0 && o.TxDataZeroGas < params.TxDataZeroGas {
+		return fmt.Errorf("tx data zero gas %d is below the floor %d", o.TxDataZeroGas, params.TxDataZeroGas)
+	}
+	if o.TxDataNonZeroGas != 0 && o.TxDataNonZeroGas < params.TxDataNonZeroGasEIP2028 {
+		return fmt.Errorf("tx data non-zero gas %d is below the floor %d", o.TxDataNonZeroGas, params.TxDataNonZeroGasEIP2028)
+	}
+	if o.SstoreSetGas != 0 && o.SstoreSetGas < params.SstoreResetGasEIP2200 {
+		return fmt.Errorf("sstore set gas %d is below the floor %d", o.SstoreSetGas, params.SstoreResetGasEIP2200)
+	}
+	return nil
+}
+
+// IntrinsicGas computes the intrinsic gas of a transaction like
+// core.IntrinsicGas, charging the transaction data bytes with the overridden
+// costs.
+func (o GasOverrides) IntrinsicGas(
+	data []byte,
+	accessList ethtypes.AccessList,
+	authList []ethtypes.SetCodeAuthorization,
+	isContractCreation, isHomestead, isEIP2028, isEIP3860 bool,
+) (uint64, error) {
+	gas, err := core.IntrinsicGas(data, accessList, authList, isContractCreation, isHomestead, isEIP2028, isEIP3860)
+	if err != nil || (o.TxDataZeroGas == 0 && o.TxDataNonZeroGas == 0) {
+		return gas, err
+	}
+
+	nonZeroGas := params.TxDataNonZeroGasFrontier
+	if isEIP2028 {
+		nonZeroGas = params.TxDataNonZeroGasEIP2028
+	}
+	z := uint64(bytes.Count(data, []byte{0}))
+	nz := uint64(len(data)) - z
+
+	// remove the default data cost charged by go-ethereum
+	gas -= z*params.TxDataZeroGas + nz*nonZeroGas
+
+	if o.TxDataZeroGas != 0 {
+		if z > 0 && (math.MaxUint64-gas)/z < o.TxDataZeroGas {
+			return 0, core.ErrGasUintOverflow
+		}
+		gas += z * o.TxDataZeroGas
+	} else {
+		gas += z * params.TxDataZeroGas
+	}
+
+	if o.TxDataNonZeroGas != 0 {
+		nonZeroGas = o.TxDataNonZeroGas
+	}
+	if nz > 0 && (math.MaxUint64-gas)/nz < nonZeroGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + nz*nonZeroGas, nil
+}
+
+// EnableGasOverrides is the jump table activator of the gas overrides. The
+// overridden costs are read from the StateDB of the EVM, since the activators
+// are registered once for all the EVM instances.
+func EnableGasOverrides(jt *vm.JumpTable) {
+	jt[vm.SSTORE].SetDynamicGas(gasSStoreOverride)
+}
+
+// gasSStoreOverride implements the SSTORE gas cost of EIP-2929, with the
+// refunds of EIP-3529 after the London fork, charging the overridden cost for
+// setting a storage slot.
+func gasSStoreOverride(evm *vm.EVM, contract *vm.Contract, stack *vm.Stack, _ *vm.Memory, _ uint64) (uint64, error) {
+	sstoreSetGas := params.SstoreSetGasEIP2200
+	if stateDB, ok := evm.StateDB.(GasOverridesStateDB); ok && stateDB.GetGasOverrides().SstoreSetGas != 0 {
+		sstoreSetGas = stateDB.GetGasOverrides().SstoreSetGas
+	}
+	clearingRefund := params.SstoreClearsScheduleRefundEIP2200
+	if evm.ChainConfig().IsLondon(evm.Context.BlockNumber) {
+		clearingRefund = params.SstoreClearsScheduleRefundEIP3529
+	}
+
+	// If we fail the minimum gas availability invariant, fail (0)
+	if contract.Gas <= params.SstoreSentryGasEIP2200 {
+		return 0, errors.New("not enough gas for reentrancy sentry")
+	}
+	var (
+		y, x              = stack.Back(1), stack.Back(0)
+		slot              = common.Hash(x.Bytes32())
+		current, original = evm.StateDB.GetStateAndCommittedState(contract.Address(), slot)
+		cost              = uint64(0)
+	)
+	if _, slotPresent := evm.StateDB.SlotInAccessList(contract.Address(), slot); !slotPresent {
+		cost = params.ColdSloadCostEIP2929
+		evm.StateDB.AddSlotToAccessList(contract.Address(), slot)
+	}
+	value := common.Hash(y.Bytes32())
+
+	if current == value { // noop
+		return cost + params.WarmStorageReadCostEIP2929, nil
+	}
+	if original == current {
+		if original == (common.Hash{}) { // create slot
+			if sstoreSetGas > math.MaxUint64-cost {
+				return 0, vm.ErrGasUintOverflow
+			}
+			return cost + sstoreSetGas, nil
+		}
+		if value == (common.Hash{}) { // delete slot
+			evm.StateDB.AddRefund(clearingRefund)
+		}
+		return cost + (params.SstoreResetGasEIP2200 - params.ColdSloadCostEIP2929), nil // write existing slot
+	}
+	if original != (common.Hash{}) {
+		if current == (common.Hash{}) { // recreate slot
+			evm.StateDB.SubRefund(clearingRefund)
+		} else if value == (common.Hash{}) { // delete slot
+			evm.StateDB.AddRefund(clearingRefund)
+		}
+	}
+	if original == value {
+		if original == (common.Hash{}) { // reset to original inexistent slot
+			evm.StateDB.AddRefund(sstoreSetGas - params.WarmStorageReadCostEIP2929)
+		} else { // reset to original existing slot
+			evm.StateDB.AddRefund((params.SstoreResetGasEIP2200 - params.ColdSloadCostEIP2929) - params.WarmStorageReadCostEIP2929)
+		}
+	}
+	return cost + params.WarmStorageReadCostEIP2929, nil // dirty update
+}
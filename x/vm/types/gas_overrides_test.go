@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasOverridesValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		gasOverrides GasOverrides
+		errContains  string
+	}{
+		{"empty", GasOverrides{}, ""},
+		{"valid", GasOverrides{TxDataZeroGas: 8, TxDataNonZeroGas: 32, SstoreSetGas: 10_000}, ""},
+		{"valid floors", GasOverrides{TxDataZeroGas: params.TxDataZeroGas, TxDataNonZeroGas: params.TxDataNonZeroGasEIP2028, SstoreSetGas: params.SstoreResetGasEIP2200}, ""},
+		{"tx data zero gas below floor", GasOverrides{TxDataZeroGas: 1}, "tx data zero gas"},
+		{"tx data non-zero gas below floor", GasOverrides{TxDataNonZeroGas: 4}, "tx data non-zero gas"},
+		{"sstore set gas below floor", GasOverrides{SstoreSetGas: 100}, "sstore set gas"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.gasOverrides.Validate()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errContains)
+		})
+	}
+}
+
+func TestGasOverridesIntrinsicGas(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{0, 0, 1, 2, 3}
+
+	testCases := []struct {
+		name         string
+		gasOverrides GasOverrides
+		isEIP2028    bool
+		expGas       uint64
+	}{
+		{"no overrides", GasOverrides{}, true, params.TxGas + 2*params.TxDataZeroGas + 3*params.TxDataNonZeroGasEIP2028},
+		{"no overrides, before EIP-2028", GasOverrides{}, false, params.TxGas + 2*params.TxDataZeroGas + 3*params.TxDataNonZeroGasFrontier},
+		{"zero byte override", GasOverrides{TxDataZeroGas: 10}, true, params.TxGas + 2*10 + 3*params.TxDataNonZeroGasEIP2028},
+		{"non-zero byte override", GasOverrides{TxDataNonZeroGas: 20}, true, params.TxGas + 2*params.TxDataZeroGas + 3*20},
+		{"non-zero byte override, before EIP-2028", GasOverrides{TxDataNonZeroGas: 20}, false, params.TxGas + 2*params.TxDataZeroGas + 3*20},
+		{"both overrides", GasOverrides{TxDataZeroGas: 10, TxDataNonZeroGas: 20}, true, params.TxGas + 2*10 + 3*20},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gas, err := tc.gasOverrides.IntrinsicGas(data, nil, nil, false, true, tc.isEIP2028, true)
+			require.NoError(t, err)
+			require.Equal(t, tc.expGas, gas)
+		})
+	}
+
+	_, err := GasOverrides{TxDataNonZeroGas: 1 << 63}.IntrinsicGas(data, nil, nil, false, true, true, true)
+	require.ErrorIs(t, err, core.ErrGasUintOverflow)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/cosmos/evm/types"
 )
 
@@ -66,5 +68,43 @@ func (gs GenesisState) Validate() error {
 		seenPreinstalls[preinstall.Address] = true
 	}
 
+	seenCodeMetadata := make(map[string]bool)
+	for _, metadata := range gs.CodeMetadata {
+		if err := metadata.Validate(); err != nil {
+			return fmt.Errorf("invalid code metadata for code hash %s: %w", metadata.CodeHash, err)
+		}
+
+		key := common.HexToHash(metadata.CodeHash).Hex() + "/" + metadata.Publisher
+		if seenCodeMetadata[key] {
+			return fmt.Errorf("duplicated code metadata for code hash %s published by %s", metadata.CodeHash, metadata.Publisher)
+		}
+		seenCodeMetadata[key] = true
+	}
+
+	if err := types.ValidateParamsAuthorities(gs.ParamsAuthorities, &Params{}); err != nil {
+		return fmt.Errorf("invalid params authorities: %w", err)
+	}
+
+	if err := validateParamsHistory(gs.ParamsHistory); err != nil {
+		return fmt.Errorf("invalid params history: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
+
+// validateParamsHistory checks that the parameter changes are valid and
+// recorded by strictly ascending, non-negative heights.
+func validateParamsHistory(history []ParamsChange) error {
+	for i, change := range history {
+		if change.Height < 0 {
+			return fmt.Errorf("negative change height %d", change.Height)
+		}
+		if i > 0 && change.Height <= history[i-1].Height {
+			return fmt.Errorf("change at height %d is not after the one at height %d", change.Height, history[i-1].Height)
+		}
+		if err := change.Params.Validate(); err != nil {
+			return fmt.Errorf("change at height %d: %w", change.Height, err)
+		}
+	}
+	return nil
+}
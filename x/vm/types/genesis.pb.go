@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -32,6 +33,14 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 	// preinstalls defines a set of predefined contracts
 	Preinstalls []Preinstall `protobuf:"bytes,3,rep,name=preinstalls,proto3" json:"preinstalls"`
+	// params_authorities are the accounts allowed to update a subset of the
+	// module parameters, in addition to the governance account.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,4,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+	// code_metadata is the contract code metadata published by the deployers.
+	CodeMetadata []CodeMetadata `protobuf:"bytes,5,rep,name=code_metadata,json=codeMetadata,proto3" json:"code_metadata"`
+	// params_history are the recorded changes of the module parameters, by
+	// ascending height.
+	ParamsHistory []ParamsChange `protobuf:"bytes,6,rep,name=params_history,json=paramsHistory,proto3" json:"params_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +97,27 @@ func (m *GenesisState) GetPreinstalls() []Preinstall {
 	return nil
 }
 
+func (m *GenesisState) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeMetadata() []CodeMetadata {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamsHistory() []ParamsChange {
+	if m != nil {
+		return m.ParamsHistory
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -154,37 +184,101 @@ func (m *GenesisAccount) GetStorage() Storage {
 	return nil
 }
 
+// ParamsChange is a change of the module parameters recorded in the parameter
+// change history.
+type ParamsChange struct {
+	// params are the parameters set by the change.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// height is the block height of the change.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ParamsChange) Reset()         { *m = ParamsChange{} }
+func (m *ParamsChange) String() string { return proto.CompactTextString(m) }
+func (*ParamsChange) ProtoMessage()    {}
+func (*ParamsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b6f3a3ceb84d18, []int{2}
+}
+func (m *ParamsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsChange.Merge(m, src)
+}
+func (m *ParamsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsChange proto.InternalMessageInfo
+
+func (m *ParamsChange) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ParamsChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.vm.v1.GenesisState")
 	proto.RegisterType((*GenesisAccount)(nil), "cosmos.evm.vm.v1.GenesisAccount")
+	proto.RegisterType((*ParamsChange)(nil), "cosmos.evm.vm.v1.ParamsChange")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/genesis.proto", fileDescriptor_e6b6f3a3ceb84d18) }
 
 var fileDescriptor_e6b6f3a3ceb84d18 = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x07, 0x21, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2,
-	0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c, 0x21, 0x01, 0x88, 0xbc, 0x5e, 0x6a, 0x59, 0xae,
-	0x1e, 0x08, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x22, 0x29,
-	0x29, 0x0c, 0x43, 0x40, 0xca, 0x21, 0x72, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0xba, 0xcf, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x9d, 0x8b, 0x23, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4, 0x58, 0x82, 0x51, 0x81, 0x59,
-	0x83, 0xdb, 0x48, 0x41, 0x0f, 0xdd, 0x6a, 0x3d, 0xa8, 0x0e, 0x47, 0x88, 0x42, 0x27, 0xce, 0x13,
-	0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd7, 0x2c, 0x64, 0xcd, 0xc5, 0x56,
-	0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0x81, 0x69, 0x4c,
-	0x00, 0x58, 0x1e, 0x59, 0x3b, 0x54, 0x8b, 0x90, 0x27, 0x17, 0x77, 0x41, 0x51, 0x6a, 0x66, 0x5e,
-	0x71, 0x49, 0x62, 0x4e, 0x4e, 0xb1, 0x04, 0x33, 0xd8, 0x21, 0x32, 0x58, 0x4c, 0x80, 0x2b, 0x42,
-	0x36, 0x05, 0x59, 0xaf, 0x52, 0x3b, 0x23, 0x17, 0x1f, 0xaa, 0x7b, 0x85, 0x24, 0xb8, 0xd8, 0x13,
-	0x53, 0x52, 0x8a, 0x52, 0x8b, 0x41, 0x5e, 0x64, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0x84, 0xb8,
-	0x58, 0x92, 0xf3, 0x53, 0x52, 0xc1, 0x4e, 0xe6, 0x0c, 0x02, 0xb3, 0x85, 0xdc, 0xb9, 0xd8, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x12, 0xd3, 0x53, 0xa1, 0xee, 0x10, 0xc7, 0x74, 0x07, 0x38, 0xec, 0x9c, 0x44,
-	0x40, 0x4e, 0x58, 0x75, 0x5f, 0x9e, 0x3d, 0x18, 0xa2, 0x1e, 0xe2, 0x1a, 0x98, 0x6e, 0x27, 0xab,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0x8a, 0xc2, 0x0a, 0x50, 0x24, 0x96, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0xa3, 0xcb, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x23, 0x28, 0x5f,
-	0x27, 0x02, 0x00, 0x00,
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x95, 0x93, 0xbd, 0x4e, 0xc3, 0x30,
+	0x10, 0xc7, 0x09, 0x81, 0x96, 0x9a, 0x82, 0xa8, 0x55, 0x41, 0x54, 0xa1, 0x50, 0x55, 0x0c, 0x88,
+	0x21, 0x51, 0x61, 0x83, 0x89, 0x76, 0x28, 0x0c, 0xa0, 0x2a, 0x6c, 0x0c, 0x20, 0x93, 0x5a, 0x49,
+	0x24, 0x12, 0x47, 0xb1, 0x8b, 0xe8, 0x13, 0xb0, 0xf2, 0x18, 0x88, 0x89, 0xc7, 0xe8, 0xc8, 0xc8,
+	0xc4, 0xe7, 0xc0, 0x6b, 0xe0, 0x8f, 0x14, 0x5c, 0x0a, 0x03, 0x92, 0x2f, 0xb2, 0x73, 0x77, 0x3f,
+	0xff, 0xe5, 0xbb, 0x03, 0xb6, 0x4f, 0x68, 0x4c, 0xa8, 0x8b, 0x2f, 0x63, 0x57, 0xac, 0xa6, 0x1b,
+	0xe0, 0x04, 0xd3, 0x88, 0x3a, 0x69, 0x46, 0x18, 0x81, 0x4b, 0xca, 0xef, 0x70, 0xbf, 0x23, 0x56,
+	0xb3, 0x56, 0x41, 0x71, 0x94, 0x10, 0x57, 0x7e, 0x55, 0x50, 0x6d, 0x53, 0x83, 0xb0, 0x41, 0x8a,
+	0xa9, 0xe0, 0xa4, 0x28, 0x43, 0x31, 0x3d, 0x43, 0x7d, 0x16, 0x92, 0x2c, 0x62, 0x83, 0x3c, 0xb6,
+	0x36, 0x71, 0xa1, 0x40, 0x2b, 0x5f, 0x35, 0x20, 0x01, 0x91, 0x5b, 0x57, 0xec, 0xd4, 0xdf, 0xc6,
+	0xab, 0x09, 0xca, 0x1d, 0x25, 0xea, 0x98, 0x21, 0x86, 0x61, 0x07, 0xcc, 0x21, 0xdf, 0x27, 0xfd,
+	0x84, 0x51, 0xcb, 0xa8, 0x9b, 0x1b, 0xf3, 0x5b, 0x75, 0xe7, 0xa7, 0x4c, 0x27, 0xcf, 0xd8, 0x53,
+	0x81, 0xad, 0xd2, 0xf0, 0x69, 0x6d, 0xea, 0xf6, 0xe3, 0x7e, 0xd3, 0xf0, 0xbe, 0x92, 0xe1, 0x2e,
+	0x28, 0x28, 0x95, 0xd6, 0x74, 0xdd, 0xe0, 0x18, 0x6b, 0x12, 0xd3, 0x95, 0x7e, 0x3d, 0x3d, 0x4f,
+	0x81, 0x07, 0x60, 0x3e, 0xcd, 0x70, 0x94, 0x50, 0x86, 0x2e, 0x2e, 0xa8, 0x65, 0x4a, 0x21, 0xab,
+	0xbf, 0x10, 0xbe, 0x82, 0x74, 0x8a, 0x9e, 0x0b, 0x4f, 0x01, 0xfc, 0xf1, 0x5a, 0x11, 0xa6, 0xd6,
+	0x8c, 0x24, 0xae, 0xeb, 0x44, 0xf9, 0xb8, 0xdf, 0xb2, 0xf6, 0x46, 0x6f, 0xab, 0x93, 0x2b, 0xe9,
+	0x98, 0x8f, 0x93, 0xe0, 0x11, 0x58, 0xf0, 0x49, 0x0f, 0x9f, 0xc5, 0x98, 0xa1, 0x1e, 0x62, 0xc8,
+	0x9a, 0x95, 0x68, 0x7b, 0x52, 0x6c, 0x9b, 0x87, 0x1d, 0xe6, 0x51, 0x3a, 0xb4, 0xec, 0x6b, 0x0e,
+	0xd8, 0x05, 0x8b, 0xb9, 0xde, 0x30, 0xa2, 0x8c, 0x64, 0x03, 0xab, 0xf0, 0x17, 0x50, 0x09, 0x6d,
+	0x87, 0x28, 0x09, 0xb0, 0x0e, 0x5c, 0x50, 0x80, 0x7d, 0x95, 0xdf, 0xb8, 0x36, 0xc0, 0xe2, 0x78,
+	0xc5, 0xa0, 0x05, 0x8a, 0xa8, 0xd7, 0xcb, 0x30, 0x15, 0x45, 0x36, 0x36, 0x4a, 0xde, 0xe8, 0x08,
+	0x21, 0x98, 0x11, 0x72, 0x64, 0xd1, 0x4a, 0x9e, 0xdc, 0xf3, 0x9e, 0x28, 0x0a, 0x12, 0x0a, 0x70,
+	0x5e, 0x89, 0x95, 0x49, 0x2d, 0xb2, 0x7b, 0x5a, 0x55, 0x21, 0xe2, 0xee, 0x79, 0xad, 0x78, 0xac,
+	0xe2, 0x95, 0x9e, 0x51, 0x76, 0xc3, 0x07, 0x65, 0x5d, 0xb3, 0xd6, 0x23, 0xc6, 0xff, 0x7b, 0x64,
+	0x19, 0x14, 0x42, 0x1c, 0x05, 0x21, 0x93, 0x5a, 0x4d, 0x2f, 0x3f, 0xb5, 0x76, 0x86, 0x6f, 0xb6,
+	0xf1, 0xc0, 0xed, 0x85, 0xdb, 0xcd, 0xbb, 0x3d, 0xf5, 0xc0, 0xed, 0x91, 0xdb, 0x49, 0x3d, 0x88,
+	0x58, 0xd8, 0x3f, 0xe7, 0x97, 0xc4, 0xae, 0x36, 0x29, 0x57, 0xee, 0x68, 0xb4, 0xce, 0x0b, 0x72,
+	0x2a, 0xb6, 0x3f, 0x01, 0x2d, 0x2e, 0x11, 0x46, 0xba, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +301,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParamsHistory) > 0 {
+		for iNdEx := len(m.ParamsHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CodeMetadata) > 0 {
+		for iNdEx := len(m.CodeMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Preinstalls) > 0 {
 		for iNdEx := len(m.Preinstalls) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -299,6 +435,44 @@ func (m *GenesisAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -330,6 +504,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeMetadata) > 0 {
+		for _, e := range m.CodeMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParamsHistory) > 0 {
+		for _, e := range m.ParamsHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -356,6 +548,20 @@ func (m *GenesisAccount) Size() (n int) {
 	return n
 }
 
+func (m *ParamsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -492,6 +698,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata, CodeMetadata{})
+			if err := m.CodeMetadata[len(m.CodeMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsHistory = append(m.ParamsHistory, ParamsChange{})
+			if err := m.ParamsHistory[len(m.ParamsHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -661,6 +969,108 @@ func (m *GenesisAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamsChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,25 @@
+package types
+
+// HookFailurePolicy defines how the EVM transaction processing reacts to an
+// error returned by a registered EVM hook.
+type HookFailurePolicy int32
+
+const (
+	// HookFailClosed reverts the whole EVM transaction if the hook fails.
+	HookFailClosed HookFailurePolicy = iota
+	// HookFailOpen discards the state changes of the failed hook and keeps
+	// processing the EVM transaction.
+	HookFailOpen
+)
+
+// String implements the fmt.Stringer interface.
+func (p HookFailurePolicy) String() string {
+	switch p {
+	case HookFailClosed:
+		return "fail-closed"
+	case HookFailOpen:
+		return "fail-open"
+	default:
+		return "unknown"
+	}
+}
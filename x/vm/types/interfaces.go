@@ -65,6 +65,7 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) math.LegacyDec
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	CalculateBaseFee(ctx sdk.Context) math.LegacyDec
+	AddTransientBaseFees(ctx sdk.Context, fees sdk.Coins)
 }
 
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
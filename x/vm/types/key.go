@@ -27,6 +27,9 @@ const (
 	prefixStorage
 	prefixParams
 	prefixCodeHash
+	prefixParamsAuthority
+	prefixParamsHistory
+	prefixCodeMetadata
 )
 
 // prefix bytes for the EVM transient store
@@ -39,10 +42,13 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode     = []byte{prefixCode}
-	KeyPrefixStorage  = []byte{prefixStorage}
-	KeyPrefixParams   = []byte{prefixParams}
-	KeyPrefixCodeHash = []byte{prefixCodeHash}
+	KeyPrefixCode            = []byte{prefixCode}
+	KeyPrefixStorage         = []byte{prefixStorage}
+	KeyPrefixParams          = []byte{prefixParams}
+	KeyPrefixCodeHash        = []byte{prefixCodeHash}
+	KeyPrefixParamsAuthority = []byte{prefixParamsAuthority}
+	KeyPrefixParamsHistory   = []byte{prefixParamsHistory}
+	KeyPrefixCodeMetadata    = []byte{prefixCodeMetadata}
 )
 
 // Transient Store key prefixes
@@ -62,3 +68,15 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// CodeMetadataPrefix returns a prefix to iterate over the metadata published
+// for a given code hash.
+func CodeMetadataPrefix(codeHash common.Hash) []byte {
+	return append(KeyPrefixCodeMetadata, codeHash.Bytes()...)
+}
+
+// CodeMetadataKey defines the full key under which the metadata published by
+// an account for a code hash is stored.
+func CodeMetadataKey(codeHash common.Hash, publisher []byte) []byte {
+	return append(CodeMetadataPrefix(codeHash), publisher...)
+}
@@ -16,6 +16,11 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AddTransientBaseFees provides a mock function with given fields: ctx, fees
+func (_m *FeeMarketKeeper) AddTransientBaseFees(ctx types.Context, fees types.Coins) {
+	_m.Called(ctx, fees)
+}
+
 // CalculateBaseFee provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	ret := _m.Called(ctx)
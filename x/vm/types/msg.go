@@ -13,6 +13,7 @@ import (
 	protov2 "google.golang.org/protobuf/proto"
 
 	evmapi "github.com/cosmos/evm/api/cosmos/evm/vm/v1"
+	cosmosevmtypes "github.com/cosmos/evm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -34,6 +35,8 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgUpdateParamsAuthorities{}
+	_ sdk.Msg    = &MsgRegisterCodeMetadata{}
 )
 
 // message type and route constants
@@ -350,3 +353,35 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParamsAuthorities) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return cosmosevmtypes.ValidateParamsAuthorities(m.ParamsAuthorities, &Params{})
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParamsAuthorities) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterCodeMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := ValidateCodeMetadata(m.CodeHash, m.MetadataUri); err != nil {
+		return errorsmod.Wrap(ErrInvalidCodeMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterCodeMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
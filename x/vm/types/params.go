@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"slices"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -14,6 +15,8 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -41,6 +44,9 @@ var (
 			AccessControlList: DefaultCallAllowlistAddresses,
 		},
 	}
+	// DefaultAllowedMsgTypeURLs defines the messages that can be executed through the message
+	// router precompile, none by default.
+	DefaultAllowedMsgTypeURLs []string
 )
 
 const DefaultHistoryServeWindow = 8192 // same as EIP-2935
@@ -69,6 +75,7 @@ func DefaultParams() Params {
 		EVMChannels:             DefaultEVMChannels,
 		AccessControl:           DefaultAccessControl,
 		HistoryServeWindow:      DefaultHistoryServeWindow,
+		AllowedMsgTypeURLs:      DefaultAllowedMsgTypeURLs,
 	}
 }
 
@@ -104,6 +111,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := ValidateAllowedMsgTypeURLs(p.AllowedMsgTypeURLs); err != nil {
+		return err
+	}
+
+	if err := p.CircuitBreaker.Validate(); err != nil {
+		return err
+	}
+
+	if err := p.GasOverrides.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -132,6 +151,12 @@ func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// IsAllowedMsgTypeURL returns true if the message of the given type URL can be
+// executed through the message router precompile
+func (p Params) IsAllowedMsgTypeURL(typeURL string) bool {
+	return slices.Contains(p.AllowedMsgTypeURLs, typeURL)
+}
+
 func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
@@ -226,6 +251,35 @@ func ValidatePrecompiles(i interface{}) error {
 	return nil
 }
 
+// ValidateAllowedMsgTypeURLs checks if the message type URLs allowed in the message router
+// precompile are valid and unique. Ethereum transactions cannot be allowed, to prevent
+// their execution from within the EVM.
+func ValidateAllowedMsgTypeURLs(i interface{}) error {
+	typeURLs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid msg type url slice type: %T", i)
+	}
+
+	ethereumTxTypeURL := sdk.MsgTypeURL(&MsgEthereumTx{})
+	seenTypeURLs := make(map[string]struct{})
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid msg type url %q", typeURL)
+		}
+
+		if typeURL == ethereumTxTypeURL {
+			return fmt.Errorf("msg type url %s cannot be allowed", typeURL)
+		}
+
+		if _, ok := seenTypeURLs[typeURL]; ok {
+			return fmt.Errorf("duplicate msg type url %s", typeURL)
+		}
+		seenTypeURLs[typeURL] = struct{}{}
+	}
+
+	return nil
+}
+
 // IsLondon returns if london hardfork is enabled.
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
@@ -49,6 +49,34 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "allowed msg type urls",
+			params: Params{
+				AllowedMsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid allowed msg type url",
+			params: Params{
+				AllowedMsgTypeURLs: []string{"cosmos.bank.v1beta1.MsgSend"},
+			},
+			errContains: "invalid msg type url",
+		},
+		{
+			name: "duplicate allowed msg type url",
+			params: Params{
+				AllowedMsgTypeURLs: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"},
+			},
+			errContains: "duplicate msg type url",
+		},
+		{
+			name: "ethereum tx allowed msg type url",
+			params: Params{
+				AllowedMsgTypeURLs: []string{"/cosmos.evm.vm.v1.MsgEthereumTx"},
+			},
+			errContains: "cannot be allowed",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,15 +6,38 @@ const (
 )
 
 const (
-	StakingPrecompileAddress      = "0x0000000000000000000000000000000000000800"
-	DistributionPrecompileAddress = "0x0000000000000000000000000000000000000801"
-	ICS20PrecompileAddress        = "0x0000000000000000000000000000000000000802"
-	VestingPrecompileAddress      = "0x0000000000000000000000000000000000000803"
-	BankPrecompileAddress         = "0x0000000000000000000000000000000000000804"
-	GovPrecompileAddress          = "0x0000000000000000000000000000000000000805"
-	SlashingPrecompileAddress     = "0x0000000000000000000000000000000000000806"
+	StakingPrecompileAddress       = "0x0000000000000000000000000000000000000800"
+	DistributionPrecompileAddress  = "0x0000000000000000000000000000000000000801"
+	ICS20PrecompileAddress         = "0x0000000000000000000000000000000000000802"
+	VestingPrecompileAddress       = "0x0000000000000000000000000000000000000803"
+	BankPrecompileAddress          = "0x0000000000000000000000000000000000000804"
+	GovPrecompileAddress           = "0x0000000000000000000000000000000000000805"
+	SlashingPrecompileAddress      = "0x0000000000000000000000000000000000000806"
+	ICAControllerPrecompileAddress = "0x0000000000000000000000000000000000000807"
+	IBCCorePrecompileAddress       = "0x0000000000000000000000000000000000000808"
+	AuthzPrecompileAddress         = "0x0000000000000000000000000000000000000809"
+	MsgRouterPrecompileAddress     = "0x000000000000000000000000000000000000080a"
+	ChainInfoPrecompileAddress     = "0x000000000000000000000000000000000000080d"
 )
 
+// WasmPrecompileAddress defines the address of the CosmWasm precompile.
+//
+// NOTE: It is not part of the available static precompiles, since it requires the x/wasm
+// module. Chains running x/wasm need to register it with the EVM keeper themselves.
+const WasmPrecompileAddress = "0x000000000000000000000000000000000000080b"
+
+// OraclePrecompileAddress defines the address of the oracle price feed precompile.
+//
+// NOTE: It is not part of the available static precompiles, since it requires an oracle
+// module providing the prices. Chains running one need to register it with the EVM keeper themselves.
+const OraclePrecompileAddress = "0x000000000000000000000000000000000000080c"
+
+// RevenuePrecompileAddress defines the address of the revenue precompile.
+//
+// NOTE: It is not part of the available static precompiles, since it requires the x/revenue
+// module. Chains running x/revenue need to register it with the EVM keeper themselves.
+const RevenuePrecompileAddress = "0x000000000000000000000000000000000000080e"
+
 // AvailableStaticPrecompiles defines the full list of all available EVM extension addresses.
 //
 // NOTE: To be explicit, this list does not include the dynamically registered EVM extensions
@@ -29,4 +52,9 @@ var AvailableStaticPrecompiles = []string{
 	BankPrecompileAddress,
 	GovPrecompileAddress,
 	SlashingPrecompileAddress,
+	ICAControllerPrecompileAddress,
+	IBCCorePrecompileAddress,
+	AuthzPrecompileAddress,
+	MsgRouterPrecompileAddress,
+	ChainInfoPrecompileAddress,
 }
@@ -10,6 +10,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -606,6 +607,105 @@ func (m *QueryStorageResponse) GetValue() string {
 	return ""
 }
 
+// QueryContractStorageRequest is the request type for the
+// Query/ContractStorage RPC method.
+type QueryContractStorageRequest struct {
+	// address is the ethereum hex address of the contract to query the storage
+	// for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractStorageRequest) Reset()         { *m = QueryContractStorageRequest{} }
+func (m *QueryContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageRequest) ProtoMessage()    {}
+func (*QueryContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+}
+func (m *QueryContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageRequest.Merge(m, src)
+}
+func (m *QueryContractStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageRequest proto.InternalMessageInfo
+
+// QueryContractStorageResponse is the response type for the
+// Query/ContractStorage RPC method.
+type QueryContractStorageResponse struct {
+	// storage are the set storage slots of the contract, ordered by key.
+	Storage []State `protobuf:"bytes,1,rep,name=storage,proto3" json:"storage"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractStorageResponse) Reset()         { *m = QueryContractStorageResponse{} }
+func (m *QueryContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageResponse) ProtoMessage()    {}
+func (*QueryContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+}
+func (m *QueryContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageResponse.Merge(m, src)
+}
+func (m *QueryContractStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageResponse proto.InternalMessageInfo
+
+func (m *QueryContractStorageResponse) GetStorage() []State {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *QueryContractStorageResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryCodeRequest is the request type for the Query/Code RPC method.
 type QueryCodeRequest struct {
 	// address is the ethereum hex address to query the code for.
@@ -616,7 +716,7 @@ func (m *QueryCodeRequest) Reset()         { *m = QueryCodeRequest{} }
 func (m *QueryCodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeRequest) ProtoMessage()    {}
 func (*QueryCodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{12}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
 }
 func (m *QueryCodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +756,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{13}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +804,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +845,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +896,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +935,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,13 +981,19 @@ type EthCallRequest struct {
 	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
 	// chain_id is the eip155 chain id parsed from the requested block header
 	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// overrides is the set of account overrides applied to the state before
+	// executing the call, using the same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,5,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// pending_txs are the binary encoded ethereum transactions of the pending
+	// block, executed in order before the call.
+	PendingTxs [][]byte `protobuf:"bytes,6,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,6 +1050,196 @@ func (m *EthCallRequest) GetChainId() int64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetPendingTxs() [][]byte {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return nil
+}
+
+// EthCallBatchRequest defines EthCallBatch request
+type EthCallBatchRequest struct {
+	// args are the arguments of the calls, executed in order, using the same
+	// json format as the json rpc api.
+	Args [][]byte `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	// gas_cap defines the default gas cap to be used by each call
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *EthCallBatchRequest) Reset()         { *m = EthCallBatchRequest{} }
+func (m *EthCallBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*EthCallBatchRequest) ProtoMessage()    {}
+func (*EthCallBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+}
+func (m *EthCallBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthCallBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthCallBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthCallBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthCallBatchRequest.Merge(m, src)
+}
+func (m *EthCallBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthCallBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthCallBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthCallBatchRequest proto.InternalMessageInfo
+
+func (m *EthCallBatchRequest) GetArgs() [][]byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *EthCallBatchRequest) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *EthCallBatchRequest) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *EthCallBatchRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// EthCallBatchResult defines the result of a call of a batch
+type EthCallBatchResult struct {
+	// response is the result of the call, empty if the call failed to execute
+	Response *MsgEthereumTxResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// error is the reason the call failed to execute, e.g. its invalid args.
+	// Reverted calls are executed and reported by the vm_error of the response.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EthCallBatchResult) Reset()         { *m = EthCallBatchResult{} }
+func (m *EthCallBatchResult) String() string { return proto.CompactTextString(m) }
+func (*EthCallBatchResult) ProtoMessage()    {}
+func (*EthCallBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+}
+func (m *EthCallBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthCallBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthCallBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthCallBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthCallBatchResult.Merge(m, src)
+}
+func (m *EthCallBatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthCallBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthCallBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthCallBatchResult proto.InternalMessageInfo
+
+func (m *EthCallBatchResult) GetResponse() *MsgEthereumTxResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *EthCallBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EthCallBatchResponse defines EthCallBatch response
+type EthCallBatchResponse struct {
+	// results are the results of the calls, in the order of the request args
+	Results []EthCallBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *EthCallBatchResponse) Reset()         { *m = EthCallBatchResponse{} }
+func (m *EthCallBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*EthCallBatchResponse) ProtoMessage()    {}
+func (*EthCallBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+}
+func (m *EthCallBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthCallBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthCallBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EthCallBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthCallBatchResponse.Merge(m, src)
+}
+func (m *EthCallBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthCallBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthCallBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthCallBatchResponse proto.InternalMessageInfo
+
+func (m *EthCallBatchResponse) GetResults() []EthCallBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// gas returns the estimated gas
@@ -959,7 +1255,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1332,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1434,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1494,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1589,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1634,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1673,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1415,7 +1711,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1750,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{32}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1483,143 +1779,602 @@ func (m *QueryGlobalMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryGlobalMinGasPriceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
-	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
-	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
-	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
-	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
-	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountResponse")
-	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountRequest")
-	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.evm.vm.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
-	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
-	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
-	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
-	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
-	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
-	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
-	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
-	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
-	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
-	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
-	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
-	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
-	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
-	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
-	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+// QueryEIP712TypedDataRequest defines the request type for querying the
+// EIP-712 typed data of an unsigned Cosmos transaction.
+type QueryEIP712TypedDataRequest struct {
+	// tx_bytes is the protobuf encoded unsigned transaction
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// chain_id is the Cosmos chain id the transaction is signed for
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the signer
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the sequence of the signer, used when the transaction does
+	// not contain any signer info
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
-
-var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1624 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0xec, 0x8c, 0x13, 0x08, 0x43, 0xf8, 0xe2, 0xec, 0x37, 0xb1, 0xc3, 0x42,
-	0x7e, 0x12, 0x76, 0x49, 0x4a, 0x2b, 0x95, 0x1e, 0xda, 0x24, 0x0a, 0x81, 0x02, 0x15, 0xdd, 0x46,
-	0x3d, 0x54, 0xaa, 0xac, 0xf1, 0x7a, 0x58, 0xaf, 0xe2, 0xdd, 0x31, 0x3b, 0xeb, 0xd4, 0x81, 0xd2,
-	0x43, 0xa5, 0x22, 0x28, 0x17, 0xa4, 0xde, 0x5b, 0x8e, 0xbd, 0xb5, 0x37, 0xfe, 0x05, 0x8e, 0x48,
-	0xbd, 0x54, 0x3d, 0xd0, 0x0a, 0x2a, 0xb5, 0x7f, 0x43, 0x4f, 0xd5, 0xfc, 0x58, 0xdb, 0xeb, 0xf5,
-	0xda, 0xa1, 0xa2, 0xb7, 0x4a, 0x56, 0x32, 0x3f, 0xde, 0xbc, 0xf7, 0x79, 0x6f, 0xde, 0xbc, 0xf7,
-	0xb1, 0xc1, 0x8c, 0x45, 0xa8, 0x4b, 0xa8, 0x81, 0xf7, 0x5d, 0x83, 0x7d, 0xd6, 0x8c, 0x5b, 0x0d,
-	0xec, 0x1f, 0xe8, 0x75, 0x9f, 0x04, 0x04, 0x4e, 0x8a, 0x5d, 0x1d, 0xef, 0xbb, 0x3a, 0xfb, 0xac,
-	0xa9, 0xc7, 0x90, 0xeb, 0x78, 0xc4, 0xe0, 0x7f, 0x85, 0x90, 0xba, 0x22, 0x55, 0x94, 0x11, 0xc5,
-	0xe2, 0xb4, 0xb1, 0xbf, 0x56, 0xc6, 0x01, 0x5a, 0x33, 0xea, 0xc8, 0x76, 0x3c, 0x14, 0x38, 0xc4,
-	0x93, 0xb2, 0x6a, 0xcc, 0x1c, 0x53, 0x2d, 0xf6, 0xa6, 0x63, 0x7b, 0x41, 0x53, 0x6e, 0x4d, 0xd9,
-	0xc4, 0x26, 0x7c, 0x68, 0xb0, 0x91, 0x5c, 0x9d, 0xb1, 0x09, 0xb1, 0x6b, 0xd8, 0x40, 0x75, 0xc7,
-	0x40, 0x9e, 0x47, 0x02, 0x6e, 0x89, 0xca, 0xdd, 0xa2, 0xdc, 0xe5, 0xb3, 0x72, 0xe3, 0xa6, 0x11,
-	0x38, 0x2e, 0xa6, 0x01, 0x72, 0xeb, 0x42, 0x40, 0x9b, 0x02, 0xf0, 0x43, 0x86, 0x76, 0x8b, 0x78,
-	0x37, 0x1d, 0xdb, 0xc4, 0xb7, 0x1a, 0x98, 0x06, 0xda, 0x35, 0x70, 0x3c, 0xb2, 0x4a, 0xeb, 0xc4,
-	0xa3, 0x18, 0xbe, 0x09, 0x46, 0x2d, 0xbe, 0x92, 0x57, 0xe6, 0x94, 0xa5, 0xdc, 0xfa, 0xac, 0xde,
-	0x1d, 0x1a, 0x7d, 0xab, 0x8a, 0x1c, 0x4f, 0x1e, 0x93, 0xc2, 0xda, 0xdb, 0x52, 0xdb, 0x86, 0x65,
-	0x91, 0x86, 0x17, 0x48, 0x23, 0x30, 0x0f, 0x32, 0xa8, 0x52, 0xf1, 0x31, 0xa5, 0x5c, 0xdd, 0x98,
-	0x19, 0x4e, 0x2f, 0x66, 0xef, 0x3f, 0x2e, 0x0e, 0xfd, 0xf9, 0xb8, 0x38, 0xa4, 0x59, 0x60, 0x2a,
-	0x7a, 0x54, 0x22, 0xc9, 0x83, 0x4c, 0x19, 0xd5, 0x90, 0x67, 0xe1, 0xf0, 0xac, 0x9c, 0xc2, 0xff,
-	0x83, 0x31, 0x8b, 0x54, 0x70, 0xa9, 0x8a, 0x68, 0x35, 0x3f, 0xcc, 0xf7, 0xb2, 0x6c, 0xe1, 0x32,
-	0xa2, 0x55, 0x38, 0x05, 0x46, 0x3c, 0xc2, 0x0e, 0xa5, 0xe6, 0x94, 0xa5, 0xb4, 0x29, 0x26, 0xda,
-	0xbb, 0x60, 0x5a, 0x7a, 0xcb, 0x9c, 0xf9, 0x07, 0x28, 0xef, 0x29, 0x40, 0xed, 0xa5, 0x41, 0x82,
-	0x9d, 0x07, 0x47, 0x44, 0x9c, 0x4a, 0x51, 0x4d, 0x13, 0x62, 0x75, 0x43, 0x2c, 0x42, 0x15, 0x64,
-	0x29, 0x33, 0xca, 0xf0, 0x0d, 0x73, 0x7c, 0xad, 0x39, 0x53, 0x81, 0x84, 0xd6, 0x92, 0xd7, 0x70,
-	0xcb, 0xd8, 0x97, 0x1e, 0x4c, 0xc8, 0xd5, 0x0f, 0xf8, 0xa2, 0x76, 0x15, 0xcc, 0x70, 0x1c, 0x1f,
-	0xa3, 0x9a, 0x53, 0x41, 0x01, 0xf1, 0xbb, 0x9c, 0x39, 0x05, 0xc6, 0x2d, 0xe2, 0x75, 0xe3, 0xc8,
-	0xb1, 0xb5, 0x8d, 0x98, 0x57, 0x0f, 0x15, 0x30, 0x9b, 0xa0, 0x4d, 0x3a, 0xb6, 0x08, 0x8e, 0x86,
-	0xa8, 0xa2, 0x1a, 0x43, 0xb0, 0xaf, 0xd1, 0xb5, 0x30, 0x89, 0x36, 0xc5, 0x3d, 0xbf, 0xca, 0xf5,
-	0x9c, 0x97, 0x49, 0xd4, 0x3a, 0x3a, 0x28, 0x89, 0xb4, 0xab, 0xd2, 0xd8, 0x47, 0x01, 0xf1, 0x91,
-	0x3d, 0xd8, 0x18, 0x9c, 0x04, 0xa9, 0x3d, 0x7c, 0x20, 0xf3, 0x8d, 0x0d, 0x3b, 0xcc, 0xaf, 0x4a,
-	0xf3, 0x2d, 0x65, 0xd2, 0xfc, 0x14, 0x18, 0xd9, 0x47, 0xb5, 0x46, 0x68, 0x5c, 0x4c, 0xb4, 0xb7,
-	0xc0, 0xa4, 0x4c, 0xa5, 0xca, 0x2b, 0x39, 0xb9, 0x08, 0x8e, 0x75, 0x9c, 0x93, 0x26, 0x20, 0x48,
-	0xb3, 0xdc, 0xe7, 0xa7, 0xc6, 0x4d, 0x3e, 0xd6, 0x6e, 0xcb, 0x17, 0xbf, 0xdb, 0xbc, 0x46, 0x6c,
-	0x1a, 0x9a, 0x80, 0x20, 0xcd, 0x5f, 0x8c, 0xd0, 0xcf, 0xc7, 0xf0, 0x12, 0x00, 0xed, 0xda, 0xc5,
-	0x7d, 0xcb, 0xad, 0x2f, 0x84, 0x4f, 0x9e, 0x15, 0x3a, 0x5d, 0x94, 0x49, 0x59, 0xe8, 0xf4, 0x1b,
-	0xed, 0x50, 0x99, 0x1d, 0x27, 0x3b, 0x40, 0x3e, 0x50, 0x64, 0x60, 0x43, 0xe3, 0x12, 0xe7, 0x32,
-	0x48, 0xd7, 0x88, 0xcd, 0xbc, 0x4b, 0x2d, 0xe5, 0xd6, 0x4f, 0xc4, 0xcb, 0xca, 0x35, 0x62, 0x9b,
-	0x5c, 0x04, 0xee, 0xf4, 0x00, 0xb5, 0x38, 0x10, 0x94, 0xb0, 0xd3, 0x89, 0xaa, 0x55, 0xf9, 0x6e,
-	0x20, 0x1f, 0xb9, 0x61, 0x1c, 0x34, 0x53, 0x02, 0x0c, 0x57, 0x25, 0xc0, 0x77, 0xc0, 0x68, 0x9d,
-	0xaf, 0xc8, 0xca, 0x97, 0x8f, 0x43, 0x14, 0x27, 0x36, 0xc7, 0x9e, 0x3e, 0x2f, 0x0e, 0x7d, 0xff,
-	0xc7, 0x8f, 0x2b, 0x8a, 0x29, 0x8f, 0x68, 0x4f, 0x14, 0x70, 0x64, 0x3b, 0xa8, 0x6e, 0xa1, 0x5a,
-	0xad, 0x23, 0xdc, 0xc8, 0xb7, 0x69, 0x78, 0x31, 0x6c, 0x0c, 0x4f, 0x82, 0x8c, 0x8d, 0x68, 0xc9,
-	0x42, 0x75, 0xf9, 0x46, 0x46, 0x6d, 0x44, 0xb7, 0x50, 0x1d, 0x7e, 0x0a, 0x26, 0xeb, 0x3e, 0xa9,
-	0x13, 0x8a, 0xfd, 0xd6, 0x3b, 0x63, 0x6f, 0x64, 0x7c, 0x73, 0xfd, 0xaf, 0xe7, 0x45, 0xdd, 0x76,
-	0x82, 0x6a, 0xa3, 0xac, 0x5b, 0xc4, 0x35, 0x64, 0xf3, 0x10, 0xff, 0xce, 0xd1, 0xca, 0x9e, 0x11,
-	0x1c, 0xd4, 0x31, 0xd5, 0xb7, 0xda, 0x0f, 0xdc, 0x3c, 0x1a, 0xea, 0x0a, 0x1f, 0xe7, 0x34, 0xc8,
-	0x5a, 0xac, 0x6a, 0x97, 0x9c, 0x4a, 0x3e, 0x3d, 0xa7, 0x2c, 0xa5, 0xcc, 0x0c, 0x9f, 0x5f, 0xa9,
-	0x68, 0xbb, 0xe0, 0xf8, 0x36, 0x0d, 0x1c, 0x17, 0x05, 0x78, 0x07, 0xb5, 0xa3, 0x31, 0x09, 0x52,
-	0x36, 0x12, 0xe0, 0xd3, 0x26, 0x1b, 0xb2, 0x15, 0x1f, 0x07, 0x1c, 0xf7, 0xb8, 0xc9, 0x86, 0x4c,
-	0xeb, 0xbe, 0x5b, 0xc2, 0xbe, 0x4f, 0xc4, 0x83, 0x1e, 0x33, 0x33, 0xfb, 0xee, 0x36, 0x9b, 0x6a,
-	0x0f, 0xd2, 0x61, 0x16, 0xf8, 0xc8, 0xc2, 0xbb, 0xcd, 0x30, 0x28, 0x6b, 0x20, 0xe5, 0xd2, 0xb0,
-	0xb7, 0x14, 0xe3, 0x11, 0xbe, 0x4e, 0xed, 0xed, 0xa0, 0x8a, 0x7d, 0xdc, 0x70, 0x77, 0x9b, 0x26,
-	0x93, 0x85, 0xef, 0x81, 0xf1, 0x80, 0x29, 0x29, 0xc9, 0xbe, 0x94, 0x4a, 0xea, 0x4b, 0xdc, 0x94,
-	0xec, 0x4b, 0xb9, 0xa0, 0x3d, 0x81, 0x5b, 0x60, 0xbc, 0xee, 0xe3, 0x0a, 0xb6, 0x30, 0xa5, 0xc4,
-	0xa7, 0xf9, 0x34, 0x4f, 0xc1, 0x81, 0xd6, 0x23, 0x87, 0x58, 0x5d, 0x2d, 0xd7, 0x88, 0xb5, 0x17,
-	0x56, 0xb0, 0x11, 0x1e, 0xc6, 0x1c, 0x5f, 0x13, 0xf5, 0x0b, 0xce, 0x02, 0x20, 0x44, 0xf8, 0x33,
-	0x1b, 0xe5, 0x11, 0x19, 0xe3, 0x2b, 0xbc, 0x33, 0x5d, 0x0e, 0xb7, 0x59, 0x83, 0xce, 0x67, 0xb8,
-	0x1b, 0xaa, 0x2e, 0xba, 0xb7, 0x1e, 0x76, 0x6f, 0x7d, 0x37, 0xec, 0xde, 0x9b, 0x13, 0x2c, 0xcd,
-	0x1e, 0xfd, 0x5a, 0x54, 0x44, 0xaa, 0x09, 0x4d, 0x6c, 0xbb, 0x67, 0xb6, 0x64, 0xff, 0x9d, 0x6c,
-	0x19, 0x8b, 0x64, 0x0b, 0xd4, 0xc0, 0x84, 0xf0, 0xc1, 0x45, 0xcd, 0x12, 0x4b, 0x10, 0xd0, 0x11,
-	0x86, 0xeb, 0xa8, 0xb9, 0x83, 0xe8, 0xfb, 0xe9, 0xec, 0xf0, 0x64, 0xca, 0xcc, 0x06, 0xcd, 0x92,
-	0xe3, 0x55, 0x70, 0x53, 0x5b, 0x91, 0xc5, 0xb1, 0x95, 0x0a, 0xed, 0xca, 0x55, 0x41, 0x01, 0x0a,
-	0x1f, 0x08, 0x1b, 0x6b, 0x4f, 0x52, 0xe0, 0x7f, 0x6d, 0xe1, 0x4d, 0xa6, 0xb5, 0x23, 0x75, 0x82,
-	0x66, 0x58, 0x3f, 0x06, 0xa7, 0x4e, 0xd0, 0xa4, 0xaf, 0x21, 0x75, 0xfe, 0xbb, 0xf5, 0x43, 0xde,
-	0xba, 0x76, 0x0e, 0x9c, 0x8c, 0x5d, 0x5c, 0x9f, 0x8b, 0x3e, 0xd1, 0xea, 0xf5, 0x14, 0x5f, 0xc2,
-	0xb8, 0xcd, 0x4a, 0xa7, 0xa2, 0xcb, 0x52, 0xc5, 0x05, 0x90, 0x65, 0x85, 0xbf, 0x74, 0x13, 0xcb,
-	0x5e, 0xba, 0x39, 0xfd, 0xcb, 0xf3, 0xe2, 0x09, 0xe1, 0x21, 0xad, 0xec, 0xe9, 0x0e, 0x31, 0x5c,
-	0x14, 0x54, 0xf5, 0x2b, 0x5e, 0xc0, 0x7a, 0x3c, 0x3f, 0xad, 0x15, 0x25, 0xbb, 0xd9, 0xa9, 0x91,
-	0x32, 0xaa, 0x5d, 0x77, 0xbc, 0x1d, 0x44, 0x6f, 0xf8, 0x4e, 0x8b, 0x5a, 0x68, 0x16, 0x28, 0x24,
-	0x09, 0x48, 0xc3, 0x1b, 0x60, 0xc2, 0x75, 0x3c, 0xe6, 0x74, 0xa9, 0xce, 0x36, 0xa4, 0xf5, 0x59,
-	0x76, 0x4b, 0xc9, 0x08, 0x72, 0x6e, 0x5b, 0xd5, 0xfa, 0xd7, 0x47, 0xc1, 0x08, 0xb7, 0x02, 0xbf,
-	0x52, 0x40, 0x46, 0x12, 0x2c, 0x38, 0x1f, 0xcf, 0xc2, 0x1e, 0x0c, 0x5a, 0x5d, 0x18, 0x24, 0x26,
-	0x70, 0x6a, 0x67, 0xbf, 0xfc, 0xe9, 0xf7, 0x6f, 0x86, 0xe7, 0xe1, 0x69, 0x23, 0xf6, 0xed, 0x42,
-	0x92, 0x2c, 0xe3, 0x8e, 0x4c, 0x9a, 0xbb, 0xf0, 0x5b, 0x05, 0x4c, 0x44, 0x78, 0x2c, 0x3c, 0x9b,
-	0x60, 0xa6, 0x17, 0x5f, 0x56, 0x57, 0x0f, 0x27, 0x2c, 0x91, 0xad, 0x73, 0x64, 0xab, 0x70, 0x25,
-	0x8e, 0x2c, 0xa4, 0xcc, 0x31, 0x80, 0x3f, 0x28, 0x60, 0xb2, 0x9b, 0x92, 0x42, 0x3d, 0xc1, 0x6c,
-	0x02, 0x13, 0x56, 0x8d, 0x43, 0xcb, 0x4b, 0xa4, 0x17, 0x39, 0xd2, 0x0b, 0x70, 0x3d, 0x8e, 0x74,
-	0x3f, 0x3c, 0xd3, 0x06, 0xdb, 0xc9, 0xb2, 0xef, 0xc2, 0x7b, 0x0a, 0xc8, 0x48, 0xf2, 0x99, 0x78,
-	0xb5, 0x51, 0x5e, 0x9b, 0x78, 0xb5, 0x5d, 0x1c, 0x56, 0x5b, 0xe5, 0xb0, 0x16, 0xe0, 0x99, 0x38,
-	0x2c, 0x49, 0x66, 0x69, 0x47, 0xe8, 0x1e, 0x2a, 0x20, 0x23, 0x69, 0x68, 0x22, 0x90, 0x28, 0xe7,
-	0x4d, 0x04, 0xd2, 0xc5, 0x66, 0xb5, 0x35, 0x0e, 0xe4, 0x2c, 0x5c, 0x8e, 0x03, 0xa1, 0x42, 0xb4,
-	0x8d, 0xc3, 0xb8, 0xb3, 0x87, 0x0f, 0xee, 0xc2, 0xdb, 0x20, 0xcd, 0xd8, 0x2a, 0xd4, 0x12, 0x53,
-	0xa6, 0x45, 0x81, 0xd5, 0xd3, 0x7d, 0x65, 0x24, 0x86, 0x65, 0x8e, 0xe1, 0x34, 0x3c, 0xd5, 0x2b,
-	0x9b, 0x2a, 0x91, 0x48, 0x7c, 0x06, 0x46, 0x05, 0x61, 0x83, 0x67, 0x12, 0x34, 0x47, 0x78, 0xa1,
-	0x3a, 0x3f, 0x40, 0x4a, 0x22, 0x98, 0xe3, 0x08, 0x54, 0x98, 0x8f, 0x23, 0x10, 0x64, 0x10, 0x36,
-	0x41, 0x46, 0x72, 0x41, 0x38, 0x17, 0xd7, 0x19, 0xa5, 0x89, 0xea, 0xe2, 0xa0, 0x4e, 0x16, 0xda,
-	0xd5, 0xb8, 0xdd, 0x19, 0xa8, 0xc6, 0xed, 0xe2, 0xa0, 0x5a, 0xb2, 0x98, 0xb9, 0x2f, 0x40, 0xae,
-	0x83, 0xcc, 0x1d, 0xc2, 0x7a, 0x0f, 0x9f, 0x7b, 0xb0, 0x41, 0x6d, 0x81, 0xdb, 0x9e, 0x83, 0x85,
-	0x1e, 0xb6, 0xa5, 0x38, 0x2b, 0x91, 0xf0, 0x73, 0x90, 0x91, 0x5d, 0x3e, 0x31, 0xf7, 0xa2, 0x84,
-	0x30, 0x31, 0xf7, 0xba, 0xc8, 0x42, 0x3f, 0xef, 0x45, 0x8b, 0x0f, 0x9a, 0xf0, 0xbe, 0x02, 0x40,
-	0xbb, 0xfd, 0xc0, 0xa5, 0x7e, 0xaa, 0x3b, 0xa9, 0x85, 0xba, 0x7c, 0x08, 0x49, 0x89, 0x63, 0x9e,
-	0xe3, 0x28, 0xc2, 0xd9, 0x24, 0x1c, 0xbc, 0x27, 0xb2, 0x40, 0xc8, 0x16, 0xd6, 0xa7, 0x1a, 0x74,
-	0x76, 0xbe, 0x3e, 0xd5, 0x20, 0xd2, 0x09, 0xfb, 0x05, 0x22, 0xec, 0x90, 0x2c, 0xf3, 0x25, 0x7f,
-	0x39, 0x93, 0xf8, 0xa6, 0x3a, 0x7e, 0x0b, 0x4a, 0xcc, 0xfc, 0xe8, 0x6f, 0x43, 0xfd, 0x32, 0x5f,
-	0x10, 0x2c, 0xf8, 0x9d, 0x02, 0x8e, 0xc5, 0x7a, 0x29, 0x4c, 0x2a, 0xc4, 0x49, 0x6d, 0x59, 0x3d,
-	0x7f, 0xf8, 0x03, 0x12, 0xda, 0x22, 0x87, 0x76, 0x0a, 0x16, 0xe3, 0xd0, 0x22, 0xed, 0x7b, 0xf3,
-	0xe2, 0xd3, 0x17, 0x05, 0xe5, 0xd9, 0x8b, 0x82, 0xf2, 0xdb, 0x8b, 0x82, 0xf2, 0xe8, 0x65, 0x61,
-	0xe8, 0xd9, 0xcb, 0xc2, 0xd0, 0xcf, 0x2f, 0x0b, 0x43, 0x9f, 0xcc, 0xc5, 0x09, 0x14, 0x53, 0xd2,
-	0x64, 0x6a, 0x38, 0x7d, 0x2a, 0x8f, 0x72, 0xba, 0xf6, 0xc6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x62, 0xca, 0x4c, 0x14, 0x00, 0x00,
+func (m *QueryEIP712TypedDataRequest) Reset()         { *m = QueryEIP712TypedDataRequest{} }
+func (m *QueryEIP712TypedDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEIP712TypedDataRequest) ProtoMessage()    {}
+func (*QueryEIP712TypedDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{33}
+}
+func (m *QueryEIP712TypedDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEIP712TypedDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEIP712TypedDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEIP712TypedDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEIP712TypedDataRequest.Merge(m, src)
+}
+func (m *QueryEIP712TypedDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEIP712TypedDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEIP712TypedDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEIP712TypedDataRequest proto.InternalMessageInfo
+
+func (m *QueryEIP712TypedDataRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *QueryEIP712TypedDataRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *QueryEIP712TypedDataRequest) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *QueryEIP712TypedDataRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryEIP712TypedDataResponse returns the EIP-712 typed data.
+type QueryEIP712TypedDataResponse struct {
+	// typed_data is the JSON encoded EIP-712 typed data payload
+	TypedData string `protobuf:"bytes,1,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+}
+
+func (m *QueryEIP712TypedDataResponse) Reset()         { *m = QueryEIP712TypedDataResponse{} }
+func (m *QueryEIP712TypedDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEIP712TypedDataResponse) ProtoMessage()    {}
+func (*QueryEIP712TypedDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{34}
+}
+func (m *QueryEIP712TypedDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEIP712TypedDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEIP712TypedDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEIP712TypedDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEIP712TypedDataResponse.Merge(m, src)
+}
+func (m *QueryEIP712TypedDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEIP712TypedDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEIP712TypedDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEIP712TypedDataResponse proto.InternalMessageInfo
+
+func (m *QueryEIP712TypedDataResponse) GetTypedData() string {
+	if m != nil {
+		return m.TypedData
+	}
+	return ""
+}
+
+// QueryParamsAuthoritiesRequest defines the request type for querying the
+// accounts allowed to update a subset of the x/vm parameters.
+type QueryParamsAuthoritiesRequest struct {
+}
+
+func (m *QueryParamsAuthoritiesRequest) Reset()         { *m = QueryParamsAuthoritiesRequest{} }
+func (m *QueryParamsAuthoritiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAuthoritiesRequest) ProtoMessage()    {}
+func (*QueryParamsAuthoritiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{35}
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAuthoritiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAuthoritiesRequest.Merge(m, src)
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAuthoritiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAuthoritiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAuthoritiesRequest proto.InternalMessageInfo
+
+// QueryParamsAuthoritiesResponse defines the response type for querying the
+// accounts allowed to update a subset of the x/vm parameters.
+type QueryParamsAuthoritiesResponse struct {
+	// params_authorities are the accounts and the parameters they can update.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,1,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+}
+
+func (m *QueryParamsAuthoritiesResponse) Reset()         { *m = QueryParamsAuthoritiesResponse{} }
+func (m *QueryParamsAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAuthoritiesResponse) ProtoMessage()    {}
+func (*QueryParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{36}
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAuthoritiesResponse.Merge(m, src)
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAuthoritiesResponse proto.InternalMessageInfo
+
+func (m *QueryParamsAuthoritiesResponse) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+// QueryParamsAtHeightRequest defines the request type for querying the x/vm
+// parameters in effect at a past height.
+type QueryParamsAtHeightRequest struct {
+	// height is the block height to query the parameters at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryParamsAtHeightRequest) Reset()         { *m = QueryParamsAtHeightRequest{} }
+func (m *QueryParamsAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAtHeightRequest) ProtoMessage()    {}
+func (*QueryParamsAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{37}
+}
+func (m *QueryParamsAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAtHeightRequest.Merge(m, src)
+}
+func (m *QueryParamsAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryParamsAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryParamsAtHeightResponse defines the response type for querying the
+// x/vm parameters in effect at a past height.
+type QueryParamsAtHeightResponse struct {
+	// params are the parameters in effect at the end of the queried block.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// change_height is the height of the parameter change in effect at the
+	// queried block.
+	ChangeHeight int64 `protobuf:"varint,2,opt,name=change_height,json=changeHeight,proto3" json:"change_height,omitempty"`
+}
+
+func (m *QueryParamsAtHeightResponse) Reset()         { *m = QueryParamsAtHeightResponse{} }
+func (m *QueryParamsAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsAtHeightResponse) ProtoMessage()    {}
+func (*QueryParamsAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{38}
+}
+func (m *QueryParamsAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsAtHeightResponse.Merge(m, src)
+}
+func (m *QueryParamsAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryParamsAtHeightResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryParamsAtHeightResponse) GetChangeHeight() int64 {
+	if m != nil {
+		return m.ChangeHeight
+	}
+	return 0
+}
+
+// QueryCodeMetadataRequest is the request type for the Query/CodeMetadata
+// RPC method.
+type QueryCodeMetadataRequest struct {
+	// address is the ethereum hex address of the contract to query the code
+	// metadata for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCodeMetadataRequest) Reset()         { *m = QueryCodeMetadataRequest{} }
+func (m *QueryCodeMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeMetadataRequest) ProtoMessage()    {}
+func (*QueryCodeMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{39}
+}
+func (m *QueryCodeMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeMetadataRequest.Merge(m, src)
+}
+func (m *QueryCodeMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeMetadataRequest proto.InternalMessageInfo
+
+// QueryCodeMetadataResponse is the response type for the Query/CodeMetadata
+// RPC method.
+type QueryCodeMetadataResponse struct {
+	// metadata are the metadata published for the code hash of the contract,
+	// ordered by publisher.
+	Metadata []CodeMetadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCodeMetadataResponse) Reset()         { *m = QueryCodeMetadataResponse{} }
+func (m *QueryCodeMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeMetadataResponse) ProtoMessage()    {}
+func (*QueryCodeMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{40}
+}
+func (m *QueryCodeMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeMetadataResponse.Merge(m, src)
+}
+func (m *QueryCodeMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryCodeMetadataResponse) GetMetadata() []CodeMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *QueryCodeMetadataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryConfigRequest)(nil), "cosmos.evm.vm.v1.QueryConfigRequest")
+	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.evm.vm.v1.QueryConfigResponse")
+	proto.RegisterType((*QueryAccountRequest)(nil), "cosmos.evm.vm.v1.QueryAccountRequest")
+	proto.RegisterType((*QueryAccountResponse)(nil), "cosmos.evm.vm.v1.QueryAccountResponse")
+	proto.RegisterType((*QueryCosmosAccountRequest)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountRequest")
+	proto.RegisterType((*QueryCosmosAccountResponse)(nil), "cosmos.evm.vm.v1.QueryCosmosAccountResponse")
+	proto.RegisterType((*QueryValidatorAccountRequest)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountRequest")
+	proto.RegisterType((*QueryValidatorAccountResponse)(nil), "cosmos.evm.vm.v1.QueryValidatorAccountResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.evm.vm.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.evm.vm.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryStorageRequest)(nil), "cosmos.evm.vm.v1.QueryStorageRequest")
+	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
+	proto.RegisterType((*QueryContractStorageRequest)(nil), "cosmos.evm.vm.v1.QueryContractStorageRequest")
+	proto.RegisterType((*QueryContractStorageResponse)(nil), "cosmos.evm.vm.v1.QueryContractStorageResponse")
+	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
+	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
+	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.vm.v1.QueryParamsResponse")
+	proto.RegisterType((*EthCallRequest)(nil), "cosmos.evm.vm.v1.EthCallRequest")
+	proto.RegisterType((*EthCallBatchRequest)(nil), "cosmos.evm.vm.v1.EthCallBatchRequest")
+	proto.RegisterType((*EthCallBatchResult)(nil), "cosmos.evm.vm.v1.EthCallBatchResult")
+	proto.RegisterType((*EthCallBatchResponse)(nil), "cosmos.evm.vm.v1.EthCallBatchResponse")
+	proto.RegisterType((*EstimateGasResponse)(nil), "cosmos.evm.vm.v1.EstimateGasResponse")
+	proto.RegisterType((*QueryTraceTxRequest)(nil), "cosmos.evm.vm.v1.QueryTraceTxRequest")
+	proto.RegisterType((*QueryTraceTxResponse)(nil), "cosmos.evm.vm.v1.QueryTraceTxResponse")
+	proto.RegisterType((*QueryTraceBlockRequest)(nil), "cosmos.evm.vm.v1.QueryTraceBlockRequest")
+	proto.RegisterType((*QueryTraceBlockResponse)(nil), "cosmos.evm.vm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.evm.vm.v1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.vm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryGlobalMinGasPriceRequest)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceRequest")
+	proto.RegisterType((*QueryGlobalMinGasPriceResponse)(nil), "cosmos.evm.vm.v1.QueryGlobalMinGasPriceResponse")
+	proto.RegisterType((*QueryEIP712TypedDataRequest)(nil), "cosmos.evm.vm.v1.QueryEIP712TypedDataRequest")
+	proto.RegisterType((*QueryEIP712TypedDataResponse)(nil), "cosmos.evm.vm.v1.QueryEIP712TypedDataResponse")
+	proto.RegisterType((*QueryParamsAuthoritiesRequest)(nil), "cosmos.evm.vm.v1.QueryParamsAuthoritiesRequest")
+	proto.RegisterType((*QueryParamsAuthoritiesResponse)(nil), "cosmos.evm.vm.v1.QueryParamsAuthoritiesResponse")
+	proto.RegisterType((*QueryParamsAtHeightRequest)(nil), "cosmos.evm.vm.v1.QueryParamsAtHeightRequest")
+	proto.RegisterType((*QueryParamsAtHeightResponse)(nil), "cosmos.evm.vm.v1.QueryParamsAtHeightResponse")
+	proto.RegisterType((*QueryCodeMetadataRequest)(nil), "cosmos.evm.vm.v1.QueryCodeMetadataRequest")
+	proto.RegisterType((*QueryCodeMetadataResponse)(nil), "cosmos.evm.vm.v1.QueryCodeMetadataResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
+
+var fileDescriptor_0e8f08e175b3ef0c = []byte{
+	// 2200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x8a, 0xb4, 0x48, 0x8d, 0x28, 0x5b, 0x9e, 0xc8, 0x36, 0xbd, 0x91, 0x44, 0x79, 0x6d,
+	0x59, 0xb6, 0x3e, 0x76, 0x23, 0xc5, 0x4d, 0x10, 0xa7, 0x41, 0x22, 0x2a, 0x8e, 0xed, 0x24, 0x2e,
+	0x1c, 0x46, 0xe8, 0xa1, 0x68, 0xbb, 0x58, 0x92, 0x63, 0x72, 0x61, 0x92, 0xcb, 0xee, 0x2e, 0x55,
+	0x2a, 0x8e, 0x93, 0xa0, 0x40, 0x8b, 0x7c, 0x5c, 0x02, 0x04, 0xe8, 0xa1, 0x87, 0x36, 0x28, 0x9a,
+	0xa0, 0x40, 0x0f, 0xc9, 0x2d, 0x97, 0xfc, 0x01, 0x39, 0xe4, 0x10, 0x20, 0x97, 0x20, 0x07, 0xb7,
+	0x68, 0x0b, 0x24, 0x7f, 0x41, 0x0e, 0x39, 0x65, 0x3e, 0xde, 0x2c, 0x77, 0xb9, 0xbb, 0x14, 0x53,
+	0x28, 0x45, 0x0f, 0x05, 0x44, 0x71, 0x67, 0xf6, 0xbd, 0x79, 0xbf, 0x79, 0xef, 0xcd, 0x9b, 0xf7,
+	0x1e, 0xd1, 0x7c, 0xcd, 0xf1, 0xda, 0x8e, 0x67, 0x90, 0xbd, 0xb6, 0xc1, 0xfe, 0x36, 0x8d, 0x5f,
+	0xf5, 0x88, 0xbb, 0xaf, 0x77, 0x5d, 0xc7, 0x77, 0xf0, 0xac, 0x78, 0xab, 0xd3, 0xb7, 0x3a, 0xfb,
+	0xdb, 0x54, 0x4f, 0x58, 0x6d, 0xbb, 0xe3, 0x18, 0xfc, 0xbf, 0x20, 0x52, 0x57, 0x61, 0x89, 0xaa,
+	0xe5, 0x11, 0xc1, 0x4d, 0x97, 0xa9, 0x12, 0xdf, 0xda, 0x34, 0xba, 0x56, 0xc3, 0xee, 0x58, 0xbe,
+	0xed, 0x74, 0x86, 0x68, 0x99, 0x38, 0x7f, 0xbf, 0x4b, 0x3c, 0x26, 0xb1, 0x6b, 0xb9, 0x56, 0xdb,
+	0x33, 0xad, 0x9e, 0xdf, 0x74, 0x5c, 0xdb, 0x07, 0xe1, 0xaa, 0x1a, 0x83, 0xc6, 0x60, 0x88, 0x77,
+	0x67, 0x62, 0xef, 0xfc, 0x3e, 0xbc, 0x9a, 0x6b, 0x38, 0x0d, 0x87, 0x3f, 0x1a, 0xec, 0x09, 0x66,
+	0xe7, 0x1b, 0x8e, 0xd3, 0x68, 0x11, 0xc3, 0xea, 0xda, 0x86, 0xd5, 0xe9, 0x38, 0x3e, 0x47, 0xe5,
+	0xc1, 0xdb, 0x12, 0xbc, 0xe5, 0xa3, 0x6a, 0xef, 0xb6, 0xe1, 0xdb, 0x6d, 0xe2, 0xf9, 0x56, 0xbb,
+	0x2b, 0x08, 0xb4, 0x39, 0x84, 0x5f, 0x60, 0x3b, 0xdb, 0x71, 0x3a, 0xb7, 0xed, 0x46, 0x85, 0xd0,
+	0x6d, 0x7a, 0xbe, 0xf6, 0x3c, 0x7a, 0x20, 0x32, 0xeb, 0x75, 0xe9, 0x92, 0x04, 0xff, 0x08, 0x4d,
+	0xd6, 0xf8, 0x4c, 0x51, 0x59, 0x52, 0x2e, 0x4e, 0x6f, 0x2d, 0xe8, 0xc3, 0x6a, 0xd4, 0x77, 0x9a,
+	0x96, 0xdd, 0x01, 0x36, 0x20, 0xd6, 0x1e, 0x83, 0xd5, 0xb6, 0x6b, 0x35, 0xa7, 0xd7, 0xf1, 0x41,
+	0x08, 0x2e, 0xa2, 0x9c, 0x55, 0xaf, 0xbb, 0xc4, 0xf3, 0xf8, 0x72, 0x53, 0x15, 0x39, 0xbc, 0x92,
+	0x7f, 0xfd, 0xdd, 0xd2, 0x91, 0xaf, 0xe9, 0x47, 0xab, 0xa1, 0xb9, 0x28, 0x2b, 0x20, 0xa1, 0xbc,
+	0x55, 0xab, 0x65, 0x75, 0x6a, 0x44, 0xf2, 0xc2, 0x10, 0x3f, 0x88, 0xa6, 0x6a, 0x4e, 0x9d, 0x98,
+	0x4d, 0xcb, 0x6b, 0x16, 0x27, 0xf8, 0xbb, 0x3c, 0x9b, 0xb8, 0x4e, 0xc7, 0x78, 0x0e, 0x1d, 0xed,
+	0x38, 0x8c, 0x29, 0x43, 0x5f, 0x64, 0x2b, 0x62, 0xa0, 0x3d, 0x89, 0xce, 0xc0, 0x6e, 0xd9, 0x66,
+	0xfe, 0x03, 0x94, 0xbf, 0x53, 0x90, 0x9a, 0xb4, 0x02, 0x80, 0x5d, 0x46, 0xc7, 0x84, 0x9e, 0xcc,
+	0xe8, 0x4a, 0x33, 0x62, 0x76, 0x5b, 0x4c, 0x62, 0x15, 0xe5, 0x3d, 0x26, 0x94, 0xe1, 0x9b, 0xe0,
+	0xf8, 0x82, 0x31, 0x5b, 0xc2, 0x12, 0xab, 0x9a, 0x9d, 0x5e, 0xbb, 0x4a, 0x5c, 0xd8, 0xc1, 0x0c,
+	0xcc, 0xfe, 0x84, 0x4f, 0x6a, 0xcf, 0xa1, 0x79, 0x8e, 0xe3, 0xa7, 0x56, 0xcb, 0xae, 0x5b, 0xbe,
+	0xe3, 0x0e, 0x6d, 0xe6, 0x2c, 0x2a, 0x50, 0x9b, 0x0c, 0xe3, 0x98, 0x66, 0x73, 0xdb, 0xb1, 0x5d,
+	0xbd, 0xa5, 0xa0, 0x85, 0x94, 0xd5, 0x60, 0x63, 0x2b, 0xe8, 0xb8, 0x44, 0x15, 0x5d, 0x51, 0x82,
+	0x3d, 0xc4, 0xad, 0x49, 0x27, 0x2a, 0x0b, 0x3b, 0x7f, 0x1f, 0xf3, 0x3c, 0x04, 0x4e, 0x14, 0xb0,
+	0x1e, 0xe4, 0x44, 0x54, 0x8f, 0x42, 0xd8, 0x8b, 0x74, 0xd3, 0x56, 0xe3, 0x60, 0x61, 0x78, 0x16,
+	0x65, 0xee, 0x90, 0x7d, 0xf0, 0x37, 0xf6, 0x18, 0x12, 0xbf, 0x0e, 0xe2, 0x83, 0xc5, 0x40, 0x3c,
+	0x75, 0xc6, 0x3d, 0xab, 0xd5, 0x93, 0xc2, 0xc5, 0x40, 0x7b, 0x43, 0x41, 0x0f, 0xca, 0xb3, 0xe7,
+	0xbb, 0x56, 0xcd, 0x1f, 0x1b, 0xc3, 0x33, 0x08, 0x0d, 0xc2, 0x12, 0x87, 0x32, 0xbd, 0x75, 0x41,
+	0x9e, 0x50, 0x16, 0xc3, 0x74, 0x11, 0x01, 0x21, 0x86, 0xe9, 0xb7, 0x06, 0xab, 0x56, 0x42, 0x9c,
+	0x21, 0xe4, 0xef, 0x29, 0xe0, 0x4f, 0x31, 0x2c, 0xb0, 0x85, 0x1f, 0xa3, 0x9c, 0x27, 0xa6, 0x28,
+	0x98, 0x0c, 0x95, 0x77, 0x3a, 0x1e, 0x11, 0x5e, 0xa4, 0x11, 0x89, 0x94, 0xa7, 0x3e, 0xb9, 0x5f,
+	0x3a, 0xf2, 0xd7, 0xaf, 0x3e, 0x5c, 0x55, 0x2a, 0x92, 0x05, 0x5f, 0x4b, 0x00, 0xbc, 0x72, 0x20,
+	0x60, 0x21, 0x3a, 0x8c, 0x58, 0x7b, 0x04, 0xcd, 0x02, 0xcc, 0xfa, 0xf7, 0x72, 0x8c, 0x15, 0x74,
+	0x22, 0xc4, 0x07, 0x7b, 0xc2, 0x28, 0xcb, 0xe2, 0x05, 0xe7, 0x2a, 0x54, 0xf8, 0xb3, 0xf6, 0x12,
+	0x44, 0xc9, 0xdd, 0xfe, 0xf3, 0x4e, 0xc3, 0x93, 0x22, 0x28, 0x25, 0x8f, 0x32, 0x62, 0x7d, 0xfe,
+	0xfc, 0x03, 0x18, 0x81, 0x3a, 0xc4, 0x03, 0x11, 0xe1, 0x80, 0xf3, 0x12, 0xca, 0xb6, 0xe8, 0x18,
+	0x14, 0x7f, 0x32, 0xae, 0x78, 0x4a, 0x5d, 0xe1, 0x24, 0x87, 0xa7, 0x68, 0x79, 0x5b, 0xdc, 0xe2,
+	0x17, 0x9b, 0xbc, 0x2d, 0x2a, 0x00, 0x50, 0xce, 0x02, 0xc0, 0xc7, 0xd1, 0xa4, 0xb8, 0x00, 0xe1,
+	0xb6, 0x28, 0xc6, 0x21, 0x0a, 0x8e, 0xb0, 0x73, 0x00, 0x8b, 0xf6, 0x8d, 0x82, 0x8e, 0x5d, 0xf5,
+	0x9b, 0x3b, 0x56, 0xab, 0x15, 0x52, 0xb7, 0xe5, 0x36, 0x3c, 0x69, 0x18, 0xf6, 0x8c, 0x4f, 0xa3,
+	0x5c, 0xc3, 0xf2, 0xcc, 0x9a, 0xd5, 0x85, 0xb8, 0x32, 0x49, 0x87, 0x3b, 0x56, 0x17, 0xff, 0x02,
+	0xcd, 0xd2, 0x0b, 0xae, 0xeb, 0x78, 0xc4, 0x0d, 0x62, 0x13, 0x8b, 0x2b, 0x85, 0xf2, 0xd6, 0xb7,
+	0xf7, 0x4b, 0x7a, 0xc3, 0xf6, 0x9b, 0xbd, 0x2a, 0x05, 0xd4, 0x36, 0xe0, 0xc2, 0x15, 0x5f, 0x1b,
+	0x5e, 0xfd, 0x8e, 0xb8, 0xbf, 0xf5, 0x9d, 0x41, 0x50, 0xac, 0x1c, 0x97, 0x6b, 0xc9, 0x80, 0x76,
+	0x06, 0xe5, 0x6b, 0xec, 0xa6, 0x33, 0xed, 0x7a, 0x31, 0x4b, 0x97, 0xcd, 0x54, 0x72, 0x7c, 0x7c,
+	0xa3, 0x8e, 0xe7, 0xd1, 0x94, 0xb3, 0x47, 0x5c, 0xd7, 0xae, 0x13, 0xaf, 0x78, 0x94, 0x63, 0x1d,
+	0x4c, 0xe0, 0x12, 0x9a, 0xee, 0x92, 0x4e, 0xdd, 0xee, 0x34, 0x4c, 0xbf, 0xef, 0x15, 0x27, 0xa9,
+	0xf1, 0x0a, 0x54, 0xc5, 0x62, 0x6a, 0xb7, 0xef, 0x69, 0x1f, 0x53, 0x73, 0xc3, 0xc6, 0xcb, 0x96,
+	0x5f, 0x6b, 0xc6, 0x77, 0x9f, 0xf9, 0x1f, 0xde, 0xbd, 0xe6, 0x20, 0x1c, 0x45, 0xef, 0xf5, 0x5a,
+	0x3e, 0xde, 0x41, 0x79, 0x17, 0xdc, 0x02, 0x9c, 0x61, 0x25, 0xee, 0x0c, 0x37, 0xbd, 0x06, 0x65,
+	0x25, 0x2e, 0xe9, 0xb5, 0x77, 0xfb, 0x81, 0xfb, 0x05, 0x8c, 0x2c, 0x5e, 0x52, 0x2d, 0x3a, 0x2e,
+	0x44, 0x59, 0x31, 0xd0, 0x7e, 0x8e, 0xe6, 0x86, 0x04, 0x0a, 0xea, 0xa7, 0x51, 0xce, 0xe5, 0xc2,
+	0xe5, 0x09, 0x39, 0x1f, 0x97, 0x18, 0x47, 0x5a, 0xce, 0x32, 0x57, 0xac, 0x48, 0x56, 0x6d, 0x97,
+	0x1a, 0xc3, 0xa3, 0x39, 0x13, 0x0d, 0x61, 0xd7, 0xac, 0x81, 0x6b, 0xd3, 0x70, 0x4f, 0x35, 0xcd,
+	0xb7, 0x92, 0xad, 0xb0, 0x47, 0x36, 0xe3, 0x12, 0x9f, 0x43, 0x2b, 0x54, 0xd8, 0x23, 0x53, 0xd2,
+	0x5e, 0xdb, 0x14, 0x88, 0x33, 0x22, 0x02, 0xed, 0xb5, 0xaf, 0x72, 0xcc, 0x6f, 0x64, 0xe5, 0x91,
+	0xa6, 0x41, 0x95, 0xb0, 0xcd, 0x0a, 0x1b, 0x6f, 0xa2, 0x4c, 0xdb, 0x93, 0xc9, 0x55, 0xe9, 0x20,
+	0x0d, 0x31, 0x5a, 0xfc, 0x14, 0x2a, 0xb0, 0xc8, 0x4c, 0x4c, 0x48, 0xcc, 0x32, 0x69, 0x89, 0x19,
+	0x17, 0x05, 0x89, 0xd9, 0xb4, 0x3f, 0x18, 0x50, 0xdb, 0x14, 0xba, 0x2e, 0xa9, 0x93, 0x1a, 0xb5,
+	0xac, 0xe3, 0x7a, 0xd4, 0xa0, 0x99, 0x71, 0xa4, 0x47, 0x98, 0x58, 0x62, 0x51, 0x6d, 0x39, 0xb5,
+	0x3b, 0xf2, 0x0a, 0x3f, 0xca, 0xbd, 0x62, 0x9a, 0xcf, 0x89, 0x0b, 0x1c, 0x2f, 0x20, 0x24, 0x48,
+	0x78, 0xcc, 0x9c, 0xe4, 0x1a, 0x99, 0xe2, 0x33, 0x3c, 0x35, 0xbb, 0x2e, 0x5f, 0xb3, 0x0c, 0xb5,
+	0x98, 0xe3, 0xdb, 0x50, 0x75, 0x91, 0xbe, 0xea, 0x32, 0x7d, 0xd5, 0x77, 0x65, 0xfa, 0x5a, 0x9e,
+	0x61, 0x86, 0x7a, 0xfb, 0xef, 0x25, 0x45, 0xc4, 0x0d, 0xb1, 0x12, 0x7b, 0x9d, 0xe8, 0xfc, 0xf9,
+	0x1f, 0xc6, 0xf9, 0xa7, 0xa2, 0x47, 0x5f, 0x43, 0x33, 0x62, 0x0f, 0x6d, 0xab, 0x6f, 0x32, 0x07,
+	0x41, 0x21, 0x35, 0xdc, 0xb4, 0xfa, 0xd4, 0x85, 0x9e, 0xcd, 0xe6, 0x27, 0x66, 0x33, 0x95, 0xbc,
+	0xdf, 0x37, 0xed, 0x4e, 0x9d, 0xf4, 0xb5, 0x55, 0xc8, 0x0e, 0x02, 0x57, 0x18, 0x5c, 0x43, 0x34,
+	0xe7, 0xb2, 0x64, 0xb4, 0x63, 0xcf, 0xda, 0x47, 0x19, 0x74, 0x6a, 0x40, 0x5c, 0x66, 0xab, 0x86,
+	0x5c, 0x87, 0xc5, 0x13, 0x65, 0x3c, 0xe3, 0x31, 0xda, 0x43, 0x70, 0x9d, 0xff, 0x5b, 0x7d, 0x4c,
+	0xab, 0x6b, 0x1b, 0xe8, 0x74, 0xcc, 0x70, 0x23, 0x0c, 0x7d, 0x32, 0x48, 0x76, 0x3d, 0xf2, 0x0c,
+	0x21, 0x83, 0xb2, 0x6c, 0x2e, 0x3a, 0x0d, 0x4b, 0x5c, 0x46, 0x79, 0x76, 0x8b, 0x9b, 0xb7, 0x09,
+	0x24, 0x93, 0xe5, 0x33, 0x5f, 0xde, 0x2f, 0x9d, 0x14, 0x3b, 0xa4, 0x1b, 0xd4, 0x6d, 0xc7, 0xa0,
+	0x21, 0xac, 0xa9, 0xdf, 0xa0, 0xc9, 0x7b, 0xae, 0x2a, 0xb8, 0xb5, 0x12, 0xa4, 0xf7, 0xd7, 0x5a,
+	0x0e, 0xcd, 0x7b, 0x6f, 0xda, 0x1d, 0x0a, 0xf4, 0x96, 0x6b, 0x07, 0xb9, 0x35, 0x2d, 0xbe, 0x16,
+	0xd3, 0x08, 0x40, 0xf0, 0x36, 0x9a, 0xa1, 0xf5, 0x32, 0xdb, 0xb4, 0xd9, 0x65, 0x2f, 0x40, 0xfa,
+	0x02, 0xb3, 0x52, 0x3a, 0x82, 0xe9, 0xf6, 0x60, 0x29, 0xed, 0xf7, 0x32, 0xdf, 0xbd, 0x7a, 0xe3,
+	0xd6, 0xa3, 0x9b, 0x5b, 0xbb, 0xd4, 0x14, 0xf5, 0xa7, 0xa9, 0x0e, 0xa4, 0x63, 0x53, 0xc5, 0xd3,
+	0xb3, 0x52, 0xdd, 0xf7, 0x89, 0xbc, 0xf9, 0x73, 0x7e, 0xbf, 0xcc, 0x86, 0x11, 0x9b, 0x88, 0x3b,
+	0x21, 0xb0, 0xc9, 0x78, 0x45, 0x45, 0xa4, 0x2e, 0xc9, 0x46, 0xeb, 0x12, 0xed, 0x09, 0xc8, 0x7d,
+	0x63, 0xb8, 0x60, 0xef, 0xd4, 0xb3, 0x99, 0xdf, 0xd4, 0xcd, 0xc0, 0x7a, 0xd4, 0xb3, 0x7d, 0x49,
+	0x16, 0x68, 0x57, 0xa4, 0x38, 0xdb, 0xd0, 0x02, 0xb0, 0x49, 0x90, 0x35, 0xbd, 0xa6, 0x80, 0x7a,
+	0x13, 0x28, 0x40, 0xc4, 0x2f, 0x11, 0x1e, 0x6a, 0x21, 0xd8, 0x24, 0xf1, 0x3a, 0x13, 0x0e, 0x1c,
+	0x24, 0x54, 0x72, 0xad, 0xfd, 0x70, 0x66, 0x75, 0xa2, 0x3b, 0x2c, 0x47, 0xbb, 0x0c, 0x65, 0x2b,
+	0x70, 0xf9, 0xd7, 0x89, 0xdd, 0x68, 0x06, 0xc5, 0xe2, 0x29, 0x34, 0xd9, 0xe4, 0x13, 0x7c, 0x73,
+	0x99, 0x0a, 0x8c, 0xb4, 0x57, 0xc1, 0x60, 0xc3, 0x5c, 0x87, 0x90, 0xf6, 0xe1, 0x73, 0x68, 0x86,
+	0x9a, 0xb0, 0xd3, 0xa0, 0xf5, 0xbb, 0x10, 0x3d, 0xc1, 0x45, 0x17, 0xc4, 0xa4, 0x90, 0xc4, 0xca,
+	0xed, 0x62, 0x90, 0xb7, 0xdf, 0xa4, 0x19, 0x6b, 0x3d, 0xe4, 0x2f, 0xff, 0xcd, 0xfa, 0xe8, 0x6f,
+	0x4a, 0xd0, 0x39, 0x08, 0x03, 0x01, 0x45, 0x5c, 0x45, 0xf9, 0x36, 0xcc, 0x81, 0xcd, 0x16, 0x13,
+	0xfa, 0x25, 0x21, 0xce, 0xb0, 0x42, 0x02, 0xd6, 0x43, 0x4b, 0xde, 0xb7, 0x3e, 0x3d, 0x85, 0x8e,
+	0x72, 0xb4, 0xf8, 0xb7, 0x0a, 0xca, 0x41, 0x2d, 0x8f, 0x97, 0xe3, 0x98, 0x12, 0x9a, 0x35, 0xea,
+	0x85, 0x83, 0xc8, 0x84, 0x40, 0x6d, 0xed, 0x37, 0x9f, 0xff, 0xfb, 0x9d, 0x89, 0x65, 0x7c, 0xce,
+	0x88, 0x35, 0xb2, 0xe0, 0xe8, 0x19, 0x77, 0xc1, 0x20, 0xf7, 0xf0, 0x1f, 0x15, 0x34, 0x13, 0x69,
+	0x99, 0xe0, 0xb5, 0x14, 0x31, 0x49, 0xad, 0x19, 0x75, 0x7d, 0x3c, 0x62, 0x40, 0xb6, 0xc5, 0x91,
+	0xad, 0xe3, 0xd5, 0x38, 0x32, 0xd9, 0x9d, 0x89, 0x01, 0xfc, 0x40, 0x41, 0xb3, 0xc3, 0xdd, 0x0f,
+	0xac, 0xa7, 0x88, 0x4d, 0x69, 0xba, 0xa8, 0xc6, 0xd8, 0xf4, 0x80, 0xf4, 0x0a, 0x47, 0x7a, 0x19,
+	0x6f, 0xc5, 0x91, 0xee, 0x49, 0x9e, 0x01, 0xd8, 0x70, 0x43, 0xe7, 0x1e, 0xa6, 0x67, 0x23, 0x07,
+	0x7d, 0x8e, 0x54, 0xd3, 0x46, 0x5b, 0x28, 0xa9, 0xa6, 0x1d, 0x6a, 0x97, 0x68, 0xeb, 0x1c, 0xd6,
+	0x05, 0x7c, 0x3e, 0x0e, 0x0b, 0xfa, 0x26, 0x5e, 0x48, 0x75, 0x6f, 0x51, 0x20, 0xd0, 0x2e, 0x48,
+	0x05, 0x12, 0x6d, 0x6d, 0xa4, 0x02, 0x19, 0xea, 0x3a, 0x68, 0x9b, 0x1c, 0xc8, 0x1a, 0xbe, 0x14,
+	0x07, 0x02, 0xad, 0x85, 0x01, 0x0e, 0xe3, 0xee, 0x1d, 0xb2, 0x7f, 0x0f, 0xbf, 0xaf, 0xa0, 0xe3,
+	0x43, 0x4d, 0x0c, 0xbc, 0x91, 0xea, 0x3e, 0x49, 0x8d, 0x17, 0x55, 0x1f, 0x97, 0x1c, 0x50, 0x5e,
+	0xe6, 0x28, 0x75, 0xbc, 0x9e, 0xe4, 0x6f, 0x82, 0xc5, 0x8c, 0xc1, 0xc5, 0x2f, 0xa1, 0x2c, 0x0b,
+	0x09, 0x58, 0x4b, 0x95, 0x16, 0xb4, 0x38, 0xd4, 0x73, 0x23, 0x69, 0x00, 0xc6, 0x25, 0x0e, 0xe3,
+	0x1c, 0x3e, 0x9b, 0x04, 0xa3, 0x1e, 0x31, 0xd9, 0xaf, 0xd1, 0xa4, 0x88, 0xcc, 0xf8, 0x7c, 0xca,
+	0xca, 0x91, 0xba, 0x5f, 0x5d, 0x3e, 0x80, 0x0a, 0x10, 0x2c, 0x71, 0x04, 0x2a, 0x2e, 0xc6, 0x11,
+	0x40, 0xd4, 0xef, 0xa3, 0x1c, 0x94, 0x62, 0x78, 0x29, 0xb5, 0x4a, 0x93, 0x52, 0xc7, 0xad, 0x1c,
+	0x35, 0x8d, 0xcb, 0x9d, 0xc7, 0x6a, 0x5c, 0x2e, 0xf1, 0x9b, 0xb4, 0x6a, 0xa6, 0xe2, 0xde, 0x54,
+	0x50, 0x21, 0x5c, 0x05, 0x26, 0xb9, 0x6a, 0x42, 0x35, 0x9e, 0xe4, 0xaa, 0x49, 0x55, 0xa8, 0x0c,
+	0x87, 0x57, 0x94, 0x55, 0x6d, 0x29, 0x1d, 0x86, 0x59, 0xe5, 0xb2, 0x5f, 0x41, 0xd3, 0xa1, 0x62,
+	0x73, 0x0c, 0x55, 0x24, 0x81, 0x8d, 0x57, 0xab, 0xda, 0x05, 0x0e, 0x62, 0x09, 0x2f, 0x26, 0x20,
+	0x00, 0x72, 0x96, 0xc2, 0xe1, 0x97, 0x51, 0x0e, 0xaa, 0x90, 0xd4, 0x13, 0x1b, 0x2d, 0x58, 0x53,
+	0x4f, 0xec, 0x50, 0x31, 0x33, 0xca, 0x14, 0xa2, 0x04, 0xf1, 0xfb, 0xf8, 0x75, 0x05, 0xa1, 0x41,
+	0x7a, 0x8c, 0x2f, 0x8e, 0x5a, 0x3a, 0x5c, 0xfa, 0xa8, 0x97, 0xc6, 0xa0, 0x04, 0x1c, 0xcb, 0x1c,
+	0x47, 0x09, 0x2f, 0xa4, 0xe1, 0xe0, 0x39, 0x3b, 0x53, 0x04, 0xa4, 0xd8, 0x23, 0x62, 0x68, 0x38,
+	0x33, 0x1f, 0x11, 0x43, 0x23, 0x99, 0xfa, 0x28, 0x45, 0xc8, 0x0c, 0x9e, 0x1d, 0x43, 0xa8, 0xaf,
+	0xce, 0xa7, 0x87, 0x9c, 0xc1, 0x8f, 0x35, 0xa9, 0xc7, 0x30, 0xfa, 0xe3, 0xcd, 0xa8, 0x63, 0x28,
+	0x0a, 0x40, 0xfc, 0x27, 0x05, 0x9d, 0x88, 0xe5, 0xfa, 0x38, 0xed, 0xfa, 0x4a, 0x2b, 0x1b, 0xd4,
+	0x87, 0xc6, 0x67, 0x00, 0x68, 0x2b, 0x1c, 0xda, 0x59, 0x5c, 0x8a, 0x43, 0x8b, 0x94, 0x17, 0xf8,
+	0x5d, 0x1a, 0xc6, 0x87, 0xf2, 0xf1, 0xd4, 0x30, 0x9e, 0x5c, 0x4f, 0xa4, 0x86, 0xf1, 0x94, 0x34,
+	0x5f, 0xd3, 0x39, 0xb6, 0x8b, 0xec, 0x04, 0x27, 0xe4, 0x34, 0xc4, 0xee, 0x52, 0x2e, 0x73, 0x50,
+	0x08, 0xe0, 0xbf, 0x50, 0x25, 0xc6, 0x32, 0xfa, 0x54, 0x25, 0xa6, 0x55, 0x07, 0xa9, 0x4a, 0x4c,
+	0x2d, 0x16, 0x46, 0x5d, 0xcf, 0xf1, 0x22, 0x02, 0xff, 0x59, 0x41, 0xc7, 0xa2, 0x09, 0x3c, 0x5e,
+	0x1f, 0x2d, 0x32, 0x5a, 0x1d, 0xa8, 0x1b, 0x63, 0x52, 0x03, 0xba, 0x87, 0x39, 0xba, 0x0d, 0xbc,
+	0x96, 0x8e, 0xce, 0x87, 0x9c, 0xdf, 0xb8, 0x2b, 0xbe, 0xef, 0xe1, 0x3f, 0xd0, 0xe8, 0x1c, 0x4e,
+	0x90, 0xf1, 0xea, 0x88, 0x1b, 0x6f, 0xa8, 0x10, 0x50, 0xd7, 0xc6, 0xa2, 0x3d, 0x38, 0xa5, 0xe0,
+	0xbf, 0x26, 0xca, 0x6c, 0x7c, 0x70, 0x5b, 0x96, 0xaf, 0x7c, 0xf2, 0xcf, 0x45, 0xe5, 0x33, 0xfa,
+	0xf9, 0x07, 0xfd, 0xbc, 0xfd, 0xaf, 0xc5, 0x23, 0x9f, 0xd1, 0xcf, 0x17, 0xf4, 0xf3, 0xb3, 0xa5,
+	0x78, 0xb3, 0x81, 0x2d, 0xd7, 0x37, 0xe4, 0x6f, 0xc3, 0xd5, 0x49, 0xde, 0xda, 0x78, 0xf8, 0x3b,
+	0x94, 0xe3, 0x60, 0x5c, 0xa5, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1646,12 +2401,17 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
+	// ContractStorage queries all the storage slots of a contract.
+	ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
+	// EthCallBatch executes a batch of `eth_call` requests against the state of
+	// a single height
+	EthCallBatch(ctx context.Context, in *EthCallBatchRequest, opts ...grpc.CallOption) (*EthCallBatchResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
@@ -1670,6 +2430,17 @@ type QueryClient interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinGasPriceRequest, opts ...grpc.CallOption) (*QueryGlobalMinGasPriceResponse, error)
+	// EIP712TypedData returns the EIP-712 typed data that must be signed to
+	// authorize the given unsigned Cosmos transaction.
+	EIP712TypedData(ctx context.Context, in *QueryEIP712TypedDataRequest, opts ...grpc.CallOption) (*QueryEIP712TypedDataResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/vm module parameters.
+	ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/vm module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error)
+	// CodeMetadata queries the metadata published for the code of a contract.
+	CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error)
 }
 
 type queryClient struct {
@@ -1725,16 +2496,25 @@ func (c *queryClient) Storage(ctx context.Context, in *QueryStorageRequest, opts
 	return out, nil
 }
 
-func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
-	out := new(QueryCodeResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Code", in, out, opts...)
+func (c *queryClient) ContractStorage(ctx context.Context, in *QueryContractStorageRequest, opts ...grpc.CallOption) (*QueryContractStorageResponse, error) {
+	out := new(QueryContractStorageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/ContractStorage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
+	out := new(QueryCodeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Code", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Params", in, out, opts...)
 	if err != nil {
@@ -1752,6 +2532,15 @@ func (c *queryClient) EthCall(ctx context.Context, in *EthCallRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) EthCallBatch(ctx context.Context, in *EthCallBatchRequest, opts ...grpc.CallOption) (*EthCallBatchResponse, error) {
+	out := new(EthCallBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/EthCallBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
 	out := new(EstimateGasResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/EstimateGas", in, out, opts...)
@@ -1806,6 +2595,42 @@ func (c *queryClient) GlobalMinGasPrice(ctx context.Context, in *QueryGlobalMinG
 	return out, nil
 }
 
+func (c *queryClient) EIP712TypedData(ctx context.Context, in *QueryEIP712TypedDataRequest, opts ...grpc.CallOption) (*QueryEIP712TypedDataResponse, error) {
+	out := new(QueryEIP712TypedDataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/EIP712TypedData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAuthorities(ctx context.Context, in *QueryParamsAuthoritiesRequest, opts ...grpc.CallOption) (*QueryParamsAuthoritiesResponse, error) {
+	out := new(QueryParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/ParamsAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsAtHeight(ctx context.Context, in *QueryParamsAtHeightRequest, opts ...grpc.CallOption) (*QueryParamsAtHeightResponse, error) {
+	out := new(QueryParamsAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/ParamsAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error) {
+	out := new(QueryCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/CodeMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1820,12 +2645,17 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// Storage queries the balance of all coins for a single account.
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
+	// ContractStorage queries all the storage slots of a contract.
+	ContractStorage(context.Context, *QueryContractStorageRequest) (*QueryContractStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
+	// EthCallBatch executes a batch of `eth_call` requests against the state of
+	// a single height
+	EthCallBatch(context.Context, *EthCallBatchRequest) (*EthCallBatchResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
@@ -1844,6 +2674,17 @@ type QueryServer interface {
 	// but makes the conversion to 18 decimals
 	// when the evm denom is represented with a different precision.
 	GlobalMinGasPrice(context.Context, *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error)
+	// EIP712TypedData returns the EIP-712 typed data that must be signed to
+	// authorize the given unsigned Cosmos transaction.
+	EIP712TypedData(context.Context, *QueryEIP712TypedDataRequest) (*QueryEIP712TypedDataResponse, error)
+	// ParamsAuthorities queries the accounts allowed to update a subset of the
+	// x/vm module parameters.
+	ParamsAuthorities(context.Context, *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error)
+	// ParamsAtHeight queries the x/vm module parameters in effect at the end
+	// of a past block, as recorded by the parameter change history.
+	ParamsAtHeight(context.Context, *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error)
+	// CodeMetadata queries the metadata published for the code of a contract.
+	CodeMetadata(context.Context, *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1865,6 +2706,9 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageRequest) (*QueryStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Storage not implemented")
 }
+func (*UnimplementedQueryServer) ContractStorage(ctx context.Context, req *QueryContractStorageRequest) (*QueryContractStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorage not implemented")
+}
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
@@ -1874,6 +2718,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallRequest) (*MsgEthereumTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthCall not implemented")
 }
+func (*UnimplementedQueryServer) EthCallBatch(ctx context.Context, req *EthCallBatchRequest) (*EthCallBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthCallBatch not implemented")
+}
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
@@ -1892,6 +2739,19 @@ func (*UnimplementedQueryServer) Config(ctx context.Context, req *QueryConfigReq
 func (*UnimplementedQueryServer) GlobalMinGasPrice(ctx context.Context, req *QueryGlobalMinGasPriceRequest) (*QueryGlobalMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GlobalMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) EIP712TypedData(ctx context.Context, req *QueryEIP712TypedDataRequest) (*QueryEIP712TypedDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EIP712TypedData not implemented")
+}
+func (*UnimplementedQueryServer) ParamsAuthorities(ctx context.Context, req *QueryParamsAuthoritiesRequest) (*QueryParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAuthorities not implemented")
+}
+func (*UnimplementedQueryServer) ParamsAtHeight(ctx context.Context, req *QueryParamsAtHeightRequest) (*QueryParamsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsAtHeight not implemented")
+}
+
+func (*UnimplementedQueryServer) CodeMetadata(ctx context.Context, req *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeMetadata not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1987,6 +2847,24 @@ func _Query_Storage_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/ContractStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorage(ctx, req.(*QueryContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCodeRequest)
 	if err := dec(in); err != nil {
@@ -2041,6 +2919,24 @@ func _Query_EthCall_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EthCallBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthCallBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/EthCallBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthCallBatch(ctx, req.(*EthCallBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
@@ -2149,6 +3045,78 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EIP712TypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEIP712TypedDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EIP712TypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/EIP712TypedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EIP712TypedData(ctx, req.(*QueryEIP712TypedDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAuthoritiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/ParamsAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAuthorities(ctx, req.(*QueryParamsAuthoritiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/ParamsAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsAtHeight(ctx, req.(*QueryParamsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/CodeMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeMetadata(ctx, req.(*QueryCodeMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2173,6 +3141,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Storage",
 			Handler:    _Query_Storage_Handler,
 		},
+		{
+			MethodName: "ContractStorage",
+			Handler:    _Query_ContractStorage_Handler,
+		},
 		{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
@@ -2185,6 +3157,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthCall",
 			Handler:    _Query_EthCall_Handler,
 		},
+		{
+			MethodName: "EthCallBatch",
+			Handler:    _Query_EthCallBatch_Handler,
+		},
 		{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
@@ -2209,6 +3185,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalMinGasPrice",
 			Handler:    _Query_GlobalMinGasPrice_Handler,
 		},
+		{
+			MethodName: "EIP712TypedData",
+			Handler:    _Query_EIP712TypedData_Handler,
+		},
+		{
+			MethodName: "ParamsAuthorities",
+			Handler:    _Query_ParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "ParamsAtHeight",
+			Handler:    _Query_ParamsAtHeight_Handler,
+		},
+		{
+			MethodName: "CodeMetadata",
+			Handler:    _Query_CodeMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/query.proto",
@@ -2611,6 +3603,97 @@ func (m *QueryStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryContractStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2838,6 +3921,22 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingTxs[iNdEx])
+			copy(dAtA[i:], m.PendingTxs[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -2865,7 +3964,7 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+func (m *EthCallBatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2875,34 +3974,162 @@ func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *EthCallBatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EthCallBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VmError) > 0 {
-		i -= len(m.VmError)
-		copy(dAtA[i:], m.VmError)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if len(m.Ret) > 0 {
-		i -= len(m.Ret)
-		copy(dAtA[i:], m.Ret)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.Gas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthCallBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthCallBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthCallBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthCallBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthCallBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthCallBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -2944,12 +4171,12 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BlockHash) > 0 {
@@ -3072,12 +4299,12 @@ func (m *QueryTraceBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x42
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
-	if err8 != nil {
-		return 0, err8
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x3a
 	if len(m.BlockHash) > 0 {
@@ -3265,264 +4492,594 @@ func (m *QueryGlobalMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEIP712TypedDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryEIP712TypedDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEIP712TypedDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.AccountNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCosmosAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEIP712TypedDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCosmosAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEIP712TypedDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEIP712TypedDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CosmosAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	if len(m.TypedData) > 0 {
+		i -= len(m.TypedData)
+		copy(dAtA[i:], m.TypedData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypedData)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsAuthoritiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAuthoritiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAuthoritiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovQuery(uint64(m.AccountNumber))
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChangeHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
+func (m *QueryConfigResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
+	if m.Config != nil {
+		l = m.Config.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
+func (m *QueryAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Balance)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryStorageRequest) Size() (n int) {
+func (m *QueryAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Balance)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.Key)
+	l = len(m.CodeHash)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
 	return n
 }
 
-func (m *QueryStorageResponse) Size() (n int) {
+func (m *QueryCosmosAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Value)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryCodeRequest) Size() (n int) {
+func (m *QueryCosmosAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.CosmosAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
 	return n
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
+func (m *QueryValidatorAccountRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Code)
+	l = len(m.ConsAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryTxLogsRequest) Size() (n int) {
+func (m *QueryValidatorAccountResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Hash)
+	l = len(m.AccountAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
 	}
 	return n
 }
 
-func (m *QueryTxLogsResponse) Size() (n int) {
+func (m *QueryBalanceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryBalanceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTxLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3553,6 +5110,73 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.ChainId != 0 {
 		n += 1 + sovQuery(uint64(m.ChainId))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PendingTxs) > 0 {
+		for _, b := range m.PendingTxs {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EthCallBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *EthCallBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EthCallBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3708,29 +5332,988 @@ func (m *QueryGlobalMinGasPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEIP712TypedDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovQuery(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryEIP712TypedDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypedData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsAuthoritiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryParamsAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ChangeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ChangeHeight))
+	}
+	return n
+}
+
+func (m *QueryCodeMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &ChainConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGlobalMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3753,12 +6336,44 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3780,7 +6395,7 @@ func (m *QueryConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3803,17 +6418,17 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3823,27 +6438,55 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &ChainConfig{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3866,7 +6509,7 @@ func (m *QueryConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3889,15 +6532,15 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3925,7 +6568,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3948,7 +6591,7 @@ func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractStorageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3971,15 +6614,15 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractStorageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4007,13 +6650,99 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4023,29 +6752,31 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4055,11 +6786,28 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4081,7 +6829,7 @@ func (m *QueryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4104,10 +6852,10 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4163,7 +6911,7 @@ func (m *QueryCosmosAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4186,17 +6934,17 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCosmosAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4206,62 +6954,26 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
-			}
-			m.AccountNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4283,7 +6995,7 @@ func (m *QueryCosmosAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4306,15 +7018,15 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4342,7 +7054,43 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4365,7 +7113,7 @@ func (m *QueryValidatorAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4388,17 +7136,17 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4408,29 +7156,31 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4440,30 +7190,28 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AccountNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4485,7 +7233,7 @@ func (m *QueryValidatorAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4505,47 +7253,15 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4567,7 +7283,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4590,17 +7306,17 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4610,23 +7326,24 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4649,7 +7366,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
+func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,17 +7389,17 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4692,29 +7409,50 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4724,23 +7462,110 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxs = append(m.PendingTxs, make([]byte, postIndex-iNdEx))
+			copy(m.PendingTxs[len(m.PendingTxs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4763,7 +7588,7 @@ func (m *QueryStorageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4786,17 +7611,17 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4806,24 +7631,96 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4845,7 +7742,7 @@ func (m *QueryStorageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
+func (m *EthCallBatchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4868,15 +7765,51 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallBatchResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &MsgEthereumTxResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4904,7 +7837,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4927,7 +7860,7 @@ func (m *QueryCodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *EthCallBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4950,17 +7883,17 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EthCallBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EthCallBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4970,24 +7903,24 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			m.Results = append(m.Results, EthCallBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5011,7 +7944,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5034,17 +7967,36 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5054,29 +8006,31 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5086,27 +8040,23 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5129,7 +8079,7 @@ func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5152,15 +8102,15 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTxLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5187,14 +8137,16 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5221,116 +8173,16 @@ func (m *QueryTxLogsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5357,65 +8209,35 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EthCallRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EthCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5425,31 +8247,29 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
-			if m.Args == nil {
-				m.Args = []byte{}
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
-			m.GasCap = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5459,12 +8279,26 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasCap |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5498,7 +8332,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5517,6 +8351,25 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5538,7 +8391,7 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5561,34 +8414,15 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
-			}
-			m.Gas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5615,42 +8449,10 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5673,7 +8475,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5696,51 +8498,15 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5767,16 +8533,14 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txs = append(m.Txs, &MsgEthereumTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5803,8 +8567,10 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5945,25 +8711,295 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5985,7 +9021,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6008,17 +9044,17 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6028,24 +9064,24 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6069,7 +9105,7 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEIP712TypedDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6092,51 +9128,17 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEIP712TypedDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEIP712TypedDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Txs = append(m.Txs, &MsgEthereumTx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6146,50 +9148,29 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
-			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6217,13 +9198,13 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
 			}
-			var msglen int
+			m.AccountNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6233,30 +9214,16 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AccountNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6266,50 +9233,66 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposerAddress == nil {
-				m.ProposerAddress = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEIP712TypedDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEIP712TypedDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEIP712TypedDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedData", wireType)
 			}
-			m.BlockMaxGas = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6319,11 +9302,24 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypedData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6345,7 +9341,7 @@ func (m *QueryTraceBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsAuthoritiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6368,17 +9364,67 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsAuthoritiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsAuthoritiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6388,24 +9434,24 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6429,7 +9475,7 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6452,12 +9498,31 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6479,7 +9544,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6502,17 +9567,17 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6522,28 +9587,44 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.BaseFee = &v
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeHeight", wireType)
+			}
+			m.ChangeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6565,7 +9646,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6588,12 +9669,80 @@ func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6615,7 +9764,7 @@ func (m *QueryGlobalMinGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6638,17 +9787,17 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGlobalMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6658,23 +9807,59 @@ func (m *QueryGlobalMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Metadata = append(m.Metadata, CodeMetadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
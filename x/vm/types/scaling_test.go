@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"math/big"
+	"math/rand"
 	"testing"
 
 	"github.com/holiman/uint256"
@@ -239,3 +240,41 @@ func TestConvertAmountTo18DecimalsBigInt(t *testing.T) {
 		}
 	}
 }
+
+// TestScalingRandomValuesMultiDecimals checks for random amounts that scaling
+// the EVM coin amounts to and from their 18 decimals representation neither
+// creates nor destroys any dust, for the supported decimals.
+func TestScalingRandomValuesMultiDecimals(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	maxAmount := new(big.Int).Lsh(big.NewInt(1), 128)
+
+	for _, decimals := range []evmtypes.Decimals{evmtypes.SixDecimals, evmtypes.TwelveDecimals, evmtypes.EighteenDecimals} {
+		t.Run(fmt.Sprintf("%d decimals", decimals), func(t *testing.T) {
+			coinInfo := evmtypes.EvmCoinInfo{Denom: "ucoin", ExtendedDenom: "acoin", DisplayDenom: "coin", Decimals: decimals}
+			if decimals == evmtypes.EighteenDecimals {
+				coinInfo.Denom = coinInfo.ExtendedDenom
+			}
+
+			configurator := evmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, configurator.WithEVMCoinInfo(coinInfo).Configure())
+
+			conversionFactor := decimals.ConversionFactor().BigInt()
+			for i := 0; i < 1_000; i++ {
+				amt := new(big.Int).Rand(r, maxAmount)
+
+				// integer amounts round-trip exactly
+				scaled := evmtypes.ConvertAmountTo18DecimalsBigInt(amt)
+				require.Equal(t, scaled, evmtypes.ConvertAmountTo18Decimals256Int(uint256.MustFromBig(amt)).ToBig())
+				require.Equal(t, math.LegacyNewDecFromBigInt(amt), evmtypes.ConvertBigIntFrom18DecimalsToLegacyDec(scaled))
+
+				// 18 decimals amounts split into an integer and a fractional
+				// amount, summing up to the original amount
+				integer := evmtypes.ConvertBigIntFrom18DecimalsToLegacyDec(amt).TruncateInt().BigInt()
+				fractional := new(big.Int).Sub(amt, evmtypes.ConvertAmountTo18DecimalsBigInt(integer))
+				require.True(t, fractional.Sign() >= 0 && fractional.Cmp(conversionFactor) < 0, "fractional amount %s out of range", fractional)
+				require.Equal(t, amt, new(big.Int).Add(evmtypes.ConvertAmountTo18DecimalsBigInt(integer), fractional))
+			}
+		})
+	}
+}
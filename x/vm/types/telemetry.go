@@ -0,0 +1,127 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Opcode families the gas used by the EVM transactions is reported by.
+const (
+	OpcodeFamilyArithmetic  = "arithmetic"
+	OpcodeFamilyBitwise     = "bitwise"
+	OpcodeFamilyKeccak      = "keccak"
+	OpcodeFamilyEnvironment = "environment"
+	OpcodeFamilyBlock       = "block"
+	OpcodeFamilyStack       = "stack"
+	OpcodeFamilyMemory      = "memory"
+	OpcodeFamilyStorage     = "storage"
+	OpcodeFamilyFlow        = "flow"
+	OpcodeFamilyLog         = "log"
+	OpcodeFamilyCall        = "call"
+	OpcodeFamilyCreate      = "create"
+	OpcodeFamilySystem      = "system"
+)
+
+// OpcodeFamily returns the family of the opcode, e.g. storage for SLOAD and
+// SSTORE.
+func OpcodeFamily(op vm.OpCode) string {
+	switch {
+	case op <= vm.SIGNEXTEND:
+		return OpcodeFamilyArithmetic
+	case op >= vm.LT && op <= vm.SAR:
+		return OpcodeFamilyBitwise
+	case op == vm.KECCAK256:
+		return OpcodeFamilyKeccak
+	case op >= vm.ADDRESS && op <= vm.EXTCODEHASH:
+		return OpcodeFamilyEnvironment
+	case op >= vm.BLOCKHASH && op <= vm.BLOBBASEFEE:
+		return OpcodeFamilyBlock
+	case op == vm.POP || op.IsPush() || (op >= vm.DUP1 && op <= vm.SWAP16):
+		return OpcodeFamilyStack
+	case op == vm.MLOAD || op == vm.MSTORE || op == vm.MSTORE8 || op == vm.MSIZE || op == vm.MCOPY:
+		return OpcodeFamilyMemory
+	case op == vm.SLOAD || op == vm.SSTORE || op == vm.TLOAD || op == vm.TSTORE:
+		return OpcodeFamilyStorage
+	case op == vm.JUMP || op == vm.JUMPI || op == vm.PC || op == vm.GAS || op == vm.JUMPDEST:
+		return OpcodeFamilyFlow
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return OpcodeFamilyLog
+	case isCallOpcode(op):
+		return OpcodeFamilyCall
+	case op == vm.CREATE || op == vm.CREATE2:
+		return OpcodeFamilyCreate
+	default:
+		return OpcodeFamilySystem
+	}
+}
+
+func isCallOpcode(op vm.OpCode) bool {
+	return op == vm.CALL || op == vm.CALLCODE || op == vm.DELEGATECALL || op == vm.STATICCALL
+}
+
+// OpcodeGasTracer collects the gas used by an EVM transaction by opcode family,
+// to be reported by telemetry.
+//
+// The cost of the call opcodes includes the gas forwarded to the callee, which
+// is then reported by the opcodes of the callee, so it is deducted from the call
+// family.
+type OpcodeGasTracer struct {
+	gasUsed map[string]uint64
+}
+
+// NewOpcodeGasTracer returns an empty OpcodeGasTracer.
+func NewOpcodeGasTracer() *OpcodeGasTracer {
+	return &OpcodeGasTracer{gasUsed: make(map[string]uint64)}
+}
+
+// Hooks returns the EVM tracing hooks of the tracer.
+func (t *OpcodeGasTracer) Hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnTxStart: func(_ *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {},
+		OnOpcode:  t.onOpcode,
+		OnEnter:   t.onEnter,
+	}
+}
+
+func (t *OpcodeGasTracer) onOpcode(_ uint64, op byte, _, cost uint64, _ tracing.OpContext, _ []byte, _ int, _ error) {
+	t.gasUsed[OpcodeFamily(vm.OpCode(op))] += cost
+}
+
+func (t *OpcodeGasTracer) onEnter(depth int, typ byte, _, _ common.Address, _ []byte, gas uint64, value *big.Int) {
+	op := vm.OpCode(typ)
+	if depth == 0 || !isCallOpcode(op) {
+		return
+	}
+
+	// the stipend of the value transfers is given to the callee for free
+	if (op == vm.CALL || op == vm.CALLCODE) && value != nil && value.Sign() > 0 {
+		gas -= min(gas, params.CallStipend)
+	}
+	t.gasUsed[OpcodeFamilyCall] -= min(gas, t.gasUsed[OpcodeFamilyCall])
+}
+
+// GasUsed returns the gas used by opcode family.
+func (t *OpcodeGasTracer) GasUsed() map[string]uint64 {
+	return t.gasUsed
+}
+
+// EmitTelemetry reports the gas used by opcode family.
+func (t *OpcodeGasTracer) EmitTelemetry() {
+	for family, gas := range t.gasUsed {
+		if gas > 0 {
+			telemetry.IncrCounterWithLabels(
+				[]string{"evm", "opcode", "gas_used"},
+				float32(gas),
+				[]metrics.Label{telemetry.NewLabel("family", family)},
+			)
+		}
+	}
+}
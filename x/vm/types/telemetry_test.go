@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/vm/types"
+)
+
+func TestOpcodeFamily(t *testing.T) {
+	testCases := []struct {
+		op     vm.OpCode
+		family string
+	}{
+		{vm.STOP, types.OpcodeFamilyArithmetic},
+		{vm.ADD, types.OpcodeFamilyArithmetic},
+		{vm.SIGNEXTEND, types.OpcodeFamilyArithmetic},
+		{vm.LT, types.OpcodeFamilyBitwise},
+		{vm.SAR, types.OpcodeFamilyBitwise},
+		{vm.KECCAK256, types.OpcodeFamilyKeccak},
+		{vm.CALLER, types.OpcodeFamilyEnvironment},
+		{vm.EXTCODEHASH, types.OpcodeFamilyEnvironment},
+		{vm.TIMESTAMP, types.OpcodeFamilyBlock},
+		{vm.POP, types.OpcodeFamilyStack},
+		{vm.PUSH0, types.OpcodeFamilyStack},
+		{vm.PUSH32, types.OpcodeFamilyStack},
+		{vm.DUP1, types.OpcodeFamilyStack},
+		{vm.SWAP16, types.OpcodeFamilyStack},
+		{vm.MSTORE, types.OpcodeFamilyMemory},
+		{vm.MCOPY, types.OpcodeFamilyMemory},
+		{vm.SLOAD, types.OpcodeFamilyStorage},
+		{vm.TSTORE, types.OpcodeFamilyStorage},
+		{vm.JUMPI, types.OpcodeFamilyFlow},
+		{vm.JUMPDEST, types.OpcodeFamilyFlow},
+		{vm.LOG2, types.OpcodeFamilyLog},
+		{vm.STATICCALL, types.OpcodeFamilyCall},
+		{vm.DELEGATECALL, types.OpcodeFamilyCall},
+		{vm.CREATE2, types.OpcodeFamilyCreate},
+		{vm.RETURN, types.OpcodeFamilySystem},
+		{vm.REVERT, types.OpcodeFamilySystem},
+		{vm.SELFDESTRUCT, types.OpcodeFamilySystem},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.family, types.OpcodeFamily(tc.op), tc.op.String())
+	}
+}
+
+func TestOpcodeGasTracer(t *testing.T) {
+	tracer := types.NewOpcodeGasTracer()
+	hooks := tracer.Hooks()
+	to := common.HexToAddress("0x01")
+
+	hooks.OnEnter(0, byte(vm.CALL), common.Address{}, to, nil, 100_000, big.NewInt(0))
+	hooks.OnOpcode(0, byte(vm.PUSH1), 0, 3, nil, nil, 1, nil)
+	hooks.OnOpcode(0, byte(vm.SSTORE), 0, 20_000, nil, nil, 1, nil)
+	// the call forwards 10_000 gas to the callee
+	hooks.OnOpcode(0, byte(vm.CALL), 0, 12_600, nil, nil, 1, nil)
+	hooks.OnEnter(1, byte(vm.CALL), to, to, nil, 10_000, big.NewInt(0))
+	hooks.OnOpcode(0, byte(vm.SLOAD), 0, 2_100, nil, nil, 2, nil)
+	// the value transfer gives the stipend to the callee on top of the forwarded gas
+	hooks.OnOpcode(0, byte(vm.CALL), 0, 11_000, nil, nil, 2, nil)
+	hooks.OnEnter(2, byte(vm.CALL), to, to, nil, 4_300, big.NewInt(1))
+
+	require.Equal(t, map[string]uint64{
+		types.OpcodeFamilyStack:   3,
+		types.OpcodeFamilyStorage: 22_100,
+		types.OpcodeFamilyCall:    11_600,
+	}, tracer.GasUsed())
+}
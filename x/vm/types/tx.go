@@ -90,3 +90,13 @@ func (m *MsgEthereumTxResponse) Revert() []byte {
 	}
 	return common.CopyBytes(m.Ret)
 }
+
+// FailureReason returns the error of a failed execution, along with the decoded
+// reason of a revert if any, e.g. "execution reverted: insufficient balance".
+// It's empty if the execution succeeded.
+func (m *MsgEthereumTxResponse) FailureReason() string {
+	if m.VmError != vm.ErrExecutionReverted.Error() {
+		return m.VmError
+	}
+	return NewExecErrorWithReason(m.Ret).Error()
+}
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/evm/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -77,6 +78,12 @@ var xxx_messageInfo_MsgEthereumTx proto.InternalMessageInfo
 
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
+	// fee_payment_signatures are the EIP-191 signatures authorizing the fee
+	// payment terms of the transaction, i.e. the fee denomination and the fee
+	// granter, which are not covered by the Ethereum transaction signatures. They
+	// are required when the fees are not paid by the senders in the EVM
+	// denomination, with one signature per message, in order, by its sender.
+	FeePaymentSignatures [][]byte `protobuf:"bytes,1,rep,name=fee_payment_signatures,json=feePaymentSignatures,proto3" json:"fee_payment_signatures,omitempty"`
 }
 
 func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthereumTx{} }
@@ -356,6 +363,204 @@ func (m *MsgRegisterPreinstallsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterPreinstallsResponse proto.InternalMessageInfo
 
+// MsgUpdateParamsAuthorities defines a Msg for updating the accounts allowed to
+// update a subset of the x/vm module parameters.
+type MsgUpdateParamsAuthorities struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params_authorities defines the accounts replacing the current ones.
+	ParamsAuthorities []types.ParamsAuthority `protobuf:"bytes,2,rep,name=params_authorities,json=paramsAuthorities,proto3" json:"params_authorities"`
+}
+
+func (m *MsgUpdateParamsAuthorities) Reset()         { *m = MsgUpdateParamsAuthorities{} }
+func (m *MsgUpdateParamsAuthorities) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsAuthorities) ProtoMessage()    {}
+func (*MsgUpdateParamsAuthorities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{7}
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsAuthorities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsAuthorities.Merge(m, src)
+}
+func (m *MsgUpdateParamsAuthorities) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsAuthorities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsAuthorities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsAuthorities proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsAuthorities) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParamsAuthorities) GetParamsAuthorities() []types.ParamsAuthority {
+	if m != nil {
+		return m.ParamsAuthorities
+	}
+	return nil
+}
+
+// MsgUpdateParamsAuthoritiesResponse defines the response structure for
+// executing a MsgUpdateParamsAuthorities message.
+type MsgUpdateParamsAuthoritiesResponse struct {
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Reset()         { *m = MsgUpdateParamsAuthoritiesResponse{} }
+func (m *MsgUpdateParamsAuthoritiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsAuthoritiesResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsAuthoritiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{8}
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsAuthoritiesResponse proto.InternalMessageInfo
+
+// MsgRegisterCodeMetadata defines a Msg for publishing the metadata of a
+// contract code hash, such as the IPFS hash of its compiler metadata.
+type MsgRegisterCodeMetadata struct {
+	// sender is the address of the account publishing the metadata.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// code_hash is the hex encoded hash of the contract code.
+	CodeHash string `protobuf:"bytes,2,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// metadata_uri is the location of the compiler metadata of the contract,
+	// e.g. ipfs://<cid>.
+	MetadataUri string `protobuf:"bytes,3,opt,name=metadata_uri,json=metadataUri,proto3" json:"metadata_uri,omitempty"`
+}
+
+func (m *MsgRegisterCodeMetadata) Reset()         { *m = MsgRegisterCodeMetadata{} }
+func (m *MsgRegisterCodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCodeMetadata) ProtoMessage()    {}
+func (*MsgRegisterCodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{9}
+}
+func (m *MsgRegisterCodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCodeMetadata.Merge(m, src)
+}
+func (m *MsgRegisterCodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCodeMetadata proto.InternalMessageInfo
+
+func (m *MsgRegisterCodeMetadata) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterCodeMetadata) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *MsgRegisterCodeMetadata) GetMetadataUri() string {
+	if m != nil {
+		return m.MetadataUri
+	}
+	return ""
+}
+
+// MsgRegisterCodeMetadataResponse defines the response structure for executing
+// a MsgRegisterCodeMetadata message.
+type MsgRegisterCodeMetadataResponse struct {
+}
+
+func (m *MsgRegisterCodeMetadataResponse) Reset()         { *m = MsgRegisterCodeMetadataResponse{} }
+func (m *MsgRegisterCodeMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCodeMetadataResponse) ProtoMessage()    {}
+func (*MsgRegisterCodeMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a8ac5e8c9c4850, []int{10}
+}
+func (m *MsgRegisterCodeMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCodeMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCodeMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCodeMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCodeMetadataResponse.Merge(m, src)
+}
+func (m *MsgRegisterCodeMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCodeMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCodeMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCodeMetadataResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "cosmos.evm.vm.v1.MsgEthereumTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "cosmos.evm.vm.v1.ExtensionOptionsEthereumTx")
@@ -364,58 +569,76 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.evm.vm.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgRegisterPreinstalls)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstalls")
 	proto.RegisterType((*MsgRegisterPreinstallsResponse)(nil), "cosmos.evm.vm.v1.MsgRegisterPreinstallsResponse")
+	proto.RegisterType((*MsgUpdateParamsAuthorities)(nil), "cosmos.evm.vm.v1.MsgUpdateParamsAuthorities")
+	proto.RegisterType((*MsgUpdateParamsAuthoritiesResponse)(nil), "cosmos.evm.vm.v1.MsgUpdateParamsAuthoritiesResponse")
+	proto.RegisterType((*MsgRegisterCodeMetadata)(nil), "cosmos.evm.vm.v1.MsgRegisterCodeMetadata")
+	proto.RegisterType((*MsgRegisterCodeMetadataResponse)(nil), "cosmos.evm.vm.v1.MsgRegisterCodeMetadataResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/tx.proto", fileDescriptor_77a8ac5e8c9c4850) }
 
 var fileDescriptor_77a8ac5e8c9c4850 = []byte{
-	// 736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0x26, 0xdb, 0x36, 0x99, 0x56, 0x1b, 0xd7, 0xd6, 0x6e, 0x97, 0x36, 0x49, 0x17, 0xb5,
-	0x69, 0xc1, 0xac, 0x8d, 0x20, 0x18, 0x4f, 0x06, 0x8a, 0x1a, 0x0c, 0x96, 0xb5, 0xbd, 0x88, 0x10,
-	0xa6, 0xcd, 0x38, 0x59, 0xcc, 0xec, 0xac, 0x3b, 0x93, 0x98, 0x1e, 0x04, 0x29, 0x1e, 0xc4, 0x83,
-	0x08, 0xfe, 0x01, 0x8f, 0x1e, 0x7b, 0xf0, 0xe4, 0x2f, 0xe8, 0xb1, 0x28, 0x88, 0x88, 0x14, 0x69,
-	0x85, 0xfe, 0x0d, 0x99, 0xd9, 0x4d, 0x93, 0x34, 0x0b, 0x15, 0x61, 0x59, 0x66, 0xdf, 0xe7, 0x79,
-	0x9f, 0xf7, 0x73, 0x16, 0xcc, 0x6e, 0x51, 0x46, 0x28, 0xb3, 0x50, 0x9b, 0x58, 0xe2, 0x59, 0xb1,
-	0x78, 0xa7, 0xe0, 0xf9, 0x94, 0x53, 0x2d, 0x1d, 0x40, 0x05, 0xd4, 0x26, 0x05, 0xf1, 0xac, 0x18,
-	0x17, 0x20, 0x71, 0x5c, 0x6a, 0xc9, 0x77, 0x40, 0x32, 0x8c, 0x21, 0x7f, 0x41, 0x0f, 0xb0, 0x99,
-	0x10, 0x23, 0x0c, 0x0b, 0x80, 0x30, 0x1c, 0x02, 0x61, 0xd0, 0x9a, 0xfc, 0xb2, 0xc2, 0x30, 0x01,
-	0x34, 0x85, 0x29, 0xa6, 0x81, 0x5d, 0x9c, 0x42, 0xeb, 0x1c, 0xa6, 0x14, 0x37, 0x91, 0x05, 0x3d,
-	0xc7, 0x82, 0xae, 0x4b, 0x39, 0xe4, 0x0e, 0x75, 0x43, 0x1f, 0xf3, 0xb5, 0x02, 0xce, 0x55, 0x19,
-	0x5e, 0xe5, 0x0d, 0xe4, 0xa3, 0x16, 0x59, 0xef, 0x68, 0x1a, 0x50, 0x9f, 0xfa, 0x94, 0xe8, 0x23,
-	0x39, 0x25, 0x3f, 0x61, 0xcb, 0xb3, 0x76, 0x19, 0x24, 0x7c, 0xf8, 0x42, 0x1f, 0x15, 0xa6, 0xb2,
-	0xb6, 0x77, 0x90, 0x8d, 0xfd, 0x3c, 0xc8, 0x82, 0x9e, 0x93, 0x2d, 0xe0, 0xd2, 0xc2, 0x9b, 0x8f,
-	0xd9, 0xd8, 0xdb, 0xe3, 0xdd, 0x65, 0xbd, 0xaf, 0xb0, 0x01, 0xf1, 0x8a, 0x9a, 0x54, 0xd2, 0xf1,
-	0x8a, 0x9a, 0x8c, 0xa7, 0x13, 0x15, 0x35, 0x99, 0x48, 0xab, 0x15, 0x35, 0xa9, 0xa6, 0x47, 0x4c,
-	0x13, 0x18, 0xab, 0x1d, 0x8e, 0x5c, 0xe6, 0x50, 0xf7, 0xa1, 0x27, 0x13, 0xec, 0x79, 0x95, 0x54,
-	0x21, 0x6c, 0xbe, 0x8b, 0x83, 0xe9, 0x01, 0x35, 0x1b, 0x31, 0x8f, 0xba, 0x0c, 0x89, 0x94, 0x1b,
-	0x90, 0x35, 0x74, 0x25, 0xa7, 0xe4, 0x53, 0xb6, 0x3c, 0x6b, 0x4b, 0x40, 0x6d, 0x52, 0xcc, 0xf4,
-	0x78, 0x2e, 0x91, 0x1f, 0x2f, 0x4e, 0x17, 0x4e, 0x0f, 0xa4, 0xf0, 0x80, 0x62, 0x5b, 0x52, 0xb4,
-	0x34, 0x48, 0xf8, 0x88, 0xeb, 0x09, 0x59, 0xb0, 0x38, 0x6a, 0xb3, 0x20, 0xd9, 0x26, 0x35, 0xe4,
-	0xfb, 0xd4, 0xd7, 0x55, 0x29, 0x3a, 0xd6, 0x26, 0xab, 0xe2, 0x53, 0x40, 0x18, 0xb2, 0x5a, 0x8b,
-	0xa1, 0xba, 0x6c, 0x91, 0x6a, 0x8f, 0x61, 0xc8, 0x36, 0x18, 0xaa, 0x6b, 0x39, 0x30, 0x41, 0x60,
-	0x47, 0x42, 0x35, 0x0c, 0x99, 0x6c, 0x97, 0x6a, 0x03, 0x02, 0x3b, 0x02, 0xbe, 0x0b, 0x99, 0x36,
-	0x0f, 0xc0, 0x66, 0x93, 0x6e, 0x3d, 0xab, 0xc9, 0x74, 0xc7, 0x64, 0xc0, 0x94, 0xb4, 0xdc, 0x13,
-	0x39, 0x2f, 0x82, 0xc9, 0x00, 0xe6, 0x0e, 0x41, 0x8c, 0x43, 0xe2, 0xe9, 0x49, 0xa9, 0x71, 0x5e,
-	0x9a, 0xd7, 0xbb, 0xd6, 0xb0, 0x21, 0x5f, 0x14, 0x30, 0x59, 0x65, 0x78, 0xc3, 0xab, 0x43, 0x8e,
-	0xd6, 0xa0, 0x0f, 0x09, 0xd3, 0x6e, 0x82, 0x14, 0x6c, 0xf1, 0x06, 0xf5, 0x1d, 0xbe, 0x1d, 0xf4,
-	0xa3, 0xac, 0x7f, 0xfd, 0x7c, 0x6d, 0x2a, 0x2c, 0xff, 0x4e, 0xbd, 0xee, 0x23, 0xc6, 0x1e, 0x71,
-	0xdf, 0x71, 0xb1, 0xdd, 0xa3, 0x6a, 0xb7, 0xc1, 0xa8, 0x27, 0x15, 0xf4, 0x78, 0x4e, 0xc9, 0x8f,
-	0x17, 0xf5, 0xe1, 0x86, 0x05, 0x11, 0xca, 0x29, 0x31, 0xfe, 0x4f, 0xc7, 0xbb, 0xcb, 0x8a, 0x1d,
-	0xba, 0x94, 0x8a, 0x3b, 0xc7, 0xbb, 0xcb, 0x3d, 0x31, 0xb1, 0x02, 0xd9, 0xbe, 0x15, 0xe8, 0x58,
-	0xc1, 0x1e, 0xf4, 0x27, 0x6a, 0xce, 0x82, 0x99, 0x53, 0xa6, 0xee, 0x38, 0xcd, 0xef, 0x0a, 0xb8,
-	0x54, 0x65, 0xd8, 0x46, 0xd8, 0x61, 0x1c, 0xf9, 0x6b, 0x3e, 0x72, 0x5c, 0xc6, 0x61, 0xb3, 0xf9,
-	0xff, 0xe5, 0xdd, 0x07, 0xe3, 0x5e, 0x4f, 0x26, 0x5c, 0x8a, 0xb9, 0x88, 0x1a, 0x4f, 0x48, 0xfd,
-	0x75, 0xf6, 0xfb, 0x96, 0x6e, 0x0d, 0x17, 0x7b, 0x35, 0xa2, 0xd8, 0x88, 0xec, 0xcd, 0x1c, 0xc8,
-	0x44, 0x23, 0xdd, 0xd2, 0x8b, 0xbf, 0xe2, 0x20, 0x51, 0x65, 0x58, 0x7b, 0x09, 0xfa, 0x6e, 0x97,
-	0x96, 0x1d, 0x4e, 0x74, 0xe0, 0x22, 0x18, 0x8b, 0x67, 0x10, 0x4e, 0x5a, 0x7b, 0x65, 0xe7, 0xdb,
-	0x9f, 0x0f, 0xf1, 0xac, 0x39, 0x6f, 0x0d, 0xff, 0x7b, 0x42, 0x76, 0x8d, 0x77, 0xb4, 0x27, 0x60,
-	0x62, 0x60, 0xab, 0x16, 0x22, 0xf5, 0xfb, 0x29, 0xc6, 0xd2, 0x99, 0x94, 0x93, 0xeb, 0xfa, 0x1c,
-	0x5c, 0x8c, 0x9a, 0x6d, 0x3e, 0x52, 0x21, 0x82, 0x69, 0x5c, 0xff, 0x57, 0x66, 0x37, 0xa4, 0x31,
-	0xf2, 0x4a, 0x0c, 0xb2, 0x5c, 0xda, 0x3b, 0xcc, 0x28, 0xfb, 0x87, 0x19, 0xe5, 0xf7, 0x61, 0x46,
-	0x79, 0x7f, 0x94, 0x89, 0xed, 0x1f, 0x65, 0x62, 0x3f, 0x8e, 0x32, 0xb1, 0xc7, 0x39, 0xec, 0xf0,
-	0x46, 0x6b, 0xb3, 0xb0, 0x45, 0x89, 0x75, 0x7a, 0x9a, 0x7c, 0xdb, 0x43, 0x6c, 0x73, 0x54, 0xfe,
-	0x30, 0x6f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xb8, 0x53, 0x9d, 0xf6, 0x05, 0x00, 0x00,
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x56, 0x4d, 0x8f, 0xdb, 0x44,
+	0x18, 0x5e, 0x27, 0xde, 0x8f, 0xcc, 0x2e, 0x34, 0x1d, 0xb6, 0x5d, 0xaf, 0x69, 0x37, 0x59, 0x6b,
+	0xdb, 0xfd, 0x10, 0x8d, 0xbb, 0x4b, 0x55, 0x44, 0x10, 0x87, 0x2e, 0x5a, 0x41, 0x11, 0x15, 0x95,
+	0xdb, 0x95, 0x10, 0xaa, 0x6a, 0xcd, 0x6e, 0xa6, 0x8e, 0xd5, 0xd8, 0xe3, 0x7a, 0x26, 0xdb, 0xec,
+	0xa1, 0x12, 0xaa, 0x7a, 0x40, 0x1c, 0x10, 0x12, 0x7f, 0x80, 0x23, 0x47, 0x0e, 0x9c, 0x38, 0x73,
+	0xe8, 0xb1, 0x80, 0x84, 0x10, 0x87, 0x0a, 0x15, 0xa4, 0xfe, 0x00, 0xfe, 0x00, 0xef, 0x8c, 0x1d,
+	0xc7, 0x9b, 0x78, 0x9b, 0x52, 0x29, 0x76, 0x3c, 0xef, 0xf3, 0xcc, 0x33, 0xef, 0xfb, 0xf8, 0x9d,
+	0x49, 0xd0, 0xe2, 0x3e, 0xe3, 0x01, 0xe3, 0x36, 0x3d, 0x08, 0x6c, 0xf9, 0xd9, 0xb4, 0x45, 0xaf,
+	0x11, 0xc5, 0x4c, 0x30, 0x5c, 0x4d, 0xa0, 0x06, 0x40, 0x0d, 0xf9, 0xd9, 0x34, 0x4f, 0x92, 0xc0,
+	0x0f, 0x99, 0xad, 0xee, 0x09, 0xc9, 0xdc, 0xc8, 0xcd, 0x17, 0x87, 0x11, 0xe5, 0x52, 0x22, 0x22,
+	0x31, 0x09, 0xb8, 0x4b, 0xba, 0xa2, 0xcd, 0x62, 0x5f, 0x1c, 0xa6, 0x5c, 0x73, 0x64, 0x2d, 0x29,
+	0x9d, 0x60, 0x0b, 0x29, 0x16, 0x70, 0x4f, 0x02, 0xf0, 0x95, 0x02, 0x69, 0x82, 0xae, 0x1a, 0xd9,
+	0x69, 0x4a, 0x09, 0x34, 0xef, 0x31, 0x8f, 0x25, 0x71, 0xf9, 0x94, 0x46, 0xcf, 0x78, 0x8c, 0x79,
+	0x1d, 0x6a, 0x93, 0xc8, 0xb7, 0x49, 0x18, 0x32, 0x41, 0x84, 0xcf, 0xc2, 0x74, 0x8e, 0xf5, 0x48,
+	0x43, 0xaf, 0x5d, 0xe3, 0xde, 0x8e, 0x68, 0xd3, 0x98, 0x76, 0x83, 0x9b, 0x3d, 0x8c, 0x91, 0x7e,
+	0x27, 0x66, 0x81, 0x31, 0x59, 0xd7, 0xd6, 0xe6, 0x1c, 0xf5, 0x8c, 0x57, 0x50, 0x39, 0x26, 0xf7,
+	0x8d, 0x29, 0x19, 0xda, 0xc6, 0x8f, 0x9f, 0xd6, 0x26, 0xfe, 0x7c, 0x5a, 0x43, 0x83, 0x49, 0x8e,
+	0x84, 0x9b, 0xcb, 0x5f, 0x7e, 0x57, 0x9b, 0xf8, 0xea, 0xf9, 0x0f, 0x1b, 0x46, 0xae, 0xb0, 0x23,
+	0xe2, 0x1f, 0xeb, 0x33, 0x5a, 0xb5, 0x04, 0xf7, 0x52, 0xb5, 0x0c, 0xf7, 0x72, 0x55, 0x87, 0xbb,
+	0x5e, 0x9d, 0xb4, 0x3e, 0x43, 0xe6, 0x4e, 0x4f, 0xd0, 0x90, 0x43, 0x6a, 0x9f, 0x46, 0x2a, 0xc1,
+	0x5c, 0x4a, 0x97, 0xd0, 0xe9, 0x3b, 0x94, 0xba, 0x11, 0x39, 0x0c, 0x68, 0x28, 0x5c, 0xee, 0x7b,
+	0x21, 0x11, 0xdd, 0x98, 0x72, 0x43, 0xab, 0x97, 0x21, 0xc9, 0x79, 0x40, 0xaf, 0x27, 0xe0, 0x8d,
+	0x0c, 0x6b, 0xea, 0x32, 0x1d, 0xeb, 0xeb, 0x12, 0x3a, 0x75, 0x24, 0x07, 0x87, 0xf2, 0x08, 0xe4,
+	0xa9, 0x2c, 0xb4, 0x4d, 0x78, 0x1b, 0x34, 0xb4, 0xb5, 0x8a, 0xa3, 0x9e, 0xf1, 0x3a, 0xd2, 0x3b,
+	0xcc, 0xe3, 0x46, 0x09, 0x74, 0x67, 0xb7, 0x4e, 0x35, 0x86, 0x5f, 0x79, 0xe3, 0x13, 0xe6, 0x39,
+	0x8a, 0x82, 0xab, 0xe0, 0x09, 0x15, 0x46, 0x59, 0xd9, 0x24, 0x1f, 0xf1, 0x22, 0x9a, 0x39, 0x08,
+	0x5c, 0x1a, 0xc7, 0x2c, 0x36, 0x74, 0x25, 0x3a, 0x7d, 0x10, 0xec, 0xc8, 0xa1, 0x84, 0x3c, 0xc2,
+	0xdd, 0x2e, 0xa7, 0x2d, 0x65, 0xac, 0xee, 0x4c, 0xc3, 0x78, 0x17, 0x86, 0xb8, 0x8e, 0xe6, 0x02,
+	0xd2, 0x53, 0x90, 0x0b, 0x31, 0x65, 0xb2, 0xee, 0x20, 0x88, 0x49, 0xf8, 0x43, 0xc2, 0xf1, 0x59,
+	0x84, 0xf6, 0x3a, 0x6c, 0xff, 0xae, 0xab, 0xd2, 0x9d, 0x56, 0x0b, 0x56, 0x54, 0xe4, 0x23, 0x99,
+	0xf3, 0x2a, 0x3a, 0x91, 0xc0, 0xc2, 0x0f, 0x28, 0x17, 0x24, 0x88, 0x8c, 0x19, 0xa5, 0xf1, 0xba,
+	0x0a, 0xdf, 0xec, 0x47, 0x53, 0x43, 0x7e, 0xd2, 0xd0, 0x09, 0x30, 0x64, 0x37, 0x6a, 0x11, 0x01,
+	0xae, 0xc9, 0xce, 0xc4, 0x97, 0x51, 0x25, 0x6b, 0xce, 0xc4, 0x8f, 0x6d, 0xe3, 0xd7, 0x1f, 0x2f,
+	0xcc, 0xa7, 0xe5, 0x5f, 0x69, 0xb5, 0xc0, 0x50, 0x7e, 0x43, 0xc4, 0x7e, 0xe8, 0x39, 0x03, 0x2a,
+	0x7e, 0x0f, 0x4d, 0x25, 0xbd, 0x0d, 0x86, 0x69, 0x60, 0x98, 0x31, 0x6a, 0x58, 0xb2, 0xc2, 0x76,
+	0x45, 0x36, 0xcd, 0xf7, 0xd0, 0x1b, 0x9a, 0x93, 0x4e, 0x69, 0x6e, 0x3d, 0x84, 0xe1, 0x40, 0x4c,
+	0x36, 0x4e, 0x2d, 0xd7, 0x38, 0x3d, 0x3b, 0xe9, 0x9e, 0x7c, 0xa2, 0xd6, 0x22, 0x5a, 0x18, 0x0a,
+	0xf5, 0x5f, 0xa7, 0xf5, 0xbb, 0x86, 0x4e, 0x03, 0xe6, 0x50, 0xcf, 0xe7, 0x82, 0xc6, 0xd7, 0x63,
+	0xea, 0x87, 0x50, 0x77, 0xa7, 0xf3, 0xea, 0xe5, 0x5d, 0x45, 0xb3, 0xd1, 0x40, 0x26, 0x6d, 0x8a,
+	0x33, 0x05, 0x35, 0x66, 0xa4, 0x7c, 0x9d, 0xf9, 0xb9, 0xcd, 0x77, 0x47, 0x8b, 0x3d, 0x5f, 0x50,
+	0x6c, 0x41, 0xf6, 0x56, 0x1d, 0x2d, 0x15, 0x23, 0x59, 0xe9, 0xff, 0x6a, 0xc8, 0x1c, 0xb2, 0xe5,
+	0x4a, 0xba, 0x92, 0x4f, 0x5f, 0xbd, 0xfc, 0xdb, 0x08, 0x0f, 0x9d, 0x5c, 0xa0, 0x96, 0xba, 0xb0,
+	0x92, 0x77, 0x41, 0x1d, 0x74, 0x83, 0x97, 0xdd, 0x5f, 0xfb, 0x30, 0xef, 0xc6, 0xc9, 0x68, 0x38,
+	0xaf, 0xe6, 0xfb, 0xa3, 0x9e, 0x6c, 0x8c, 0x69, 0x80, 0xdc, 0x74, 0x6b, 0x05, 0x59, 0xc7, 0xa3,
+	0x99, 0x37, 0x3f, 0x6b, 0xaa, 0x65, 0xfa, 0xf6, 0x7d, 0xc0, 0x5a, 0xf4, 0x1a, 0x15, 0x04, 0x26,
+	0x11, 0x7c, 0x11, 0x4d, 0x71, 0x1a, 0xb6, 0x68, 0x3c, 0xd6, 0x95, 0x94, 0x87, 0xdf, 0x44, 0x95,
+	0x7d, 0x50, 0x48, 0x76, 0x62, 0x49, 0xed, 0xf1, 0x19, 0x19, 0x50, 0x1b, 0x71, 0x19, 0x76, 0x72,
+	0x2a, 0xed, 0x76, 0x63, 0x5f, 0x1d, 0x0d, 0x15, 0x67, 0xb6, 0x1f, 0xdb, 0x8d, 0xfd, 0xe6, 0x3b,
+	0xb2, 0xe4, 0x54, 0x4c, 0xd6, 0xbb, 0xfa, 0x82, 0x1e, 0xc8, 0xa7, 0x6a, 0x2d, 0xa3, 0xda, 0x31,
+	0x50, 0xbf, 0xd2, 0xad, 0x5f, 0x74, 0x54, 0x06, 0x0e, 0x7e, 0x80, 0x72, 0x27, 0x33, 0xae, 0x8d,
+	0xb6, 0xeb, 0x91, 0xe3, 0xd0, 0x5c, 0x1d, 0x43, 0xc8, 0x9c, 0x3c, 0xf7, 0xf0, 0xb7, 0x7f, 0xbe,
+	0x2d, 0xd5, 0xac, 0xb3, 0xf6, 0xe8, 0xef, 0x56, 0xca, 0x76, 0x45, 0x0f, 0xdf, 0x42, 0x73, 0x47,
+	0xce, 0x96, 0xe5, 0x42, 0xfd, 0x3c, 0xc5, 0x5c, 0x1f, 0x4b, 0xc9, 0x0e, 0xed, 0x7b, 0xe8, 0x8d,
+	0xa2, 0x1d, 0xbe, 0x56, 0xa8, 0x50, 0xc0, 0x34, 0x2f, 0xbe, 0x2c, 0x33, 0x5b, 0xf2, 0x01, 0x5a,
+	0x38, 0x6e, 0x67, 0xbd, 0x35, 0x36, 0xf1, 0x1c, 0xdb, 0xbc, 0xf4, 0x7f, 0xd8, 0xd9, 0xf2, 0x02,
+	0xcd, 0x17, 0x36, 0xef, 0xfa, 0x0b, 0x0b, 0xc9, 0x53, 0xcd, 0xcd, 0x97, 0xa6, 0xf6, 0x57, 0x35,
+	0x27, 0xbf, 0x90, 0xbb, 0x76, 0xbb, 0xf9, 0xf8, 0xd9, 0x92, 0xf6, 0x04, 0xae, 0xbf, 0xe0, 0xfa,
+	0xe6, 0xef, 0xa5, 0x89, 0x27, 0x70, 0xfd, 0x01, 0xd7, 0xe7, 0x75, 0xcf, 0x17, 0xed, 0xee, 0x1e,
+	0x28, 0x07, 0xf6, 0x70, 0x13, 0xab, 0xf3, 0x60, 0x6f, 0x4a, 0xfd, 0xc3, 0x78, 0xfb, 0x3f, 0x1d,
+	0xad, 0x1e, 0x2f, 0x53, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -440,6 +663,14 @@ type MsgClient interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(ctx context.Context, in *MsgRegisterPreinstalls, opts ...grpc.CallOption) (*MsgRegisterPreinstallsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/vm module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error)
+	// RegisterCodeMetadata defines a method for publishing the metadata of a
+	// contract code hash stored on-chain, to be used as a source verification
+	// anchor. Any account can publish metadata for a code hash.
+	RegisterCodeMetadata(ctx context.Context, in *MsgRegisterCodeMetadata, opts ...grpc.CallOption) (*MsgRegisterCodeMetadataResponse, error)
 }
 
 type msgClient struct {
@@ -477,6 +708,24 @@ func (c *msgClient) RegisterPreinstalls(ctx context.Context, in *MsgRegisterPrei
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsAuthorities(ctx context.Context, in *MsgUpdateParamsAuthorities, opts ...grpc.CallOption) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	out := new(MsgUpdateParamsAuthoritiesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/UpdateParamsAuthorities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCodeMetadata(ctx context.Context, in *MsgRegisterCodeMetadata, opts ...grpc.CallOption) (*MsgRegisterCodeMetadataResponse, error) {
+	out := new(MsgRegisterCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Msg/RegisterCodeMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -489,6 +738,14 @@ type MsgServer interface {
 	// preinstalled contracts in the EVM. The authority is the same as is used for
 	// Params updates.
 	RegisterPreinstalls(context.Context, *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error)
+	// UpdateParamsAuthorities defines a governance operation for updating the
+	// accounts allowed to update a subset of the x/vm module parameters. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParamsAuthorities(context.Context, *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error)
+	// RegisterCodeMetadata defines a method for publishing the metadata of a
+	// contract code hash stored on-chain, to be used as a source verification
+	// anchor. Any account can publish metadata for a code hash.
+	RegisterCodeMetadata(context.Context, *MsgRegisterCodeMetadata) (*MsgRegisterCodeMetadataResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -504,6 +761,13 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) RegisterPreinstalls(ctx context.Context, req *MsgRegisterPreinstalls) (*MsgRegisterPreinstallsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterPreinstalls not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParamsAuthorities(ctx context.Context, req *MsgUpdateParamsAuthorities) (*MsgUpdateParamsAuthoritiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsAuthorities not implemented")
+}
+
+func (*UnimplementedMsgServer) RegisterCodeMetadata(ctx context.Context, req *MsgRegisterCodeMetadata) (*MsgRegisterCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCodeMetadata not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -563,6 +827,42 @@ func _Msg_RegisterPreinstalls_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsAuthorities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsAuthorities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/UpdateParamsAuthorities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsAuthorities(ctx, req.(*MsgUpdateParamsAuthorities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCodeMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Msg/RegisterCodeMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCodeMetadata(ctx, req.(*MsgRegisterCodeMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -579,6 +879,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterPreinstalls",
 			Handler:    _Msg_RegisterPreinstalls_Handler,
 		},
+		{
+			MethodName: "UpdateParamsAuthorities",
+			Handler:    _Msg_UpdateParamsAuthorities_Handler,
+		},
+		{
+			MethodName: "RegisterCodeMetadata",
+			Handler:    _Msg_RegisterCodeMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/vm/v1/tx.proto",
@@ -644,6 +952,15 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePaymentSignatures) > 0 {
+		for iNdEx := len(m.FeePaymentSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeePaymentSignatures[iNdEx])
+			copy(dAtA[i:], m.FeePaymentSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FeePaymentSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -857,56 +1174,196 @@ func (m *MsgRegisterPreinstallsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParamsAuthorities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParamsAuthorities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsAuthorities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ParamsAuthorities) > 0 {
+		for iNdEx := len(m.ParamsAuthorities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamsAuthorities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Raw.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExtensionOptionsEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsAuthoritiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEthereumTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterCodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MetadataUri) > 0 {
+		i -= len(m.MetadataUri)
+		copy(dAtA[i:], m.MetadataUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataUri)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCodeMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCodeMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCodeMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Raw.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeePaymentSignatures) > 0 {
+		for _, b := range m.FeePaymentSignatures {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEthereumTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	l = len(m.Ret)
 	if l > 0 {
@@ -984,6 +1441,64 @@ func (m *MsgRegisterPreinstallsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParamsAuthorities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ParamsAuthorities) > 0 {
+		for _, e := range m.ParamsAuthorities {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsAuthoritiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetadataUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCodeMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1136,6 +1651,38 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePaymentSignatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePaymentSignatures = append(m.FeePaymentSignatures, make([]byte, postIndex-iNdEx))
+			copy(m.FeePaymentSignatures[len(m.FeePaymentSignatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1761,6 +2308,368 @@ func (m *MsgRegisterPreinstallsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsAuthorities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthorities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthorities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsAuthorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsAuthorities = append(m.ParamsAuthorities, types.ParamsAuthority{})
+			if err := m.ParamsAuthorities[len(m.ParamsAuthorities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsAuthoritiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthoritiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsAuthoritiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCodeMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCodeMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCodeMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -278,6 +278,10 @@ func (args *TransactionArgs) ToTransaction(defaultType int) *types.Transaction {
 		}
 
 	case types.AccessListTxType:
+		al := types.AccessList{}
+		if args.AccessList != nil {
+			al = *args.AccessList
+		}
 		data = &types.AccessListTx{
 			To:         args.To,
 			ChainID:    (*big.Int)(args.ChainID),
@@ -286,7 +290,7 @@ func (args *TransactionArgs) ToTransaction(defaultType int) *types.Transaction {
 			GasPrice:   (*big.Int)(args.GasPrice),
 			Value:      (*big.Int)(args.Value),
 			Data:       args.GetData(),
-			AccessList: *args.AccessList,
+			AccessList: al,
 		}
 
 	default:
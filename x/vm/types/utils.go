@@ -172,6 +172,11 @@ func UnpackEthMsg(msg sdk.Msg) (
 func BinSearch(lo, hi uint64, executable func(uint64) (bool, *MsgEthereumTxResponse, error)) (uint64, error) {
 	for lo+1 < hi {
 		mid := (hi + lo) / 2
+		if lo > 0 && mid > lo*2 {
+			// Most transactions need a gas limit close to their gas used, far
+			// below the block gas limit, so the search is skewed to the low side.
+			mid = lo * 2
+		}
 		failed, _, err := executable(mid)
 		// If the error is not nil(consensus error), it means the provided message
 		// call or transaction will never be accepted no matter how much gas it is
@@ -374,6 +374,15 @@ func TestBinSearch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, gas, uint64(21000))
 
+	// the search is skewed to the low side with a large upper bound
+	gas, err = evmtypes.BinSearch(20999, 30_000_000, successExecutable)
+	require.NoError(t, err)
+	require.Equal(t, gas, uint64(21000))
+
+	gas, err = evmtypes.BinSearch(0, 30_000_000, successExecutable)
+	require.NoError(t, err)
+	require.Equal(t, gas, uint64(21000))
+
 	gas, err = evmtypes.BinSearch(20000, 21001, failedExecutable)
 	require.Error(t, err)
 	require.Equal(t, gas, uint64(0))
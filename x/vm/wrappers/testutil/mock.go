@@ -516,6 +516,18 @@ func (m *MockFeeMarketKeeper) EXPECT() *MockFeeMarketKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddTransientBaseFees mocks base method.
+func (m *MockFeeMarketKeeper) AddTransientBaseFees(ctx types.Context, fees types.Coins) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddTransientBaseFees", ctx, fees)
+}
+
+// AddTransientBaseFees indicates an expected call of AddTransientBaseFees.
+func (mr *MockFeeMarketKeeperMockRecorder) AddTransientBaseFees(ctx, fees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransientBaseFees", reflect.TypeOf((*MockFeeMarketKeeper)(nil).AddTransientBaseFees), ctx, fees)
+}
+
 // CalculateBaseFee mocks base method.
 func (m *MockFeeMarketKeeper) CalculateBaseFee(ctx types.Context) math.LegacyDec {
 	m.ctrl.T.Helper()